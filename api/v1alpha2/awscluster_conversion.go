@@ -56,13 +56,62 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.ImageLookupFormat = restored.Spec.ImageLookupFormat
 	dst.Spec.ImageLookupOrg = restored.Spec.ImageLookupOrg
 	dst.Spec.ImageLookupBaseOS = restored.Spec.ImageLookupBaseOS
+	dst.Spec.GPUDriverBucketURL = restored.Spec.GPUDriverBucketURL
+	dst.Spec.ServiceCatalogRef = restored.Spec.ServiceCatalogRef
+	dst.Spec.TerraformStateImport = restored.Spec.TerraformStateImport
+	dst.Spec.SecuritySpec = restored.Spec.SecuritySpec
+	dst.Spec.FISExperimentTemplates = restored.Spec.FISExperimentTemplates
+	dst.Spec.OIDCIssuerURL = restored.Spec.OIDCIssuerURL
+	dst.Spec.ServiceAccountRole = restored.Spec.ServiceAccountRole
+	dst.Spec.DeleteUnusedRoles = restored.Spec.DeleteUnusedRoles
+	dst.Spec.CertificateAuthority = restored.Spec.CertificateAuthority
+	dst.Spec.LifecycleNotifications = restored.Spec.LifecycleNotifications
+	dst.Spec.EventBridge = restored.Spec.EventBridge
+	dst.Spec.BootstrapTokenRotation = restored.Spec.BootstrapTokenRotation
+	dst.Spec.TerminationAlerts = restored.Spec.TerminationAlerts
+	dst.Spec.CostAllocationTags = restored.Spec.CostAllocationTags
+	dst.Spec.Karpenter = restored.Spec.Karpenter
+	dst.Spec.NodeTerminationHandler = restored.Spec.NodeTerminationHandler
+	dst.Spec.SSMParameterExport = restored.Spec.SSMParameterExport
+	dst.Spec.DataSync = restored.Spec.DataSync
+	dst.Spec.NetworkBenchmark = restored.Spec.NetworkBenchmark
+	dst.Spec.ControllerOptions = restored.Spec.ControllerOptions
+	dst.Spec.RoleARN = restored.Spec.RoleARN
 	if restored.Spec.ControlPlaneLoadBalancer != nil {
 		dst.Spec.ControlPlaneLoadBalancer = restored.Spec.ControlPlaneLoadBalancer
 	}
+	dst.Spec.AdditionalControlPlaneEndpoints = restored.Spec.AdditionalControlPlaneEndpoints
 	dst.Spec.NetworkSpec.CNI = restored.Spec.NetworkSpec.CNI
 	dst.Status.FailureDomains = restored.Status.FailureDomains
 	dst.Status.Network.APIServerELB.AvailabilityZones = restored.Status.Network.APIServerELB.AvailabilityZones
 	dst.Status.Network.APIServerELB.Attributes.CrossZoneLoadBalancing = restored.Status.Network.APIServerELB.Attributes.CrossZoneLoadBalancing
+	dst.Status.Network.AdditionalAPIServerELBs = restored.Status.Network.AdditionalAPIServerELBs
+	dst.Status.Network.RemoteRegion = restored.Status.Network.RemoteRegion
+	dst.Status.QuotaStatus = restored.Status.QuotaStatus
+	dst.Status.QuotaCheckedAt = restored.Status.QuotaCheckedAt
+	dst.Status.ProvisionedProductID = restored.Status.ProvisionedProductID
+	dst.Status.ConfigConformanceStatus = restored.Status.ConfigConformanceStatus
+	dst.Status.FISExperimentTemplateIDs = restored.Status.FISExperimentTemplateIDs
+	dst.Status.ServiceAccountRoles = restored.Status.ServiceAccountRoles
+	dst.Status.LifecycleEvent = restored.Status.LifecycleEvent
+	dst.Status.BootstrapTokenSecretARN = restored.Status.BootstrapTokenSecretARN
+	dst.Status.Karpenter = restored.Status.Karpenter
+	dst.Status.NTHQueueURL = restored.Status.NTHQueueURL
+	dst.Status.DataSyncTaskARN = restored.Status.DataSyncTaskARN
+	dst.Status.DataSyncTaskExecutionARN = restored.Status.DataSyncTaskExecutionARN
+	dst.Status.SSMParameters = restored.Status.SSMParameters
+	dst.Spec.NetworkSpec.RemoteRegion = restored.Spec.NetworkSpec.RemoteRegion
+	dst.Spec.NetworkSpec.RAMShare = restored.Spec.NetworkSpec.RAMShare
+	dst.Status.Network.ResourceShareARN = restored.Status.Network.ResourceShareARN
+	dst.Spec.NetworkSpec.InstanceConnectEndpoint = restored.Spec.NetworkSpec.InstanceConnectEndpoint
+	dst.Spec.NetworkSpec.CloudFormationStackRef = restored.Spec.NetworkSpec.CloudFormationStackRef
+	dst.Spec.NetworkSpec.SecurityGroupOverrides = restored.Spec.NetworkSpec.SecurityGroupOverrides
+	dst.Spec.NetworkSpec.VPCEndpoints = restored.Spec.NetworkSpec.VPCEndpoints
+	dst.Status.Network.InstanceConnectEndpoint = restored.Status.Network.InstanceConnectEndpoint
+	dst.Spec.NetworkSpec.EIPPool = restored.Spec.NetworkSpec.EIPPool
+	dst.Status.Network.PreallocatedEIPs = restored.Status.Network.PreallocatedEIPs
+	dst.Status.Network.VPC = restored.Status.Network.VPC
+	dst.Status.Network.Subnets = restored.Status.Network.Subnets
 
 	if restored.Status.Bastion != nil {
 		restored.Status.Bastion.DeepCopyInto(dst.Status.Bastion)
@@ -74,6 +123,7 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.NetworkSpec.VPC.AvailabilityZoneSelection != nil {
 		dst.Spec.NetworkSpec.VPC.AvailabilityZoneSelection = restored.Spec.NetworkSpec.VPC.AvailabilityZoneSelection
 	}
+	dst.Spec.NetworkSpec.VPC.Tenancy = restored.Spec.NetworkSpec.VPC.Tenancy
 	// Manually convert conditions
 	dst.SetConditions(restored.GetConditions())
 
@@ -207,3 +257,8 @@ func Convert_v1alpha3_VPCSpec_To_v1alpha2_VPCSpec(in *infrav1alpha3.VPCSpec, out
 func Convert_v1alpha3_NetworkSpec_To_v1alpha2_NetworkSpec(in *infrav1alpha3.NetworkSpec, out *NetworkSpec, s apiconversion.Scope) error {
 	return autoConvert_v1alpha3_NetworkSpec_To_v1alpha2_NetworkSpec(in, out, s)
 }
+
+// Convert_v1alpha3_Network_To_v1alpha2_Network
+func Convert_v1alpha3_Network_To_v1alpha2_Network(in *infrav1alpha3.Network, out *Network, s apiconversion.Scope) error {
+	return autoConvert_v1alpha3_Network_To_v1alpha2_Network(in, out, s)
+}
@@ -38,6 +38,18 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	restoreAWSMachineSpec(&restored.Spec, &dst.Spec)
+	dst.Status.AMIID = restored.Status.AMIID
+	dst.Status.ImageBuildVersionARN = restored.Status.ImageBuildVersionARN
+	dst.Status.EBSBaselineBandwidthMbps = restored.Status.EBSBaselineBandwidthMbps
+	dst.Status.SelectedFailureDomain = restored.Status.SelectedFailureDomain
+	dst.Status.SubnetID = restored.Status.SubnetID
+	dst.Status.FleetID = restored.Status.FleetID
+	dst.Status.VulnerabilityFindings = restored.Status.VulnerabilityFindings
+	dst.Status.VulnerabilitiesCheckedAt = restored.Status.VulnerabilitiesCheckedAt
+	dst.Status.SyncedTags = restored.Status.SyncedTags
+	dst.Status.SecureDeleteInstanceID = restored.Status.SecureDeleteInstanceID
+	dst.Status.SecureDeleteVolumeIDs = restored.Status.SecureDeleteVolumeIDs
+	dst.Status.IPv4Prefixes = restored.Status.IPv4Prefixes
 	// Manual conversion for conditions
 	dst.SetConditions(restored.GetConditions())
 	return nil
@@ -46,6 +58,7 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 func restoreAWSMachineSpec(restored, dst *infrav1alpha3.AWSMachineSpec) {
 	dst.ImageLookupFormat = restored.ImageLookupFormat
 	dst.ImageLookupBaseOS = restored.ImageLookupBaseOS
+	dst.OSType = restored.OSType
 
 	// Note this may override the manual conversion in Convert_v1alpha2_AWSMachineSpec_To_v1alpha3_AWSMachineSpec.
 	if restored.RootVolume != nil {
@@ -59,6 +72,40 @@ func restoreAWSMachineSpec(restored, dst *infrav1alpha3.AWSMachineSpec) {
 
 	// manual conversion for UncompressedUserData
 	dst.UncompressedUserData = restored.UncompressedUserData
+
+	dst.NitroEnclavesEnabled = restored.NitroEnclavesEnabled
+	dst.ENAExpressSettings = restored.ENAExpressSettings
+	dst.PreTerminationHook = restored.PreTerminationHook
+	dst.PostProvisionHook = restored.PostProvisionHook
+	dst.AutoExpandRootDisk = restored.AutoExpandRootDisk
+	dst.Hibernation = restored.Hibernation
+	dst.ShutdownBehavior = restored.ShutdownBehavior
+	dst.RestartOnStop = restored.RestartOnStop
+	dst.EBSOptimized = restored.EBSOptimized
+	dst.DetailedMonitoring = restored.DetailedMonitoring
+	dst.CPUOptions = restored.CPUOptions
+	dst.CreditSpecification = restored.CreditSpecification
+	dst.GPU = restored.GPU
+	dst.CloudProviderConfig = restored.CloudProviderConfig
+	dst.EphemeralStorage = restored.EphemeralStorage
+	dst.AMISSMPath = restored.AMISSMPath
+	dst.AMISourceRegion = restored.AMISourceRegion
+	dst.ImageBuilderPipelineARN = restored.ImageBuilderPipelineARN
+	dst.TriggerNewBuild = restored.TriggerNewBuild
+	dst.LaunchTemplateRef = restored.LaunchTemplateRef
+	dst.VerifySSHFingerprint = restored.VerifySSHFingerprint
+	dst.TagSyncLabelSelector = restored.TagSyncLabelSelector
+	dst.MinAvailableIPs = restored.MinAvailableIPs
+	dst.OutpostARN = restored.OutpostARN
+	dst.PlacementGroupAffinityKey = restored.PlacementGroupAffinityKey
+	dst.LaunchSnapshot = restored.LaunchSnapshot
+	dst.RestoreFromSnapshot = restored.RestoreFromSnapshot
+	dst.SecureDelete = restored.SecureDelete
+	dst.SecureDeleteTimeout = restored.SecureDeleteTimeout
+	dst.PrefixDelegation = restored.PrefixDelegation
+	dst.FleetMode = restored.FleetMode
+	dst.FleetAllocationStrategy = restored.FleetAllocationStrategy
+	dst.InstanceTypeRequirements = restored.InstanceTypeRequirements
 }
 
 // ConvertFrom converts from the Hub version (v1alpha3) to this version.
@@ -199,11 +199,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha3.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_Network_To_v1alpha2_Network(a.(*v1alpha3.Network), b.(*Network), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*NetworkSpec)(nil), (*v1alpha3.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_NetworkSpec_To_v1alpha3_NetworkSpec(a.(*NetworkSpec), b.(*v1alpha3.NetworkSpec), scope)
 	}); err != nil {
@@ -324,6 +319,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Network_To_v1alpha2_Network(a.(*v1alpha3.Network), b.(*Network), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.VPCSpec)(nil), (*VPCSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VPCSpec_To_v1alpha2_VPCSpec(a.(*v1alpha3.VPCSpec), b.(*VPCSpec), scope)
 	}); err != nil {
@@ -433,10 +433,12 @@ func autoConvert_v1alpha3_AWSClusterSpec_To_v1alpha2_AWSClusterSpec(in *v1alpha3
 		return err
 	}
 	out.Region = in.Region
+	// WARNING: in.RoleARN requires manual conversion: does not exist in peer-type
 	if err := v1.Convert_Pointer_string_To_string(&in.SSHKeyName, &out.SSHKeyName, s); err != nil {
 		return err
 	}
 	// WARNING: in.ControlPlaneEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalControlPlaneEndpoints requires manual conversion: does not exist in peer-type
 	out.AdditionalTags = *(*Tags)(unsafe.Pointer(&in.AdditionalTags))
 	if in.ControlPlaneLoadBalancer != nil {
 		in, out := &in.ControlPlaneLoadBalancer, &out.ControlPlaneLoadBalancer
@@ -450,7 +452,27 @@ func autoConvert_v1alpha3_AWSClusterSpec_To_v1alpha2_AWSClusterSpec(in *v1alpha3
 	// WARNING: in.ImageLookupFormat requires manual conversion: does not exist in peer-type
 	// WARNING: in.ImageLookupOrg requires manual conversion: does not exist in peer-type
 	// WARNING: in.ImageLookupBaseOS requires manual conversion: does not exist in peer-type
+	// WARNING: in.GPUDriverBucketURL requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServiceCatalogRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.TerraformStateImport requires manual conversion: does not exist in peer-type
 	// WARNING: in.Bastion requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecuritySpec requires manual conversion: does not exist in peer-type
+	// WARNING: in.FISExperimentTemplates requires manual conversion: does not exist in peer-type
+	// WARNING: in.OIDCIssuerURL requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServiceAccountRole requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeleteUnusedRoles requires manual conversion: does not exist in peer-type
+	// WARNING: in.CertificateAuthority requires manual conversion: does not exist in peer-type
+	// WARNING: in.LifecycleNotifications requires manual conversion: does not exist in peer-type
+	// WARNING: in.EventBridge requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapTokenRotation requires manual conversion: does not exist in peer-type
+	// WARNING: in.TerminationAlerts requires manual conversion: does not exist in peer-type
+	// WARNING: in.CostAllocationTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.Karpenter requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeTerminationHandler requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSMParameterExport requires manual conversion: does not exist in peer-type
+	// WARNING: in.DataSync requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkBenchmark requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControllerOptions requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -472,6 +494,19 @@ func autoConvert_v1alpha3_AWSClusterStatus_To_v1alpha2_AWSClusterStatus(in *v1al
 	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	// WARNING: in.Bastion requires manual conversion: inconvertible types (*sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3.Instance vs sigs.k8s.io/cluster-api-provider-aws/api/v1alpha2.Instance)
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
+	// WARNING: in.QuotaStatus requires manual conversion: does not exist in peer-type
+	// WARNING: in.QuotaCheckedAt requires manual conversion: does not exist in peer-type
+	// WARNING: in.ProvisionedProductID requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConfigConformanceStatus requires manual conversion: does not exist in peer-type
+	// WARNING: in.FISExperimentTemplateIDs requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServiceAccountRoles requires manual conversion: does not exist in peer-type
+	// WARNING: in.LifecycleEvent requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapTokenSecretARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.Karpenter requires manual conversion: does not exist in peer-type
+	// WARNING: in.NTHQueueURL requires manual conversion: does not exist in peer-type
+	// WARNING: in.DataSyncTaskARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.DataSyncTaskExecutionARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSMParameters requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -592,10 +627,19 @@ func autoConvert_v1alpha3_AWSMachineSpec_To_v1alpha2_AWSMachineSpec(in *v1alpha3
 	if err := Convert_v1alpha3_AWSResourceReference_To_v1alpha2_AWSResourceReference(&in.AMI, &out.AMI, s); err != nil {
 		return err
 	}
+	// WARNING: in.AMISSMPath requires manual conversion: does not exist in peer-type
+	// WARNING: in.AMISourceRegion requires manual conversion: does not exist in peer-type
+	// WARNING: in.ImageBuilderPipelineARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.TriggerNewBuild requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchTemplateRef requires manual conversion: does not exist in peer-type
 	// WARNING: in.ImageLookupFormat requires manual conversion: does not exist in peer-type
 	out.ImageLookupOrg = in.ImageLookupOrg
 	// WARNING: in.ImageLookupBaseOS requires manual conversion: does not exist in peer-type
+	// WARNING: in.OSType requires manual conversion: does not exist in peer-type
 	out.InstanceType = in.InstanceType
+	// WARNING: in.FleetMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.FleetAllocationStrategy requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceTypeRequirements requires manual conversion: does not exist in peer-type
 	out.AdditionalTags = *(*Tags)(unsafe.Pointer(&in.AdditionalTags))
 	out.IAMInstanceProfile = in.IAMInstanceProfile
 	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
@@ -606,9 +650,34 @@ func autoConvert_v1alpha3_AWSMachineSpec_To_v1alpha2_AWSMachineSpec(in *v1alpha3
 		return err
 	}
 	// WARNING: in.RootVolume requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoExpandRootDisk requires manual conversion: does not exist in peer-type
+	// WARNING: in.Hibernation requires manual conversion: does not exist in peer-type
+	// WARNING: in.ShutdownBehavior requires manual conversion: does not exist in peer-type
+	// WARNING: in.RestartOnStop requires manual conversion: does not exist in peer-type
+	// WARNING: in.NitroEnclavesEnabled requires manual conversion: does not exist in peer-type
+	// WARNING: in.ENAExpressSettings requires manual conversion: does not exist in peer-type
+	// WARNING: in.EBSOptimized requires manual conversion: does not exist in peer-type
+	// WARNING: in.DetailedMonitoring requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.CreditSpecification requires manual conversion: does not exist in peer-type
+	// WARNING: in.GPU requires manual conversion: does not exist in peer-type
+	// WARNING: in.CloudProviderConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.EphemeralStorage requires manual conversion: does not exist in peer-type
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
 	// WARNING: in.UncompressedUserData requires manual conversion: does not exist in peer-type
 	// WARNING: in.CloudInit requires manual conversion: inconvertible types (sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3.CloudInit vs *sigs.k8s.io/cluster-api-provider-aws/api/v1alpha2.CloudInit)
+	// WARNING: in.PreTerminationHook requires manual conversion: does not exist in peer-type
+	// WARNING: in.PostProvisionHook requires manual conversion: does not exist in peer-type
+	// WARNING: in.VerifySSHFingerprint requires manual conversion: does not exist in peer-type
+	// WARNING: in.TagSyncLabelSelector requires manual conversion: does not exist in peer-type
+	// WARNING: in.MinAvailableIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.OutpostARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroupAffinityKey requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.RestoreFromSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecureDelete requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecureDeleteTimeout requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrefixDelegation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -625,6 +694,18 @@ func autoConvert_v1alpha3_AWSMachineStatus_To_v1alpha2_AWSMachineStatus(in *v1al
 	out.Ready = in.Ready
 	out.Addresses = *(*[]apiv1alpha2.MachineAddress)(unsafe.Pointer(&in.Addresses))
 	out.InstanceState = (*InstanceState)(unsafe.Pointer(in.InstanceState))
+	// WARNING: in.AMIID requires manual conversion: does not exist in peer-type
+	// WARNING: in.ImageBuildVersionARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.EBSBaselineBandwidthMbps requires manual conversion: does not exist in peer-type
+	// WARNING: in.SelectedFailureDomain requires manual conversion: does not exist in peer-type
+	// WARNING: in.SubnetID requires manual conversion: does not exist in peer-type
+	// WARNING: in.FleetID requires manual conversion: does not exist in peer-type
+	// WARNING: in.VulnerabilityFindings requires manual conversion: does not exist in peer-type
+	// WARNING: in.VulnerabilitiesCheckedAt requires manual conversion: does not exist in peer-type
+	// WARNING: in.SyncedTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecureDeleteInstanceID requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecureDeleteVolumeIDs requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPv4Prefixes requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureReason requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureMessage requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
@@ -1010,7 +1091,16 @@ func autoConvert_v1alpha3_Instance_To_v1alpha2_Instance(in *v1alpha3.Instance, o
 	out.ENASupport = (*bool)(unsafe.Pointer(in.ENASupport))
 	out.EBSOptimized = (*bool)(unsafe.Pointer(in.EBSOptimized))
 	// WARNING: in.RootVolume requires manual conversion: does not exist in peer-type
+	// WARNING: in.Hibernation requires manual conversion: does not exist in peer-type
+	// WARNING: in.ShutdownBehavior requires manual conversion: does not exist in peer-type
+	// WARNING: in.DetailedMonitoring requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.CreditSpecification requires manual conversion: does not exist in peer-type
+	// WARNING: in.EphemeralStorage requires manual conversion: does not exist in peer-type
+	// WARNING: in.NitroEnclavesEnabled requires manual conversion: does not exist in peer-type
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
+	// WARNING: in.LaunchTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroupName requires manual conversion: does not exist in peer-type
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	// WARNING: in.AvailabilityZone requires manual conversion: does not exist in peer-type
 	return nil
@@ -1034,14 +1124,16 @@ func autoConvert_v1alpha3_Network_To_v1alpha2_Network(in *v1alpha3.Network, out
 	if err := Convert_v1alpha3_ClassicELB_To_v1alpha2_ClassicELB(&in.APIServerELB, &out.APIServerELB, s); err != nil {
 		return err
 	}
+	// WARNING: in.AdditionalAPIServerELBs requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemoteRegion requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceShareARN requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceConnectEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreallocatedEIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPC requires manual conversion: does not exist in peer-type
+	// WARNING: in.Subnets requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha3_Network_To_v1alpha2_Network is an autogenerated conversion function.
-func Convert_v1alpha3_Network_To_v1alpha2_Network(in *v1alpha3.Network, out *Network, s conversion.Scope) error {
-	return autoConvert_v1alpha3_Network_To_v1alpha2_Network(in, out, s)
-}
-
 func autoConvert_v1alpha2_NetworkSpec_To_v1alpha3_NetworkSpec(in *NetworkSpec, out *v1alpha3.NetworkSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha2_VPCSpec_To_v1alpha3_VPCSpec(&in.VPC, &out.VPC, s); err != nil {
 		return err
@@ -1061,6 +1153,13 @@ func autoConvert_v1alpha3_NetworkSpec_To_v1alpha2_NetworkSpec(in *v1alpha3.Netwo
 	}
 	out.Subnets = *(*Subnets)(unsafe.Pointer(&in.Subnets))
 	// WARNING: in.CNI requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemoteRegion requires manual conversion: does not exist in peer-type
+	// WARNING: in.RAMShare requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceConnectEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.CloudFormationStackRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecurityGroupOverrides requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCEndpoints requires manual conversion: does not exist in peer-type
+	// WARNING: in.EIPPool requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1162,5 +1261,6 @@ func autoConvert_v1alpha3_VPCSpec_To_v1alpha2_VPCSpec(in *v1alpha3.VPCSpec, out
 	out.Tags = *(*Tags)(unsafe.Pointer(&in.Tags))
 	// WARNING: in.AvailabilityZoneUsageLimit requires manual conversion: does not exist in peer-type
 	// WARNING: in.AvailabilityZoneSelection requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tenancy requires manual conversion: does not exist in peer-type
 	return nil
 }
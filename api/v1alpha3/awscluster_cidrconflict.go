@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultManagedVPCCidrBlock is the CIDR block of the managed VPCs whose CIDR block is left empty.
+const defaultManagedVPCCidrBlock = "10.0.0.0/16"
+
+// CIDRConflictDetector rejects AWSClusters whose VPC CIDR block conflicts with another AWSCluster, when
+// set. It is set by the manager, as the AWS clients it calls are not part of the API.
+var CIDRConflictDetector *ClusterCIDRConflictDetector
+
+// ClusterCIDRConflictDetector detects AWSClusters whose VPC CIDR block overlaps the VPC CIDR block of
+// another AWSCluster in the same AWS account, as their VPCs could not be peered with each other. The
+// account of an AWSCluster is the account of its role, or the controller's own account without one.
+type ClusterCIDRConflictDetector struct {
+	// Reader lists the AWSClusters.
+	Reader client.Reader
+
+	// ControllerAccountID returns the ID of the AWS account of the controller's own credentials.
+	ControllerAccountID func(region string) (string, error)
+
+	// VPCCIDRBlock returns the CIDR block of an existing VPC, described with the credentials of the
+	// given region and role.
+	VPCCIDRBlock func(region, roleARN, vpcID string) (string, error)
+}
+
+// Detect returns an error for each AWSCluster in the same account as the given one whose VPC CIDR
+// block overlaps its own. AWSClusters whose account or CIDR block cannot be determined are skipped,
+// so that a broken cluster does not block the creation of the others.
+func (d *ClusterCIDRConflictDetector) Detect(ctx context.Context, cluster *AWSCluster) field.ErrorList {
+	path := field.NewPath("spec", "networkSpec", "vpc", "cidrBlock")
+
+	cidrBlock, err := d.cidrBlock(cluster)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	if cidrBlock == nil {
+		return nil
+	}
+	accountID, err := d.accountID(cluster)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+
+	clusters := &AWSClusterList{}
+	if err := d.Reader.List(ctx, clusters); err != nil {
+		return field.ErrorList{field.InternalError(path, errors.Wrap(err, "failed to list AWSClusters"))}
+	}
+
+	var allErrs field.ErrorList
+	for i := range clusters.Items {
+		other := &clusters.Items[i]
+		if (other.Namespace == cluster.Namespace && other.Name == cluster.Name) || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		otherAccountID, err := d.accountID(other)
+		if err != nil {
+			awsclusterlog.Error(err, "failed to find the AWS account of AWSCluster, skipping CIDR conflict detection", "namespace", other.Namespace, "name", other.Name)
+			continue
+		}
+		if otherAccountID != accountID {
+			continue
+		}
+
+		otherCidrBlock, err := d.cidrBlock(other)
+		if err != nil {
+			awsclusterlog.Error(err, "failed to find the VPC CIDR block of AWSCluster, skipping CIDR conflict detection", "namespace", other.Namespace, "name", other.Name)
+			continue
+		}
+		if otherCidrBlock != nil && cidrBlocksOverlap(cidrBlock, otherCidrBlock) {
+			allErrs = append(allErrs, field.Invalid(path, cidrBlock.String(),
+				fmt.Sprintf("overlaps VPC CIDR block %s of AWSCluster %s/%s in the same AWS account", otherCidrBlock, other.Namespace, other.Name)))
+		}
+	}
+	return allErrs
+}
+
+// accountID returns the ID of the AWS account of the AWSCluster.
+func (d *ClusterCIDRConflictDetector) accountID(cluster *AWSCluster) (string, error) {
+	if cluster.Spec.RoleARN == "" {
+		return d.ControllerAccountID(cluster.Spec.Region)
+	}
+	parsed, err := arn.Parse(cluster.Spec.RoleARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse role ARN %q", cluster.Spec.RoleARN)
+	}
+	return parsed.AccountID, nil
+}
+
+// cidrBlock returns the CIDR block of the VPC of the AWSCluster, describing unmanaged VPCs whose CIDR
+// block is not set, or nil when it cannot be known yet.
+func (d *ClusterCIDRConflictDetector) cidrBlock(cluster *AWSCluster) (*net.IPNet, error) {
+	cidrBlock := localVPCCidrBlock(cluster)
+	if vpc := cluster.Spec.NetworkSpec.VPC; cidrBlock == "" && vpc.ID != "" && d.VPCCIDRBlock != nil {
+		if cluster.Spec.RoleARN != "" && RoleValidator != nil {
+			if err := RoleValidator(cluster.Namespace, cluster.Spec.RoleARN); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if cidrBlock, err = d.VPCCIDRBlock(cluster.Spec.Region, cluster.Spec.RoleARN, vpc.ID); err != nil {
+			return nil, errors.Wrapf(err, "failed to describe VPC %q", vpc.ID)
+		}
+	}
+	if cidrBlock == "" {
+		return nil, nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse VPC CIDR block %q", cidrBlock)
+	}
+	return ipNet, nil
+}
+
+// localVPCCidrBlock returns the CIDR block of the VPC of the AWSCluster known without calling AWS: the
+// CIDR block of its spec, or the default CIDR block of managed VPCs.
+func localVPCCidrBlock(cluster *AWSCluster) string {
+	vpc := cluster.Spec.NetworkSpec.VPC
+	if vpc.CidrBlock == "" && vpc.ID == "" {
+		return defaultManagedVPCCidrBlock
+	}
+	return vpc.CidrBlock
+}
+
+// cidrBlocksOverlap returns true if the CIDR blocks share addresses, which is when either holds the
+// first address of the other.
+func cidrBlocksOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
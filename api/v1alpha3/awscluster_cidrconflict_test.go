@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClusterCIDRConflictDetector_Detect(t *testing.T) {
+	cluster := func(namespace, name, roleARN string, vpc VPCSpec) *AWSCluster {
+		return &AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       AWSClusterSpec{Region: "us-east-1", RoleARN: roleARN, NetworkSpec: NetworkSpec{VPC: vpc}},
+		}
+	}
+	const otherAccountRole = "arn:aws:iam::210987654321:role/capa"
+
+	tests := []struct {
+		name     string
+		cluster  *AWSCluster
+		existing []runtime.Object
+		wantErr  bool
+	}{
+		{
+			name:    "single cluster does not conflict",
+			cluster: cluster("default", "test", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+		},
+		{
+			name:    "cluster does not conflict with itself",
+			cluster: cluster("default", "test", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			existing: []runtime.Object{
+				cluster("default", "test", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			},
+		},
+		{
+			name:    "overlapping CIDR block in the same account conflicts",
+			cluster: cluster("default", "test", "", VPCSpec{CidrBlock: "10.0.128.0/17"}),
+			existing: []runtime.Object{
+				cluster("other", "other", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "default CIDR blocks of managed VPCs conflict",
+			cluster: cluster("default", "test", "", VPCSpec{}),
+			existing: []runtime.Object{
+				cluster("default", "other", "", VPCSpec{}),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "adjacent CIDR blocks do not conflict",
+			cluster: cluster("default", "test", "", VPCSpec{CidrBlock: "10.1.0.0/16"}),
+			existing: []runtime.Object{
+				cluster("default", "other", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			},
+		},
+		{
+			name:    "same CIDR block in another account does not conflict",
+			cluster: cluster("default", "test", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			existing: []runtime.Object{
+				cluster("default", "other", otherAccountRole, VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			},
+		},
+		{
+			name:    "role in the controller's account conflicts",
+			cluster: cluster("default", "test", "arn:aws:iam::123456789012:role/capa", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			existing: []runtime.Object{
+				cluster("default", "other", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unmanaged VPC is described in the account of its role",
+			cluster: cluster("default", "test", otherAccountRole, VPCSpec{CidrBlock: "10.0.0.0/24"}),
+			existing: []runtime.Object{
+				cluster("default", "other", otherAccountRole, VPCSpec{ID: "vpc-1"}),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "clusters whose VPC cannot be described are skipped",
+			cluster: cluster("default", "test", "", VPCSpec{CidrBlock: "10.0.0.0/16"}),
+			existing: []runtime.Object{
+				cluster("default", "other", "", VPCSpec{ID: "vpc-missing"}),
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = AddToScheme(scheme)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			detector := &ClusterCIDRConflictDetector{
+				Reader: fake.NewFakeClientWithScheme(scheme, tt.existing...),
+				ControllerAccountID: func(region string) (string, error) {
+					return "123456789012", nil
+				},
+				VPCCIDRBlock: func(region, roleARN, vpcID string) (string, error) {
+					if vpcID == "vpc-1" && roleARN == otherAccountRole {
+						return "10.0.0.0/16", nil
+					}
+					return "", errors.New("VPC not found")
+				},
+			}
+
+			errs := detector.Detect(context.Background(), tt.cluster)
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
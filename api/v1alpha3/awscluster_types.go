@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
@@ -25,6 +26,32 @@ const (
 	// ClusterFinalizer allows ReconcileAWSCluster to clean up AWS resources associated with AWSCluster before
 	// removing it from the apiserver.
 	ClusterFinalizer = "awscluster.infrastructure.cluster.x-k8s.io"
+
+	// StartFISExperimentAnnotation starts an experiment from the FIS experiment template of the AWSCluster
+	// named by its value. The annotation is removed once the experiment is started.
+	StartFISExperimentAnnotation = "fis.aws.infrastructure.cluster.x-k8s.io/start-experiment"
+
+	// TopologyDigestAnnotation holds a JSON graph of the VPC, internet gateway, NAT gateways, subnets and
+	// route tables of the AWSCluster, updated after the network is reconciled.
+	TopologyDigestAnnotation = "network.aws.infrastructure.cluster.x-k8s.io/topology-digest"
+
+	// ReconcileReasonAnnotation summarizes the last changes made to the network resources of the AWSCluster
+	// by its reconciliation.
+	ReconcileReasonAnnotation = "network.aws.infrastructure.cluster.x-k8s.io/reconcile-reason"
+
+	// AdoptAnnotation adopts the existing VPC, subnets and security groups referenced by the network spec
+	// of the AWSCluster when set to "true". Adopted resources are tagged as owned by the cluster, so that
+	// they are reconciled and deleted along with it instead of being created.
+	AdoptAnnotation = "cluster-api-provider-aws.sigs.k8s.io/adopt"
+
+	// EstimatedMonthlyCostAnnotation holds the estimated monthly cost in USD of the instances, NAT gateways,
+	// load balancer and volumes of the AWSCluster, computed before any of its resources are created when the
+	// controller runs with --enable-cost-estimates.
+	EstimatedMonthlyCostAnnotation = "cluster-api-provider-aws.sigs.k8s.io/estimated-monthly-cost-usd"
+
+	// NetworkBenchmarkResultAnnotation holds the JSON result of the iperf3 benchmark of the network
+	// between two nodes of the AWSCluster, run once the cluster is ready.
+	NetworkBenchmarkResultAnnotation = "cluster-api-provider-aws.sigs.k8s.io/network-benchmark-result"
 )
 
 // AWSClusterSpec defines the desired state of AWSCluster
@@ -35,6 +62,16 @@ type AWSClusterSpec struct {
 	// The AWS Region the cluster lives in.
 	Region string `json:"region,omitempty"`
 
+	// RoleARN is the ARN of an IAM role the controller assumes to manage the
+	// AWS resources of this cluster, which may live in a different account
+	// from the controller. The controller's own credentials must be allowed to
+	// assume the role, and the controller must be started with the role and the
+	// namespace of the AWSCluster in its allow lists. Defaults to the
+	// controller's credentials.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:iam::[0-9]{12}:role/.+$`
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
 	// SSHKeyName is the name of the ssh key to attach to the bastion host. Valid values are empty string (do not use SSH keys), a valid SSH key name, or omitted (use the default SSH key name)
 	// +optional
 	SSHKeyName *string `json:"sshKeyName,omitempty"`
@@ -43,6 +80,16 @@ type AWSClusterSpec struct {
 	// +optional
 	ControlPlaneEndpoint clusterv1.APIEndpoint `json:"controlPlaneEndpoint"`
 
+	// AdditionalControlPlaneEndpoints is an optional list of extra endpoints used to communicate
+	// with the control plane. An additional classic load balancer is created for each entry,
+	// listening on the entry's port, which must differ from the control plane endpoint port.
+	// The scheme is not configurable, the load balancer always uses the opposite scheme of the
+	// control plane load balancer (e.g. an internal load balancer when the control plane load
+	// balancer is internet-facing). The host must be left empty, the endpoint is reached through
+	// the DNS name of its load balancer, recorded in status.network.additionalApiServerElbs.
+	// +optional
+	AdditionalControlPlaneEndpoints []clusterv1.APIEndpoint `json:"additionalControlPlaneEndpoints,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the
 	// ones added by default.
 	// +optional
@@ -79,9 +126,143 @@ type AWSClusterSpec struct {
 	// different ImageLookupBaseOS.
 	ImageLookupBaseOS string `json:"imageLookupBaseOS,omitempty"`
 
+	// GPUDriverBucketURL is the S3 location, in the form s3://bucket/prefix,
+	// from which machines with GPU drivers configured download the NVIDIA driver
+	// and CUDA toolkit installers. The node IAM role must be allowed to read from it.
+	// +kubebuilder:validation:Pattern=`^s3://[a-z0-9][a-z0-9.-]+[a-z0-9](/.*)?$`
+	// +optional
+	GPUDriverBucketURL string `json:"gpuDriverBucketURL,omitempty"`
+
+	// ServiceCatalogRef provisions a Service Catalog product for the cluster
+	// and imports the VPC and subnets of the cluster from the outputs of the
+	// provisioned product, for accounts where resources may only be created
+	// through Service Catalog.
+	// +optional
+	ServiceCatalogRef *ServiceCatalogRef `json:"serviceCatalogRef,omitempty"`
+
+	// TerraformStateImport imports the VPC, subnets, security groups and API
+	// server load balancer of the cluster from the state of an existing
+	// Terraform configuration, to migrate clusters whose network is managed
+	// by Terraform. The state is imported once, before the network is
+	// reconciled, and the imported resources are tagged with
+	// sigs.k8s.io/cluster-api-provider-aws/imported-from=terraform.
+	// +optional
+	TerraformStateImport *TFStateImportSpec `json:"terraformStateImport,omitempty"`
+
 	// Bastion contains options to configure the bastion host.
 	// +optional
 	Bastion Bastion `json:"bastion"`
+
+	// SecuritySpec contains options related to the security and compliance of the cluster.
+	// +optional
+	SecuritySpec SecuritySpec `json:"securitySpec,omitempty"`
+
+	// FISExperimentTemplates are AWS Fault Injection Simulator experiment
+	// templates created for the cluster, to run chaos experiments against
+	// its resources. Experiments are started with the
+	// fis.aws.infrastructure.cluster.x-k8s.io/start-experiment annotation.
+	// +optional
+	FISExperimentTemplates []FISTemplateSpec `json:"fisExperimentTemplates,omitempty"`
+
+	// OIDCIssuerURL is the issuer URL of the cluster's service account
+	// tokens, which must be registered in the account as an IAM OIDC
+	// identity provider. Required by ServiceAccountRole.
+	// +kubebuilder:validation:Pattern=`^https://`
+	// +optional
+	OIDCIssuerURL string `json:"oidcIssuerURL,omitempty"`
+
+	// ServiceAccountRole are IAM roles created for the cluster, which
+	// Kubernetes service accounts assume through IAM Roles for Service
+	// Accounts (IRSA).
+	// +optional
+	ServiceAccountRole []ServiceAccountRoleSpec `json:"serviceAccountRole,omitempty"`
+
+	// DeleteUnusedRoles deletes the IAM roles created for service accounts
+	// once they are removed from ServiceAccountRole. Otherwise such roles
+	// are left in the account.
+	// +optional
+	DeleteUnusedRoles bool `json:"deleteUnusedRoles,omitempty"`
+
+	// CertificateAuthority configures the certificate authority issuing
+	// certificates for the cluster.
+	// +optional
+	CertificateAuthority *CertificateAuthoritySpec `json:"certificateAuthority,omitempty"`
+
+	// LifecycleNotifications publishes the lifecycle events of the cluster
+	// to an SNS topic.
+	// +optional
+	LifecycleNotifications *SNSNotificationSpec `json:"lifecycleNotifications,omitempty"`
+
+	// EventBridge publishes the lifecycle events of the cluster's AWSMachines
+	// to an EventBridge event bus.
+	// +optional
+	EventBridge *EventBridgeSpec `json:"eventBridge,omitempty"`
+
+	// BootstrapTokenRotation stores a node bootstrap token in AWS Secrets Manager
+	// and rotates it, so that nodes started after the expiry of the tokens issued
+	// by the bootstrap provider can still join the cluster.
+	// +optional
+	BootstrapTokenRotation *RotationSpec `json:"bootstrapTokenRotation,omitempty"`
+
+	// TerminationAlerts sends the EC2 state-change notifications of the cluster's
+	// instances reaching the terminated state to an SNS topic or SQS queue,
+	// through an EventBridge rule.
+	// +optional
+	TerminationAlerts *TerminationAlertSpec `json:"terminationAlerts,omitempty"`
+
+	// CostAllocationTags are the keys of tags activated as cost allocation tags
+	// of the account when the cluster is created, so that Cost Explorer can
+	// break down the spend of its machines by these tags, e.g. tags set through
+	// AdditionalTags. Billing only knows the keys of tags applied to resources,
+	// which can take up to 24 hours after the tags are first used.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	CostAllocationTags []string `json:"costAllocationTags,omitempty"`
+
+	// Karpenter installs the Karpenter node provisioner in the cluster once it
+	// is ready, along with the IAM resources, SQS interruption queue and
+	// EventBridge rules it needs. Requires OIDCIssuerURL.
+	// +optional
+	Karpenter *KarpenterSpec `json:"karpenter,omitempty"`
+
+	// NodeTerminationHandler configures the SQS queue the AWS Node Termination
+	// Handler of the cluster receives the interruption events of its instances
+	// from, either an existing queue or one created along with its EventBridge
+	// rules.
+	// +optional
+	NodeTerminationHandler *NTHSpec `json:"nodeTerminationHandler,omitempty"`
+
+	// SSMParameterExport exports fields of the status of the cluster as
+	// parameters of the AWS Systems Manager Parameter Store, for tools without
+	// access to the management cluster. The parameters are deleted with the
+	// cluster.
+	// +optional
+	SSMParameterExport *SSMExportSpec `json:"ssmParameterExport,omitempty"`
+
+	// DataSync creates an AWS DataSync task copying the data of persistent
+	// volumes between existing DataSync locations, e.g. to migrate workloads
+	// from another cluster. The task is run once when it is created, then on
+	// its schedule if any. The task is deleted with the cluster.
+	// +optional
+	DataSync *DataSyncSpec `json:"dataSync,omitempty"`
+
+	// NetworkBenchmark measures the bandwidth between two randomly selected
+	// nodes of the cluster with iperf3 once the cluster is ready, and reports
+	// it in the network-benchmark-result annotation of the AWSCluster.
+	// +optional
+	NetworkBenchmark *BenchmarkSpec `json:"networkBenchmark,omitempty"`
+
+	// ControllerOptions configures optional behaviours of the AWSCluster controller.
+	// +optional
+	ControllerOptions *ControllerOptions `json:"controllerOptions,omitempty"`
+}
+
+// SecuritySpec contains options related to the security and compliance of a cluster.
+type SecuritySpec struct {
+	// ConformancePacks are the names of AWS Config conformance packs whose
+	// compliance is reported in the status of the cluster.
+	// +optional
+	ConformancePacks []string `json:"conformancePacks,omitempty"`
 }
 
 type Bastion struct {
@@ -117,6 +298,100 @@ type AWSClusterStatus struct {
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                `json:"bastion,omitempty"`
 	Conditions     clusterv1.Conditions     `json:"conditions,omitempty"`
+
+	// QuotaStatus reports the usage of the AWS service quotas the cluster
+	// depends on, as of QuotaCheckedAt.
+	// +optional
+	QuotaStatus []QuotaUsage `json:"quotaStatus,omitempty"`
+
+	// QuotaCheckedAt is the last time QuotaStatus was updated.
+	// +optional
+	QuotaCheckedAt *metav1.Time `json:"quotaCheckedAt,omitempty"`
+
+	// ProvisionedProductID is the ID of the Service Catalog provisioned
+	// product created for the cluster's ServiceCatalogRef.
+	// +optional
+	ProvisionedProductID string `json:"provisionedProductID,omitempty"`
+
+	// ConfigConformanceStatus reports the compliance of the AWS Config
+	// conformance packs listed in the cluster's SecuritySpec.
+	// +optional
+	ConfigConformanceStatus []ConformancePackStatus `json:"configConformanceStatus,omitempty"`
+
+	// FISExperimentTemplateIDs maps the names of the cluster's FIS experiment
+	// templates to their IDs.
+	// +optional
+	FISExperimentTemplateIDs map[string]string `json:"fisExperimentTemplateIDs,omitempty"`
+
+	// ServiceAccountRoles maps the names of the IAM roles created for the
+	// cluster's service accounts to their ARNs.
+	// +optional
+	ServiceAccountRoles map[string]string `json:"serviceAccountRoles,omitempty"`
+
+	// LifecycleEvent is the last lifecycle event of the cluster, as published
+	// to the SNS topic of its LifecycleNotifications.
+	// +optional
+	LifecycleEvent ClusterLifecycleEvent `json:"lifecycleEvent,omitempty"`
+
+	// BootstrapTokenSecretARN is the ARN of the AWS Secrets Manager secret
+	// holding the current node bootstrap token.
+	// +optional
+	BootstrapTokenSecretARN string `json:"bootstrapTokenSecretARN,omitempty"`
+
+	// Karpenter reports the resources created for the cluster's Karpenter
+	// installation.
+	// +optional
+	Karpenter *KarpenterStatus `json:"karpenter,omitempty"`
+
+	// NTHQueueURL is the URL of the SQS queue of the cluster's AWS Node
+	// Termination Handler.
+	// +optional
+	NTHQueueURL string `json:"nthQueueURL,omitempty"`
+
+	// DataSyncTaskARN is the ARN of the DataSync task created for the
+	// cluster's DataSync.
+	// +optional
+	DataSyncTaskARN string `json:"dataSyncTaskARN,omitempty"`
+
+	// DataSyncTaskExecutionARN is the ARN of the last execution of the
+	// DataSync task.
+	// +optional
+	DataSyncTaskExecutionARN string `json:"dataSyncTaskExecutionARN,omitempty"`
+
+	// SSMParameters lists the names of the SSM parameters written for the
+	// cluster's SSMParameterExport, which are deleted once they are no longer
+	// exported or along with the cluster.
+	// +optional
+	SSMParameters []string `json:"ssmParameters,omitempty"`
+}
+
+// ConformancePackStatus reports the compliance of an AWS Config conformance pack.
+type ConformancePackStatus struct {
+	// PackName is the name of the conformance pack.
+	PackName string `json:"packName"`
+
+	// ComplianceType is the compliance of the conformance pack, one of
+	// COMPLIANT, NON_COMPLIANT or INSUFFICIENT_DATA.
+	ComplianceType string `json:"complianceType"`
+
+	// LastUpdate is the last time the compliance of the conformance pack was checked.
+	LastUpdate metav1.Time `json:"lastUpdate"`
+}
+
+// QuotaUsage reports the usage of an AWS service quota.
+type QuotaUsage struct {
+	// ServiceCode is the Service Quotas code of the service, e.g. ec2.
+	ServiceCode string `json:"serviceCode"`
+
+	// QuotaCode is the Service Quotas code of the quota, e.g. L-1216C47A.
+	QuotaCode string `json:"quotaCode"`
+
+	// Value is the quota value applied to the account. Quantities are used
+	// rather than floating point numbers, which CRDs don't support well.
+	Value resource.Quantity `json:"value"`
+
+	// UsedValue is the current usage of the quota in the cluster's region.
+	UsedValue resource.Quantity `json:"usedValue"`
 }
 
 // +kubebuilder:object:root=true
@@ -17,22 +17,46 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/cluster-api-provider-aws/internal/subnet"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
-// log is for logging in this package.
-var _ = logf.Log.WithName("awscluster-resource")
+// awsclusterlog is for logging in the AWSCluster webhooks.
+var awsclusterlog = logf.Log.WithName("awscluster-resource")
+
+// defaultMaxAvailabilityZones is the number of availability zones the default subnets of a managed VPC are
+// created in, unless limited by its AvailabilityZoneUsageLimit.
+const defaultMaxAvailabilityZones = 3
+
+// defaultAPIServerPort is the port the API server load balancer listens on unless the owning Cluster sets one.
+const defaultAPIServerPort = 6443
+
+// webhookReader reads the MachineDeployments of the clusters of the AWSClusters being defaulted. It is the
+// manager's API reader, which bypasses the cache, so it is only used when the VPC CIDR block needs sizing.
+// It is nil, and the CIDR block is left to its default, until the webhook is registered with a manager.
+var webhookReader client.Reader
+
+// RoleValidator returns an error unless AWSClusters of the given namespace may have the controller assume
+// the given role. It is set by the manager, which restricts the roles the controller assumes too.
+var RoleValidator func(namespace, roleARN string) error
 
 func (r *AWSCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	webhookReader = mgr.GetAPIReader()
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -47,9 +71,78 @@ var (
 )
 
 func (r *AWSCluster) ValidateCreate() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, r.validateAdditionalControlPlaneEndpoints()...)
+	allErrs = append(allErrs, r.validateRemoteRegion()...)
+	allErrs = append(allErrs, r.validateRAMShare()...)
+	allErrs = append(allErrs, r.validateCloudFormationStackRef()...)
+	allErrs = append(allErrs, r.validateServiceCatalogRef()...)
+	allErrs = append(allErrs, r.validateFISExperimentTemplates()...)
+	allErrs = append(allErrs, r.validateServiceAccountRoles()...)
+	allErrs = append(allErrs, r.validateKarpenter()...)
+	allErrs = append(allErrs, r.validateNodeTerminationHandler()...)
+	allErrs = append(allErrs, r.validateSSMParameterExport()...)
+	allErrs = append(allErrs, r.validateDataSync()...)
+	allErrs = append(allErrs, r.validateAdoption()...)
+	allErrs = append(allErrs, r.validateVPCEndpoints()...)
+	allErrs = append(allErrs, r.validateSubnets()...)
+
+	roleErrs := r.validateRoleARN()
+	allErrs = append(allErrs, roleErrs...)
+
+	// Detecting CIDR conflicts may assume the role of the AWSCluster, which must be allowed first.
+	if CIDRConflictDetector != nil && len(roleErrs) == 0 {
+		allErrs = append(allErrs, CIDRConflictDetector.Detect(context.TODO(), r)...)
+	}
+
+	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+}
+
+// validateRoleARN rejects roles the AWSCluster is not allowed to use, see RoleValidator.
+func (r *AWSCluster) validateRoleARN() field.ErrorList {
+	if r.Spec.RoleARN == "" || RoleValidator == nil {
+		return nil
+	}
+	if err := RoleValidator(r.Namespace, r.Spec.RoleARN); err != nil {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "roleARN"), err.Error())}
+	}
 	return nil
 }
 
+// validateSubnets validates the subnets of the network spec, which are only checked on creation, before the
+// controller updates them with the subnets it found or created.
+func (r *AWSCluster) validateSubnets() field.ErrorList {
+	var allErrs field.ErrorList
+
+	specs := make([]subnet.SubnetSpec, len(r.Spec.NetworkSpec.Subnets))
+	for i, sn := range r.Spec.NetworkSpec.Subnets {
+		if sn == nil {
+			continue
+		}
+		specs[i] = subnet.SubnetSpec{
+			ID:               sn.ID,
+			CidrBlock:        sn.CidrBlock,
+			AvailabilityZone: sn.AvailabilityZone,
+			IsPublic:         sn.IsPublic,
+		}
+		if sn.NatGatewayID != nil {
+			specs[i].NatGatewayID = *sn.NatGatewayID
+		}
+	}
+
+	path := field.NewPath("spec", "networkSpec", "subnets")
+	for _, err := range subnet.ValidateSubnetSpecs(r.Spec.NetworkSpec.VPC.CidrBlock, specs) {
+		if fieldErr, ok := err.(*subnet.FieldError); ok {
+			allErrs = append(allErrs, field.Invalid(path.Index(fieldErr.Index).Child(fieldErr.Field), fieldErr.Value, fieldErr.Detail))
+		} else {
+			allErrs = append(allErrs, field.Invalid(path, r.Spec.NetworkSpec.Subnets, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSCluster) ValidateDelete() error {
 	return nil
 }
@@ -68,6 +161,12 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		)
 	}
 
+	if r.Spec.RoleARN != oldC.Spec.RoleARN {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "roleARN"), r.Spec.RoleARN, "field is immutable"),
+		)
+	}
+
 	if !reflect.DeepEqual(r.Spec.ControlPlaneLoadBalancer, oldC.Spec.ControlPlaneLoadBalancer) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer"),
@@ -82,10 +181,470 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		)
 	}
 
+	if r.Spec.NetworkSpec.VPC.Tenancy != oldC.Spec.NetworkSpec.VPC.Tenancy {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "networkSpec", "vpc", "tenancy"), r.Spec.NetworkSpec.VPC.Tenancy, "field is immutable"),
+		)
+	}
+
+	if oldRemote := oldC.Spec.NetworkSpec.RemoteRegion; oldRemote != nil {
+		path := field.NewPath("spec", "networkSpec", "remoteRegion")
+		newRemote := r.Spec.NetworkSpec.RemoteRegion
+		switch {
+		case newRemote == nil:
+			allErrs = append(allErrs, field.Invalid(path, newRemote, "field cannot be removed"))
+		default:
+			if newRemote.Region != oldRemote.Region {
+				allErrs = append(allErrs, field.Invalid(path.Child("region"), newRemote.Region, "field is immutable"))
+			}
+			if newRemote.VPCCidr != oldRemote.VPCCidr {
+				allErrs = append(allErrs, field.Invalid(path.Child("vpcCidr"), newRemote.VPCCidr, "field is immutable"))
+			}
+			if newRemote.TransitGatewayID != oldRemote.TransitGatewayID {
+				allErrs = append(allErrs, field.Invalid(path.Child("transitGatewayId"), newRemote.TransitGatewayID, "field is immutable"))
+			}
+		}
+	}
+
+	if oldShare := oldC.Spec.NetworkSpec.RAMShare; oldShare != nil {
+		path := field.NewPath("spec", "networkSpec", "ramShare")
+		newShare := r.Spec.NetworkSpec.RAMShare
+		switch {
+		case newShare == nil:
+			allErrs = append(allErrs, field.Invalid(path, newShare, "field cannot be removed"))
+		case newShare.ResourceShareARN != oldShare.ResourceShareARN:
+			allErrs = append(allErrs, field.Invalid(path.Child("resourceShareArn"), newShare.ResourceShareARN, "field is immutable"))
+		}
+	}
+
+	// Changes to the provisioned product are not applied, so the reference can not change once set.
+	if oldC.Spec.ServiceCatalogRef != nil && !reflect.DeepEqual(r.Spec.ServiceCatalogRef, oldC.Spec.ServiceCatalogRef) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "serviceCatalogRef"), r.Spec.ServiceCatalogRef, "field is immutable"),
+		)
+	}
+
+	// Experiment templates are created once, changes to an existing template would not be applied.
+	oldTemplates := map[string]FISTemplateSpec{}
+	for _, template := range oldC.Spec.FISExperimentTemplates {
+		oldTemplates[template.Name] = template
+	}
+	for i, template := range r.Spec.FISExperimentTemplates {
+		if oldTemplate, ok := oldTemplates[template.Name]; ok && !reflect.DeepEqual(template, oldTemplate) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "fisExperimentTemplates").Index(i), template.Name, "existing experiment templates are immutable"),
+			)
+		}
+	}
+
+	// The locations of a DataSync task can't be changed once it is created.
+	if oldSync, newSync := oldC.Spec.DataSync, r.Spec.DataSync; oldSync != nil && newSync != nil {
+		path := field.NewPath("spec", "dataSync")
+		if newSync.SourceLocationARN != oldSync.SourceLocationARN {
+			allErrs = append(allErrs, field.Invalid(path.Child("sourceLocationARN"), newSync.SourceLocationARN, "field is immutable"))
+		}
+		if newSync.DestinationLocationARN != oldSync.DestinationLocationARN {
+			allErrs = append(allErrs, field.Invalid(path.Child("destinationLocationARN"), newSync.DestinationLocationARN, "field is immutable"))
+		}
+	}
+
+	allErrs = append(allErrs, r.validateAdditionalControlPlaneEndpoints()...)
+	allErrs = append(allErrs, r.validateRemoteRegion()...)
+	allErrs = append(allErrs, r.validateRAMShare()...)
+	allErrs = append(allErrs, r.validateCloudFormationStackRef()...)
+	allErrs = append(allErrs, r.validateServiceCatalogRef()...)
+	allErrs = append(allErrs, r.validateFISExperimentTemplates()...)
+	allErrs = append(allErrs, r.validateServiceAccountRoles()...)
+	allErrs = append(allErrs, r.validateKarpenter()...)
+	allErrs = append(allErrs, r.validateNodeTerminationHandler()...)
+	allErrs = append(allErrs, r.validateSSMParameterExport()...)
+	allErrs = append(allErrs, r.validateDataSync()...)
+	allErrs = append(allErrs, r.validateAdoption()...)
+	allErrs = append(allErrs, r.validateVPCEndpoints()...)
+
+	// Only changes to the VPC are checked, so that clusters already conflicting can still be updated.
+	if CIDRConflictDetector != nil && (localVPCCidrBlock(r) != localVPCCidrBlock(oldC) || r.Spec.NetworkSpec.VPC.ID != oldC.Spec.NetworkSpec.VPC.ID) {
+		allErrs = append(allErrs, CIDRConflictDetector.Detect(context.TODO(), r)...)
+	}
+
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// validateFISExperimentTemplates checks that the names of templates, and of the targets and actions within a
+// template, are unique, and that actions only reference targets and actions of their own template.
+func (r *AWSCluster) validateFISExperimentTemplates() field.ErrorList {
+	var allErrs field.ErrorList
+
+	templates := map[string]bool{}
+	for i, template := range r.Spec.FISExperimentTemplates {
+		path := field.NewPath("spec", "fisExperimentTemplates").Index(i)
+		if templates[template.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), template.Name))
+		}
+		templates[template.Name] = true
+
+		targets := map[string]bool{}
+		for j, target := range template.Targets {
+			if targets[target.Name] {
+				allErrs = append(allErrs, field.Duplicate(path.Child("targets").Index(j).Child("name"), target.Name))
+			}
+			targets[target.Name] = true
+		}
+
+		actions := map[string]bool{}
+		for _, action := range template.Actions {
+			actions[action.Name] = true
+		}
+
+		seen := map[string]bool{}
+		for j, action := range template.Actions {
+			actionPath := path.Child("actions").Index(j)
+			if seen[action.Name] {
+				allErrs = append(allErrs, field.Duplicate(actionPath.Child("name"), action.Name))
+			}
+			seen[action.Name] = true
+
+			for targetType, target := range action.Targets {
+				if !targets[target] {
+					allErrs = append(allErrs, field.NotFound(actionPath.Child("targets").Key(targetType), target))
+				}
+			}
+			for k, after := range action.StartAfter {
+				if !actions[after] || after == action.Name {
+					allErrs = append(allErrs, field.Invalid(actionPath.Child("startAfter").Index(k), after, "must reference another action of the template"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateServiceAccountRoles checks that service account roles have unique names and that the cluster has an
+// OIDC issuer URL for their trust policies.
+func (r *AWSCluster) validateServiceAccountRoles() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(r.Spec.ServiceAccountRole) > 0 && r.Spec.OIDCIssuerURL == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "oidcIssuerURL"), "required by serviceAccountRole"))
+	}
+
+	roles := map[string]bool{}
+	for i, role := range r.Spec.ServiceAccountRole {
+		if roles[role.RoleName] {
+			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec", "serviceAccountRole").Index(i).Child("roleName"), role.RoleName))
+		}
+		roles[role.RoleName] = true
+	}
+
+	return allErrs
+}
+
+// validateKarpenter checks that the cluster has an OIDC issuer URL for the trust policy of the role of the Karpenter
+// controller.
+func (r *AWSCluster) validateKarpenter() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Karpenter != nil && r.Spec.OIDCIssuerURL == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "oidcIssuerURL"), "required by karpenter"))
+	}
+
+	return allErrs
+}
+
+// validateNodeTerminationHandler checks that the queue of the Node Termination Handler is either given or created.
+func (r *AWSCluster) validateNodeTerminationHandler() field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.NodeTerminationHandler
+	if spec == nil {
+		return allErrs
+	}
+	path := field.NewPath("spec", "nodeTerminationHandler")
+	if spec.QueueARN != "" && spec.CreateQueue {
+		allErrs = append(allErrs, field.Forbidden(path.Child("queueARN"), "cannot be set along with createQueue"))
+	}
+	if spec.QueueARN == "" && !spec.CreateQueue {
+		allErrs = append(allErrs, field.Required(path.Child("queueARN"), "either queueARN or createQueue must be set"))
+	}
+	if spec.QueueARN != "" {
+		if parsed, err := arn.Parse(spec.QueueARN); err != nil || parsed.Service != "sqs" {
+			allErrs = append(allErrs, field.Invalid(path.Child("queueARN"), spec.QueueARN, "must be the ARN of an SQS queue"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSSMParameterExport checks that the exported fields are distinct dotted paths, whose segments are valid in
+// the names of SSM parameters.
+func (r *AWSCluster) validateSSMParameterExport() field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.SSMParameterExport
+	if spec == nil {
+		return allErrs
+	}
+	path := field.NewPath("spec", "ssmParameterExport", "parameters")
+	seen := map[string]bool{}
+	for i, parameter := range spec.Parameters {
+		if !isSSMFieldPath(parameter) {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), parameter, "must be a dotted path of letters, digits, '_' and '-'"))
+			continue
+		}
+		if seen[parameter] {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i), parameter))
+		}
+		seen[parameter] = true
+	}
+
+	return allErrs
+}
+
+// isSSMFieldPath returns true if the path is made of non-empty segments separated by dots, whose characters are
+// valid in the names of SSM parameters.
+func isSSMFieldPath(path string) bool {
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			return false
+		}
+		for _, c := range segment {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validateDataSync checks that the DataSync task copies between two different locations, and that its schedule
+// has the six fields of AWS cron expressions.
+func (r *AWSCluster) validateDataSync() field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.DataSync
+	if spec == nil {
+		return allErrs
+	}
+	path := field.NewPath("spec", "dataSync")
+	if spec.SourceLocationARN == spec.DestinationLocationARN {
+		allErrs = append(allErrs, field.Invalid(path.Child("destinationLocationARN"), spec.DestinationLocationARN, "must be different from sourceLocationARN"))
+	}
+	if spec.ScheduleCron != "" && len(strings.Fields(spec.ScheduleCron)) != 6 {
+		allErrs = append(allErrs, field.Invalid(path.Child("scheduleCron"), spec.ScheduleCron, "must be an AWS cron expression of six fields"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateCloudFormationStackRef() field.ErrorList {
+	var allErrs field.ErrorList
+
+	ref := r.Spec.NetworkSpec.CloudFormationStackRef
+	if ref == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "networkSpec", "cloudFormationStackRef")
+	if ref.StackName == "" {
+		allErrs = append(allErrs, field.Required(path.Child("stackName"), "stackName is required"))
+	}
+	if len(ref.OutputKeyMappings) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("outputKeyMappings"), "at least one output key mapping is required"))
+	}
+	allErrs = append(allErrs, validateOutputKeyMappings(path.Child("outputKeyMappings"), ref.OutputKeyMappings)...)
+
+	return allErrs
+}
+
+// validateAdoption checks that the VPC to adopt is set when the cluster is annotated for adoption, and that
+// security groups only override the roles of the cluster.
+func (r *AWSCluster) validateAdoption() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Annotations[AdoptAnnotation] == "true" && r.Spec.NetworkSpec.VPC.ID == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "networkSpec", "vpc", "id"), "the VPC to adopt must be set"))
+	}
+
+	roles := []string{
+		string(SecurityGroupBastion),
+		string(SecurityGroupAPIServerLB),
+		string(SecurityGroupLB),
+		string(SecurityGroupControlPlane),
+		string(SecurityGroupNode),
+	}
+	supported := map[string]bool{}
+	for _, role := range roles {
+		supported[role] = true
+	}
+	path := field.NewPath("spec", "networkSpec", "securityGroupOverrides")
+	for role, id := range r.Spec.NetworkSpec.SecurityGroupOverrides {
+		if !supported[string(role)] {
+			allErrs = append(allErrs, field.NotSupported(path.Key(string(role)), role, roles))
+		}
+		if id == "" {
+			allErrs = append(allErrs, field.Required(path.Key(string(role)), "the ID of the security group must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateServiceCatalogRef() field.ErrorList {
+	var allErrs field.ErrorList
+
+	ref := r.Spec.ServiceCatalogRef
+	if ref == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "serviceCatalogRef")
+	if r.Spec.NetworkSpec.CloudFormationStackRef != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "serviceCatalogRef and networkSpec.cloudFormationStackRef are mutually exclusive"))
+	}
+	if ref.ProductID == "" {
+		allErrs = append(allErrs, field.Required(path.Child("productID"), "productID is required"))
+	}
+	if ref.ProvisioningArtifactID == "" {
+		allErrs = append(allErrs, field.Required(path.Child("provisioningArtifactID"), "provisioningArtifactID is required"))
+	}
+	if len(ref.OutputKeyMappings) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("outputKeyMappings"), "at least one output key mapping is required"))
+	}
+	allErrs = append(allErrs, validateOutputKeyMappings(path.Child("outputKeyMappings"), ref.OutputKeyMappings)...)
+
+	return allErrs
+}
+
+// validateOutputKeyMappings checks that stack outputs are mapped to supported network fields,
+// and that at most one output is mapped to the VPC ID.
+func validateOutputKeyMappings(path *field.Path, mappings map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	mapped := map[string]bool{}
+	for key, target := range mappings {
+		switch {
+		case target != CFStackOutputVPCID && target != CFStackOutputSubnets:
+			allErrs = append(allErrs, field.NotSupported(path.Key(key), target, []string{CFStackOutputVPCID, CFStackOutputSubnets}))
+		case target == CFStackOutputVPCID && mapped[target]:
+			allErrs = append(allErrs, field.Duplicate(path.Key(key), target))
+		}
+		mapped[target] = true
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateRAMShare() field.ErrorList {
+	var allErrs field.ErrorList
+
+	share := r.Spec.NetworkSpec.RAMShare
+	if share == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "networkSpec", "ramShare")
+	switch {
+	case share.ResourceShareARN == "" && len(share.PrincipalARNs) == 0:
+		allErrs = append(allErrs, field.Required(path, "one of resourceShareArn or principalArns is required"))
+	case share.ResourceShareARN != "" && len(share.PrincipalARNs) > 0:
+		allErrs = append(allErrs, field.Forbidden(path.Child("principalArns"), "cannot be set together with resourceShareArn"))
+	}
+
+	return allErrs
+}
+
+// validateVPCEndpoints checks that each service has a single endpoint policy and that policies are JSON objects.
+// The policies are checked against IAM when applied to the endpoints.
+func (r *AWSCluster) validateVPCEndpoints() field.ErrorList {
+	var allErrs field.ErrorList
+
+	endpoints := r.Spec.NetworkSpec.VPCEndpoints
+	if endpoints == nil {
+		return allErrs
+	}
+
+	services := map[string]bool{}
+	for i, policy := range endpoints.EndpointPolicies {
+		path := field.NewPath("spec", "networkSpec", "vpcEndpoints", "endpointPolicies").Index(i)
+		if policy.ServiceName == "" {
+			allErrs = append(allErrs, field.Required(path.Child("serviceName"), "serviceName is required"))
+		} else if services[policy.ServiceName] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("serviceName"), policy.ServiceName))
+		}
+		services[policy.ServiceName] = true
+
+		var document map[string]interface{}
+		if err := json.Unmarshal([]byte(policy.PolicyDocument), &document); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("policyDocument"), policy.PolicyDocument, fmt.Sprintf("must be a JSON policy document: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateRemoteRegion() field.ErrorList {
+	var allErrs field.ErrorList
+
+	remote := r.Spec.NetworkSpec.RemoteRegion
+	if remote == nil {
+		return allErrs
+	}
+
+	path := field.NewPath("spec", "networkSpec", "remoteRegion")
+	if remote.Region == "" {
+		allErrs = append(allErrs, field.Required(path.Child("region"), "region is required"))
+	} else if remote.Region == r.Spec.Region {
+		allErrs = append(allErrs, field.Invalid(path.Child("region"), remote.Region, "must differ from spec.region"))
+	}
+	if remote.VPCCidr == "" {
+		allErrs = append(allErrs, field.Required(path.Child("vpcCidr"), "vpcCidr is required"))
+	}
+	if remote.TransitGatewayID == "" {
+		allErrs = append(allErrs, field.Required(path.Child("transitGatewayId"), "transitGatewayId is required"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateAdditionalControlPlaneEndpoints() field.ErrorList {
+	var allErrs field.ErrorList
+
+	// The API server port itself is configured on the owning Cluster, which is not
+	// available here, so fall back to the default when the endpoint isn't set yet.
+	primaryPort := r.Spec.ControlPlaneEndpoint.Port
+	if primaryPort == 0 {
+		primaryPort = defaultAPIServerPort
+	}
+
+	ports := map[int32]bool{}
+	for i, endpoint := range r.Spec.AdditionalControlPlaneEndpoints {
+		path := field.NewPath("spec", "additionalControlPlaneEndpoints").Index(i)
+
+		if endpoint.Port <= 0 || endpoint.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(path.Child("port"), endpoint.Port, "must be between 1 and 65535"))
+		}
+
+		if endpoint.Port == primaryPort {
+			allErrs = append(allErrs, field.Invalid(path.Child("port"), endpoint.Port, "must not be the control plane endpoint port"))
+		}
+
+		if ports[endpoint.Port] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("port"), endpoint.Port))
+		}
+		ports[endpoint.Port] = true
+
+		if endpoint.Host != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("host"), "must be empty, the endpoint is reached through its load balancer DNS name"))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSCluster) Default() {
+	r.defaultVPCCidrBlock()
+
 	// Default to Calico ingress rules if no rules have been set
 	if r.Spec.NetworkSpec.CNI == nil {
 		r.Spec.NetworkSpec.CNI = &CNISpec{
@@ -106,3 +665,71 @@ func (r *AWSCluster) Default() {
 		}
 	}
 }
+
+// defaultVPCCidrBlock sizes the empty CIDR block of a managed VPC after the number of machines of the
+// MachineDeployments of the cluster. It is left empty, for the VPC to get the default /16 block, until
+// the cluster has machines. Counting the machines lists the MachineDeployments of the namespace from the
+// API server, within the admission request of the AWSCluster.
+func (r *AWSCluster) defaultVPCCidrBlock() {
+	vpc := &r.Spec.NetworkSpec.VPC
+	if vpc.ID != "" || vpc.CidrBlock != "" {
+		return
+	}
+	if webhookReader == nil {
+		awsclusterlog.V(1).Info("Webhook is not registered with a manager, leaving VPC CIDR block empty", "namespace", r.Namespace, "name", r.Name)
+		return
+	}
+
+	machines, err := r.machineDeploymentReplicas(context.TODO(), webhookReader)
+	if err != nil {
+		awsclusterlog.Error(err, "failed to count machines to size the VPC CIDR block", "namespace", r.Namespace, "name", r.Name)
+		return
+	}
+	if machines == 0 {
+		return
+	}
+
+	zones := defaultMaxAvailabilityZones
+	if vpc.AvailabilityZoneUsageLimit != nil {
+		zones = *vpc.AvailabilityZoneUsageLimit
+	}
+	vpc.CidrBlock = subnet.ComputeRequiredCIDRSize(machines, zones)
+	awsclusterlog.Info("Sized VPC CIDR block", "namespace", r.Namespace, "name", r.Name, "cidrBlock", vpc.CidrBlock, "machines", machines)
+}
+
+// machineDeploymentReplicas returns the number of replicas of the MachineDeployments of the cluster.
+func (r *AWSCluster) machineDeploymentReplicas(ctx context.Context, reader client.Reader) (int, error) {
+	deployments := &clusterv1.MachineDeploymentList{}
+	if err := reader.List(ctx, deployments, client.InNamespace(r.Namespace)); err != nil {
+		return 0, err
+	}
+
+	clusterName := r.clusterName()
+	replicas := 0
+	for _, md := range deployments.Items {
+		if md.Spec.ClusterName != clusterName {
+			continue
+		}
+		if md.Spec.Replicas == nil {
+			// Defaulted to 1 by Cluster API.
+			replicas++
+			continue
+		}
+		replicas += int(*md.Spec.Replicas)
+	}
+	return replicas, nil
+}
+
+// clusterName returns the name of the cluster of the AWSCluster, from its cluster label or owner, or
+// its own name as new AWSClusters are usually named after their cluster.
+func (r *AWSCluster) clusterName() string {
+	if name, ok := r.Labels[clusterv1.ClusterLabelName]; ok {
+		return name
+	}
+	for _, ref := range r.OwnerReferences {
+		if ref.Kind == "Cluster" && strings.HasPrefix(ref.APIVersion, clusterv1.GroupVersion.Group+"/") {
+			return ref.Name
+		}
+	}
+	return r.Name
+}
@@ -17,10 +17,15 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestAWSCluster_ValidateUpdate(t *testing.T) {
@@ -44,66 +49,871 @@ func TestAWSCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "roleARN is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					RoleARN: "arn:aws:iam::111111111111:role/capa",
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					RoleARN: "arn:aws:iam::222222222222:role/capa",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "controlPlaneLoadBalancer is immutable",
 			oldCluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						Scheme: &ClassicELBSchemeInternal,
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Scheme: &ClassicELBSchemeInternal,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Scheme: &ClassicELBSchemeInternetFacing,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "vpc tenancy is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							Tenancy: "default",
+						},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							Tenancy: "dedicated",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "controlPlaneEndpoint is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "example.com",
+						Port: int32(8000),
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "foo.example.com",
+						Port: int32(9000),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "controlPlaneEndpoint can be updated if it is empty",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "example.com",
+						Port: int32(8000),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additionalControlPlaneEndpoints can be added",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{Port: int32(8443)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additionalControlPlaneEndpoints cannot use the controlPlaneEndpoint port",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "example.com",
+						Port: int32(6443),
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "example.com",
+						Port: int32(6443),
+					},
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{Port: int32(6443)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remoteRegion vpcCidr is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: NetworkSpec{
+						RemoteRegion: &RemoteRegionSpec{Region: "us-west-2", VPCCidr: "10.1.0.0/16", TransitGatewayID: "tgw-1"},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: NetworkSpec{
+						RemoteRegion: &RemoteRegionSpec{Region: "us-west-2", VPCCidr: "10.2.0.0/16", TransitGatewayID: "tgw-1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remoteRegion cannot be removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: NetworkSpec{
+						RemoteRegion: &RemoteRegionSpec{Region: "us-west-2", VPCCidr: "10.1.0.0/16", TransitGatewayID: "tgw-1"},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ramShare resourceShareArn is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						RAMShare: &RAMShareSpec{ResourceShareARN: "arn:aws:ram:us-east-1:123456789012:resource-share/a"},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						RAMShare: &RAMShareSpec{ResourceShareARN: "arn:aws:ram:us-east-1:123456789012:resource-share/b"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ramShare principalArns can be changed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						RAMShare: &RAMShareSpec{PrincipalARNs: []string{"111111111111"}},
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						RAMShare: &RAMShareSpec{PrincipalARNs: []string{"111111111111", "222222222222"}},
+					},
+				},
+			},
+			wantErr: false,
+		}, {
+			name: "serviceCatalogRef is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceCatalogRef: &ServiceCatalogRef{ProductID: "prod-1", ProvisioningArtifactID: "pa-1", OutputKeyMappings: map[string]string{"VpcId": CFStackOutputVPCID}},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceCatalogRef: &ServiceCatalogRef{ProductID: "prod-1", ProvisioningArtifactID: "pa-2", OutputKeyMappings: map[string]string{"VpcId": CFStackOutputVPCID}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing fisExperimentTemplates are immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{fisTemplate("stop-instances", "first")},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{fisTemplate("stop-instances", "second")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fisExperimentTemplates can be added and removed",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{fisTemplate("stop-instances", "first")},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{fisTemplate("reboot-instances", "second")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "dataSync locations are immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{SourceLocationARN: testDataSyncLocation("loc-1"), DestinationLocationARN: testDataSyncLocation("loc-2")},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{SourceLocationARN: testDataSyncLocation("loc-3"), DestinationLocationARN: testDataSyncLocation("loc-2")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dataSync schedule and filters can change",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{SourceLocationARN: testDataSyncLocation("loc-1"), DestinationLocationARN: testDataSyncLocation("loc-2")},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{
+						SourceLocationARN:      testDataSyncLocation("loc-1"),
+						DestinationLocationARN: testDataSyncLocation("loc-2"),
+						ScheduleCron:           "0 2 * * ? *",
+						InclFilters:            []string{"/data/*"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.newCluster.ValidateUpdate(tt.oldCluster); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAWSCluster_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *AWSCluster
+		wantErr bool
+	}{
+		{
+			name: "additionalControlPlaneEndpoints with distinct ports",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{Port: int32(8443)},
+						{Port: int32(9443)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additionalControlPlaneEndpoints with duplicate ports",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{Port: int32(8443)},
+						{Port: int32(8443)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additionalControlPlaneEndpoints with the default API server port",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{Port: int32(6443)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additionalControlPlaneEndpoints with a host",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{Host: "internal.example.com", Port: int32(8443)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additionalControlPlaneEndpoints without a port",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+						{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remoteRegion in another region",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: NetworkSpec{
+						RemoteRegion: &RemoteRegionSpec{
+							Region:           "us-west-2",
+							VPCCidr:          "10.1.0.0/16",
+							TransitGatewayID: "tgw-1",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "remoteRegion in the cluster's region",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: NetworkSpec{
+						RemoteRegion: &RemoteRegionSpec{
+							Region:           "us-east-1",
+							VPCCidr:          "10.1.0.0/16",
+							TransitGatewayID: "tgw-1",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remoteRegion without a transit gateway",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Region: "us-east-1",
+					NetworkSpec: NetworkSpec{
+						RemoteRegion: &RemoteRegionSpec{
+							Region:  "us-west-2",
+							VPCCidr: "10.1.0.0/16",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ramShare with both resourceShareArn and principalArns",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						RAMShare: &RAMShareSpec{
+							ResourceShareARN: "arn:aws:ram:us-east-1:123456789012:resource-share/a",
+							PrincipalARNs:    []string{"111111111111"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty ramShare",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						RAMShare: &RAMShareSpec{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cloudFormationStackRef with an unsupported mapping",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						CloudFormationStackRef: &CFStackRef{
+							StackName:         "network",
+							OutputKeyMappings: map[string]string{"VpcCidr": "vpc.cidrBlock"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cloudFormationStackRef mapping the vpc id twice",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						CloudFormationStackRef: &CFStackRef{
+							StackName: "network",
+							OutputKeyMappings: map[string]string{
+								"VpcId":      CFStackOutputVPCID,
+								"OtherVpcId": CFStackOutputVPCID,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "serviceCatalogRef with cloudFormationStackRef",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceCatalogRef: &ServiceCatalogRef{
+						ProductID:              "prod-1",
+						ProvisioningArtifactID: "pa-1",
+						OutputKeyMappings:      map[string]string{"VpcId": CFStackOutputVPCID},
+					},
+					NetworkSpec: NetworkSpec{
+						CloudFormationStackRef: &CFStackRef{
+							StackName:         "network",
+							OutputKeyMappings: map[string]string{"VpcId": CFStackOutputVPCID},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "serviceCatalogRef without a provisioning artifact",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceCatalogRef: &ServiceCatalogRef{
+						ProductID:         "prod-1",
+						OutputKeyMappings: map[string]string{"VpcId": CFStackOutputVPCID},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid serviceCatalogRef",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceCatalogRef: &ServiceCatalogRef{
+						ProductID:              "prod-1",
+						ProvisioningArtifactID: "pa-1",
+						Parameters:             map[string]string{"VpcCidr": "10.0.0.0/16"},
+						OutputKeyMappings:      map[string]string{"VpcId": CFStackOutputVPCID, "Subnets": CFStackOutputSubnets},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid cloudFormationStackRef",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						CloudFormationStackRef: &CFStackRef{
+							StackName: "network",
+							OutputKeyMappings: map[string]string{
+								"VpcId":   CFStackOutputVPCID,
+								"Subnets": CFStackOutputSubnets,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid fisExperimentTemplates",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{
+						fisTemplate("stop-instances", "first"),
+						fisTemplate("reboot-instances", "second"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate fisExperimentTemplates",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{
+						fisTemplate("stop-instances", "first"),
+						fisTemplate("stop-instances", "second"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fisExperimentTemplates action with an unknown target",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					FISExperimentTemplates: []FISTemplateSpec{
+						{
+							Name:        "stop-instances",
+							Description: "first",
+							Targets:     []FISTargetSpec{{Name: "instances", ResourceType: "aws:ec2:instance"}},
+							Actions: []FISActionSpec{
+								{Name: "stop", ActionID: "aws:ec2:stop-instances", Targets: map[string]string{"Instances": "nodes"}},
+							},
+						},
 					},
 				},
 			},
-			newCluster: &AWSCluster{
+			wantErr: true,
+		},
+		{
+			name: "fisExperimentTemplates action starting after an unknown action",
+			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
-						Scheme: &ClassicELBSchemeInternetFacing,
+					FISExperimentTemplates: []FISTemplateSpec{
+						{
+							Name:        "stop-instances",
+							Description: "first",
+							Targets:     []FISTargetSpec{{Name: "instances", ResourceType: "aws:ec2:instance"}},
+							Actions: []FISActionSpec{
+								{Name: "stop", ActionID: "aws:ec2:stop-instances", StartAfter: []string{"wait"}},
+							},
+						},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "controlPlaneEndpoint is immutable",
-			oldCluster: &AWSCluster{
+			name: "valid serviceAccountRole",
+			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneEndpoint: clusterv1.APIEndpoint{
-						Host: "example.com",
-						Port: int32(8000),
+					OIDCIssuerURL: "https://oidc.example.com/test",
+					ServiceAccountRole: []ServiceAccountRoleSpec{
+						{Namespace: "kube-system", ServiceAccountName: "ebs-csi-controller", RoleName: "test-ebs-csi"},
+						{Namespace: "kube-system", ServiceAccountName: "cluster-autoscaler", RoleName: "test-autoscaler"},
 					},
 				},
 			},
-			newCluster: &AWSCluster{
+			wantErr: false,
+		},
+		{
+			name: "serviceAccountRole without oidcIssuerURL",
+			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneEndpoint: clusterv1.APIEndpoint{
-						Host: "foo.example.com",
-						Port: int32(9000),
+					ServiceAccountRole: []ServiceAccountRoleSpec{
+						{Namespace: "kube-system", ServiceAccountName: "ebs-csi-controller", RoleName: "test-ebs-csi"},
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "controlPlaneEndpoint can be updated if it is empty",
-			oldCluster: &AWSCluster{
+			name: "duplicate serviceAccountRole",
+			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneEndpoint: clusterv1.APIEndpoint{},
+					OIDCIssuerURL: "https://oidc.example.com/test",
+					ServiceAccountRole: []ServiceAccountRoleSpec{
+						{Namespace: "kube-system", ServiceAccountName: "ebs-csi-controller", RoleName: "test-ebs-csi"},
+						{Namespace: "default", ServiceAccountName: "ebs-csi-controller", RoleName: "test-ebs-csi"},
+					},
 				},
 			},
-			newCluster: &AWSCluster{
+			wantErr: true,
+		},
+		{
+			name: "valid karpenter",
+			cluster: &AWSCluster{
 				Spec: AWSClusterSpec{
-					ControlPlaneEndpoint: clusterv1.APIEndpoint{
-						Host: "example.com",
-						Port: int32(8000),
+					OIDCIssuerURL: "https://oidc.example.com/test",
+					Karpenter:     &KarpenterSpec{Version: "v0.27.0", IAMRoleARN: "arn:aws:iam::123456789012:role/karpenter-node"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "karpenter without oidcIssuerURL",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Karpenter: &KarpenterSpec{Version: "v0.27.0", IAMRoleARN: "arn:aws:iam::123456789012:role/karpenter-node"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node termination handler with an existing queue",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeTerminationHandler: &NTHSpec{QueueARN: "arn:aws:sqs:us-east-1:123456789012:nth"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "node termination handler with a created queue",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeTerminationHandler: &NTHSpec{CreateQueue: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "node termination handler with both an existing and a created queue",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeTerminationHandler: &NTHSpec{QueueARN: "arn:aws:sqs:us-east-1:123456789012:nth", CreateQueue: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node termination handler without queue",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeTerminationHandler: &NTHSpec{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node termination handler with the ARN of another service",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NodeTerminationHandler: &NTHSpec{QueueARN: "arn:aws:sns:us-east-1:123456789012:nth"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ssm parameter export of status fields",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SSMParameterExport: &SSMExportSpec{PathPrefix: "/capa", Parameters: []string{"network.vpc.id", "network.subnets.id"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ssm parameter export of an invalid field path",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SSMParameterExport: &SSMExportSpec{PathPrefix: "/capa", Parameters: []string{"network..id"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ssm parameter export of a field path with a slash",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SSMParameterExport: &SSMExportSpec{PathPrefix: "/capa", Parameters: []string{"network/vpc"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ssm parameter export of duplicate fields",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					SSMParameterExport: &SSMExportSpec{PathPrefix: "/capa", Parameters: []string{"network.vpc.id", "network.vpc.id"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dataSync task between two locations",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{
+						SourceLocationARN:      testDataSyncLocation("loc-1"),
+						DestinationLocationARN: testDataSyncLocation("loc-2"),
+						ScheduleCron:           "0 2 * * ? *",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "dataSync task to its source location",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{SourceLocationARN: testDataSyncLocation("loc-1"), DestinationLocationARN: testDataSyncLocation("loc-1")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dataSync task with a five field cron expression",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					DataSync: &DataSyncSpec{
+						SourceLocationARN:      testDataSyncLocation("loc-1"),
+						DestinationLocationARN: testDataSyncLocation("loc-2"),
+						ScheduleCron:           "0 2 * * *",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "adoption of an existing VPC",
+			cluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{AdoptAnnotation: "true"},
+				},
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{ID: "vpc-1"},
+						SecurityGroupOverrides: map[SecurityGroupRole]string{
+							SecurityGroupNode: "sg-1",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "adoption without a VPC",
+			cluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{AdoptAnnotation: "true"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "security group overriding an unknown role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupOverrides: map[SecurityGroupRole]string{
+							SecurityGroupRole("database"): "sg-1",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "vpc endpoint policies",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCEndpoints: &VPCEndpointsSpec{
+							EndpointPolicies: []EndpointPolicySpec{
+								{ServiceName: "com.amazonaws.us-west-2.s3", PolicyDocument: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`},
+								{ServiceName: "com.amazonaws.us-west-2.ecr.api", PolicyDocument: `{"Statement":[]}`},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "vpc endpoint policy which is not JSON",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCEndpoints: &VPCEndpointsSpec{
+							EndpointPolicies: []EndpointPolicySpec{
+								{ServiceName: "com.amazonaws.us-west-2.s3", PolicyDocument: `{"Statement":`},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "vpc endpoint policies of the same service",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPCEndpoints: &VPCEndpointsSpec{
+							EndpointPolicies: []EndpointPolicySpec{
+								{ServiceName: "com.amazonaws.us-west-2.s3", PolicyDocument: `{}`},
+								{ServiceName: "com.amazonaws.us-west-2.s3", PolicyDocument: `{}`},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "subnets within the vpc cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{CidrBlock: "10.0.0.0/16"},
+						Subnets: Subnets{
+							{CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-west-2a"},
+							{CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-west-2a", IsPublic: true},
+						},
 					},
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "subnet outside of the vpc cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{CidrBlock: "10.0.0.0/16"},
+						Subnets: Subnets{
+							{CidrBlock: "10.1.0.0/24", AvailabilityZone: "us-west-2a"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.newCluster.ValidateUpdate(tt.oldCluster); (err != nil) != tt.wantErr {
-				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			if err := tt.cluster.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
@@ -205,3 +1015,172 @@ func TestAWSCluster_Default(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSCluster_DefaultVPCCidrBlock(t *testing.T) {
+	machineDeployment := func(name, clusterName string, replicas *int32) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       clusterv1.MachineDeploymentSpec{ClusterName: clusterName, Replicas: replicas},
+		}
+	}
+	oneZone := 1
+
+	tests := []struct {
+		name        string
+		cluster     *AWSCluster
+		deployments []runtime.Object
+		expect      string
+	}{
+		{
+			name:    "CIDR block is sized after the replicas of the cluster's machine deployments",
+			cluster: &AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+			deployments: []runtime.Object{
+				machineDeployment("md-0", "test", pointer.Int32Ptr(1000)),
+				machineDeployment("md-1", "test", pointer.Int32Ptr(1000)),
+				machineDeployment("md-2", "other", pointer.Int32Ptr(5000)),
+			},
+			expect: "10.0.0.0/19",
+		},
+		{
+			name: "cluster is found from its label",
+			cluster: &AWSCluster{ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-infra",
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			}},
+			deployments: []runtime.Object{
+				machineDeployment("md-0", "test", nil),
+			},
+			expect: "10.0.0.0/20",
+		},
+		{
+			name: "zones are limited by the availability zone usage limit",
+			cluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: AWSClusterSpec{NetworkSpec: NetworkSpec{VPC: VPCSpec{
+					AvailabilityZoneUsageLimit: &oneZone,
+				}}},
+			},
+			deployments: []runtime.Object{
+				machineDeployment("md-0", "test", pointer.Int32Ptr(2100)),
+			},
+			expect: "10.0.0.0/18",
+		},
+		{
+			name:    "CIDR block is left empty without machines",
+			cluster: &AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+			expect:  "",
+		},
+		{
+			name: "CIDR block is not changed once set",
+			cluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec:       AWSClusterSpec{NetworkSpec: NetworkSpec{VPC: VPCSpec{CidrBlock: "10.1.0.0/16"}}},
+			},
+			deployments: []runtime.Object{
+				machineDeployment("md-0", "test", pointer.Int32Ptr(10)),
+			},
+			expect: "10.1.0.0/16",
+		},
+		{
+			name: "CIDR block of unmanaged VPCs is left empty",
+			cluster: &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec:       AWSClusterSpec{NetworkSpec: NetworkSpec{VPC: VPCSpec{ID: "vpc-1"}}},
+			},
+			deployments: []runtime.Object{
+				machineDeployment("md-0", "test", pointer.Int32Ptr(10)),
+			},
+			expect: "",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	defer func() { webhookReader = nil }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			webhookReader = fake.NewFakeClientWithScheme(scheme, tt.deployments...)
+
+			tt.cluster.Default()
+			g.Expect(tt.cluster.Spec.NetworkSpec.VPC.CidrBlock).To(Equal(tt.expect))
+		})
+	}
+
+	t.Run("CIDR block is left empty without a reader", func(t *testing.T) {
+		g := NewWithT(t)
+		webhookReader = nil
+
+		cluster := &AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		cluster.Default()
+		g.Expect(cluster.Spec.NetworkSpec.VPC.CidrBlock).To(BeEmpty())
+	})
+}
+
+func TestAWSCluster_ValidateRoleARN(t *testing.T) {
+	const role = "arn:aws:iam::123456789012:role/capa"
+	RoleValidator = func(namespace, roleARN string) error {
+		if namespace != "allowed" || roleARN != role {
+			return errors.New("not allowed")
+		}
+		return nil
+	}
+	defer func() { RoleValidator = nil }()
+
+	tests := []struct {
+		name      string
+		namespace string
+		roleARN   string
+		wantErr   bool
+	}{
+		{
+			name:      "no role",
+			namespace: "tenant",
+		},
+		{
+			name:      "allowed role",
+			namespace: "allowed",
+			roleARN:   role,
+		},
+		{
+			name:      "role not allowed in the namespace",
+			namespace: "tenant",
+			roleARN:   role,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tt.namespace, Name: "test"},
+				Spec:       AWSClusterSpec{RoleARN: tt.roleARN},
+			}
+			if tt.wantErr {
+				g.Expect(cluster.ValidateCreate()).NotTo(Succeed())
+			} else {
+				g.Expect(cluster.ValidateCreate()).To(Succeed())
+			}
+		})
+	}
+}
+
+func fisTemplate(name, description string) FISTemplateSpec {
+	return FISTemplateSpec{
+		Name:        name,
+		Description: description,
+		RoleARN:     "arn:aws:iam::123456789012:role/fis",
+		Targets:     []FISTargetSpec{{Name: "instances", ResourceType: "aws:ec2:instance"}},
+		Actions: []FISActionSpec{
+			{Name: "wait", ActionID: "aws:fis:wait", Parameters: map[string]string{"duration": "PT1M"}},
+			{Name: "stop", ActionID: "aws:ec2:stop-instances", Targets: map[string]string{"Instances": "instances"}, StartAfter: []string{"wait"}},
+		},
+	}
+}
+
+func testDataSyncLocation(id string) string {
+	return "arn:aws:datasync:us-east-1:123456789012:location/" + id
+}
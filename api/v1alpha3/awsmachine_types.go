@@ -26,6 +26,22 @@ const (
 	// MachineFinalizer allows ReconcileAWSMachine to clean up AWS resources associated with AWSMachine before
 	// removing it from the apiserver.
 	MachineFinalizer = "awsmachine.infrastructure.cluster.x-k8s.io"
+
+	// IAMInstanceProfileHotSwapAnnotation allows the IAM instance profile of an AWSMachine to be changed,
+	// in which case the profile associated with the running EC2 instance is replaced when set to "true".
+	IAMInstanceProfileHotSwapAnnotation = "iam.aws.infrastructure.cluster.x-k8s.io/allow-hot-swap"
+
+	// PrepareForDrainAnnotation, when set to "true", deregisters the EC2 instance of an AWSMachine from the
+	// target groups it is registered in, and holds its deletion until connection draining has completed.
+	PrepareForDrainAnnotation = "cluster-api-provider-aws.sigs.k8s.io/prepare-for-drain"
+
+	// MaxPodsAnnotation is set on the node of an AWSMachine with PrefixDelegation to the maximum number of
+	// pods its instance type can run with the IPv4 prefixes of the AWS VPC CNI.
+	MaxPodsAnnotation = "cluster-api-provider-aws.sigs.k8s.io/max-pods"
+
+	// SecurityViolationMachineError is the failure reason of machines whose instance failed a
+	// security check, such as the verification of its SSH host key fingerprints.
+	SecurityViolationMachineError errors.MachineStatusError = "SecurityViolation"
 )
 
 // AWSMachineSpec defines the desired state of AWSMachine
@@ -36,6 +52,39 @@ type AWSMachineSpec struct {
 	// AMI is the reference to the AMI from which to create the machine instance.
 	AMI AWSResourceReference `json:"ami,omitempty"`
 
+	// AMISSMPath is the path of an SSM Parameter Store parameter holding the ID of the AMI
+	// from which to create the machine instance, e.g.
+	// /aws/service/eks/optimized-ami/1.17/amazon-linux-2/recommended/image_id.
+	// It will be ignored if an explicit AMI ID is set.
+	// +optional
+	AMISSMPath string `json:"amiSSMPath,omitempty"`
+
+	// AMISourceRegion is the region the AMI set in spec.ami.id was published
+	// in. If the AMI doesn't exist in the region of the machine, it is copied
+	// from this region before the instance is created, and the copy is
+	// deregistered when the cluster is deleted.
+	// +optional
+	AMISourceRegion string `json:"amiSourceRegion,omitempty"`
+
+	// ImageBuilderPipelineARN is the ARN of an EC2 Image Builder pipeline
+	// whose output AMI the instance is launched from. The AMI of the latest
+	// available image of the pipeline is used unless TriggerNewBuild is set.
+	// It will be ignored if an explicit AMI ID is set.
+	// +optional
+	ImageBuilderPipelineARN string `json:"imageBuilderPipelineARN,omitempty"`
+
+	// TriggerNewBuild starts an execution of the Image Builder pipeline set in
+	// ImageBuilderPipelineARN before the instance is created, which is launched
+	// from the output AMI of the build once it is available.
+	// +optional
+	TriggerNewBuild bool `json:"triggerNewBuild,omitempty"`
+
+	// LaunchTemplateRef is an EC2 launch template the instance is launched
+	// from. The AMI, instance type, SSH key, security groups and block
+	// devices of the template are used unless set on this AWSMachine.
+	// +optional
+	LaunchTemplateRef *LaunchTemplateRef `json:"launchTemplateRef,omitempty"`
+
 	// ImageLookupFormat is the AMI naming format to look up the image for this
 	// machine It will be ignored if an explicit AMI is set. Supports
 	// substitutions for {{.BaseOS}} and {{.K8sVersion}} with the base OS and
@@ -57,9 +106,39 @@ type AWSMachineSpec struct {
 	// image lookup the AMI is not set.
 	ImageLookupBaseOS string `json:"imageLookupBaseOS,omitempty"`
 
+	// OSType is the operating system of the instance. Windows instances are
+	// launched from Windows AMIs when the AMI is looked up, and only get the
+	// SSH key set in SSHKeyName, which EC2Launch encrypts the generated
+	// Administrator password with. The WindowsPasswordAvailable condition
+	// reports on whether the password can be retrieved. Defaults to Linux.
+	// +kubebuilder:validation:Enum=Linux;Windows
+	// +optional
+	OSType OSType `json:"osType,omitempty"`
+
 	// InstanceType is the type of instance to create. Example: m4.xlarge
 	InstanceType string `json:"instanceType,omitempty"`
 
+	// FleetMode launches the instance with an EC2 Fleet instead of
+	// RunInstances. The fleet picks the instance type among the types matching
+	// InstanceTypeRequirements with FleetAllocationStrategy, which lowers the
+	// risk of a launch failing for lack of capacity of a single type.
+	// InstanceType must not be set in fleet mode.
+	// +optional
+	FleetMode bool `json:"fleetMode,omitempty"`
+
+	// FleetAllocationStrategy is the strategy the EC2 Fleet picks the instance
+	// type with in fleet mode. lowest-price launches the cheapest matching
+	// On-Demand instance. diversified and capacity-optimized are Spot
+	// strategies, and launch a Spot Instance. Defaults to lowest-price.
+	// +kubebuilder:validation:Enum=lowest-price;diversified;capacity-optimized
+	// +optional
+	FleetAllocationStrategy FleetAllocationStrategy `json:"fleetAllocationStrategy,omitempty"`
+
+	// InstanceTypeRequirements are the attributes of the instance types the
+	// EC2 Fleet may launch in fleet mode.
+	// +optional
+	InstanceTypeRequirements *InstanceTypeRequirements `json:"instanceTypeRequirements,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// AWS provider. If both the AWSCluster and the AWSMachine specify the same tag name with different values, the
 	// AWSMachine's value takes precedence.
@@ -102,6 +181,90 @@ type AWSMachineSpec struct {
 	// +optional
 	RootVolume *RootVolume `json:"rootVolume,omitempty"`
 
+	// AutoExpandRootDisk grows the root volume and its filesystem when its usage
+	// exceeds a threshold. The usage is read from the disk_used_percent metric of
+	// the CloudWatch agent, which must be running on the instance, and the
+	// filesystem is grown through SSM.
+	// +optional
+	AutoExpandRootDisk *AutoExpandSpec `json:"autoExpandRootDisk,omitempty"`
+
+	// Hibernation specifies whether the instance is configured to hibernate on stop.
+	// Hibernation requires an encrypted root volume and an instance type that
+	// supports hibernation with less than 150 GiB of memory.
+	// +optional
+	Hibernation bool `json:"hibernation,omitempty"`
+
+	// ShutdownBehavior is what EC2 does with the instance when its operating
+	// system halts. terminate terminates the instance, and stop stops it and
+	// preserves its volumes. Stopped instances are then restarted when
+	// RestartOnStop is set, and their machine is deleted otherwise. When unset,
+	// the instance is stopped and left as is.
+	// +kubebuilder:validation:Enum=stop;terminate
+	// +optional
+	ShutdownBehavior ShutdownBehavior `json:"shutdownBehavior,omitempty"`
+
+	// RestartOnStop restarts the instance once it is stopped by its operating
+	// system, instead of deleting its machine. Only applies to the stop
+	// shutdown behavior.
+	// +optional
+	RestartOnStop bool `json:"restartOnStop,omitempty"`
+
+	// NitroEnclavesEnabled enables AWS Nitro Enclaves on the instance. The
+	// instance type must support enclaves, which excludes Xen based families
+	// such as t2 or m4, and enclaves cannot be combined with hibernation.
+	// Building the enclave image and starting the enclave is left to the
+	// user data of the instance and is not handled by the provider.
+	// +optional
+	NitroEnclavesEnabled bool `json:"nitroEnclavesEnabled,omitempty"`
+
+	// ENAExpressSettings configures ENA Express on the network interfaces of
+	// the instance, lowering the latency of traffic to other instances in the
+	// same availability zone. The instance type must support ENA Express. It
+	// can be changed on running instances.
+	// +optional
+	ENAExpressSettings *ENAExpressSpec `json:"enaExpressSettings,omitempty"`
+
+	// EBSOptimized specifies whether the instance is optimized for EBS I/O.
+	// When unset, instances are EBS-optimized if their instance type supports it.
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// DetailedMonitoring enables CloudWatch detailed monitoring, which
+	// publishes instance metrics every minute instead of every five minutes.
+	// Detailed monitoring is charged for. It can be changed on running instances.
+	// +optional
+	DetailedMonitoring bool `json:"detailedMonitoring,omitempty"`
+
+	// CPUOptions sets the number of CPU cores and threads per core of the
+	// instance, for example to disable hyperthreading.
+	// +optional
+	CPUOptions *CPUOptionsSpec `json:"cpuOptions,omitempty"`
+
+	// CreditSpecification is the CPU credit option of burstable (T family)
+	// instances. Unlimited instances can burst above their baseline for as
+	// long as needed, at an additional charge. It can be changed on running instances.
+	// +kubebuilder:validation:Enum=standard;unlimited
+	// +optional
+	CreditSpecification string `json:"creditSpecification,omitempty"`
+
+	// GPU installs NVIDIA GPU drivers on the instance when it boots. The
+	// installers are downloaded from the GPUDriverBucketURL of the AWSCluster.
+	// +optional
+	GPU *GPUSpec `json:"gpu,omitempty"`
+
+	// CloudProviderConfig writes a cloud.conf file for a custom
+	// cloud-controller-manager to /etc/kubernetes/cloud.conf on the instance
+	// when it boots.
+	// +optional
+	CloudProviderConfig *CloudProviderConfigSpec `json:"cloudProviderConfig,omitempty"`
+
+	// EphemeralStorage maps the instance store volumes of instance types that
+	// include them, such as i3 or d3, to block devices. The instance type must
+	// have at least as many instance store volumes as entries.
+	// +kubebuilder:validation:MaxItems=24
+	// +optional
+	EphemeralStorage []EphemeralStorageSpec `json:"ephemeralStorage,omitempty"`
+
 	// NetworkInterfaces is a list of ENIs to associate with the instance.
 	// A maximum of 2 may be specified.
 	// +optional
@@ -119,6 +282,89 @@ type AWSMachineSpec struct {
 	// CloudInit is used.
 	// +optional
 	CloudInit CloudInit `json:"cloudInit,omitempty"`
+
+	// PreTerminationHook is an optional webhook called before the EC2 instance
+	// is terminated when the AWSMachine is deleted.
+	// +optional
+	PreTerminationHook *WebhookSpec `json:"preTerminationHook,omitempty"`
+
+	// PostProvisionHook is an optional webhook called once the EC2 instance is running.
+	// The AWSMachine is not marked as ready until the hook responds successfully.
+	// The failure policy of the hook is not used.
+	// +optional
+	PostProvisionHook *WebhookSpec `json:"postProvisionHook,omitempty"`
+
+	// VerifySSHFingerprint verifies the SSH host key fingerprints the instance
+	// prints to its system log against its SSH host keys once it is running.
+	// A mismatch fails the machine with a SecurityViolation failure reason.
+	// +optional
+	VerifySSHFingerprint bool `json:"verifySSHFingerprint,omitempty"`
+
+	// TagSyncLabelSelector selects the labels of the machine's node which are
+	// copied to its EC2 instance as tags, keyed k8s-label/<label key>.
+	// A label is selected when it matches a requirement of the selector on its
+	// key. Tags of labels which are no longer selected are removed.
+	// +optional
+	TagSyncLabelSelector *metav1.LabelSelector `json:"tagSyncLabelSelector,omitempty"`
+
+	// MinAvailableIPs is the minimum number of available IP addresses the subnet
+	// of the instance must have for it to be launched. Subnets picked by the
+	// controller fall back to the next subnet of the same availability zone
+	// when they are full. Defaults to 10, 0 disables the check.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinAvailableIPs *int64 `json:"minAvailableIPs,omitempty"`
+
+	// OutpostARN is the ARN of the AWS Outpost to launch the instance on.
+	// Images are looked up among the AMIs supported by the Outpost, and the
+	// subnets of the Outpost are preferred over the other subnets of the
+	// availability zone.
+	// +optional
+	OutpostARN string `json:"outpostArn,omitempty"`
+
+	// PlacementGroupAffinityKey launches the instance in the cluster placement
+	// group shared by the AWSMachines of the cluster with the same key, so
+	// that they run on closely located hardware. The placement group is named
+	// <cluster name>-<key>, and is deleted along with the last AWSMachine of
+	// the cluster with the key.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9-]+$`
+	PlacementGroupAffinityKey string `json:"placementGroupAffinityKey,omitempty"`
+
+	// LaunchSnapshot stores the launch configuration of the instance, minus
+	// its user data, each time it is launched, for machines to be quickly
+	// recreated from it with RestoreFromSnapshot. Snapshots are kept after
+	// the machine is deleted.
+	// +optional
+	LaunchSnapshot *LaunchSnapshotSpec `json:"launchSnapshot,omitempty"`
+
+	// RestoreFromSnapshot launches the instance from the launch snapshot of
+	// LaunchSnapshot instead of computing its configuration from the spec.
+	// Only the tags and the user data of the instance are computed again.
+	// +optional
+	RestoreFromSnapshot bool `json:"restoreFromSnapshot,omitempty"`
+
+	// SecureDelete wipes the EBS volumes of the instance before they are
+	// deleted. The instance is stopped and its volumes are moved to a shredder
+	// instance launched from the same image, which overwrites them through
+	// SSM. The volumes and the shredder instance are then deleted before the
+	// instance is terminated. The instance profile of the instance must allow
+	// the shredder to be managed by SSM.
+	// +optional
+	SecureDelete bool `json:"secureDelete,omitempty"`
+
+	// SecureDeleteTimeout is how long the shredder instance may take to wipe
+	// the volumes of the instance, up to 48h. The wipe fails once it expires,
+	// which blocks the deletion of the machine. Defaults to 1h.
+	// +optional
+	SecureDeleteTimeout *metav1.Duration `json:"secureDeleteTimeout,omitempty"`
+
+	// PrefixDelegation assigns /28 IPv4 prefixes rather than individual IP
+	// addresses to the primary network interface of the instance once it is
+	// launched, for the AWS VPC CNI to run more pods on the node. The instance
+	// type must be built on the Nitro System.
+	// +optional
+	PrefixDelegation bool `json:"prefixDelegation,omitempty"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -154,6 +400,64 @@ type AWSMachineStatus struct {
 	// +optional
 	InstanceState *InstanceState `json:"instanceState,omitempty"`
 
+	// AMIID is the ID of the AMI the instance was launched from.
+	// +optional
+	AMIID *string `json:"amiID,omitempty"`
+
+	// ImageBuildVersionARN is the ARN of the image built by the Image Builder
+	// pipeline execution started for the instance, if any.
+	// +optional
+	ImageBuildVersionARN *string `json:"imageBuildVersionARN,omitempty"`
+
+	// EBSBaselineBandwidthMbps is the baseline EBS bandwidth of the instance
+	// type, in megabits per second. Only set for EBS-optimized instances.
+	// +optional
+	EBSBaselineBandwidthMbps *int64 `json:"ebsBaselineBandwidthMbps,omitempty"`
+
+	// SelectedFailureDomain is the failure domain the instance was last launched in.
+	// It differs from the requested failure domain when its availability zone
+	// did not have capacity for the instance type.
+	// +optional
+	SelectedFailureDomain *string `json:"selectedFailureDomain,omitempty"`
+
+	// SubnetID is the ID of the subnet the instance was last launched in.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
+
+	// FleetID is the ID of the EC2 Fleet launching the instance in fleet mode.
+	// It is cleared once the instance is running and the fleet deleted.
+	// +optional
+	FleetID *string `json:"fleetID,omitempty"`
+
+	// VulnerabilityFindings are the active Amazon Inspector findings for the
+	// instance, as of VulnerabilitiesCheckedAt.
+	// +optional
+	VulnerabilityFindings []VulnerabilityFinding `json:"vulnerabilityFindings,omitempty"`
+
+	// VulnerabilitiesCheckedAt is the last time VulnerabilityFindings was updated.
+	// +optional
+	VulnerabilitiesCheckedAt *metav1.Time `json:"vulnerabilitiesCheckedAt,omitempty"`
+
+	// SyncedTags are the tags of the instance copied from the labels of its
+	// node through TagSyncLabelSelector.
+	// +optional
+	SyncedTags map[string]string `json:"syncedTags,omitempty"`
+
+	// SecureDeleteInstanceID is the ID of the shredder instance wiping the
+	// volumes of the instance, when deleted with SecureDelete.
+	// +optional
+	SecureDeleteInstanceID *string `json:"secureDeleteInstanceID,omitempty"`
+
+	// SecureDeleteVolumeIDs are the IDs of the EBS volumes of the instance
+	// being wiped, when deleted with SecureDelete.
+	// +optional
+	SecureDeleteVolumeIDs []string `json:"secureDeleteVolumeIDs,omitempty"`
+
+	// IPv4Prefixes are the IPv4 prefixes assigned to the primary network
+	// interface of the instance through PrefixDelegation.
+	// +optional
+	IPv4Prefixes []string `json:"ipv4Prefixes,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -197,6 +501,25 @@ type AWSMachineStatus struct {
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
 }
 
+// VulnerabilityFinding is an Amazon Inspector finding for a software vulnerability of an instance.
+type VulnerabilityFinding struct {
+	// Severity is the severity of the finding, e.g. CRITICAL or HIGH.
+	Severity string `json:"severity"`
+
+	// Title is the title of the finding.
+	Title string `json:"title"`
+
+	// CVE is the ID of the vulnerability, e.g. CVE-2021-44228.
+	// +optional
+	CVE string `json:"cve,omitempty"`
+
+	// FixAvailable is true when a fix is available for every affected package.
+	FixAvailable bool `json:"fixAvailable"`
+}
+
+// VulnerabilitySeverityCritical is the severity of the most severe vulnerability findings.
+const VulnerabilitySeverityCritical = "CRITICAL"
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsmachines,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
@@ -17,11 +17,16 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -29,7 +34,10 @@ import (
 )
 
 // log is for logging in this package.
-var _ = logf.Log.WithName("awsmachine-resource")
+var awsmachinelog = logf.Log.WithName("awsmachine-resource")
+
+// maxSecureDeleteTimeout is the longest execution timeout of the SSM command wiping the volumes of an instance.
+const maxSecureDeleteTimeout = 48 * time.Hour
 
 func (r *AWSMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
@@ -47,6 +55,27 @@ func (r *AWSMachine) ValidateCreate() error {
 
 	allErrs = append(allErrs, r.validateCloudInitSecret()...)
 	allErrs = append(allErrs, r.validateVolumeTypeIOPS()...)
+	allErrs = append(allErrs, validateHibernation(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateCPUOptions(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateCreditSpecification(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateEphemeralStorage(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateAMISourceRegion(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateImageBuilderPipeline(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateLaunchTemplateRef(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateTagSyncLabelSelector(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateLaunchSnapshot(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateSecureDelete(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateFleetMode(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateShutdownBehavior(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateNitroEnclaves(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateENAExpress(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateWebhookSpec(r.Spec.PreTerminationHook, field.NewPath("spec", "preTerminationHook"))...)
+	allErrs = append(allErrs, validateWebhookSpec(r.Spec.PostProvisionHook, field.NewPath("spec", "postProvisionHook"))...)
+
+	r.warnDetailedMonitoringCost()
+	r.warnUnlimitedCredits()
+	warnLaunchTemplateOverrides(&r.Spec, r.Namespace, r.Name)
+	warnSpotShutdownBehavior(&r.Spec, r.Namespace, r.Name)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -69,6 +98,10 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, r.validateCloudInitSecret()...)
+	allErrs = append(allErrs, validateWebhookSpec(r.Spec.PreTerminationHook, field.NewPath("spec", "preTerminationHook"))...)
+	allErrs = append(allErrs, validateWebhookSpec(r.Spec.PostProvisionHook, field.NewPath("spec", "postProvisionHook"))...)
+	allErrs = append(allErrs, validateTagSyncLabelSelector(&r.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateShutdownBehavior(&r.Spec, field.NewPath("spec"))...)
 
 	newAWSMachineSpec := newAWSMachine["spec"].(map[string]interface{})
 	oldAWSMachineSpec := oldAWSMachine["spec"].(map[string]interface{})
@@ -81,10 +114,61 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	delete(oldAWSMachineSpec, "additionalTags")
 	delete(newAWSMachineSpec, "additionalTags")
 
+	// allow changes to tagSyncLabelSelector, tags are synced on running instances
+	delete(oldAWSMachineSpec, "tagSyncLabelSelector")
+	delete(newAWSMachineSpec, "tagSyncLabelSelector")
+
 	// allow changes to additionalSecurityGroups
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to preTerminationHook & postProvisionHook
+	delete(oldAWSMachineSpec, "preTerminationHook")
+	delete(newAWSMachineSpec, "preTerminationHook")
+	delete(oldAWSMachineSpec, "postProvisionHook")
+	delete(newAWSMachineSpec, "postProvisionHook")
+
+	// allow changes to restartOnStop, it only applies once the instance is stopped
+	delete(oldAWSMachineSpec, "restartOnStop")
+	delete(newAWSMachineSpec, "restartOnStop")
+
+	// allow changes to secureDelete & secureDeleteTimeout, they only apply to the deletion of the machine
+	delete(oldAWSMachineSpec, "secureDelete")
+	delete(newAWSMachineSpec, "secureDelete")
+	delete(oldAWSMachineSpec, "secureDeleteTimeout")
+	delete(newAWSMachineSpec, "secureDeleteTimeout")
+	allErrs = append(allErrs, validateSecureDelete(&r.Spec, field.NewPath("spec"))...)
+
+	// allow changes to autoExpandRootDisk
+	delete(oldAWSMachineSpec, "autoExpandRootDisk")
+	delete(newAWSMachineSpec, "autoExpandRootDisk")
+
+	// allow changes to detailedMonitoring, it is applied to running instances
+	if enabled, _ := oldAWSMachineSpec["detailedMonitoring"].(bool); !enabled {
+		r.warnDetailedMonitoringCost()
+	}
+	delete(oldAWSMachineSpec, "detailedMonitoring")
+	delete(newAWSMachineSpec, "detailedMonitoring")
+
+	// allow changes to creditSpecification, it is applied to running instances
+	if credits, _ := oldAWSMachineSpec["creditSpecification"].(string); credits != CPUCreditsUnlimited {
+		r.warnUnlimitedCredits()
+	}
+	delete(oldAWSMachineSpec, "creditSpecification")
+	delete(newAWSMachineSpec, "creditSpecification")
+	allErrs = append(allErrs, validateCreditSpecification(&r.Spec, field.NewPath("spec"))...)
+
+	// allow changes to enaExpressSettings, they are applied to running instances
+	delete(oldAWSMachineSpec, "enaExpressSettings")
+	delete(newAWSMachineSpec, "enaExpressSettings")
+	allErrs = append(allErrs, validateENAExpress(&r.Spec, field.NewPath("spec"))...)
+
+	// allow changes to iamInstanceProfile when hot-swapping is enabled
+	if r.Annotations[IAMInstanceProfileHotSwapAnnotation] == "true" {
+		delete(oldAWSMachineSpec, "iamInstanceProfile")
+		delete(newAWSMachineSpec, "iamInstanceProfile")
+	}
+
 	// allow changes to secretPrefix & secretCount
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -103,6 +187,56 @@ func (r *AWSMachine) ValidateUpdate(old runtime.Object) error {
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// warnDetailedMonitoringCost logs a warning when detailed monitoring is
+// enabled, as CloudWatch charges for the additional metrics.
+func (r *AWSMachine) warnDetailedMonitoringCost() {
+	if !r.Spec.DetailedMonitoring {
+		return
+	}
+	awsmachinelog.Info("detailed monitoring incurs additional CloudWatch charges", "cost-warning", "detailedMonitoring", "namespace", r.Namespace, "name", r.Name)
+}
+
+// warnUnlimitedCredits logs a warning when unlimited CPU credits are set outside
+// of development and test namespaces, as bursting is charged for.
+func (r *AWSMachine) warnUnlimitedCredits() {
+	if r.Spec.CreditSpecification != CPUCreditsUnlimited || r.Namespace == "dev" || r.Namespace == "test" {
+		return
+	}
+	awsmachinelog.Info("unlimited CPU credits incur additional charges when instances burst", "cost-warning", "creditSpecification", "namespace", r.Namespace, "name", r.Name)
+}
+
+// warnLaunchTemplateOverrides logs a warning when fields set from the launch template of
+// the instance are overridden, as the launch template may be expected to take precedence.
+func warnLaunchTemplateOverrides(spec *AWSMachineSpec, namespace, name string) {
+	if spec.LaunchTemplateRef == nil {
+		return
+	}
+	if overrides := launchTemplateOverrides(spec); len(overrides) > 0 {
+		awsmachinelog.Info("fields set along with launchTemplateRef override the launch template", "overrides", overrides, "namespace", namespace, "name", name)
+	}
+}
+
+// launchTemplateOverrides returns the fields of the spec which override the launch template.
+func launchTemplateOverrides(spec *AWSMachineSpec) []string {
+	var overrides []string
+	if spec.AMI.ID != nil || spec.AMISSMPath != "" || spec.ImageBuilderPipelineARN != "" {
+		overrides = append(overrides, "ami")
+	}
+	if spec.InstanceType != "" {
+		overrides = append(overrides, "instanceType")
+	}
+	if spec.SSHKeyName != nil {
+		overrides = append(overrides, "sshKeyName")
+	}
+	if len(spec.AdditionalSecurityGroups) > 0 {
+		overrides = append(overrides, "additionalSecurityGroups")
+	}
+	if spec.RootVolume != nil || len(spec.EphemeralStorage) > 0 {
+		overrides = append(overrides, "blockDeviceMappings")
+	}
+	return overrides
+}
+
 func (r *AWSMachine) validateCloudInitSecret() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -132,7 +266,295 @@ func (r *AWSMachine) validateVolumeTypeIOPS() field.ErrorList {
 	return allErrs
 }
 
+// validateHibernation checks that the root volume is encrypted when hibernation is enabled,
+// as EC2 stores the contents of the instance memory on the root volume.
+func validateHibernation(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !spec.Hibernation {
+		return allErrs
+	}
+
+	if spec.RootVolume == nil || (!spec.RootVolume.Encrypted && spec.RootVolume.EncryptionKey == "") {
+		allErrs = append(allErrs, field.Required(path.Child("rootVolume", "encrypted"), "root volume must be encrypted if hibernation is enabled"))
+	}
+
+	return allErrs
+}
+
+// validateCPUOptions checks the CPU options that don't depend on the instance type.
+// Core counts are checked against the instance type when the instance is created.
+func validateCPUOptions(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.CPUOptions == nil {
+		return allErrs
+	}
+
+	if spec.CPUOptions.CoreCount <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("cpuOptions", "coreCount"), spec.CPUOptions.CoreCount, "must be positive"))
+	}
+	if spec.CPUOptions.ThreadsPerCore != 1 && spec.CPUOptions.ThreadsPerCore != 2 {
+		allErrs = append(allErrs, field.NotSupported(path.Child("cpuOptions", "threadsPerCore"), spec.CPUOptions.ThreadsPerCore, []string{"1", "2"}))
+	}
+
+	return allErrs
+}
+
+// validateCreditSpecification checks that a credit specification is only set
+// on burstable instance types.
+func validateCreditSpecification(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.CreditSpecification == "" {
+		return allErrs
+	}
+
+	if spec.CreditSpecification != CPUCreditsStandard && spec.CreditSpecification != CPUCreditsUnlimited {
+		allErrs = append(allErrs, field.NotSupported(path.Child("creditSpecification"), spec.CreditSpecification, []string{CPUCreditsStandard, CPUCreditsUnlimited}))
+	}
+	if !IsBurstableInstanceType(spec.InstanceType) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("creditSpecification"), fmt.Sprintf("instance type %q is not burstable", spec.InstanceType)))
+	}
+
+	return allErrs
+}
+
+// validateEphemeralStorage checks that instance store volumes and devices are only mapped once.
+// Whether the instance type has enough instance store volumes is checked when the instance is created.
+func validateEphemeralStorage(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	virtualNames := map[string]bool{}
+	deviceNames := map[string]bool{}
+	for i, storage := range spec.EphemeralStorage {
+		if virtualNames[storage.VirtualName] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("ephemeralStorage").Index(i).Child("virtualName"), storage.VirtualName))
+		}
+		if deviceNames[storage.DeviceName] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("ephemeralStorage").Index(i).Child("deviceName"), storage.DeviceName))
+		}
+		virtualNames[storage.VirtualName] = true
+		deviceNames[storage.DeviceName] = true
+	}
+
+	return allErrs
+}
+
+// validateAMISourceRegion checks that an AMI source region is only set along with an AMI ID.
+func validateAMISourceRegion(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.AMISourceRegion != "" && spec.AMI.ID == nil {
+		allErrs = append(allErrs, field.Required(path.Child("ami", "id"), "must be set along with amiSourceRegion"))
+	}
+
+	return allErrs
+}
+
+// validateImageBuilderPipeline checks that the Image Builder pipeline is an ARN, and that it is set
+// when a new build is triggered.
+func validateImageBuilderPipeline(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.ImageBuilderPipelineARN != "" {
+		if parsed, err := arn.Parse(spec.ImageBuilderPipelineARN); err != nil || parsed.Service != "imagebuilder" {
+			allErrs = append(allErrs, field.Invalid(path.Child("imageBuilderPipelineARN"), spec.ImageBuilderPipelineARN, "must be the ARN of an Image Builder image pipeline"))
+		}
+	}
+	if spec.TriggerNewBuild && spec.ImageBuilderPipelineARN == "" {
+		allErrs = append(allErrs, field.Required(path.Child("imageBuilderPipelineARN"), "must be set along with triggerNewBuild"))
+	}
+
+	return allErrs
+}
+
+// validateLaunchTemplateRef checks that the image of instances launched from a launch template
+// is set when their root volume is, as its device is looked up from the image.
+func validateLaunchTemplateRef(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.LaunchTemplateRef != nil && spec.RootVolume != nil && spec.AMI.ID == nil && spec.AMISSMPath == "" && spec.ImageBuilderPipelineARN == "" {
+		allErrs = append(allErrs, field.Required(path.Child("ami", "id"), "must be set along with rootVolume and launchTemplateRef"))
+	}
+
+	return allErrs
+}
+
+// validateTagSyncLabelSelector checks that the label selector of the tags synced from the node is valid.
+func validateTagSyncLabelSelector(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.TagSyncLabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.TagSyncLabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("tagSyncLabelSelector"), spec.TagSyncLabelSelector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateLaunchSnapshot checks that machines restored from a launch snapshot have one, and that the
+// bucket of the snapshot is only set for the S3 storage type.
+func validateLaunchSnapshot(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.RestoreFromSnapshot && spec.LaunchSnapshot == nil {
+		allErrs = append(allErrs, field.Required(path.Child("launchSnapshot"), "must be set along with restoreFromSnapshot"))
+	}
+
+	snapshot := spec.LaunchSnapshot
+	if snapshot == nil {
+		return allErrs
+	}
+	switch snapshot.StorageType {
+	case LaunchSnapshotStorageS3:
+		if snapshot.Bucket == "" {
+			allErrs = append(allErrs, field.Required(path.Child("launchSnapshot", "bucket"), "must be set with the S3 storage type"))
+		}
+	case LaunchSnapshotStorageConfigMap:
+		if snapshot.Bucket != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("launchSnapshot", "bucket"), "can only be set with the S3 storage type"))
+		}
+		if snapshot.Name != "" {
+			for _, msg := range validation.IsDNS1123Subdomain(snapshot.Name) {
+				allErrs = append(allErrs, field.Invalid(path.Child("launchSnapshot", "name"), snapshot.Name, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateNitroEnclaves checks that Nitro Enclaves are only enabled on Nitro instance types
+// and without hibernation. Whether the instance type supports enclaves is checked when the
+// instance is created.
+func validateNitroEnclaves(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !spec.NitroEnclavesEnabled {
+		return allErrs
+	}
+
+	if spec.InstanceType != "" && !IsNitroInstanceType(spec.InstanceType) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("nitroEnclavesEnabled"), fmt.Sprintf("instance type %q is not built on the Nitro System", spec.InstanceType)))
+	}
+	if spec.Hibernation {
+		allErrs = append(allErrs, field.Forbidden(path.Child("nitroEnclavesEnabled"), "Nitro Enclaves cannot be enabled on instances configured for hibernation"))
+	}
+
+	return allErrs
+}
+
+// validateENAExpress checks that UDP traffic only uses ENA Express when it is enabled, and that it is
+// only enabled on Nitro instance types. Whether the instance type supports it is checked when the
+// instance is created.
+func validateENAExpress(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.ENAExpressSettings == nil {
+		return allErrs
+	}
+
+	if spec.ENAExpressSettings.UDPEnabled && !spec.ENAExpressSettings.Enabled {
+		allErrs = append(allErrs, field.Forbidden(path.Child("enaExpressSettings", "udpEnabled"), "requires ENA Express to be enabled"))
+	}
+	if spec.ENAExpressSettings.Enabled && spec.InstanceType != "" && !IsNitroInstanceType(spec.InstanceType) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("enaExpressSettings", "enabled"), fmt.Sprintf("instance type %q is not built on the Nitro System", spec.InstanceType)))
+	}
+
+	return allErrs
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *AWSMachine) ValidateDelete() error {
 	return nil
 }
+
+// validateSecureDelete checks that the timeout of the secure deletion of the instance is within the execution
+// timeouts SSM allows.
+func validateSecureDelete(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if timeout := spec.SecureDeleteTimeout; timeout != nil && (timeout.Duration < time.Second || timeout.Duration > maxSecureDeleteTimeout) {
+		allErrs = append(allErrs, field.Invalid(path.Child("secureDeleteTimeout"), timeout.Duration.String(), "must be between 1s and 48h"))
+	}
+
+	return allErrs
+}
+
+// validateFleetMode checks that the instance types of machines in fleet mode are set by their requirements, and
+// that the options depending on the instance type, which isn't known before the launch, aren't set.
+func validateFleetMode(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !spec.FleetMode {
+		if spec.FleetAllocationStrategy != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("fleetAllocationStrategy"), "can only be set in fleet mode"))
+		}
+		if spec.InstanceTypeRequirements != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("instanceTypeRequirements"), "can only be set in fleet mode"))
+		}
+		return allErrs
+	}
+
+	reqs := spec.InstanceTypeRequirements
+	if reqs == nil {
+		allErrs = append(allErrs, field.Required(path.Child("instanceTypeRequirements"), "must be set in fleet mode"))
+	} else {
+		if reqs.MaxVCPUs != nil && *reqs.MaxVCPUs < reqs.MinVCPUs {
+			allErrs = append(allErrs, field.Invalid(path.Child("instanceTypeRequirements", "maxVCPUs"), *reqs.MaxVCPUs, "must be greater than or equal to minVCPUs"))
+		}
+		if reqs.MaxMemoryMiB != nil && *reqs.MaxMemoryMiB < reqs.MinMemoryMiB {
+			allErrs = append(allErrs, field.Invalid(path.Child("instanceTypeRequirements", "maxMemoryMiB"), *reqs.MaxMemoryMiB, "must be greater than or equal to minMemoryMiB"))
+		}
+	}
+
+	if spec.InstanceType != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("instanceType"), "cannot be set in fleet mode"))
+	}
+	if spec.LaunchTemplateRef != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("launchTemplateRef"), "cannot be set in fleet mode"))
+	}
+	if len(spec.NetworkInterfaces) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("networkInterfaces"), "cannot be set in fleet mode"))
+	}
+	if spec.LaunchSnapshot != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("launchSnapshot"), "cannot be set in fleet mode"))
+	}
+	if spec.Hibernation {
+		allErrs = append(allErrs, field.Forbidden(path.Child("hibernation"), "cannot be set in fleet mode"))
+	}
+	if spec.CPUOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("cpuOptions"), "cannot be set in fleet mode"))
+	}
+	if len(spec.EphemeralStorage) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("ephemeralStorage"), "cannot be set in fleet mode"))
+	}
+
+	return allErrs
+}
+
+// validateShutdownBehavior checks that stopped instances are only restarted with the stop shutdown behavior.
+func validateShutdownBehavior(spec *AWSMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.RestartOnStop && spec.ShutdownBehavior != ShutdownBehaviorStop {
+		allErrs = append(allErrs, field.Forbidden(path.Child("restartOnStop"), "can only be set with the stop shutdown behavior"))
+	}
+
+	return allErrs
+}
+
+// warnSpotShutdownBehavior logs a warning when the stop shutdown behavior is set on machines launching a Spot
+// Instance, which EC2 doesn't stop but terminates when they are interrupted.
+func warnSpotShutdownBehavior(spec *AWSMachineSpec, namespace, name string) {
+	if spec.ShutdownBehavior != ShutdownBehaviorStop || !spec.FleetMode {
+		return
+	}
+	switch spec.FleetAllocationStrategy {
+	case FleetAllocationStrategyDiversified, FleetAllocationStrategyCapacityOptimized:
+		awsmachinelog.Info("Spot Instances are terminated when they are interrupted, regardless of the stop shutdown behavior",
+			"shutdownBehavior", spec.ShutdownBehavior, "fleetAllocationStrategy", spec.FleetAllocationStrategy, "namespace", namespace, "name", name)
+	}
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 )
 
@@ -39,6 +42,437 @@ func TestAWSMachine_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "allow nitro enclaves on a nitro instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:         "m5.xlarge",
+					NitroEnclavesEnabled: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure nitro enclaves are not enabled on xen instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:         "m4.xlarge",
+					NitroEnclavesEnabled: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure nitro enclaves are not enabled with hibernation",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:         "m5.xlarge",
+					Hibernation:          true,
+					NitroEnclavesEnabled: true,
+					RootVolume: &RootVolume{
+						Size:      16,
+						Encrypted: true,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow ena express with udp on a nitro instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "c6in.large",
+					ENAExpressSettings: &ENAExpressSpec{Enabled: true, UDPEnabled: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure ena express udp requires ena express",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "c6in.large",
+					ENAExpressSettings: &ENAExpressSpec{UDPEnabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure ena express is not enabled on xen instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "c4.large",
+					ENAExpressSettings: &ENAExpressSpec{Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure preTerminationHook has a valid URL",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					PreTerminationHook: &WebhookSpec{
+						URL: "not-a-url",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow preTerminationHook with an http URL",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					PreTerminationHook: &WebhookSpec{
+						URL: "http://hooks.example.com/pre-termination",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure root volume is encrypted if hibernation is enabled",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					Hibernation: true,
+					RootVolume: &RootVolume{
+						Size: 16,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow hibernation with an encrypted root volume",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					Hibernation: true,
+					RootVolume: &RootVolume{
+						Size:      16,
+						Encrypted: true,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "allow cpu options with hyperthreading disabled",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CPUOptions: &CPUOptionsSpec{CoreCount: 4, ThreadsPerCore: 1},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure cpu options threads per core is 1 or 2",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CPUOptions: &CPUOptionsSpec{CoreCount: 4, ThreadsPerCore: 4},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow unlimited credit specification on a burstable instance type",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:        "t3.large",
+					CreditSpecification: CPUCreditsUnlimited,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure credit specification is only set on burstable instance types",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:        "m5.large",
+					CreditSpecification: CPUCreditsStandard,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure ephemeral storage device names are unique",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					EphemeralStorage: []EphemeralStorageSpec{
+						{VirtualName: "ephemeral0", DeviceName: "/dev/sdb"},
+						{VirtualName: "ephemeral1", DeviceName: "/dev/sdb"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow ephemeral storage mapped to distinct devices",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "i3.2xlarge",
+					EphemeralStorage: []EphemeralStorageSpec{
+						{VirtualName: "ephemeral0", DeviceName: "/dev/sdb"},
+						{VirtualName: "ephemeral1", DeviceName: "/dev/sdc"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure cpu options core count is positive",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					CPUOptions: &CPUOptionsSpec{CoreCount: 0, ThreadsPerCore: 2},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure amiSourceRegion is set along with an AMI ID",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMISourceRegion: "us-west-2",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow amiSourceRegion with an AMI ID",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AMI:             AWSResourceReference{ID: pointer.StringPtr("ami-1")},
+					AMISourceRegion: "us-west-2",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "allow an Image Builder pipeline triggering a new build",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					ImageBuilderPipelineARN: "arn:aws:imagebuilder:us-east-1:123456789012:image-pipeline/capa-ubuntu",
+					TriggerNewBuild:         true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure imageBuilderPipelineARN is an Image Builder ARN",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					ImageBuilderPipelineARN: "arn:aws:ec2:us-east-1:123456789012:image/ami-1",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure triggerNewBuild requires an Image Builder pipeline",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					TriggerNewBuild: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow launchTemplateRef without an AMI",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchTemplateRef: &LaunchTemplateRef{ID: "lt-0123456789abcdef0", Version: "$Latest"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure rootVolume along with launchTemplateRef requires an AMI",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchTemplateRef: &LaunchTemplateRef{ID: "lt-0123456789abcdef0"},
+					RootVolume:        &RootVolume{Size: 16},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow rootVolume along with launchTemplateRef and an AMI",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchTemplateRef: &LaunchTemplateRef{ID: "lt-0123456789abcdef0"},
+					AMI:               AWSResourceReference{ID: pointer.StringPtr("ami-1")},
+					RootVolume:        &RootVolume{Size: 16},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "allow a tag sync label selector",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					TagSyncLabelSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: metav1.LabelSelectorOpExists},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure the tag sync label selector is valid",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					TagSyncLabelSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: metav1.LabelSelectorOpIn},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow restoring from a launch snapshot in S3",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchSnapshot:      &LaunchSnapshotSpec{StorageType: LaunchSnapshotStorageS3, Bucket: "snapshots", Name: "workers/machine-1"},
+					RestoreFromSnapshot: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure machines restored from a launch snapshot have one",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					RestoreFromSnapshot: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure launch snapshots in S3 have a bucket",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchSnapshot: &LaunchSnapshotSpec{StorageType: LaunchSnapshotStorageS3},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure launch snapshots in ConfigMaps have no bucket",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchSnapshot: &LaunchSnapshotSpec{StorageType: LaunchSnapshotStorageConfigMap, Bucket: "snapshots"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure launch snapshots in ConfigMaps have a valid name",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					LaunchSnapshot: &LaunchSnapshotSpec{StorageType: LaunchSnapshotStorageConfigMap, Name: "workers/machine-1"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow secure deletion with a timeout",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					SecureDelete:        true,
+					SecureDeleteTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure the timeout of secure deletion is positive",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					SecureDelete:        true,
+					SecureDeleteTimeout: &metav1.Duration{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure the timeout of secure deletion is at most 48h",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					SecureDelete:        true,
+					SecureDeleteTimeout: &metav1.Duration{Duration: 72 * time.Hour},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow fleet mode with instance type requirements",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					FleetMode:               true,
+					FleetAllocationStrategy: FleetAllocationStrategyCapacityOptimized,
+					InstanceTypeRequirements: &InstanceTypeRequirements{
+						MinVCPUs:     2,
+						MaxVCPUs:     pointer.Int64Ptr(8),
+						MinMemoryMiB: 4096,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure instance type requirements are set in fleet mode",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					FleetMode:    true,
+					InstanceType: "m5.large",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure the maximum vCPUs of fleet instance types are at least the minimum",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					FleetMode: true,
+					InstanceTypeRequirements: &InstanceTypeRequirements{
+						MinVCPUs: 4,
+						MaxVCPUs: pointer.Int64Ptr(2),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "allow restarting instances with the stop shutdown behavior",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					ShutdownBehavior: ShutdownBehaviorStop,
+					RestartOnStop:    true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure instances are only restarted with the stop shutdown behavior",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					ShutdownBehavior: ShutdownBehaviorTerminate,
+					RestartOnStop:    true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure instance type requirements are only set in fleet mode",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:             "m5.large",
+					InstanceTypeRequirements: &InstanceTypeRequirements{MinVCPUs: 2},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -49,6 +483,48 @@ func TestAWSMachine_ValidateCreate(t *testing.T) {
 	}
 }
 
+func TestLaunchTemplateOverrides(t *testing.T) {
+	tests := []struct {
+		name string
+		spec AWSMachineSpec
+		want []string
+	}{
+		{
+			name: "no overrides",
+			spec: AWSMachineSpec{
+				LaunchTemplateRef:  &LaunchTemplateRef{ID: "lt-0123456789abcdef0"},
+				IAMInstanceProfile: "nodes.cluster-api-provider-aws.sigs.k8s.io",
+			},
+		},
+		{
+			name: "overridden image, instance type and block devices",
+			spec: AWSMachineSpec{
+				LaunchTemplateRef: &LaunchTemplateRef{ID: "lt-0123456789abcdef0"},
+				AMISSMPath:        "/aws/service/eks/optimized-ami/1.17/amazon-linux-2/recommended/image_id",
+				InstanceType:      "m5.large",
+				RootVolume:        &RootVolume{Size: 16},
+			},
+			want: []string{"ami", "instanceType", "blockDeviceMappings"},
+		},
+		{
+			name: "overridden SSH key and security groups",
+			spec: AWSMachineSpec{
+				LaunchTemplateRef:        &LaunchTemplateRef{ID: "lt-0123456789abcdef0"},
+				SSHKeyName:               pointer.StringPtr(""),
+				AdditionalSecurityGroups: []AWSResourceReference{{ID: pointer.StringPtr("sg-1")}},
+			},
+			want: []string{"sshKeyName", "additionalSecurityGroups"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := launchTemplateOverrides(&tt.spec); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("launchTemplateOverrides() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAWSMachine_ValidateUpdate(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -71,6 +547,9 @@ func TestAWSMachine_ValidateUpdate(t *testing.T) {
 					AdditionalTags: Tags{
 						"key-1": "value-1",
 					},
+					TagSyncLabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "payments"},
+					},
 					AdditionalSecurityGroups: []AWSResourceReference{
 						{
 							ID: pointer.StringPtr("ID"),
@@ -110,6 +589,98 @@ func TestAWSMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in iaminstanceprofile with hot-swap annotation",
+			oldMachine: &AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{IAMInstanceProfileHotSwapAnnotation: "true"},
+				},
+				Spec: AWSMachineSpec{
+					IAMInstanceProfile: "old-profile",
+				},
+			},
+			newMachine: &AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{IAMInstanceProfileHotSwapAnnotation: "true"},
+				},
+				Spec: AWSMachineSpec{
+					IAMInstanceProfile: "new-profile",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in iaminstanceprofile without hot-swap annotation",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					IAMInstanceProfile: "old-profile",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					IAMInstanceProfile: "new-profile",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "change in creditspecification",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:        "t3.large",
+					CreditSpecification: CPUCreditsStandard,
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:        "t3.large",
+					CreditSpecification: CPUCreditsUnlimited,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in detailedmonitoring",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					DetailedMonitoring: false,
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					DetailedMonitoring: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in autoExpandRootDisk",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					AutoExpandRootDisk: &AutoExpandSpec{
+						ThresholdPercent: 80,
+						MaxSizeGiB:       200,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in secureDelete",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					SecureDelete:        true,
+					SecureDeleteTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+// AWSMachineRemediationSpec defines the desired state of AWSMachineRemediation
+type AWSMachineRemediationSpec struct {
+}
+
+// AWSMachineRemediationStatus defines the observed state of AWSMachineRemediation
+type AWSMachineRemediationStatus struct {
+	// LastRemediated is the time at which the unhealthy machine was deleted.
+	// +optional
+	LastRemediated *metav1.Time `json:"lastRemediated,omitempty"`
+
+	// Conditions defines current service state of the AWSMachineRemediation.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsmachineremediations,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Machine",type="string",JSONPath=".metadata.ownerReferences[?(@.kind==\"Machine\")].name",description="Machine object being remediated"
+// +kubebuilder:printcolumn:name="LastRemediated",type="string",JSONPath=".status.lastRemediated",description="Time at which the machine was deleted"
+
+// AWSMachineRemediation is the Schema for the awsmachineremediations API
+type AWSMachineRemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSMachineRemediationSpec   `json:"spec,omitempty"`
+	Status AWSMachineRemediationStatus `json:"status,omitempty"`
+}
+
+func (r *AWSMachineRemediation) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+func (r *AWSMachineRemediation) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// AWSMachineRemediationList contains a list of AWSMachineRemediation
+type AWSMachineRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSMachineRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSMachineRemediation{}, &AWSMachineRemediationList{})
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSMachineRemediationTemplateResource describes the data needed to create an AWSMachineRemediation from a template
+type AWSMachineRemediationTemplateResource struct {
+	// Spec is the specification of the desired behavior of the remediation.
+	Spec AWSMachineRemediationSpec `json:"spec"`
+}
+
+// AWSMachineRemediationTemplateSpec defines the desired state of AWSMachineRemediationTemplate
+type AWSMachineRemediationTemplateSpec struct {
+	Template AWSMachineRemediationTemplateResource `json:"template"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsmachineremediationtemplates,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+
+// AWSMachineRemediationTemplate is the Schema for the awsmachineremediationtemplates API.
+// A MachineHealthCheck references it to create an AWSMachineRemediation for each unhealthy machine.
+type AWSMachineRemediationTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AWSMachineRemediationTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSMachineRemediationTemplateList contains a list of AWSMachineRemediationTemplate
+type AWSMachineRemediationTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSMachineRemediationTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSMachineRemediationTemplate{}, &AWSMachineRemediationTemplateList{})
+}
@@ -53,6 +53,26 @@ func (r *AWSMachineTemplate) ValidateCreate() error {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
 	}
 
+	allErrs = append(allErrs, validateWebhookSpec(spec.PreTerminationHook, field.NewPath("spec", "template", "spec", "preTerminationHook"))...)
+	allErrs = append(allErrs, validateWebhookSpec(spec.PostProvisionHook, field.NewPath("spec", "template", "spec", "postProvisionHook"))...)
+	allErrs = append(allErrs, validateHibernation(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateCPUOptions(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateCreditSpecification(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateEphemeralStorage(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateAMISourceRegion(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateImageBuilderPipeline(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateLaunchTemplateRef(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateTagSyncLabelSelector(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateLaunchSnapshot(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateSecureDelete(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateFleetMode(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateShutdownBehavior(&spec, field.NewPath("spec", "template", "spec"))...)
+
+	warnLaunchTemplateOverrides(&spec, r.Namespace, r.Name)
+	warnSpotShutdownBehavior(&spec, r.Namespace, r.Name)
+	allErrs = append(allErrs, validateNitroEnclaves(&spec, field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateENAExpress(&spec, field.NewPath("spec", "template", "spec"))...)
+
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
@@ -61,6 +61,171 @@ const (
 	RouteTableReconciliationFailedReason = "RouteTableReconciliationFailed"
 )
 
+const (
+	// RemoteRegionReadyCondition reports successful reconciliation of the remote region VPC and its
+	// transit gateway attachment. Only applicable to clusters with a remote region.
+	RemoteRegionReadyCondition clusterv1.ConditionType = "RemoteRegionReady"
+	// RemoteRegionReconciliationFailedReason used when any errors occur during reconciliation of the remote region.
+	RemoteRegionReconciliationFailedReason = "RemoteRegionReconciliationFailed"
+	// TransitGatewayShareAcceptedCondition reports on whether the RAM share of the transit gateway of the remote
+	// region is accepted and the transit gateway is visible to the cluster's account. Only applicable to clusters
+	// with a remote region whose transit gateway is shared through RAM.
+	TransitGatewayShareAcceptedCondition clusterv1.ConditionType = "TransitGatewayShareAccepted"
+	// WaitingForTransitGatewayShareReason used while the shared transit gateway is not visible yet.
+	WaitingForTransitGatewayShareReason = "WaitingForTransitGatewayShare"
+	// TransitGatewayShareTimedOutReason used when the shared transit gateway did not become visible in time.
+	TransitGatewayShareTimedOutReason = "TransitGatewayShareTimedOut"
+	// TransitGatewayShareFailedReason used when the invitation to the RAM share of the transit gateway could not be
+	// accepted.
+	TransitGatewayShareFailedReason = "TransitGatewayShareFailed"
+	// ResourceShareReadyCondition reports successful reconciliation of the RAM resource share of the
+	// cluster's subnets. Only applicable to clusters with a RAM share.
+	ResourceShareReadyCondition clusterv1.ConditionType = "ResourceShareReady"
+	// ResourceShareReconciliationFailedReason used when any errors occur during reconciliation of the RAM resource share.
+	ResourceShareReconciliationFailedReason = "ResourceShareReconciliationFailed"
+	// InstanceConnectEndpointReadyCondition reports successful reconciliation of the EC2 Instance Connect Endpoint.
+	// Only applicable to clusters with an instance connect endpoint.
+	InstanceConnectEndpointReadyCondition clusterv1.ConditionType = "InstanceConnectEndpointReady"
+	// InstanceConnectEndpointReconciliationFailedReason used when any errors occur during reconciliation of the
+	// EC2 Instance Connect Endpoint.
+	InstanceConnectEndpointReconciliationFailedReason = "InstanceConnectEndpointReconciliationFailed"
+	// VPCEndpointPoliciesReadyCondition reports on whether the policies of the VPC endpoints of the cluster's VPC
+	// are applied. Only applicable to clusters with VPC endpoint policies.
+	VPCEndpointPoliciesReadyCondition clusterv1.ConditionType = "VPCEndpointPoliciesReady"
+	// VPCEndpointPolicyReconciliationFailedReason used when a VPC endpoint policy could not be validated or applied.
+	VPCEndpointPolicyReconciliationFailedReason = "VPCEndpointPolicyReconciliationFailed"
+	// EIPPoolReadyCondition reports on whether the Elastic IPs of the pool of the cluster are allocated.
+	// Only applicable to clusters with an Elastic IP pool.
+	EIPPoolReadyCondition clusterv1.ConditionType = "EIPPoolReady"
+	// EIPPoolReconciliationFailedReason used when the Elastic IPs of the pool could not be described or allocated.
+	EIPPoolReconciliationFailedReason = "EIPPoolReconciliationFailed"
+	// CloudFormationStackImportedCondition reports on whether the network fields of the cluster were imported from
+	// the outputs of its CloudFormation stack. Only applicable to clusters with a CloudFormation stack reference.
+	CloudFormationStackImportedCondition clusterv1.ConditionType = "CloudFormationStackImported"
+	// CloudFormationStackImportFailedReason used when the stack outputs could not be read or applied.
+	CloudFormationStackImportFailedReason = "CloudFormationStackImportFailed"
+	// TerraformStateImportedCondition reports on whether the network resources of the cluster were imported from
+	// its Terraform state. Only applicable to clusters with a Terraform state import.
+	TerraformStateImportedCondition clusterv1.ConditionType = "TerraformStateImported"
+	// TerraformStateImportFailedReason used when the Terraform state could not be read or applied.
+	TerraformStateImportFailedReason = "TerraformStateImportFailed"
+	// ServiceCatalogProductReadyCondition reports on whether the Service Catalog product of the cluster is
+	// provisioned and its outputs imported. Only applicable to clusters with a Service Catalog reference.
+	ServiceCatalogProductReadyCondition clusterv1.ConditionType = "ServiceCatalogProductReady"
+	// ServiceCatalogProvisioningFailedReason used when the product could not be provisioned or its outputs applied.
+	ServiceCatalogProvisioningFailedReason = "ServiceCatalogProvisioningFailed"
+	// ConformancePacksCompliantCondition reports on whether the AWS Config conformance packs of the cluster are
+	// compliant. Only applicable to clusters listing conformance packs in their security spec.
+	ConformancePacksCompliantCondition clusterv1.ConditionType = "ConformancePacksCompliant"
+	// ConformancePackNonCompliantReason used when at least one conformance pack is not compliant.
+	ConformancePackNonCompliantReason = "ConformancePackNonCompliant"
+	// FISExperimentTemplatesReadyCondition reports on the reconciliation of the FIS experiment templates of the cluster.
+	// Only applicable to clusters with FIS experiment templates.
+	FISExperimentTemplatesReadyCondition clusterv1.ConditionType = "FISExperimentTemplatesReady"
+	// FISExperimentTemplateFailedReason used when an experiment template could not be created or deleted.
+	FISExperimentTemplateFailedReason = "FISExperimentTemplateFailed"
+	// ServiceAccountRolesReadyCondition reports on the reconciliation of the IAM roles of the cluster's service accounts.
+	// Only applicable to clusters with service account roles.
+	ServiceAccountRolesReadyCondition clusterv1.ConditionType = "ServiceAccountRolesReady"
+	// ServiceAccountRoleFailedReason used when a service account role could not be created, updated or deleted.
+	ServiceAccountRoleFailedReason = "ServiceAccountRoleFailed"
+	// BootstrapTokenReadyCondition reports on the rotation of the node bootstrap token stored in AWS Secrets Manager.
+	// Only applicable to clusters with a bootstrap token rotation.
+	BootstrapTokenReadyCondition clusterv1.ConditionType = "BootstrapTokenReady"
+	// BootstrapTokenRotationFailedReason used when the bootstrap token could not be stored or rotated.
+	BootstrapTokenRotationFailedReason = "BootstrapTokenRotationFailed"
+	// TerminationAlertsReadyCondition reports on the reconciliation of the EventBridge rule sending the termination
+	// notifications of the cluster's instances. Only applicable to clusters with termination alerts.
+	TerminationAlertsReadyCondition clusterv1.ConditionType = "TerminationAlertsReady"
+	// TerminationAlertRuleFailedReason used when the termination alert rule or its target could not be reconciled.
+	TerminationAlertRuleFailedReason = "TerminationAlertRuleFailed"
+	// KarpenterReadyCondition reports on the installation of Karpenter in the cluster and of the AWS resources it
+	// needs. Only applicable to clusters with Karpenter.
+	KarpenterReadyCondition clusterv1.ConditionType = "KarpenterReady"
+	// KarpenterInstallationFailedReason used when the AWS resources of Karpenter or its manifests could not be reconciled.
+	KarpenterInstallationFailedReason = "KarpenterInstallationFailed"
+	// WaitingForControlPlaneReason used when Karpenter is waiting for the control plane of the cluster to be initialized.
+	WaitingForControlPlaneReason = "WaitingForControlPlane"
+	// NodeTerminationHandlerReadyCondition reports on the reconciliation of the SQS queue of the cluster's AWS Node
+	// Termination Handler, and of its EventBridge rules when the queue is created for the cluster. Only applicable to
+	// clusters with a Node Termination Handler.
+	NodeTerminationHandlerReadyCondition clusterv1.ConditionType = "NodeTerminationHandlerReady"
+	// NodeTerminationHandlerQueueFailedReason used when the queue of the Node Termination Handler or its rules could
+	// not be reconciled.
+	NodeTerminationHandlerQueueFailedReason = "NodeTerminationHandlerQueueFailed"
+	// CostAllocationTagsActiveCondition reports on whether the cost allocation tags of the cluster are activated
+	// in the account. Only applicable to clusters with cost allocation tags.
+	CostAllocationTagsActiveCondition clusterv1.ConditionType = "CostAllocationTagsActive"
+	// CostAllocationTagActivationFailedReason used when cost allocation tags are unknown to billing or could not be
+	// activated.
+	CostAllocationTagActivationFailedReason = "CostAllocationTagActivationFailed"
+	// SSMParametersExportedCondition reports on the export of the fields of the status of the cluster to the
+	// Parameter Store. Only applicable to clusters with an SSMParameterExport.
+	SSMParametersExportedCondition clusterv1.ConditionType = "SSMParametersExported"
+	// SSMParameterExportFailedReason used when the parameters of the cluster could not be written.
+	SSMParameterExportFailedReason = "SSMParameterExportFailed"
+	// DataSyncCompletedCondition reports on the last execution of the DataSync task of the cluster. Only
+	// applicable to clusters with a DataSync.
+	DataSyncCompletedCondition clusterv1.ConditionType = "DataSyncCompleted"
+	// DataSyncInProgressReason used while the last execution of the DataSync task is running.
+	DataSyncInProgressReason = "DataSyncInProgress"
+	// DataSyncFailedReason used when the DataSync task could not be created or its last execution failed.
+	DataSyncFailedReason = "DataSyncFailed"
+	// NetworkPerformanceCondition reports on the bandwidth between the nodes of the cluster measured by its
+	// network benchmark. Only applicable to clusters with an enabled network benchmark.
+	NetworkPerformanceCondition clusterv1.ConditionType = "NetworkPerformance"
+	// NetworkBenchmarkInProgressReason used while the network benchmark is waiting for nodes or running.
+	NetworkBenchmarkInProgressReason = "NetworkBenchmarkInProgress"
+	// NetworkBenchmarkFailedReason used when the network benchmark could not be run.
+	NetworkBenchmarkFailedReason = "NetworkBenchmarkFailed"
+	// NetworkPerformanceDegradedReason used when the measured bandwidth is lower than the target bandwidth.
+	NetworkPerformanceDegradedReason = "NetworkPerformanceDegraded"
+	// MachinesHealthyCondition reports on whether any AWSMachine of the cluster has failed. Only applicable to
+	// clusters aggregating the failure messages of their machines.
+	MachinesHealthyCondition clusterv1.ConditionType = "MachinesHealthy"
+	// MachineFailuresReason used when AWSMachines of the cluster have failed. The message of the condition
+	// summarizes their failure messages.
+	MachineFailuresReason = "MachineFailures"
+)
+
+const (
+	// QuotaSufficientCondition reports on whether the service quotas of the account leave room for the resources
+	// of the cluster. It is only checked before the resources of a new cluster are created.
+	QuotaSufficientCondition clusterv1.ConditionType = "QuotaSufficient"
+	// QuotaInsufficientReason used when creating the resources of the cluster would exceed a service quota.
+	QuotaInsufficientReason = "QuotaInsufficient"
+)
+
+const (
+	// SecurityGroupRulesWithinLimitCondition reports on whether the ingress rules of the managed security groups
+	// fit within their rule limit. Rules beyond the limit are moved to overflow security groups.
+	SecurityGroupRulesWithinLimitCondition clusterv1.ConditionType = "SecurityGroupRulesWithinLimit"
+	// SecurityGroupOverflowReason used when ingress rules of managed security groups were moved to overflow
+	// security groups.
+	SecurityGroupOverflowReason = "SecurityGroupOverflow"
+)
+
+const (
+	// WindowsPasswordAvailableCondition reports on whether the Administrator password generated by EC2Launch
+	// can be retrieved for a Windows instance, which shows that the instance finished booting and is accessible.
+	WindowsPasswordAvailableCondition clusterv1.ConditionType = "WindowsPasswordAvailable"
+	// WaitingForWindowsPasswordReason used while the password of the instance is not generated yet.
+	WaitingForWindowsPasswordReason = "WaitingForWindowsPassword"
+	// WindowsPasswordKeyPairMissingReason used when the instance has no key pair for its password to be
+	// encrypted with, in which case no password is generated.
+	WindowsPasswordKeyPairMissingReason = "WindowsPasswordKeyPairMissing"
+	// WindowsPasswordCheckFailedReason used when the password data of the instance could not be retrieved.
+	WindowsPasswordCheckFailedReason = "WindowsPasswordCheckFailed"
+)
+
+const (
+	// ResourcesAdoptedCondition reports on the adoption of the existing VPC, subnets and security groups
+	// of an AWSCluster annotated for adoption.
+	ResourcesAdoptedCondition clusterv1.ConditionType = "ResourcesAdopted"
+	// ResourceAdoptionFailedReason used when a resource to adopt could not be found or tagged.
+	ResourceAdoptionFailedReason = "ResourceAdoptionFailed"
+)
+
 const (
 	// ClusterSecurityGroupsReady condition reports successful reconciliation of security groups.
 	ClusterSecurityGroupsReadyCondition clusterv1.ConditionType = "ClusterSecurityGroupsReady"
@@ -89,6 +254,14 @@ const (
 	LoadBalancerFailedReason = "LoadBalancerFailed"
 )
 
+const (
+	// CertificateReadyCondition reports on whether the etcd certificates issued by the private CA of the cluster
+	// are stored and not about to expire. Only applicable to clusters with a private CA.
+	CertificateReadyCondition clusterv1.ConditionType = "CertificateReady"
+	// CertificateIssuanceFailedReason used when a certificate could not be issued by the private CA or stored.
+	CertificateIssuanceFailedReason = "CertificateIssuanceFailed"
+)
+
 const (
 	// InstanceReadyCondition reports on current status of the EC2 instance. Ready indicates the instance is in a Running state.
 	InstanceReadyCondition clusterv1.ConditionType = "InstanceReady"
@@ -109,6 +282,32 @@ const (
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+	// WaitingForAMICopyReason used when machine is waiting for its AMI to be copied from its source region.
+	WaitingForAMICopyReason = "WaitingForAMICopy"
+	// WaitingForImageBuildReason used when machine is waiting for its AMI to be built by its Image Builder pipeline.
+	WaitingForImageBuildReason = "WaitingForImageBuild"
+	// SubnetFullReason used when the subnets the instance can be launched in do not have enough available IP addresses.
+	SubnetFullReason = "SubnetFull"
+	// WaitingForFleetReason used when machine is waiting for its EC2 Fleet to launch its instance.
+	WaitingForFleetReason = "WaitingForFleet"
+)
+
+const (
+	// PostProvisionHookSucceededCondition reports on whether the post-provision hook of the AWSMachine responded
+	// successfully. Only applicable to machines which define a post-provision hook.
+	PostProvisionHookSucceededCondition clusterv1.ConditionType = "PostProvisionHookSucceeded"
+
+	// PostProvisionHookFailedReason used when the post-provision hook timed out or did not respond with a 200 status code.
+	PostProvisionHookFailedReason = "PostProvisionHookFailed"
+)
+
+const (
+	// PreTerminationHookCalledCondition reports on whether the pre-termination hook of a deleted AWSMachine was
+	// called, so that it is only called once. Only applicable to machines which define a pre-termination hook.
+	PreTerminationHookCalledCondition clusterv1.ConditionType = "PreTerminationHookCalled"
+
+	// PreTerminationHookFailedReason used when the pre-termination hook failed and its failure policy blocks the termination.
+	PreTerminationHookFailedReason = "PreTerminationHookFailed"
 )
 
 const (
@@ -129,3 +328,63 @@ const (
 	// ELBDetachFailedReason used when a control plane node fails to detach from an ELB
 	ELBDetachFailedReason = "ELBDetachFailed"
 )
+
+const (
+	// TargetGroupsDrainedCondition reports on whether the instance of the AWSMachine was deregistered from its
+	// target groups and connection draining completed. Only applicable to machines annotated to prepare for drain.
+	TargetGroupsDrainedCondition clusterv1.ConditionType = "TargetGroupsDrained"
+
+	// TargetGroupsDrainingReason used while connection draining has not completed in all target groups yet.
+	TargetGroupsDrainingReason = "TargetGroupsDraining"
+	// TargetGroupsDeregistrationFailedReason used when the instance could not be deregistered from its target groups.
+	TargetGroupsDeregistrationFailedReason = "TargetGroupsDeregistrationFailed"
+)
+
+const (
+	// RemediationInProgressCondition reports on the remediation of an unhealthy machine. It is false once the
+	// machine was deleted, or when deleting it failed.
+	RemediationInProgressCondition clusterv1.ConditionType = "RemediationInProgress"
+
+	// RemediationCompletedReason used when the machine was deleted so that its owner replaces it.
+	RemediationCompletedReason = "RemediationCompleted"
+	// RemediationFailedReason used when an error occurs while remediating the machine.
+	RemediationFailedReason = "RemediationFailed"
+)
+
+const (
+	// GPUReadyCondition reports on whether the NVIDIA drivers of a GPU machine are working, as verified
+	// by running nvidia-smi on the instance through SSM.
+	GPUReadyCondition clusterv1.ConditionType = "GPUReady"
+
+	// GPUVerificationInProgressReason used while the nvidia-smi command has not completed yet.
+	GPUVerificationInProgressReason = "GPUVerificationInProgress"
+	// GPUVerificationFailedReason used when nvidia-smi failed or could not be run on the instance.
+	GPUVerificationFailedReason = "GPUVerificationFailed"
+)
+
+const (
+	// SSHKeyVerifiedCondition reports on whether the SSH host key fingerprints printed to the system log
+	// of the instance match its SSH host keys.
+	SSHKeyVerifiedCondition clusterv1.ConditionType = "SSHKeyVerified"
+
+	// SSHKeyVerificationInProgressReason used while the system log of the instance does not hold its SSH
+	// host key fingerprints yet.
+	SSHKeyVerificationInProgressReason = "SSHKeyVerificationInProgress"
+	// SSHKeyMismatchReason used when an SSH host key fingerprint does not match the SSH host keys of the instance.
+	SSHKeyMismatchReason = "SSHKeyMismatch"
+	// SSHKeyVerificationFailedReason used when the system log of the instance could not be retrieved or parsed.
+	SSHKeyVerificationFailedReason = "SSHKeyVerificationFailed"
+)
+
+const (
+	// VolumesWipedCondition reports on the wiping of the EBS volumes of the instance of the AWSMachine before it
+	// is terminated. Only applicable to machines deleted with SecureDelete.
+	VolumesWipedCondition clusterv1.ConditionType = "VolumesWiped"
+
+	// VolumeWipeInProgressReason used while the instance is stopped, its volumes are moved to the shredder
+	// instance, wiped or deleted.
+	VolumeWipeInProgressReason = "VolumeWipeInProgress"
+	// VolumeWipeFailedReason used when the volumes could not be wiped, or were not wiped within the
+	// SecureDeleteTimeout of the machine.
+	VolumeWipeFailedReason = "VolumeWipeFailed"
+)
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReconcileSummarySpec defines the desired state of ReconcileSummary
+type ReconcileSummarySpec struct {
+}
+
+// ReconcileSummaryStatus defines the observed state of ReconcileSummary
+type ReconcileSummaryStatus struct {
+	// TotalClusters is the number of AWSClusters in the namespace.
+	// +optional
+	TotalClusters int32 `json:"totalClusters"`
+
+	// ReadyClusters is the number of AWSClusters in the namespace whose Ready condition is true.
+	// +optional
+	ReadyClusters int32 `json:"readyClusters"`
+
+	// TotalMachines is the number of AWSMachines in the namespace.
+	// +optional
+	TotalMachines int32 `json:"totalMachines"`
+
+	// RunningMachines is the number of AWSMachines in the namespace whose instance is running.
+	// +optional
+	RunningMachines int32 `json:"runningMachines"`
+
+	// FailedMachines is the number of AWSMachines in the namespace which have failed.
+	// +optional
+	FailedMachines int32 `json:"failedMachines"`
+
+	// AverageReconcileDuration is the average duration of the reconciliations of the AWSClusters
+	// and AWSMachines in the namespace over the last hour, if any.
+	// +optional
+	AverageReconcileDuration *metav1.Duration `json:"averageReconcileDuration,omitempty"`
+
+	// TopErrors are the five most frequent reconciliation errors of the AWSClusters and AWSMachines
+	// in the namespace over the last hour, along with the failure messages of the failed AWSMachines.
+	// +optional
+	TopErrors []ReconcileErrorCount `json:"topErrors,omitempty"`
+
+	// LastUpdated is the time at which the summary was last computed.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// ReconcileErrorCount is an error message and the number of times it was seen.
+type ReconcileErrorCount struct {
+	// Message is the error message.
+	Message string `json:"message"`
+
+	// Count is the number of times the error was seen.
+	Count int32 `json:"count"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=reconcilesummaries,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.totalClusters",description="Number of AWSClusters"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyClusters",description="Number of ready AWSClusters"
+// +kubebuilder:printcolumn:name="Machines",type="integer",JSONPath=".status.totalMachines",description="Number of AWSMachines"
+// +kubebuilder:printcolumn:name="Running",type="integer",JSONPath=".status.runningMachines",description="Number of running AWSMachines"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedMachines",description="Number of failed AWSMachines"
+
+// ReconcileSummary is the Schema for the reconcilesummaries API. It reports the reconciliation
+// health of the AWSClusters and AWSMachines of its namespace.
+type ReconcileSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReconcileSummarySpec   `json:"spec,omitempty"`
+	Status ReconcileSummaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReconcileSummaryList contains a list of ReconcileSummary
+type ReconcileSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReconcileSummary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReconcileSummary{}, &ReconcileSummaryList{})
+}
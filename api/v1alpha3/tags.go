@@ -104,6 +104,26 @@ const (
 	// dedicated to this cluster api provider implementation.
 	NameAWSClusterAPIRole = NameAWSProviderPrefix + "role"
 
+	// ManagementClusterTagKey is the tag key of the resources created for the clusters of a management
+	// cluster, whose value is the ID the management cluster's controller was started with.
+	ManagementClusterTagKey = NameAWSProviderPrefix + "management-cluster"
+
+	// ClusterNamespaceTagKey is the tag key of the resources created for a cluster, whose value is
+	// the namespace of the cluster. It is only set along with ManagementClusterTagKey.
+	ClusterNamespaceTagKey = NameAWSProviderPrefix + "cluster-namespace"
+
+	// ImportedFromTagKey is the tag key of the resources imported from the state of
+	// another tool, whose value names the tool.
+	ImportedFromTagKey = NameAWSProviderPrefix + "imported-from"
+
+	// OverflowSecurityGroupTagKey is the tag key of the security groups holding the ingress
+	// rules of a managed security group beyond its rule limit.
+	OverflowSecurityGroupTagKey = NameAWSProviderPrefix + "overflow-sg"
+
+	// NodeLabelTagPrefix is the prefix of the instance tags copied from the labels of
+	// its node through the TagSyncLabelSelector of the AWSMachine.
+	NodeLabelTagPrefix = "k8s-label/"
+
 	// APIServerRoleTagValue describes the value for the apiserver role
 	APIServerRoleTagValue = "apiserver"
 
@@ -118,6 +138,16 @@ const (
 
 	// PrivateRoleTagValue describes the value for the private role
 	PrivateRoleTagValue = "private"
+
+	// EIPPoolRoleTagValue describes the value for the Elastic IP pool role
+	EIPPoolRoleTagValue = "eip-pool"
+
+	// ShredderRoleTagValue describes the value for the shredder role
+	ShredderRoleTagValue = "shredder"
+
+	// TerraformImportedFromTagValue describes the value of the imported-from tag of
+	// resources imported from a Terraform state
+	TerraformImportedFromTagValue = "terraform"
 )
 
 // ClusterTagKey generates the key for resources associated with a cluster.
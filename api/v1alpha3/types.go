@@ -19,8 +19,10 @@ package v1alpha3
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
@@ -76,6 +78,60 @@ type Network struct {
 
 	// APIServerELB is the Kubernetes api server classic load balancer.
 	APIServerELB ClassicELB `json:"apiServerElb,omitempty"`
+
+	// AdditionalAPIServerELBs are the classic load balancers serving the additional control plane endpoints.
+	// +optional
+	AdditionalAPIServerELBs []ClassicELB `json:"additionalApiServerElbs,omitempty"`
+
+	// RemoteRegion reports the resources created for the remote region, if any.
+	// +optional
+	RemoteRegion *RemoteRegionStatus `json:"remoteRegion,omitempty"`
+
+	// ResourceShareARN is the ARN of the RAM resource share the cluster's
+	// subnets are shared through, if any.
+	// +optional
+	ResourceShareARN string `json:"resourceShareArn,omitempty"`
+
+	// InstanceConnectEndpoint is the EC2 Instance Connect Endpoint created
+	// for the cluster, if any.
+	// +optional
+	InstanceConnectEndpoint *InstanceConnectEndpoint `json:"instanceConnectEndpoint,omitempty"`
+
+	// PreallocatedEIPs are the allocation IDs of the Elastic IPs of the pool
+	// which are not used by a NAT gateway yet.
+	// +optional
+	PreallocatedEIPs []string `json:"preallocatedEips,omitempty"`
+
+	// VPC is the VPC of the cluster, as provisioned by the last network reconciliation.
+	// +optional
+	VPC *VPCSpec `json:"vpc,omitempty"`
+
+	// Subnets are the subnets of the cluster, as provisioned by the last network reconciliation.
+	// +optional
+	Subnets Subnets `json:"subnets,omitempty"`
+}
+
+// InstanceConnectEndpoint describes an EC2 Instance Connect Endpoint.
+type InstanceConnectEndpoint struct {
+	// ID is the ID of the endpoint.
+	ID string `json:"id"`
+
+	// DNSName is the DNS name of the endpoint.
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+}
+
+// RemoteRegionStatus describes the resources created in the remote region.
+type RemoteRegionStatus struct {
+	// VPCID is the ID of the remote VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// RouteTableID is the ID of the route table shared by the remote subnets.
+	RouteTableID string `json:"routeTableId,omitempty"`
+
+	// TransitGatewayAttachmentID is the ID of the attachment connecting the
+	// remote VPC to the transit gateway.
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
 }
 
 // ClassicELBScheme defines the scheme of a classic load balancer.
@@ -194,6 +250,185 @@ type NetworkSpec struct {
 	// CNI configuration
 	// +optional
 	CNI *CNISpec `json:"cni,omitempty"`
+
+	// RemoteRegion configures a second VPC in another region, connected to the
+	// cluster's VPC through a transit gateway, so that nodes can be spread
+	// across two regions.
+	// +optional
+	RemoteRegion *RemoteRegionSpec `json:"remoteRegion,omitempty"`
+
+	// RAMShare configures sharing of the cluster's subnets across accounts
+	// through AWS Resource Access Manager.
+	// +optional
+	RAMShare *RAMShareSpec `json:"ramShare,omitempty"`
+
+	// InstanceConnectEndpoint configures an EC2 Instance Connect Endpoint,
+	// which allows SSH access to instances in private subnets without a
+	// bastion host.
+	// +optional
+	InstanceConnectEndpoint *InstanceConnectEndpointSpec `json:"instanceConnectEndpoint,omitempty"`
+
+	// CloudFormationStackRef imports the VPC and subnets of the cluster from
+	// the outputs of an existing CloudFormation stack instead of provisioning them.
+	// +optional
+	CloudFormationStackRef *CFStackRef `json:"cloudFormationStackRef,omitempty"`
+
+	// SecurityGroupOverrides maps the roles of the cluster's security groups
+	// to the IDs of existing security groups used instead of creating them.
+	// Their ingress rules are left as is.
+	// +optional
+	SecurityGroupOverrides map[SecurityGroupRole]string `json:"securityGroupOverrides,omitempty"`
+
+	// VPCEndpoints configures the VPC endpoints of the cluster's VPC.
+	// +optional
+	VPCEndpoints *VPCEndpointsSpec `json:"vpcEndpoints,omitempty"`
+
+	// EIPPool configures a pool of Elastic IPs allocated ahead of the NAT
+	// gateways of the cluster, so that their creation does not wait for it.
+	// +optional
+	EIPPool *EIPPoolSpec `json:"eipPool,omitempty"`
+}
+
+// EIPPoolSpec configures the pool of Elastic IPs pre-allocated for the NAT gateways of the cluster.
+type EIPPoolSpec struct {
+	// PreAllocated is the number of Elastic IPs allocated before the NAT
+	// gateways are created. Elastic IPs left in the pool once the NAT
+	// gateways are created are released along with the cluster.
+	// +kubebuilder:validation:Minimum=0
+	PreAllocated int `json:"preAllocated"`
+}
+
+const (
+	// CFStackOutputVPCID maps a CloudFormation stack output to the ID of the cluster's VPC.
+	CFStackOutputVPCID = "vpc.id"
+
+	// CFStackOutputSubnets maps a CloudFormation stack output holding a comma-separated
+	// list of subnet IDs to the subnets of the cluster.
+	CFStackOutputSubnets = "subnets"
+)
+
+// CFStackRef references a CloudFormation stack whose outputs describe the cluster's network.
+type CFStackRef struct {
+	// StackName is the name or ID of the stack.
+	// +kubebuilder:validation:MinLength=1
+	StackName string `json:"stackName"`
+
+	// OutputKeyMappings maps stack output keys to the network fields they
+	// populate, one of vpc.id or subnets.
+	OutputKeyMappings map[string]string `json:"outputKeyMappings"`
+}
+
+// TFStateImportSpec references the Terraform state stored by an S3 backend.
+// Security groups are imported for the role matching the name of their
+// Terraform resource, e.g. aws_security_group.controlplane, with underscores
+// standing for dashes, and the API server load balancer from the aws_elb
+// resource named apiserver.
+type TFStateImportSpec struct {
+	// StateS3Bucket is the name of the S3 bucket of the backend.
+	// +kubebuilder:validation:MinLength=3
+	StateS3Bucket string `json:"stateS3Bucket"`
+
+	// StateS3Key is the key of the state in the bucket.
+	// +kubebuilder:validation:MinLength=1
+	StateS3Key string `json:"stateS3Key"`
+
+	// WorkspaceID is the Terraform workspace the state belongs to. The state
+	// of a workspace other than default is read from env:/<workspace>/<key>,
+	// where the S3 backend stores it by default.
+	// +optional
+	WorkspaceID string `json:"workspaceID,omitempty"`
+}
+
+// ServiceCatalogRef references a Service Catalog product provisioned for the cluster.
+type ServiceCatalogRef struct {
+	// ProductID is the ID of the Service Catalog product.
+	// +kubebuilder:validation:MinLength=1
+	ProductID string `json:"productID"`
+
+	// ProvisioningArtifactID is the ID of the product version to provision.
+	// +kubebuilder:validation:MinLength=1
+	ProvisioningArtifactID string `json:"provisioningArtifactID"`
+
+	// Parameters are the provisioning parameters of the product.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// OutputKeyMappings maps outputs of the provisioned product to the
+	// network fields they populate, one of vpc.id or subnets.
+	OutputKeyMappings map[string]string `json:"outputKeyMappings"`
+}
+
+// InstanceConnectEndpointSpec configures an EC2 Instance Connect Endpoint for the cluster.
+type InstanceConnectEndpointSpec struct {
+	// SubnetID is the subnet the endpoint is created in. Defaults to the
+	// first private subnet of the cluster.
+	// +optional
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// SecurityGroupIDs are the security groups attached to the endpoint.
+	// The control plane and node security groups allow SSH from them.
+	// +kubebuilder:validation:MinItems=1
+	SecurityGroupIDs []string `json:"securityGroupIds"`
+}
+
+// VPCEndpointsSpec configures the VPC endpoints of the cluster's VPC.
+type VPCEndpointsSpec struct {
+	// EndpointPolicies are the policies of the VPC endpoints of the cluster's
+	// VPC, by service, restricting the requests made through the endpoints.
+	// +optional
+	EndpointPolicies []EndpointPolicySpec `json:"endpointPolicies,omitempty"`
+}
+
+// EndpointPolicySpec is the policy of the VPC endpoints of a service.
+type EndpointPolicySpec struct {
+	// ServiceName is the name of the service of the endpoints, e.g.
+	// com.amazonaws.us-west-2.s3.
+	ServiceName string `json:"serviceName"`
+
+	// PolicyDocument is the JSON IAM policy applied to the endpoints.
+	PolicyDocument string `json:"policyDocument"`
+}
+
+// RAMShareSpec configures a RAM resource share for the cluster's subnets.
+// Exactly one of ResourceShareARN and PrincipalARNs must be set.
+type RAMShareSpec struct {
+	// ResourceShareARN is the ARN of a resource share created by another
+	// account. The controller accepts the pending invitation to the share so
+	// that its subnets can be used by the cluster.
+	// +optional
+	ResourceShareARN string `json:"resourceShareArn,omitempty"`
+
+	// PrincipalARNs are the accounts, organizations or organizational units
+	// the cluster's subnets are shared with. The controller creates the
+	// resource share and deletes it along with the cluster.
+	// +optional
+	PrincipalARNs []string `json:"principalArns,omitempty"`
+}
+
+// RemoteRegionSpec configures a VPC in a region other than the cluster's.
+type RemoteRegionSpec struct {
+	// Region is the AWS region the remote VPC is created in.
+	Region string `json:"region"`
+
+	// VPCCidr is the CIDR block of the remote VPC. It must not overlap with
+	// the cluster's VPC.
+	VPCCidr string `json:"vpcCidr"`
+
+	// Subnets are the subnets to create in the remote VPC. Remote subnets are
+	// private and route to the cluster's VPC through the transit gateway.
+	// +optional
+	Subnets Subnets `json:"subnets,omitempty"`
+
+	// TransitGatewayID is the ID of an existing transit gateway in the remote
+	// region that the remote VPC is attached to. It must be peered with a
+	// transit gateway the cluster's VPC is attached to.
+	TransitGatewayID string `json:"transitGatewayId"`
+
+	// TransitGatewayRAMShareARN is the ARN of the RAM resource share through
+	// which another account shares the transit gateway. The pending invitation
+	// to the share is accepted before the remote VPC is attached to it.
+	// +optional
+	TransitGatewayRAMShareARN string `json:"transitGatewayRamShareArn,omitempty"`
 }
 
 // VPCSpec configures an AWS VPC.
@@ -202,7 +437,9 @@ type VPCSpec struct {
 	ID string `json:"id,omitempty"`
 
 	// CidrBlock is the CIDR block to be used when the provider creates a managed VPC.
-	// Defaults to 10.0.0.0/16.
+	// Defaults to the smallest block from 10.0.0.0/20 to 10.0.0.0/16 whose private subnets
+	// have two addresses for each machine of the MachineDeployments of the cluster, or to
+	// 10.0.0.0/16 when the cluster has no machines yet.
 	CidrBlock string `json:"cidrBlock,omitempty"`
 
 	// InternetGatewayID is the id of the internet gateway associated with the VPC.
@@ -228,6 +465,14 @@ type VPCSpec struct {
 	// +kubebuilder:default=Ordered
 	// +kubebuilder:validation:Enum=Ordered;Random
 	AvailabilityZoneSelection *AZSelectionScheme `json:"availabilityZoneSelection,omitempty"`
+
+	// Tenancy is the tenancy of instances launched into a managed VPC. When set to dedicated,
+	// all instances in the VPC run on single-tenant hardware regardless of their own tenancy.
+	// Tenancy can only be set when the VPC is created.
+	// Defaults to default
+	// +kubebuilder:validation:Enum=default;dedicated
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
 }
 
 // String returns a string representation of the VPC.
@@ -618,9 +863,45 @@ type Instance struct {
 	// +optional
 	RootVolume *RootVolume `json:"rootVolume,omitempty"`
 
+	// Indicates whether the instance is configured for hibernation.
+	// +optional
+	Hibernation bool `json:"hibernation,omitempty"`
+
+	// The behavior of the instance when its operating system halts.
+	// +optional
+	ShutdownBehavior ShutdownBehavior `json:"shutdownBehavior,omitempty"`
+
+	// Indicates whether detailed monitoring is enabled on the instance.
+	// +optional
+	DetailedMonitoring bool `json:"detailedMonitoring,omitempty"`
+
+	// The CPU options of the instance.
+	// +optional
+	CPUOptions *CPUOptionsSpec `json:"cpuOptions,omitempty"`
+
+	// The CPU credit option of a burstable instance.
+	// +optional
+	CreditSpecification string `json:"creditSpecification,omitempty"`
+
+	// The instance store volumes mapped to block devices of the instance.
+	// +optional
+	EphemeralStorage []EphemeralStorageSpec `json:"ephemeralStorage,omitempty"`
+
+	// Indicates whether the instance is enabled for AWS Nitro Enclaves.
+	// +optional
+	NitroEnclavesEnabled bool `json:"nitroEnclavesEnabled,omitempty"`
+
 	// Specifies ENIs attached to instance
 	NetworkInterfaces []string `json:"networkInterfaces,omitempty"`
 
+	// The launch template the instance is launched from.
+	// +optional
+	LaunchTemplate *LaunchTemplateRef `json:"launchTemplate,omitempty"`
+
+	// The name of the placement group the instance is launched in.
+	// +optional
+	PlacementGroupName string `json:"placementGroupName,omitempty"`
+
 	// The tags associated with the instance.
 	Tags map[string]string `json:"tags,omitempty"`
 
@@ -653,3 +934,562 @@ type RootVolume struct {
 	// +optional
 	EncryptionKey string `json:"encryptionKey,omitempty"`
 }
+
+// FISTemplateSpec defines an AWS Fault Injection Simulator experiment template.
+type FISTemplateSpec struct {
+	// Name identifies the experiment template within the cluster.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description is the description of the experiment template.
+	// +kubebuilder:validation:MinLength=1
+	Description string `json:"description"`
+
+	// RoleARN is the ARN of the IAM role FIS assumes to run the experiment's actions.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:iam::[0-9]{12}:role/.+$`
+	RoleARN string `json:"roleARN"`
+
+	// Targets are the resources the experiment's actions run on.
+	// +kubebuilder:validation:MinItems=1
+	Targets []FISTargetSpec `json:"targets"`
+
+	// Actions are the actions run by the experiment.
+	// +kubebuilder:validation:MinItems=1
+	Actions []FISActionSpec `json:"actions"`
+}
+
+// FISTargetSpec defines the resources targeted by the actions of a FIS experiment.
+type FISTargetSpec struct {
+	// Name identifies the target within the experiment template.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// ResourceType is the FIS resource type of the target, e.g. aws:ec2:instance.
+	// +kubebuilder:validation:MinLength=1
+	ResourceType string `json:"resourceType"`
+
+	// SelectionMode is how targets are chosen among the matching resources:
+	// ALL, COUNT(n) or PERCENT(n). Defaults to ALL.
+	// +optional
+	SelectionMode string `json:"selectionMode,omitempty"`
+
+	// ResourceARNs are the ARNs of the targeted resources.
+	// +optional
+	ResourceARNs []string `json:"resourceARNs,omitempty"`
+
+	// ResourceTags select the targeted resources by tag. When neither
+	// ResourceARNs nor ResourceTags are set, the resources owned by the
+	// cluster are targeted.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty"`
+}
+
+// FISActionSpec defines an action of a FIS experiment.
+type FISActionSpec struct {
+	// Name identifies the action within the experiment template.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// ActionID is the ID of the FIS action, e.g. aws:ec2:terminate-instances.
+	// +kubebuilder:validation:MinLength=1
+	ActionID string `json:"actionID"`
+
+	// Parameters are the parameters of the action.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Targets maps the target types of the action, e.g. Instances, to the
+	// names of targets of the experiment template.
+	// +optional
+	Targets map[string]string `json:"targets,omitempty"`
+
+	// StartAfter are the names of the actions that must complete before this action starts.
+	// +optional
+	StartAfter []string `json:"startAfter,omitempty"`
+}
+
+// AutoExpandSpec configures the automatic expansion of a root volume.
+type AutoExpandSpec struct {
+	// ThresholdPercent is the disk usage of the root filesystem, in percent,
+	// above which the root volume is grown by 20%.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	ThresholdPercent int `json:"thresholdPercent"`
+
+	// MaxSizeGiB is the size (in Gi) the root volume is never grown beyond.
+	// +kubebuilder:validation:Minimum=8
+	MaxSizeGiB int `json:"maxSizeGiB"`
+}
+
+// CPUOptionsSpec configures the CPU cores and threads of an instance.
+type CPUOptionsSpec struct {
+	// CoreCount is the number of CPU cores of the instance. It must be one
+	// of the core counts supported by the instance type.
+	// +kubebuilder:validation:Minimum=1
+	CoreCount int64 `json:"coreCount"`
+
+	// ThreadsPerCore is the number of threads per CPU core. Set to 1 to
+	// disable hyperthreading.
+	// +kubebuilder:validation:Enum=1;2
+	ThreadsPerCore int64 `json:"threadsPerCore"`
+}
+
+// EphemeralStorageSpec maps an instance store volume to a block device.
+type EphemeralStorageSpec struct {
+	// VirtualName is the name of the instance store volume, ephemeral0 for the first one.
+	// +kubebuilder:validation:Pattern=`^ephemeral[0-9]+$`
+	VirtualName string `json:"virtualName"`
+
+	// DeviceName is the block device the volume is exposed as, for example /dev/sdb.
+	// +kubebuilder:validation:MinLength=1
+	DeviceName string `json:"deviceName"`
+}
+
+// GPUSpec configures the NVIDIA GPU drivers installed on an instance.
+type GPUSpec struct {
+	// DriverVersion is the version of the NVIDIA driver to install, for example 450.80.02.
+	// The installer is expected at NVIDIA-Linux-x86_64-<DriverVersion>.run in the driver bucket.
+	// +kubebuilder:validation:MinLength=1
+	DriverVersion string `json:"driverVersion"`
+
+	// CUDAVersion is the version of the CUDA toolkit to install, for example 11.0.3.
+	// The installer is expected at cuda_<CUDAVersion>_linux.run in the driver bucket.
+	// When empty, only the driver is installed.
+	// +optional
+	CUDAVersion string `json:"cudaVersion,omitempty"`
+}
+
+// CloudProviderConfigSpec defines the cloud.conf file of a cloud-controller-manager.
+type CloudProviderConfigSpec struct {
+	// ClusterName is the ID of the cluster of the cloud provider. Defaults to the name of the cluster.
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Region is the region of the cluster. Defaults to the region of the AWSCluster.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// VpcID is the ID of the VPC of the cluster. Defaults to the VPC of the AWSCluster.
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the cloud provider manages.
+	// +optional
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+
+	// RoleARN is the ARN of the role the cloud provider assumes.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+}
+
+const (
+	// CPUCreditsStandard limits burstable instances to their accrued CPU credits.
+	CPUCreditsStandard = "standard"
+
+	// CPUCreditsUnlimited lets burstable instances burst beyond their accrued CPU credits.
+	CPUCreditsUnlimited = "unlimited"
+)
+
+// IsBurstableInstanceType returns true for instance types of the T families,
+// which have a CPU credit specification.
+func IsBurstableInstanceType(instanceType string) bool {
+	return len(instanceType) > 1 && instanceType[0] == 't' && instanceType[1] >= '0' && instanceType[1] <= '9'
+}
+
+// ENAExpressSpec configures ENA Express on the network interfaces of an instance.
+type ENAExpressSpec struct {
+	// Enabled enables ENA Express, which sends the TCP traffic between instances
+	// of the same availability zone over the AWS Scalable Reliable Datagram protocol.
+	Enabled bool `json:"enabled"`
+
+	// UDPEnabled also sends UDP traffic over ENA Express. It requires Enabled.
+	// +optional
+	UDPEnabled bool `json:"udpEnabled,omitempty"`
+}
+
+// xenInstanceFamilies are the instance families running on the Xen hypervisor
+// instead of the Nitro System.
+var xenInstanceFamilies = sets.NewString(
+	"c1", "c3", "c4", "cc2", "cr1", "d2", "f1", "g2", "g3", "g3s", "h1", "hs1",
+	"i2", "i3", "m1", "m2", "m3", "m4", "p2", "p3", "r3", "r4", "t1", "t2", "x1", "x1e",
+)
+
+// IsNitroInstanceType returns true for instance types built on the Nitro System.
+// Whether a Nitro instance type supports a given feature still depends on its size.
+func IsNitroInstanceType(instanceType string) bool {
+	family := strings.SplitN(instanceType, ".", 2)[0]
+	return family != "" && !xenInstanceFamilies.Has(family)
+}
+
+// WebhookFailurePolicy defines how errors calling a webhook are handled.
+type WebhookFailurePolicy string
+
+var (
+	// WebhookFailurePolicyIgnore ignores errors calling the webhook and proceeds.
+	WebhookFailurePolicyIgnore = WebhookFailurePolicy("Ignore")
+
+	// WebhookFailurePolicyFail blocks the operation until the webhook succeeds.
+	WebhookFailurePolicyFail = WebhookFailurePolicy("Fail")
+)
+
+// WebhookSpec defines an HTTP endpoint the controller calls at a given point of the machine lifecycle.
+type WebhookSpec struct {
+	// URL is the endpoint a JSON payload is POSTed to. The webhook must respond with a 200 status code.
+	URL string `json:"url"`
+
+	// TimeoutSeconds is the number of seconds to wait for the webhook to respond. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy defines how errors calling the webhook are handled. Defaults to Fail.
+	// +kubebuilder:validation:Enum=Ignore;Fail
+	// +optional
+	FailurePolicy WebhookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// ServiceAccountRoleSpec defines an IAM role assumed by a Kubernetes service account.
+type ServiceAccountRoleSpec struct {
+	// Namespace is the namespace of the service account.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// ServiceAccountName is the name of the service account.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// RoleName is the name of the IAM role.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	RoleName string `json:"roleName"`
+
+	// PolicyARNs are the ARNs of the managed policies attached to the role.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+}
+
+// CertificateAuthoritySpec defines the certificate authority of a cluster.
+type CertificateAuthoritySpec struct {
+	// PrivateCAARN is the ARN of an AWS Private CA (ACM PCA) which issues the
+	// etcd peer and client certificates of the cluster. The certificates are
+	// stored in the <cluster>-etcd-peer and <cluster>-apiserver-etcd-client
+	// Secrets and renewed 30 days before they expire.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:acm-pca:[^:]+:[0-9]{12}:certificate-authority/.+$`
+	// +optional
+	PrivateCAARN string `json:"privateCAARN,omitempty"`
+}
+
+// ClusterLifecycleEvent is a lifecycle transition of a cluster.
+// +kubebuilder:validation:Enum=created;deleted;ready;degraded
+type ClusterLifecycleEvent string
+
+var (
+	// ClusterLifecycleEventCreated is published when the cluster is first reconciled.
+	ClusterLifecycleEventCreated = ClusterLifecycleEvent("created")
+
+	// ClusterLifecycleEventDeleted is published once the resources of the cluster are deleted.
+	ClusterLifecycleEventDeleted = ClusterLifecycleEvent("deleted")
+
+	// ClusterLifecycleEventReady is published when the cluster becomes ready.
+	ClusterLifecycleEventReady = ClusterLifecycleEvent("ready")
+
+	// ClusterLifecycleEventDegraded is published when a ready cluster is no longer ready.
+	ClusterLifecycleEventDegraded = ClusterLifecycleEvent("degraded")
+)
+
+// SNSNotificationSpec defines the SNS topic the lifecycle events of a cluster are published to.
+type SNSNotificationSpec struct {
+	// TopicARN is the ARN of the SNS topic.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:sns:[^:]+:[0-9]{12}:.+$`
+	TopicARN string `json:"topicARN"`
+
+	// Events are the lifecycle events published to the topic. All events
+	// are published if empty.
+	// +optional
+	Events []ClusterLifecycleEvent `json:"events,omitempty"`
+}
+
+// EventBridgeSpec defines the EventBridge event bus the lifecycle events of
+// AWSMachines are sent to.
+type EventBridgeSpec struct {
+	// EventBusName is the name or ARN of the event bus.
+	// +kubebuilder:validation:MinLength=1
+	EventBusName string `json:"eventBusName"`
+
+	// DetailType is the detail type of the events, which rules can match on.
+	// Defaults to "AWSMachine Lifecycle Event".
+	// +optional
+	DetailType string `json:"detailType,omitempty"`
+}
+
+// RotationSpec defines how the node bootstrap token of a cluster is rotated.
+type RotationSpec struct {
+	// RotationLambdaARN is the ARN of a Lambda function rotating the secret
+	// holding the bootstrap token, which is responsible for creating the new
+	// token in the cluster. When empty, the controller regenerates the token
+	// through the Kubernetes API of the cluster.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:lambda:[^:]+:[0-9]{12}:function:.+$`
+	// +optional
+	RotationLambdaARN string `json:"rotationLambdaARN,omitempty"`
+
+	// RotationDays is the number of days between rotations of the token.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=365
+	RotationDays int64 `json:"rotationDays"`
+}
+
+// TerminationAlertSpec defines where the termination notifications of the
+// instances of a cluster are sent.
+type TerminationAlertSpec struct {
+	// EventBridgeRuleARN is the ARN of the EventBridge rule matching the
+	// termination notifications, set by the controller once the rule is created.
+	// +optional
+	EventBridgeRuleARN string `json:"eventBridgeRuleARN,omitempty"`
+
+	// TargetARN is the ARN of the SNS topic or SQS queue the notifications are
+	// sent to. Its resource policy must allow events.amazonaws.com to publish
+	// or send messages to it.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:(sns|sqs):[^:]+:[0-9]{12}:.+$`
+	TargetARN string `json:"targetARN"`
+}
+
+// ControllerOptions configures optional behaviours of the AWSCluster controller.
+type ControllerOptions struct {
+	// AggregateFailureMessages reports the failure messages of the cluster's
+	// AWSMachines, deduplicated and counted, in the MachinesHealthy condition
+	// of the AWSCluster.
+	// +optional
+	AggregateFailureMessages bool `json:"aggregateFailureMessages,omitempty"`
+}
+
+// KarpenterSpec defines the Karpenter installation of a cluster.
+type KarpenterSpec struct {
+	// Version is the version of Karpenter to install, the tag of its
+	// controller image, e.g. v0.27.0.
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+`
+	Version string `json:"version"`
+
+	// IAMRoleARN is the ARN of the IAM role of the nodes launched by
+	// Karpenter, which is added to the instance profile created for them.
+	// The controller must be allowed to pass the role.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:iam::[0-9]{12}:role/.+$`
+	IAMRoleARN string `json:"iamRoleARN"`
+}
+
+// KarpenterStatus reports the resources created for the Karpenter installation of a cluster.
+type KarpenterStatus struct {
+	// ControllerRoleARN is the ARN of the IAM role assumed by the Karpenter
+	// controller through IAM Roles for Service Accounts.
+	// +optional
+	ControllerRoleARN string `json:"controllerRoleARN,omitempty"`
+
+	// InstanceProfileName is the name of the instance profile of the nodes
+	// launched by Karpenter.
+	// +optional
+	InstanceProfileName string `json:"instanceProfileName,omitempty"`
+
+	// InterruptionQueueURL is the URL of the SQS queue receiving the
+	// interruption events of the nodes launched by Karpenter.
+	// +optional
+	InterruptionQueueURL string `json:"interruptionQueueURL,omitempty"`
+
+	// InterruptionQueueARN is the ARN of the interruption queue.
+	// +optional
+	InterruptionQueueARN string `json:"interruptionQueueARN,omitempty"`
+
+	// InstalledVersion is the version of Karpenter last applied to the cluster.
+	// +optional
+	InstalledVersion string `json:"installedVersion,omitempty"`
+}
+
+// NTHSpec defines the SQS queue of the AWS Node Termination Handler of a cluster.
+// Exactly one of QueueARN and CreateQueue must be set.
+type NTHSpec struct {
+	// QueueARN is the ARN of an existing SQS queue receiving the interruption
+	// events of the instances of the cluster, managed outside of the cluster.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:sqs:[^:]+:[0-9]{12}:.+$`
+	// +optional
+	QueueARN string `json:"queueARN,omitempty"`
+
+	// CreateQueue creates an SQS queue for the cluster, along with the
+	// EventBridge rules sending it the spot interruption, rebalance
+	// recommendation, scheduled change and Auto Scaling lifecycle events of
+	// the account. The queue and rules are deleted with the cluster.
+	// +optional
+	CreateQueue bool `json:"createQueue,omitempty"`
+}
+
+// SSMExportSpec defines the fields of the status of a cluster exported to the
+// AWS Systems Manager Parameter Store.
+type SSMExportSpec struct {
+	// PathPrefix is the path under which the parameters of the cluster are
+	// written, as <PathPrefix>/<cluster name>/<field path>.
+	// +kubebuilder:validation:Pattern=`^(/[a-zA-Z0-9_.-]+)+$`
+	PathPrefix string `json:"pathPrefix"`
+
+	// Parameters are the dotted JSON paths of the fields of the status to
+	// export, e.g. network.apiServerElb.dnsName. The network also holds the
+	// VPC and subnets of the cluster, e.g. network.vpc.id. Paths through lists
+	// export the values of all their items as a StringList parameter, e.g.
+	// network.subnets.id. Fields without a value are not exported.
+	// +kubebuilder:validation:MinItems=1
+	Parameters []string `json:"parameters"`
+}
+
+// DataSyncSpec defines the AWS DataSync task copying the data of persistent
+// volumes between two DataSync locations.
+type DataSyncSpec struct {
+	// SourceLocationARN is the ARN of the DataSync location the data is
+	// copied from.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:datasync:[^:]+:[0-9]{12}:location/.+$`
+	SourceLocationARN string `json:"sourceLocationARN"`
+
+	// DestinationLocationARN is the ARN of the DataSync location the data is
+	// copied to.
+	// +kubebuilder:validation:Pattern=`^arn:[^:]+:datasync:[^:]+:[0-9]{12}:location/.+$`
+	DestinationLocationARN string `json:"destinationLocationARN"`
+
+	// ScheduleCron is the AWS cron expression the task is run on after its
+	// first execution, e.g. "0 2 * * ? *" to run it every day at 2:00 UTC.
+	// DataSync doesn't run tasks more than once an hour.
+	// +optional
+	ScheduleCron string `json:"scheduleCron,omitempty"`
+
+	// InclFilters are the patterns of the paths copied by the task, e.g.
+	// /data/*. All the data of the source location is copied when empty.
+	// +optional
+	InclFilters []string `json:"inclFilters,omitempty"`
+}
+
+// BenchmarkSpec defines the benchmark of the network of a cluster.
+type BenchmarkSpec struct {
+	// Enabled runs the benchmark.
+	Enabled bool `json:"enabled"`
+
+	// TargetBandwidthGbps is the bandwidth in Gbit/s expected between two
+	// nodes, e.g. "9.5". The NetworkPerformance condition of the AWSCluster
+	// reports the performance as degraded when the measured bandwidth is
+	// lower. Quantities are used rather than floating point numbers, which
+	// CRDs don't support well.
+	// +optional
+	TargetBandwidthGbps *resource.Quantity `json:"targetBandwidthGbps,omitempty"`
+}
+
+// LaunchSnapshotStorageType is the kind of storage of launch snapshots.
+type LaunchSnapshotStorageType string
+
+const (
+	// LaunchSnapshotStorageConfigMap stores launch snapshots in ConfigMaps in the namespace of the machine.
+	LaunchSnapshotStorageConfigMap = LaunchSnapshotStorageType("ConfigMap")
+
+	// LaunchSnapshotStorageS3 stores launch snapshots as objects of an S3 bucket.
+	LaunchSnapshotStorageS3 = LaunchSnapshotStorageType("S3")
+)
+
+// LaunchSnapshotSpec defines where the launch snapshot of a machine is stored.
+type LaunchSnapshotSpec struct {
+	// StorageType is the kind of storage of the snapshot.
+	// +kubebuilder:validation:Enum=ConfigMap;S3
+	StorageType LaunchSnapshotStorageType `json:"storageType"`
+
+	// Name is the name of the ConfigMap, or the key of the S3 object, holding
+	// the snapshot. Defaults to the name of the AWSMachine suffixed with
+	// -launch-snapshot.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Bucket is the S3 bucket holding the snapshot. Required with the S3
+	// storage type.
+	// +optional
+	Bucket string `json:"bucket,omitempty"`
+}
+
+// LaunchTemplateRef references an EC2 launch template.
+type LaunchTemplateRef struct {
+	// ID is the ID of the launch template.
+	// +kubebuilder:validation:Pattern=`^lt-[0-9a-f]+$`
+	ID string `json:"id"`
+
+	// Version is the version of the launch template: a version number,
+	// $Latest or $Default. Defaults to the default version of the template.
+	// +kubebuilder:validation:Pattern=`^([0-9]+|\$Latest|\$Default)$`
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// OSType is the operating system of an instance.
+type OSType string
+
+const (
+	// OSTypeLinux is the operating system of Linux instances.
+	OSTypeLinux = OSType("Linux")
+
+	// OSTypeWindows is the operating system of Windows instances.
+	OSTypeWindows = OSType("Windows")
+)
+
+// ShutdownBehavior is what EC2 does with an instance when its operating system halts.
+type ShutdownBehavior string
+
+const (
+	// ShutdownBehaviorStop stops the instance, which preserves its volumes.
+	ShutdownBehaviorStop = ShutdownBehavior("stop")
+
+	// ShutdownBehaviorTerminate terminates the instance.
+	ShutdownBehaviorTerminate = ShutdownBehavior("terminate")
+)
+
+// FleetAllocationStrategy is the strategy an EC2 Fleet picks the instance type it launches with.
+type FleetAllocationStrategy string
+
+const (
+	// FleetAllocationStrategyLowestPrice launches the cheapest matching On-Demand instance type.
+	FleetAllocationStrategyLowestPrice = FleetAllocationStrategy("lowest-price")
+
+	// FleetAllocationStrategyDiversified launches a Spot Instance, spreading the instances of the fleet
+	// across the matching Spot capacity pools.
+	FleetAllocationStrategyDiversified = FleetAllocationStrategy("diversified")
+
+	// FleetAllocationStrategyCapacityOptimized launches a Spot Instance from the matching Spot capacity
+	// pool with the most available capacity, which is the least likely to be interrupted.
+	FleetAllocationStrategyCapacityOptimized = FleetAllocationStrategy("capacity-optimized")
+)
+
+const (
+	// ArchitectureX8664 is the architecture of 64-bit x86 instance types and images.
+	ArchitectureX8664 = "x86_64"
+
+	// ArchitectureARM64 is the architecture of 64-bit ARM instance types and images.
+	ArchitectureARM64 = "arm64"
+)
+
+// InstanceTypeRequirements are the attributes of the instance types an EC2 Fleet may launch.
+type InstanceTypeRequirements struct {
+	// MinVCPUs is the minimum number of vCPUs of the instance types.
+	// +kubebuilder:validation:Minimum=1
+	MinVCPUs int64 `json:"minVCPUs"`
+
+	// MaxVCPUs is the maximum number of vCPUs of the instance types.
+	// There is no maximum when unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxVCPUs *int64 `json:"maxVCPUs,omitempty"`
+
+	// MinMemoryMiB is the minimum amount of memory of the instance types, in MiB.
+	// +kubebuilder:validation:Minimum=0
+	MinMemoryMiB int64 `json:"minMemoryMiB"`
+
+	// MaxMemoryMiB is the maximum amount of memory of the instance types, in MiB.
+	// There is no maximum when unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxMemoryMiB *int64 `json:"maxMemoryMiB,omitempty"`
+
+	// Architecture is the CPU architecture of the instance types. The fleet
+	// only launches instance types of the architecture of the AMI, which must
+	// match. Defaults to x86_64.
+	// +kubebuilder:validation:Enum=x86_64;arm64
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+}
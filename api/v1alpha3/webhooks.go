@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"net/url"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -33,3 +35,17 @@ func aggregateObjErrors(gk schema.GroupKind, name string, allErrs field.ErrorLis
 		allErrs,
 	)
 }
+
+func validateWebhookSpec(spec *WebhookSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec == nil {
+		return allErrs
+	}
+
+	if u, err := url.Parse(spec.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(path.Child("url"), spec.URL, "must be an absolute http or https URL"))
+	}
+
+	return allErrs
+}
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/errors"
@@ -95,6 +96,11 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		**out = **in
 	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	if in.AdditionalControlPlaneEndpoints != nil {
+		in, out := &in.AdditionalControlPlaneEndpoints, &out.AdditionalControlPlaneEndpoints
+		*out = make([]apiv1alpha3.APIEndpoint, len(*in))
+		copy(*out, *in)
+	}
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
 		*out = make(Tags, len(*in))
@@ -107,7 +113,92 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(AWSLoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceCatalogRef != nil {
+		in, out := &in.ServiceCatalogRef, &out.ServiceCatalogRef
+		*out = new(ServiceCatalogRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformStateImport != nil {
+		in, out := &in.TerraformStateImport, &out.TerraformStateImport
+		*out = new(TFStateImportSpec)
+		**out = **in
+	}
 	out.Bastion = in.Bastion
+	in.SecuritySpec.DeepCopyInto(&out.SecuritySpec)
+	if in.FISExperimentTemplates != nil {
+		in, out := &in.FISExperimentTemplates, &out.FISExperimentTemplates
+		*out = make([]FISTemplateSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccountRole != nil {
+		in, out := &in.ServiceAccountRole, &out.ServiceAccountRole
+		*out = make([]ServiceAccountRoleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(CertificateAuthoritySpec)
+		**out = **in
+	}
+	if in.LifecycleNotifications != nil {
+		in, out := &in.LifecycleNotifications, &out.LifecycleNotifications
+		*out = new(SNSNotificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventBridge != nil {
+		in, out := &in.EventBridge, &out.EventBridge
+		*out = new(EventBridgeSpec)
+		**out = **in
+	}
+	if in.BootstrapTokenRotation != nil {
+		in, out := &in.BootstrapTokenRotation, &out.BootstrapTokenRotation
+		*out = new(RotationSpec)
+		**out = **in
+	}
+	if in.TerminationAlerts != nil {
+		in, out := &in.TerminationAlerts, &out.TerminationAlerts
+		*out = new(TerminationAlertSpec)
+		**out = **in
+	}
+	if in.CostAllocationTags != nil {
+		in, out := &in.CostAllocationTags, &out.CostAllocationTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterSpec)
+		**out = **in
+	}
+	if in.NodeTerminationHandler != nil {
+		in, out := &in.NodeTerminationHandler, &out.NodeTerminationHandler
+		*out = new(NTHSpec)
+		**out = **in
+	}
+	if in.SSMParameterExport != nil {
+		in, out := &in.SSMParameterExport, &out.SSMParameterExport
+		*out = new(SSMExportSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataSync != nil {
+		in, out := &in.DataSync, &out.DataSync
+		*out = new(DataSyncSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkBenchmark != nil {
+		in, out := &in.NetworkBenchmark, &out.NetworkBenchmark
+		*out = new(BenchmarkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControllerOptions != nil {
+		in, out := &in.ControllerOptions, &out.ControllerOptions
+		*out = new(ControllerOptions)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -143,6 +234,48 @@ func (in *AWSClusterStatus) DeepCopyInto(out *AWSClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.QuotaStatus != nil {
+		in, out := &in.QuotaStatus, &out.QuotaStatus
+		*out = make([]QuotaUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QuotaCheckedAt != nil {
+		in, out := &in.QuotaCheckedAt, &out.QuotaCheckedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ConfigConformanceStatus != nil {
+		in, out := &in.ConfigConformanceStatus, &out.ConfigConformanceStatus
+		*out = make([]ConformancePackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FISExperimentTemplateIDs != nil {
+		in, out := &in.FISExperimentTemplateIDs, &out.FISExperimentTemplateIDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAccountRoles != nil {
+		in, out := &in.ServiceAccountRoles, &out.ServiceAccountRoles
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterStatus)
+		**out = **in
+	}
+	if in.SSMParameters != nil {
+		in, out := &in.SSMParameters, &out.SSMParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterStatus.
@@ -235,98 +368,85 @@ func (in *AWSMachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
+func (in *AWSMachineRemediation) DeepCopyInto(out *AWSMachineRemediation) {
 	*out = *in
-	if in.ProviderID != nil {
-		in, out := &in.ProviderID, &out.ProviderID
-		*out = new(string)
-		**out = **in
-	}
-	in.AMI.DeepCopyInto(&out.AMI)
-	if in.AdditionalTags != nil {
-		in, out := &in.AdditionalTags, &out.AdditionalTags
-		*out = make(Tags, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediation.
+func (in *AWSMachineRemediation) DeepCopy() *AWSMachineRemediation {
+	if in == nil {
+		return nil
 	}
-	if in.PublicIP != nil {
-		in, out := &in.PublicIP, &out.PublicIP
-		*out = new(bool)
-		**out = **in
+	out := new(AWSMachineRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSMachineRemediation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.AdditionalSecurityGroups != nil {
-		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
-		*out = make([]AWSResourceReference, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineRemediationList) DeepCopyInto(out *AWSMachineRemediationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSMachineRemediation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.FailureDomain != nil {
-		in, out := &in.FailureDomain, &out.FailureDomain
-		*out = new(string)
-		**out = **in
-	}
-	if in.Subnet != nil {
-		in, out := &in.Subnet, &out.Subnet
-		*out = new(AWSResourceReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SSHKeyName != nil {
-		in, out := &in.SSHKeyName, &out.SSHKeyName
-		*out = new(string)
-		**out = **in
-	}
-	if in.RootVolume != nil {
-		in, out := &in.RootVolume, &out.RootVolume
-		*out = new(RootVolume)
-		**out = **in
-	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationList.
+func (in *AWSMachineRemediationList) DeepCopy() *AWSMachineRemediationList {
+	if in == nil {
+		return nil
 	}
-	if in.UncompressedUserData != nil {
-		in, out := &in.UncompressedUserData, &out.UncompressedUserData
-		*out = new(bool)
-		**out = **in
+	out := new(AWSMachineRemediationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSMachineRemediationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out.CloudInit = in.CloudInit
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
-func (in *AWSMachineSpec) DeepCopy() *AWSMachineSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineRemediationSpec) DeepCopyInto(out *AWSMachineRemediationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationSpec.
+func (in *AWSMachineRemediationSpec) DeepCopy() *AWSMachineRemediationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AWSMachineSpec)
+	out := new(AWSMachineRemediationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
+func (in *AWSMachineRemediationStatus) DeepCopyInto(out *AWSMachineRemediationStatus) {
 	*out = *in
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]apiv1alpha3.MachineAddress, len(*in))
-		copy(*out, *in)
-	}
-	if in.InstanceState != nil {
-		in, out := &in.InstanceState, &out.InstanceState
-		*out = new(InstanceState)
-		**out = **in
-	}
-	if in.FailureReason != nil {
-		in, out := &in.FailureReason, &out.FailureReason
-		*out = new(errors.MachineStatusError)
-		**out = **in
-	}
-	if in.FailureMessage != nil {
-		in, out := &in.FailureMessage, &out.FailureMessage
-		*out = new(string)
-		**out = **in
+	if in.LastRemediated != nil {
+		in, out := &in.LastRemediated, &out.LastRemediated
+		*out = (*in).DeepCopy()
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -337,36 +457,36 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineStatus.
-func (in *AWSMachineStatus) DeepCopy() *AWSMachineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationStatus.
+func (in *AWSMachineRemediationStatus) DeepCopy() *AWSMachineRemediationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AWSMachineStatus)
+	out := new(AWSMachineRemediationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSMachineTemplate) DeepCopyInto(out *AWSMachineTemplate) {
+func (in *AWSMachineRemediationTemplate) DeepCopyInto(out *AWSMachineRemediationTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplate.
-func (in *AWSMachineTemplate) DeepCopy() *AWSMachineTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationTemplate.
+func (in *AWSMachineRemediationTemplate) DeepCopy() *AWSMachineRemediationTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(AWSMachineTemplate)
+	out := new(AWSMachineRemediationTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AWSMachineTemplate) DeepCopyObject() runtime.Object {
+func (in *AWSMachineRemediationTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -374,31 +494,31 @@ func (in *AWSMachineTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSMachineTemplateList) DeepCopyInto(out *AWSMachineTemplateList) {
+func (in *AWSMachineRemediationTemplateList) DeepCopyInto(out *AWSMachineRemediationTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AWSMachineTemplate, len(*in))
+		*out = make([]AWSMachineRemediationTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateList.
-func (in *AWSMachineTemplateList) DeepCopy() *AWSMachineTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationTemplateList.
+func (in *AWSMachineRemediationTemplateList) DeepCopy() *AWSMachineRemediationTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(AWSMachineTemplateList)
+	out := new(AWSMachineRemediationTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AWSMachineTemplateList) DeepCopyObject() runtime.Object {
+func (in *AWSMachineRemediationTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -406,552 +526,1774 @@ func (in *AWSMachineTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSMachineTemplateResource) DeepCopyInto(out *AWSMachineTemplateResource) {
+func (in *AWSMachineRemediationTemplateResource) DeepCopyInto(out *AWSMachineRemediationTemplateResource) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateResource.
-func (in *AWSMachineTemplateResource) DeepCopy() *AWSMachineTemplateResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationTemplateResource.
+func (in *AWSMachineRemediationTemplateResource) DeepCopy() *AWSMachineRemediationTemplateResource {
 	if in == nil {
 		return nil
 	}
-	out := new(AWSMachineTemplateResource)
+	out := new(AWSMachineRemediationTemplateResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSMachineTemplateSpec) DeepCopyInto(out *AWSMachineTemplateSpec) {
+func (in *AWSMachineRemediationTemplateSpec) DeepCopyInto(out *AWSMachineRemediationTemplateSpec) {
 	*out = *in
-	in.Template.DeepCopyInto(&out.Template)
+	out.Template = in.Template
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateSpec.
-func (in *AWSMachineTemplateSpec) DeepCopy() *AWSMachineTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineRemediationTemplateSpec.
+func (in *AWSMachineRemediationTemplateSpec) DeepCopy() *AWSMachineRemediationTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AWSMachineTemplateSpec)
+	out := new(AWSMachineRemediationTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AWSResourceReference) DeepCopyInto(out *AWSResourceReference) {
+func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
+	in.AMI.DeepCopyInto(&out.AMI)
+	if in.LaunchTemplateRef != nil {
+		in, out := &in.LaunchTemplateRef, &out.LaunchTemplateRef
+		*out = new(LaunchTemplateRef)
 		**out = **in
 	}
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = make([]Filter, len(*in))
+	if in.InstanceTypeRequirements != nil {
+		in, out := &in.InstanceTypeRequirements, &out.InstanceTypeRequirements
+		*out = new(InstanceTypeRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalSecurityGroups != nil {
+		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
+		*out = make([]AWSResourceReference, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSResourceReference.
-func (in *AWSResourceReference) DeepCopy() *AWSResourceReference {
-	if in == nil {
-		return nil
-	}
-	out := new(AWSResourceReference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Bastion) DeepCopyInto(out *Bastion) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
-func (in *Bastion) DeepCopy() *Bastion {
-	if in == nil {
-		return nil
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
 	}
-	out := new(Bastion)
-	in.DeepCopyInto(out)
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHKeyName != nil {
+		in, out := &in.SSHKeyName, &out.SSHKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(RootVolume)
+		**out = **in
+	}
+	if in.AutoExpandRootDisk != nil {
+		in, out := &in.AutoExpandRootDisk, &out.AutoExpandRootDisk
+		*out = new(AutoExpandSpec)
+		**out = **in
+	}
+	if in.ENAExpressSettings != nil {
+		in, out := &in.ENAExpressSettings, &out.ENAExpressSettings
+		*out = new(ENAExpressSpec)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptionsSpec)
+		**out = **in
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(GPUSpec)
+		**out = **in
+	}
+	if in.CloudProviderConfig != nil {
+		in, out := &in.CloudProviderConfig, &out.CloudProviderConfig
+		*out = new(CloudProviderConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		*out = make([]EphemeralStorageSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UncompressedUserData != nil {
+		in, out := &in.UncompressedUserData, &out.UncompressedUserData
+		*out = new(bool)
+		**out = **in
+	}
+	out.CloudInit = in.CloudInit
+	if in.PreTerminationHook != nil {
+		in, out := &in.PreTerminationHook, &out.PreTerminationHook
+		*out = new(WebhookSpec)
+		**out = **in
+	}
+	if in.PostProvisionHook != nil {
+		in, out := &in.PostProvisionHook, &out.PostProvisionHook
+		*out = new(WebhookSpec)
+		**out = **in
+	}
+	if in.TagSyncLabelSelector != nil {
+		in, out := &in.TagSyncLabelSelector, &out.TagSyncLabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinAvailableIPs != nil {
+		in, out := &in.MinAvailableIPs, &out.MinAvailableIPs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LaunchSnapshot != nil {
+		in, out := &in.LaunchSnapshot, &out.LaunchSnapshot
+		*out = new(LaunchSnapshotSpec)
+		**out = **in
+	}
+	if in.SecureDeleteTimeout != nil {
+		in, out := &in.SecureDeleteTimeout, &out.SecureDeleteTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
+func (in *AWSMachineSpec) DeepCopy() *AWSMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]apiv1alpha3.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceState != nil {
+		in, out := &in.InstanceState, &out.InstanceState
+		*out = new(InstanceState)
+		**out = **in
+	}
+	if in.AMIID != nil {
+		in, out := &in.AMIID, &out.AMIID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageBuildVersionARN != nil {
+		in, out := &in.ImageBuildVersionARN, &out.ImageBuildVersionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.EBSBaselineBandwidthMbps != nil {
+		in, out := &in.EBSBaselineBandwidthMbps, &out.EBSBaselineBandwidthMbps
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SelectedFailureDomain != nil {
+		in, out := &in.SelectedFailureDomain, &out.SelectedFailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FleetID != nil {
+		in, out := &in.FleetID, &out.FleetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VulnerabilityFindings != nil {
+		in, out := &in.VulnerabilityFindings, &out.VulnerabilityFindings
+		*out = make([]VulnerabilityFinding, len(*in))
+		copy(*out, *in)
+	}
+	if in.VulnerabilitiesCheckedAt != nil {
+		in, out := &in.VulnerabilitiesCheckedAt, &out.VulnerabilitiesCheckedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncedTags != nil {
+		in, out := &in.SyncedTags, &out.SyncedTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecureDeleteInstanceID != nil {
+		in, out := &in.SecureDeleteInstanceID, &out.SecureDeleteInstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecureDeleteVolumeIDs != nil {
+		in, out := &in.SecureDeleteVolumeIDs, &out.SecureDeleteVolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv4Prefixes != nil {
+		in, out := &in.IPv4Prefixes, &out.IPv4Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(errors.MachineStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1alpha3.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineStatus.
+func (in *AWSMachineStatus) DeepCopy() *AWSMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineTemplate) DeepCopyInto(out *AWSMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplate.
+func (in *AWSMachineTemplate) DeepCopy() *AWSMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineTemplateList) DeepCopyInto(out *AWSMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateList.
+func (in *AWSMachineTemplateList) DeepCopy() *AWSMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineTemplateResource) DeepCopyInto(out *AWSMachineTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateResource.
+func (in *AWSMachineTemplateResource) DeepCopy() *AWSMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMachineTemplateSpec) DeepCopyInto(out *AWSMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateSpec.
+func (in *AWSMachineTemplateSpec) DeepCopy() *AWSMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSResourceReference) DeepCopyInto(out *AWSResourceReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSResourceReference.
+func (in *AWSResourceReference) DeepCopy() *AWSResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoExpandSpec) DeepCopyInto(out *AutoExpandSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoExpandSpec.
+func (in *AutoExpandSpec) DeepCopy() *AutoExpandSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoExpandSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bastion) DeepCopyInto(out *Bastion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
+func (in *Bastion) DeepCopy() *Bastion {
+	if in == nil {
+		return nil
+	}
+	out := new(Bastion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BenchmarkSpec) DeepCopyInto(out *BenchmarkSpec) {
+	*out = *in
+	if in.TargetBandwidthGbps != nil {
+		in, out := &in.TargetBandwidthGbps, &out.TargetBandwidthGbps
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BenchmarkSpec.
+func (in *BenchmarkSpec) DeepCopy() *BenchmarkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BenchmarkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildParams) DeepCopyInto(out *BuildParams) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.Additional != nil {
+		in, out := &in.Additional, &out.Additional
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildParams.
+func (in *BuildParams) DeepCopy() *BuildParams {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CFStackRef) DeepCopyInto(out *CFStackRef) {
+	*out = *in
+	if in.OutputKeyMappings != nil {
+		in, out := &in.OutputKeyMappings, &out.OutputKeyMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CFStackRef.
+func (in *CFStackRef) DeepCopy() *CFStackRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CFStackRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNIIngressRule) DeepCopyInto(out *CNIIngressRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRule.
+func (in *CNIIngressRule) DeepCopy() *CNIIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in CNIIngressRules) DeepCopyInto(out *CNIIngressRules) {
+	{
+		in := &in
+		*out = make(CNIIngressRules, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CNIIngressRule)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRules.
+func (in CNIIngressRules) DeepCopy() CNIIngressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(CNIIngressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNISpec) DeepCopyInto(out *CNISpec) {
+	*out = *in
+	if in.CNIIngressRules != nil {
+		in, out := &in.CNIIngressRules, &out.CNIIngressRules
+		*out = make(CNIIngressRules, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CNIIngressRule)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNISpec.
+func (in *CNISpec) DeepCopy() *CNISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CNISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUOptionsSpec) DeepCopyInto(out *CPUOptionsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptionsSpec.
+func (in *CPUOptionsSpec) DeepCopy() *CPUOptionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUOptionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthoritySpec) DeepCopyInto(out *CertificateAuthoritySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthoritySpec.
+func (in *CertificateAuthoritySpec) DeepCopy() *CertificateAuthoritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthoritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClassicELB) DeepCopyInto(out *ClassicELB) {
+	*out = *in
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]*ClassicELBListener, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ClassicELBListener)
+				**out = **in
+			}
+		}
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ClassicELBHealthCheck)
+		**out = **in
+	}
+	out.Attributes = in.Attributes
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELB.
+func (in *ClassicELB) DeepCopy() *ClassicELB {
+	if in == nil {
+		return nil
+	}
+	out := new(ClassicELB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBAttributes.
+func (in *ClassicELBAttributes) DeepCopy() *ClassicELBAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ClassicELBAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClassicELBHealthCheck) DeepCopyInto(out *ClassicELBHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBHealthCheck.
+func (in *ClassicELBHealthCheck) DeepCopy() *ClassicELBHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ClassicELBHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClassicELBListener) DeepCopyInto(out *ClassicELBListener) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBListener.
+func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
+	if in == nil {
+		return nil
+	}
+	out := new(ClassicELBListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInit) DeepCopyInto(out *CloudInit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
+func (in *CloudInit) DeepCopy() *CloudInit {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderConfigSpec) DeepCopyInto(out *CloudProviderConfigSpec) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderConfigSpec.
+func (in *CloudProviderConfigSpec) DeepCopy() *CloudProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConformancePackStatus) DeepCopyInto(out *ConformancePackStatus) {
+	*out = *in
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConformancePackStatus.
+func (in *ConformancePackStatus) DeepCopy() *ConformancePackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConformancePackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerOptions) DeepCopyInto(out *ControllerOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerOptions.
+func (in *ControllerOptions) DeepCopy() *ControllerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSyncSpec) DeepCopyInto(out *DataSyncSpec) {
+	*out = *in
+	if in.InclFilters != nil {
+		in, out := &in.InclFilters, &out.InclFilters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSyncSpec.
+func (in *DataSyncSpec) DeepCopy() *DataSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EIPPoolSpec) DeepCopyInto(out *EIPPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EIPPoolSpec.
+func (in *EIPPoolSpec) DeepCopy() *EIPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EIPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENAExpressSpec) DeepCopyInto(out *ENAExpressSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENAExpressSpec.
+func (in *ENAExpressSpec) DeepCopy() *ENAExpressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ENAExpressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointPolicySpec) DeepCopyInto(out *EndpointPolicySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPolicySpec.
+func (in *EndpointPolicySpec) DeepCopy() *EndpointPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralStorageSpec) DeepCopyInto(out *EphemeralStorageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralStorageSpec.
+func (in *EphemeralStorageSpec) DeepCopy() *EphemeralStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBridgeSpec) DeepCopyInto(out *EventBridgeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBridgeSpec.
+func (in *EventBridgeSpec) DeepCopy() *EventBridgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBridgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FISActionSpec) DeepCopyInto(out *FISActionSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StartAfter != nil {
+		in, out := &in.StartAfter, &out.StartAfter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FISActionSpec.
+func (in *FISActionSpec) DeepCopy() *FISActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FISActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FISTargetSpec) DeepCopyInto(out *FISTargetSpec) {
+	*out = *in
+	if in.ResourceARNs != nil {
+		in, out := &in.ResourceARNs, &out.ResourceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FISTargetSpec.
+func (in *FISTargetSpec) DeepCopy() *FISTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FISTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FISTemplateSpec) DeepCopyInto(out *FISTemplateSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]FISTargetSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]FISActionSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FISTemplateSpec.
+func (in *FISTemplateSpec) DeepCopy() *FISTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FISTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUSpec) DeepCopyInto(out *GPUSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUSpec.
+func (in *GPUSpec) DeepCopy() *GPUSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressRule) DeepCopyInto(out *IngressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSecurityGroupIDs != nil {
+		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
+func (in *IngressRule) DeepCopy() *IngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in IngressRules) DeepCopyInto(out *IngressRules) {
+	{
+		in := &in
+		*out = make(IngressRules, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IngressRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRules.
+func (in IngressRules) DeepCopy() IngressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	if in.SSHKeyName != nil {
+		in, out := &in.SSHKeyName, &out.SSHKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]apiv1alpha3.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.ENASupport != nil {
+		in, out := &in.ENASupport, &out.ENASupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(RootVolume)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptionsSpec)
+		**out = **in
+	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		*out = make([]EphemeralStorageSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LaunchTemplate != nil {
+		in, out := &in.LaunchTemplate, &out.LaunchTemplate
+		*out = new(LaunchTemplateRef)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceConnectEndpoint) DeepCopyInto(out *InstanceConnectEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceConnectEndpoint.
+func (in *InstanceConnectEndpoint) DeepCopy() *InstanceConnectEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceConnectEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceConnectEndpointSpec) DeepCopyInto(out *InstanceConnectEndpointSpec) {
+	*out = *in
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceConnectEndpointSpec.
+func (in *InstanceConnectEndpointSpec) DeepCopy() *InstanceConnectEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceConnectEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTypeRequirements) DeepCopyInto(out *InstanceTypeRequirements) {
+	*out = *in
+	if in.MaxVCPUs != nil {
+		in, out := &in.MaxVCPUs, &out.MaxVCPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxMemoryMiB != nil {
+		in, out := &in.MaxMemoryMiB, &out.MaxMemoryMiB
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTypeRequirements.
+func (in *InstanceTypeRequirements) DeepCopy() *InstanceTypeRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTypeRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterSpec) DeepCopyInto(out *KarpenterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterSpec.
+func (in *KarpenterSpec) DeepCopy() *KarpenterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterSpec)
+	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BuildParams) DeepCopyInto(out *BuildParams) {
+func (in *KarpenterStatus) DeepCopyInto(out *KarpenterStatus) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.Role != nil {
-		in, out := &in.Role, &out.Role
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterStatus.
+func (in *KarpenterStatus) DeepCopy() *KarpenterStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Additional != nil {
-		in, out := &in.Additional, &out.Additional
-		*out = make(Tags, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out := new(KarpenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchSnapshotSpec) DeepCopyInto(out *LaunchSnapshotSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchSnapshotSpec.
+func (in *LaunchSnapshotSpec) DeepCopy() *LaunchSnapshotSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(LaunchSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildParams.
-func (in *BuildParams) DeepCopy() *BuildParams {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateRef) DeepCopyInto(out *LaunchTemplateRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateRef.
+func (in *LaunchTemplateRef) DeepCopy() *LaunchTemplateRef {
 	if in == nil {
 		return nil
 	}
-	out := new(BuildParams)
+	out := new(LaunchTemplateRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNIIngressRule) DeepCopyInto(out *CNIIngressRule) {
+func (in *NTHSpec) DeepCopyInto(out *NTHSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRule.
-func (in *CNIIngressRule) DeepCopy() *CNIIngressRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTHSpec.
+func (in *NTHSpec) DeepCopy() *NTHSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CNIIngressRule)
+	out := new(NTHSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in CNIIngressRules) DeepCopyInto(out *CNIIngressRules) {
-	{
-		in := &in
-		*out = make(CNIIngressRules, len(*in))
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make(map[SecurityGroupRole]SecurityGroup, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
+	if in.AdditionalAPIServerELBs != nil {
+		in, out := &in.AdditionalAPIServerELBs, &out.AdditionalAPIServerELBs
+		*out = make([]ClassicELB, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemoteRegion != nil {
+		in, out := &in.RemoteRegion, &out.RemoteRegion
+		*out = new(RemoteRegionStatus)
+		**out = **in
+	}
+	if in.InstanceConnectEndpoint != nil {
+		in, out := &in.InstanceConnectEndpoint, &out.InstanceConnectEndpoint
+		*out = new(InstanceConnectEndpoint)
+		**out = **in
+	}
+	if in.PreallocatedEIPs != nil {
+		in, out := &in.PreallocatedEIPs, &out.PreallocatedEIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(VPCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(Subnets, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(CNIIngressRule)
-				**out = **in
+				*out = new(SubnetSpec)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIIngressRules.
-func (in CNIIngressRules) DeepCopy() CNIIngressRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
 	if in == nil {
 		return nil
 	}
-	out := new(CNIIngressRules)
+	out := new(Network)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CNISpec) DeepCopyInto(out *CNISpec) {
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
-	if in.CNIIngressRules != nil {
-		in, out := &in.CNIIngressRules, &out.CNIIngressRules
-		*out = make(CNIIngressRules, len(*in))
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(Subnets, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(CNIIngressRule)
-				**out = **in
+				*out = new(SubnetSpec)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNISpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteRegion != nil {
+		in, out := &in.RemoteRegion, &out.RemoteRegion
+		*out = new(RemoteRegionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAMShare != nil {
+		in, out := &in.RAMShare, &out.RAMShare
+		*out = new(RAMShareSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceConnectEndpoint != nil {
+		in, out := &in.InstanceConnectEndpoint, &out.InstanceConnectEndpoint
+		*out = new(InstanceConnectEndpointSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFormationStackRef != nil {
+		in, out := &in.CloudFormationStackRef, &out.CloudFormationStackRef
+		*out = new(CFStackRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupOverrides != nil {
+		in, out := &in.SecurityGroupOverrides, &out.SecurityGroupOverrides
+		*out = make(map[SecurityGroupRole]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = new(VPCEndpointsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EIPPool != nil {
+		in, out := &in.EIPPool, &out.EIPPool
+		*out = new(EIPPoolSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNISpec.
-func (in *CNISpec) DeepCopy() *CNISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CNISpec)
+	out := new(NetworkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELB) DeepCopyInto(out *ClassicELB) {
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
 	*out = *in
-	if in.AvailabilityZones != nil {
-		in, out := &in.AvailabilityZones, &out.AvailabilityZones
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.Value = in.Value.DeepCopy()
+	out.UsedValue = in.UsedValue.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
 	}
-	if in.SubnetIDs != nil {
-		in, out := &in.SubnetIDs, &out.SubnetIDs
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAMShareSpec) DeepCopyInto(out *RAMShareSpec) {
+	*out = *in
+	if in.PrincipalARNs != nil {
+		in, out := &in.PrincipalARNs, &out.PrincipalARNs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.SecurityGroupIDs != nil {
-		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAMShareSpec.
+func (in *RAMShareSpec) DeepCopy() *RAMShareSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Listeners != nil {
-		in, out := &in.Listeners, &out.Listeners
-		*out = make([]*ClassicELBListener, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ClassicELBListener)
-				**out = **in
-			}
-		}
+	out := new(RAMShareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileErrorCount) DeepCopyInto(out *ReconcileErrorCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileErrorCount.
+func (in *ReconcileErrorCount) DeepCopy() *ReconcileErrorCount {
+	if in == nil {
+		return nil
 	}
-	if in.HealthCheck != nil {
-		in, out := &in.HealthCheck, &out.HealthCheck
-		*out = new(ClassicELBHealthCheck)
-		**out = **in
+	out := new(ReconcileErrorCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileSummary) DeepCopyInto(out *ReconcileSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileSummary.
+func (in *ReconcileSummary) DeepCopy() *ReconcileSummary {
+	if in == nil {
+		return nil
 	}
-	out.Attributes = in.Attributes
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out := new(ReconcileSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReconcileSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileSummaryList) DeepCopyInto(out *ReconcileSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReconcileSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELB.
-func (in *ClassicELB) DeepCopy() *ClassicELB {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileSummaryList.
+func (in *ReconcileSummaryList) DeepCopy() *ReconcileSummaryList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELB)
+	out := new(ReconcileSummaryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReconcileSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
+func (in *ReconcileSummarySpec) DeepCopyInto(out *ReconcileSummarySpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBAttributes.
-func (in *ClassicELBAttributes) DeepCopy() *ClassicELBAttributes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileSummarySpec.
+func (in *ReconcileSummarySpec) DeepCopy() *ReconcileSummarySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBAttributes)
+	out := new(ReconcileSummarySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBHealthCheck) DeepCopyInto(out *ClassicELBHealthCheck) {
+func (in *ReconcileSummaryStatus) DeepCopyInto(out *ReconcileSummaryStatus) {
 	*out = *in
+	if in.AverageReconcileDuration != nil {
+		in, out := &in.AverageReconcileDuration, &out.AverageReconcileDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TopErrors != nil {
+		in, out := &in.TopErrors, &out.TopErrors
+		*out = make([]ReconcileErrorCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBHealthCheck.
-func (in *ClassicELBHealthCheck) DeepCopy() *ClassicELBHealthCheck {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileSummaryStatus.
+func (in *ReconcileSummaryStatus) DeepCopy() *ReconcileSummaryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBHealthCheck)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClassicELBListener) DeepCopyInto(out *ClassicELBListener) {
-	*out = *in
+	out := new(ReconcileSummaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteRegionSpec) DeepCopyInto(out *RemoteRegionSpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(Subnets, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetSpec)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicELBListener.
-func (in *ClassicELBListener) DeepCopy() *ClassicELBListener {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteRegionSpec.
+func (in *RemoteRegionSpec) DeepCopy() *RemoteRegionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClassicELBListener)
+	out := new(RemoteRegionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudInit) DeepCopyInto(out *CloudInit) {
+func (in *RemoteRegionStatus) DeepCopyInto(out *RemoteRegionStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInit.
-func (in *CloudInit) DeepCopy() *CloudInit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteRegionStatus.
+func (in *RemoteRegionStatus) DeepCopy() *RemoteRegionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudInit)
+	out := new(RemoteRegionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Filter) DeepCopyInto(out *Filter) {
+func (in *RootVolume) DeepCopyInto(out *RootVolume) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
-func (in *Filter) DeepCopy() *Filter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootVolume.
+func (in *RootVolume) DeepCopy() *RootVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(Filter)
+	out := new(RootVolume)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressRule) DeepCopyInto(out *IngressRule) {
+func (in *RotationSpec) DeepCopyInto(out *RotationSpec) {
 	*out = *in
-	if in.CidrBlocks != nil {
-		in, out := &in.CidrBlocks, &out.CidrBlocks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SourceSecurityGroupIDs != nil {
-		in, out := &in.SourceSecurityGroupIDs, &out.SourceSecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
-func (in *IngressRule) DeepCopy() *IngressRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationSpec.
+func (in *RotationSpec) DeepCopy() *RotationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressRule)
+	out := new(RotationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in IngressRules) DeepCopyInto(out *IngressRules) {
-	{
-		in := &in
-		*out = make(IngressRules, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(IngressRule)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRules.
-func (in IngressRules) DeepCopy() IngressRules {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressRules)
+	out := new(RouteTable)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *SNSNotificationSpec) DeepCopyInto(out *SNSNotificationSpec) {
 	*out = *in
-	if in.SSHKeyName != nil {
-		in, out := &in.SSHKeyName, &out.SSHKeyName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SecurityGroupIDs != nil {
-		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.UserData != nil {
-		in, out := &in.UserData, &out.UserData
-		*out = new(string)
-		**out = **in
-	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]apiv1alpha3.MachineAddress, len(*in))
-		copy(*out, *in)
-	}
-	if in.PrivateIP != nil {
-		in, out := &in.PrivateIP, &out.PrivateIP
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicIP != nil {
-		in, out := &in.PublicIP, &out.PublicIP
-		*out = new(string)
-		**out = **in
-	}
-	if in.ENASupport != nil {
-		in, out := &in.ENASupport, &out.ENASupport
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RootVolume != nil {
-		in, out := &in.RootVolume, &out.RootVolume
-		*out = new(RootVolume)
-		**out = **in
-	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]string, len(*in))
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]ClusterLifecycleEvent, len(*in))
 		copy(*out, *in)
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
-func (in *Instance) DeepCopy() *Instance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSNotificationSpec.
+func (in *SNSNotificationSpec) DeepCopy() *SNSNotificationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Instance)
+	out := new(SNSNotificationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Network) DeepCopyInto(out *Network) {
+func (in *SSMExportSpec) DeepCopyInto(out *SSMExportSpec) {
 	*out = *in
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make(map[SecurityGroupRole]SecurityGroup, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
-func (in *Network) DeepCopy() *Network {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSMExportSpec.
+func (in *SSMExportSpec) DeepCopy() *SSMExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Network)
+	out := new(SSMExportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
-	in.VPC.DeepCopyInto(&out.VPC)
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make(Subnets, len(*in))
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make(IngressRules, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(SubnetSpec)
+				*out = new(IngressRule)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.CNI != nil {
-		in, out := &in.CNI, &out.CNI
-		*out = new(CNISpec)
-		(*in).DeepCopyInto(*out)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
-func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkSpec)
+	out := new(SecurityGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RootVolume) DeepCopyInto(out *RootVolume) {
+func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
 	*out = *in
+	if in.ConformancePacks != nil {
+		in, out := &in.ConformancePacks, &out.ConformancePacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootVolume.
-func (in *RootVolume) DeepCopy() *RootVolume {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
+func (in *SecuritySpec) DeepCopy() *SecuritySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RootVolume)
+	out := new(SecuritySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *ServiceAccountRoleSpec) DeepCopyInto(out *ServiceAccountRoleSpec) {
 	*out = *in
+	if in.PolicyARNs != nil {
+		in, out := &in.PolicyARNs, &out.PolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountRoleSpec.
+func (in *ServiceAccountRoleSpec) DeepCopy() *ServiceAccountRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(ServiceAccountRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+func (in *ServiceCatalogRef) DeepCopyInto(out *ServiceCatalogRef) {
 	*out = *in
-	if in.IngressRules != nil {
-		in, out := &in.IngressRules, &out.IngressRules
-		*out = make(IngressRules, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(IngressRule)
-				(*in).DeepCopyInto(*out)
-			}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(Tags, len(*in))
+	if in.OutputKeyMappings != nil {
+		in, out := &in.OutputKeyMappings, &out.OutputKeyMappings
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
-func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalogRef.
+func (in *ServiceCatalogRef) DeepCopy() *ServiceCatalogRef {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroup)
+	out := new(ServiceCatalogRef)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1013,6 +2355,21 @@ func (in Subnets) DeepCopy() Subnets {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TFStateImportSpec) DeepCopyInto(out *TFStateImportSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TFStateImportSpec.
+func (in *TFStateImportSpec) DeepCopy() *TFStateImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TFStateImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Tags) DeepCopyInto(out *Tags) {
 	{
@@ -1034,6 +2391,41 @@ func (in Tags) DeepCopy() Tags {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerminationAlertSpec) DeepCopyInto(out *TerminationAlertSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerminationAlertSpec.
+func (in *TerminationAlertSpec) DeepCopy() *TerminationAlertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TerminationAlertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointsSpec) DeepCopyInto(out *VPCEndpointsSpec) {
+	*out = *in
+	if in.EndpointPolicies != nil {
+		in, out := &in.EndpointPolicies, &out.EndpointPolicies
+		*out = make([]EndpointPolicySpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointsSpec.
+func (in *VPCEndpointsSpec) DeepCopy() *VPCEndpointsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
@@ -1070,3 +2462,33 @@ func (in *VPCSpec) DeepCopy() *VPCSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilityFinding) DeepCopyInto(out *VulnerabilityFinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilityFinding.
+func (in *VulnerabilityFinding) DeepCopy() *VulnerabilityFinding {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilityFinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSpec) DeepCopyInto(out *WebhookSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSpec.
+func (in *WebhookSpec) DeepCopy() *WebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
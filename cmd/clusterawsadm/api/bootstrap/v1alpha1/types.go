@@ -108,6 +108,10 @@ type Nodes struct {
 	// DisableCloudProviderPolicy if set to true, will not generate and attach the policy for the AWS Cloud Provider.
 	// Defaults to false.
 	DisableCloudProviderPolicy bool `json:"disableCloudProviderPolicy"`
+
+	// GPUDriverBuckets is a list of S3 bucket names that nodes are allowed to download GPU drivers from.
+	// It should include the bucket of the GPUDriverBucketURL of every AWSCluster running GPU machines.
+	GPUDriverBuckets []string `json:"gpuDriverBuckets,omitempty"`
 }
 
 // +kubebuilder:object:root=true
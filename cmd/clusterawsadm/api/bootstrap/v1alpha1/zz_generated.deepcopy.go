@@ -196,6 +196,11 @@ func (in *ControlPlane) DeepCopy() *ControlPlane {
 func (in *Nodes) DeepCopyInto(out *Nodes) {
 	*out = *in
 	in.AWSIAMRoleSpec.DeepCopyInto(&out.AWSIAMRoleSpec)
+	if in.GPUDriverBuckets != nil {
+		in, out := &in.GPUDriverBuckets, &out.GPUDriverBuckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nodes.
@@ -58,51 +58,180 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 				Effect:   iamv1.EffectAllow,
 				Resource: iamv1.Resources{iamv1.Any},
 				Action: iamv1.Actions{
+					"acm-pca:DescribeCertificateAuthority",
+					"acm-pca:GetCertificate",
+					"acm-pca:IssueCertificate",
+					"ce:ListCostAllocationTags",
+					"ce:UpdateCostAllocationTagsStatus",
+					"cloudformation:DescribeStacks",
+					"cloudwatch:GetMetricStatistics",
+					"cloudwatch:ListMetrics",
+					"config:GetConformancePackComplianceSummary",
+					"datasync:CreateTask",
+					"datasync:DeleteTask",
+					"datasync:DescribeTask",
+					"datasync:DescribeTaskExecution",
+					"datasync:StartTaskExecution",
+					"datasync:TagResource",
+					"datasync:UpdateTask",
 					"ec2:AllocateAddress",
+					"ec2:AssignPrivateIpAddresses",
+					"ec2:AssociateIamInstanceProfile",
 					"ec2:AssociateRouteTable",
 					"ec2:AttachInternetGateway",
+					"ec2:AttachVolume",
 					"ec2:AuthorizeSecurityGroupIngress",
+					"ec2:CopyImage",
+					"ec2:CreateFleet",
+					"ec2:CreateInstanceConnectEndpoint",
 					"ec2:CreateInternetGateway",
+					"ec2:CreateLaunchTemplate",
 					"ec2:CreateNatGateway",
+					"ec2:CreateNetworkInterface",
+					"ec2:CreatePlacementGroup",
 					"ec2:CreateRoute",
 					"ec2:CreateRouteTable",
 					"ec2:CreateSecurityGroup",
 					"ec2:CreateSubnet",
 					"ec2:CreateTags",
+					"ec2:CreateTransitGatewayVpcAttachment",
 					"ec2:CreateVpc",
 					"ec2:ModifyVpcAttribute",
+					"ec2:ModifyVpcEndpoint",
+					"ec2:DeleteFleets",
+					"ec2:DeleteInstanceConnectEndpoint",
 					"ec2:DeleteInternetGateway",
+					"ec2:DeleteLaunchTemplate",
 					"ec2:DeleteNatGateway",
+					"ec2:DeletePlacementGroup",
+					"ec2:DeleteRoute",
 					"ec2:DeleteRouteTable",
 					"ec2:DeleteSecurityGroup",
+					"ec2:DeleteSnapshot",
 					"ec2:DeleteSubnet",
 					"ec2:DeleteTags",
+					"ec2:DeleteTransitGatewayVpcAttachment",
+					"ec2:DeleteVolume",
 					"ec2:DeleteVpc",
 					"ec2:DescribeAccountAttributes",
 					"ec2:DescribeAddresses",
 					"ec2:DescribeAvailabilityZones",
+					"ec2:DescribeFleetInstances",
+					"ec2:DescribeFleets",
+					"ec2:DescribeIamInstanceProfileAssociations",
+					"ec2:DescribeInstanceConnectEndpoints",
+					"ec2:DescribeInstanceCreditSpecifications",
 					"ec2:DescribeInstances",
+					"ec2:DescribeInstanceTypeOfferings",
+					"ec2:DescribeInstanceTypes",
 					"ec2:DescribeInternetGateways",
 					"ec2:DescribeImages",
 					"ec2:DescribeNatGateways",
 					"ec2:DescribeNetworkInterfaces",
 					"ec2:DescribeNetworkInterfaceAttribute",
+					"ec2:DescribePlacementGroups",
 					"ec2:DescribeRouteTables",
 					"ec2:DescribeSecurityGroups",
 					"ec2:DescribeSubnets",
+					"ec2:DescribeTransitGatewayVpcAttachments",
+					"ec2:DescribeTransitGateways",
 					"ec2:DescribeVpcs",
 					"ec2:DescribeVpcAttribute",
+					"ec2:DescribeVpcEndpoints",
 					"ec2:DescribeVolumes",
+					"ec2:DescribeVolumesModifications",
+					"ec2:DeregisterImage",
 					"ec2:DetachInternetGateway",
+					"ec2:DetachVolume",
 					"ec2:DisassociateRouteTable",
 					"ec2:DisassociateAddress",
+					"ec2:GetConsoleOutput",
+					"ec2:GetPasswordData",
 					"ec2:ModifyInstanceAttribute",
+					"ec2:ModifyInstanceCreditSpecification",
 					"ec2:ModifyNetworkInterfaceAttribute",
 					"ec2:ModifySubnetAttribute",
+					"ec2:ModifyVolume",
+					"ec2:MonitorInstances",
 					"ec2:ReleaseAddress",
+					"ec2:ReplaceIamInstanceProfileAssociation",
 					"ec2:RevokeSecurityGroupIngress",
 					"ec2:RunInstances",
+					"ec2:StartInstances",
+					"ec2:StopInstances",
 					"ec2:TerminateInstances",
+					"ec2:UnmonitorInstances",
+					"events:DeleteRule",
+					"events:DescribeRule",
+					"events:ListTargetsByRule",
+					"events:PutEvents",
+					"events:PutRule",
+					"events:PutTargets",
+					"events:RemoveTargets",
+					"events:TagResource",
+					"fis:CreateExperimentTemplate",
+					"fis:DeleteExperimentTemplate",
+					"fis:ListExperimentTemplates",
+					"fis:StartExperiment",
+					"fis:TagResource",
+					"iam:AddRoleToInstanceProfile",
+					"iam:AttachRolePolicy",
+					"iam:CreateInstanceProfile",
+					"iam:CreateRole",
+					"iam:DeleteInstanceProfile",
+					"iam:DeleteRole",
+					"iam:DeleteRolePolicy",
+					"iam:DetachRolePolicy",
+					"iam:GetInstanceProfile",
+					"iam:GetRole",
+					"iam:GetRolePolicy",
+					"iam:ListAttachedRolePolicies",
+					"iam:ListOpenIDConnectProviders",
+					"iam:PutRolePolicy",
+					"iam:RemoveRoleFromInstanceProfile",
+					"iam:SimulateCustomPolicy",
+					"iam:TagInstanceProfile",
+					"iam:TagRole",
+					"iam:UpdateAssumeRolePolicy",
+					"imagebuilder:GetImage",
+					"imagebuilder:ListImagePipelineImages",
+					"imagebuilder:StartImagePipelineExecution",
+					"inspector2:ListFindings",
+					"pricing:GetProducts",
+					"ram:AcceptResourceShareInvitation",
+					"ram:AssociateResourceShare",
+					"ram:CreateResourceShare",
+					"ram:DeleteResourceShare",
+					"ram:DisassociateResourceShare",
+					"ram:GetResourceShareAssociations",
+					"ram:GetResourceShareInvitations",
+					"ram:GetResourceShares",
+					"ram:TagResource",
+					"s3:GetObject",
+					"s3:PutObject",
+					"servicecatalog:DescribeProvisionedProduct",
+					"servicecatalog:GetProvisionedProductOutputs",
+					"servicecatalog:ProvisionProduct",
+					"servicecatalog:TerminateProvisionedProduct",
+					"servicequotas:GetAWSDefaultServiceQuota",
+					"servicequotas:GetServiceQuota",
+					"sns:Publish",
+					"sqs:CreateQueue",
+					"sqs:DeleteQueue",
+					"sqs:GetQueueAttributes",
+					"sqs:GetQueueUrl",
+					"sqs:ListQueueTags",
+					"sqs:SetQueueAttributes",
+					"sqs:TagQueue",
+					"ssm:AddTagsToResource",
+					"ssm:DeleteParameters",
+					"ssm:GetCommandInvocation",
+					"ssm:GetParameter",
+					"ssm:GetParametersByPath",
+					"ssm:ListCommands",
+					"ssm:ListTagsForResource",
+					"ssm:PutParameter",
+					"ssm:SendCommand",
 					"tag:GetResources",
 					"elasticloadbalancing:AddTags",
 					"elasticloadbalancing:CreateLoadBalancer",
@@ -114,6 +243,9 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 					"elasticloadbalancing:ModifyLoadBalancerAttributes",
 					"elasticloadbalancing:RegisterInstancesWithLoadBalancer",
 					"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+					"elasticloadbalancing:DeregisterTargets",
+					"elasticloadbalancing:DescribeTargetGroups",
+					"elasticloadbalancing:DescribeTargetHealth",
 					"elasticloadbalancing:RemoveTags",
 				},
 			},
@@ -129,6 +261,42 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 					iamv1.StringLike: map[string]string{"iam:AWSServiceName": "elasticloadbalancing.amazonaws.com"},
 				},
 			},
+			{
+				Effect: iamv1.EffectAllow,
+				Resource: iamv1.Resources{
+					"arn:*:iam::*:role/aws-service-role/ec2-instance-connect.amazonaws.com/AWSServiceRoleForEc2InstanceConnect",
+				},
+				Action: iamv1.Actions{
+					"iam:CreateServiceLinkedRole",
+				},
+				Condition: iamv1.Conditions{
+					iamv1.StringLike: map[string]string{"iam:AWSServiceName": "ec2-instance-connect.amazonaws.com"},
+				},
+			},
+			{
+				Effect: iamv1.EffectAllow,
+				Resource: iamv1.Resources{
+					"arn:*:iam::*:role/aws-service-role/ec2fleet.amazonaws.com/AWSServiceRoleForEC2Fleet",
+				},
+				Action: iamv1.Actions{
+					"iam:CreateServiceLinkedRole",
+				},
+				Condition: iamv1.Conditions{
+					iamv1.StringLike: map[string]string{"iam:AWSServiceName": "ec2fleet.amazonaws.com"},
+				},
+			},
+			{
+				Effect: iamv1.EffectAllow,
+				Resource: iamv1.Resources{
+					"arn:*:iam::*:role/aws-service-role/spot.amazonaws.com/AWSServiceRoleForEC2Spot",
+				},
+				Action: iamv1.Actions{
+					"iam:CreateServiceLinkedRole",
+				},
+				Condition: iamv1.Conditions{
+					iamv1.StringLike: map[string]string{"iam:AWSServiceName": "spot.amazonaws.com"},
+				},
+			},
 			{
 				Effect:   iamv1.EffectAllow,
 				Resource: t.allowedEC2InstanceProfiles(),
@@ -136,14 +304,30 @@ func (t Template) controllersPolicy() *iamv1.PolicyDocument {
 					"iam:PassRole",
 				},
 			},
+			{
+				Effect: iamv1.EffectAllow,
+				Resource: iamv1.Resources{
+					"*",
+				},
+				Action: iamv1.Actions{
+					"iam:PassRole",
+				},
+				Condition: iamv1.Conditions{
+					iamv1.StringLike: map[string]string{"iam:PassedToService": "fis.amazonaws.com"},
+				},
+			},
 			{
 				Effect: iamv1.EffectAllow,
 				Resource: iamv1.Resources{
 					"arn:*:secretsmanager:*:*:secret:aws.cluster.x-k8s.io/*",
 				},
 				Action: iamv1.Actions{
+					"secretsmanager:CancelRotateSecret",
 					"secretsmanager:CreateSecret",
 					"secretsmanager:DeleteSecret",
+					"secretsmanager:DescribeSecret",
+					"secretsmanager:PutSecretValue",
+					"secretsmanager:RotateSecret",
 					"secretsmanager:TagResource",
 				},
 			},
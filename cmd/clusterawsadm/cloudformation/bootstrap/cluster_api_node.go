@@ -17,6 +17,8 @@ limitations under the License.
 package bootstrap
 
 import (
+	"fmt"
+
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/api/iam/v1alpha1"
 )
 
@@ -48,6 +50,20 @@ func (t Template) sessionManagerPolicy() iamv1.StatementEntry {
 	}
 }
 
+func (t Template) gpuDriverPolicy() iamv1.StatementEntry {
+	resources := iamv1.Resources{}
+	for _, bucket := range t.Spec.Nodes.GPUDriverBuckets {
+		resources = append(resources, fmt.Sprintf("arn:*:s3:::%s/*", bucket))
+	}
+	return iamv1.StatementEntry{
+		Effect:   iamv1.EffectAllow,
+		Resource: resources,
+		Action: iamv1.Actions{
+			"s3:GetObject",
+		},
+	}
+}
+
 func (t Template) nodePolicy() *iamv1.PolicyDocument {
 	policyDocument := t.cloudProviderNodeAwsPolicy()
 	policyDocument.Statement = append(
@@ -55,6 +71,9 @@ func (t Template) nodePolicy() *iamv1.PolicyDocument {
 		t.secretPolicy(),
 		t.sessionManagerPolicy(),
 	)
+	if len(t.Spec.Nodes.GPUDriverBuckets) > 0 {
+		policyDocument.Statement = append(policyDocument.Statement, t.gpuDriverPolicy())
+	}
 
 	return policyDocument
 }
@@ -64,6 +64,14 @@ func Test_RenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_gpu_driver_buckets",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.Nodes.GPUDriverBuckets = []string{"gpu-drivers"}
+				return t
+			},
+		},
 		{
 			fixture: "with_extra_statements",
 			template: func() Template {
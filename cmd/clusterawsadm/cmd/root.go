@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/alpha"
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/bootstrap"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/topology"
 	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/cmd/version"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd"
 )
@@ -60,6 +61,7 @@ func RootCmd() *cobra.Command {
 	}
 	newCmd.AddCommand(alpha.AlphaCmd())
 	newCmd.AddCommand(bootstrap.RootCmd())
+	newCmd.AddCommand(topology.TopologyCmd(os.Stdout))
 	newCmd.AddCommand(version.VersionCmd(os.Stdout))
 	return newCmd
 }
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/topology"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TopologyCmd renders the network topology of a cluster.
+func TopologyCmd(out io.Writer) *cobra.Command {
+	newCmd := &cobra.Command{
+		Use:   "topology [cluster name]",
+		Short: "Render the network topology of a cluster",
+		Long: cmd.LongDesc(`
+			Render the VPC, internet gateway, NAT gateways, subnets and route tables
+			of a cluster as an ASCII diagram, from the topology digest its AWSCluster
+			is annotated with once its network is reconciled.
+		`),
+		Example: cmd.Examples(`
+			# Render the network topology of the cluster "test" in the namespace "default".
+			clusterawsadm topology test --namespace default
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunTopology(out, cmd, args[0])
+		},
+	}
+	newCmd.Flags().String("kubeconfig", "", "Path to the kubeconfig file of the management cluster, defaults to the current context")
+	newCmd.Flags().StringP("namespace", "n", "default", "Namespace of the cluster")
+	return newCmd
+}
+
+// RunTopology renders the network topology of the given cluster, as found in the management cluster
+// set up by the flags of cobra.Command.
+func RunTopology(out io.Writer, cmd *cobra.Command, clusterName string) error {
+	kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+	if err != nil {
+		return err
+	}
+	namespace, err := cmd.Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: clusterName}, cluster); err != nil {
+		return errors.Wrapf(err, "failed to get cluster %s/%s", namespace, clusterName)
+	}
+	if cluster.Spec.InfrastructureRef == nil || cluster.Spec.InfrastructureRef.Kind != "AWSCluster" {
+		return errors.Errorf("cluster %s/%s is not an AWS cluster", namespace, clusterName)
+	}
+
+	awsCluster := &infrav1.AWSCluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: cluster.Spec.InfrastructureRef.Name}, awsCluster); err != nil {
+		return errors.Wrapf(err, "failed to get AWSCluster %s/%s", namespace, cluster.Spec.InfrastructureRef.Name)
+	}
+
+	digest, ok := awsCluster.Annotations[infrav1.TopologyDigestAnnotation]
+	if !ok {
+		return errors.Errorf("AWSCluster %s/%s has no topology digest yet, its network may not be reconciled", namespace, awsCluster.Name)
+	}
+
+	graph := &topology.Graph{}
+	if err := json.Unmarshal([]byte(digest), graph); err != nil {
+		return errors.Wrapf(err, "failed to parse topology digest of AWSCluster %s/%s", namespace, awsCluster.Name)
+	}
+
+	return topology.Render(out, graph)
+}
+
+func newClient(kubeconfig string) (client.Client, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load kubeconfig")
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clusterv1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := infrav1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create client for management cluster")
+	}
+	return c, nil
+}
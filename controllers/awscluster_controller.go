@@ -18,18 +18,42 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"reflect"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/cost"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/benchmark"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/cloudformation"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/configservice"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/costexplorer"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/datasync"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/fis"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/karpenter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/pca"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ram"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicecatalog"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sns"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sqs"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/terraform"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/topology"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -47,18 +71,43 @@ import (
 // AWSClusterReconciler reconciles a AwsCluster object
 type AWSClusterReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Log      logr.Logger
+	Recorder    record.EventRecorder
+	Log         logr.Logger
+	APITimeouts scope.AWSAPITimeoutConfig
+
+	// ControllerNamespace is the namespace of the controller, holding the AMI copy cache.
+	ControllerNamespace string
+
+	// Stats records the reconciliations for the ReconcileSummaries, if set.
+	Stats *ReconcileStats
+
+	// ManagementClusterID is added to the tags of the resources of the clusters, if set, so that the
+	// GarbageCollector only collects the resources of this management cluster.
+	ManagementClusterID string
+
+	// QuotaChecks enables the pre-flight and periodic checks of the AWS service quotas of the clusters.
+	QuotaChecks bool
+
+	// CostEstimates enables annotating new clusters with the estimated monthly cost of their resources.
+	CostEstimates bool
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 
 func (r *AWSClusterReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx := context.TODO()
 	log := r.Log.WithValues("namespace", req.Namespace, "awsCluster", req.Name)
 
+	started := time.Now()
+	defer func() {
+		r.Stats.Observe(req.Namespace, started, reterr)
+	}()
+
 	// Fetch the AWSCluster instance
 	awsCluster := &infrav1.AWSCluster{}
 	err := r.Get(ctx, req.NamespacedName, awsCluster)
@@ -89,10 +138,13 @@ func (r *AWSClusterReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 
 	// Create the scope.
 	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-		Client:     r.Client,
-		Logger:     log,
-		Cluster:    cluster,
-		AWSCluster: awsCluster,
+		Client:      r.Client,
+		Logger:      log,
+		Cluster:     cluster,
+		AWSCluster:  awsCluster,
+		APITimeouts: r.APITimeouts,
+
+		ManagementClusterID: r.ManagementClusterID,
 	})
 	if err != nil {
 		return reconcile.Result{}, errors.Errorf("failed to create scope: %+v", err)
@@ -120,6 +172,11 @@ func (r *AWSClusterReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 
 		conditions.SetSummary(clusterScope.AWSCluster, conditions.WithConditions(applicableConditions...), conditions.WithStepCounter())
 
+		// Lifecycle notifications are best effort and don't fail the reconciliation.
+		if err := sns.NewNotificationPublisher(clusterScope).ReconcileLifecycle(); err != nil {
+			clusterScope.Error(err, "failed to publish lifecycle notification")
+		}
+
 		if err := clusterScope.Close(); err != nil && reterr == nil {
 			reterr = err
 		}
@@ -127,21 +184,57 @@ func (r *AWSClusterReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 
 	// Handle deleted clusters
 	if !awsCluster.DeletionTimestamp.IsZero() {
-		return reconcileDelete(clusterScope)
+		return reconcileDelete(clusterScope, ec2.NewAMICopyCache(r.Client, r.ControllerNamespace))
 	}
 
 	// Handle non-deleted clusters
-	return reconcileNormal(clusterScope)
+	return reconcileNormal(ctx, clusterScope, r.QuotaChecks, r.CostEstimates)
 }
 
 // TODO(ncdc): should this be a function on ClusterScope?
-func reconcileDelete(clusterScope *scope.ClusterScope) (reconcile.Result, error) {
+func reconcileDelete(clusterScope *scope.ClusterScope, amiCopyCache *ec2.AMICopyCache) (reconcile.Result, error) {
 	clusterScope.Info("Reconciling AWSCluster delete")
 
 	ec2svc := ec2.NewService(clusterScope)
 	elbsvc := elb.NewService(clusterScope)
 	awsCluster := clusterScope.AWSCluster
 
+	if err := fis.NewService(clusterScope).DeleteExperimentTemplates(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting FIS experiment templates for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := eventbridge.NewService(clusterScope).DeleteTerminationAlerts(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting termination alert rule for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := eventbridge.NewService(clusterScope).DeleteNodeTerminationHandlerRules(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting node termination handler rules for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := sqs.NewService(clusterScope).DeleteNodeTerminationHandlerQueue(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting node termination handler queue for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := datasync.NewService(clusterScope).DeleteTask(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting DataSync task for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := ssm.NewService(clusterScope).DeleteParameters(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting SSM parameters for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := karpenter.NewService(clusterScope).DeleteKarpenter(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting Karpenter resources for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := iam.NewService(clusterScope).DeleteServiceAccountRoles(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting service account roles for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := secretsmanager.NewService(clusterScope).DeleteBootstrapToken(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting bootstrap token secret for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
 	if err := elbsvc.DeleteLoadbalancers(); err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "error deleting load balancer for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
@@ -150,10 +243,31 @@ func reconcileDelete(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 		return reconcile.Result{}, errors.Wrapf(err, "error deleting bastion for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
 
+	if err := ec2.NewAMICopier(clusterScope, amiCopyCache).DeleteCopies(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting copied AMIs for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if remote := clusterScope.RemoteRegionScope(); remote != nil {
+		if err := ec2.NewAMICopier(remote, amiCopyCache).DeleteCopies(); err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "error deleting copied AMIs in remote region for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+	}
+
+	if err := ec2.NewRemoteVPCReconciler(clusterScope).Delete(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting remote region network for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	if err := ram.NewService(clusterScope).DeleteResourceShare(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting resource share for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
 	if err := ec2svc.DeleteNetwork(); err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "error deleting network for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
 
+	if err := servicecatalog.NewService(clusterScope).DeleteProvisionedProduct(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "error deleting Service Catalog provisioned product for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
 	// Cluster is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(clusterScope.AWSCluster, infrav1.ClusterFinalizer)
 
@@ -161,7 +275,7 @@ func reconcileDelete(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 }
 
 // TODO(ncdc): should this be a function on ClusterScope?
-func reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error) {
+func reconcileNormal(ctx context.Context, clusterScope *scope.ClusterScope, quotaChecks, costEstimates bool) (reconcile.Result, error) {
 	clusterScope.Info("Reconciling AWSCluster")
 
 	awsCluster := clusterScope.AWSCluster
@@ -173,13 +287,120 @@ func reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 		return reconcile.Result{}, err
 	}
 
+	// Nothing has been created for a new cluster yet, block it early if it wouldn't fit in the account's quotas.
+	if needsPreFlightQuotaCheck(awsCluster) {
+		if _, ok := awsCluster.Annotations[infrav1.EstimatedMonthlyCostAnnotation]; !ok && costEstimates {
+			// Like quotas, prices that can't be read must not block the cluster.
+			if err := estimateMonthlyCost(ctx, clusterScope); err != nil {
+				clusterScope.Error(err, "failed to estimate monthly cost")
+			}
+		}
+
+		if quotaChecks {
+			err := servicequotas.NewService(clusterScope).PreFlightQuotaCheck()
+			if qerr, ok := servicequotas.IsQuotaInsufficient(err); ok {
+				conditions.MarkFalse(awsCluster, infrav1.QuotaSufficientCondition, infrav1.QuotaInsufficientReason, clusterv1.ConditionSeverityError, qerr.Error())
+				clusterScope.Info("Service quotas are insufficient for AWSCluster", "quota", qerr.QuotaCode, "used", qerr.Used, "limit", qerr.Limit)
+				return reconcile.Result{RequeueAfter: servicequotas.QuotaCheckInterval}, nil
+			}
+			if err != nil {
+				// Like quota usage, an unreadable quota must not block the cluster.
+				clusterScope.Error(err, "failed to run pre-flight quota check")
+			} else {
+				conditions.MarkTrue(awsCluster, infrav1.QuotaSufficientCondition)
+			}
+		}
+	}
+
 	ec2Service := ec2.NewService(clusterScope)
 	elbService := elb.NewService(clusterScope)
+	ramService := ram.NewService(clusterScope)
+
+	// The VPC and subnets of clusters provisioned through Service Catalog come from the provisioned product.
+	if clusterScope.ServiceCatalogRef() != nil {
+		if err := servicecatalog.NewService(clusterScope).ReconcileProvisionedProduct(); err != nil {
+			conditions.MarkFalse(awsCluster, infrav1.ServiceCatalogProductReadyCondition, infrav1.ServiceCatalogProvisioningFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile Service Catalog provisioned product for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		conditions.MarkTrue(awsCluster, infrav1.ServiceCatalogProductReadyCondition)
+	}
+
+	// The VPC and subnets of clusters whose network is managed by CloudFormation must be known before the network is reconciled.
+	if clusterScope.CloudFormationStackRef() != nil {
+		if err := cloudformation.NewService(clusterScope).ImportStackOutputs(); err != nil {
+			conditions.MarkFalse(awsCluster, infrav1.CloudFormationStackImportedCondition, infrav1.CloudFormationStackImportFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to import CloudFormation stack outputs for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		conditions.MarkTrue(awsCluster, infrav1.CloudFormationStackImportedCondition)
+	}
+
+	// The network of clusters adopting Terraform-managed resources is imported once, before the network is reconciled.
+	if clusterScope.TerraformStateImport() != nil && !conditions.IsTrue(awsCluster, infrav1.TerraformStateImportedCondition) {
+		if err := terraform.NewService(clusterScope).ImportState(); err != nil {
+			conditions.MarkFalse(awsCluster, infrav1.TerraformStateImportedCondition, infrav1.TerraformStateImportFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to import Terraform state for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		conditions.MarkTrue(awsCluster, infrav1.TerraformStateImportedCondition)
+	}
+
+	// Subnets shared by another account can only be found once the share is accepted.
+	if err := ramService.AcceptResourceShare(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.ResourceShareReadyCondition, infrav1.ResourceShareReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to accept resource share for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
+	// Existing resources must be tagged as owned before the network is reconciled, for them to be found instead of created.
+	if err := ec2Service.AdoptResources(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to adopt network resources for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
 
 	if err := ec2Service.ReconcileNetwork(); err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile network for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
 
+	if awsCluster.Annotations == nil {
+		awsCluster.Annotations = map[string]string{}
+	}
+	awsCluster.Annotations[infrav1.TopologyDigestAnnotation] = generateTopologyDigest(awsCluster.Status)
+
+	if err := ramService.ReconcileResourceShare(); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.ResourceShareReadyCondition, infrav1.ResourceShareReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile resource share for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if clusterScope.RAMShare() != nil {
+		conditions.MarkTrue(awsCluster, infrav1.ResourceShareReadyCondition)
+	}
+
+	if clusterScope.RemoteRegion() != nil {
+		if clusterScope.RemoteRegion().TransitGatewayRAMShareARN != "" {
+			err := ramService.AcceptTransitGatewayShare()
+			switch {
+			case ram.IsTransitGatewaySharePending(err):
+				// The condition turns false when the wait starts, its transition time tells how long it lasts.
+				if c := conditions.Get(awsCluster, infrav1.TransitGatewayShareAcceptedCondition); c != nil && c.Status == corev1.ConditionFalse &&
+					time.Since(c.LastTransitionTime.Time) > ram.TransitGatewayShareTimeout {
+					conditions.MarkFalse(awsCluster, infrav1.TransitGatewayShareAcceptedCondition, infrav1.TransitGatewayShareTimedOutReason, clusterv1.ConditionSeverityError, err.Error())
+					return reconcile.Result{}, errors.Wrapf(err, "timed out waiting for the transit gateway of AWSCluster %s/%s to be shared", awsCluster.Namespace, awsCluster.Name)
+				}
+				conditions.MarkFalse(awsCluster, infrav1.TransitGatewayShareAcceptedCondition, infrav1.WaitingForTransitGatewayShareReason, clusterv1.ConditionSeverityInfo, err.Error())
+				clusterScope.Info("Waiting for the shared transit gateway to be available", "transit-gateway-id", clusterScope.RemoteRegion().TransitGatewayID)
+				return reconcile.Result{RequeueAfter: ram.TransitGatewaySharePollInterval}, nil
+			case err != nil:
+				conditions.MarkFalse(awsCluster, infrav1.TransitGatewayShareAcceptedCondition, infrav1.TransitGatewayShareFailedReason, clusterv1.ConditionSeverityError, err.Error())
+				return reconcile.Result{}, errors.Wrapf(err, "failed to accept the transit gateway share for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+			}
+			conditions.MarkTrue(awsCluster, infrav1.TransitGatewayShareAcceptedCondition)
+		} else {
+			conditions.Delete(awsCluster, infrav1.TransitGatewayShareAcceptedCondition)
+		}
+
+		if err := ec2.NewRemoteVPCReconciler(clusterScope).Reconcile(); err != nil {
+			conditions.MarkFalse(awsCluster, infrav1.RemoteRegionReadyCondition, infrav1.RemoteRegionReconciliationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile remote region network for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		conditions.MarkTrue(awsCluster, infrav1.RemoteRegionReadyCondition)
+	}
+
 	if err := ec2Service.ReconcileBastion(); err != nil {
 		conditions.MarkFalse(awsCluster, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile bastion host for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
@@ -190,6 +411,103 @@ func reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile load balancers for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
 
+	if clusterScope.PrivateCAARN() != "" {
+		if err := pca.NewService(clusterScope).ReconcileCertificates(); err != nil {
+			conditions.MarkFalse(awsCluster, infrav1.CertificateReadyCondition, infrav1.CertificateIssuanceFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile certificates for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		conditions.MarkTrue(awsCluster, infrav1.CertificateReadyCondition)
+	} else {
+		conditions.Delete(awsCluster, infrav1.CertificateReadyCondition)
+	}
+
+	if quotaChecks {
+		// Quota usage is informational only, failing to read it must not block the cluster.
+		if err := servicequotas.NewService(clusterScope).ReconcileQuotas(); err != nil {
+			clusterScope.Error(err, "failed to reconcile service quotas")
+		}
+	} else {
+		awsCluster.Status.QuotaStatus = nil
+		awsCluster.Status.QuotaCheckedAt = nil
+		conditions.Delete(awsCluster, infrav1.QuotaSufficientCondition)
+	}
+
+	// Like quota usage, compliance is informational only.
+	if err := configservice.NewService(clusterScope).ReconcileConformancePacks(); err != nil {
+		clusterScope.Error(err, "failed to reconcile conformance pack compliance")
+	}
+
+	// Fault injection experiments are not needed to run the cluster either.
+	reconcileFISExperimentTemplates(clusterScope)
+
+	// Nor are the IAM roles of workloads.
+	if err := iam.NewService(clusterScope).ReconcileServiceAccountRoles(); err != nil {
+		clusterScope.Error(err, "failed to reconcile service account roles")
+		conditions.MarkFalse(awsCluster, infrav1.ServiceAccountRolesReadyCondition, infrav1.ServiceAccountRoleFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+	} else if len(awsCluster.Status.ServiceAccountRoles) > 0 {
+		conditions.MarkTrue(awsCluster, infrav1.ServiceAccountRolesReadyCondition)
+	} else {
+		conditions.Delete(awsCluster, infrav1.ServiceAccountRolesReadyCondition)
+	}
+
+	// Nodes can still join with the tokens of the bootstrap provider if the rotation fails.
+	if clusterScope.BootstrapTokenRotation() != nil {
+		if err := secretsmanager.NewService(clusterScope).ReconcileBootstrapToken(); err != nil {
+			clusterScope.Error(err, "failed to reconcile bootstrap token")
+			conditions.MarkFalse(awsCluster, infrav1.BootstrapTokenReadyCondition, infrav1.BootstrapTokenRotationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		} else if awsCluster.Status.BootstrapTokenSecretARN != "" {
+			conditions.MarkTrue(awsCluster, infrav1.BootstrapTokenReadyCondition)
+		}
+	} else {
+		conditions.Delete(awsCluster, infrav1.BootstrapTokenReadyCondition)
+	}
+
+	// Alerts on the termination of instances are not needed to run the cluster either.
+	if clusterScope.TerminationAlerts() != nil {
+		if err := eventbridge.NewService(clusterScope).ReconcileTerminationAlerts(); err != nil {
+			clusterScope.Error(err, "failed to reconcile termination alerts")
+			conditions.MarkFalse(awsCluster, infrav1.TerminationAlertsReadyCondition, infrav1.TerminationAlertRuleFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		} else {
+			conditions.MarkTrue(awsCluster, infrav1.TerminationAlertsReadyCondition)
+		}
+	} else {
+		conditions.Delete(awsCluster, infrav1.TerminationAlertsReadyCondition)
+	}
+
+	// The queue of the Node Termination Handler only matters to the handler running in the cluster.
+	if clusterScope.NodeTerminationHandler() != nil {
+		if err := reconcileNodeTerminationHandler(clusterScope); err != nil {
+			clusterScope.Error(err, "failed to reconcile node termination handler queue")
+			conditions.MarkFalse(awsCluster, infrav1.NodeTerminationHandlerReadyCondition, infrav1.NodeTerminationHandlerQueueFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		} else {
+			conditions.MarkTrue(awsCluster, infrav1.NodeTerminationHandlerReadyCondition)
+		}
+	} else {
+		conditions.Delete(awsCluster, infrav1.NodeTerminationHandlerReadyCondition)
+	}
+
+	// The data copied by DataSync only matters to the workloads migrated to the cluster.
+	reconcileDataSync(clusterScope)
+
+	// Cost allocation tags only affect billing reports. They are activated once, until the activation succeeds.
+	if len(clusterScope.CostAllocationTags()) > 0 {
+		if !conditions.IsTrue(awsCluster, infrav1.CostAllocationTagsActiveCondition) {
+			if err := costexplorer.NewService(clusterScope).ActivateCostAllocationTags(); err != nil {
+				clusterScope.Error(err, "failed to activate cost allocation tags")
+				conditions.MarkFalse(awsCluster, infrav1.CostAllocationTagsActiveCondition, infrav1.CostAllocationTagActivationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			} else {
+				conditions.MarkTrue(awsCluster, infrav1.CostAllocationTagsActiveCondition)
+			}
+		}
+	} else {
+		conditions.Delete(awsCluster, infrav1.CostAllocationTagsActiveCondition)
+	}
+
+	// The failures of machines are already reported on each AWSMachine, their summary is informational.
+	if err := reconcileMachineFailures(ctx, clusterScope); err != nil {
+		clusterScope.Error(err, "failed to aggregate machine failures")
+	}
+
 	if awsCluster.Status.Network.APIServerELB.DNSName == "" {
 		conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSNameReason, clusterv1.ConditionSeverityInfo, "")
 		clusterScope.Info("Waiting on API server ELB DNS name")
@@ -222,13 +540,275 @@ func reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error)
 		})
 	}
 
+	if err := discoverFailureDomains(ctx, clusterScope); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to discover failure domains for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
 	awsCluster.Status.Ready = true
+
+	// The exported parameters are only read by tools outside of the cluster.
+	if clusterScope.SSMParameterExport() != nil {
+		if err := ssm.NewService(clusterScope).ReconcileParameters(); err != nil {
+			clusterScope.Error(err, "failed to export SSM parameters")
+			conditions.MarkFalse(awsCluster, infrav1.SSMParametersExportedCondition, infrav1.SSMParameterExportFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		} else {
+			conditions.MarkTrue(awsCluster, infrav1.SSMParametersExportedCondition)
+		}
+	} else {
+		// Removing the export deletes the parameters written for it.
+		if err := ssm.NewService(clusterScope).DeleteParameters(); err != nil {
+			clusterScope.Error(err, "failed to delete SSM parameters")
+		}
+		conditions.Delete(awsCluster, infrav1.SSMParametersExportedCondition)
+	}
+
+	// Karpenter is installed once the cluster is ready, as it needs the endpoint of the API server. Nodes can
+	// still be added through MachineDeployments if the installation fails.
+	if clusterScope.Karpenter() != nil {
+		installed, err := karpenter.NewService(clusterScope).ReconcileKarpenter()
+		switch {
+		case err != nil:
+			clusterScope.Error(err, "failed to reconcile Karpenter")
+			conditions.MarkFalse(awsCluster, infrav1.KarpenterReadyCondition, infrav1.KarpenterInstallationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		case !installed:
+			conditions.MarkFalse(awsCluster, infrav1.KarpenterReadyCondition, infrav1.WaitingForControlPlaneReason, clusterv1.ConditionSeverityInfo, "")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		default:
+			conditions.MarkTrue(awsCluster, infrav1.KarpenterReadyCondition)
+		}
+	} else {
+		conditions.Delete(awsCluster, infrav1.KarpenterReadyCondition)
+	}
+
+	// The network is benchmarked once the cluster is ready, as the benchmark is run on its nodes.
+	if spec := clusterScope.NetworkBenchmark(); spec != nil && spec.Enabled {
+		result, err := benchmark.NewService(clusterScope).ReconcileBenchmark()
+		switch {
+		case err != nil:
+			clusterScope.Error(err, "failed to benchmark network")
+			conditions.MarkFalse(awsCluster, infrav1.NetworkPerformanceCondition, infrav1.NetworkBenchmarkFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		case result == nil:
+			conditions.MarkFalse(awsCluster, infrav1.NetworkPerformanceCondition, infrav1.NetworkBenchmarkInProgressReason, clusterv1.ConditionSeverityInfo, "")
+		case result.BelowTarget(spec.TargetBandwidthGbps):
+			conditions.MarkFalse(awsCluster, infrav1.NetworkPerformanceCondition, infrav1.NetworkPerformanceDegradedReason, clusterv1.ConditionSeverityWarning,
+				"measured %.2f Gbit/s from node %s to node %s, below the target of %s Gbit/s", result.BandwidthGbps, result.ClientNode, result.ServerNode, spec.TargetBandwidthGbps)
+		default:
+			conditions.MarkTrue(awsCluster, infrav1.NetworkPerformanceCondition)
+		}
+	} else {
+		conditions.Delete(awsCluster, infrav1.NetworkPerformanceCondition)
+	}
+
+	if conditions.GetReason(awsCluster, infrav1.DataSyncCompletedCondition) == infrav1.DataSyncInProgressReason {
+		return reconcile.Result{RequeueAfter: datasync.TaskExecutionPollInterval}, nil
+	}
+	if conditions.GetReason(awsCluster, infrav1.NetworkPerformanceCondition) == infrav1.NetworkBenchmarkInProgressReason {
+		return reconcile.Result{RequeueAfter: benchmark.PollInterval}, nil
+	}
+
+	if quotaChecks {
+		return reconcile.Result{RequeueAfter: servicequotas.QuotaCheckInterval}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
+// estimateMonthlyCost annotates the AWSCluster with the estimated monthly cost of its resources and of
+// the AWSMachines of the cluster.
+func estimateMonthlyCost(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	machines, err := clusterScope.ListAWSMachines(ctx)
+	if err != nil {
+		return err
+	}
+	specs := make([]infrav1.AWSMachineSpec, 0, len(machines))
+	for i := range machines {
+		specs = append(specs, machines[i].Spec)
+	}
+
+	estimate, err := cost.NewCostEstimator(clusterScope.Pricing, clusterScope.Region()).Estimate(&clusterScope.AWSCluster.Spec, specs)
+	if err != nil {
+		return err
+	}
+
+	total := strconv.FormatFloat(estimate.Total(), 'f', 2, 64)
+	clusterScope.V(2).Info("Estimated monthly cost of AWSCluster", "usd", total, "machines", len(specs))
+	if clusterScope.AWSCluster.Annotations == nil {
+		clusterScope.AWSCluster.Annotations = map[string]string{}
+	}
+	clusterScope.AWSCluster.Annotations[infrav1.EstimatedMonthlyCostAnnotation] = total
+	return nil
+}
+
+// needsPreFlightQuotaCheck returns true for AWSClusters being created, whose status is still empty but
+// for a previous insufficient quota check.
+func needsPreFlightQuotaCheck(awsCluster *infrav1.AWSCluster) bool {
+	if !awsCluster.DeletionTimestamp.IsZero() {
+		return false
+	}
+	status := awsCluster.Status.DeepCopy()
+	status.Conditions = nil
+	status.LifecycleEvent = ""
+	for _, c := range awsCluster.Status.Conditions {
+		if c.Type != infrav1.QuotaSufficientCondition || c.Status == corev1.ConditionTrue {
+			status.Conditions = append(status.Conditions, c)
+		}
+	}
+	return reflect.DeepEqual(*status, infrav1.AWSClusterStatus{})
+}
+
+// discoverFailureDomains falls back to the availability zones of the region when no failure domain could be
+// derived from the subnets of the cluster.
+func discoverFailureDomains(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	if len(clusterScope.AWSCluster.Status.FailureDomains) > 0 {
+		return nil
+	}
+
+	zones, err := ec2.NewService(clusterScope).DiscoverAvailabilityZones(ctx, clusterScope.Region())
+	if err != nil {
+		return err
+	}
+	for _, zone := range zones {
+		clusterScope.SetFailureDomain(zone, clusterv1.FailureDomainSpec{
+			ControlPlane: true,
+		})
+	}
+
+	return nil
+}
+
+// generateTopologyDigest returns the JSON graph of the provisioned network of the cluster. Private subnets are routed
+// through the NAT gateway of the public subnets in their availability zone, as set up by the network reconciliation.
+func generateTopologyDigest(status infrav1.AWSClusterStatus) string {
+	graph := &topology.Graph{Nodes: []topology.Node{}, Edges: []topology.Edge{}}
+
+	network := status.Network
+	vpc := infrav1.VPCSpec{}
+	if network.VPC != nil {
+		vpc = *network.VPC
+	}
+	graph.AddNode(topology.Node{ID: vpc.ID, Kind: topology.NodeKindVPC, CIDR: vpc.CidrBlock})
+	if vpc.InternetGatewayID != nil {
+		graph.AddNode(topology.Node{ID: *vpc.InternetGatewayID, Kind: topology.NodeKindInternetGateway})
+		graph.AddEdge(topology.Edge{From: *vpc.InternetGatewayID, To: vpc.ID, Kind: topology.EdgeKindAttached})
+	}
+
+	subnets := make(infrav1.Subnets, len(network.Subnets))
+	copy(subnets, network.Subnets)
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].ID < subnets[j].ID
+	})
+
+	natGateways := map[string]string{}
+	for _, subnet := range subnets.FilterPublic() {
+		if subnet.NatGatewayID == nil {
+			continue
+		}
+		if _, ok := natGateways[subnet.AvailabilityZone]; !ok {
+			natGateways[subnet.AvailabilityZone] = *subnet.NatGatewayID
+		}
+	}
+
+	for _, subnet := range subnets {
+		graph.AddNode(topology.Node{
+			ID:               subnet.ID,
+			Kind:             topology.NodeKindSubnet,
+			CIDR:             subnet.CidrBlock,
+			AvailabilityZone: subnet.AvailabilityZone,
+			Public:           subnet.IsPublic,
+		})
+		graph.AddEdge(topology.Edge{From: vpc.ID, To: subnet.ID, Kind: topology.EdgeKindContains})
+
+		if subnet.IsPublic && subnet.NatGatewayID != nil {
+			graph.AddNode(topology.Node{ID: *subnet.NatGatewayID, Kind: topology.NodeKindNatGateway, AvailabilityZone: subnet.AvailabilityZone})
+			graph.AddEdge(topology.Edge{From: subnet.ID, To: *subnet.NatGatewayID, Kind: topology.EdgeKindContains})
+		}
+
+		if subnet.RouteTableID == nil {
+			continue
+		}
+		graph.AddNode(topology.Node{ID: *subnet.RouteTableID, Kind: topology.NodeKindRouteTable})
+		graph.AddEdge(topology.Edge{From: subnet.ID, To: *subnet.RouteTableID, Kind: topology.EdgeKindAssociated})
+
+		switch {
+		case subnet.IsPublic && vpc.InternetGatewayID != nil:
+			graph.AddEdge(topology.Edge{From: *subnet.RouteTableID, To: *vpc.InternetGatewayID, Kind: topology.EdgeKindRoutes})
+		case !subnet.IsPublic:
+			graph.AddEdge(topology.Edge{From: *subnet.RouteTableID, To: natGateways[subnet.AvailabilityZone], Kind: topology.EdgeKindRoutes})
+		}
+	}
+
+	// The graph only holds strings and booleans, it can always be marshalled.
+	digest, _ := json.Marshal(graph)
+	return string(digest)
+}
+
+// reconcileNodeTerminationHandler reconciles the queue of the cluster's AWS Node Termination Handler, along with
+// the EventBridge rules feeding it when the queue is created for the cluster.
+func reconcileNodeTerminationHandler(clusterScope *scope.ClusterScope) error {
+	queueARN, err := sqs.NewService(clusterScope).ReconcileNodeTerminationHandlerQueue()
+	if err != nil {
+		return err
+	}
+	return eventbridge.NewService(clusterScope).ReconcileNodeTerminationHandlerRules(queueARN)
+}
+
+// reconcileDataSync reconciles the DataSync task of the cluster, and reports on its last execution.
+func reconcileDataSync(clusterScope *scope.ClusterScope) {
+	awsCluster := clusterScope.AWSCluster
+	dataSyncService := datasync.NewService(clusterScope)
+
+	if err := dataSyncService.ReconcileTask(); err != nil {
+		clusterScope.Error(err, "failed to reconcile DataSync task")
+		conditions.MarkFalse(awsCluster, infrav1.DataSyncCompletedCondition, infrav1.DataSyncFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return
+	}
+	if clusterScope.DataSyncTask() == nil {
+		conditions.Delete(awsCluster, infrav1.DataSyncCompletedCondition)
+		return
+	}
+
+	completed, err := dataSyncService.CheckTaskExecution()
+	switch {
+	case datasync.IsTaskExecutionFailed(err):
+		conditions.MarkFalse(awsCluster, infrav1.DataSyncCompletedCondition, infrav1.DataSyncFailedReason, clusterv1.ConditionSeverityError, err.Error())
+	case err != nil:
+		clusterScope.Error(err, "failed to check DataSync task execution")
+	case !completed:
+		conditions.MarkFalse(awsCluster, infrav1.DataSyncCompletedCondition, infrav1.DataSyncInProgressReason, clusterv1.ConditionSeverityInfo, "")
+	default:
+		conditions.MarkTrue(awsCluster, infrav1.DataSyncCompletedCondition)
+	}
+}
+
+func reconcileFISExperimentTemplates(clusterScope *scope.ClusterScope) {
+	awsCluster := clusterScope.AWSCluster
+	fisService := fis.NewService(clusterScope)
+
+	if err := fisService.ReconcileExperimentTemplates(); err != nil {
+		clusterScope.Error(err, "failed to reconcile FIS experiment templates")
+		conditions.MarkFalse(awsCluster, infrav1.FISExperimentTemplatesReadyCondition, infrav1.FISExperimentTemplateFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+	} else if len(awsCluster.Status.FISExperimentTemplateIDs) > 0 {
+		conditions.MarkTrue(awsCluster, infrav1.FISExperimentTemplatesReadyCondition)
+	} else {
+		conditions.Delete(awsCluster, infrav1.FISExperimentTemplatesReadyCondition)
+	}
+
+	// The annotation is removed whether or not the experiment started, so that it is not started again on every
+	// reconcile. Failures are reported as events by the FIS service.
+	name, ok := awsCluster.Annotations[infrav1.StartFISExperimentAnnotation]
+	if !ok {
+		return
+	}
+	delete(awsCluster.Annotations, infrav1.StartFISExperimentAnnotation)
+	if id, err := fisService.StartExperiment(name); err != nil {
+		clusterScope.Error(err, "failed to start FIS experiment", "template", name)
+	} else {
+		clusterScope.Info("Started FIS experiment", "template", name, "experiment", id)
+	}
+}
+
 func (r *AWSClusterReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
 	controller, err := ctrl.NewControllerManagedBy(mgr).
-		WithOptions(options).
+		WithOptions(workerPoolOptions("awscluster", options)).
 		For(&infrav1.AWSCluster{}).
 		WithEventFilter(pausedPredicates(r.Log)).
 		WithEventFilter(
@@ -0,0 +1,399 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/topology"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDiscoverFailureDomains(t *testing.T) {
+	testCases := []struct {
+		name           string
+		failureDomains clusterv1.FailureDomains
+		expect         func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expected       clusterv1.FailureDomains
+	}{
+		{
+			name: "zones of the region are discovered when failure domains are empty",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeAvailabilityZonesInput{})).
+					Return(&ec2.DescribeAvailabilityZonesOutput{
+						AvailabilityZones: []*ec2.AvailabilityZone{
+							{ZoneName: aws.String("us-east-1b")},
+							{ZoneName: aws.String("us-east-1a")},
+						},
+					}, nil)
+			},
+			expected: clusterv1.FailureDomains{
+				"us-east-1a": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"us-east-1b": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		{
+			name: "failure domains derived from subnets are kept",
+			failureDomains: clusterv1.FailureDomains{
+				"us-east-1a": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+			expected: clusterv1.FailureDomains{
+				"us-east-1a": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec:   infrav1.AWSClusterSpec{Region: "us-east-1"},
+				Status: infrav1.AWSClusterStatus{FailureDomains: tc.failureDomains},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			if err := discoverFailureDomains(context.TODO(), clusterScope); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(awsCluster.Status.FailureDomains, tc.expected) {
+				t.Fatalf("expected failure domains %v, got %v", tc.expected, awsCluster.Status.FailureDomains)
+			}
+		})
+	}
+}
+
+func TestNeedsPreFlightQuotaCheck(t *testing.T) {
+	now := metav1.Now()
+	testCases := []struct {
+		name       string
+		awsCluster *infrav1.AWSCluster
+		expected   bool
+	}{
+		{
+			name:       "new cluster",
+			awsCluster: &infrav1.AWSCluster{},
+			expected:   true,
+		},
+		{
+			name: "cluster blocked by an insufficient quota",
+			awsCluster: &infrav1.AWSCluster{
+				Status: infrav1.AWSClusterStatus{
+					Conditions: clusterv1.Conditions{
+						{Type: infrav1.QuotaSufficientCondition, Status: corev1.ConditionFalse, Reason: infrav1.QuotaInsufficientReason},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "cluster whose quotas were sufficient",
+			awsCluster: &infrav1.AWSCluster{
+				Status: infrav1.AWSClusterStatus{
+					Conditions: clusterv1.Conditions{
+						{Type: infrav1.QuotaSufficientCondition, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+		},
+		{
+			name: "cluster with existing resources",
+			awsCluster: &infrav1.AWSCluster{
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{APIServerELB: infrav1.ClassicELB{Name: "test-apiserver"}},
+				},
+			},
+		},
+		{
+			name: "deleted cluster",
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := needsPreFlightQuotaCheck(tc.awsCluster); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGenerateTopologyDigest(t *testing.T) {
+	network := infrav1.Network{
+		VPC: &infrav1.VPCSpec{
+			ID:                "vpc-1",
+			CidrBlock:         "10.0.0.0/16",
+			InternetGatewayID: aws.String("igw-1"),
+		},
+		Subnets: infrav1.Subnets{
+			{
+				ID:               "subnet-private-a",
+				CidrBlock:        "10.0.1.0/24",
+				AvailabilityZone: "us-east-1a",
+				RouteTableID:     aws.String("rtb-private-a"),
+			},
+			{
+				ID:               "subnet-public-a",
+				CidrBlock:        "10.0.0.0/24",
+				AvailabilityZone: "us-east-1a",
+				IsPublic:         true,
+				RouteTableID:     aws.String("rtb-public"),
+				NatGatewayID:     aws.String("nat-a"),
+			},
+		},
+	}
+
+	expected := &topology.Graph{
+		Nodes: []topology.Node{
+			{ID: "vpc-1", Kind: topology.NodeKindVPC, CIDR: "10.0.0.0/16"},
+			{ID: "igw-1", Kind: topology.NodeKindInternetGateway},
+			{ID: "subnet-private-a", Kind: topology.NodeKindSubnet, CIDR: "10.0.1.0/24", AvailabilityZone: "us-east-1a"},
+			{ID: "rtb-private-a", Kind: topology.NodeKindRouteTable},
+			{ID: "subnet-public-a", Kind: topology.NodeKindSubnet, CIDR: "10.0.0.0/24", AvailabilityZone: "us-east-1a", Public: true},
+			{ID: "nat-a", Kind: topology.NodeKindNatGateway, AvailabilityZone: "us-east-1a"},
+			{ID: "rtb-public", Kind: topology.NodeKindRouteTable},
+		},
+		Edges: []topology.Edge{
+			{From: "igw-1", To: "vpc-1", Kind: topology.EdgeKindAttached},
+			{From: "vpc-1", To: "subnet-private-a", Kind: topology.EdgeKindContains},
+			{From: "subnet-private-a", To: "rtb-private-a", Kind: topology.EdgeKindAssociated},
+			{From: "rtb-private-a", To: "nat-a", Kind: topology.EdgeKindRoutes},
+			{From: "vpc-1", To: "subnet-public-a", Kind: topology.EdgeKindContains},
+			{From: "subnet-public-a", To: "nat-a", Kind: topology.EdgeKindContains},
+			{From: "subnet-public-a", To: "rtb-public", Kind: topology.EdgeKindAssociated},
+			{From: "rtb-public", To: "igw-1", Kind: topology.EdgeKindRoutes},
+		},
+	}
+
+	status := infrav1.AWSClusterStatus{Network: network}
+	digest := generateTopologyDigest(status)
+
+	graph := &topology.Graph{}
+	if err := json.Unmarshal([]byte(digest), graph); err != nil {
+		t.Fatalf("failed to parse digest %q: %v", digest, err)
+	}
+	if !reflect.DeepEqual(graph, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, graph)
+	}
+
+	if generateTopologyDigest(status) != digest {
+		t.Fatalf("expected the digest to be stable")
+	}
+}
+
+func TestGenerateTopologyDigestUnmanagedNetwork(t *testing.T) {
+	digest := generateTopologyDigest(infrav1.AWSClusterStatus{
+		Network: infrav1.Network{
+			VPC: &infrav1.VPCSpec{ID: "vpc-1"},
+			Subnets: infrav1.Subnets{
+				{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+			},
+		},
+	})
+
+	expected := `{"nodes":[{"id":"vpc-1","kind":"vpc"},{"id":"subnet-1","kind":"subnet","az":"us-east-1a"}],` +
+		`"edges":[{"from":"vpc-1","to":"subnet-1","kind":"contains"}]}`
+	if digest != expected {
+		t.Fatalf("expected %s, got %s", expected, digest)
+	}
+}
+
+func failedMachine(name string, message *string) infrav1.AWSMachine {
+	reason := capierrors.CreateMachineError
+	return infrav1.AWSMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{clusterv1.ClusterLabelName: "test-cluster"},
+		},
+		Status: infrav1.AWSMachineStatus{
+			FailureReason:  &reason,
+			FailureMessage: message,
+		},
+	}
+}
+
+func TestSummarizeMachineFailures(t *testing.T) {
+	testCases := []struct {
+		name     string
+		machines []infrav1.AWSMachine
+		expected string
+	}{
+		{
+			name: "no failed machines",
+			machines: []infrav1.AWSMachine{
+				{ObjectMeta: metav1.ObjectMeta{Name: "healthy"}},
+			},
+			expected: "",
+		},
+		{
+			name: "deduplicates messages by descending count",
+			machines: []infrav1.AWSMachine{
+				failedMachine("m1", pointer.StringPtr("InvalidAMI")),
+				failedMachine("m2", pointer.StringPtr("InsufficientCapacity")),
+				{ObjectMeta: metav1.ObjectMeta{Name: "healthy"}},
+				failedMachine("m3", pointer.StringPtr("InsufficientCapacity")),
+				failedMachine("m4", pointer.StringPtr("InvalidAMI")),
+				failedMachine("m5", pointer.StringPtr("InsufficientCapacity")),
+			},
+			expected: "5 machines failed: InsufficientCapacity (3), InvalidAMI (2)",
+		},
+		{
+			name: "messages of the same count sorted by message",
+			machines: []infrav1.AWSMachine{
+				failedMachine("m1", pointer.StringPtr("b")),
+				failedMachine("m2", pointer.StringPtr("a")),
+			},
+			expected: "2 machines failed: a (1), b (1)",
+		},
+		{
+			name: "failure reason without message",
+			machines: []infrav1.AWSMachine{
+				failedMachine("m1", nil),
+			},
+			expected: "1 machine failed: CreateError (1)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := summarizeMachineFailures(tc.machines); got != tc.expected {
+				t.Fatalf("expected summary %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSummarizeMachineFailuresTruncated(t *testing.T) {
+	var machines []infrav1.AWSMachine
+	for i := 0; i < 20; i++ {
+		machines = append(machines, failedMachine("m", pointer.StringPtr(strings.Repeat(string(rune('a'+i)), 40))))
+	}
+
+	summary := summarizeMachineFailures(machines)
+	if len(summary) != maxMachineFailuresMessageLength {
+		t.Fatalf("expected a summary of %d characters, got %d", maxMachineFailuresMessageLength, len(summary))
+	}
+	if !strings.HasPrefix(summary, "20 machines failed: ") || !strings.HasSuffix(summary, "...") {
+		t.Fatalf("expected a truncated summary of 20 failed machines, got %q", summary)
+	}
+}
+
+func TestReconcileMachineFailures(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := infrav1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		options  *infrav1.ControllerOptions
+		machines []infrav1.AWSMachine
+		expected *clusterv1.Condition
+	}{
+		{
+			name:     "aggregation disabled",
+			machines: []infrav1.AWSMachine{failedMachine("m1", pointer.StringPtr("InvalidAMI"))},
+		},
+		{
+			name:     "failed machines",
+			options:  &infrav1.ControllerOptions{AggregateFailureMessages: true},
+			machines: []infrav1.AWSMachine{failedMachine("m1", pointer.StringPtr("InvalidAMI"))},
+			expected: conditions.FalseCondition(infrav1.MachinesHealthyCondition, infrav1.MachineFailuresReason, clusterv1.ConditionSeverityWarning, "1 machine failed: InvalidAMI (1)"),
+		},
+		{
+			name:     "no failed machines",
+			options:  &infrav1.ControllerOptions{AggregateFailureMessages: true},
+			expected: conditions.TrueCondition(infrav1.MachinesHealthyCondition),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster"},
+				Spec:       infrav1.AWSClusterSpec{ControllerOptions: tc.options},
+			}
+			objects := []runtime.Object{awsCluster}
+			for i := range tc.machines {
+				objects = append(objects, &tc.machines[i])
+			}
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster"},
+				},
+				AWSCluster: awsCluster,
+				Client:     fake.NewFakeClientWithScheme(scheme, objects...),
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			if err := reconcileMachineFailures(context.TODO(), clusterScope); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			got := conditions.Get(awsCluster, infrav1.MachinesHealthyCondition)
+			if tc.expected == nil {
+				if got != nil {
+					t.Fatalf("expected no %s condition, got %v", infrav1.MachinesHealthyCondition, got)
+				}
+				return
+			}
+			if got == nil || got.Status != tc.expected.Status || got.Reason != tc.expected.Reason || got.Message != tc.expected.Message {
+				t.Fatalf("expected condition %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
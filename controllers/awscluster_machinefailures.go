@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+)
+
+// maxMachineFailuresMessageLength caps the length of the message of the MachinesHealthy condition.
+const maxMachineFailuresMessageLength = 512
+
+// reconcileMachineFailures reports the failures of the cluster's AWSMachines in the MachinesHealthy condition.
+func reconcileMachineFailures(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	awsCluster := clusterScope.AWSCluster
+	if options := awsCluster.Spec.ControllerOptions; options == nil || !options.AggregateFailureMessages {
+		conditions.Delete(awsCluster, infrav1.MachinesHealthyCondition)
+		return nil
+	}
+
+	machines, err := clusterScope.ListAWSMachines(ctx)
+	if err != nil {
+		return err
+	}
+
+	if summary := summarizeMachineFailures(machines); summary != "" {
+		conditions.MarkFalse(awsCluster, infrav1.MachinesHealthyCondition, infrav1.MachineFailuresReason, clusterv1.ConditionSeverityWarning, summary)
+	} else {
+		conditions.MarkTrue(awsCluster, infrav1.MachinesHealthyCondition)
+	}
+	return nil
+}
+
+// summarizeMachineFailures returns a summary of the failure messages of the failed machines, counting the machines
+// failing with each message, e.g. "5 machines failed: InsufficientCapacity (3), InvalidAMI (2)". It returns an empty
+// string when no machine has failed.
+func summarizeMachineFailures(machines []infrav1.AWSMachine) string {
+	failed := 0
+	counts := map[string]int{}
+	for i := range machines {
+		status := machines[i].Status
+		switch {
+		case status.FailureMessage != nil:
+			counts[*status.FailureMessage]++
+		case status.FailureReason != nil:
+			counts[string(*status.FailureReason)]++
+		default:
+			continue
+		}
+		failed++
+	}
+	if failed == 0 {
+		return ""
+	}
+
+	messages := make([]string, 0, len(counts))
+	for message := range counts {
+		messages = append(messages, message)
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		if counts[messages[i]] != counts[messages[j]] {
+			return counts[messages[i]] > counts[messages[j]]
+		}
+		return messages[i] < messages[j]
+	})
+
+	failures := make([]string, 0, len(messages))
+	for _, message := range messages {
+		failures = append(failures, fmt.Sprintf("%s (%d)", message, counts[message]))
+	}
+
+	noun := "machines"
+	if failed == 1 {
+		noun = "machine"
+	}
+	summary := fmt.Sprintf("%d %s failed: %s", failed, noun, strings.Join(failures, ", "))
+	if len(summary) > maxMachineFailuresMessageLength {
+		summary = summary[:maxMachineFailuresMessageLength-3] + "..."
+	}
+	return summary
+}
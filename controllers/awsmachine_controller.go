@@ -19,7 +19,9 @@ package controllers
 import (
 	"context"
 	"reflect"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -45,17 +47,79 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elbv2"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/imagebuilder"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/hooks"
 )
 
+// postProvisionHookRequeueAfter is the interval at which a failed post-provision hook is retried.
+const postProvisionHookRequeueAfter = 30 * time.Second
+
+// gpuVerificationRequeueAfter is the interval at which the GPU drivers of a machine are verified until they work.
+const gpuVerificationRequeueAfter = 30 * time.Second
+
+// sshKeyVerificationRequeueAfter is the interval at which the SSH host key fingerprints of a machine are
+// verified until its system log holds them.
+const sshKeyVerificationRequeueAfter = 30 * time.Second
+
+// windowsPasswordRequeueAfter is the interval at which the password of a Windows machine is checked until it
+// is generated.
+const windowsPasswordRequeueAfter = 30 * time.Second
+
+// drainRequeueAfter is the interval at which connection draining of a machine's target groups is checked.
+const drainRequeueAfter = 15 * time.Second
+
+// amiCopyRequeueAfter is the interval at which the copy of a machine's AMI from its source region is checked.
+const amiCopyRequeueAfter = 30 * time.Second
+
+// imageBuildRequeueAfter is the interval at which the image built for a machine by its Image Builder pipeline is checked.
+const imageBuildRequeueAfter = time.Minute
+
+// subnetFullRequeueAfter is the interval at which the launch of a machine whose subnets are full is retried.
+const subnetFullRequeueAfter = 5 * time.Minute
+
+// fleetRequeueAfter is the interval at which the EC2 Fleet launching the instance of a machine in fleet mode is checked.
+const fleetRequeueAfter = 30 * time.Second
+
+// secureDeleteRequeueAfter is the interval at which the wipe of the volumes of a deleted machine is checked.
+const secureDeleteRequeueAfter = 30 * time.Second
+
+// stoppedInstanceRequeueAfter is the interval at which the instance of a machine with the stop shutdown behavior
+// is checked while it stops or restarts.
+const stoppedInstanceRequeueAfter = 30 * time.Second
+
 // AWSMachineReconciler reconciles a AwsMachine object
 type AWSMachineReconciler struct {
 	client.Client
 	Log                          logr.Logger
 	Recorder                     record.EventRecorder
+	APITimeouts                  scope.AWSAPITimeoutConfig
 	ec2ServiceFactory            func(*scope.ClusterScope) services.EC2MachineInterface
 	secretsManagerServiceFactory func(*scope.ClusterScope) services.SecretsManagerInterface
+	workloadClientFactory        func(*scope.ClusterScope) (client.Client, error)
+
+	// ControllerNamespace is the namespace of the controller, holding the AMI copy cache.
+	ControllerNamespace string
+
+	// Stats records the reconciliations for the ReconcileSummaries, if set.
+	Stats *ReconcileStats
+}
+
+// ec2ScopeForMachine returns the cluster scope for the region of the machine's
+// subnet, so that machines placed in the cluster's remote region are managed
+// through an EC2 client for that region.
+func ec2ScopeForMachine(machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) *scope.ClusterScope {
+	subnet := machineScope.AWSMachine.Spec.Subnet
+	if subnet == nil || subnet.ID == nil || !clusterScope.IsRemoteSubnet(*subnet.ID) {
+		return clusterScope
+	}
+	if remote := clusterScope.RemoteRegionScope(); remote != nil {
+		return remote
+	}
+	return clusterScope
 }
 
 func (r *AWSMachineReconciler) getEC2Service(scope *scope.ClusterScope) services.EC2MachineInterface {
@@ -63,7 +127,7 @@ func (r *AWSMachineReconciler) getEC2Service(scope *scope.ClusterScope) services
 		return r.ec2ServiceFactory(scope)
 	}
 
-	return ec2.NewService(scope)
+	return ec2.NewService(scope).WithAMICopier(ec2.NewAMICopier(scope, ec2.NewAMICopyCache(r.Client, r.ControllerNamespace)))
 }
 
 func (r *AWSMachineReconciler) getSecretsManagerService(scope *scope.ClusterScope) services.SecretsManagerInterface {
@@ -77,13 +141,20 @@ func (r *AWSMachineReconciler) getSecretsManagerService(scope *scope.ClusterScop
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 
 func (r *AWSMachineReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx := context.TODO()
 	logger := r.Log.WithValues("namespace", req.Namespace, "awsMachine", req.Name)
 
+	started := time.Now()
+	defer func() {
+		r.Stats.Observe(req.Namespace, started, reterr)
+	}()
+
 	// Fetch the AWSMachine instance.
 	awsMachine := &infrav1.AWSMachine{}
 	err := r.Get(ctx, req.NamespacedName, awsMachine)
@@ -135,10 +206,11 @@ func (r *AWSMachineReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 
 	// Create the cluster scope
 	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-		Client:     r.Client,
-		Logger:     logger,
-		Cluster:    cluster,
-		AWSCluster: awsCluster,
+		Client:      r.Client,
+		Logger:      logger,
+		Cluster:     cluster,
+		AWSCluster:  awsCluster,
+		APITimeouts: r.APITimeouts,
 	})
 	if err != nil {
 		return ctrl.Result{}, err
@@ -193,7 +265,7 @@ func (r *AWSMachineReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 
 	// Handle deleted machines
 	if !awsMachine.ObjectMeta.DeletionTimestamp.IsZero() {
-		return r.reconcileDelete(machineScope, clusterScope)
+		return r.reconcileDelete(ctx, machineScope, clusterScope)
 	}
 
 	// Handle non-deleted machines
@@ -202,7 +274,7 @@ func (r *AWSMachineReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reter
 
 func (r *AWSMachineReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
 	controller, err := ctrl.NewControllerManagedBy(mgr).
-		WithOptions(options).
+		WithOptions(workerPoolOptions("awsmachine", options)).
 		For(&infrav1.AWSMachine{}).
 		Watches(
 			&source.Kind{Type: &clusterv1.Machine{}},
@@ -296,10 +368,10 @@ func (r *AWSMachineReconciler) SetupWithManager(mgr ctrl.Manager, options contro
 	)
 }
 
-func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+func (r *AWSMachineReconciler) reconcileDelete(ctx context.Context, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
 	machineScope.Info("Handling deleted AWSMachine")
 
-	ec2Service := r.getEC2Service(clusterScope)
+	ec2Service := r.getEC2Service(ec2ScopeForMachine(machineScope, clusterScope))
 	secretSvc := r.getSecretsManagerService(clusterScope)
 
 	if err := r.deleteEncryptedBootstrapDataSecret(machineScope, secretSvc); err != nil {
@@ -311,6 +383,14 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		return ctrl.Result{}, err
 	}
 
+	// The EC2 Fleet of machines in fleet mode may still launch the instance when it isn't found, which is
+	// terminated along with the fleet then.
+	if machineScope.AWSMachine.Status.FleetID != nil {
+		if err := ec2Service.DeleteFleet(machineScope, instance == nil); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if instance == nil {
 		// The machine was never created or was deleted by some other entity
 		// One way to reach this state:
@@ -321,6 +401,11 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		// 4. Scale controller deployment to 1
 		machineScope.V(2).Info("Unable to locate EC2 instance by ID or tags")
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "NoInstanceFound", "Unable to find matching EC2 instance")
+		if machineScope.AWSMachine.Spec.PlacementGroupAffinityKey != "" {
+			if err := ec2Service.DeletePlacementGroup(machineScope); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
 		controllerutil.RemoveFinalizer(machineScope.AWSMachine, infrav1.MachineFinalizer)
 		return ctrl.Result{}, nil
 	}
@@ -343,6 +428,29 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	case infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated:
 		machineScope.Info("EC2 instance is shutting down or already terminated", "instance-id", instance.ID)
 	default:
+		drained, err := r.reconcilePrepareForDrain(machineScope, clusterScope, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !drained {
+			machineScope.Info("Waiting for connection draining to complete before terminating EC2 instance", "instance-id", instance.ID)
+			return ctrl.Result{RequeueAfter: drainRequeueAfter}, nil
+		}
+
+		// The hook is called once, before the instance is stopped to wipe its volumes.
+		if err := r.callPreTerminationHook(ctx, machineScope, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		wiped, err := r.reconcileSecureDelete(ec2Service, machineScope, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !wiped {
+			machineScope.Info("Waiting for the volumes of the EC2 instance to be wiped before terminating it", "instance-id", instance.ID)
+			return ctrl.Result{RequeueAfter: secureDeleteRequeueAfter}, nil
+		}
+
 		machineScope.Info("Terminating EC2 instance", "instance-id", instance.ID)
 		if err := ec2Service.TerminateInstanceAndWait(instance.ID); err != nil {
 			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedTerminate", "Failed to terminate instance %q: %v", instance.ID, err)
@@ -350,7 +458,7 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 		}
 
 		// If the AWSMachine specifies Network Interfaces, detach the cluster's core Security Groups from them as part of deletion.
-		if len(machineScope.AWSMachine.Spec.NetworkInterfaces) > 0 {
+		if len(machineScope.AWSMachine.Spec.NetworkInterfaces) > 0 && machineScope.AWSMachine.Spec.LaunchTemplateRef == nil {
 			core, err := ec2Service.GetCoreSecurityGroups(machineScope)
 			if err != nil {
 				return ctrl.Result{}, errors.Wrap(err, "failed to get core security groups to detach from instance's network interfaces")
@@ -371,6 +479,15 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 
 		machineScope.Info("EC2 instance successfully terminated", "instance-id", instance.ID)
 		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulTerminate", "Terminated instance %q", instance.ID)
+		r.publishInstanceEvent(machineScope, clusterScope, instance, eventbridge.InstanceTerminated)
+	}
+
+	// The placement group is shared with the other machines of the cluster with the same affinity key,
+	// it is only deleted along with the last of them.
+	if machineScope.AWSMachine.Spec.PlacementGroupAffinityKey != "" {
+		if err := ec2Service.DeletePlacementGroup(machineScope); err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 
 	// Instance is deleted so remove the finalizer.
@@ -379,6 +496,60 @@ func (r *AWSMachineReconciler) reconcileDelete(machineScope *scope.MachineScope,
 	return ctrl.Result{}, nil
 }
 
+// reconcileSecureDelete wipes the volumes of the instance of a deleted machine with SecureDelete, and
+// returns false while they are being wiped. Failures block the termination of the instance.
+func (r *AWSMachineReconciler) reconcileSecureDelete(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) (bool, error) {
+	if !machineScope.AWSMachine.Spec.SecureDelete || conditions.IsTrue(machineScope.AWSMachine, infrav1.VolumesWipedCondition) {
+		return true, nil
+	}
+
+	wiped, err := ec2svc.SecureDeleteInstance(machineScope, instance)
+	if err != nil {
+		severity := clusterv1.ConditionSeverityWarning
+		if ec2.IsVolumeWipeFailed(err) {
+			severity = clusterv1.ConditionSeverityError
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedSecureDelete", "Failed to wipe the volumes of instance %q: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.VolumesWipedCondition, infrav1.VolumeWipeFailedReason, severity, err.Error())
+		return false, errors.Wrap(err, "failed to wipe the volumes of the instance")
+	}
+	if !wiped {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.VolumesWipedCondition, infrav1.VolumeWipeInProgressReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulSecureDelete", "Wiped the volumes of instance %q", instance.ID)
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.VolumesWipedCondition)
+	return true, nil
+}
+
+// callPreTerminationHook calls the pre-termination hook of the AWSMachine, if any, until it was called once.
+// Errors are only returned when the hook failure policy does not allow the termination to proceed.
+func (r *AWSMachineReconciler) callPreTerminationHook(ctx context.Context, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	hook := machineScope.AWSMachine.Spec.PreTerminationHook
+	if hook == nil || conditions.IsTrue(machineScope.AWSMachine, infrav1.PreTerminationHookCalledCondition) {
+		return nil
+	}
+
+	machineScope.V(2).Info("Calling pre-termination hook", "url", hook.URL, "instance-id", instance.ID)
+	payload := &hooks.PreTerminationPayload{
+		MachineID:  machineScope.Machine.Name,
+		InstanceID: instance.ID,
+	}
+	if err := hooks.Call(ctx, hook, payload); err != nil {
+		if !hooks.IgnoreFailure(hook) {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedPreTerminationHook", "Pre-termination hook failed: %v", err)
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.PreTerminationHookCalledCondition, infrav1.PreTerminationHookFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return errors.Wrap(err, "failed to call pre-termination hook")
+		}
+		machineScope.Info("Ignoring failed pre-termination hook", "url", hook.URL, "error", err.Error())
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedPreTerminationHook", "Ignoring failed pre-termination hook: %v", err)
+	}
+
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.PreTerminationHookCalledCondition)
+	return nil
+}
+
 // findInstance queries the EC2 apis and retrieves the instance if it exists, returns nil otherwise.
 func (r *AWSMachineReconciler) findInstance(scope *scope.MachineScope, ec2svc services.EC2MachineInterface) (*infrav1.Instance, error) {
 	// Parse the ProviderID.
@@ -405,7 +576,16 @@ func (r *AWSMachineReconciler) findInstance(scope *scope.MachineScope, ec2svc se
 	return instance, nil
 }
 
-func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+// publishInstanceEvent sends the given lifecycle event of the machine's instance to the event bus of the
+// cluster, if any. Failures are reported but don't fail the reconciliation.
+func (r *AWSMachineReconciler) publishInstanceEvent(machineScope *scope.MachineScope, clusterScope *scope.ClusterScope, instance *infrav1.Instance, state eventbridge.InstanceLifecycleState) {
+	if err := eventbridge.NewEventBridgePublisher(clusterScope).Publish(machineScope.Name(), instance, state); err != nil {
+		machineScope.Error(err, "Failed to send instance lifecycle event", "state", state, "instance-id", instance.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedPublishEvent", "Failed to send %s event of instance %q: %v", state, instance.ID, err)
+	}
+}
+
+func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
 	machineScope.Info("Reconciling AWSMachine")
 
 	secretSvc := r.getSecretsManagerService(clusterScope)
@@ -442,7 +622,7 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		return ctrl.Result{}, nil
 	}
 
-	ec2svc := r.getEC2Service(clusterScope)
+	ec2svc := r.getEC2Service(ec2ScopeForMachine(machineScope, clusterScope))
 
 	// Find existing instance
 	instance, err := r.findInstance(machineScope, ec2svc)
@@ -460,10 +640,38 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 			}
 		}
 		instance, err = r.createInstance(machineScope, ec2svc, secretSvc)
+		if ec2.IsAMICopyPending(err) {
+			machineScope.Info("Waiting for AMI to be copied from its source region", "source-region", machineScope.AWSMachine.Spec.AMISourceRegion)
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForAMICopyReason, clusterv1.ConditionSeverityInfo, "")
+			return ctrl.Result{RequeueAfter: amiCopyRequeueAfter}, nil
+		}
+		if imagebuilder.IsImageBuildPending(err) {
+			machineScope.Info("Waiting for AMI to be built by its image pipeline", "pipeline", machineScope.AWSMachine.Spec.ImageBuilderPipelineARN, "reason", err.Error())
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForImageBuildReason, clusterv1.ConditionSeverityInfo, "")
+			return ctrl.Result{RequeueAfter: imageBuildRequeueAfter}, nil
+		}
+		if ec2.IsSubnetFull(err) {
+			machineScope.Info("Waiting for IP addresses to be available in the subnets of the machine", "reason", err.Error())
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.SubnetFullReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return ctrl.Result{RequeueAfter: subnetFullRequeueAfter}, nil
+		}
+		if ec2.IsFleetPending(err) {
+			machineScope.Info("Waiting for EC2 Fleet to launch the instance", "reason", err.Error())
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForFleetReason, clusterv1.ConditionSeverityInfo, "")
+			return ctrl.Result{RequeueAfter: fleetRequeueAfter}, nil
+		}
 		if err != nil {
 			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, err.Error())
 			return ctrl.Result{}, err
 		}
+		r.publishInstanceEvent(machineScope, clusterScope, instance, eventbridge.InstanceLaunched)
+	}
+
+	// The EC2 Fleet of machines in fleet mode is deleted once it launched the instance, which keeps running.
+	if machineScope.AWSMachine.Status.FleetID != nil {
+		if err := ec2svc.DeleteFleet(machineScope, false); err != nil {
+			machineScope.Error(err, "failed to delete EC2 Fleet", "fleet-id", *machineScope.AWSMachine.Status.FleetID)
+		}
 	}
 
 	// Make sure Spec.ProviderID is always set.
@@ -482,6 +690,7 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 	// TODO(vincepri): Remove this annotation when clusterctl is no longer relevant.
 	machineScope.SetAnnotation("cluster-api-provider-aws", "true")
 
+	var result ctrl.Result
 	switch instance.State {
 	case infrav1.InstanceStatePending:
 		machineScope.SetNotReady()
@@ -489,9 +698,22 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 	case infrav1.InstanceStateStopping, infrav1.InstanceStateStopped:
 		machineScope.SetNotReady()
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStoppedReason, clusterv1.ConditionSeverityError, "")
+		if machineScope.AWSMachine.Spec.ShutdownBehavior == infrav1.ShutdownBehaviorStop {
+			if result, err = r.reconcileStoppedInstance(ctx, ec2svc, machineScope, instance); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
 	case infrav1.InstanceStateRunning:
-		machineScope.SetReady()
 		conditions.MarkTrue(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
+		if r.reconcilePostProvisionHook(ctx, machineScope, instance) {
+			if !machineScope.AWSMachine.Status.Ready {
+				r.publishInstanceEvent(machineScope, clusterScope, instance, eventbridge.InstanceReady)
+			}
+			machineScope.SetReady()
+		} else {
+			machineScope.SetNotReady()
+			result = ctrl.Result{RequeueAfter: postProvisionHookRequeueAfter}
+		}
 	case infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated:
 		machineScope.SetNotReady()
 		machineScope.Info("Unexpected EC2 instance termination", "state", instance.State, "instance-id", *machineScope.GetInstanceID())
@@ -526,6 +748,14 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 		if err := r.reconcileLBAttachment(machineScope, clusterScope, instance); err != nil {
 			return ctrl.Result{}, errors.Errorf("failed to reconcile LB attachment: %+v", err)
 		}
+
+		drained, err := r.reconcilePrepareForDrain(machineScope, clusterScope, instance)
+		if err != nil {
+			return ctrl.Result{}, errors.Errorf("failed to prepare for drain: %+v", err)
+		}
+		if !drained && result.RequeueAfter == 0 {
+			result = ctrl.Result{RequeueAfter: drainRequeueAfter}
+		}
 	}
 
 	// tasks that can only take place during operational instance states
@@ -544,11 +774,304 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 			return ctrl.Result{}, errors.Errorf("failed to apply security groups: %+v", err)
 		}
 		conditions.MarkTrue(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition)
+
+		if err := r.reconcileIAMInstanceProfile(ec2svc, machineScope, instance); err != nil {
+			return ctrl.Result{}, errors.Errorf("failed to update IAM instance profile: %+v", err)
+		}
+
+		if err := r.reconcileDetailedMonitoring(ec2svc, machineScope, instance); err != nil {
+			return ctrl.Result{}, errors.Errorf("failed to update detailed monitoring: %+v", err)
+		}
+
+		if err := r.reconcileCreditSpecification(ec2svc, machineScope, instance); err != nil {
+			return ctrl.Result{}, errors.Errorf("failed to update credit specification: %+v", err)
+		}
+
+		if !r.reconcileGPUDrivers(ec2svc, machineScope, instance) && result.RequeueAfter == 0 {
+			result = ctrl.Result{RequeueAfter: gpuVerificationRequeueAfter}
+		}
+
+		if !r.reconcileSSHKeyVerification(ec2svc, machineScope, instance) && result.RequeueAfter == 0 {
+			result = ctrl.Result{RequeueAfter: sshKeyVerificationRequeueAfter}
+		}
+
+		if !r.reconcileWindowsPassword(ec2svc, machineScope, instance) && result.RequeueAfter == 0 {
+			result = ctrl.Result{RequeueAfter: windowsPasswordRequeueAfter}
+		}
+
+		delegated, err := r.reconcilePrefixDelegation(ctx, ec2svc, machineScope, clusterScope, instance)
+		if err != nil {
+			return ctrl.Result{}, errors.Errorf("failed to reconcile prefix delegation: %+v", err)
+		}
+		if !delegated && result.RequeueAfter == 0 {
+			result = ctrl.Result{RequeueAfter: prefixDelegationRequeueAfter}
+		}
+
+		// Tags synced from node labels are informational, failing to sync them must not fail the machine.
+		if _, err := r.reconcileNodeLabelTags(ctx, ec2svc, machineScope, clusterScope); err != nil {
+			machineScope.Info("Failed to sync node label tags", "instance-id", instance.ID, "error", err.Error())
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedSyncNodeLabelTags", "Failed to sync node label tags of instance %q: %v", instance.ID, err)
+		}
+		if machineScope.AWSMachine.Spec.TagSyncLabelSelector != nil && result.RequeueAfter == 0 {
+			result = ctrl.Result{RequeueAfter: nodeLabelTagSyncInterval}
+		}
+
+		if err := r.reconcileENAExpress(ec2svc, machineScope, instance); err != nil {
+			return ctrl.Result{}, errors.Errorf("failed to update ENA Express: %+v", err)
+		}
 	}
 
+	return result, nil
+}
+
+// reconcileIAMInstanceProfile replaces the IAM instance profile of a running instance
+// when it has changed and hot-swapping is enabled on the AWSMachine.
+func (r *AWSMachineReconciler) reconcileIAMInstanceProfile(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	profile := machineScope.AWSMachine.Spec.IAMInstanceProfile
+	if profile == "" || profile == instance.IAMProfile {
+		return nil
+	}
+
+	if machineScope.AWSMachine.Annotations[infrav1.IAMInstanceProfileHotSwapAnnotation] != "true" {
+		return nil
+	}
+
+	if err := ec2svc.UpdateInstanceIAMProfile(instance.ID, profile); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedUpdateIAMInstanceProfile", "Failed to update IAM instance profile of instance %q to %q: %v", instance.ID, profile, err)
+		return err
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulUpdateIAMInstanceProfile", "Updated IAM instance profile of instance %q from %q to %q", instance.ID, instance.IAMProfile, profile)
+	instance.IAMProfile = profile
+	return nil
+}
+
+// reconcileDetailedMonitoring enables or disables detailed monitoring of a running
+// instance when spec.detailedMonitoring has changed.
+func (r *AWSMachineReconciler) reconcileDetailedMonitoring(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	enabled := machineScope.AWSMachine.Spec.DetailedMonitoring
+	if enabled == instance.DetailedMonitoring {
+		return nil
+	}
+
+	if err := ec2svc.UpdateInstanceMonitoring(instance.ID, enabled); err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedUpdateDetailedMonitoring", "Failed to update detailed monitoring of instance %q: %v", instance.ID, err)
+		return err
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulUpdateDetailedMonitoring", "Set detailed monitoring of instance %q to %v", instance.ID, enabled)
+	instance.DetailedMonitoring = enabled
+	return nil
+}
+
+// reconcileCreditSpecification updates the CPU credit option of a running
+// burstable instance when spec.creditSpecification has changed.
+func (r *AWSMachineReconciler) reconcileCreditSpecification(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	credits := machineScope.AWSMachine.Spec.CreditSpecification
+	if credits == "" || !infrav1.IsBurstableInstanceType(instance.Type) {
+		return nil
+	}
+
+	changed, err := ec2svc.UpdateInstanceCreditSpecification(instance.ID, credits)
+	if err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedUpdateCreditSpecification", "Failed to update credit specification of instance %q: %v", instance.ID, err)
+		return err
+	}
+
+	if changed {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulUpdateCreditSpecification", "Set credit specification of instance %q to %s", instance.ID, credits)
+	}
+	instance.CreditSpecification = credits
+	return nil
+}
+
+// reconcileGPUDrivers verifies the NVIDIA drivers of a running GPU machine until they work once, and
+// returns false while the verification should be retried.
+func (r *AWSMachineReconciler) reconcileGPUDrivers(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) bool {
+	if machineScope.AWSMachine.Spec.GPU == nil || instance.State != infrav1.InstanceStateRunning ||
+		conditions.IsTrue(machineScope.AWSMachine, infrav1.GPUReadyCondition) {
+		return true
+	}
+
+	ready, err := ec2svc.VerifyGPUDrivers(instance.ID)
+	if err != nil {
+		machineScope.Info("GPU driver verification failed", "instance-id", instance.ID, "error", err.Error())
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedVerifyGPUDrivers", "Failed to verify GPU drivers of instance %q: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.GPUReadyCondition, infrav1.GPUVerificationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return false
+	}
+	if !ready {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.GPUReadyCondition, infrav1.GPUVerificationInProgressReason, clusterv1.ConditionSeverityInfo, "")
+		return false
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulVerifyGPUDrivers", "Verified GPU drivers of instance %q", instance.ID)
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.GPUReadyCondition)
+	return true
+}
+
+// reconcileSSHKeyVerification verifies the SSH host key fingerprints of a running machine until they
+// match once, and returns false while the verification should be retried. A mismatch fails the machine.
+func (r *AWSMachineReconciler) reconcileSSHKeyVerification(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) bool {
+	if !machineScope.AWSMachine.Spec.VerifySSHFingerprint || instance.State != infrav1.InstanceStateRunning ||
+		conditions.IsTrue(machineScope.AWSMachine, infrav1.SSHKeyVerifiedCondition) {
+		return true
+	}
+
+	verified, err := ec2svc.VerifyInstanceSSHKey(instance.ID)
+	if ec2.IsSSHHostKeyMismatch(err) {
+		machineScope.Error(err, "SSH host key verification failed", "instance-id", instance.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "SSHKeyMismatch", "SSH host key verification of instance %q failed: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.SSHKeyVerifiedCondition, infrav1.SSHKeyMismatchReason, clusterv1.ConditionSeverityError, err.Error())
+		machineScope.SetFailureReason(infrav1.SecurityViolationMachineError)
+		machineScope.SetFailureMessage(err)
+		return true
+	}
+	if err != nil {
+		machineScope.Info("SSH host key verification failed", "instance-id", instance.ID, "error", err.Error())
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedVerifySSHKey", "Failed to verify SSH host keys of instance %q: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.SSHKeyVerifiedCondition, infrav1.SSHKeyVerificationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return false
+	}
+	if !verified {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.SSHKeyVerifiedCondition, infrav1.SSHKeyVerificationInProgressReason, clusterv1.ConditionSeverityInfo, "")
+		return false
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulVerifySSHKey", "Verified SSH host keys of instance %q", instance.ID)
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.SSHKeyVerifiedCondition)
+	return true
+}
+
+// reconcilePrepareForDrain deregisters the instance of a machine annotated to prepare for drain from its
+// target groups, and returns false until connection draining has completed.
+func (r *AWSMachineReconciler) reconcilePrepareForDrain(machineScope *scope.MachineScope, clusterScope *scope.ClusterScope, instance *infrav1.Instance) (bool, error) {
+	if machineScope.AWSMachine.Annotations[infrav1.PrepareForDrainAnnotation] != "true" ||
+		conditions.IsTrue(machineScope.AWSMachine, infrav1.TargetGroupsDrainedCondition) {
+		return true, nil
+	}
+
+	drained, err := elbv2.NewService(clusterScope).DeregisterInstance(instance.ID)
+	if err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedDeregisterTargets", "Failed to deregister instance %q from target groups: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.TargetGroupsDrainedCondition, infrav1.TargetGroupsDeregistrationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return false, err
+	}
+	if !drained {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.TargetGroupsDrainedCondition, infrav1.TargetGroupsDrainingReason, clusterv1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulDrainTargetGroups", "Deregistered instance %q from target groups", instance.ID)
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.TargetGroupsDrainedCondition)
+	return true, nil
+}
+
+// reconcileWindowsPassword checks that the password of a Windows machine is generated once its instance runs,
+// and returns false while it should be checked again.
+func (r *AWSMachineReconciler) reconcileWindowsPassword(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) bool {
+	if machineScope.AWSMachine.Spec.OSType != infrav1.OSTypeWindows || instance.State != infrav1.InstanceStateRunning ||
+		conditions.IsTrue(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition) {
+		return true
+	}
+
+	// EC2Launch only generates a password for instances with a key pair to encrypt it with.
+	if aws.StringValue(instance.SSHKeyName) == "" {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition, infrav1.WindowsPasswordKeyPairMissingReason, clusterv1.ConditionSeverityWarning,
+			"Instance %q has no key pair to encrypt its password with", instance.ID)
+		return true
+	}
+
+	available, err := ec2svc.WindowsPasswordAvailable(instance.ID)
+	if err != nil {
+		machineScope.Info("Failed to check the password of the Windows instance", "instance-id", instance.ID, "error", err.Error())
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedGetPasswordData", "Failed to get password data of instance %q: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition, infrav1.WindowsPasswordCheckFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return false
+	}
+	if !available {
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition, infrav1.WaitingForWindowsPasswordReason, clusterv1.ConditionSeverityInfo, "")
+		return false
+	}
+
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "WindowsPasswordAvailable", "Password of instance %q is available", instance.ID)
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition)
+	return true
+}
+
+// reconcileStoppedInstance waits for the instance of a machine with the stop shutdown behavior to be stopped by
+// its operating system, then restarts it when RestartOnStop is set, or deletes the machine for its owner to
+// replace it.
+func (r *AWSMachineReconciler) reconcileStoppedInstance(ctx context.Context, ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) (ctrl.Result, error) {
+	if instance.State == infrav1.InstanceStateStopping {
+		machineScope.V(2).Info("Waiting for EC2 instance to stop", "instance-id", instance.ID)
+		return ctrl.Result{RequeueAfter: stoppedInstanceRequeueAfter}, nil
+	}
+
+	if machineScope.AWSMachine.Spec.RestartOnStop {
+		if err := ec2svc.StartInstance(instance.ID); err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStartInstance", "Failed to restart stopped instance %q: %v", instance.ID, err)
+			return ctrl.Result{}, err
+		}
+		machineScope.Info("Restarted stopped EC2 instance", "instance-id", instance.ID)
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStartInstance", "Restarted stopped instance %q", instance.ID)
+		return ctrl.Result{RequeueAfter: stoppedInstanceRequeueAfter}, nil
+	}
+
+	if !machineScope.Machine.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if err := r.Delete(ctx, machineScope.Machine); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to delete Machine %q of stopped instance %q", machineScope.Machine.Name, instance.ID)
+	}
+	machineScope.Info("Deleted Machine of stopped EC2 instance", "instance-id", instance.ID)
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulDeleteStoppedMachine", "Deleted Machine %q of stopped instance %q", machineScope.Machine.Name, instance.ID)
 	return ctrl.Result{}, nil
 }
 
+// reconcileENAExpress applies the ENA Express settings of the AWSMachine to the network interfaces of the instance.
+func (r *AWSMachineReconciler) reconcileENAExpress(ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	settings := machineScope.AWSMachine.Spec.ENAExpressSettings
+	if settings == nil {
+		return nil
+	}
+
+	changed, err := ec2svc.UpdateInstanceENAExpress(instance.ID, *settings)
+	if err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedUpdateENAExpress", "Failed to update ENA Express of instance %q: %v", instance.ID, err)
+		return err
+	}
+
+	if changed {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulUpdateENAExpress", "Updated ENA Express of instance %q", instance.ID)
+	}
+	return nil
+}
+
+// reconcilePostProvisionHook calls the post-provision hook of the AWSMachine until it succeeds once, and
+// returns true if the machine can be marked as ready.
+func (r *AWSMachineReconciler) reconcilePostProvisionHook(ctx context.Context, machineScope *scope.MachineScope, instance *infrav1.Instance) bool {
+	hook := machineScope.AWSMachine.Spec.PostProvisionHook
+	if hook == nil || conditions.IsTrue(machineScope.AWSMachine, infrav1.PostProvisionHookSucceededCondition) {
+		return true
+	}
+
+	machineScope.V(2).Info("Calling post-provision hook", "url", hook.URL, "instance-id", instance.ID)
+	payload := &hooks.PostProvisionPayload{
+		InstanceID: instance.ID,
+		PrivateIP:  aws.StringValue(instance.PrivateIP),
+	}
+	if err := hooks.Call(ctx, hook, payload); err != nil {
+		machineScope.Info("Post-provision hook failed", "url", hook.URL, "error", err.Error())
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedPostProvisionHook", "Post-provision hook failed: %v", err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.PostProvisionHookSucceededCondition, infrav1.PostProvisionHookFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return false
+	}
+
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.PostProvisionHookSucceededCondition)
+	return true
+}
+
 func (r *AWSMachineReconciler) deleteEncryptedBootstrapDataSecret(machineScope *scope.MachineScope, secretSvc services.SecretsManagerInterface) error {
 	// do nothing if there isn't a secret
 	if machineScope.GetSecretPrefix() == "" {
@@ -585,6 +1108,26 @@ func (r *AWSMachineReconciler) createInstance(scope *scope.MachineScope, ec2svc
 		return nil, err
 	}
 
+	if spec := scope.CloudProviderConfig(); spec != nil {
+		userData, err = userdata.AppendCloudProviderConfig(userData, *spec)
+		if err != nil {
+			r.Recorder.Eventf(scope.AWSMachine, corev1.EventTypeWarning, "FailedGenerateCloudProviderConfig", err.Error())
+			return nil, err
+		}
+	}
+
+	if gpu := scope.AWSMachine.Spec.GPU; gpu != nil {
+		userData, err = userdata.AppendGPUDriverInstaller(userData, &userdata.GPUDriverInput{
+			BucketURL:     scope.AWSCluster.Spec.GPUDriverBucketURL,
+			DriverVersion: gpu.DriverVersion,
+			CUDAVersion:   gpu.CUDAVersion,
+		})
+		if err != nil {
+			r.Recorder.Eventf(scope.AWSMachine, corev1.EventTypeWarning, "FailedGenerateGPUDriverInstaller", err.Error())
+			return nil, err
+		}
+	}
+
 	if scope.UseSecretsManager() { // nolint:nestif
 		compressedUserData, err := userdata.GzipBytes(userData)
 		if err != nil {
@@ -19,8 +19,12 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	"sigs.k8s.io/cluster-api/util/conditions"
 
@@ -38,7 +42,9 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/hooks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -81,7 +87,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 				Name: "bootstrap-data",
 			},
 			Data: map[string][]byte{
-				"value": []byte("shell-script"),
+				"value": []byte("#!/bin/bash\nshell-script"),
 			},
 		}
 
@@ -271,6 +277,44 @@ var _ = Describe("AWSMachineReconciler", func() {
 							{conditionType: infrav1.InstanceReadyCondition, status: corev1.ConditionTrue},
 						})
 					})
+
+					It("should set instance to ready once the post-provision hook succeeds", func() {
+						var payload hooks.PostProvisionPayload
+						server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							_ = json.NewDecoder(r.Body).Decode(&payload)
+							w.WriteHeader(http.StatusOK)
+						}))
+						defer server.Close()
+
+						instance.State = infrav1.InstanceStateRunning
+						instance.PrivateIP = pointer.StringPtr("10.0.0.1")
+						ms.AWSMachine.Spec.PostProvisionHook = &infrav1.WebhookSpec{URL: server.URL}
+						_, _ = reconciler.reconcileNormal(context.Background(), ms, cs)
+						Expect(ms.AWSMachine.Status.Ready).To(Equal(true))
+						Expect(payload).To(Equal(hooks.PostProvisionPayload{InstanceID: "myMachine", PrivateIP: "10.0.0.1"}))
+						expectConditions(ms.AWSMachine, []conditionAssertion{
+							{conditionType: infrav1.InstanceReadyCondition, status: corev1.ConditionTrue},
+							{conditionType: infrav1.PostProvisionHookSucceededCondition, status: corev1.ConditionTrue},
+						})
+					})
+
+					It("should not set instance to ready when the post-provision hook times out", func() {
+						server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							time.Sleep(2 * time.Second)
+							w.WriteHeader(http.StatusOK)
+						}))
+						defer server.Close()
+
+						instance.State = infrav1.InstanceStateRunning
+						ms.AWSMachine.Spec.PostProvisionHook = &infrav1.WebhookSpec{URL: server.URL, TimeoutSeconds: 1}
+						_, _ = reconciler.reconcileNormal(context.Background(), ms, cs)
+						Expect(ms.AWSMachine.Status.Ready).To(Equal(false))
+						Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedPostProvisionHook")))
+						expectConditions(ms.AWSMachine, []conditionAssertion{
+							{infrav1.InstanceReadyCondition, corev1.ConditionTrue, "", ""},
+							{infrav1.PostProvisionHookSucceededCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, infrav1.PostProvisionHookFailedReason},
+						})
+					})
 				})
 			})
 
@@ -332,6 +376,125 @@ var _ = Describe("AWSMachineReconciler", func() {
 					_, err := reconciler.reconcileNormal(context.Background(), ms, cs)
 					Expect(err).To(BeNil())
 				})
+
+				It("should hot-swap the IAM instance profile when allowed", func() {
+					instance.IAMProfile = "old-profile"
+					ms.AWSMachine.Spec.IAMInstanceProfile = "new-profile"
+					ms.AWSMachine.Annotations = map[string]string{infrav1.IAMInstanceProfileHotSwapAnnotation: "true"}
+
+					ec2Svc.EXPECT().UpdateInstanceIAMProfile(instance.ID, "new-profile").Return(nil)
+
+					_, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(recorder.Events).To(Receive(ContainSubstring("SuccessfulUpdateIAMInstanceProfile")))
+				})
+
+				It("should enable detailed monitoring on a running instance", func() {
+					ms.AWSMachine.Spec.DetailedMonitoring = true
+
+					ec2Svc.EXPECT().UpdateInstanceMonitoring(instance.ID, true).Return(nil)
+
+					_, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(recorder.Events).To(Receive(ContainSubstring("SuccessfulUpdateDetailedMonitoring")))
+				})
+
+				It("should update the credit specification of a running burstable instance", func() {
+					instance.Type = "t3.medium"
+					ms.AWSMachine.Spec.CreditSpecification = "unlimited"
+
+					ec2Svc.EXPECT().UpdateInstanceCreditSpecification(instance.ID, "unlimited").Return(true, nil)
+
+					_, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(recorder.Events).To(Receive(ContainSubstring("SuccessfulUpdateCreditSpecification")))
+				})
+
+				It("should mark GPUReady once nvidia-smi succeeds on a running GPU instance", func() {
+					instance.State = infrav1.InstanceStateRunning
+					ms.AWSCluster.Spec.GPUDriverBucketURL = "s3://gpu-drivers"
+					ms.AWSMachine.Spec.GPU = &infrav1.GPUSpec{DriverVersion: "450.80.02"}
+
+					ec2Svc.EXPECT().VerifyGPUDrivers(instance.ID).Return(true, nil)
+
+					result, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(result.RequeueAfter).To(BeZero())
+					Expect(conditions.IsTrue(ms.AWSMachine, infrav1.GPUReadyCondition)).To(BeTrue())
+					Expect(recorder.Events).To(Receive(ContainSubstring("SuccessfulVerifyGPUDrivers")))
+				})
+
+				It("should requeue while nvidia-smi has not completed on a GPU instance", func() {
+					instance.State = infrav1.InstanceStateRunning
+					ms.AWSCluster.Spec.GPUDriverBucketURL = "s3://gpu-drivers"
+					ms.AWSMachine.Spec.GPU = &infrav1.GPUSpec{DriverVersion: "450.80.02"}
+
+					ec2Svc.EXPECT().VerifyGPUDrivers(instance.ID).Return(false, nil)
+
+					result, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(result.RequeueAfter).To(Equal(gpuVerificationRequeueAfter))
+					expectConditions(ms.AWSMachine, []conditionAssertion{{infrav1.GPUReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.GPUVerificationInProgressReason}})
+				})
+
+				It("should mark SSHKeyVerified once the SSH host key fingerprints match", func() {
+					instance.State = infrav1.InstanceStateRunning
+					ms.AWSMachine.Spec.VerifySSHFingerprint = true
+
+					ec2Svc.EXPECT().VerifyInstanceSSHKey(instance.ID).Return(true, nil)
+
+					result, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(result.RequeueAfter).To(BeZero())
+					Expect(conditions.IsTrue(ms.AWSMachine, infrav1.SSHKeyVerifiedCondition)).To(BeTrue())
+					Expect(recorder.Events).To(Receive(ContainSubstring("SuccessfulVerifySSHKey")))
+				})
+
+				It("should requeue while the SSH host key fingerprints are not in the system log", func() {
+					instance.State = infrav1.InstanceStateRunning
+					ms.AWSMachine.Spec.VerifySSHFingerprint = true
+
+					ec2Svc.EXPECT().VerifyInstanceSSHKey(instance.ID).Return(false, nil)
+
+					result, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(result.RequeueAfter).To(Equal(sshKeyVerificationRequeueAfter))
+					expectConditions(ms.AWSMachine, []conditionAssertion{{infrav1.SSHKeyVerifiedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.SSHKeyVerificationInProgressReason}})
+				})
+
+				It("should fail the machine when an SSH host key fingerprint does not match", func() {
+					instance.State = infrav1.InstanceStateRunning
+					ms.AWSMachine.Spec.VerifySSHFingerprint = true
+
+					ec2Svc.EXPECT().VerifyInstanceSSHKey(instance.ID).Return(false, &ec2.SSHHostKeyMismatchError{InstanceID: instance.ID, Fingerprint: "SHA256:abc"})
+
+					result, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(result.RequeueAfter).To(BeZero())
+					Expect(ms.AWSMachine.Status.FailureReason).To(PointTo(Equal(infrav1.SecurityViolationMachineError)))
+					expectConditions(ms.AWSMachine, []conditionAssertion{{infrav1.SSHKeyVerifiedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.SSHKeyMismatchReason}})
+					Expect(recorder.Events).To(Receive(ContainSubstring("SSHKeyMismatch")))
+				})
+
+				It("should not hot-swap the IAM instance profile without the annotation", func() {
+					instance.IAMProfile = "old-profile"
+					ms.AWSMachine.Spec.IAMInstanceProfile = "new-profile"
+
+					ec2Svc.EXPECT().UpdateInstanceIAMProfile(gomock.Any(), gomock.Any()).Times(0)
+
+					_, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+				})
+
+				It("should update ENA Express on the network interfaces of a running instance", func() {
+					ms.AWSMachine.Spec.ENAExpressSettings = &infrav1.ENAExpressSpec{Enabled: true}
+
+					ec2Svc.EXPECT().UpdateInstanceENAExpress(instance.ID, infrav1.ENAExpressSpec{Enabled: true}).Return(true, nil)
+
+					_, err := reconciler.reconcileNormal(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(recorder.Events).To(Receive(ContainSubstring("SuccessfulUpdateENAExpress")))
+				})
 			})
 
 			When("temporarily stopping then starting the AWSMachine", func() {
@@ -460,14 +623,14 @@ var _ = Describe("AWSMachineReconciler", func() {
 				instance.State = infrav1.InstanceStateRunning
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
 				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
-				_, _ = reconciler.reconcileDelete(ms, cs)
+				_, _ = reconciler.reconcileDelete(context.Background(), ms, cs)
 			})
 
 			It("should delete the secret if the AWSMachine is in a failure condition", func() {
 				ms.AWSMachine.Status.FailureReason = capierrors.MachineStatusErrorPtr(capierrors.UpdateMachineError)
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
 				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
-				_, _ = reconciler.reconcileDelete(ms, cs)
+				_, _ = reconciler.reconcileDelete(context.Background(), ms, cs)
 			})
 		})
 
@@ -502,14 +665,14 @@ var _ = Describe("AWSMachineReconciler", func() {
 				instance.State = infrav1.InstanceStateRunning
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
 				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
-				_, _ = reconciler.reconcileDelete(ms, cs)
+				_, _ = reconciler.reconcileDelete(context.Background(), ms, cs)
 			})
 
 			It("should delete the secret if the AWSMachine is in a failure condition", func() {
 				ms.AWSMachine.Status.FailureReason = capierrors.MachineStatusErrorPtr(capierrors.UpdateMachineError)
 				secretSvc.EXPECT().Delete(gomock.Any()).Return(nil).Times(1)
 				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil).AnyTimes()
-				_, _ = reconciler.reconcileDelete(ms, cs)
+				_, _ = reconciler.reconcileDelete(context.Background(), ms, cs)
 			})
 		})
 
@@ -557,7 +720,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 			expectedErr := errors.New("no connection available ")
 			ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(nil, expectedErr).AnyTimes()
 
-			_, err := reconciler.reconcileDelete(ms, cs)
+			_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 			Expect(errors.Cause(err)).To(MatchError(expectedErr))
 		})
 
@@ -567,7 +730,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 			buf := new(bytes.Buffer)
 			klog.SetOutput(buf)
 
-			_, err := reconciler.reconcileDelete(ms, cs)
+			_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 			Expect(err).To(BeNil())
 			Expect(buf.String()).To(ContainSubstring("Unable to locate EC2 instance by ID or tags"))
 			Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
@@ -582,7 +745,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 			buf := new(bytes.Buffer)
 			klog.SetOutput(buf)
 
-			_, err := reconciler.reconcileDelete(ms, cs)
+			_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 			Expect(err).To(BeNil())
 			Expect(buf.String()).To(ContainSubstring("EC2 instance is shutting down or already terminated"))
 			Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
@@ -596,7 +759,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 			buf := new(bytes.Buffer)
 			klog.SetOutput(buf)
 
-			_, err := reconciler.reconcileDelete(ms, cs)
+			_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 			Expect(err).To(BeNil())
 			Expect(buf.String()).To(ContainSubstring("EC2 instance is shutting down or already terminated"))
 			Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
@@ -609,6 +772,117 @@ var _ = Describe("AWSMachineReconciler", func() {
 				ec2Svc.EXPECT().GetRunningInstanceByTags(gomock.Any()).Return(&infrav1.Instance{ID: id}, nil)
 			})
 
+			When("there is a pre-termination hook", func() {
+				var server *httptest.Server
+
+				AfterEach(func() {
+					server.Close()
+				})
+
+				It("should not terminate the instance if the hook fails with the Fail policy", func() {
+					server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+					}))
+					ms.AWSMachine.Spec.PreTerminationHook = &infrav1.WebhookSpec{
+						URL:           server.URL,
+						FailurePolicy: infrav1.WebhookFailurePolicyFail,
+					}
+
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).NotTo(BeNil())
+					Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+					Expect(conditions.GetReason(ms.AWSMachine, infrav1.PreTerminationHookCalledCondition)).To(Equal(infrav1.PreTerminationHookFailedReason))
+					Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedPreTerminationHook")))
+				})
+
+				It("should terminate the instance if the hook times out with the Ignore policy", func() {
+					server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						time.Sleep(2 * time.Second)
+						w.WriteHeader(http.StatusOK)
+					}))
+					ms.AWSMachine.Spec.PreTerminationHook = &infrav1.WebhookSpec{
+						URL:            server.URL,
+						TimeoutSeconds: 1,
+						FailurePolicy:  infrav1.WebhookFailurePolicyIgnore,
+					}
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil)
+
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
+				})
+
+				It("should terminate the instance once the hook succeeds", func() {
+					var payload hooks.PreTerminationPayload
+					server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						_ = json.NewDecoder(r.Body).Decode(&payload)
+						w.WriteHeader(http.StatusOK)
+					}))
+					ms.AWSMachine.Spec.PreTerminationHook = &infrav1.WebhookSpec{
+						URL: server.URL,
+					}
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil)
+
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(payload.InstanceID).To(Equal(id))
+					Expect(conditions.IsTrue(ms.AWSMachine, infrav1.PreTerminationHookCalledCondition)).To(BeTrue())
+				})
+
+				It("should not call the hook again once it was called", func() {
+					calls := 0
+					server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						calls++
+						w.WriteHeader(http.StatusOK)
+					}))
+					ms.AWSMachine.Spec.PreTerminationHook = &infrav1.WebhookSpec{
+						URL: server.URL,
+					}
+					conditions.MarkTrue(ms.AWSMachine, infrav1.PreTerminationHookCalledCondition)
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil)
+
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(calls).To(BeZero())
+				})
+			})
+
+			When("secure delete is enabled", func() {
+				BeforeEach(func() {
+					ms.AWSMachine.Spec.SecureDelete = true
+				})
+
+				It("should not terminate the instance while its volumes are being wiped", func() {
+					ec2Svc.EXPECT().SecureDeleteInstance(gomock.Any(), gomock.Any()).Return(false, nil)
+
+					result, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(result.RequeueAfter).To(Equal(secureDeleteRequeueAfter))
+					Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+					Expect(conditions.GetReason(ms.AWSMachine, infrav1.VolumesWipedCondition)).To(Equal(infrav1.VolumeWipeInProgressReason))
+				})
+
+				It("should terminate the instance once its volumes are wiped", func() {
+					ec2Svc.EXPECT().SecureDeleteInstance(gomock.Any(), gomock.Any()).Return(true, nil)
+					ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(nil)
+
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).To(BeNil())
+					Expect(conditions.IsTrue(ms.AWSMachine, infrav1.VolumesWipedCondition)).To(BeTrue())
+					Eventually(recorder.Events).Should(Receive(ContainSubstring("SuccessfulSecureDelete")))
+				})
+
+				It("should not terminate the instance when its volumes can't be wiped", func() {
+					ec2Svc.EXPECT().SecureDeleteInstance(gomock.Any(), gomock.Any()).Return(false, errors.New("failed to attach volume"))
+
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
+					Expect(err).NotTo(BeNil())
+					Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+					Expect(conditions.GetReason(ms.AWSMachine, infrav1.VolumesWipedCondition)).To(Equal(infrav1.VolumeWipeFailedReason))
+					Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedSecureDelete")))
+				})
+			})
+
 			It("should return an error when the instance can't be terminated", func() {
 				expected := errors.New("can't reach AWS to terminate machine")
 				ec2Svc.EXPECT().TerminateInstanceAndWait(gomock.Any()).Return(expected)
@@ -616,7 +890,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 				buf := new(bytes.Buffer)
 				klog.SetOutput(buf)
 
-				_, err := reconciler.reconcileDelete(ms, cs)
+				_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 				Expect(errors.Cause(err)).To(MatchError(expected))
 				Expect(buf.String()).To(ContainSubstring("Terminating EC2 instance"))
 				Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedTerminate")))
@@ -639,7 +913,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 						expected := errors.New("can't reach AWS to list security groups")
 						ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return(nil, expected)
 
-						_, err := reconciler.reconcileDelete(ms, cs)
+						_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 						Expect(errors.Cause(err)).To(MatchError(expected))
 					})
 
@@ -648,7 +922,7 @@ var _ = Describe("AWSMachineReconciler", func() {
 						ec2Svc.EXPECT().GetCoreSecurityGroups(gomock.Any()).Return([]string{"sg0", "sg1"}, nil)
 						ec2Svc.EXPECT().DetachSecurityGroupsFromNetworkInterface(gomock.Any(), gomock.Any()).Return(expected)
 
-						_, err := reconciler.reconcileDelete(ms, cs)
+						_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 						Expect(errors.Cause(err)).To(MatchError(expected))
 					})
 
@@ -658,13 +932,13 @@ var _ = Describe("AWSMachineReconciler", func() {
 						ec2Svc.EXPECT().DetachSecurityGroupsFromNetworkInterface(groups, "eth0").Return(nil)
 						ec2Svc.EXPECT().DetachSecurityGroupsFromNetworkInterface(groups, "eth1").Return(nil)
 
-						_, err := reconciler.reconcileDelete(ms, cs)
+						_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 						Expect(err).To(BeNil())
 					})
 				})
 
 				It("should remove security groups", func() {
-					_, err := reconciler.reconcileDelete(ms, cs)
+					_, err := reconciler.reconcileDelete(context.Background(), ms, cs)
 					Expect(err).To(BeNil())
 					Expect(ms.AWSMachine.Finalizers).To(ConsistOf(metav1.FinalizerDeleteDependents))
 				})
@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nodeLabelTagSyncInterval is the interval at which the labels of a machine's node are synced to its instance tags.
+const nodeLabelTagSyncInterval = 5 * time.Minute
+
+// maxTagKeyLength is the maximum length of the key of an EC2 tag.
+const maxTagKeyLength = 128
+
+// reconcileNodeLabelTags syncs the labels of the machine's node selected by its TagSyncLabelSelector to the tags of
+// its instance, and removes the synced tags of labels which are no longer selected. It returns false until the
+// machine has a node to sync the labels of.
+func (r *AWSMachineReconciler) reconcileNodeLabelTags(ctx context.Context, ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (bool, error) {
+	selector := machineScope.AWSMachine.Spec.TagSyncLabelSelector
+	synced := machineScope.AWSMachine.Status.SyncedTags
+	if selector == nil && len(synced) == 0 {
+		return true, nil
+	}
+
+	var desired map[string]string
+	if selector != nil {
+		nodeRef := machineScope.Machine.Status.NodeRef
+		if nodeRef == nil {
+			return false, nil
+		}
+
+		workloadClient, err := r.getWorkloadClient(ctx, clusterScope)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to create client for workload cluster")
+		}
+		node := &corev1.Node{}
+		if err := workloadClient.Get(ctx, client.ObjectKey{Name: nodeRef.Name}, node); err != nil {
+			return false, errors.Wrapf(err, "failed to get node %q", nodeRef.Name)
+		}
+
+		if desired, err = nodeLabelTags(selector, node.Labels); err != nil {
+			return false, err
+		}
+	}
+
+	created, deleted := tagDrift(synced, desired)
+	if len(created) > 0 || len(deleted) > 0 {
+		if err := ec2svc.UpdateResourceTags(machineScope.GetInstanceID(), created, deleted); err != nil {
+			return false, errors.Wrap(err, "failed to update node label tags")
+		}
+		machineScope.V(2).Info("Synced node label tags", "created", len(created), "deleted", len(deleted))
+	}
+
+	if len(desired) == 0 {
+		desired = nil
+	}
+	machineScope.AWSMachine.Status.SyncedTags = desired
+	return true, nil
+}
+
+// getWorkloadClient returns the client of the workload cluster, which can be overridden by tests.
+func (r *AWSMachineReconciler) getWorkloadClient(ctx context.Context, clusterScope *scope.ClusterScope) (client.Client, error) {
+	if r.workloadClientFactory != nil {
+		return r.workloadClientFactory(clusterScope)
+	}
+	return clusterScope.WorkloadClient(ctx)
+}
+
+// nodeLabelTags returns the instance tags of the node labels selected by the selector. A label is selected when it
+// matches a requirement of the selector on its key, and labels whose tag key would be too long for EC2 are skipped.
+func nodeLabelTags(selector *metav1.LabelSelector, nodeLabels map[string]string) (map[string]string, error) {
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid tag sync label selector")
+	}
+	requirements, _ := parsed.Requirements()
+
+	tags := map[string]string{}
+	for key, value := range nodeLabels {
+		tagKey := infrav1.NodeLabelTagPrefix + key
+		if len(tagKey) > maxTagKeyLength {
+			continue
+		}
+		for _, requirement := range requirements {
+			if requirement.Key() == key && requirement.Matches(labels.Set{key: value}) {
+				tags[tagKey] = value
+				break
+			}
+		}
+	}
+	return tags, nil
+}
+
+// tagDrift returns the tags to create or update, and the tags to delete, for the synced tags to become the desired ones.
+func tagDrift(synced, desired map[string]string) (map[string]string, map[string]string) {
+	created := map[string]string{}
+	for key, value := range desired {
+		if current, ok := synced[key]; !ok || current != value {
+			created[key] = value
+		}
+	}
+
+	deleted := map[string]string{}
+	for key, value := range synced {
+		if _, ok := desired[key]; !ok {
+			deleted[key] = value
+		}
+	}
+	return created, deleted
+}
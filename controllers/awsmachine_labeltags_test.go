@@ -0,0 +1,243 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNodeLabelTags(t *testing.T) {
+	longLabel := strings.Repeat("a", 63) + ".example.com/" + strings.Repeat("b", 63)
+	nodeLabels := map[string]string{
+		"team":                   "payments",
+		"tier":                   "backend",
+		"kubernetes.io/hostname": "ip-10-0-0-1",
+		longLabel:                "too-long",
+	}
+
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		expected map[string]string
+	}{
+		{
+			name:     "match labels select labels by key and value",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments", "tier": "frontend"}},
+			expected: map[string]string{"k8s-label/team": "payments"},
+		},
+		{
+			name: "match expressions select labels by key",
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpExists},
+					{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"backend", "frontend"}},
+					{Key: "kubernetes.io/hostname", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			expected: map[string]string{"k8s-label/team": "payments", "k8s-label/tier": "backend"},
+		},
+		{
+			name: "labels too long for a tag key are skipped",
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: longLabel, Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+			expected: map[string]string{},
+		},
+		{
+			name:     "an empty selector selects nothing",
+			selector: &metav1.LabelSelector{},
+			expected: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, err := nodeLabelTags(tt.selector, nodeLabels)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tags, tt.expected) {
+				t.Errorf("expected tags %v, got %v", tt.expected, tags)
+			}
+		})
+	}
+}
+
+func TestTagDrift(t *testing.T) {
+	synced := map[string]string{
+		"k8s-label/team": "payments",
+		"k8s-label/tier": "backend",
+		"k8s-label/zone": "a",
+	}
+	desired := map[string]string{
+		"k8s-label/team": "payments",
+		"k8s-label/tier": "frontend",
+		"k8s-label/env":  "prod",
+	}
+
+	created, deleted := tagDrift(synced, desired)
+	if expected := map[string]string{"k8s-label/tier": "frontend", "k8s-label/env": "prod"}; !reflect.DeepEqual(created, expected) {
+		t.Errorf("expected created tags %v, got %v", expected, created)
+	}
+	if expected := map[string]string{"k8s-label/zone": "a"}; !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("expected deleted tags %v, got %v", expected, deleted)
+	}
+
+	created, deleted = tagDrift(desired, desired)
+	if len(created) != 0 || len(deleted) != 0 {
+		t.Errorf("expected no drift, got %v created and %v deleted", created, deleted)
+	}
+}
+
+func TestReconcileNodeLabelTags(t *testing.T) {
+	instanceID := "i-0123456789abcdef0"
+
+	newScopes := func(t *testing.T, selector *metav1.LabelSelector, synced map[string]string, nodeRef *corev1.ObjectReference) (*scope.MachineScope, *scope.ClusterScope) {
+		scheme, err := setupScheme()
+		if err != nil {
+			t.Fatalf("failed to set up scheme: %v", err)
+		}
+		cluster := newCluster("test-cluster")
+		awsCluster := &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		awsMachine := &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: infrav1.AWSMachineSpec{
+				ProviderID:           pointer.StringPtr("aws:///us-east-1a/" + instanceID),
+				TagSyncLabelSelector: selector,
+			},
+			Status: infrav1.AWSMachineStatus{SyncedTags: synced},
+		}
+		machine := newMachine("test-cluster", "test")
+		machine.Status.NodeRef = nodeRef
+		c := fake.NewFakeClientWithScheme(scheme, awsMachine)
+
+		machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+			Client:     c,
+			Cluster:    cluster,
+			Machine:    machine,
+			AWSCluster: awsCluster,
+			AWSMachine: awsMachine,
+		})
+		if err != nil {
+			t.Fatalf("failed to create machine scope: %v", err)
+		}
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client:     c,
+			Cluster:    cluster,
+			AWSCluster: awsCluster,
+		})
+		if err != nil {
+			t.Fatalf("failed to create cluster scope: %v", err)
+		}
+		return machineScope, clusterScope
+	}
+
+	workloadClient := fake.NewFakeClientWithScheme(clientgoscheme.Scheme, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "ip-10-0-0-1",
+			Labels: map[string]string{"team": "payments", "tier": "backend"},
+		},
+	})
+	r := &AWSMachineReconciler{
+		workloadClientFactory: func(*scope.ClusterScope) (client.Client, error) {
+			return workloadClient, nil
+		},
+	}
+	nodeRef := &corev1.ObjectReference{Kind: "Node", Name: "ip-10-0-0-1"}
+	selector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpExists}},
+	}
+
+	t.Run("syncs the selected labels and removes the tags of unselected ones", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, selector, map[string]string{"k8s-label/tier": "backend"}, nodeRef)
+		ec2Svc.EXPECT().UpdateResourceTags(pointer.StringPtr(instanceID),
+			map[string]string{"k8s-label/team": "payments"},
+			map[string]string{"k8s-label/tier": "backend"},
+		).Return(nil)
+
+		synced, err := r.reconcileNodeLabelTags(context.TODO(), ec2Svc, machineScope, clusterScope)
+		if err != nil || !synced {
+			t.Fatalf("expected the tags to be synced, got %v and error %v", synced, err)
+		}
+		if expected := map[string]string{"k8s-label/team": "payments"}; !reflect.DeepEqual(machineScope.AWSMachine.Status.SyncedTags, expected) {
+			t.Errorf("expected synced tags %v, got %v", expected, machineScope.AWSMachine.Status.SyncedTags)
+		}
+	})
+
+	t.Run("leaves tags in sync alone", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, selector, map[string]string{"k8s-label/team": "payments"}, nodeRef)
+
+		if _, err := r.reconcileNodeLabelTags(context.TODO(), ec2Svc, machineScope, clusterScope); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("waits for the node", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, selector, nil, nil)
+
+		synced, err := r.reconcileNodeLabelTags(context.TODO(), ec2Svc, machineScope, clusterScope)
+		if err != nil || synced {
+			t.Fatalf("expected to wait for the node, got %v and error %v", synced, err)
+		}
+	})
+
+	t.Run("removes the synced tags when the selector is removed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, nil, map[string]string{"k8s-label/team": "payments"}, nodeRef)
+		ec2Svc.EXPECT().UpdateResourceTags(pointer.StringPtr(instanceID),
+			map[string]string{},
+			map[string]string{"k8s-label/team": "payments"},
+		).Return(nil)
+
+		if _, err := r.reconcileNodeLabelTags(context.TODO(), ec2Svc, machineScope, clusterScope); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if machineScope.AWSMachine.Status.SyncedTags != nil {
+			t.Errorf("expected no synced tags, got %v", machineScope.AWSMachine.Status.SyncedTags)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// prefixDelegationRequeueAfter is how long to wait for the node of a machine with prefix delegation to be annotated.
+const prefixDelegationRequeueAfter = 30 * time.Second
+
+// reconcilePrefixDelegation assigns IPv4 prefixes to the primary network interface of a running machine with
+// PrefixDelegation once, then annotates its node with the maximum number of pods of its instance type. It returns
+// false until the machine has a node to annotate.
+func (r *AWSMachineReconciler) reconcilePrefixDelegation(ctx context.Context, ec2svc services.EC2MachineInterface, machineScope *scope.MachineScope, clusterScope *scope.ClusterScope, instance *infrav1.Instance) (bool, error) {
+	if !machineScope.AWSMachine.Spec.PrefixDelegation || instance.State != infrav1.InstanceStateRunning {
+		return true, nil
+	}
+
+	if len(machineScope.AWSMachine.Status.IPv4Prefixes) == 0 {
+		prefixes, err := ec2svc.AssignIPv4Prefixes(instance.ID, instance.Type)
+		if err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedAssignIPv4Prefixes", "Failed to assign IPv4 prefixes to instance %q: %v", instance.ID, err)
+			return false, err
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulAssignIPv4Prefixes", "Assigned IPv4 prefixes %v to instance %q", prefixes, instance.ID)
+		machineScope.AWSMachine.Status.IPv4Prefixes = prefixes
+	}
+
+	nodeRef := machineScope.Machine.Status.NodeRef
+	if nodeRef == nil {
+		return false, nil
+	}
+
+	maxPods, err := ec2svc.MaxPodsWithPrefixDelegation(instance.Type)
+	if err != nil {
+		return false, err
+	}
+	workloadClient, err := r.getWorkloadClient(ctx, clusterScope)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create client for workload cluster")
+	}
+	node := &corev1.Node{}
+	if err := workloadClient.Get(ctx, client.ObjectKey{Name: nodeRef.Name}, node); err != nil {
+		return false, errors.Wrapf(err, "failed to get node %q", nodeRef.Name)
+	}
+
+	value := strconv.FormatInt(maxPods, 10)
+	if node.Annotations[infrav1.MaxPodsAnnotation] == value {
+		return true, nil
+	}
+	patch := client.MergeFrom(node.DeepCopy())
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[infrav1.MaxPodsAnnotation] = value
+	if err := workloadClient.Patch(ctx, node, patch); err != nil {
+		return false, errors.Wrapf(err, "failed to annotate node %q", nodeRef.Name)
+	}
+	machineScope.V(2).Info("Annotated node with its maximum number of pods", "node", nodeRef.Name, "max-pods", maxPods)
+	return true, nil
+}
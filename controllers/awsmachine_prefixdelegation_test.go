@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcilePrefixDelegation(t *testing.T) {
+	instance := &infrav1.Instance{ID: "i-1", Type: "m5.large", State: infrav1.InstanceStateRunning}
+
+	newScopes := func(t *testing.T, prefixes []string, nodeRef *corev1.ObjectReference) (*scope.MachineScope, *scope.ClusterScope) {
+		scheme, err := setupScheme()
+		if err != nil {
+			t.Fatalf("failed to set up scheme: %v", err)
+		}
+		cluster := newCluster("test-cluster")
+		awsCluster := &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		awsMachine := &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       infrav1.AWSMachineSpec{PrefixDelegation: true},
+			Status:     infrav1.AWSMachineStatus{IPv4Prefixes: prefixes},
+		}
+		machine := newMachine("test-cluster", "test")
+		machine.Status.NodeRef = nodeRef
+		c := fake.NewFakeClientWithScheme(scheme, awsMachine)
+
+		machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+			Client:     c,
+			Cluster:    cluster,
+			Machine:    machine,
+			AWSCluster: awsCluster,
+			AWSMachine: awsMachine,
+		})
+		if err != nil {
+			t.Fatalf("failed to create machine scope: %v", err)
+		}
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client:     c,
+			Cluster:    cluster,
+			AWSCluster: awsCluster,
+		})
+		if err != nil {
+			t.Fatalf("failed to create cluster scope: %v", err)
+		}
+		return machineScope, clusterScope
+	}
+
+	workloadClient := fake.NewFakeClientWithScheme(clientgoscheme.Scheme, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "ip-10-0-1-10"},
+	})
+	r := &AWSMachineReconciler{
+		Recorder: record.NewFakeRecorder(10),
+		workloadClientFactory: func(*scope.ClusterScope) (client.Client, error) {
+			return workloadClient, nil
+		},
+	}
+	nodeRef := &corev1.ObjectReference{Kind: "Node", Name: "ip-10-0-1-10"}
+
+	t.Run("assigns prefixes and waits for the node", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, nil, nil)
+		ec2Svc.EXPECT().AssignIPv4Prefixes("i-1", "m5.large").Return([]string{"10.0.1.32/28"}, nil)
+
+		done, err := r.reconcilePrefixDelegation(context.TODO(), ec2Svc, machineScope, clusterScope, instance)
+		if err != nil || done {
+			t.Fatalf("expected to wait for the node, got %v and error %v", done, err)
+		}
+		if expected := []string{"10.0.1.32/28"}; !reflect.DeepEqual(machineScope.AWSMachine.Status.IPv4Prefixes, expected) {
+			t.Errorf("expected prefixes %v, got %v", expected, machineScope.AWSMachine.Status.IPv4Prefixes)
+		}
+	})
+
+	t.Run("annotates the node with its maximum number of pods", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, []string{"10.0.1.32/28"}, nodeRef)
+		ec2Svc.EXPECT().MaxPodsWithPrefixDelegation("m5.large").Return(int64(110), nil)
+
+		done, err := r.reconcilePrefixDelegation(context.TODO(), ec2Svc, machineScope, clusterScope, instance)
+		if err != nil || !done {
+			t.Fatalf("expected the node to be annotated, got %v and error %v", done, err)
+		}
+		node := &corev1.Node{}
+		if err := workloadClient.Get(context.TODO(), client.ObjectKey{Name: nodeRef.Name}, node); err != nil {
+			t.Fatal(err)
+		}
+		if node.Annotations[infrav1.MaxPodsAnnotation] != "110" {
+			t.Errorf("expected the node to be annotated with 110 pods, got %v", node.Annotations)
+		}
+	})
+
+	t.Run("does nothing without prefix delegation", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, clusterScope := newScopes(t, nil, nodeRef)
+		machineScope.AWSMachine.Spec.PrefixDelegation = false
+
+		done, err := r.reconcilePrefixDelegation(context.TODO(), ec2Svc, machineScope, clusterScope, instance)
+		if err != nil || !done {
+			t.Fatalf("expected nothing to do, got %v and error %v", done, err)
+		}
+	})
+}
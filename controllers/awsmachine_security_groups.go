@@ -44,9 +44,16 @@ func (r *AWSMachineReconciler) ensureSecurityGroups(ec2svc service.EC2MachineInt
 		return false, err
 	}
 
-	core, err := ec2svc.GetCoreSecurityGroups(scope)
-	if err != nil {
-		return false, err
+	// Instances launched from a launch template keep the security groups of the template,
+	// unless they are overridden by additional ones.
+	var core []string
+	if scope.AWSMachine.Spec.LaunchTemplateRef == nil {
+		core, err = ec2svc.GetCoreSecurityGroups(scope)
+		if err != nil {
+			return false, err
+		}
+	} else if len(additional) == 0 && len(annotation) == 0 {
+		return false, nil
 	}
 	changed, ids := r.securityGroupsChanged(annotation, core, additional, existing)
 	if !changed {
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileStoppedInstance(t *testing.T) {
+	newScope := func(t *testing.T, restartOnStop bool) (*scope.MachineScope, client.Client) {
+		scheme, err := setupScheme()
+		if err != nil {
+			t.Fatalf("failed to set up scheme: %v", err)
+		}
+		awsMachine := &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: infrav1.AWSMachineSpec{
+				ShutdownBehavior: infrav1.ShutdownBehaviorStop,
+				RestartOnStop:    restartOnStop,
+			},
+		}
+		machine := newMachine("test-cluster", "test")
+		c := fake.NewFakeClientWithScheme(scheme, awsMachine, machine)
+
+		machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+			Client:     c,
+			Cluster:    newCluster("test-cluster"),
+			Machine:    machine,
+			AWSCluster: &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+			AWSMachine: awsMachine,
+		})
+		if err != nil {
+			t.Fatalf("failed to create machine scope: %v", err)
+		}
+		return machineScope, c
+	}
+
+	t.Run("waits for the instance to stop", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, c := newScope(t, true)
+		r := &AWSMachineReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+		instance := &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateStopping}
+		result, err := r.reconcileStoppedInstance(context.TODO(), ec2Svc, machineScope, instance)
+		if err != nil || result.RequeueAfter != stoppedInstanceRequeueAfter {
+			t.Fatalf("expected to wait for the instance to stop, got %v and error %v", result, err)
+		}
+	})
+
+	t.Run("restarts the stopped instance", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, c := newScope(t, true)
+		r := &AWSMachineReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+		ec2Svc.EXPECT().StartInstance("i-1").Return(nil)
+
+		instance := &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateStopped}
+		result, err := r.reconcileStoppedInstance(context.TODO(), ec2Svc, machineScope, instance)
+		if err != nil || result.RequeueAfter != stoppedInstanceRequeueAfter {
+			t.Fatalf("expected the instance to be restarted, got %v and error %v", result, err)
+		}
+	})
+
+	t.Run("deletes the machine of the stopped instance", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+
+		machineScope, c := newScope(t, false)
+		r := &AWSMachineReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+		instance := &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateStopped}
+		if _, err := r.reconcileStoppedInstance(context.TODO(), ec2Svc, machineScope, instance); err != nil {
+			t.Fatalf("failed to delete the machine: %v", err)
+		}
+		err := c.Get(context.TODO(), client.ObjectKey{Namespace: machineScope.Machine.Namespace, Name: machineScope.Machine.Name}, &clusterv1.Machine{})
+		if !apierrors.IsNotFound(err) {
+			t.Fatalf("expected the machine to be deleted, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileWindowsPassword(t *testing.T) {
+	newScope := func(t *testing.T) *scope.MachineScope {
+		scheme, err := setupScheme()
+		if err != nil {
+			t.Fatalf("failed to set up scheme: %v", err)
+		}
+		awsMachine := &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       infrav1.AWSMachineSpec{OSType: infrav1.OSTypeWindows},
+		}
+		machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+			Client:     fake.NewFakeClientWithScheme(scheme, awsMachine),
+			Cluster:    newCluster("test-cluster"),
+			Machine:    newMachine("test-cluster", "test"),
+			AWSCluster: &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+			AWSMachine: awsMachine,
+		})
+		if err != nil {
+			t.Fatalf("failed to create machine scope: %v", err)
+		}
+		return machineScope
+	}
+	r := &AWSMachineReconciler{Recorder: record.NewFakeRecorder(10)}
+	instance := &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateRunning, SSHKeyName: aws.String("windows")}
+
+	t.Run("polls the password until it is available", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+		machineScope := newScope(t)
+
+		gomock.InOrder(
+			ec2Svc.EXPECT().WindowsPasswordAvailable("i-1").Return(false, nil),
+			ec2Svc.EXPECT().WindowsPasswordAvailable("i-1").Return(false, errors.New("boom")),
+			ec2Svc.EXPECT().WindowsPasswordAvailable("i-1").Return(true, nil),
+		)
+
+		if r.reconcileWindowsPassword(ec2Svc, machineScope, instance) {
+			t.Fatal("expected to wait for the password to be generated")
+		}
+		if reason := conditions.GetReason(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition); reason != infrav1.WaitingForWindowsPasswordReason {
+			t.Fatalf("expected reason %q, got %q", infrav1.WaitingForWindowsPasswordReason, reason)
+		}
+		if r.reconcileWindowsPassword(ec2Svc, machineScope, instance) {
+			t.Fatal("expected to check the password again after a failure")
+		}
+		if reason := conditions.GetReason(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition); reason != infrav1.WindowsPasswordCheckFailedReason {
+			t.Fatalf("expected reason %q, got %q", infrav1.WindowsPasswordCheckFailedReason, reason)
+		}
+		if !r.reconcileWindowsPassword(ec2Svc, machineScope, instance) {
+			t.Fatal("expected the password to be available")
+		}
+		if !conditions.IsTrue(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition) {
+			t.Fatal("expected the WindowsPasswordAvailable condition to be true")
+		}
+
+		// The password is not checked again once it was available.
+		if !r.reconcileWindowsPassword(ec2Svc, machineScope, instance) {
+			t.Fatal("expected nothing to do once the password was available")
+		}
+	})
+
+	t.Run("reports instances without a key pair", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ec2Svc := mock_services.NewMockEC2MachineInterface(mockCtrl)
+		machineScope := newScope(t)
+
+		instance := &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateRunning}
+		if !r.reconcileWindowsPassword(ec2Svc, machineScope, instance) {
+			t.Fatal("expected the password not to be checked without a key pair")
+		}
+		if reason := conditions.GetReason(machineScope.AWSMachine, infrav1.WindowsPasswordAvailableCondition); reason != infrav1.WindowsPasswordKeyPairMissingReason {
+			t.Fatalf("expected reason %q, got %q", infrav1.WindowsPasswordKeyPairMissingReason, reason)
+		}
+	})
+}
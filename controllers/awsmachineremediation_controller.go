@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// AWSMachineRemediationReconciler reconciles a AWSMachineRemediation object
+type AWSMachineRemediationReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachineremediations;awsmachineremediationtemplates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachineremediations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+
+func (r *AWSMachineRemediationReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx := context.TODO()
+	logger := r.Log.WithValues("namespace", req.Namespace, "awsMachineRemediation", req.Name)
+
+	// Fetch the AWSMachineRemediation instance.
+	remediation := &infrav1.AWSMachineRemediation{}
+	if err := r.Get(ctx, req.NamespacedName, remediation); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !remediation.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Machine being remediated.
+	machine, err := util.GetOwnerMachine(ctx, r.Client, remediation.ObjectMeta)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("Machine has already been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if machine == nil {
+		logger.Info("MachineHealthCheck has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("machine", machine.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		logger.Info("Machine is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	if util.IsPaused(cluster, remediation) {
+		logger.Info("AWSMachineRemediation or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("cluster", cluster.Name)
+
+	patchHelper, err := patch.NewHelper(remediation, r.Client)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to init patch helper")
+	}
+
+	// Always persist the AWSMachineRemediation status when exiting this function.
+	defer func() {
+		if err := patchHelper.Patch(ctx, remediation); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	return r.reconcileNormal(ctx, logger, remediation, machine)
+}
+
+func (r *AWSMachineRemediationReconciler) reconcileNormal(ctx context.Context, logger logr.Logger, remediation *infrav1.AWSMachineRemediation, machine *clusterv1.Machine) (ctrl.Result, error) {
+	// The remediation already completed, nothing left to do.
+	if conditions.GetReason(remediation, infrav1.RemediationInProgressCondition) == infrav1.RemediationCompletedReason {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Remediating unhealthy machine")
+
+	// Delete the Machine so that its owner creates a replacement. The AWSMachine reconciler
+	// takes care of terminating the instance and cleaning up after it.
+	if err := r.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		r.Recorder.Eventf(remediation, corev1.EventTypeWarning, "FailedRemediation", "Failed to delete Machine %q: %v", machine.Name, err)
+		conditions.MarkFalse(remediation, infrav1.RemediationInProgressCondition, infrav1.RemediationFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return ctrl.Result{}, errors.Wrapf(err, "failed to delete Machine %q", machine.Name)
+	}
+
+	logger.Info("Remediation of unhealthy machine completed")
+	r.Recorder.Eventf(remediation, corev1.EventTypeNormal, "SuccessfulRemediation", "Machine %q was remediated", machine.Name)
+	now := metav1.Now()
+	remediation.Status.LastRemediated = &now
+	conditions.MarkFalse(remediation, infrav1.RemediationInProgressCondition, infrav1.RemediationCompletedReason, clusterv1.ConditionSeverityInfo, "")
+
+	return ctrl.Result{}, nil
+}
+
+func (r *AWSMachineRemediationReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(workerPoolOptions("awsmachineremediation", options)).
+		For(&infrav1.AWSMachineRemediation{}).
+		WithEventFilter(pausedPredicates(r.Log)).
+		Complete(r)
+}
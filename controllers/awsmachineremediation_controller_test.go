@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("AWSMachineRemediationReconciler", func() {
+	var (
+		reconciler  AWSMachineRemediationReconciler
+		remediation *infrav1.AWSMachineRemediation
+		objects     []runtime.Object
+		ctx         context.Context
+	)
+
+	remediationKey := client.ObjectKey{Namespace: "default", Name: "unhealthy"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		cluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+			Spec: clusterv1.ClusterSpec{
+				InfrastructureRef: &corev1.ObjectReference{Name: "test"},
+			},
+		}
+		machine := &clusterv1.Machine{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Machine",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "unhealthy",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName:       "test",
+				InfrastructureRef: corev1.ObjectReference{Name: "unhealthy"},
+			},
+		}
+		awsMachine := &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "default",
+				Name:       "unhealthy",
+				Finalizers: []string{infrav1.MachineFinalizer},
+			},
+			Spec: infrav1.AWSMachineSpec{
+				ProviderID: pointer.StringPtr("aws:////i-unhealthy"),
+			},
+		}
+		remediation = &infrav1.AWSMachineRemediation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "unhealthy",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       "unhealthy",
+					},
+				},
+			},
+		}
+		objects = []runtime.Object{cluster, machine, awsMachine}
+	})
+
+	setupReconciler := func() {
+		reconciler = AWSMachineRemediationReconciler{
+			Client:   fake.NewFakeClient(append(objects, remediation)...),
+			Log:      log.Log,
+			Recorder: record.NewFakeRecorder(10),
+		}
+	}
+
+	It("should delete the machine and leave the AWSMachine to its reconciler", func() {
+		setupReconciler()
+
+		result, err := reconciler.Reconcile(ctrl.Request{NamespacedName: remediationKey})
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		updated := &infrav1.AWSMachineRemediation{}
+		Expect(reconciler.Get(ctx, remediationKey, updated)).To(Succeed())
+		Expect(updated.Status.LastRemediated).NotTo(BeNil())
+		Expect(conditions.IsFalse(updated, infrav1.RemediationInProgressCondition)).To(BeTrue())
+		Expect(conditions.GetReason(updated, infrav1.RemediationInProgressCondition)).To(Equal(infrav1.RemediationCompletedReason))
+
+		err = reconciler.Get(ctx, client.ObjectKey{Namespace: "default", Name: "unhealthy"}, &clusterv1.Machine{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		updatedAWSMachine := &infrav1.AWSMachine{}
+		Expect(reconciler.Get(ctx, client.ObjectKey{Namespace: "default", Name: "unhealthy"}, updatedAWSMachine)).To(Succeed())
+		Expect(updatedAWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+	})
+
+	It("should do nothing if the machine was already deleted", func() {
+		objects = objects[:1]
+		setupReconciler()
+
+		result, err := reconciler.Reconcile(ctrl.Request{NamespacedName: remediationKey})
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		updated := &infrav1.AWSMachineRemediation{}
+		Expect(reconciler.Get(ctx, remediationKey, updated)).To(Succeed())
+		Expect(updated.Status.LastRemediated).To(BeNil())
+	})
+})
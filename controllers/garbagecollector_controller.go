@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/gc"
+)
+
+// GarbageCollectionInterval is the interval at which the garbage collector looks for orphaned resources.
+const GarbageCollectionInterval = time.Hour
+
+// GarbageCollector deletes the NAT gateways, elastic IPs and security groups owned by clusters that no
+// longer exist, which a controller crashing while deleting a cluster can leave behind. Only the resources
+// tagged with the ID of this management cluster are collected, so that the resources of the clusters of
+// other management clusters sharing the AWS account are left alone.
+type GarbageCollector struct {
+	client.Client
+	Log logr.Logger
+
+	// Regions are the AWS regions in which orphaned resources are collected.
+	Regions []string
+
+	// ManagementClusterID is the ID the AWSClusterReconciler tags the resources of the clusters with.
+	ManagementClusterID string
+
+	// DryRun only reports the orphaned resources, without deleting them.
+	DryRun bool
+
+	gcServiceFactory func(region string) (services.GarbageCollectorInterface, error)
+}
+
+func (r *GarbageCollector) getGCService(region string) (services.GarbageCollectorInterface, error) {
+	if r.gcServiceFactory != nil {
+		return r.gcServiceFactory(region)
+	}
+
+	session, err := scope.SessionForRegion(region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create aws session for region %q", region)
+	}
+	return gc.NewService(ec2.New(session), resourcegroupstaggingapi.New(session)), nil
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
+
+// SetupWithManager runs the garbage collector with the manager, only on the leader.
+func (r *GarbageCollector) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(r)
+}
+
+// Start implements manager.Runnable. It collects orphaned resources every GarbageCollectionInterval
+// until the stop channel is closed.
+func (r *GarbageCollector) Start(stop <-chan struct{}) error {
+	wait.Until(func() {
+		if err := r.Collect(context.Background()); err != nil {
+			r.Log.Error(err, "failed to collect orphaned resources")
+		}
+	}, GarbageCollectionInterval, stop)
+	return nil
+}
+
+// Collect deletes the orphaned resources of all regions. Failing to delete a resource does not stop
+// the collection, it is retried on the next run.
+func (r *GarbageCollector) Collect(ctx context.Context) error {
+	var errs []error
+	for _, region := range r.Regions {
+		if err := r.collectRegion(ctx, region); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *GarbageCollector) collectRegion(ctx context.Context, region string) error {
+	log := r.Log.WithValues("region", region)
+
+	gcService, err := r.getGCService(region)
+	if err != nil {
+		return err
+	}
+
+	resources, err := gcService.ListClusterResources(r.ManagementClusterID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cluster resources in region %q", region)
+	}
+
+	// Clusters are listed after their resources, so that the resources of a cluster created in between
+	// are not mistaken for orphans.
+	clusters, err := r.existingClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, resource := range resources {
+		if clusters[clusterKey(resource.ClusterNamespace, resource.ClusterName)] {
+			continue
+		}
+
+		log := log.WithValues("namespace", resource.ClusterNamespace, "cluster", resource.ClusterName, "type", resource.Type, "id", resource.ID)
+		if r.DryRun {
+			log.Info("Found orphaned resource, not deleting it in dry run mode")
+			continue
+		}
+		log.Info("Deleting orphaned resource")
+		if err := gcService.DeleteResource(resource); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// existingClusters returns the namespaced names of the clusters of all AWSClusters, see clusterKey.
+func (r *GarbageCollector) existingClusters(ctx context.Context) (map[string]bool, error) {
+	awsClusters := &infrav1.AWSClusterList{}
+	if err := r.List(ctx, awsClusters); err != nil {
+		return nil, errors.Wrap(err, "failed to list AWSClusters")
+	}
+
+	// Resources are tagged with the name of the Cluster, which usually is the name of the AWSCluster too.
+	clusters := map[string]bool{}
+	for _, awsCluster := range awsClusters.Items {
+		clusters[clusterKey(awsCluster.Namespace, awsCluster.Name)] = true
+		for _, ref := range awsCluster.OwnerReferences {
+			if ref.Kind == "Cluster" && ref.APIVersion == clusterv1.GroupVersion.String() {
+				clusters[clusterKey(awsCluster.Namespace, ref.Name)] = true
+			}
+		}
+		if name, ok := awsCluster.Labels[clusterv1.ClusterLabelName]; ok {
+			clusters[clusterKey(awsCluster.Namespace, name)] = true
+		}
+	}
+	return clusters, nil
+}
+
+func clusterKey(namespace, name string) string {
+	return namespace + "/" + name
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/gc"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("GarbageCollector", func() {
+	var (
+		collector GarbageCollector
+		mockCtrl  *gomock.Controller
+		gcSvc     *mock_services.MockGarbageCollectorInterface
+	)
+
+	orphanedEIP := gc.ClusterResource{ClusterNamespace: "default", ClusterName: "deleted", Type: gc.ResourceTypeElasticIP, ID: "eipalloc-orphan"}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		gcSvc = mock_services.NewMockGarbageCollectorInterface(mockCtrl)
+
+		existing := &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "existing"},
+		}
+		owned := &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "other",
+				Name:      "owned-aws",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       "owned",
+					},
+				},
+			},
+		}
+
+		collector = GarbageCollector{
+			Client:  fake.NewFakeClient(existing, owned),
+			Log:     log.Log,
+			Regions: []string{"us-east-1"},
+
+			ManagementClusterID: "mgmt",
+			gcServiceFactory: func(region string) (services.GarbageCollectorInterface, error) {
+				Expect(region).To(Equal("us-east-1"))
+				return gcSvc, nil
+			},
+		}
+	})
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should delete orphaned elastic IPs", func() {
+		gcSvc.EXPECT().ListClusterResources("mgmt").Return([]gc.ClusterResource{orphanedEIP}, nil)
+		gcSvc.EXPECT().DeleteResource(orphanedEIP).Return(nil)
+
+		Expect(collector.Collect(context.Background())).To(Succeed())
+	})
+
+	It("should skip resources of existing clusters", func() {
+		gcSvc.EXPECT().ListClusterResources("mgmt").Return([]gc.ClusterResource{
+			{ClusterNamespace: "default", ClusterName: "existing", Type: gc.ResourceTypeElasticIP, ID: "eipalloc-existing"},
+			{ClusterNamespace: "other", ClusterName: "owned", Type: gc.ResourceTypeSecurityGroup, ID: "sg-owned"},
+			orphanedEIP,
+		}, nil)
+		gcSvc.EXPECT().DeleteResource(orphanedEIP).Return(nil)
+
+		Expect(collector.Collect(context.Background())).To(Succeed())
+	})
+
+	It("should match clusters on their namespace too", func() {
+		sameNameEIP := gc.ClusterResource{ClusterNamespace: "other", ClusterName: "existing", Type: gc.ResourceTypeElasticIP, ID: "eipalloc-other"}
+		gcSvc.EXPECT().ListClusterResources("mgmt").Return([]gc.ClusterResource{sameNameEIP}, nil)
+		gcSvc.EXPECT().DeleteResource(sameNameEIP).Return(nil)
+
+		Expect(collector.Collect(context.Background())).To(Succeed())
+	})
+
+	It("should only report orphaned resources in dry run mode", func() {
+		collector.DryRun = true
+		gcSvc.EXPECT().ListClusterResources("mgmt").Return([]gc.ClusterResource{orphanedEIP}, nil)
+
+		Expect(collector.Collect(context.Background())).To(Succeed())
+	})
+
+	It("should keep collecting when a resource can not be deleted", func() {
+		orphanedNAT := gc.ClusterResource{ClusterNamespace: "default", ClusterName: "deleted", Type: gc.ResourceTypeNATGateway, ID: "nat-orphan"}
+		gcSvc.EXPECT().ListClusterResources("mgmt").Return([]gc.ClusterResource{orphanedNAT, orphanedEIP}, nil)
+		gcSvc.EXPECT().DeleteResource(orphanedNAT).Return(errors.New("nat gateway is pending"))
+		gcSvc.EXPECT().DeleteResource(orphanedEIP).Return(nil)
+
+		Expect(collector.Collect(context.Background())).NotTo(Succeed())
+	})
+
+	It("should not delete anything when the resources can not be listed", func() {
+		gcSvc.EXPECT().ListClusterResources("mgmt").Return(nil, errors.New("throttled"))
+
+		Expect(collector.Collect(context.Background())).NotTo(Succeed())
+	})
+})
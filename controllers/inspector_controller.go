@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/inspector"
+)
+
+// VulnerabilityCheckInterval is the interval at which the Amazon Inspector findings of an instance are refreshed.
+const VulnerabilityCheckInterval = 24 * time.Hour
+
+// InspectorReconciler reports the Amazon Inspector vulnerability findings of AWSMachine instances.
+type InspectorReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Recorder                record.EventRecorder
+	APITimeouts             scope.AWSAPITimeoutConfig
+	inspectorServiceFactory func(*scope.ClusterScope) services.InspectorInterface
+}
+
+func (r *InspectorReconciler) getInspectorService(scope *scope.ClusterScope) services.InspectorInterface {
+	if r.inspectorServiceFactory != nil {
+		return r.inspectorServiceFactory(scope)
+	}
+
+	return inspector.NewService(scope)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+func (r *InspectorReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx := context.TODO()
+	logger := r.Log.WithValues("namespace", req.Namespace, "awsMachine", req.Name)
+
+	// Fetch the AWSMachine instance.
+	awsMachine := &infrav1.AWSMachine{}
+	if err := r.Get(ctx, req.NamespacedName, awsMachine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !awsMachine.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Machine.
+	machine, err := util.GetOwnerMachine(ctx, r.Client, awsMachine.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machine == nil {
+		logger.Info("Machine Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("machine", machine.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		logger.Info("Machine is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	if util.IsPaused(cluster, awsMachine) {
+		logger.Info("AWSMachine or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("cluster", cluster.Name)
+
+	awsCluster := &infrav1.AWSCluster{}
+	awsClusterName := client.ObjectKey{
+		Namespace: awsMachine.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Get(ctx, awsClusterName, awsCluster); err != nil {
+		logger.Info("AWSCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:      r.Client,
+		Logger:      logger,
+		Cluster:     cluster,
+		AWSCluster:  awsCluster,
+		APITimeouts: r.APITimeouts,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine scope
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Logger:     logger,
+		Client:     r.Client,
+		Cluster:    cluster,
+		Machine:    machine,
+		AWSCluster: awsCluster,
+		AWSMachine: awsMachine,
+	})
+	if err != nil {
+		return ctrl.Result{}, errors.Errorf("failed to create scope: %+v", err)
+	}
+
+	patchHelper, err := patch.NewHelper(awsMachine, r.Client)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to init patch helper")
+	}
+
+	// Always persist the AWSMachine status when exiting this function.
+	defer func() {
+		if err := patchHelper.Patch(ctx, awsMachine); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	return r.reconcileNormal(machineScope, clusterScope)
+}
+
+func (r *InspectorReconciler) reconcileNormal(machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+	// The instance has not been created yet, the AWSMachine update setting its provider ID
+	// triggers the first check.
+	instanceID := machineScope.GetInstanceID()
+	if instanceID == nil {
+		return ctrl.Result{}, nil
+	}
+
+	awsMachine := machineScope.AWSMachine
+	if checkedAt := awsMachine.Status.VulnerabilitiesCheckedAt; checkedAt != nil {
+		if since := time.Since(checkedAt.Time); since < VulnerabilityCheckInterval {
+			return ctrl.Result{RequeueAfter: VulnerabilityCheckInterval - since}, nil
+		}
+	}
+
+	machineScope.V(2).Info("Checking Amazon Inspector findings", "instance-id", *instanceID)
+
+	findings, err := r.getInspectorService(clusterScope).ListInstanceFindings(*instanceID)
+	if inspector.IsUnavailable(err) {
+		// Retrying won't help until Amazon Inspector is enabled or the controller is granted access to it.
+		machineScope.Info("Amazon Inspector is not available, skipping vulnerability checks", "instance-id", *instanceID, "reason", err.Error())
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "FailedListVulnerabilityFindings", "Failed to list vulnerability findings of instance %q: %v", *instanceID, err)
+		return ctrl.Result{}, err
+	}
+
+	for _, finding := range findings {
+		if finding.Severity == infrav1.VulnerabilitySeverityCritical {
+			r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "HighSeverityVulnerability", "Instance %q is affected by critical vulnerability %q (fix available: %t)", *instanceID, finding.Title, finding.FixAvailable)
+		}
+	}
+
+	now := metav1.Now()
+	awsMachine.Status.VulnerabilityFindings = findings
+	awsMachine.Status.VulnerabilitiesCheckedAt = &now
+
+	return ctrl.Result{RequeueAfter: VulnerabilityCheckInterval}, nil
+}
+
+func (r *InspectorReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(workerPoolOptions("inspector", options)).
+		Named("inspector").
+		For(&infrav1.AWSMachine{}).
+		WithEventFilter(pausedPredicates(r.Log)).
+		Complete(r)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("InspectorReconciler", func() {
+	var (
+		reconciler   InspectorReconciler
+		recorder     *record.FakeRecorder
+		mockCtrl     *gomock.Controller
+		inspectorSvc *mock_services.MockInspectorInterface
+		awsMachine   *infrav1.AWSMachine
+		objects      []runtime.Object
+		ctx          context.Context
+	)
+
+	awsMachineKey := client.ObjectKey{Namespace: "default", Name: "scanned"}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		cluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+			Spec: clusterv1.ClusterSpec{
+				InfrastructureRef: &corev1.ObjectReference{Name: "test"},
+			},
+		}
+		awsCluster := &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		}
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "scanned",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName:       "test",
+				InfrastructureRef: corev1.ObjectReference{Name: "scanned"},
+			},
+		}
+		awsMachine = &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "scanned",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       "scanned",
+					},
+				},
+			},
+			Spec: infrav1.AWSMachineSpec{
+				ProviderID: pointer.StringPtr("aws:////i-scanned"),
+			},
+		}
+		objects = []runtime.Object{cluster, awsCluster, machine}
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		inspectorSvc = mock_services.NewMockInspectorInterface(mockCtrl)
+		recorder = record.NewFakeRecorder(10)
+	})
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	setupReconciler := func() {
+		reconciler = InspectorReconciler{
+			Client: fake.NewFakeClient(append(objects, awsMachine)...),
+			Log:    log.Log,
+			inspectorServiceFactory: func(*scope.ClusterScope) services.InspectorInterface {
+				return inspectorSvc
+			},
+			Recorder: recorder,
+		}
+	}
+
+	reconcile := func() (ctrl.Result, *infrav1.AWSMachine, error) {
+		result, err := reconciler.Reconcile(ctrl.Request{NamespacedName: awsMachineKey})
+
+		updated := &infrav1.AWSMachine{}
+		Expect(reconciler.Get(ctx, awsMachineKey, updated)).To(Succeed())
+		return result, updated, err
+	}
+
+	It("should report the findings of a new instance", func() {
+		setupReconciler()
+
+		findings := []infrav1.VulnerabilityFinding{
+			{Severity: "CRITICAL", Title: "CVE-2021-44228 - log4j", CVE: "CVE-2021-44228", FixAvailable: true},
+			{Severity: "LOW", Title: "CVE-2020-0001 - openssl", CVE: "CVE-2020-0001"},
+		}
+		inspectorSvc.EXPECT().ListInstanceFindings("i-scanned").Return(findings, nil)
+
+		result, updated, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(VulnerabilityCheckInterval))
+		Expect(updated.Status.VulnerabilityFindings).To(Equal(findings))
+		Expect(updated.Status.VulnerabilitiesCheckedAt).NotTo(BeNil())
+
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(ContainSubstring("HighSeverityVulnerability"))
+	})
+
+	It("should not check the instance again before the check interval elapsed", func() {
+		checkedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+		awsMachine.Status.VulnerabilitiesCheckedAt = &checkedAt
+		setupReconciler()
+
+		result, _, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(BeNumerically("<=", VulnerabilityCheckInterval-time.Hour))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+	})
+
+	It("should check the instance again once the check interval elapsed", func() {
+		checkedAt := metav1.NewTime(time.Now().Add(-VulnerabilityCheckInterval))
+		awsMachine.Status.VulnerabilitiesCheckedAt = &checkedAt
+		awsMachine.Status.VulnerabilityFindings = []infrav1.VulnerabilityFinding{
+			{Severity: "HIGH", Title: "CVE-2020-0002 - fixed", CVE: "CVE-2020-0002", FixAvailable: true},
+		}
+		setupReconciler()
+
+		inspectorSvc.EXPECT().ListInstanceFindings("i-scanned").Return(nil, nil)
+
+		_, updated, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(updated.Status.VulnerabilityFindings).To(BeEmpty())
+		Expect(updated.Status.VulnerabilitiesCheckedAt.Time).To(BeTemporally(">", checkedAt.Time))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should wait for the instance to be created", func() {
+		awsMachine.Spec.ProviderID = nil
+		setupReconciler()
+
+		result, updated, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(updated.Status.VulnerabilitiesCheckedAt).To(BeNil())
+	})
+
+	It("should report the failure to list findings", func() {
+		setupReconciler()
+
+		inspectorSvc.EXPECT().ListInstanceFindings("i-scanned").Return(nil, errors.New("access denied"))
+
+		_, updated, err := reconcile()
+		Expect(err).NotTo(BeNil())
+		Expect(updated.Status.VulnerabilitiesCheckedAt).To(BeNil())
+		Expect(<-recorder.Events).To(ContainSubstring("FailedListVulnerabilityFindings"))
+	})
+
+	It("should stop checking the instance when Amazon Inspector is not enabled", func() {
+		setupReconciler()
+
+		notEnabled := awserr.New(inspector2.ErrCodeAccessDeniedException, "Inspector2 is not enabled for this account", nil)
+		inspectorSvc.EXPECT().ListInstanceFindings("i-scanned").Return(nil, errors.Wrap(notEnabled, "failed to list findings"))
+
+		result, updated, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.Requeue).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(updated.Status.VulnerabilitiesCheckedAt).To(BeNil())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// workerPoolSize is the number of concurrent reconcile workers of each controller.
+	workerPoolSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "capa_worker_pool_size",
+		Help: "Number of concurrent reconcile workers of each controller",
+	}, []string{"controller"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(workerPoolSize)
+}
+
+// workerPoolOptions records the number of workers the named controller runs with the given options,
+// controller-runtime starting a single worker when none is set, and returns the options unchanged.
+func workerPoolOptions(name string, options controller.Options) controller.Options {
+	workers := options.MaxConcurrentReconciles
+	if workers <= 0 {
+		workers = 1
+	}
+	workerPoolSize.WithLabelValues(name).Set(float64(workers))
+	return options
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+func TestWorkerPoolOptions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		workers int
+		expect  float64
+	}{
+		{
+			name:    "awscluster",
+			workers: 5,
+			expect:  5,
+		},
+		{
+			name:    "awsmachine",
+			workers: 10,
+			expect:  10,
+		},
+		{
+			name:   "rootdisk",
+			expect: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := workerPoolOptions(tc.name, controller.Options{MaxConcurrentReconciles: tc.workers})
+			if options.MaxConcurrentReconciles != tc.workers {
+				t.Fatalf("expected %d concurrent reconciles, got %d", tc.workers, options.MaxConcurrentReconciles)
+			}
+			if got := testutil.ToFloat64(workerPoolSize.WithLabelValues(tc.name)); got != tc.expect {
+				t.Fatalf("expected a worker pool of %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sync"
+	"time"
+)
+
+// reconcileStatsWindow is the period over which reconciliations are kept for the ReconcileSummaries.
+const reconcileStatsWindow = time.Hour
+
+// reconcileSample is the duration and the error, if any, of a single reconciliation.
+type reconcileSample struct {
+	finished time.Time
+	duration time.Duration
+	err      string
+}
+
+// ReconcileStats records the reconciliations of the AWSCluster and AWSMachine controllers of the
+// last hour by namespace, for the ReconcileSummary controller to aggregate. It is safe for
+// concurrent use, and a nil ReconcileStats records nothing.
+type ReconcileStats struct {
+	lock    sync.Mutex
+	samples map[string][]reconcileSample
+	now     func() time.Time
+}
+
+// NewReconcileStats returns a new, empty ReconcileStats.
+func NewReconcileStats() *ReconcileStats {
+	return &ReconcileStats{
+		samples: map[string][]reconcileSample{},
+		now:     time.Now,
+	}
+}
+
+// Observe records a reconciliation in the given namespace started at the given time, which
+// returned the given error.
+func (s *ReconcileStats) Observe(namespace string, started time.Time, err error) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	sample := reconcileSample{finished: now, duration: now.Sub(started)}
+	if err != nil {
+		sample.err = err.Error()
+	}
+	s.samples[namespace] = append(s.prune(namespace, now), sample)
+}
+
+// recent returns the reconciliations in the given namespace of the last hour.
+func (s *ReconcileStats) recent(namespace string) []reconcileSample {
+	if s == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	samples := s.prune(namespace, s.now())
+	s.samples[namespace] = samples
+	return append([]reconcileSample(nil), samples...)
+}
+
+// prune returns the reconciliations in the given namespace which finished within the window, the
+// samples being ordered by the time they finished.
+func (s *ReconcileStats) prune(namespace string, now time.Time) []reconcileSample {
+	samples := s.samples[namespace]
+	i := 0
+	for i < len(samples) && now.Sub(samples[i].finished) > reconcileStatsWindow {
+		i++
+	}
+	return samples[i:]
+}
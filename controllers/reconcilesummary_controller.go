@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+const (
+	// readyConditionIndex indexes AWSClusters by the status of their Ready condition.
+	readyConditionIndex = "status.conditions.ready"
+
+	// reconcileSummaryInterval is the interval at which ReconcileSummaries are recomputed, so that
+	// reconciliations leaving the window are accounted for.
+	reconcileSummaryInterval = time.Minute
+
+	// reconcileSummaryTopErrors is the number of most frequent errors reported by a ReconcileSummary.
+	reconcileSummaryTopErrors = 5
+)
+
+// ReconcileSummaryReconciler reconciles a ReconcileSummary object
+type ReconcileSummaryReconciler struct {
+	client.Client
+	Log logr.Logger
+
+	// Stats are the reconciliations recorded by the AWSCluster and AWSMachine controllers.
+	Stats *ReconcileStats
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=reconcilesummaries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=reconcilesummaries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters;awsmachines,verbs=get;list;watch
+
+func (r *ReconcileSummaryReconciler) Reconcile(req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx := context.TODO()
+	logger := r.Log.WithValues("namespace", req.Namespace, "reconcileSummary", req.Name)
+
+	summary := &infrav1.ReconcileSummary{}
+	if err := r.Get(ctx, req.NamespacedName, summary); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(summary, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, summary); err != nil {
+			logger.Error(err, "failed to patch ReconcileSummary")
+			if reterr == nil {
+				reterr = err
+			}
+		}
+	}()
+
+	clusters := &infrav1.AWSClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to list AWSClusters")
+	}
+	readyClusters := &infrav1.AWSClusterList{}
+	if err := r.List(ctx, readyClusters, client.InNamespace(req.Namespace), client.MatchingFields{readyConditionIndex: string(corev1.ConditionTrue)}); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to list ready AWSClusters")
+	}
+	machines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to list AWSMachines")
+	}
+
+	summary.Status = summarizeReconciles(len(clusters.Items), len(readyClusters.Items), machines.Items, r.Stats.recent(req.Namespace))
+	now := metav1.Now()
+	summary.Status.LastUpdated = &now
+
+	return ctrl.Result{RequeueAfter: reconcileSummaryInterval}, nil
+}
+
+// summarizeReconciles aggregates the AWSClusters and AWSMachines of a namespace, and the
+// reconciliations of the last hour, into the status of a ReconcileSummary.
+func summarizeReconciles(totalClusters, readyClusters int, machines []infrav1.AWSMachine, samples []reconcileSample) infrav1.ReconcileSummaryStatus {
+	status := infrav1.ReconcileSummaryStatus{
+		TotalClusters: int32(totalClusters),
+		ReadyClusters: int32(readyClusters),
+		TotalMachines: int32(len(machines)),
+	}
+
+	errorCounts := map[string]int32{}
+	for i := range machines {
+		machine := &machines[i]
+		if machine.Status.InstanceState != nil && *machine.Status.InstanceState == infrav1.InstanceStateRunning {
+			status.RunningMachines++
+		}
+		if machine.Status.FailureReason != nil || machine.Status.FailureMessage != nil {
+			status.FailedMachines++
+			if machine.Status.FailureMessage != nil {
+				errorCounts[*machine.Status.FailureMessage]++
+			}
+		}
+	}
+
+	if len(samples) > 0 {
+		var total time.Duration
+		for _, sample := range samples {
+			total += sample.duration
+			if sample.err != "" {
+				errorCounts[sample.err]++
+			}
+		}
+		status.AverageReconcileDuration = &metav1.Duration{Duration: total / time.Duration(len(samples))}
+	}
+
+	for message, count := range errorCounts {
+		status.TopErrors = append(status.TopErrors, infrav1.ReconcileErrorCount{Message: message, Count: count})
+	}
+	sort.Slice(status.TopErrors, func(i, j int) bool {
+		if status.TopErrors[i].Count != status.TopErrors[j].Count {
+			return status.TopErrors[i].Count > status.TopErrors[j].Count
+		}
+		return status.TopErrors[i].Message < status.TopErrors[j].Message
+	})
+	if len(status.TopErrors) > reconcileSummaryTopErrors {
+		status.TopErrors = status.TopErrors[:reconcileSummaryTopErrors]
+	}
+
+	return status
+}
+
+// readyConditionIndexValue returns the status of the Ready condition of an AWSCluster, Unknown when
+// it has none.
+func readyConditionIndexValue(o runtime.Object) []string {
+	awsCluster, ok := o.(*infrav1.AWSCluster)
+	if !ok {
+		return nil
+	}
+	if ready := conditions.Get(awsCluster, clusterv1.ReadyCondition); ready != nil {
+		return []string{string(ready.Status)}
+	}
+	return []string{string(corev1.ConditionUnknown)}
+}
+
+// toReconcileSummaries maps an AWSCluster or AWSMachine to the ReconcileSummaries of its namespace.
+func (r *ReconcileSummaryReconciler) toReconcileSummaries(o handler.MapObject) []ctrl.Request {
+	summaries := &infrav1.ReconcileSummaryList{}
+	if err := r.List(context.TODO(), summaries, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list ReconcileSummaries", "namespace", o.Meta.GetNamespace())
+		return nil
+	}
+
+	requests := make([]ctrl.Request, 0, len(summaries.Items))
+	for _, summary := range summaries.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: summary.Namespace, Name: summary.Name}})
+	}
+	return requests
+}
+
+func (r *ReconcileSummaryReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	if err := mgr.GetFieldIndexer().IndexField(&infrav1.AWSCluster{}, readyConditionIndex, readyConditionIndexValue); err != nil {
+		return errors.Wrap(err, "error indexing AWSClusters by Ready condition")
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(workerPoolOptions("reconcilesummary", options)).
+		For(&infrav1.ReconcileSummary{}).
+		WithEventFilter(
+			predicate.Funcs{
+				// Avoid reconciling a ReconcileSummary again when its own status is updated.
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					if _, ok := e.ObjectNew.(*infrav1.ReconcileSummary); !ok {
+						return true
+					}
+					return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration()
+				},
+			},
+		).
+		Watches(
+			&source.Kind{Type: &infrav1.AWSCluster{}},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.toReconcileSummaries)},
+		).
+		Watches(
+			&source.Kind{Type: &infrav1.AWSMachine{}},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.toReconcileSummaries)},
+		).
+		Complete(r)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestSummarizeReconciles(t *testing.T) {
+	running := infrav1.InstanceStateRunning
+	pending := infrav1.InstanceStatePending
+	failureReason := capierrors.UpdateMachineError
+
+	machines := []infrav1.AWSMachine{
+		{Status: infrav1.AWSMachineStatus{InstanceState: &running}},
+		{Status: infrav1.AWSMachineStatus{InstanceState: &running}},
+		{Status: infrav1.AWSMachineStatus{InstanceState: &pending}},
+		{Status: infrav1.AWSMachineStatus{FailureReason: &failureReason, FailureMessage: pointer.StringPtr("instance terminated")}},
+	}
+
+	var samples []reconcileSample
+	for i, err := range []string{"", "", "throttled", "throttled", "instance terminated", "a", "b", "c", "d"} {
+		samples = append(samples, reconcileSample{duration: time.Duration(i+1) * time.Second, err: err})
+	}
+
+	status := summarizeReconciles(3, 2, machines, samples)
+
+	if status.TotalClusters != 3 || status.ReadyClusters != 2 {
+		t.Fatalf("expected 2 of 3 clusters ready, got %d of %d", status.ReadyClusters, status.TotalClusters)
+	}
+	if status.TotalMachines != 4 || status.RunningMachines != 2 || status.FailedMachines != 1 {
+		t.Fatalf("expected 4 machines, 2 running and 1 failed, got %d, %d and %d", status.TotalMachines, status.RunningMachines, status.FailedMachines)
+	}
+	if status.AverageReconcileDuration == nil || status.AverageReconcileDuration.Duration != 5*time.Second {
+		t.Fatalf("expected an average reconcile duration of 5s, got %v", status.AverageReconcileDuration)
+	}
+
+	// The failure message of the failed machine counts along with the reconcile errors, and ties
+	// are ordered by message.
+	expected := []infrav1.ReconcileErrorCount{
+		{Message: "instance terminated", Count: 2},
+		{Message: "throttled", Count: 2},
+		{Message: "a", Count: 1},
+		{Message: "b", Count: 1},
+		{Message: "c", Count: 1},
+	}
+	if !reflect.DeepEqual(status.TopErrors, expected) {
+		t.Fatalf("expected top errors %v, got %v", expected, status.TopErrors)
+	}
+}
+
+func TestSummarizeReconcilesWithoutReconciles(t *testing.T) {
+	status := summarizeReconciles(0, 0, nil, nil)
+	if status.AverageReconcileDuration != nil || status.TopErrors != nil {
+		t.Fatalf("expected no average reconcile duration nor errors, got %v and %v", status.AverageReconcileDuration, status.TopErrors)
+	}
+}
+
+func TestReconcileStatsKeepsTheLastHour(t *testing.T) {
+	now := time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+	stats := NewReconcileStats()
+	stats.now = func() time.Time { return now }
+
+	stats.Observe("default", now.Add(-2*time.Second), nil)
+	now = now.Add(30 * time.Minute)
+	stats.Observe("default", now.Add(-time.Second), errors.New("throttled"))
+	stats.Observe("other", now.Add(-time.Second), nil)
+	now = now.Add(45 * time.Minute)
+
+	samples := stats.recent("default")
+	if len(samples) != 1 {
+		t.Fatalf("expected 1 reconcile of the last hour, got %d", len(samples))
+	}
+	if samples[0].duration != time.Second || samples[0].err != "throttled" {
+		t.Fatalf("expected a reconcile of 1s failing with %q, got %v", "throttled", samples[0])
+	}
+
+	// A nil ReconcileStats records nothing.
+	var disabled *ReconcileStats
+	disabled.Observe("default", now, nil)
+	if samples := disabled.recent("default"); samples != nil {
+		t.Fatalf("expected no reconcile, got %v", samples)
+	}
+}
+
+var _ = Describe("ReconcileSummaryReconciler", func() {
+	var (
+		ctx       context.Context
+		stop      chan struct{}
+		namespace *corev1.Namespace
+		stats     *ReconcileStats
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		stop = make(chan struct{})
+		stats = NewReconcileStats()
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "reconcile-summary-"}}
+		Expect(k8sClient.Create(ctx, namespace)).To(Succeed())
+
+		mgr, err := ctrl.NewManager(cfg, ctrl.Options{Scheme: scheme.Scheme, MetricsBindAddress: "0"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect((&ReconcileSummaryReconciler{
+			Client: mgr.GetClient(),
+			Log:    log.Log,
+			Stats:  stats,
+		}).SetupWithManager(mgr, controller.Options{})).To(Succeed())
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(mgr.Start(stop)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		close(stop)
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	It("should summarize the AWSClusters and AWSMachines of its namespace", func() {
+		for i, ready := range []bool{true, false} {
+			awsCluster := &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: fmt.Sprintf("cluster-%d", i)}}
+			Expect(k8sClient.Create(ctx, awsCluster)).To(Succeed())
+			if ready {
+				conditions.MarkTrue(awsCluster, clusterv1.ReadyCondition)
+				Expect(k8sClient.Status().Update(ctx, awsCluster)).To(Succeed())
+			}
+		}
+
+		running := infrav1.InstanceStateRunning
+		for i, state := range []*infrav1.InstanceState{&running, nil} {
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: fmt.Sprintf("machine-%d", i)},
+				Spec:       infrav1.AWSMachineSpec{InstanceType: "t3.large"},
+			}
+			Expect(k8sClient.Create(ctx, awsMachine)).To(Succeed())
+			awsMachine.Status.InstanceState = state
+			if state == nil {
+				awsMachine.Status.FailureMessage = pointer.StringPtr("instance terminated")
+			}
+			Expect(k8sClient.Status().Update(ctx, awsMachine)).To(Succeed())
+		}
+
+		stats.Observe(namespace.Name, time.Now().Add(-2*time.Second), errors.New("throttled"))
+
+		summaryKey := client.ObjectKey{Namespace: namespace.Name, Name: "summary"}
+		Expect(k8sClient.Create(ctx, &infrav1.ReconcileSummary{
+			ObjectMeta: metav1.ObjectMeta{Namespace: summaryKey.Namespace, Name: summaryKey.Name},
+		})).To(Succeed())
+
+		summary := &infrav1.ReconcileSummary{}
+		Eventually(func() int32 {
+			if err := k8sClient.Get(ctx, summaryKey, summary); err != nil {
+				return 0
+			}
+			return summary.Status.TotalMachines
+		}, 10*time.Second).Should(Equal(int32(2)))
+
+		Expect(summary.Status.TotalClusters).To(Equal(int32(2)))
+		Expect(summary.Status.ReadyClusters).To(Equal(int32(1)))
+		Expect(summary.Status.RunningMachines).To(Equal(int32(1)))
+		Expect(summary.Status.FailedMachines).To(Equal(int32(1)))
+		Expect(summary.Status.AverageReconcileDuration).NotTo(BeNil())
+		Expect(summary.Status.TopErrors).To(ConsistOf(
+			infrav1.ReconcileErrorCount{Message: "instance terminated", Count: 1},
+			infrav1.ReconcileErrorCount{Message: "throttled", Count: 1},
+		))
+		Expect(summary.Status.LastUpdated).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/cluster-api/util"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+)
+
+// RootDiskCheckInterval is the interval at which the root disk usage of machines with AutoExpandRootDisk is checked.
+const RootDiskCheckInterval = 5 * time.Minute
+
+// RootDiskReconciler expands the root volume of AWSMachines whose root filesystem is running out of space.
+type RootDiskReconciler struct {
+	client.Client
+	Log                    logr.Logger
+	Recorder               record.EventRecorder
+	APITimeouts            scope.AWSAPITimeoutConfig
+	rootDiskServiceFactory func(*scope.ClusterScope) services.RootDiskInterface
+}
+
+func (r *RootDiskReconciler) getRootDiskService(scope *scope.ClusterScope) services.RootDiskInterface {
+	if r.rootDiskServiceFactory != nil {
+		return r.rootDiskServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+
+func (r *RootDiskReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.TODO()
+	logger := r.Log.WithValues("namespace", req.Namespace, "awsMachine", req.Name)
+
+	// Fetch the AWSMachine instance.
+	awsMachine := &infrav1.AWSMachine{}
+	if err := r.Get(ctx, req.NamespacedName, awsMachine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !awsMachine.DeletionTimestamp.IsZero() || awsMachine.Spec.AutoExpandRootDisk == nil {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Machine.
+	machine, err := util.GetOwnerMachine(ctx, r.Client, awsMachine.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machine == nil {
+		logger.Info("Machine Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("machine", machine.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		logger.Info("Machine is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	if util.IsPaused(cluster, awsMachine) {
+		logger.Info("AWSMachine or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("cluster", cluster.Name)
+
+	awsCluster := &infrav1.AWSCluster{}
+	awsClusterName := client.ObjectKey{
+		Namespace: awsMachine.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Get(ctx, awsClusterName, awsCluster); err != nil {
+		logger.Info("AWSCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:      r.Client,
+		Logger:      logger,
+		Cluster:     cluster,
+		AWSCluster:  awsCluster,
+		APITimeouts: r.APITimeouts,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine scope
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Logger:     logger,
+		Client:     r.Client,
+		Cluster:    cluster,
+		Machine:    machine,
+		AWSCluster: awsCluster,
+		AWSMachine: awsMachine,
+	})
+	if err != nil {
+		return ctrl.Result{}, errors.Errorf("failed to create scope: %+v", err)
+	}
+
+	return r.reconcileNormal(machineScope, clusterScope)
+}
+
+func (r *RootDiskReconciler) reconcileNormal(machineScope *scope.MachineScope, clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+	// The instance has not been created yet, the AWSMachine update setting its provider ID
+	// triggers the first check.
+	instanceID := machineScope.GetInstanceID()
+	if instanceID == nil {
+		return ctrl.Result{}, nil
+	}
+
+	awsMachine := machineScope.AWSMachine
+	spec := awsMachine.Spec.AutoExpandRootDisk
+	rootDiskSvc := r.getRootDiskService(clusterScope)
+
+	usedPercent, err := rootDiskSvc.RootVolumeUsedPercent(*instanceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if usedPercent == nil {
+		machineScope.V(2).Info("Root disk usage is not reported by the CloudWatch agent yet", "instance-id", *instanceID)
+		return ctrl.Result{RequeueAfter: RootDiskCheckInterval}, nil
+	}
+	if *usedPercent <= float64(spec.ThresholdPercent) {
+		return ctrl.Result{RequeueAfter: RootDiskCheckInterval}, nil
+	}
+
+	machineScope.Info("Root disk usage exceeds threshold", "instance-id", *instanceID, "used-percent", *usedPercent, "threshold-percent", spec.ThresholdPercent)
+
+	size, expanded, err := rootDiskSvc.ExpandRootVolume(*instanceID, int64(spec.MaxSizeGiB))
+	if err != nil {
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "FailedExpandRootVolume", "Failed to expand root volume of instance %q: %v", *instanceID, err)
+		return ctrl.Result{}, err
+	}
+	if !expanded {
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "RootVolumeAtMaxSize", "Root disk of instance %q is %.0f%% used but its volume already is %dGiB large", *instanceID, *usedPercent, size)
+		return ctrl.Result{RequeueAfter: RootDiskCheckInterval}, nil
+	}
+
+	if err := rootDiskSvc.GrowRootFilesystem(*instanceID); err != nil {
+		r.Recorder.Eventf(awsMachine, corev1.EventTypeWarning, "FailedGrowRootFilesystem", "Failed to grow root filesystem of instance %q: %v", *instanceID, err)
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Eventf(awsMachine, corev1.EventTypeNormal, "SuccessfulExpandRootVolume", "Expanded root volume of instance %q to %dGiB", *instanceID, size)
+	return ctrl.Result{RequeueAfter: RootDiskCheckInterval}, nil
+}
+
+func (r *RootDiskReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(workerPoolOptions("rootdisk", options)).
+		Named("rootdisk").
+		For(&infrav1.AWSMachine{}).
+		WithEventFilter(pausedPredicates(r.Log)).
+		Complete(r)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("RootDiskReconciler", func() {
+	var (
+		reconciler  RootDiskReconciler
+		recorder    *record.FakeRecorder
+		mockCtrl    *gomock.Controller
+		rootDiskSvc *mock_services.MockRootDiskInterface
+		awsMachine  *infrav1.AWSMachine
+		objects     []runtime.Object
+	)
+
+	awsMachineKey := client.ObjectKey{Namespace: "default", Name: "full"}
+
+	BeforeEach(func() {
+		cluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+			Spec: clusterv1.ClusterSpec{
+				InfrastructureRef: &corev1.ObjectReference{Name: "test"},
+			},
+		}
+		awsCluster := &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		}
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "full",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "test"},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName:       "test",
+				InfrastructureRef: corev1.ObjectReference{Name: "full"},
+			},
+		}
+		awsMachine = &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "full",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       "full",
+					},
+				},
+			},
+			Spec: infrav1.AWSMachineSpec{
+				ProviderID: pointer.StringPtr("aws:////i-full"),
+				AutoExpandRootDisk: &infrav1.AutoExpandSpec{
+					ThresholdPercent: 80,
+					MaxSizeGiB:       100,
+				},
+			},
+		}
+		objects = []runtime.Object{cluster, awsCluster, machine}
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		rootDiskSvc = mock_services.NewMockRootDiskInterface(mockCtrl)
+		recorder = record.NewFakeRecorder(10)
+	})
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	setupReconciler := func() {
+		reconciler = RootDiskReconciler{
+			Client: fake.NewFakeClient(append(objects, awsMachine)...),
+			Log:    log.Log,
+			rootDiskServiceFactory: func(*scope.ClusterScope) services.RootDiskInterface {
+				return rootDiskSvc
+			},
+			Recorder: recorder,
+		}
+	}
+
+	reconcile := func() (ctrl.Result, error) {
+		return reconciler.Reconcile(ctrl.Request{NamespacedName: awsMachineKey})
+	}
+
+	It("should not check machines without AutoExpandRootDisk", func() {
+		awsMachine.Spec.AutoExpandRootDisk = nil
+		setupReconciler()
+
+		result, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(BeZero())
+	})
+
+	It("should not expand the root volume below the threshold", func() {
+		setupReconciler()
+
+		rootDiskSvc.EXPECT().RootVolumeUsedPercent("i-full").Return(aws.Float64(80), nil)
+
+		result, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(RootDiskCheckInterval))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should keep polling while the CloudWatch agent does not report the usage", func() {
+		setupReconciler()
+
+		rootDiskSvc.EXPECT().RootVolumeUsedPercent("i-full").Return(nil, nil)
+
+		result, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(RootDiskCheckInterval))
+	})
+
+	It("should expand the root volume and filesystem above the threshold", func() {
+		setupReconciler()
+
+		gomock.InOrder(
+			rootDiskSvc.EXPECT().RootVolumeUsedPercent("i-full").Return(aws.Float64(85.5), nil),
+			rootDiskSvc.EXPECT().ExpandRootVolume("i-full", int64(100)).Return(int64(39), true, nil),
+			rootDiskSvc.EXPECT().GrowRootFilesystem("i-full").Return(nil),
+		)
+
+		result, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(RootDiskCheckInterval))
+		Expect(<-recorder.Events).To(ContainSubstring("SuccessfulExpandRootVolume"))
+	})
+
+	It("should report root volumes that reached their maximum size", func() {
+		setupReconciler()
+
+		rootDiskSvc.EXPECT().RootVolumeUsedPercent("i-full").Return(aws.Float64(95), nil)
+		rootDiskSvc.EXPECT().ExpandRootVolume("i-full", int64(100)).Return(int64(100), false, nil)
+
+		result, err := reconcile()
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(RootDiskCheckInterval))
+		Expect(<-recorder.Events).To(ContainSubstring("RootVolumeAtMaxSize"))
+	})
+
+	It("should report the failure to grow the filesystem", func() {
+		setupReconciler()
+
+		rootDiskSvc.EXPECT().RootVolumeUsedPercent("i-full").Return(aws.Float64(95), nil)
+		rootDiskSvc.EXPECT().ExpandRootVolume("i-full", int64(100)).Return(int64(39), true, nil)
+		rootDiskSvc.EXPECT().GrowRootFilesystem("i-full").Return(errors.New("resize2fs failed"))
+
+		_, err := reconcile()
+		Expect(err).NotTo(BeNil())
+		Expect(<-recorder.Events).To(ContainSubstring("FailedGrowRootFilesystem"))
+	})
+})
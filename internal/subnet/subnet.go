@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subnet sizes the network of managed VPCs and validates the subnets of clusters.
+package subnet
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// baseAddress is the first address of the CIDR blocks of managed VPCs.
+	baseAddress = "10.0.0.0"
+	// minPrefixLength is the prefix length of the largest VPC CIDR block, which is also the default one.
+	minPrefixLength = 16
+	// maxPrefixLength is the prefix length of the smallest VPC CIDR block.
+	maxPrefixLength = 20
+	// reservedAddresses is the number of addresses AWS reserves in each subnet.
+	reservedAddresses = 5
+	// addressesPerMachine is the number of private addresses reserved for each machine, leaving room
+	// for rolling updates.
+	addressesPerMachine = 2
+)
+
+// ComputeRequiredCIDRSize returns the smallest VPC CIDR block, from /16 to /20, whose default private
+// subnets have at least two usable addresses per machine when the machines are spread over the zones.
+// Default subnets split the VPC CIDR block in one private subnet per zone and one block for the public
+// subnets, rounded up to the next power of 2. The largest CIDR block is returned when none is enough.
+func ComputeRequiredCIDRSize(totalMachines int, zonesCount int) string {
+	if zonesCount < 1 {
+		zonesCount = 1
+	}
+	machinesPerZone := (totalMachines + zonesCount - 1) / zonesCount
+	required := machinesPerZone * addressesPerMachine
+
+	subnetBits := int(math.Ceil(math.Log2(float64(zonesCount + 1))))
+	for prefixLength := maxPrefixLength; prefixLength > minPrefixLength; prefixLength-- {
+		if usableAddresses(prefixLength+subnetBits) >= required {
+			return fmt.Sprintf("%s/%d", baseAddress, prefixLength)
+		}
+	}
+	return fmt.Sprintf("%s/%d", baseAddress, minPrefixLength)
+}
+
+// usableAddresses returns the number of addresses of a subnet with the given prefix length that can be
+// assigned to instances.
+func usableAddresses(prefixLength int) int {
+	if prefixLength > 28 {
+		return 0
+	}
+	return 1<<uint(32-prefixLength) - reservedAddresses
+}
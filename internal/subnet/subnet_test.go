@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"testing"
+)
+
+func TestComputeRequiredCIDRSize(t *testing.T) {
+	testCases := []struct {
+		name          string
+		totalMachines int
+		zonesCount    int
+		expect        string
+	}{
+		{
+			name:          "no machines",
+			totalMachines: 0,
+			zonesCount:    3,
+			expect:        "10.0.0.0/20",
+		},
+		{
+			name:          "small cluster fits the smallest block",
+			totalMachines: 10,
+			zonesCount:    3,
+			expect:        "10.0.0.0/20",
+		},
+		{
+			// 3 zones split a /20 in /22 private subnets of 1019 usable addresses.
+			name:          "largest cluster fitting the smallest block",
+			totalMachines: 1527,
+			zonesCount:    3,
+			expect:        "10.0.0.0/20",
+		},
+		{
+			name:          "cluster outgrowing the smallest block",
+			totalMachines: 1530,
+			zonesCount:    3,
+			expect:        "10.0.0.0/19",
+		},
+		{
+			// 1 zone splits a /19 in /20 private subnets of 4091 usable addresses.
+			name:          "single zone",
+			totalMachines: 2000,
+			zonesCount:    1,
+			expect:        "10.0.0.0/19",
+		},
+		{
+			name:          "cluster too large for any block",
+			totalMachines: 100000,
+			zonesCount:    3,
+			expect:        "10.0.0.0/16",
+		},
+		{
+			name:          "zones count defaults to one",
+			totalMachines: 2000,
+			zonesCount:    0,
+			expect:        "10.0.0.0/19",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ComputeRequiredCIDRSize(tc.totalMachines, tc.zonesCount); got != tc.expect {
+				t.Fatalf("expected CIDR block %s for %d machines in %d zones, got %s", tc.expect, tc.totalMachines, tc.zonesCount, got)
+			}
+		})
+	}
+}
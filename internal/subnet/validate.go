@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"fmt"
+	"net"
+)
+
+// maxSubnetPrefixLength is the prefix length of the smallest subnet AWS allows.
+const maxSubnetPrefixLength = 28
+
+// SubnetSpec is the configuration of a subnet of a cluster to validate. It mirrors the fields of the
+// subnets of the AWSCluster network spec the validation depends on.
+type SubnetSpec struct {
+	ID               string
+	CidrBlock        string
+	AvailabilityZone string
+	IsPublic         bool
+	NatGatewayID     string
+}
+
+// FieldError is the error of a field of the subnet at Index in the validated subnets.
+type FieldError struct {
+	Index int
+	// Field is the JSON name of the field.
+	Field  string
+	Value  interface{}
+	Detail string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("subnet %d: invalid %s %v: %s", e.Index, e.Field, e.Value, e.Detail)
+}
+
+// ValidateSubnetSpecs validates the subnets of a cluster whose VPC has the given CIDR block, which may be
+// empty, and returns a FieldError for each invalid field. It checks that:
+//
+//   - the CIDR block of each subnet is valid, at most /28, and within the CIDR block of the VPC.
+//   - subnets with a NAT gateway are public, as NAT gateways reside in public subnets.
+//   - the subnets created by the provider, which have no ID, are the only private or public subnet of their
+//     availability zone.
+func ValidateSubnetSpecs(vpcCidrBlock string, subnets []SubnetSpec) []error {
+	var errs []error
+
+	var vpcNet *net.IPNet
+	if vpcCidrBlock != "" {
+		// The VPC CIDR block is validated along with the VPC.
+		_, vpcNet, _ = net.ParseCIDR(vpcCidrBlock)
+	}
+
+	type zoneKey struct {
+		zone     string
+		isPublic bool
+	}
+	zones := map[zoneKey]int{}
+
+	for i, sn := range subnets {
+		if sn.CidrBlock != "" {
+			errs = append(errs, validateCidrBlock(i, sn.CidrBlock, vpcNet)...)
+		}
+
+		if sn.NatGatewayID != "" && !sn.IsPublic {
+			errs = append(errs, &FieldError{Index: i, Field: "isPublic", Value: sn.IsPublic, Detail: "must be true for subnets with a NAT gateway"})
+		}
+
+		if sn.ID != "" || sn.AvailabilityZone == "" {
+			continue
+		}
+		key := zoneKey{zone: sn.AvailabilityZone, isPublic: sn.IsPublic}
+		if first, ok := zones[key]; ok {
+			errs = append(errs, &FieldError{Index: i, Field: "availabilityZone", Value: sn.AvailabilityZone,
+				Detail: fmt.Sprintf("subnet %d is already the %s subnet of the availability zone", first, visibility(sn.IsPublic))})
+			continue
+		}
+		zones[key] = i
+	}
+
+	return errs
+}
+
+// validateCidrBlock validates the CIDR block of the subnet at the given index.
+func validateCidrBlock(index int, cidrBlock string, vpcNet *net.IPNet) []error {
+	ip, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil || ip.To4() == nil {
+		return []error{&FieldError{Index: index, Field: "cidrBlock", Value: cidrBlock, Detail: "must be a valid IPv4 CIDR block"}}
+	}
+
+	var errs []error
+	if ones, _ := ipNet.Mask.Size(); ones > maxSubnetPrefixLength {
+		errs = append(errs, &FieldError{Index: index, Field: "cidrBlock", Value: cidrBlock,
+			Detail: fmt.Sprintf("prefix length must be at most /%d", maxSubnetPrefixLength)})
+	}
+	if vpcNet != nil && !contains(vpcNet, ipNet) {
+		errs = append(errs, &FieldError{Index: index, Field: "cidrBlock", Value: cidrBlock,
+			Detail: fmt.Sprintf("must be within the VPC CIDR block %s", vpcNet)})
+	}
+	return errs
+}
+
+// contains returns whether the network is within the parent network.
+func contains(parent, network *net.IPNet) bool {
+	parentOnes, _ := parent.Mask.Size()
+	ones, _ := network.Mask.Size()
+	return parent.Contains(network.IP) && ones >= parentOnes
+}
+
+func visibility(isPublic bool) string {
+	if isPublic {
+		return "public"
+	}
+	return "private"
+}
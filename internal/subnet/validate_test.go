@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnet
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestValidateSubnetSpecs(t *testing.T) {
+	testCases := []struct {
+		name         string
+		vpcCidrBlock string
+		subnets      []SubnetSpec
+		// expect are the fields with an error, as "<index>.<field>".
+		expect []string
+	}{
+		{
+			name:         "valid subnets",
+			vpcCidrBlock: "10.0.0.0/16",
+			subnets: []SubnetSpec{
+				{CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"},
+				{CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1a", IsPublic: true, NatGatewayID: "nat-1"},
+				{CidrBlock: "10.0.2.0/28", AvailabilityZone: "us-east-1b"},
+			},
+		},
+		{
+			name: "invalid cidr blocks",
+			subnets: []SubnetSpec{
+				{CidrBlock: "10.0.0.0"},
+				{CidrBlock: "10.0.300.0/24"},
+				{CidrBlock: "2600:1f18::/64"},
+			},
+			expect: []string{"0.cidrBlock", "1.cidrBlock", "2.cidrBlock"},
+		},
+		{
+			name: "subnets with a nat gateway are public",
+			subnets: []SubnetSpec{
+				{ID: "subnet-1", NatGatewayID: "nat-1"},
+			},
+			expect: []string{"0.isPublic"},
+		},
+		{
+			name: "one private and one public subnet per availability zone",
+			subnets: []SubnetSpec{
+				{CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"},
+				{CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1a"},
+				{CidrBlock: "10.0.2.0/24", AvailabilityZone: "us-east-1a", IsPublic: true},
+			},
+			expect: []string{"1.availabilityZone"},
+		},
+		{
+			name: "existing subnets may share an availability zone",
+			subnets: []SubnetSpec{
+				{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+				{ID: "subnet-2", AvailabilityZone: "us-east-1a"},
+			},
+		},
+		{
+			name:         "subnets within the vpc cidr block",
+			vpcCidrBlock: "10.0.0.0/16",
+			subnets: []SubnetSpec{
+				{CidrBlock: "10.1.0.0/24"},
+				{CidrBlock: "10.0.0.0/15"},
+				{CidrBlock: "10.0.0.0/16"},
+			},
+			expect: []string{"0.cidrBlock", "1.cidrBlock"},
+		},
+		{
+			name: "subnets of at least /28",
+			subnets: []SubnetSpec{
+				{CidrBlock: "10.0.0.0/28"},
+				{CidrBlock: "10.0.0.16/29"},
+			},
+			expect: []string{"1.cidrBlock"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, err := range ValidateSubnetSpecs(tc.vpcCidrBlock, tc.subnets) {
+				fieldErr, ok := err.(*FieldError)
+				if !ok {
+					t.Fatalf("expected a FieldError, got %v", err)
+				}
+				got = append(got, fmt.Sprintf("%d.%s", fieldErr.Index, fieldErr.Field))
+			}
+			if !reflect.DeepEqual(got, tc.expect) {
+				t.Fatalf("expected errors on %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
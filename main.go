@@ -22,8 +22,15 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	awssts "github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	cgrecord "k8s.io/client-go/tools/record"
@@ -32,6 +39,9 @@ import (
 	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha2"
 	infrav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/controllers"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sts"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/version"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
@@ -70,6 +80,20 @@ func main() {
 		syncPeriod              time.Duration
 		webhookPort             int
 		healthAddr              string
+		apiTimeouts             scope.AWSAPITimeoutConfig
+		credentialCheckInterval time.Duration
+		gcRegions               string
+		gcDryRun                bool
+		managementClusterID     string
+		roleAllowList           scope.RoleAllowList
+		allowedRoleNamespaces   string
+		allowedRoleARNs         string
+		controllerNamespace     string
+		cidrConflictDetection   bool
+		machineRemediation      bool
+		quotaChecks             bool
+		vulnerabilityChecks     bool
+		costEstimates           bool
 	)
 
 	flag.StringVar(
@@ -137,10 +161,117 @@ func main() {
 		"The address the health endpoint binds to.",
 	)
 
+	flag.DurationVar(&apiTimeouts.EC2Timeout,
+		"ec2-api-timeout",
+		scope.DefaultAWSAPITimeout,
+		"The timeout for requests to the EC2 API",
+	)
+
+	flag.DurationVar(&apiTimeouts.ELBTimeout,
+		"elb-api-timeout",
+		scope.DefaultAWSAPITimeout,
+		"The timeout for requests to the ELB API",
+	)
+
+	flag.DurationVar(&apiTimeouts.IAMTimeout,
+		"iam-api-timeout",
+		scope.DefaultAWSAPITimeout,
+		"The timeout for requests to the IAM API",
+	)
+
+	flag.DurationVar(&apiTimeouts.STSTimeout,
+		"sts-api-timeout",
+		scope.DefaultAWSAPITimeout,
+		"The timeout for requests to the STS API",
+	)
+
+	flag.DurationVar(&credentialCheckInterval,
+		"aws-credential-check-interval",
+		sts.DefaultCredentialCheckInterval,
+		"How long a successful AWS credential check is cached by the readiness probe",
+	)
+
+	flag.StringVar(&gcRegions,
+		"garbage-collection-regions",
+		"",
+		"Comma-separated list of AWS regions in which resources of deleted clusters are garbage collected. Garbage collection is disabled if unspecified, and requires watching all namespaces and a management cluster ID.",
+	)
+
+	flag.BoolVar(&gcDryRun,
+		"garbage-collection-dry-run",
+		true,
+		"Only log the resources of deleted clusters found by the garbage collector, instead of deleting them.",
+	)
+
+	flag.StringVar(&managementClusterID,
+		"management-cluster-id",
+		"",
+		"ID of this management cluster, unique among the management clusters sharing AWS accounts. The resources of clusters are tagged with it, and only the resources tagged with it are garbage collected.",
+	)
+
+	flag.StringVar(&controllerNamespace,
+		"controller-namespace",
+		"capa-system",
+		"Namespace the controller runs in, holding the cache of AMIs copied from other regions.",
+	)
+
+	flag.BoolVar(&cidrConflictDetection,
+		"enable-cluster-cidr-conflict-detection",
+		false,
+		"Reject AWSClusters whose VPC CIDR block overlaps the VPC CIDR block of another AWSCluster in the same AWS account. Requires listing AWSClusters in all namespaces.",
+	)
+
+	flag.BoolVar(&machineRemediation,
+		"enable-machine-remediation",
+		false,
+		"Remediate unhealthy machines through AWSMachineRemediations. Requires a Cluster API version whose MachineHealthChecks support remediation templates.",
+	)
+
+	flag.BoolVar(&quotaChecks,
+		"enable-quota-checks",
+		false,
+		"Check the AWS service quotas of AWSClusters before creating their resources, and report their usage every 15 minutes. Requires the servicequotas:GetServiceQuota and servicequotas:GetAWSDefaultServiceQuota permissions.",
+	)
+
+	flag.BoolVar(&costEstimates,
+		"enable-cost-estimates",
+		false,
+		"Annotate new AWSClusters with the estimated monthly cost of their resources. Requires the pricing:GetProducts permission.",
+	)
+
+	flag.BoolVar(&vulnerabilityChecks,
+		"enable-vulnerability-checks",
+		false,
+		"Report the Amazon Inspector vulnerability findings of AWSMachine instances every 24 hours. Requires Amazon Inspector to be enabled and the inspector2:ListFindings permission.",
+	)
+
+	flag.StringVar(&allowedRoleNamespaces,
+		"allowed-role-namespaces",
+		"",
+		"Comma-separated list of the namespaces whose AWSClusters may set spec.roleARN, or * for all namespaces. AWSClusters may not set a role if unspecified.",
+	)
+
+	flag.StringVar(&allowedRoleARNs,
+		"allowed-role-arns",
+		"",
+		"Comma-separated list of the IAM roles AWSClusters may set as spec.roleARN, or * for all roles the controller may assume. AWSClusters may not set a role if unspecified.",
+	)
+
 	flag.Parse()
 
 	ctrl.SetLogger(klogr.New())
 
+	if allowedRoleNamespaces != "" {
+		roleAllowList.Namespaces = strings.Split(allowedRoleNamespaces, ",")
+	}
+	if allowedRoleARNs != "" {
+		roleAllowList.RoleARNs = strings.Split(allowedRoleARNs, ",")
+	}
+	scope.SetRoleAllowList(roleAllowList)
+	infrav1alpha3.RoleValidator = roleAllowList.Check
+
+	scope.AddSessionBuildHandler(ec2.DefaultRetryPolicy.Handler(ctrl.Log.WithName("aws").WithName("retry")))
+
 	if watchNamespace != "" {
 		setupLog.Info("Watching cluster-api objects only in namespace for reconciliation", "namespace", watchNamespace)
 	}
@@ -179,22 +310,90 @@ func main() {
 	record.InitFromRecorder(mgr.GetEventRecorderFor("aws-controller"))
 
 	if webhookPort == 0 {
+		reconcileStats := controllers.NewReconcileStats()
 		if err = (&controllers.AWSMachineReconciler{
-			Client:   mgr.GetClient(),
-			Log:      ctrl.Log.WithName("controllers").WithName("AWSMachine"),
-			Recorder: mgr.GetEventRecorderFor("awsmachine-controller"),
+			Client:              mgr.GetClient(),
+			Log:                 ctrl.Log.WithName("controllers").WithName("AWSMachine"),
+			Recorder:            mgr.GetEventRecorderFor("awsmachine-controller"),
+			APITimeouts:         apiTimeouts,
+			ControllerNamespace: controllerNamespace,
+			Stats:               reconcileStats,
 		}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSMachine")
 			os.Exit(1)
 		}
 		if err = (&controllers.AWSClusterReconciler{
-			Client:   mgr.GetClient(),
-			Log:      ctrl.Log.WithName("controllers").WithName("AWSCluster"),
-			Recorder: mgr.GetEventRecorderFor("awscluster-controller"),
+			Client:              mgr.GetClient(),
+			Log:                 ctrl.Log.WithName("controllers").WithName("AWSCluster"),
+			Recorder:            mgr.GetEventRecorderFor("awscluster-controller"),
+			APITimeouts:         apiTimeouts,
+			ControllerNamespace: controllerNamespace,
+			Stats:               reconcileStats,
+			ManagementClusterID: managementClusterID,
+			QuotaChecks:         quotaChecks,
+			CostEstimates:       costEstimates,
 		}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSCluster")
 			os.Exit(1)
 		}
+		if err = (&controllers.ReconcileSummaryReconciler{
+			Client: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("controllers").WithName("ReconcileSummary"),
+			Stats:  reconcileStats,
+		}).SetupWithManager(mgr, controller.Options{}); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ReconcileSummary")
+			os.Exit(1)
+		}
+		if machineRemediation {
+			if err = (&controllers.AWSMachineRemediationReconciler{
+				Client:   mgr.GetClient(),
+				Log:      ctrl.Log.WithName("controllers").WithName("AWSMachineRemediation"),
+				Recorder: mgr.GetEventRecorderFor("awsmachineremediation-controller"),
+			}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency}); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "AWSMachineRemediation")
+				os.Exit(1)
+			}
+		}
+		if vulnerabilityChecks {
+			if err = (&controllers.InspectorReconciler{
+				Client:      mgr.GetClient(),
+				Log:         ctrl.Log.WithName("controllers").WithName("Inspector"),
+				Recorder:    mgr.GetEventRecorderFor("inspector-controller"),
+				APITimeouts: apiTimeouts,
+			}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency}); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "Inspector")
+				os.Exit(1)
+			}
+		}
+		if err = (&controllers.RootDiskReconciler{
+			Client:      mgr.GetClient(),
+			Log:         ctrl.Log.WithName("controllers").WithName("RootDisk"),
+			Recorder:    mgr.GetEventRecorderFor("rootdisk-controller"),
+			APITimeouts: apiTimeouts,
+		}).SetupWithManager(mgr, controller.Options{MaxConcurrentReconciles: awsMachineConcurrency}); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RootDisk")
+			os.Exit(1)
+		}
+		// Clusters outside of the watched namespace would look deleted to the garbage collector, and without
+		// a management cluster ID it can't tell the resources of its clusters from those of others.
+		switch {
+		case gcRegions == "":
+		case watchNamespace != "":
+			setupLog.Info("Garbage collection is disabled when watching a single namespace")
+		case managementClusterID == "":
+			setupLog.Info("Garbage collection is disabled without a management cluster ID")
+		default:
+			if err = (&controllers.GarbageCollector{
+				Client:              mgr.GetClient(),
+				Log:                 ctrl.Log.WithName("controllers").WithName("GarbageCollector"),
+				Regions:             strings.Split(gcRegions, ","),
+				ManagementClusterID: managementClusterID,
+				DryRun:              gcDryRun,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "GarbageCollector")
+				os.Exit(1)
+			}
+		}
 	} else {
 		if err = (&infrav1alpha3.AWSMachineTemplate{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "AWSMachineTemplate")
@@ -208,6 +407,13 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "AWSCluster")
 			os.Exit(1)
 		}
+		if cidrConflictDetection {
+			infrav1alpha3.CIDRConflictDetector = &infrav1alpha3.ClusterCIDRConflictDetector{
+				Reader:              mgr.GetAPIReader(),
+				ControllerAccountID: controllerAccountID,
+				VPCCIDRBlock:        describeVPCCIDRBlock,
+			}
+		}
 		if err = (&infrav1alpha3.AWSMachine{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "AWSMachine")
 			os.Exit(1)
@@ -228,6 +434,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	if webhookPort == 0 {
+		checker, err := newCredentialsChecker(apiTimeouts.STSTimeout, credentialCheckInterval)
+		if err != nil {
+			setupLog.Error(err, "unable to create AWS credentials check")
+			os.Exit(1)
+		}
+		if err := mgr.AddReadyzCheck("aws-credentials", checker.Check); err != nil {
+			setupLog.Error(err, "unable to create ready check")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to create health check")
 		os.Exit(1)
@@ -239,3 +457,47 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// newCredentialsChecker builds the readiness check that validates the
+// controller's AWS credentials against STS. The regional STS endpoint of the
+// region configured in the environment (AWS_REGION) is called, so that the
+// check works in every partition. us-east-1 is used if no region is configured.
+func newCredentialsChecker(timeout, interval time.Duration) (*sts.CredentialsChecker, error) {
+	sess, err := session.NewSession(aws.NewConfig().
+		WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint).
+		WithHTTPClient(&http.Client{Timeout: timeout}))
+	if err != nil {
+		return nil, err
+	}
+	cfg := aws.NewConfig()
+	if aws.StringValue(sess.Config.Region) == "" {
+		cfg = cfg.WithRegion(endpoints.UsEast1RegionID)
+	}
+	return sts.NewCredentialsChecker(awssts.New(sess, cfg), interval), nil
+}
+
+// controllerAccountID returns the ID of the AWS account of the controller's own credentials.
+func controllerAccountID(region string) (string, error) {
+	sess, err := scope.SessionForRegion(region)
+	if err != nil {
+		return "", err
+	}
+	return sts.NewService(awssts.New(sess)).AccountID()
+}
+
+// describeVPCCIDRBlock returns the primary CIDR block of a VPC, described with the credentials of the
+// given role, so that VPCs in the accounts of other clusters can be described.
+func describeVPCCIDRBlock(region, roleARN, vpcID string) (string, error) {
+	sess, err := scope.SessionForRole(region, roleARN)
+	if err != nil {
+		return "", err
+	}
+	out, err := awsec2.New(sess).DescribeVpcs(&awsec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{vpcID})})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Vpcs) == 0 {
+		return "", errors.Errorf("VPC %q not found", vpcID)
+	}
+	return aws.StringValue(out.Vpcs[0].CidrBlock), nil
+}
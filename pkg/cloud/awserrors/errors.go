@@ -33,14 +33,23 @@ const (
 	NATGatewayNotFound      = "InvalidNatGatewayID.NotFound"
 	GatewayNotFound         = "InvalidGatewayID.NotFound"
 	EIPNotFound             = "InvalidElasticIpID.NotFound"
+	AllocationIDNotFound    = "InvalidAllocationID.NotFound"
 	RouteTableNotFound      = "InvalidRouteTableID.NotFound"
 	LoadBalancerNotFound    = "LoadBalancerNotFound"
 	ResourceNotFound        = "InvalidResourceID.NotFound"
 	InvalidSubnet           = "InvalidSubnet"
 	AssociationIDNotFound   = "InvalidAssociationID.NotFound"
 	InvalidInstanceID       = "InvalidInstanceID.NotFound"
+	InsufficientCapacity    = "InsufficientInstanceCapacity"
 	ResourceExists          = "ResourceExistsException"
 	NoCredentialProviders   = "NoCredentialProviders"
+	RouteNotFound           = "InvalidRoute.NotFound"
+	RouteAlreadyExists      = "RouteAlreadyExists"
+	ImageNotFound           = "InvalidAMIID.NotFound"
+	SnapshotNotFound        = "InvalidSnapshot.NotFound"
+
+	TransitGatewayAttachmentNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+	TransitGatewayNotFound           = "InvalidTransitGatewayID.NotFound"
 )
 
 var _ error = &EC2Error{}
@@ -18,9 +18,13 @@ package converters
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 )
 
@@ -93,3 +97,78 @@ func MapToSecretsManagerTags(src infrav1.Tags) []*secretsmanager.Tag {
 
 	return tags
 }
+
+// MapToRAMTags converts a infrav1.Tags to a []*ram.Tag
+func MapToRAMTags(src infrav1.Tags) []*ram.Tag {
+	tags := make([]*ram.Tag, 0, len(src))
+
+	for k, v := range src {
+		tag := &ram.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// MapToServiceCatalogTags converts a infrav1.Tags to a []*servicecatalog.Tag
+func MapToServiceCatalogTags(src infrav1.Tags) []*servicecatalog.Tag {
+	tags := make([]*servicecatalog.Tag, 0, len(src))
+
+	for k, v := range src {
+		tag := &servicecatalog.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// SSMTagsToMap converts a []*ssm.Tag into a infrav1.Tags.
+func SSMTagsToMap(src []*ssm.Tag) infrav1.Tags {
+	tags := make(infrav1.Tags, len(src))
+
+	for _, t := range src {
+		tags[*t.Key] = *t.Value
+	}
+
+	return tags
+}
+
+// MapToSSMTags converts a infrav1.Tags to a []*ssm.Tag
+func MapToSSMTags(src infrav1.Tags) []*ssm.Tag {
+	tags := make([]*ssm.Tag, 0, len(src))
+
+	for k, v := range src {
+		tag := &ssm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// MapToDataSyncTags converts a infrav1.Tags to a []*datasync.TagListEntry
+func MapToDataSyncTags(src infrav1.Tags) []*datasync.TagListEntry {
+	tags := make([]*datasync.TagListEntry, 0, len(src))
+
+	for k, v := range src {
+		tag := &datasync.TagListEntry{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cost estimates the monthly cost of the AWS resources of a cluster from the AWS Pricing API.
+package cost
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+const (
+	// HoursPerMonth is the number of hours hourly prices are multiplied by, as used by AWS billing.
+	HoursPerMonth = 730
+
+	// bastionInstanceType is the instance type of the bastion host.
+	bastionInstanceType = "t2.micro"
+	// defaultVolumeSize is the size in GiB of root volumes left to the size of the AMI snapshot.
+	defaultVolumeSize = 8
+	// defaultVolumeType is the type of root volumes whose type isn't set.
+	defaultVolumeType = "gp2"
+	// defaultAvailabilityZones is the number of availability zones default subnets are created in.
+	defaultAvailabilityZones = 3
+
+	serviceCodeEC2 = "AmazonEC2"
+	serviceCodeELB = "AWSELB"
+
+	unitHours     = "Hrs"
+	unitGBMonth   = "GB-Mo"
+	currencyUSD   = "USD"
+	formatVersion = "aws_v1"
+)
+
+// Estimate is the estimated monthly cost in USD of the resources of a cluster. It doesn't account
+// for data transfer, provisioned IOPS nor any discount.
+type Estimate struct {
+	Instances     float64
+	NATGateways   float64
+	LoadBalancers float64
+	Volumes       float64
+}
+
+// Total returns the estimated monthly cost of all the resources.
+func (e *Estimate) Total() float64 {
+	return e.Instances + e.NATGateways + e.LoadBalancers + e.Volumes
+}
+
+// CostEstimator estimates the monthly cost of clusters from the on-demand prices of their region.
+type CostEstimator struct {
+	pricing pricingiface.PricingAPI
+	region  string
+	prices  map[string]float64
+}
+
+// NewCostEstimator returns a new CostEstimator for the given region.
+func NewCostEstimator(client pricingiface.PricingAPI, region string) *CostEstimator {
+	return &CostEstimator{
+		pricing: client,
+		region:  region,
+		prices:  map[string]float64{},
+	}
+}
+
+// Estimate returns the estimated monthly cost of the EC2 instances, NAT gateways, load balancers
+// and EBS volumes of a cluster, along with the instances and root volumes of the given machines.
+func (e *CostEstimator) Estimate(spec *infrav1.AWSClusterSpec, machines []infrav1.AWSMachineSpec) (*Estimate, error) {
+	estimate := &Estimate{}
+
+	type instance struct {
+		instanceType string
+		rootVolume   *infrav1.RootVolume
+	}
+	instances := make([]instance, 0, len(machines)+1)
+	if spec.Bastion.Enabled {
+		instances = append(instances, instance{instanceType: bastionInstanceType})
+	}
+	for i := range machines {
+		instances = append(instances, instance{instanceType: machines[i].InstanceType, rootVolume: machines[i].RootVolume})
+	}
+
+	for _, i := range instances {
+		if i.instanceType == "" {
+			continue
+		}
+		hourly, err := e.instancePrice(i.instanceType)
+		if err != nil {
+			return nil, err
+		}
+		estimate.Instances += hourly * HoursPerMonth
+
+		size, volumeType := int64(defaultVolumeSize), defaultVolumeType
+		if i.rootVolume != nil {
+			size = i.rootVolume.Size
+			if i.rootVolume.Type != "" {
+				volumeType = i.rootVolume.Type
+			}
+		}
+		monthly, err := e.volumePrice(volumeType)
+		if err != nil {
+			return nil, err
+		}
+		estimate.Volumes += monthly * float64(size)
+	}
+
+	if natGateways := natGateways(&spec.NetworkSpec); natGateways > 0 {
+		hourly, err := e.natGatewayPrice()
+		if err != nil {
+			return nil, err
+		}
+		estimate.NATGateways = hourly * HoursPerMonth * float64(natGateways)
+	}
+
+	hourly, err := e.loadBalancerPrice()
+	if err != nil {
+		return nil, err
+	}
+	estimate.LoadBalancers = hourly * HoursPerMonth * float64(1+len(spec.AdditionalControlPlaneEndpoints))
+
+	return estimate, nil
+}
+
+// natGateways returns the number of NAT gateways created for the network, one per availability
+// zone of a managed VPC.
+func natGateways(network *infrav1.NetworkSpec) int {
+	if network.VPC.ID != "" {
+		return 0
+	}
+
+	zones := map[string]bool{}
+	for _, sn := range network.Subnets.FilterPublic() {
+		zones[sn.AvailabilityZone] = true
+	}
+	if len(zones) > 0 {
+		return len(zones)
+	}
+
+	if limit := network.VPC.AvailabilityZoneUsageLimit; limit != nil {
+		return *limit
+	}
+	return defaultAvailabilityZones
+}
+
+func (e *CostEstimator) instancePrice(instanceType string) (float64, error) {
+	return e.price(serviceCodeEC2, unitHours, map[string]string{
+		"instanceType":    instanceType,
+		"operatingSystem": "Linux",
+		"tenancy":         "Shared",
+		"preInstalledSw":  "NA",
+		"capacitystatus":  "Used",
+	})
+}
+
+func (e *CostEstimator) volumePrice(volumeType string) (float64, error) {
+	return e.price(serviceCodeEC2, unitGBMonth, map[string]string{
+		"productFamily": "Storage",
+		"volumeApiName": volumeType,
+	})
+}
+
+func (e *CostEstimator) natGatewayPrice() (float64, error) {
+	return e.price(serviceCodeEC2, unitHours, map[string]string{
+		"productFamily": "NAT Gateway",
+	})
+}
+
+func (e *CostEstimator) loadBalancerPrice() (float64, error) {
+	return e.price(serviceCodeELB, unitHours, map[string]string{
+		"productFamily": "Load Balancer",
+	})
+}
+
+// price returns the on-demand price in USD per unit of the first product of the region matching
+// the given attributes. Prices are cached for the lifetime of the CostEstimator.
+func (e *CostEstimator) price(serviceCode, unit string, attributes map[string]string) (float64, error) {
+	attributes["regionCode"] = e.region
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	filters := make([]*pricing.Filter, 0, len(names))
+	key := []string{serviceCode, unit}
+	for _, name := range names {
+		filters = append(filters, &pricing.Filter{
+			Field: aws.String(name),
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Value: aws.String(attributes[name]),
+		})
+		key = append(key, name+"="+attributes[name])
+	}
+
+	cacheKey := strings.Join(key, ",")
+	if price, ok := e.prices[cacheKey]; ok {
+		return price, nil
+	}
+
+	out, err := e.pricing.GetProducts(&pricing.GetProductsInput{
+		ServiceCode:   aws.String(serviceCode),
+		FormatVersion: aws.String(formatVersion),
+		Filters:       filters,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get %s products matching %v", serviceCode, attributes)
+	}
+
+	for _, product := range out.PriceList {
+		if price, ok := onDemandPrice(product, unit); ok {
+			e.prices[cacheKey] = price
+			return price, nil
+		}
+	}
+	return 0, errors.Errorf("no on-demand price per %s found for %s products matching %v", unit, serviceCode, attributes)
+}
+
+// onDemandPrice returns the on-demand price in USD per unit of a product of the price list, as in
+// {"terms": {"OnDemand": {"<term>": {"priceDimensions": {"<rate>": {"unit": "Hrs", "pricePerUnit": {"USD": "0.1"}}}}}}}.
+func onDemandPrice(product aws.JSONValue, unit string) (float64, bool) {
+	terms, _ := product["terms"].(map[string]interface{})
+	onDemand, _ := terms["OnDemand"].(map[string]interface{})
+	for _, term := range onDemand {
+		term, _ := term.(map[string]interface{})
+		dimensions, _ := term["priceDimensions"].(map[string]interface{})
+		for _, dimension := range dimensions {
+			dimension, _ := dimension.(map[string]interface{})
+			if dimension["unit"] != unit {
+				continue
+			}
+			pricePerUnit, _ := dimension["pricePerUnit"].(map[string]interface{})
+			usd, _ := pricePerUnit[currencyUSD].(string)
+			price, err := strconv.ParseFloat(usd, 64)
+			if err != nil {
+				continue
+			}
+			return price, true
+		}
+	}
+	return 0, false
+}
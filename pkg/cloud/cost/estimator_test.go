@@ -0,0 +1,239 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/cost/mock_pricingiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+// product returns a price list entry with an on-demand price in USD per unit.
+func product(unit, usd string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{"sku": "SKU"},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"SKU.TERM": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"SKU.TERM.RATE": map[string]interface{}{
+							"unit":         unit,
+							"pricePerUnit": map[string]interface{}{"USD": usd},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// fakePrices answers GetProducts with the price of the product matching the instance type,
+// volume type or product family filters of the input.
+func fakePrices(t *testing.T, prices map[string]aws.JSONValue) func(*pricing.GetProductsInput) (*pricing.GetProductsOutput, error) {
+	return func(input *pricing.GetProductsInput) (*pricing.GetProductsOutput, error) {
+		var key string
+		for _, f := range input.Filters {
+			switch aws.StringValue(f.Field) {
+			case "regionCode":
+				if aws.StringValue(f.Value) != "us-west-2" {
+					t.Fatalf("expected products of region us-west-2, got %q", aws.StringValue(f.Value))
+				}
+			case "instanceType", "volumeApiName":
+				key = aws.StringValue(f.Value)
+			case "productFamily":
+				if key == "" {
+					key = aws.StringValue(f.Value)
+				}
+			}
+		}
+		p, ok := prices[key]
+		if !ok {
+			return &pricing.GetProductsOutput{}, nil
+		}
+		return &pricing.GetProductsOutput{PriceList: []aws.JSONValue{p}}, nil
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	prices := map[string]aws.JSONValue{
+		"t2.micro":      product("Hrs", "0.0116"),
+		"m5.large":      product("Hrs", "0.096"),
+		"gp2":           product("GB-Mo", "0.10"),
+		"io1":           product("GB-Mo", "0.125"),
+		"NAT Gateway":   product("Hrs", "0.045"),
+		"Load Balancer": product("Hrs", "0.025"),
+	}
+
+	testCases := []struct {
+		name      string
+		spec      infrav1.AWSClusterSpec
+		machines  []infrav1.AWSMachineSpec
+		expect    Estimate
+		calls     int
+		expectErr bool
+	}{
+		{
+			name: "managed vpc in three availability zones without machines",
+			spec: infrav1.AWSClusterSpec{Region: "us-west-2"},
+			expect: Estimate{
+				NATGateways:   0.045 * HoursPerMonth * 3,
+				LoadBalancers: 0.025 * HoursPerMonth,
+			},
+			calls: 2,
+		},
+		{
+			name: "bastion and machines share cached prices",
+			spec: infrav1.AWSClusterSpec{
+				Region:  "us-west-2",
+				Bastion: infrav1.Bastion{Enabled: true},
+				NetworkSpec: infrav1.NetworkSpec{
+					Subnets: infrav1.Subnets{
+						{AvailabilityZone: "us-west-2a", IsPublic: true},
+						{AvailabilityZone: "us-west-2a"},
+					},
+				},
+			},
+			machines: []infrav1.AWSMachineSpec{
+				{InstanceType: "m5.large"},
+				{InstanceType: "m5.large", RootVolume: &infrav1.RootVolume{Size: 100}},
+				{InstanceType: "m5.large", RootVolume: &infrav1.RootVolume{Size: 50, Type: "io1"}},
+			},
+			expect: Estimate{
+				Instances:     (0.0116 + 3*0.096) * HoursPerMonth,
+				Volumes:       (8+8+100)*0.10 + 50*0.125,
+				NATGateways:   0.045 * HoursPerMonth,
+				LoadBalancers: 0.025 * HoursPerMonth,
+			},
+			calls: 6,
+		},
+		{
+			name: "unmanaged vpc with additional control plane endpoints",
+			spec: infrav1.AWSClusterSpec{
+				Region:      "us-west-2",
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-1"}},
+				AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+					{Host: "internal", Port: 6443},
+				},
+			},
+			expect: Estimate{
+				LoadBalancers: 0.025 * HoursPerMonth * 2,
+			},
+			calls: 1,
+		},
+		{
+			name:      "instance type without price",
+			spec:      infrav1.AWSClusterSpec{Region: "us-west-2"},
+			machines:  []infrav1.AWSMachineSpec{{InstanceType: "x9.unknown"}},
+			calls:     1,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pricingMock := mock_pricingiface.NewMockPricingAPI(mockCtrl)
+			pricingMock.EXPECT().GetProducts(gomock.Any()).DoAndReturn(fakePrices(t, prices)).Times(tc.calls)
+
+			estimate, err := NewCostEstimator(pricingMock, "us-west-2").Estimate(&tc.spec, tc.machines)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			for name, got := range map[string][2]float64{
+				"instances":      {tc.expect.Instances, estimate.Instances},
+				"nat gateways":   {tc.expect.NATGateways, estimate.NATGateways},
+				"load balancers": {tc.expect.LoadBalancers, estimate.LoadBalancers},
+				"volumes":        {tc.expect.Volumes, estimate.Volumes},
+				"total":          {tc.expect.Total(), estimate.Total()},
+			} {
+				if math.Abs(got[0]-got[1]) > 1e-6 {
+					t.Fatalf("expected %s to cost %v, got %v", name, got[0], got[1])
+				}
+			}
+		})
+	}
+}
+
+func TestEstimatePricingError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pricingMock := mock_pricingiface.NewMockPricingAPI(mockCtrl)
+	pricingMock.EXPECT().GetProducts(gomock.Any()).Return(nil, errors.New("AccessDeniedException"))
+
+	if _, err := NewCostEstimator(pricingMock, "us-west-2").Estimate(&infrav1.AWSClusterSpec{}, nil); err == nil {
+		t.Fatal("expected error but got none")
+	}
+}
+
+func TestOnDemandPrice(t *testing.T) {
+	testCases := []struct {
+		name    string
+		product aws.JSONValue
+		unit    string
+		expect  float64
+		found   bool
+	}{
+		{
+			name:    "price per matching unit",
+			product: product("Hrs", "0.045"),
+			unit:    "Hrs",
+			expect:  0.045,
+			found:   true,
+		},
+		{
+			name:    "price per other unit",
+			product: product("GB", "0.045"),
+			unit:    "Hrs",
+		},
+		{
+			name:    "unparsable price",
+			product: product("Hrs", "n/a"),
+			unit:    "Hrs",
+		},
+		{
+			name:    "no on-demand terms",
+			product: aws.JSONValue{"terms": map[string]interface{}{"Reserved": map[string]interface{}{}}},
+			unit:    "Hrs",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, found := onDemandPrice(tc.product, tc.unit)
+			if found != tc.found || price != tc.expect {
+				t.Fatalf("expected price %v (found: %v), got %v (found: %v)", tc.expect, tc.found, price, found)
+			}
+		})
+	}
+}
@@ -94,6 +94,14 @@ func (ec2Filters) VPC(vpcID string) *ec2.Filter {
 	}
 }
 
+// SubnetAssociations returns a filter based on the subnets associated with a route table.
+func (ec2Filters) SubnetAssociations(subnetIDs ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("association.subnet-id"),
+		Values: aws.StringSlice(subnetIDs),
+	}
+}
+
 // VPCAttachment returns a filter based on the vpc id attached to the resource.
 func (ec2Filters) VPCAttachment(vpcID string) *ec2.Filter {
 	return &ec2.Filter{
@@ -134,6 +142,22 @@ func (ec2Filters) VPCStates(states ...string) *ec2.Filter {
 	}
 }
 
+// VPCEndpointStates returns a filter based on the list of states of VPC endpoints passed in.
+func (ec2Filters) VPCEndpointStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("vpc-endpoint-state"),
+		Values: aws.StringSlice(states),
+	}
+}
+
+// ServiceName returns a filter based on the name of the service of a VPC endpoint.
+func (ec2Filters) ServiceName(name string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("service-name"),
+		Values: aws.StringSlice([]string{name}),
+	}
+}
+
 // SubnetStates returns a filter based on the list of states passed in.
 func (ec2Filters) SubnetStates(states ...string) *ec2.Filter {
 	return &ec2.Filter{
@@ -141,3 +165,35 @@ func (ec2Filters) SubnetStates(states ...string) *ec2.Filter {
 		Values: aws.StringSlice(states),
 	}
 }
+
+// InstanceID returns a filter based on the instance ID.
+func (ec2Filters) InstanceID(instanceID string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("instance-id"),
+		Values: aws.StringSlice([]string{instanceID}),
+	}
+}
+
+// IAMInstanceProfileAssociationStates returns a filter based on the list of association states passed in.
+func (ec2Filters) IAMInstanceProfileAssociationStates(states ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("state"),
+		Values: aws.StringSlice(states),
+	}
+}
+
+// ZoneTypes returns a filter based on the list of availability zone types passed in.
+func (ec2Filters) ZoneTypes(types ...string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("zone-type"),
+		Values: aws.StringSlice(types),
+	}
+}
+
+// RegionName returns a filter based on the name of a region.
+func (ec2Filters) RegionName(region string) *ec2.Filter {
+	return &ec2.Filter{
+		Name:   aws.String("region-name"),
+		Values: aws.StringSlice([]string{region}),
+	}
+}
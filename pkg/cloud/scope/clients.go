@@ -17,16 +17,91 @@ limitations under the License.
 package scope
 
 import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/configservice/configserviceiface"
+	"github.com/aws/aws-sdk-go/service/costexplorer/costexploreriface"
+	"github.com/aws/aws-sdk-go/service/datasync/datasynciface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/aws/aws-sdk-go/service/fis/fisiface"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/imagebuilder/imagebuilderiface"
+	"github.com/aws/aws-sdk-go/service/inspector2/inspector2iface"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+	"github.com/aws/aws-sdk-go/service/ram/ramiface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/servicecatalog/servicecatalogiface"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
 
+// DefaultAWSAPITimeout is the timeout applied to AWS API requests when no
+// per-service timeout has been configured.
+const DefaultAWSAPITimeout = 30 * time.Second
+
 // AWSClients contains all the aws clients used by the scopes.
 type AWSClients struct {
 	EC2             ec2iface.EC2API
 	ELB             elbiface.ELBAPI
+	ELBV2           elbv2iface.ELBV2API
+	IAM             iamiface.IAMAPI
+	SSM             ssmiface.SSMAPI
 	SecretsManager  secretsmanageriface.SecretsManagerAPI
 	ResourceTagging resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	STS             stsiface.STSAPI
+	ServiceQuotas   servicequotasiface.ServiceQuotasAPI
+	RAM             ramiface.RAMAPI
+	CloudFormation  cloudformationiface.CloudFormationAPI
+	ServiceCatalog  servicecatalogiface.ServiceCatalogAPI
+	ConfigService   configserviceiface.ConfigServiceAPI
+	Inspector2      inspector2iface.Inspector2API
+	CloudWatch      cloudwatchiface.CloudWatchAPI
+	FIS             fisiface.FISAPI
+	ACMPCA          acmpcaiface.ACMPCAAPI
+	SNS             snsiface.SNSAPI
+	EventBridge     eventbridgeiface.EventBridgeAPI
+	Pricing         pricingiface.PricingAPI
+	CostExplorer    costexploreriface.CostExplorerAPI
+	ImageBuilder    imagebuilderiface.ImagebuilderAPI
+	SQS             sqsiface.SQSAPI
+	S3              s3iface.S3API
+	DataSync        datasynciface.DataSyncAPI
+
+	// RemoteEC2 is an EC2 client for the cluster's remote region, if any.
+	RemoteEC2 ec2iface.EC2API
+	// RemoteRAM is a RAM client for the cluster's remote region, if its
+	// transit gateway is shared through RAM.
+	RemoteRAM ramiface.RAMAPI
+}
+
+// AWSAPITimeoutConfig holds the per-service timeouts for AWS API requests.
+// A zero value means DefaultAWSAPITimeout.
+type AWSAPITimeoutConfig struct {
+	EC2Timeout time.Duration
+	ELBTimeout time.Duration
+	IAMTimeout time.Duration
+	STSTimeout time.Duration
+}
+
+// timeoutConfig returns an aws.Config whose HTTP client gives up on requests
+// that take longer than the given timeout, so a hung AWS endpoint can't block
+// a reconcile indefinitely.
+func timeoutConfig(timeout time.Duration) *aws.Config {
+	if timeout <= 0 {
+		timeout = DefaultAWSAPITimeout
+	}
+	return aws.NewConfig().WithHTTPClient(&http.Client{Timeout: timeout})
 }
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestTimeoutConfig(t *testing.T) {
+	if got := timeoutConfig(0).HTTPClient.Timeout; got != DefaultAWSAPITimeout {
+		t.Fatalf("expected default timeout %v, got %v", DefaultAWSAPITimeout, got)
+	}
+	if got := timeoutConfig(5 * time.Second).HTTPClient.Timeout; got != 5*time.Second {
+		t.Fatalf("expected timeout of 5s, got %v", got)
+	}
+}
+
+func TestTimeoutConfigSurfacesSlowResponses(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	sess, err := session.NewSession(aws.NewConfig().
+		WithRegion("us-east-1").
+		WithEndpoint(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeout := 100 * time.Millisecond
+	client := ec2.New(sess, timeoutConfig(timeout))
+
+	start := time.Now()
+	_, err = client.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected an error from a request exceeding the timeout")
+	}
+	if elapsed > 2*time.Second {
+		t.Fatalf("expected the request to fail within the %v timeout, took %v", timeout, elapsed)
+	}
+}
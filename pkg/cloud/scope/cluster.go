@@ -22,29 +22,69 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/klogr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/version"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// pricingRegion is the region the AWS Pricing API is served from, which lists the prices of all regions.
+	pricingRegion = "us-east-1"
+	// costExplorerRegion is the region the AWS Cost Explorer API is served from.
+	costExplorerRegion = "us-east-1"
+)
+
 // ClusterScopeParams defines the input parameters used to create a new Scope.
 type ClusterScopeParams struct {
 	AWSClients
-	Client     client.Client
-	Logger     logr.Logger
-	Cluster    *clusterv1.Cluster
-	AWSCluster *infrav1.AWSCluster
+	APITimeouts AWSAPITimeoutConfig
+	Client      client.Client
+	Logger      logr.Logger
+	Cluster     *clusterv1.Cluster
+	AWSCluster  *infrav1.AWSCluster
+
+	// ManagementClusterID, if set, tags the resources of the cluster as created by the management
+	// cluster with this ID, so that the garbage collector can tell them from those of others.
+	ManagementClusterID string
 }
 
 // NewClusterScope creates a new Scope from the supplied parameters.
@@ -61,7 +101,13 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.Logger = klogr.New()
 	}
 
-	session, err := sessionForRegion(params.AWSCluster.Spec.Region)
+	if roleARN := params.AWSCluster.Spec.RoleARN; roleARN != "" {
+		if err := roleAllowList.Check(params.AWSCluster.Namespace, roleARN); err != nil {
+			return nil, errors.Wrap(err, "failed to assume the role of the AWSCluster")
+		}
+	}
+
+	session, err := sessionCache.Get(params.AWSCluster.Spec.Region, params.AWSCluster.Spec.RoleARN)
 	if err != nil {
 		return nil, errors.Errorf("failed to create aws session: %v", err)
 	}
@@ -72,19 +118,69 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 	}
 
 	if params.AWSClients.EC2 == nil {
-		ec2Client := ec2.New(session)
+		ec2Client := ec2.New(session, timeoutConfig(params.APITimeouts.EC2Timeout))
 		ec2Client.Handlers.Build.PushFrontNamed(userAgentHandler)
 		ec2Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
 		params.AWSClients.EC2 = ec2Client
 	}
 
+	if remote := params.AWSCluster.Spec.NetworkSpec.RemoteRegion; remote != nil && params.AWSClients.RemoteEC2 == nil {
+		remoteSession, err := sessionCache.Get(remote.Region, params.AWSCluster.Spec.RoleARN)
+		if err != nil {
+			return nil, errors.Errorf("failed to create aws session for remote region %q: %v", remote.Region, err)
+		}
+		remoteEC2Client := ec2.New(remoteSession, timeoutConfig(params.APITimeouts.EC2Timeout))
+		remoteEC2Client.Handlers.Build.PushFrontNamed(userAgentHandler)
+		remoteEC2Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.RemoteEC2 = remoteEC2Client
+	}
+
+	if remote := params.AWSCluster.Spec.NetworkSpec.RemoteRegion; remote != nil && remote.TransitGatewayRAMShareARN != "" && params.AWSClients.RemoteRAM == nil {
+		remoteSession, err := sessionCache.Get(remote.Region, params.AWSCluster.Spec.RoleARN)
+		if err != nil {
+			return nil, errors.Errorf("failed to create aws session for remote region %q: %v", remote.Region, err)
+		}
+		remoteRAMClient := ram.New(remoteSession)
+		remoteRAMClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		remoteRAMClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.RemoteRAM = remoteRAMClient
+	}
+
 	if params.AWSClients.ELB == nil {
-		elbClient := elb.New(session)
+		elbClient := elb.New(session, timeoutConfig(params.APITimeouts.ELBTimeout))
 		elbClient.Handlers.Build.PushFrontNamed(userAgentHandler)
 		elbClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
 		params.AWSClients.ELB = elbClient
 	}
 
+	if params.AWSClients.ELBV2 == nil {
+		elbv2Client := elbv2.New(session, timeoutConfig(params.APITimeouts.ELBTimeout))
+		elbv2Client.Handlers.Build.PushFrontNamed(userAgentHandler)
+		elbv2Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.ELBV2 = elbv2Client
+	}
+
+	if params.AWSClients.IAM == nil {
+		iamClient := iam.New(session, timeoutConfig(params.APITimeouts.IAMTimeout))
+		iamClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		iamClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.IAM = iamClient
+	}
+
+	if params.AWSClients.STS == nil {
+		stsClient := sts.New(session, timeoutConfig(params.APITimeouts.STSTimeout))
+		stsClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		stsClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.STS = stsClient
+	}
+
+	if params.AWSClients.SSM == nil {
+		ssmClient := ssm.New(session)
+		ssmClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		ssmClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.SSM = ssmClient
+	}
+
 	if params.AWSClients.ResourceTagging == nil {
 		resourceTagging := resourcegroupstaggingapi.New(session)
 		resourceTagging.Handlers.Build.PushFrontNamed(userAgentHandler)
@@ -92,6 +188,133 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.AWSClients.ResourceTagging = resourceTagging
 	}
 
+	if params.AWSClients.ServiceQuotas == nil {
+		quotasClient := servicequotas.New(session)
+		quotasClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		quotasClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.ServiceQuotas = quotasClient
+	}
+
+	if params.AWSClients.RAM == nil {
+		ramClient := ram.New(session)
+		ramClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		ramClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.RAM = ramClient
+	}
+
+	if params.AWSClients.CloudFormation == nil {
+		cfnClient := cloudformation.New(session)
+		cfnClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		cfnClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.CloudFormation = cfnClient
+	}
+
+	if params.AWSClients.ServiceCatalog == nil {
+		scClient := servicecatalog.New(session)
+		scClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		scClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.ServiceCatalog = scClient
+	}
+
+	if params.AWSClients.ConfigService == nil {
+		configClient := configservice.New(session)
+		configClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		configClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.ConfigService = configClient
+	}
+
+	if params.AWSClients.Inspector2 == nil {
+		inspectorClient := inspector2.New(session)
+		inspectorClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		inspectorClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.Inspector2 = inspectorClient
+	}
+
+	if params.AWSClients.CloudWatch == nil {
+		cwClient := cloudwatch.New(session)
+		cwClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		cwClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.CloudWatch = cwClient
+	}
+
+	if params.AWSClients.FIS == nil {
+		fisClient := fis.New(session)
+		fisClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		fisClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.FIS = fisClient
+	}
+
+	if params.AWSClients.ACMPCA == nil {
+		pcaClient := acmpca.New(session)
+		pcaClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		pcaClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.ACMPCA = pcaClient
+	}
+
+	if params.AWSClients.SNS == nil {
+		snsClient := sns.New(session)
+		snsClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		snsClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.SNS = snsClient
+	}
+
+	if params.AWSClients.EventBridge == nil {
+		eventBridgeClient := eventbridge.New(session)
+		eventBridgeClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		eventBridgeClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.EventBridge = eventBridgeClient
+	}
+
+	if params.AWSClients.ImageBuilder == nil {
+		imageBuilderClient := imagebuilder.New(session)
+		imageBuilderClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		imageBuilderClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.ImageBuilder = imageBuilderClient
+	}
+
+	if params.AWSClients.SQS == nil {
+		sqsClient := sqs.New(session)
+		sqsClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		sqsClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.SQS = sqsClient
+	}
+
+	if params.AWSClients.S3 == nil {
+		s3Client := s3.New(session)
+		s3Client.Handlers.Build.PushFrontNamed(userAgentHandler)
+		s3Client.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.S3 = s3Client
+	}
+
+	if params.AWSClients.DataSync == nil {
+		dataSyncClient := datasync.New(session)
+		dataSyncClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		dataSyncClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.DataSync = dataSyncClient
+	}
+
+	if params.AWSClients.Pricing == nil {
+		pricingSession, err := sessionCache.Get(pricingRegion, params.AWSCluster.Spec.RoleARN)
+		if err != nil {
+			return nil, errors.Errorf("failed to create aws session for pricing region %q: %v", pricingRegion, err)
+		}
+		// Cost estimates are best effort, their failures are logged rather than reported as permissions issues.
+		pricingClient := pricing.New(pricingSession)
+		pricingClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		params.AWSClients.Pricing = pricingClient
+	}
+
+	if params.AWSClients.CostExplorer == nil {
+		costExplorerSession, err := sessionCache.Get(costExplorerRegion, params.AWSCluster.Spec.RoleARN)
+		if err != nil {
+			return nil, errors.Errorf("failed to create aws session for cost explorer region %q: %v", costExplorerRegion, err)
+		}
+		costExplorerClient := costexplorer.New(costExplorerSession)
+		costExplorerClient.Handlers.Build.PushFrontNamed(userAgentHandler)
+		costExplorerClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
+		params.AWSClients.CostExplorer = costExplorerClient
+	}
+
 	if params.AWSClients.SecretsManager == nil {
 		sClient := secretsmanager.New(session)
 		sClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(params.AWSCluster))
@@ -109,6 +332,8 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		Cluster:     params.Cluster,
 		AWSCluster:  params.AWSCluster,
 		patchHelper: helper,
+
+		managementClusterID: params.ManagementClusterID,
 	}, nil
 }
 
@@ -132,6 +357,12 @@ type ClusterScope struct {
 	AWSClients
 	Cluster    *clusterv1.Cluster
 	AWSCluster *infrav1.AWSCluster
+
+	// remoteRegion is set on scopes returned by RemoteRegionScope.
+	remoteRegion bool
+
+	// managementClusterID is the ID of the management cluster added to the tags of the cluster, if any.
+	managementClusterID string
 }
 
 // Network returns the cluster network object.
@@ -172,11 +403,230 @@ func (s *ClusterScope) Namespace() string {
 	return s.Cluster.Namespace
 }
 
-// Region returns the cluster region.
+// Region returns the cluster region, or the remote region for scopes
+// returned by RemoteRegionScope.
 func (s *ClusterScope) Region() string {
+	if s.remoteRegion {
+		return s.AWSCluster.Spec.NetworkSpec.RemoteRegion.Region
+	}
 	return s.AWSCluster.Spec.Region
 }
 
+// RoleARN returns the ARN of the role assumed for the cluster, or an empty
+// string if the controller's own credentials are used.
+func (s *ClusterScope) RoleARN() string {
+	return s.AWSCluster.Spec.RoleARN
+}
+
+// RemoteRegion returns the cluster's remote region configuration, if any.
+func (s *ClusterScope) RemoteRegion() *infrav1.RemoteRegionSpec {
+	return s.AWSCluster.Spec.NetworkSpec.RemoteRegion
+}
+
+// RAMShare returns the cluster's RAM resource share configuration, if any.
+func (s *ClusterScope) RAMShare() *infrav1.RAMShareSpec {
+	return s.AWSCluster.Spec.NetworkSpec.RAMShare
+}
+
+// VPCEndpoints returns the configuration of the VPC endpoints of the cluster's VPC, if any.
+func (s *ClusterScope) VPCEndpoints() *infrav1.VPCEndpointsSpec {
+	return s.AWSCluster.Spec.NetworkSpec.VPCEndpoints
+}
+
+// EIPPool returns the configuration of the cluster's pool of pre-allocated Elastic IPs, if any.
+func (s *ClusterScope) EIPPool() *infrav1.EIPPoolSpec {
+	return s.AWSCluster.Spec.NetworkSpec.EIPPool
+}
+
+// InstanceConnectEndpoint returns the cluster's EC2 Instance Connect Endpoint configuration, if any.
+func (s *ClusterScope) InstanceConnectEndpoint() *infrav1.InstanceConnectEndpointSpec {
+	return s.AWSCluster.Spec.NetworkSpec.InstanceConnectEndpoint
+}
+
+// CloudFormationStackRef returns the CloudFormation stack the cluster's network is imported from, if any.
+func (s *ClusterScope) CloudFormationStackRef() *infrav1.CFStackRef {
+	return s.AWSCluster.Spec.NetworkSpec.CloudFormationStackRef
+}
+
+// SecurityGroupOverrides returns the existing security groups used for the roles of the cluster, if any.
+func (s *ClusterScope) SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string {
+	return s.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides
+}
+
+// AdoptResources returns whether the existing network resources referenced by the cluster are adopted.
+func (s *ClusterScope) AdoptResources() bool {
+	return s.AWSCluster.Annotations[infrav1.AdoptAnnotation] == "true"
+}
+
+// TerraformStateImport returns the Terraform state the network of the cluster is imported from, if any.
+func (s *ClusterScope) TerraformStateImport() *infrav1.TFStateImportSpec {
+	return s.AWSCluster.Spec.TerraformStateImport
+}
+
+// ServiceCatalogRef returns the Service Catalog product provisioned for the cluster, if any.
+func (s *ClusterScope) ServiceCatalogRef() *infrav1.ServiceCatalogRef {
+	return s.AWSCluster.Spec.ServiceCatalogRef
+}
+
+// ConformancePacks returns the names of the AWS Config conformance packs whose compliance is reported for the cluster.
+func (s *ClusterScope) ConformancePacks() []string {
+	return s.AWSCluster.Spec.SecuritySpec.ConformancePacks
+}
+
+// FISExperimentTemplates returns the FIS experiment templates of the cluster.
+func (s *ClusterScope) FISExperimentTemplates() []infrav1.FISTemplateSpec {
+	return s.AWSCluster.Spec.FISExperimentTemplates
+}
+
+// ServiceAccountRoles returns the IAM roles of the cluster's service accounts.
+func (s *ClusterScope) ServiceAccountRoles() []infrav1.ServiceAccountRoleSpec {
+	return s.AWSCluster.Spec.ServiceAccountRole
+}
+
+// PrivateCAARN returns the ARN of the private CA issuing the cluster's etcd certificates, if any.
+func (s *ClusterScope) PrivateCAARN() string {
+	if s.AWSCluster.Spec.CertificateAuthority == nil {
+		return ""
+	}
+	return s.AWSCluster.Spec.CertificateAuthority.PrivateCAARN
+}
+
+// LifecycleNotifications returns the SNS topic the lifecycle events of the cluster are published to, if any.
+func (s *ClusterScope) LifecycleNotifications() *infrav1.SNSNotificationSpec {
+	return s.AWSCluster.Spec.LifecycleNotifications
+}
+
+// EventBus returns the event bus the lifecycle events of the cluster's machines are sent to, if any.
+func (s *ClusterScope) EventBus() *infrav1.EventBridgeSpec {
+	return s.AWSCluster.Spec.EventBridge
+}
+
+// BootstrapTokenRotation returns the rotation of the cluster's node bootstrap token, if any.
+func (s *ClusterScope) BootstrapTokenRotation() *infrav1.RotationSpec {
+	return s.AWSCluster.Spec.BootstrapTokenRotation
+}
+
+// TerminationAlerts returns the termination alerts of the cluster, if any.
+func (s *ClusterScope) TerminationAlerts() *infrav1.TerminationAlertSpec {
+	return s.AWSCluster.Spec.TerminationAlerts
+}
+
+// CostAllocationTags returns the keys of the cost allocation tags of the cluster.
+func (s *ClusterScope) CostAllocationTags() []string {
+	return s.AWSCluster.Spec.CostAllocationTags
+}
+
+// Karpenter returns the Karpenter installation of the cluster, if any.
+func (s *ClusterScope) Karpenter() *infrav1.KarpenterSpec {
+	return s.AWSCluster.Spec.Karpenter
+}
+
+// NodeTerminationHandler returns the configuration of the queue of the cluster's AWS Node Termination Handler, if any.
+func (s *ClusterScope) NodeTerminationHandler() *infrav1.NTHSpec {
+	return s.AWSCluster.Spec.NodeTerminationHandler
+}
+
+// SSMParameterExport returns the fields of the status of the cluster exported to the Parameter Store, if any.
+func (s *ClusterScope) SSMParameterExport() *infrav1.SSMExportSpec {
+	return s.AWSCluster.Spec.SSMParameterExport
+}
+
+// DataSyncTask returns the DataSync task of the cluster, if any.
+func (s *ClusterScope) DataSyncTask() *infrav1.DataSyncSpec {
+	return s.AWSCluster.Spec.DataSync
+}
+
+// NetworkBenchmark returns the network benchmark of the cluster, if any.
+func (s *ClusterScope) NetworkBenchmark() *infrav1.BenchmarkSpec {
+	return s.AWSCluster.Spec.NetworkBenchmark
+}
+
+// KarpenterStatus returns the status of the Karpenter installation of the cluster, initializing it if needed.
+func (s *ClusterScope) KarpenterStatus() *infrav1.KarpenterStatus {
+	if s.AWSCluster.Status.Karpenter == nil {
+		s.AWSCluster.Status.Karpenter = &infrav1.KarpenterStatus{}
+	}
+	return s.AWSCluster.Status.Karpenter
+}
+
+// WorkloadClient returns a client for the core resources of the workload cluster, built from its kubeconfig secret.
+func (s *ClusterScope) WorkloadClient(ctx context.Context) (client.Client, error) {
+	return remote.NewClusterClient(ctx, s.client, util.ObjectKey(s.Cluster), clientgoscheme.Scheme)
+}
+
+// GetSecret returns the secret with the given name from the namespace of the cluster,
+// or nil if it doesn't exist.
+func (s *ClusterScope) GetSecret(name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: s.Namespace(), Name: name}
+	if err := s.client.Get(context.TODO(), key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get secret %s/%s", s.Namespace(), name)
+	}
+	return secret, nil
+}
+
+// SaveSecret creates the given secret in the namespace of the cluster, owned by the AWSCluster,
+// or updates its data if it already exists.
+func (s *ClusterScope) SaveSecret(secret *corev1.Secret) error {
+	existing, err := s.GetSecret(secret.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		existing.Type = secret.Type
+		existing.Data = secret.Data
+		if err := s.client.Update(context.TODO(), existing); err != nil {
+			return errors.Wrapf(err, "failed to update secret %s/%s", s.Namespace(), secret.Name)
+		}
+		return nil
+	}
+
+	secret.Namespace = s.Namespace()
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[clusterv1.ClusterLabelName] = s.Name()
+	secret.OwnerReferences = append(secret.OwnerReferences, metav1.OwnerReference{
+		APIVersion: infrav1.GroupVersion.String(),
+		Kind:       "AWSCluster",
+		Name:       s.AWSCluster.Name,
+		UID:        s.AWSCluster.UID,
+	})
+	if err := s.client.Create(context.TODO(), secret); err != nil {
+		return errors.Wrapf(err, "failed to create secret %s/%s", s.Namespace(), secret.Name)
+	}
+	return nil
+}
+
+// IsRemoteRegion returns true for scopes returned by RemoteRegionScope.
+func (s *ClusterScope) IsRemoteRegion() bool {
+	return s.remoteRegion
+}
+
+// RemoteRegionScope returns a copy of the scope whose EC2 client targets the
+// cluster's remote region. It returns nil if the cluster has no remote region.
+func (s *ClusterScope) RemoteRegionScope() *ClusterScope {
+	if s.RemoteRegion() == nil || s.RemoteEC2 == nil {
+		return nil
+	}
+	remote := *s
+	remote.EC2 = s.RemoteEC2
+	remote.remoteRegion = true
+	return &remote
+}
+
+// IsRemoteSubnet returns true if the given subnet belongs to the cluster's remote region.
+func (s *ClusterScope) IsRemoteSubnet(id string) bool {
+	if s.RemoteRegion() == nil || id == "" {
+		return false
+	}
+	_, ok := s.RemoteRegion().Subnets.ToMap()[id]
+	return ok
+}
+
 // ControlPlaneLoadBalancer returns the AWSLoadBalancerSpec
 func (s *ClusterScope) ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec {
 	return s.AWSCluster.Spec.ControlPlaneLoadBalancer
@@ -203,6 +653,15 @@ func (s *ClusterScope) ListOptionsLabelSelector() client.ListOption {
 	})
 }
 
+// ListAWSMachines returns the AWSMachines of the cluster.
+func (s *ClusterScope) ListAWSMachines(ctx context.Context) ([]infrav1.AWSMachine, error) {
+	machines := &infrav1.AWSMachineList{}
+	if err := s.client.List(ctx, machines, client.InNamespace(s.Namespace()), s.ListOptionsLabelSelector()); err != nil {
+		return nil, errors.Wrapf(err, "failed to list AWSMachines of cluster %s/%s", s.Namespace(), s.Name())
+	}
+	return machines.Items, nil
+}
+
 // PatchObject persists the cluster configuration and status.
 func (s *ClusterScope) PatchObject() error {
 	return s.patchHelper.Patch(
@@ -225,13 +684,19 @@ func (s *ClusterScope) Close() error {
 	return s.PatchObject()
 }
 
-// AdditionalTags returns AdditionalTags from the scope's AWSCluster. The returned value will never be nil.
+// AdditionalTags returns AdditionalTags from the scope's AWSCluster, and the tags of the management cluster
+// if the scope has its ID. The returned value will never be nil.
 func (s *ClusterScope) AdditionalTags() infrav1.Tags {
 	if s.AWSCluster.Spec.AdditionalTags == nil {
 		s.AWSCluster.Spec.AdditionalTags = infrav1.Tags{}
 	}
 
-	return s.AWSCluster.Spec.AdditionalTags.DeepCopy()
+	tags := s.AWSCluster.Spec.AdditionalTags.DeepCopy()
+	if s.managementClusterID != "" {
+		tags[infrav1.ManagementClusterTagKey] = s.managementClusterID
+		tags[infrav1.ClusterNamespaceTagKey] = s.Namespace()
+	}
+	return tags
 }
 
 // APIServerPort returns the APIServerPort to use when creating the load balancer.
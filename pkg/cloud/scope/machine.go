@@ -24,6 +24,8 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/klogr"
 	"k8s.io/utils/pointer"
@@ -194,6 +196,25 @@ func (m *MachineScope) UserDataIsUncompressed() bool {
 	return m.AWSMachine.Spec.UncompressedUserData != nil && *m.AWSMachine.Spec.UncompressedUserData
 }
 
+// CloudProviderConfig returns the cloud.conf settings of the AWSMachine, if any, with the cluster name, region
+// and VPC ID defaulted to those of the cluster.
+func (m *MachineScope) CloudProviderConfig() *infrav1.CloudProviderConfigSpec {
+	spec := m.AWSMachine.Spec.CloudProviderConfig.DeepCopy()
+	if spec == nil {
+		return nil
+	}
+	if spec.ClusterName == "" {
+		spec.ClusterName = m.Cluster.Name
+	}
+	if spec.Region == "" {
+		spec.Region = m.AWSCluster.Spec.Region
+	}
+	if spec.VpcID == "" {
+		spec.VpcID = m.AWSCluster.Spec.NetworkSpec.VPC.ID
+	}
+	return spec
+}
+
 // GetSecretPrefix returns the prefix for the secrets belonging
 // to the AWSMachine in AWS Secrets Manager
 func (m *MachineScope) GetSecretPrefix() string {
@@ -258,6 +279,59 @@ func (m *MachineScope) GetRawBootstrapData() ([]byte, error) {
 	return value, nil
 }
 
+// launchSnapshotConfigMapKey is the key of the launch snapshot in the ConfigMaps holding one.
+const launchSnapshotConfigMapKey = "runInstancesInput.json"
+
+// GetLaunchSnapshotConfigMap returns the launch snapshot held by the given ConfigMap in the namespace of the
+// machine, or nil if there is none.
+func (m *MachineScope) GetLaunchSnapshotConfigMap(name string) ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: name}
+	if err := m.client.Get(context.TODO(), key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get launch snapshot ConfigMap %s/%s", m.Namespace(), name)
+	}
+
+	snapshot, ok := configMap.Data[launchSnapshotConfigMapKey]
+	if !ok {
+		return nil, nil
+	}
+	return []byte(snapshot), nil
+}
+
+// StoreLaunchSnapshotConfigMap stores a launch snapshot in the given ConfigMap in the namespace of the machine.
+// The ConfigMap is not owned by the machine, for the snapshot to outlive it.
+func (m *MachineScope) StoreLaunchSnapshotConfigMap(name string, snapshot []byte) error {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: name}
+	if err := m.client.Get(context.TODO(), key, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get launch snapshot ConfigMap %s/%s", m.Namespace(), name)
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: m.Namespace(),
+				Name:      name,
+				Labels:    map[string]string{clusterv1.ClusterLabelName: m.Cluster.Name},
+			},
+			Data: map[string]string{launchSnapshotConfigMapKey: string(snapshot)},
+		}
+		if err := m.client.Create(context.TODO(), configMap); err != nil {
+			return errors.Wrapf(err, "failed to create launch snapshot ConfigMap %s/%s", m.Namespace(), name)
+		}
+		return nil
+	}
+
+	configMap.Data = map[string]string{launchSnapshotConfigMapKey: string(snapshot)}
+	if err := m.client.Update(context.TODO(), configMap); err != nil {
+		return errors.Wrapf(err, "failed to update launch snapshot ConfigMap %s/%s", m.Namespace(), name)
+	}
+	return nil
+}
+
 // PatchObject persists the machine spec and status.
 func (m *MachineScope) PatchObject() error {
 	return m.patchHelper.Patch(
@@ -267,6 +341,7 @@ func (m *MachineScope) PatchObject() error {
 			infrav1.InstanceReadyCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.ELBAttachedCondition,
+			infrav1.PostProvisionHookSucceededCondition,
 		}})
 }
 
@@ -18,6 +18,7 @@ package scope
 
 import (
 	"encoding/base64"
+	"reflect"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -222,3 +223,32 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestCloudProviderConfigDefaults(t *testing.T) {
+	scope, err := setupMachineScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec := scope.CloudProviderConfig(); spec != nil {
+		t.Fatalf("expected no cloud provider config, got %+v", spec)
+	}
+
+	scope.AWSCluster.Spec.Region = "us-east-1"
+	scope.AWSCluster.Spec.NetworkSpec.VPC.ID = "vpc-1"
+	scope.AWSMachine.Spec.CloudProviderConfig = &infrav1.CloudProviderConfigSpec{
+		Region:    "us-west-2",
+		SubnetIDs: []string{"subnet-1"},
+	}
+	expected := &infrav1.CloudProviderConfigSpec{
+		ClusterName: "my-cluster",
+		Region:      "us-west-2",
+		VpcID:       "vpc-1",
+		SubnetIDs:   []string{"subnet-1"},
+	}
+	if spec := scope.CloudProviderConfig(); !reflect.DeepEqual(spec, expected) {
+		t.Fatalf("expected cloud provider config %+v, got %+v", expected, spec)
+	}
+	if scope.AWSMachine.Spec.CloudProviderConfig.ClusterName != "" {
+		t.Fatal("expected the spec of the AWSMachine to be left unchanged")
+	}
+}
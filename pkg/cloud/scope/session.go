@@ -17,25 +17,255 @@ limitations under the License.
 package scope
 
 import (
+	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 )
 
-var sessionCache sync.Map
+const (
+	// DefaultMaxRetries is the number of times a throttled or transient AWS
+	// API request is retried before the error is returned to the caller.
+	DefaultMaxRetries = 10
 
-func sessionForRegion(region string) (*session.Session, error) {
-	s, ok := sessionCache.Load(region)
-	if ok {
-		return s.(*session.Session), nil
+	// DefaultRetryBaseDelay is the base delay used to compute the backoff.
+	DefaultRetryBaseDelay = 100 * time.Millisecond
+
+	// DefaultRetryMaxDelay caps the computed backoff for a single attempt.
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+var sessionCache = NewSessionCache(time.Hour)
+
+// sessionBuildHandlers are added to the build handlers of every session, and thereby of every AWS client
+// created from them.
+var sessionBuildHandlers []request.NamedHandler
+
+// AddSessionBuildHandler adds a handler to the build handlers of the sessions created afterwards. It is meant
+// to be called before the controllers are started.
+func AddSessionBuildHandler(handler request.NamedHandler) {
+	sessionBuildHandlers = append(sessionBuildHandlers, handler)
+}
+
+// SessionForRegion returns the session of the controller's own credentials for the given region.
+// It is meant for controllers that are not scoped to a cluster.
+func SessionForRegion(region string) (*session.Session, error) {
+	return sessionCache.Get(region, "")
+}
+
+// SessionForRole returns the session of the given role for the given region, or of the controller's
+// own credentials when the role is empty. It is meant for webhooks that call AWS on behalf of a cluster.
+func SessionForRole(region, roleARN string) (*session.Session, error) {
+	return sessionCache.Get(region, roleARN)
+}
+
+// RoleAllowList restricts the IAM roles AWSClusters may have the controller assume with their
+// spec.roleARN, as any user allowed to create an AWSCluster could otherwise use any role the
+// controller's credentials may assume. The zero value allows no role.
+type RoleAllowList struct {
+	// Namespaces are the namespaces of the AWSClusters allowed to set a role, "*" allowing all of them.
+	Namespaces []string
+	// RoleARNs are the roles allowed, "*" allowing all of them.
+	RoleARNs []string
+}
+
+// Check returns an error unless AWSClusters of the given namespace may use the given role.
+func (l RoleAllowList) Check(namespace, roleARN string) error {
+	if !allowListContains(l.Namespaces, namespace) {
+		return errors.Errorf("AWSClusters in namespace %q are not allowed to set a role", namespace)
 	}
+	if !allowListContains(l.RoleARNs, roleARN) {
+		return errors.Errorf("role %q is not allowed", roleARN)
+	}
+	return nil
+}
 
-	ns, err := session.NewSession(aws.NewConfig().WithRegion(region))
+func allowListContains(list []string, value string) bool {
+	for _, v := range list {
+		if v == "*" || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// roleAllowList restricts the roles of the AWSClusters the scopes are created for.
+var roleAllowList RoleAllowList
+
+// SetRoleAllowList sets the roles the AWSClusters may use. It is meant to be called before the
+// controllers are started.
+func SetRoleAllowList(allowList RoleAllowList) {
+	roleAllowList = allowList
+}
+
+// throttlingCodes are the AWS error codes that are always retried.
+var throttlingCodes = map[string]struct{}{
+	"ThrottlingException":  {},
+	"RequestLimitExceeded": {},
+	"ServiceUnavailable":   {},
+}
+
+// JitteredRetryer is a request.Retryer that uses exponential backoff with full
+// jitter, so that many controllers throttled at the same time don't retry in
+// lockstep and make the throttling worse.
+type JitteredRetryer struct {
+	NumMaxRetries int
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+}
+
+// NewJitteredRetryer returns a JitteredRetryer with the default settings.
+func NewJitteredRetryer() *JitteredRetryer {
+	return &JitteredRetryer{
+		NumMaxRetries: DefaultMaxRetries,
+		BaseDelay:     DefaultRetryBaseDelay,
+		MaxDelay:      DefaultRetryMaxDelay,
+	}
+}
+
+// MaxRetries returns the number of times a request may be retried.
+func (r *JitteredRetryer) MaxRetries() int {
+	return r.NumMaxRetries
+}
+
+// RetryRules returns a random delay between zero and BaseDelay * 2^attempt,
+// capped at MaxDelay.
+func (r *JitteredRetryer) RetryRules(req *request.Request) time.Duration {
+	return r.backoff(req.RetryCount)
+}
+
+func (r *JitteredRetryer) backoff(attempt int) time.Duration {
+	ceiling := r.MaxDelay
+	// Guard against overflowing the shift for large attempt counts.
+	if attempt < 32 {
+		if d := r.BaseDelay * time.Duration(1<<uint(attempt)); d > 0 && d < ceiling {
+			ceiling = d
+		}
+	}
+	return time.Duration(rand.Float64() * float64(ceiling))
+}
+
+// ShouldRetry returns true if the request failed with a throttling error, or
+// with an error the SDK considers retryable.
+func (r *JitteredRetryer) ShouldRetry(req *request.Request) bool {
+	if r.NumMaxRetries == 0 {
+		return false
+	}
+	if req.Retryable != nil {
+		return *req.Retryable
+	}
+	if code, ok := awserrors.Code(req.Error); ok {
+		if _, ok := throttlingCodes[code]; ok {
+			return true
+		}
+	}
+	return req.IsErrorRetryable() || req.IsErrorThrottle()
+}
+
+// sessionKey identifies the credentials a session was created for. AccountID
+// and RoleARN are empty for sessions using the controller's own credentials.
+type sessionKey struct {
+	AccountID string
+	Region    string
+	RoleARN   string
+}
+
+type sessionEntry struct {
+	session *session.Session
+	expires time.Time
+}
+
+// SessionCache caches AWS sessions per identity, so that clusters managed in
+// different accounts never share credentials. Entries expire after the TTL.
+// Expired entries are swept at most once per TTL, by the first Get after the
+// sweep is due, so they are kept for at most two TTLs.
+type SessionCache struct {
+	ttl        time.Duration
+	now        func() time.Time
+	newSession func(key sessionKey) (*session.Session, error)
+
+	mu        sync.RWMutex
+	sessions  map[sessionKey]sessionEntry
+	nextSweep time.Time
+}
+
+// NewSessionCache returns a SessionCache whose entries expire after the given TTL.
+func NewSessionCache(ttl time.Duration) *SessionCache {
+	return &SessionCache{
+		ttl:        ttl,
+		now:        time.Now,
+		newSession: newSession,
+		sessions:   map[sessionKey]sessionEntry{},
+	}
+}
+
+// Get returns the session for the given region and role, creating it if it
+// isn't cached. An empty roleARN selects the controller's own credentials.
+func (c *SessionCache) Get(region, roleARN string) (*session.Session, error) {
+	key := sessionKey{Region: region, RoleARN: roleARN}
+	if roleARN != "" {
+		parsed, err := arn.Parse(roleARN)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse role ARN %q", roleARN)
+		}
+		key.AccountID = parsed.AccountID
+	}
+
+	c.mu.RLock()
+	entry, ok := c.sessions[key]
+	sweepDue := !c.now().Before(c.nextSweep)
+	c.mu.RUnlock()
+	if ok && c.now().Before(entry.expires) && !sweepDue {
+		return entry.session, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if !now.Before(c.nextSweep) {
+		for k, e := range c.sessions {
+			if !now.Before(e.expires) {
+				delete(c.sessions, k)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+
+	// Another caller may have created the session while we waited for the lock.
+	if entry, ok := c.sessions[key]; ok && now.Before(entry.expires) {
+		return entry.session, nil
+	}
+
+	sess, err := c.newSession(key)
 	if err != nil {
 		return nil, err
 	}
+	c.sessions[key] = sessionEntry{session: sess, expires: now.Add(c.ttl)}
+	return sess, nil
+}
 
-	sessionCache.Store(region, ns)
-	return ns, nil
+func newSession(key sessionKey) (*session.Session, error) {
+	cfg := request.WithRetryer(aws.NewConfig().WithRegion(key.Region), NewJitteredRetryer())
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if key.RoleARN != "" {
+		sess, err = session.NewSession(cfg.Copy().WithCredentials(stscreds.NewCredentials(sess, key.RoleARN)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, handler := range sessionBuildHandlers {
+		sess.Handlers.Build.PushBackNamed(handler)
+	}
+	return sess, nil
 }
@@ -0,0 +1,283 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestJitteredRetryerRetryRules(t *testing.T) {
+	r := NewJitteredRetryer()
+
+	for attempt := 0; attempt < 20; attempt++ {
+		ceiling := r.BaseDelay * time.Duration(1<<uint(attempt))
+		if ceiling > r.MaxDelay {
+			ceiling = r.MaxDelay
+		}
+		for i := 0; i < 100; i++ {
+			delay := r.RetryRules(&request.Request{RetryCount: attempt})
+			if delay < 0 || delay > ceiling {
+				t.Fatalf("attempt %d: delay %v out of range [0, %v]", attempt, delay, ceiling)
+			}
+		}
+	}
+
+	// The cap must hold even for attempt counts that would overflow the shift.
+	if delay := r.RetryRules(&request.Request{RetryCount: 100}); delay > r.MaxDelay {
+		t.Fatalf("delay %v exceeds max delay %v", delay, r.MaxDelay)
+	}
+}
+
+func TestJitteredRetryerShouldRetry(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "ThrottlingException",
+			err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			expected: true,
+		},
+		{
+			name:     "RequestLimitExceeded",
+			err:      awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			expected: true,
+		},
+		{
+			name:     "ServiceUnavailable",
+			err:      awserr.New("ServiceUnavailable", "Service unavailable", nil),
+			expected: true,
+		},
+		{
+			name:     "non retryable AWS error",
+			err:      awserr.New("InvalidParameterValue", "bad value", nil),
+			expected: false,
+		},
+	}
+
+	r := NewJitteredRetryer()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := r.ShouldRetry(&request.Request{Error: tc.err}); got != tc.expected {
+				t.Fatalf("expected ShouldRetry to be %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	if r.ShouldRetry(&request.Request{Error: awserr.New("ThrottlingException", "", nil), Retryable: aws.Bool(false)}) {
+		t.Fatal("expected an explicit Retryable=false to be honoured")
+	}
+	if (&JitteredRetryer{}).ShouldRetry(&request.Request{Error: awserr.New("ThrottlingException", "", nil)}) {
+		t.Fatal("expected no retries when NumMaxRetries is 0")
+	}
+}
+
+func TestJitteredRetryerMaxRetries(t *testing.T) {
+	if got := NewJitteredRetryer().MaxRetries(); got != DefaultMaxRetries {
+		t.Fatalf("expected %d max retries, got %d", DefaultMaxRetries, got)
+	}
+
+	sess, err := NewSessionCache(time.Minute).Get("us-west-2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sess.Config.Retryer.(request.Retryer).MaxRetries(); got != DefaultMaxRetries {
+		t.Fatalf("expected session retryer to allow %d retries, got %d", DefaultMaxRetries, got)
+	}
+}
+
+func TestSessionCache(t *testing.T) {
+	const (
+		roleA = "arn:aws:iam::111111111111:role/capa"
+		roleB = "arn:aws:iam::222222222222:role/capa"
+	)
+
+	now := time.Now()
+	created := 0
+	c := NewSessionCache(time.Minute)
+	c.now = func() time.Time { return now }
+	c.newSession = func(key sessionKey) (*session.Session, error) {
+		created++
+		return session.NewSession(aws.NewConfig().
+			WithRegion(key.Region).
+			WithCredentials(credentials.NewStaticCredentials(key.AccountID, "secret", "")))
+	}
+
+	accessKeyID := func(roleARN string) string {
+		sess, err := c.Get("us-east-1", roleARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := sess.Config.Credentials.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v.AccessKeyID
+	}
+
+	if got := accessKeyID(roleA); got != "111111111111" {
+		t.Fatalf("expected credentials for account 111111111111, got %q", got)
+	}
+	if got := accessKeyID(roleB); got != "222222222222" {
+		t.Fatalf("expected credentials for account 222222222222, got %q", got)
+	}
+	if got := accessKeyID(roleA); got != "111111111111" {
+		t.Fatalf("expected cached credentials for account 111111111111, got %q", got)
+	}
+	if created != 2 {
+		t.Fatalf("expected 2 sessions to be created, got %d", created)
+	}
+
+	now = now.Add(2 * time.Minute)
+	accessKeyID(roleA)
+	if created != 3 {
+		t.Fatalf("expected an expired session to be recreated, got %d creations", created)
+	}
+	if _, ok := c.sessions[sessionKey{AccountID: "222222222222", Region: "us-east-1", RoleARN: roleB}]; ok {
+		t.Fatal("expected the expired session for account 222222222222 to be evicted")
+	}
+
+	if _, err := c.Get("us-east-1", "not-an-arn"); err == nil {
+		t.Fatal("expected an error for an invalid role ARN")
+	}
+}
+
+func TestSessionCacheAssumesRoles(t *testing.T) {
+	c := NewSessionCache(time.Minute)
+
+	base, err := c.Get("us-east-1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := c.Get("us-east-1", "arn:aws:iam::111111111111:role/capa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := c.Get("us-east-1", "arn:aws:iam::222222222222:role/capa")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Config.Credentials == base.Config.Credentials || a.Config.Credentials == b.Config.Credentials {
+		t.Fatal("expected each role to use its own credentials")
+	}
+}
+
+func TestSessionBuildHandlers(t *testing.T) {
+	defer func(handlers []request.NamedHandler) { sessionBuildHandlers = handlers }(sessionBuildHandlers)
+	AddSessionBuildHandler(request.NamedHandler{Name: "test", Fn: func(*request.Request) {}})
+
+	c := NewSessionCache(time.Minute)
+	for _, roleARN := range []string{"", "arn:aws:iam::111111111111:role/capa"} {
+		sess, err := c.Get("us-east-1", roleARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sess.Handlers.Build.Swap("test", request.NamedHandler{Name: "test"}) {
+			t.Fatalf("expected the build handler on the session of role %q", roleARN)
+		}
+	}
+}
+
+func TestSessionCacheSweepsOnRead(t *testing.T) {
+	const (
+		roleA = "arn:aws:iam::111111111111:role/capa"
+		roleB = "arn:aws:iam::222222222222:role/capa"
+	)
+
+	now := time.Now()
+	created := 0
+	c := NewSessionCache(time.Minute)
+	c.now = func() time.Time { return now }
+	c.newSession = func(key sessionKey) (*session.Session, error) {
+		created++
+		return session.NewSession(aws.NewConfig().WithRegion(key.Region))
+	}
+
+	for _, step := range []struct {
+		after   time.Duration
+		roleARN string
+	}{{0, roleA}, {30 * time.Second, roleB}, {40 * time.Second, roleB}} {
+		now = now.Add(step.after)
+		if _, err := c.Get("us-east-1", step.roleARN); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if created != 2 {
+		t.Fatalf("expected the session of %s to be read from the cache, got %d creations", roleB, created)
+	}
+	if _, ok := c.sessions[sessionKey{AccountID: "111111111111", Region: "us-east-1", RoleARN: roleA}]; ok {
+		t.Fatal("expected the expired session for account 111111111111 to be swept on read")
+	}
+}
+
+func TestRoleAllowList(t *testing.T) {
+	const role = "arn:aws:iam::111111111111:role/capa"
+
+	testCases := []struct {
+		name      string
+		allowList RoleAllowList
+		namespace string
+		expectErr bool
+	}{
+		{
+			name:      "no roles are allowed by default",
+			namespace: "default",
+			expectErr: true,
+		},
+		{
+			name:      "allowed role in an allowed namespace",
+			allowList: RoleAllowList{Namespaces: []string{"default"}, RoleARNs: []string{role}},
+			namespace: "default",
+		},
+		{
+			name:      "allowed role in another namespace",
+			allowList: RoleAllowList{Namespaces: []string{"default"}, RoleARNs: []string{role}},
+			namespace: "tenant",
+			expectErr: true,
+		},
+		{
+			name:      "other role in an allowed namespace",
+			allowList: RoleAllowList{Namespaces: []string{"default"}, RoleARNs: []string{"arn:aws:iam::222222222222:role/capa"}},
+			namespace: "default",
+			expectErr: true,
+		},
+		{
+			name:      "any role in any namespace",
+			allowList: RoleAllowList{Namespaces: []string{"*"}, RoleARNs: []string{"*"}},
+			namespace: "tenant",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowList.Check(tc.namespace, role)
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Namespace is the namespace of the workload cluster the jobs of the benchmark are run in.
+	Namespace = metav1.NamespaceSystem
+
+	// PollInterval is the interval at which a running benchmark is checked.
+	PollInterval = 30 * time.Second
+)
+
+// Result is the result of the network benchmark of a cluster, held by its network-benchmark-result
+// annotation.
+type Result struct {
+	// ServerNode is the name of the node the iperf3 server was run on.
+	ServerNode string `json:"serverNode"`
+
+	// ClientNode is the name of the node the iperf3 client was run on.
+	ClientNode string `json:"clientNode"`
+
+	// BandwidthGbps is the bandwidth in Gbit/s measured by the server.
+	BandwidthGbps float64 `json:"bandwidthGbps"`
+}
+
+// BelowTarget returns true if the measured bandwidth is lower than the given target in Gbit/s, if any.
+func (r *Result) BelowTarget(target *resource.Quantity) bool {
+	return target != nil && r.BandwidthGbps < float64(target.MilliValue())/1000
+}
+
+// ReconcileBenchmark runs the network benchmark of the cluster once, between two randomly selected ready
+// nodes, and returns its result. It returns nil while the cluster has fewer than two ready nodes or while
+// the benchmark is running. The result is kept in the network-benchmark-result annotation of the AWSCluster,
+// whose removal runs the benchmark again.
+func (s *Service) ReconcileBenchmark() (*Result, error) {
+	if value, ok := s.scope.AWSCluster.Annotations[infrav1.NetworkBenchmarkResultAnnotation]; ok {
+		result := &Result{}
+		if err := json.Unmarshal([]byte(value), result); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse annotation %s", infrav1.NetworkBenchmarkResultAnnotation)
+		}
+		return result, nil
+	}
+
+	ctx := context.TODO()
+	workloadClient, err := s.getWorkloadClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create client for workload cluster")
+	}
+
+	job := &batchv1.Job{}
+	key := client.ObjectKey{Namespace: Namespace, Name: s.clientJobName()}
+	if err := workloadClient.Get(ctx, key, job); apierrors.IsNotFound(err) {
+		return nil, s.start(ctx, workloadClient)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to get job %s", key)
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		result, err := s.result(ctx, workloadClient, job)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		if s.scope.AWSCluster.Annotations == nil {
+			s.scope.AWSCluster.Annotations = map[string]string{}
+		}
+		s.scope.AWSCluster.Annotations[infrav1.NetworkBenchmarkResultAnnotation] = string(value)
+		record.Eventf(s.scope.AWSCluster, "SuccessfulNetworkBenchmark", "Measured %.2f Gbit/s from node %s to node %s", result.BandwidthGbps, result.ClientNode, result.ServerNode)
+		return result, s.cleanup(ctx, workloadClient)
+	case isFailed(job):
+		record.Warnf(s.scope.AWSCluster, "FailedNetworkBenchmark", "Network benchmark job %s failed", key)
+		// The jobs are deleted so that the benchmark is run again, between other nodes.
+		if err := s.cleanup(ctx, workloadClient); err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("network benchmark job %s failed", key)
+	default:
+		return nil, nil
+	}
+}
+
+// start creates the server and client jobs of the benchmark on two randomly selected ready nodes, if any.
+func (s *Service) start(ctx context.Context, c client.Client) error {
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return errors.Wrap(err, "failed to list nodes")
+	}
+	ready := readyNodes(nodes.Items)
+	if len(ready) < 2 {
+		s.scope.V(2).Info("Waiting for two ready nodes to run the network benchmark", "nodes", len(ready))
+		return nil
+	}
+
+	perm := s.shuffle(len(ready))
+	server, clientNode := &ready[perm[0]], &ready[perm[1]]
+	serverIP := internalIP(server)
+	if serverIP == "" {
+		return errors.Errorf("node %s has no internal IP", server.Name)
+	}
+
+	for _, job := range []*batchv1.Job{s.serverJob(server), s.clientJob(clientNode, server, serverIP)} {
+		if err := c.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "failed to create job %s/%s", job.Namespace, job.Name)
+		}
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulStartNetworkBenchmark", "Started network benchmark from node %s to node %s", clientNode.Name, server.Name)
+	return nil
+}
+
+// result returns the result of the benchmark, from the termination message of the succeeded pod of the
+// client job.
+func (s *Service) result(ctx context.Context, c client.Client, job *batchv1.Job) (*Result, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list pods of job %s/%s", job.Namespace, job.Name)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Terminated == nil {
+				continue
+			}
+			bandwidth, err := parseBandwidth(status.State.Terminated.Message)
+			if err != nil {
+				return nil, err
+			}
+			return &Result{
+				ServerNode:    job.Annotations[serverNodeAnnotation],
+				ClientNode:    pod.Spec.NodeName,
+				BandwidthGbps: bandwidth,
+			}, nil
+		}
+	}
+	return nil, errors.Errorf("no succeeded pod of job %s/%s", job.Namespace, job.Name)
+}
+
+// cleanup deletes the jobs of the benchmark, along with their pods.
+func (s *Service) cleanup(ctx context.Context, c client.Client) error {
+	for _, name := range []string{s.clientJobName(), s.serverJobName()} {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: name}}
+		if err := c.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete job %s/%s", Namespace, name)
+		}
+	}
+	return nil
+}
+
+func (s *Service) getWorkloadClient() (client.Client, error) {
+	if s.workloadClient != nil {
+		return s.workloadClient, nil
+	}
+	return s.scope.WorkloadClient(context.TODO())
+}
+
+// readyNodes returns the ready nodes that pods can be scheduled on.
+func readyNodes(nodes []corev1.Node) []corev1.Node {
+	var ready []corev1.Node
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				ready = append(ready, node)
+				break
+			}
+		}
+	}
+	return ready
+}
+
+// internalIP returns the internal IP address of the node, if any.
+func internalIP(node *corev1.Node) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			return address.Address
+		}
+	}
+	return ""
+}
+
+// isFailed returns true if the job failed, having reached its backoff limit or deadline.
+func isFailed(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
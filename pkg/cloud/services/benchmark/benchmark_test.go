@@ -0,0 +1,284 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const iperf3Output = `[  5]   0.00-10.00  sec  10.9 GBytes  9.39 Gbits/sec    0             sender
+[  5]   0.00-10.04  sec  10.9 GBytes  9.35 Gbits/sec                  receiver
+`
+
+func newBenchmarkTestService(t *testing.T, objs ...runtime.Object) (*Service, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkBenchmark: &infrav1.BenchmarkSpec{Enabled: true},
+		},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	workloadClient := fake.NewFakeClientWithScheme(clientgoscheme.Scheme, objs...)
+	s := NewService(clusterScope)
+	s.workloadClient = workloadClient
+	// The nodes are selected in their listing order.
+	s.shuffle = func(n int) []int {
+		perm := make([]int, n)
+		for i := range perm {
+			perm[i] = i
+		}
+		return perm
+	}
+	return s, workloadClient
+}
+
+func testNode(name, ip string, ready bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}},
+		},
+	}
+}
+
+func TestServerJob(t *testing.T) {
+	s, _ := newBenchmarkTestService(t)
+
+	job := s.serverJob(testNode("node-a", "10.0.1.10", true))
+	if job.Name != "test-cluster-network-benchmark-server" || job.Namespace != Namespace {
+		t.Fatalf("unexpected job %s/%s", job.Namespace, job.Name)
+	}
+	spec := job.Spec.Template.Spec
+	if spec.NodeName != "node-a" || !spec.HostNetwork || spec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Fatalf("expected the server to run once on the network of node-a, got node %q, host network %v, restart policy %s", spec.NodeName, spec.HostNetwork, spec.RestartPolicy)
+	}
+	if command := strings.Join(spec.Containers[0].Command, " "); command != "iperf3 --server --one-off --port 5201" {
+		t.Fatalf("unexpected server command %q", command)
+	}
+}
+
+func TestClientJob(t *testing.T) {
+	s, _ := newBenchmarkTestService(t)
+
+	job := s.clientJob(testNode("node-b", "10.0.2.10", true), testNode("node-a", "10.0.1.10", true), "10.0.1.10")
+	if job.Name != "test-cluster-network-benchmark-client" || job.Namespace != Namespace {
+		t.Fatalf("unexpected job %s/%s", job.Namespace, job.Name)
+	}
+	if job.Annotations[serverNodeAnnotation] != "node-a" {
+		t.Fatalf("expected the client job to be annotated with the server node, got %v", job.Annotations)
+	}
+	spec := job.Spec.Template.Spec
+	if spec.NodeName != "node-b" || !spec.HostNetwork {
+		t.Fatalf("expected the client to run on the network of node-b, got node %q, host network %v", spec.NodeName, spec.HostNetwork)
+	}
+	container := spec.Containers[0]
+	script := container.Command[len(container.Command)-1]
+	if !strings.Contains(script, "iperf3 --client 10.0.1.10 --port 5201 --time 10 --format g") {
+		t.Fatalf("expected the client to connect to the server in Gbit/s, got %q", script)
+	}
+	if !strings.HasSuffix(script, "> "+container.TerminationMessagePath) {
+		t.Fatalf("expected the client to write its summary to %s, got %q", container.TerminationMessagePath, script)
+	}
+}
+
+func TestParseBandwidth(t *testing.T) {
+	testCases := []struct {
+		name    string
+		output  string
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:   "returns the bandwidth of the receiver",
+			output: iperf3Output,
+			want:   9.35,
+		},
+		{
+			name:    "fails without a receiver summary",
+			output:  "iperf3: error - unable to connect to server: Connection refused\n",
+			wantErr: true,
+		},
+		{
+			name:    "fails with a bandwidth in other units",
+			output:  "[  5]   0.00-10.04  sec   112 MBytes  94.0 Mbits/sec                  receiver\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseBandwidth(tc.output)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected bandwidth %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReconcileBenchmarkWaitsForNodes(t *testing.T) {
+	s, c := newBenchmarkTestService(t, testNode("node-a", "10.0.1.10", true), testNode("node-b", "10.0.2.10", false))
+
+	result, err := s.ReconcileBenchmark()
+	if err != nil || result != nil {
+		t.Fatalf("expected the benchmark to wait for two ready nodes, got result %v, error %v", result, err)
+	}
+	jobs := &batchv1.JobList{}
+	if err := c.List(context.TODO(), jobs); err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Items) != 0 {
+		t.Fatalf("expected no jobs, got %d", len(jobs.Items))
+	}
+}
+
+func TestReconcileBenchmark(t *testing.T) {
+	s, c := newBenchmarkTestService(t, testNode("node-a", "10.0.1.10", true), testNode("node-b", "10.0.2.10", true))
+	ctx := context.TODO()
+
+	result, err := s.ReconcileBenchmark()
+	if err != nil || result != nil {
+		t.Fatalf("expected the benchmark to be started, got result %v, error %v", result, err)
+	}
+	clientJob := &batchv1.Job{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: s.clientJobName()}, clientJob); err != nil {
+		t.Fatalf("expected the client job to be created: %v", err)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: s.serverJobName()}, &batchv1.Job{}); err != nil {
+		t.Fatalf("expected the server job to be created: %v", err)
+	}
+
+	// The benchmark is running.
+	result, err = s.ReconcileBenchmark()
+	if err != nil || result != nil {
+		t.Fatalf("expected the benchmark to be running, got result %v, error %v", result, err)
+	}
+
+	clientJob.Status.Succeeded = 1
+	if err := c.Update(ctx, clientJob); err != nil {
+		t.Fatal(err)
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: Namespace, Labels: map[string]string{"job-name": clientJob.Name}},
+		Spec:       corev1.PodSpec{NodeName: "node-b"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: iperf3Output}},
+			}},
+		},
+	}
+	if err := c.Create(ctx, pod); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = s.ReconcileBenchmark()
+	if err != nil {
+		t.Fatalf("failed to reconcile benchmark: %v", err)
+	}
+	want := Result{ServerNode: "node-a", ClientNode: "node-b", BandwidthGbps: 9.35}
+	if result == nil || *result != want {
+		t.Fatalf("expected result %+v, got %+v", want, result)
+	}
+	annotated := &Result{}
+	if err := json.Unmarshal([]byte(s.scope.AWSCluster.Annotations[infrav1.NetworkBenchmarkResultAnnotation]), annotated); err != nil || *annotated != want {
+		t.Fatalf("expected the result to be annotated, got %v", s.scope.AWSCluster.Annotations)
+	}
+	jobs := &batchv1.JobList{}
+	if err := c.List(ctx, jobs); err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Items) != 0 {
+		t.Fatalf("expected the jobs to be deleted, got %d", len(jobs.Items))
+	}
+}
+
+func TestReconcileBenchmarkFailed(t *testing.T) {
+	s, c := newBenchmarkTestService(t, testNode("node-a", "10.0.1.10", true), testNode("node-b", "10.0.2.10", true))
+	ctx := context.TODO()
+
+	if _, err := s.ReconcileBenchmark(); err != nil {
+		t.Fatalf("failed to start benchmark: %v", err)
+	}
+	clientJob := &batchv1.Job{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: s.clientJobName()}, clientJob); err != nil {
+		t.Fatal(err)
+	}
+	clientJob.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+	if err := c.Update(ctx, clientJob); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.ReconcileBenchmark(); err == nil {
+		t.Fatal("expected the failed job to be reported")
+	}
+	jobs := &batchv1.JobList{}
+	if err := c.List(ctx, jobs); err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Items) != 0 {
+		t.Fatalf("expected the jobs to be deleted to run the benchmark again, got %d", len(jobs.Items))
+	}
+}
+
+func TestResultBelowTarget(t *testing.T) {
+	result := &Result{BandwidthGbps: 9.35}
+	if result.BelowTarget(nil) {
+		t.Fatal("expected no degradation without a target")
+	}
+	if target := resource.MustParse("9.5"); !result.BelowTarget(&target) {
+		t.Fatalf("expected %v Gbit/s to be below %s", result.BandwidthGbps, target.String())
+	}
+	if target := resource.MustParse("5"); result.BelowTarget(&target) {
+		t.Fatalf("expected %v Gbit/s not to be below %s", result.BandwidthGbps, target.String())
+	}
+}
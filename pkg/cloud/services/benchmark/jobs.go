@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmark
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	// iperf3Image is the image the server and the client of the benchmark are run from.
+	iperf3Image = "networkstatic/iperf3:latest"
+
+	// iperf3Port is the port the server of the benchmark listens on, on the network of its node.
+	iperf3Port = 5201
+
+	// durationSeconds is the duration of the transfer measured by the benchmark.
+	durationSeconds = 10
+
+	// deadlineSeconds bounds the time the jobs of the benchmark may run for, including the retries of the
+	// client while the server is starting.
+	deadlineSeconds = 600
+
+	// serverNodeAnnotation holds the name of the node of the server on the client job.
+	serverNodeAnnotation = "cluster-api-provider-aws.sigs.k8s.io/network-benchmark-server-node"
+)
+
+func (s *Service) serverJobName() string {
+	return fmt.Sprintf("%s-network-benchmark-server", s.scope.Name())
+}
+
+func (s *Service) clientJobName() string {
+	return fmt.Sprintf("%s-network-benchmark-client", s.scope.Name())
+}
+
+// serverJob returns the job running the iperf3 server of the benchmark on the given node. The server exits
+// after serving a single client.
+func (s *Service) serverJob(node *corev1.Node) *batchv1.Job {
+	return job(s.serverJobName(), node.Name, []string{"iperf3", "--server", "--one-off", "--port", strconv.Itoa(iperf3Port)})
+}
+
+// clientJob returns the job running the iperf3 client of the benchmark on the given node, against the server
+// on the given server node. The client reports the sender and receiver summaries of the transfer, in Gbit/s,
+// as the termination message of its container. It fails while the server isn't listening yet, and is retried
+// by the job.
+func (s *Service) clientJob(node, server *corev1.Node, serverIP string) *batchv1.Job {
+	script := fmt.Sprintf("iperf3 --client %s --port %d --time %d --format g > /tmp/iperf3.log && grep -E 'sender|receiver' /tmp/iperf3.log > %s",
+		serverIP, iperf3Port, durationSeconds, corev1.TerminationMessagePathDefault)
+	j := job(s.clientJobName(), node.Name, []string{"/bin/sh", "-c", script})
+	j.Annotations = map[string]string{serverNodeAnnotation: server.Name}
+	return j
+}
+
+// job returns a job running the given command on the network of the given node.
+func job(name, nodeName string, command []string) *batchv1.Job {
+	labels := map[string]string{"app.kubernetes.io/name": "network-benchmark"}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: Namespace, Labels: labels},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          pointer.Int32Ptr(6),
+			ActiveDeadlineSeconds: pointer.Int64Ptr(deadlineSeconds),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					// The node is set rather than selected, so that the pod isn't kept off the node by its taints.
+					NodeName:      nodeName,
+					HostNetwork:   true,
+					RestartPolicy: corev1.RestartPolicyNever,
+					Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{{
+						Name:                     "iperf3",
+						Image:                    iperf3Image,
+						Command:                  command,
+						TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+						TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+					}},
+				},
+			},
+		},
+	}
+}
+
+// parseBandwidth returns the bandwidth in Gbit/s measured by the receiver of an iperf3 transfer, from the
+// summary lines of the output of the client, e.g.
+//
+//	[  5]   0.00-10.04  sec  10.9 GBytes  9.35 Gbits/sec                  receiver
+func parseBandwidth(output string) (float64, error) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[len(fields)-1] != "receiver" {
+			continue
+		}
+		for i := 1; i < len(fields); i++ {
+			if fields[i] == "Gbits/sec" {
+				bandwidth, err := strconv.ParseFloat(fields[i-1], 64)
+				if err != nil {
+					return 0, errors.Wrapf(err, "invalid bandwidth in iperf3 output %q", line)
+				}
+				return bandwidth, nil
+			}
+		}
+	}
+	return 0, errors.Errorf("no receiver bandwidth in iperf3 output %q", output)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmark
+
+import (
+	"math/rand"
+
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Service benchmarks the network of a cluster with iperf3 jobs run in the cluster.
+type Service struct {
+	scope *scope.ClusterScope
+
+	// workloadClient overrides the client for the workload cluster built from its kubeconfig.
+	workloadClient client.Client
+
+	// shuffle returns a random permutation of the integers in [0,n), to select the nodes of the benchmark.
+	shuffle func(n int) []int
+}
+
+// NewService returns a new service given the api clients.
+func NewService(scope *scope.ClusterScope) *Service {
+	return &Service{
+		scope:   scope,
+		shuffle: rand.Perm,
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// importableStackStatuses are the stack statuses whose outputs can be relied on.
+var importableStackStatuses = map[string]bool{
+	cloudformation.StackStatusCreateComplete:         true,
+	cloudformation.StackStatusUpdateComplete:         true,
+	cloudformation.StackStatusUpdateRollbackComplete: true,
+	cloudformation.StackStatusImportComplete:         true,
+	cloudformation.StackStatusImportRollbackComplete: true,
+}
+
+// ImportStackOutputs populates the VPC ID and subnets of the cluster's network spec from
+// the outputs of the CloudFormation stack it references. Fields already set in the spec
+// are kept, and a VPC ID that conflicts with the stack is an error.
+func (s *Service) ImportStackOutputs() error {
+	ref := s.scope.CloudFormationStackRef()
+	if ref == nil {
+		return nil
+	}
+
+	s.scope.V(2).Info("Importing network from CloudFormation stack", "stack-name", ref.StackName)
+
+	outputs, err := s.describeStackOutputs(ref.StackName)
+	if err != nil {
+		return err
+	}
+
+	source := fmt.Sprintf("CloudFormation stack %q", ref.StackName)
+	imported, err := s.ImportOutputs(source, ref.OutputKeyMappings, outputs)
+	if err != nil {
+		return err
+	}
+
+	if imported {
+		record.Eventf(s.scope.AWSCluster, "SuccessfulImportCloudFormationStack", "Imported network from CloudFormation stack %q", ref.StackName)
+	}
+	return nil
+}
+
+// ImportOutputs populates the VPC ID and subnets of the cluster's network spec from stack
+// outputs, according to mappings from output keys to network fields. The source describes
+// where the outputs come from in errors. It returns whether any field of the spec changed.
+func (s *Service) ImportOutputs(source string, mappings map[string]string, outputs map[string]string) (bool, error) {
+	imported := false
+	for key, target := range mappings {
+		value, ok := outputs[key]
+		if !ok {
+			return false, errors.Errorf("%s has no output %q", source, key)
+		}
+
+		switch target {
+		case infrav1.CFStackOutputVPCID:
+			vpc := s.scope.VPC()
+			switch vpc.ID {
+			case value:
+			case "":
+				vpc.ID = value
+				imported = true
+			default:
+				return false, errors.Errorf("VPC ID %q of %s output %q conflicts with VPC ID %q", value, source, key, vpc.ID)
+			}
+		case infrav1.CFStackOutputSubnets:
+			for _, id := range strings.Split(value, ",") {
+				id = strings.TrimSpace(id)
+				if id == "" || s.scope.Subnets().FindByID(id) != nil {
+					continue
+				}
+				s.scope.AWSCluster.Spec.NetworkSpec.Subnets = append(s.scope.AWSCluster.Spec.NetworkSpec.Subnets, &infrav1.SubnetSpec{ID: id})
+				imported = true
+			}
+		default:
+			return false, errors.Errorf("unsupported target %q for %s output %q", target, source, key)
+		}
+	}
+
+	return imported, nil
+}
+
+func (s *Service) describeStackOutputs(stackName string) (map[string]string, error) {
+	out, err := s.scope.CloudFormation.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedDescribeCloudFormationStack", "Failed to describe CloudFormation stack %q: %v", stackName, err)
+		return nil, errors.Wrapf(err, "failed to describe CloudFormation stack %q", stackName)
+	}
+	if len(out.Stacks) == 0 {
+		return nil, errors.Errorf("CloudFormation stack %q not found", stackName)
+	}
+
+	stack := out.Stacks[0]
+	if status := aws.StringValue(stack.StackStatus); !importableStackStatuses[status] {
+		return nil, errors.Errorf("CloudFormation stack %q is in status %s, outputs can not be imported", stackName, status)
+	}
+
+	outputs := make(map[string]string, len(stack.Outputs))
+	for _, o := range stack.Outputs {
+		outputs[aws.StringValue(o.OutputKey)] = aws.StringValue(o.OutputValue)
+	}
+	return outputs, nil
+}
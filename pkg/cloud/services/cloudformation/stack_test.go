@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/cloudformation/mock_cloudformationiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testStackName = "network"
+
+func newCloudFormationTestScope(t *testing.T, cfnMock *mock_cloudformationiface.MockCloudFormationAPI, network infrav1.NetworkSpec) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	network.CloudFormationStackRef = &infrav1.CFStackRef{
+		StackName: testStackName,
+		OutputKeyMappings: map[string]string{
+			"VpcId":   infrav1.CFStackOutputVPCID,
+			"Subnets": infrav1.CFStackOutputSubnets,
+		},
+	}
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:      "us-east-1",
+			NetworkSpec: network,
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			CloudFormation: cfnMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func stackWithOutputs(status string, outputs map[string]string) *cloudformation.DescribeStacksOutput {
+	stack := &cloudformation.Stack{
+		StackName:   aws.String(testStackName),
+		StackStatus: aws.String(status),
+	}
+	for k, v := range outputs {
+		stack.Outputs = append(stack.Outputs, &cloudformation.Output{OutputKey: aws.String(k), OutputValue: aws.String(v)})
+	}
+	return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{stack}}
+}
+
+func TestImportStackOutputs(t *testing.T) {
+	testCases := []struct {
+		name            string
+		network         infrav1.NetworkSpec
+		output          *cloudformation.DescribeStacksOutput
+		describeErr     error
+		expectErr       bool
+		expectVPCID     string
+		expectSubnetIDs []string
+	}{
+		{
+			name: "imports vpc and subnets",
+			output: stackWithOutputs(cloudformation.StackStatusCreateComplete, map[string]string{
+				"VpcId":   "vpc-1",
+				"Subnets": "subnet-1, subnet-2",
+				"Other":   "ignored",
+			}),
+			expectVPCID:     "vpc-1",
+			expectSubnetIDs: []string{"subnet-1", "subnet-2"},
+		},
+		{
+			name: "keeps subnets already in the spec",
+			network: infrav1.NetworkSpec{
+				VPC:     infrav1.VPCSpec{ID: "vpc-1"},
+				Subnets: infrav1.Subnets{{ID: "subnet-1", AvailabilityZone: "us-east-1a"}},
+			},
+			output: stackWithOutputs(cloudformation.StackStatusUpdateComplete, map[string]string{
+				"VpcId":   "vpc-1",
+				"Subnets": "subnet-1,subnet-2",
+			}),
+			expectVPCID:     "vpc-1",
+			expectSubnetIDs: []string{"subnet-1", "subnet-2"},
+		},
+		{
+			name:    "conflicting vpc id",
+			network: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-2"}},
+			output: stackWithOutputs(cloudformation.StackStatusCreateComplete, map[string]string{
+				"VpcId":   "vpc-1",
+				"Subnets": "subnet-1",
+			}),
+			expectErr: true,
+		},
+		{
+			name: "missing output",
+			output: stackWithOutputs(cloudformation.StackStatusCreateComplete, map[string]string{
+				"VpcId": "vpc-1",
+			}),
+			expectErr: true,
+		},
+		{
+			name: "stack still being created",
+			output: stackWithOutputs(cloudformation.StackStatusCreateInProgress, map[string]string{
+				"VpcId":   "vpc-1",
+				"Subnets": "subnet-1",
+			}),
+			expectErr: true,
+		},
+		{
+			name:        "stack not found",
+			describeErr: awserr.New("ValidationError", "Stack with id network does not exist", nil),
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			cfnMock := mock_cloudformationiface.NewMockCloudFormationAPI(mockCtrl)
+
+			cfnMock.EXPECT().DescribeStacks(gomock.Eq(&cloudformation.DescribeStacksInput{
+				StackName: aws.String(testStackName),
+			})).Return(tc.output, tc.describeErr)
+
+			s := NewService(newCloudFormationTestScope(t, cfnMock, tc.network))
+			err := s.ImportStackOutputs()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if s.scope.VPC().ID != tc.expectVPCID {
+				t.Fatalf("expected VPC ID %q, got %q", tc.expectVPCID, s.scope.VPC().ID)
+			}
+			subnets := s.scope.Subnets()
+			if len(subnets) != len(tc.expectSubnetIDs) {
+				t.Fatalf("expected %d subnets, got %d", len(tc.expectSubnetIDs), len(subnets))
+			}
+			for _, id := range tc.expectSubnetIDs {
+				if subnets.FindByID(id) == nil {
+					t.Fatalf("expected subnet %q in the network spec", id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// ComplianceCheckInterval is the minimum interval between two compliance checks of a cluster.
+	ComplianceCheckInterval = time.Hour
+
+	// maxConformancePacksPerRequest is the maximum number of conformance packs
+	// GetConformancePackComplianceSummary accepts in a single request.
+	maxConformancePacksPerRequest = 5
+)
+
+// ReconcileConformancePacks updates the compliance of the cluster's AWS Config conformance
+// packs in its status, at most once per ComplianceCheckInterval, and marks the cluster as not
+// compliant when any of them is NON_COMPLIANT.
+func (s *Service) ReconcileConformancePacks() error {
+	packs := s.scope.ConformancePacks()
+	status := &s.scope.AWSCluster.Status
+	if len(packs) == 0 {
+		status.ConfigConformanceStatus = nil
+		conditions.Delete(s.scope.AWSCluster, infrav1.ConformancePacksCompliantCondition)
+		return nil
+	}
+	if !s.complianceCheckDue(packs) {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling conformance pack compliance", "conformance-packs", packs)
+
+	compliance, err := s.getComplianceSummaries(packs)
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	statuses := make([]infrav1.ConformancePackStatus, 0, len(packs))
+	var nonCompliant []string
+	for _, name := range packs {
+		complianceType, ok := compliance[name]
+		if !ok {
+			return errors.Errorf("no compliance summary returned for conformance pack %q", name)
+		}
+		if complianceType == configservice.ConformancePackComplianceTypeNonCompliant {
+			nonCompliant = append(nonCompliant, name)
+		}
+		statuses = append(statuses, infrav1.ConformancePackStatus{
+			PackName:       name,
+			ComplianceType: complianceType,
+			LastUpdate:     now,
+		})
+	}
+	status.ConfigConformanceStatus = statuses
+
+	if len(nonCompliant) > 0 {
+		record.Warnf(s.scope.AWSCluster, "ConformancePackNonCompliant", "Conformance packs %s are not compliant", strings.Join(nonCompliant, ", "))
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.ConformancePacksCompliantCondition, infrav1.ConformancePackNonCompliantReason, clusterv1.ConditionSeverityWarning,
+			"Conformance packs %s are not compliant", strings.Join(nonCompliant, ", "))
+		return nil
+	}
+	conditions.MarkTrue(s.scope.AWSCluster, infrav1.ConformancePacksCompliantCondition)
+	return nil
+}
+
+// complianceCheckDue returns true when a conformance pack was never checked, or was checked
+// more than ComplianceCheckInterval ago.
+func (s *Service) complianceCheckDue(packs []string) bool {
+	checked := map[string]metav1.Time{}
+	for _, st := range s.scope.AWSCluster.Status.ConfigConformanceStatus {
+		checked[st.PackName] = st.LastUpdate
+	}
+	for _, name := range packs {
+		last, ok := checked[name]
+		if !ok || time.Since(last.Time) >= ComplianceCheckInterval {
+			return true
+		}
+	}
+	return len(checked) != len(packs)
+}
+
+// getComplianceSummaries returns the compliance type of each of the given conformance packs.
+func (s *Service) getComplianceSummaries(packs []string) (map[string]string, error) {
+	compliance := make(map[string]string, len(packs))
+	for start := 0; start < len(packs); start += maxConformancePacksPerRequest {
+		end := start + maxConformancePacksPerRequest
+		if end > len(packs) {
+			end = len(packs)
+		}
+
+		err := s.scope.ConfigService.GetConformancePackComplianceSummaryPages(&configservice.GetConformancePackComplianceSummaryInput{
+			ConformancePackNames: aws.StringSlice(packs[start:end]),
+		}, func(out *configservice.GetConformancePackComplianceSummaryOutput, _ bool) bool {
+			for _, summary := range out.ConformancePackComplianceSummaryList {
+				compliance[aws.StringValue(summary.ConformancePackName)] = aws.StringValue(summary.ConformancePackComplianceStatus)
+			}
+			return true
+		})
+		if err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedGetConformancePackCompliance", "Failed to get compliance of conformance packs %s: %v", strings.Join(packs[start:end], ", "), err)
+			return nil, errors.Wrapf(err, "failed to get compliance of conformance packs %s", strings.Join(packs[start:end], ", "))
+		}
+	}
+
+	return compliance, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/configservice/mock_configserviceiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newConformanceTestScope(t *testing.T, configMock *mock_configserviceiface.MockConfigServiceAPI, packs []string, statuses []infrav1.ConformancePackStatus) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:       "us-east-1",
+			SecuritySpec: infrav1.SecuritySpec{ConformancePacks: packs},
+		},
+		Status: infrav1.AWSClusterStatus{ConfigConformanceStatus: statuses},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			ConfigService: configMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func expectComplianceSummary(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder, compliance map[string]string, names ...string) {
+	m.GetConformancePackComplianceSummaryPages(gomock.Eq(&configservice.GetConformancePackComplianceSummaryInput{
+		ConformancePackNames: aws.StringSlice(names),
+	}), gomock.Any()).DoAndReturn(func(_ *configservice.GetConformancePackComplianceSummaryInput, fn func(*configservice.GetConformancePackComplianceSummaryOutput, bool) bool) error {
+		out := &configservice.GetConformancePackComplianceSummaryOutput{}
+		for _, name := range names {
+			out.ConformancePackComplianceSummaryList = append(out.ConformancePackComplianceSummaryList, &configservice.ConformancePackComplianceSummary{
+				ConformancePackName:             aws.String(name),
+				ConformancePackComplianceStatus: aws.String(compliance[name]),
+			})
+		}
+		fn(out, true)
+		return nil
+	})
+}
+
+func TestReconcileConformancePacks(t *testing.T) {
+	recent := metav1.NewTime(time.Now().Add(-time.Minute))
+	stale := metav1.NewTime(time.Now().Add(-2 * ComplianceCheckInterval))
+
+	testCases := []struct {
+		name            string
+		packs           []string
+		statuses        []infrav1.ConformancePackStatus
+		expect          func(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder)
+		expectErr       bool
+		expectStatus    map[string]string
+		expectCondition corev1.ConditionStatus
+	}{
+		{
+			name: "no conformance packs",
+			statuses: []infrav1.ConformancePackStatus{
+				{PackName: "removed", ComplianceType: configservice.ConformancePackComplianceTypeCompliant, LastUpdate: recent},
+			},
+			expectStatus: map[string]string{},
+		},
+		{
+			name:  "compliant packs",
+			packs: []string{"operational-best-practices", "cis"},
+			expect: func(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder) {
+				expectComplianceSummary(m, map[string]string{
+					"operational-best-practices": configservice.ConformancePackComplianceTypeCompliant,
+					"cis":                        configservice.ConformancePackComplianceTypeInsufficientData,
+				}, "operational-best-practices", "cis")
+			},
+			expectStatus: map[string]string{
+				"operational-best-practices": configservice.ConformancePackComplianceTypeCompliant,
+				"cis":                        configservice.ConformancePackComplianceTypeInsufficientData,
+			},
+			expectCondition: corev1.ConditionTrue,
+		},
+		{
+			name:  "non compliant pack",
+			packs: []string{"cis"},
+			expect: func(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder) {
+				expectComplianceSummary(m, map[string]string{
+					"cis": configservice.ConformancePackComplianceTypeNonCompliant,
+				}, "cis")
+			},
+			expectStatus: map[string]string{
+				"cis": configservice.ConformancePackComplianceTypeNonCompliant,
+			},
+			expectCondition: corev1.ConditionFalse,
+		},
+		{
+			name:  "packs are checked in batches",
+			packs: []string{"p1", "p2", "p3", "p4", "p5", "p6"},
+			expect: func(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder) {
+				compliance := map[string]string{}
+				for _, p := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+					compliance[p] = configservice.ConformancePackComplianceTypeCompliant
+				}
+				expectComplianceSummary(m, compliance, "p1", "p2", "p3", "p4", "p5")
+				expectComplianceSummary(m, compliance, "p6")
+			},
+			expectStatus: map[string]string{
+				"p1": configservice.ConformancePackComplianceTypeCompliant,
+				"p2": configservice.ConformancePackComplianceTypeCompliant,
+				"p3": configservice.ConformancePackComplianceTypeCompliant,
+				"p4": configservice.ConformancePackComplianceTypeCompliant,
+				"p5": configservice.ConformancePackComplianceTypeCompliant,
+				"p6": configservice.ConformancePackComplianceTypeCompliant,
+			},
+			expectCondition: corev1.ConditionTrue,
+		},
+		{
+			name:  "recently checked packs are skipped",
+			packs: []string{"cis"},
+			statuses: []infrav1.ConformancePackStatus{
+				{PackName: "cis", ComplianceType: configservice.ConformancePackComplianceTypeCompliant, LastUpdate: recent},
+			},
+			expectStatus: map[string]string{
+				"cis": configservice.ConformancePackComplianceTypeCompliant,
+			},
+		},
+		{
+			name:  "stale packs are checked again",
+			packs: []string{"cis"},
+			statuses: []infrav1.ConformancePackStatus{
+				{PackName: "cis", ComplianceType: configservice.ConformancePackComplianceTypeCompliant, LastUpdate: stale},
+			},
+			expect: func(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder) {
+				expectComplianceSummary(m, map[string]string{
+					"cis": configservice.ConformancePackComplianceTypeNonCompliant,
+				}, "cis")
+			},
+			expectStatus: map[string]string{
+				"cis": configservice.ConformancePackComplianceTypeNonCompliant,
+			},
+			expectCondition: corev1.ConditionFalse,
+		},
+		{
+			name:  "conformance pack does not exist",
+			packs: []string{"missing"},
+			expect: func(m *mock_configserviceiface.MockConfigServiceAPIMockRecorder) {
+				m.GetConformancePackComplianceSummaryPages(gomock.Any(), gomock.Any()).
+					Return(awserr.New(configservice.ErrCodeNoSuchConformancePackException, "not found", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			configMock := mock_configserviceiface.NewMockConfigServiceAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(configMock.EXPECT())
+			}
+
+			s := NewService(newConformanceTestScope(t, configMock, tc.packs, tc.statuses))
+			err := s.ReconcileConformancePacks()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			statuses := s.scope.AWSCluster.Status.ConfigConformanceStatus
+			if len(statuses) != len(tc.expectStatus) {
+				t.Fatalf("expected %d conformance pack statuses, got %d", len(tc.expectStatus), len(statuses))
+			}
+			for _, st := range statuses {
+				if st.ComplianceType != tc.expectStatus[st.PackName] {
+					t.Errorf("expected conformance pack %q to be %q, got %q", st.PackName, tc.expectStatus[st.PackName], st.ComplianceType)
+				}
+			}
+
+			if tc.expectCondition == "" {
+				return
+			}
+			condition := conditions.Get(s.scope.AWSCluster, infrav1.ConformancePacksCompliantCondition)
+			if condition == nil || condition.Status != tc.expectCondition {
+				t.Fatalf("expected condition %s to be %s, got %v", infrav1.ConformancePacksCompliantCondition, tc.expectCondition, condition)
+			}
+		})
+	}
+}
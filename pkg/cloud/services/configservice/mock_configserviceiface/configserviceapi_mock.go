@@ -0,0 +1,5972 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/aws-sdk-go/service/configservice/configserviceiface (interfaces: ConfigServiceAPI)
+
+// Package mock_configserviceiface is a generated GoMock package.
+package mock_configserviceiface
+
+import (
+	context "context"
+	request "github.com/aws/aws-sdk-go/aws/request"
+	configservice "github.com/aws/aws-sdk-go/service/configservice"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockConfigServiceAPI is a mock of ConfigServiceAPI interface
+type MockConfigServiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigServiceAPIMockRecorder
+}
+
+// MockConfigServiceAPIMockRecorder is the mock recorder for MockConfigServiceAPI
+type MockConfigServiceAPIMockRecorder struct {
+	mock *MockConfigServiceAPI
+}
+
+// NewMockConfigServiceAPI creates a new mock instance
+func NewMockConfigServiceAPI(ctrl *gomock.Controller) *MockConfigServiceAPI {
+	mock := &MockConfigServiceAPI{ctrl: ctrl}
+	mock.recorder = &MockConfigServiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConfigServiceAPI) EXPECT() *MockConfigServiceAPIMockRecorder {
+	return m.recorder
+}
+
+// BatchGetAggregateResourceConfig mocks base method
+func (m *MockConfigServiceAPI) BatchGetAggregateResourceConfig(arg0 *configservice.BatchGetAggregateResourceConfigInput) (*configservice.BatchGetAggregateResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetAggregateResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.BatchGetAggregateResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetAggregateResourceConfig indicates an expected call of BatchGetAggregateResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) BatchGetAggregateResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetAggregateResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).BatchGetAggregateResourceConfig), arg0)
+}
+
+// BatchGetAggregateResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) BatchGetAggregateResourceConfigRequest(arg0 *configservice.BatchGetAggregateResourceConfigInput) (*request.Request, *configservice.BatchGetAggregateResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetAggregateResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.BatchGetAggregateResourceConfigOutput)
+	return ret0, ret1
+}
+
+// BatchGetAggregateResourceConfigRequest indicates an expected call of BatchGetAggregateResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) BatchGetAggregateResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetAggregateResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).BatchGetAggregateResourceConfigRequest), arg0)
+}
+
+// BatchGetAggregateResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) BatchGetAggregateResourceConfigWithContext(arg0 context.Context, arg1 *configservice.BatchGetAggregateResourceConfigInput, arg2 ...request.Option) (*configservice.BatchGetAggregateResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetAggregateResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.BatchGetAggregateResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetAggregateResourceConfigWithContext indicates an expected call of BatchGetAggregateResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) BatchGetAggregateResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetAggregateResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).BatchGetAggregateResourceConfigWithContext), varargs...)
+}
+
+// BatchGetResourceConfig mocks base method
+func (m *MockConfigServiceAPI) BatchGetResourceConfig(arg0 *configservice.BatchGetResourceConfigInput) (*configservice.BatchGetResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.BatchGetResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetResourceConfig indicates an expected call of BatchGetResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) BatchGetResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).BatchGetResourceConfig), arg0)
+}
+
+// BatchGetResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) BatchGetResourceConfigRequest(arg0 *configservice.BatchGetResourceConfigInput) (*request.Request, *configservice.BatchGetResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.BatchGetResourceConfigOutput)
+	return ret0, ret1
+}
+
+// BatchGetResourceConfigRequest indicates an expected call of BatchGetResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) BatchGetResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).BatchGetResourceConfigRequest), arg0)
+}
+
+// BatchGetResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) BatchGetResourceConfigWithContext(arg0 context.Context, arg1 *configservice.BatchGetResourceConfigInput, arg2 ...request.Option) (*configservice.BatchGetResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.BatchGetResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetResourceConfigWithContext indicates an expected call of BatchGetResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) BatchGetResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).BatchGetResourceConfigWithContext), varargs...)
+}
+
+// DeleteAggregationAuthorization mocks base method
+func (m *MockConfigServiceAPI) DeleteAggregationAuthorization(arg0 *configservice.DeleteAggregationAuthorizationInput) (*configservice.DeleteAggregationAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAggregationAuthorization", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteAggregationAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAggregationAuthorization indicates an expected call of DeleteAggregationAuthorization
+func (mr *MockConfigServiceAPIMockRecorder) DeleteAggregationAuthorization(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAggregationAuthorization", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteAggregationAuthorization), arg0)
+}
+
+// DeleteAggregationAuthorizationRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteAggregationAuthorizationRequest(arg0 *configservice.DeleteAggregationAuthorizationInput) (*request.Request, *configservice.DeleteAggregationAuthorizationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAggregationAuthorizationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteAggregationAuthorizationOutput)
+	return ret0, ret1
+}
+
+// DeleteAggregationAuthorizationRequest indicates an expected call of DeleteAggregationAuthorizationRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteAggregationAuthorizationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAggregationAuthorizationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteAggregationAuthorizationRequest), arg0)
+}
+
+// DeleteAggregationAuthorizationWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteAggregationAuthorizationWithContext(arg0 context.Context, arg1 *configservice.DeleteAggregationAuthorizationInput, arg2 ...request.Option) (*configservice.DeleteAggregationAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAggregationAuthorizationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteAggregationAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAggregationAuthorizationWithContext indicates an expected call of DeleteAggregationAuthorizationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteAggregationAuthorizationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAggregationAuthorizationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteAggregationAuthorizationWithContext), varargs...)
+}
+
+// DeleteConfigRule mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigRule(arg0 *configservice.DeleteConfigRuleInput) (*configservice.DeleteConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigRule indicates an expected call of DeleteConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigRule), arg0)
+}
+
+// DeleteConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigRuleRequest(arg0 *configservice.DeleteConfigRuleInput) (*request.Request, *configservice.DeleteConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteConfigRuleOutput)
+	return ret0, ret1
+}
+
+// DeleteConfigRuleRequest indicates an expected call of DeleteConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigRuleRequest), arg0)
+}
+
+// DeleteConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigRuleWithContext(arg0 context.Context, arg1 *configservice.DeleteConfigRuleInput, arg2 ...request.Option) (*configservice.DeleteConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigRuleWithContext indicates an expected call of DeleteConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigRuleWithContext), varargs...)
+}
+
+// DeleteConfigurationAggregator mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigurationAggregator(arg0 *configservice.DeleteConfigurationAggregatorInput) (*configservice.DeleteConfigurationAggregatorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigurationAggregator", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteConfigurationAggregatorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigurationAggregator indicates an expected call of DeleteConfigurationAggregator
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigurationAggregator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationAggregator", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigurationAggregator), arg0)
+}
+
+// DeleteConfigurationAggregatorRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigurationAggregatorRequest(arg0 *configservice.DeleteConfigurationAggregatorInput) (*request.Request, *configservice.DeleteConfigurationAggregatorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigurationAggregatorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteConfigurationAggregatorOutput)
+	return ret0, ret1
+}
+
+// DeleteConfigurationAggregatorRequest indicates an expected call of DeleteConfigurationAggregatorRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigurationAggregatorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationAggregatorRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigurationAggregatorRequest), arg0)
+}
+
+// DeleteConfigurationAggregatorWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigurationAggregatorWithContext(arg0 context.Context, arg1 *configservice.DeleteConfigurationAggregatorInput, arg2 ...request.Option) (*configservice.DeleteConfigurationAggregatorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConfigurationAggregatorWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteConfigurationAggregatorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigurationAggregatorWithContext indicates an expected call of DeleteConfigurationAggregatorWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigurationAggregatorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationAggregatorWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigurationAggregatorWithContext), varargs...)
+}
+
+// DeleteConfigurationRecorder mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigurationRecorder(arg0 *configservice.DeleteConfigurationRecorderInput) (*configservice.DeleteConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigurationRecorder", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigurationRecorder indicates an expected call of DeleteConfigurationRecorder
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigurationRecorder(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationRecorder", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigurationRecorder), arg0)
+}
+
+// DeleteConfigurationRecorderRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigurationRecorderRequest(arg0 *configservice.DeleteConfigurationRecorderInput) (*request.Request, *configservice.DeleteConfigurationRecorderOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigurationRecorderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteConfigurationRecorderOutput)
+	return ret0, ret1
+}
+
+// DeleteConfigurationRecorderRequest indicates an expected call of DeleteConfigurationRecorderRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigurationRecorderRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationRecorderRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigurationRecorderRequest), arg0)
+}
+
+// DeleteConfigurationRecorderWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteConfigurationRecorderWithContext(arg0 context.Context, arg1 *configservice.DeleteConfigurationRecorderInput, arg2 ...request.Option) (*configservice.DeleteConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConfigurationRecorderWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigurationRecorderWithContext indicates an expected call of DeleteConfigurationRecorderWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConfigurationRecorderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationRecorderWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConfigurationRecorderWithContext), varargs...)
+}
+
+// DeleteConformancePack mocks base method
+func (m *MockConfigServiceAPI) DeleteConformancePack(arg0 *configservice.DeleteConformancePackInput) (*configservice.DeleteConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConformancePack", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConformancePack indicates an expected call of DeleteConformancePack
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConformancePack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConformancePack", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConformancePack), arg0)
+}
+
+// DeleteConformancePackRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteConformancePackRequest(arg0 *configservice.DeleteConformancePackInput) (*request.Request, *configservice.DeleteConformancePackOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConformancePackRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteConformancePackOutput)
+	return ret0, ret1
+}
+
+// DeleteConformancePackRequest indicates an expected call of DeleteConformancePackRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConformancePackRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConformancePackRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConformancePackRequest), arg0)
+}
+
+// DeleteConformancePackWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteConformancePackWithContext(arg0 context.Context, arg1 *configservice.DeleteConformancePackInput, arg2 ...request.Option) (*configservice.DeleteConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConformancePackWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConformancePackWithContext indicates an expected call of DeleteConformancePackWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteConformancePackWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConformancePackWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteConformancePackWithContext), varargs...)
+}
+
+// DeleteDeliveryChannel mocks base method
+func (m *MockConfigServiceAPI) DeleteDeliveryChannel(arg0 *configservice.DeleteDeliveryChannelInput) (*configservice.DeleteDeliveryChannelOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeliveryChannel", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteDeliveryChannelOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeliveryChannel indicates an expected call of DeleteDeliveryChannel
+func (mr *MockConfigServiceAPIMockRecorder) DeleteDeliveryChannel(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryChannel", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteDeliveryChannel), arg0)
+}
+
+// DeleteDeliveryChannelRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteDeliveryChannelRequest(arg0 *configservice.DeleteDeliveryChannelInput) (*request.Request, *configservice.DeleteDeliveryChannelOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeliveryChannelRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteDeliveryChannelOutput)
+	return ret0, ret1
+}
+
+// DeleteDeliveryChannelRequest indicates an expected call of DeleteDeliveryChannelRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteDeliveryChannelRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryChannelRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteDeliveryChannelRequest), arg0)
+}
+
+// DeleteDeliveryChannelWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteDeliveryChannelWithContext(arg0 context.Context, arg1 *configservice.DeleteDeliveryChannelInput, arg2 ...request.Option) (*configservice.DeleteDeliveryChannelOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDeliveryChannelWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteDeliveryChannelOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeliveryChannelWithContext indicates an expected call of DeleteDeliveryChannelWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteDeliveryChannelWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryChannelWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteDeliveryChannelWithContext), varargs...)
+}
+
+// DeleteEvaluationResults mocks base method
+func (m *MockConfigServiceAPI) DeleteEvaluationResults(arg0 *configservice.DeleteEvaluationResultsInput) (*configservice.DeleteEvaluationResultsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvaluationResults", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteEvaluationResultsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvaluationResults indicates an expected call of DeleteEvaluationResults
+func (mr *MockConfigServiceAPIMockRecorder) DeleteEvaluationResults(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationResults", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteEvaluationResults), arg0)
+}
+
+// DeleteEvaluationResultsRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteEvaluationResultsRequest(arg0 *configservice.DeleteEvaluationResultsInput) (*request.Request, *configservice.DeleteEvaluationResultsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvaluationResultsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteEvaluationResultsOutput)
+	return ret0, ret1
+}
+
+// DeleteEvaluationResultsRequest indicates an expected call of DeleteEvaluationResultsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteEvaluationResultsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationResultsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteEvaluationResultsRequest), arg0)
+}
+
+// DeleteEvaluationResultsWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteEvaluationResultsWithContext(arg0 context.Context, arg1 *configservice.DeleteEvaluationResultsInput, arg2 ...request.Option) (*configservice.DeleteEvaluationResultsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEvaluationResultsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteEvaluationResultsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvaluationResultsWithContext indicates an expected call of DeleteEvaluationResultsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteEvaluationResultsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvaluationResultsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteEvaluationResultsWithContext), varargs...)
+}
+
+// DeleteOrganizationConfigRule mocks base method
+func (m *MockConfigServiceAPI) DeleteOrganizationConfigRule(arg0 *configservice.DeleteOrganizationConfigRuleInput) (*configservice.DeleteOrganizationConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteOrganizationConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationConfigRule indicates an expected call of DeleteOrganizationConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) DeleteOrganizationConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteOrganizationConfigRule), arg0)
+}
+
+// DeleteOrganizationConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteOrganizationConfigRuleRequest(arg0 *configservice.DeleteOrganizationConfigRuleInput) (*request.Request, *configservice.DeleteOrganizationConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteOrganizationConfigRuleOutput)
+	return ret0, ret1
+}
+
+// DeleteOrganizationConfigRuleRequest indicates an expected call of DeleteOrganizationConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteOrganizationConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteOrganizationConfigRuleRequest), arg0)
+}
+
+// DeleteOrganizationConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteOrganizationConfigRuleWithContext(arg0 context.Context, arg1 *configservice.DeleteOrganizationConfigRuleInput, arg2 ...request.Option) (*configservice.DeleteOrganizationConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrganizationConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteOrganizationConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationConfigRuleWithContext indicates an expected call of DeleteOrganizationConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteOrganizationConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteOrganizationConfigRuleWithContext), varargs...)
+}
+
+// DeleteOrganizationConformancePack mocks base method
+func (m *MockConfigServiceAPI) DeleteOrganizationConformancePack(arg0 *configservice.DeleteOrganizationConformancePackInput) (*configservice.DeleteOrganizationConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationConformancePack", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteOrganizationConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationConformancePack indicates an expected call of DeleteOrganizationConformancePack
+func (mr *MockConfigServiceAPIMockRecorder) DeleteOrganizationConformancePack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationConformancePack", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteOrganizationConformancePack), arg0)
+}
+
+// DeleteOrganizationConformancePackRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteOrganizationConformancePackRequest(arg0 *configservice.DeleteOrganizationConformancePackInput) (*request.Request, *configservice.DeleteOrganizationConformancePackOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationConformancePackRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteOrganizationConformancePackOutput)
+	return ret0, ret1
+}
+
+// DeleteOrganizationConformancePackRequest indicates an expected call of DeleteOrganizationConformancePackRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteOrganizationConformancePackRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationConformancePackRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteOrganizationConformancePackRequest), arg0)
+}
+
+// DeleteOrganizationConformancePackWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteOrganizationConformancePackWithContext(arg0 context.Context, arg1 *configservice.DeleteOrganizationConformancePackInput, arg2 ...request.Option) (*configservice.DeleteOrganizationConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrganizationConformancePackWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteOrganizationConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationConformancePackWithContext indicates an expected call of DeleteOrganizationConformancePackWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteOrganizationConformancePackWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationConformancePackWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteOrganizationConformancePackWithContext), varargs...)
+}
+
+// DeletePendingAggregationRequest mocks base method
+func (m *MockConfigServiceAPI) DeletePendingAggregationRequest(arg0 *configservice.DeletePendingAggregationRequestInput) (*configservice.DeletePendingAggregationRequestOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingAggregationRequest", arg0)
+	ret0, _ := ret[0].(*configservice.DeletePendingAggregationRequestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePendingAggregationRequest indicates an expected call of DeletePendingAggregationRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeletePendingAggregationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingAggregationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeletePendingAggregationRequest), arg0)
+}
+
+// DeletePendingAggregationRequestRequest mocks base method
+func (m *MockConfigServiceAPI) DeletePendingAggregationRequestRequest(arg0 *configservice.DeletePendingAggregationRequestInput) (*request.Request, *configservice.DeletePendingAggregationRequestOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingAggregationRequestRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeletePendingAggregationRequestOutput)
+	return ret0, ret1
+}
+
+// DeletePendingAggregationRequestRequest indicates an expected call of DeletePendingAggregationRequestRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeletePendingAggregationRequestRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingAggregationRequestRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeletePendingAggregationRequestRequest), arg0)
+}
+
+// DeletePendingAggregationRequestWithContext mocks base method
+func (m *MockConfigServiceAPI) DeletePendingAggregationRequestWithContext(arg0 context.Context, arg1 *configservice.DeletePendingAggregationRequestInput, arg2 ...request.Option) (*configservice.DeletePendingAggregationRequestOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePendingAggregationRequestWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeletePendingAggregationRequestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePendingAggregationRequestWithContext indicates an expected call of DeletePendingAggregationRequestWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeletePendingAggregationRequestWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingAggregationRequestWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeletePendingAggregationRequestWithContext), varargs...)
+}
+
+// DeleteRemediationConfiguration mocks base method
+func (m *MockConfigServiceAPI) DeleteRemediationConfiguration(arg0 *configservice.DeleteRemediationConfigurationInput) (*configservice.DeleteRemediationConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemediationConfiguration", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteRemediationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemediationConfiguration indicates an expected call of DeleteRemediationConfiguration
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRemediationConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationConfiguration", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRemediationConfiguration), arg0)
+}
+
+// DeleteRemediationConfigurationRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteRemediationConfigurationRequest(arg0 *configservice.DeleteRemediationConfigurationInput) (*request.Request, *configservice.DeleteRemediationConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemediationConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteRemediationConfigurationOutput)
+	return ret0, ret1
+}
+
+// DeleteRemediationConfigurationRequest indicates an expected call of DeleteRemediationConfigurationRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRemediationConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationConfigurationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRemediationConfigurationRequest), arg0)
+}
+
+// DeleteRemediationConfigurationWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteRemediationConfigurationWithContext(arg0 context.Context, arg1 *configservice.DeleteRemediationConfigurationInput, arg2 ...request.Option) (*configservice.DeleteRemediationConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRemediationConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteRemediationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemediationConfigurationWithContext indicates an expected call of DeleteRemediationConfigurationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRemediationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationConfigurationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRemediationConfigurationWithContext), varargs...)
+}
+
+// DeleteRemediationExceptions mocks base method
+func (m *MockConfigServiceAPI) DeleteRemediationExceptions(arg0 *configservice.DeleteRemediationExceptionsInput) (*configservice.DeleteRemediationExceptionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemediationExceptions", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteRemediationExceptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemediationExceptions indicates an expected call of DeleteRemediationExceptions
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRemediationExceptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationExceptions", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRemediationExceptions), arg0)
+}
+
+// DeleteRemediationExceptionsRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteRemediationExceptionsRequest(arg0 *configservice.DeleteRemediationExceptionsInput) (*request.Request, *configservice.DeleteRemediationExceptionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemediationExceptionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteRemediationExceptionsOutput)
+	return ret0, ret1
+}
+
+// DeleteRemediationExceptionsRequest indicates an expected call of DeleteRemediationExceptionsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRemediationExceptionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationExceptionsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRemediationExceptionsRequest), arg0)
+}
+
+// DeleteRemediationExceptionsWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteRemediationExceptionsWithContext(arg0 context.Context, arg1 *configservice.DeleteRemediationExceptionsInput, arg2 ...request.Option) (*configservice.DeleteRemediationExceptionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRemediationExceptionsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteRemediationExceptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemediationExceptionsWithContext indicates an expected call of DeleteRemediationExceptionsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRemediationExceptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationExceptionsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRemediationExceptionsWithContext), varargs...)
+}
+
+// DeleteResourceConfig mocks base method
+func (m *MockConfigServiceAPI) DeleteResourceConfig(arg0 *configservice.DeleteResourceConfigInput) (*configservice.DeleteResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceConfig indicates an expected call of DeleteResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) DeleteResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteResourceConfig), arg0)
+}
+
+// DeleteResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteResourceConfigRequest(arg0 *configservice.DeleteResourceConfigInput) (*request.Request, *configservice.DeleteResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteResourceConfigOutput)
+	return ret0, ret1
+}
+
+// DeleteResourceConfigRequest indicates an expected call of DeleteResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteResourceConfigRequest), arg0)
+}
+
+// DeleteResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteResourceConfigWithContext(arg0 context.Context, arg1 *configservice.DeleteResourceConfigInput, arg2 ...request.Option) (*configservice.DeleteResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceConfigWithContext indicates an expected call of DeleteResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteResourceConfigWithContext), varargs...)
+}
+
+// DeleteRetentionConfiguration mocks base method
+func (m *MockConfigServiceAPI) DeleteRetentionConfiguration(arg0 *configservice.DeleteRetentionConfigurationInput) (*configservice.DeleteRetentionConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionConfiguration", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteRetentionConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionConfiguration indicates an expected call of DeleteRetentionConfiguration
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRetentionConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionConfiguration", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRetentionConfiguration), arg0)
+}
+
+// DeleteRetentionConfigurationRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteRetentionConfigurationRequest(arg0 *configservice.DeleteRetentionConfigurationInput) (*request.Request, *configservice.DeleteRetentionConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteRetentionConfigurationOutput)
+	return ret0, ret1
+}
+
+// DeleteRetentionConfigurationRequest indicates an expected call of DeleteRetentionConfigurationRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRetentionConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionConfigurationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRetentionConfigurationRequest), arg0)
+}
+
+// DeleteRetentionConfigurationWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteRetentionConfigurationWithContext(arg0 context.Context, arg1 *configservice.DeleteRetentionConfigurationInput, arg2 ...request.Option) (*configservice.DeleteRetentionConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRetentionConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteRetentionConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionConfigurationWithContext indicates an expected call of DeleteRetentionConfigurationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteRetentionConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionConfigurationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteRetentionConfigurationWithContext), varargs...)
+}
+
+// DeleteStoredQuery mocks base method
+func (m *MockConfigServiceAPI) DeleteStoredQuery(arg0 *configservice.DeleteStoredQueryInput) (*configservice.DeleteStoredQueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoredQuery", arg0)
+	ret0, _ := ret[0].(*configservice.DeleteStoredQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStoredQuery indicates an expected call of DeleteStoredQuery
+func (mr *MockConfigServiceAPIMockRecorder) DeleteStoredQuery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoredQuery", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteStoredQuery), arg0)
+}
+
+// DeleteStoredQueryRequest mocks base method
+func (m *MockConfigServiceAPI) DeleteStoredQueryRequest(arg0 *configservice.DeleteStoredQueryInput) (*request.Request, *configservice.DeleteStoredQueryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoredQueryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeleteStoredQueryOutput)
+	return ret0, ret1
+}
+
+// DeleteStoredQueryRequest indicates an expected call of DeleteStoredQueryRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeleteStoredQueryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoredQueryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteStoredQueryRequest), arg0)
+}
+
+// DeleteStoredQueryWithContext mocks base method
+func (m *MockConfigServiceAPI) DeleteStoredQueryWithContext(arg0 context.Context, arg1 *configservice.DeleteStoredQueryInput, arg2 ...request.Option) (*configservice.DeleteStoredQueryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStoredQueryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeleteStoredQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStoredQueryWithContext indicates an expected call of DeleteStoredQueryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeleteStoredQueryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoredQueryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeleteStoredQueryWithContext), varargs...)
+}
+
+// DeliverConfigSnapshot mocks base method
+func (m *MockConfigServiceAPI) DeliverConfigSnapshot(arg0 *configservice.DeliverConfigSnapshotInput) (*configservice.DeliverConfigSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverConfigSnapshot", arg0)
+	ret0, _ := ret[0].(*configservice.DeliverConfigSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverConfigSnapshot indicates an expected call of DeliverConfigSnapshot
+func (mr *MockConfigServiceAPIMockRecorder) DeliverConfigSnapshot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverConfigSnapshot", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeliverConfigSnapshot), arg0)
+}
+
+// DeliverConfigSnapshotRequest mocks base method
+func (m *MockConfigServiceAPI) DeliverConfigSnapshotRequest(arg0 *configservice.DeliverConfigSnapshotInput) (*request.Request, *configservice.DeliverConfigSnapshotOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverConfigSnapshotRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DeliverConfigSnapshotOutput)
+	return ret0, ret1
+}
+
+// DeliverConfigSnapshotRequest indicates an expected call of DeliverConfigSnapshotRequest
+func (mr *MockConfigServiceAPIMockRecorder) DeliverConfigSnapshotRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverConfigSnapshotRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeliverConfigSnapshotRequest), arg0)
+}
+
+// DeliverConfigSnapshotWithContext mocks base method
+func (m *MockConfigServiceAPI) DeliverConfigSnapshotWithContext(arg0 context.Context, arg1 *configservice.DeliverConfigSnapshotInput, arg2 ...request.Option) (*configservice.DeliverConfigSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeliverConfigSnapshotWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DeliverConfigSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverConfigSnapshotWithContext indicates an expected call of DeliverConfigSnapshotWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DeliverConfigSnapshotWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverConfigSnapshotWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DeliverConfigSnapshotWithContext), varargs...)
+}
+
+// DescribeAggregateComplianceByConfigRules mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConfigRules(arg0 *configservice.DescribeAggregateComplianceByConfigRulesInput) (*configservice.DescribeAggregateComplianceByConfigRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConfigRules", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeAggregateComplianceByConfigRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAggregateComplianceByConfigRules indicates an expected call of DescribeAggregateComplianceByConfigRules
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConfigRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConfigRules", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConfigRules), arg0)
+}
+
+// DescribeAggregateComplianceByConfigRulesPages mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConfigRulesPages(arg0 *configservice.DescribeAggregateComplianceByConfigRulesInput, arg1 func(*configservice.DescribeAggregateComplianceByConfigRulesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConfigRulesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAggregateComplianceByConfigRulesPages indicates an expected call of DescribeAggregateComplianceByConfigRulesPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConfigRulesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConfigRulesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConfigRulesPages), arg0, arg1)
+}
+
+// DescribeAggregateComplianceByConfigRulesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConfigRulesPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeAggregateComplianceByConfigRulesInput, arg2 func(*configservice.DescribeAggregateComplianceByConfigRulesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConfigRulesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAggregateComplianceByConfigRulesPagesWithContext indicates an expected call of DescribeAggregateComplianceByConfigRulesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConfigRulesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConfigRulesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConfigRulesPagesWithContext), varargs...)
+}
+
+// DescribeAggregateComplianceByConfigRulesRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConfigRulesRequest(arg0 *configservice.DescribeAggregateComplianceByConfigRulesInput) (*request.Request, *configservice.DescribeAggregateComplianceByConfigRulesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConfigRulesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeAggregateComplianceByConfigRulesOutput)
+	return ret0, ret1
+}
+
+// DescribeAggregateComplianceByConfigRulesRequest indicates an expected call of DescribeAggregateComplianceByConfigRulesRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConfigRulesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConfigRulesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConfigRulesRequest), arg0)
+}
+
+// DescribeAggregateComplianceByConfigRulesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConfigRulesWithContext(arg0 context.Context, arg1 *configservice.DescribeAggregateComplianceByConfigRulesInput, arg2 ...request.Option) (*configservice.DescribeAggregateComplianceByConfigRulesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConfigRulesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeAggregateComplianceByConfigRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAggregateComplianceByConfigRulesWithContext indicates an expected call of DescribeAggregateComplianceByConfigRulesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConfigRulesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConfigRulesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConfigRulesWithContext), varargs...)
+}
+
+// DescribeAggregateComplianceByConformancePacks mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConformancePacks(arg0 *configservice.DescribeAggregateComplianceByConformancePacksInput) (*configservice.DescribeAggregateComplianceByConformancePacksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConformancePacks", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeAggregateComplianceByConformancePacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAggregateComplianceByConformancePacks indicates an expected call of DescribeAggregateComplianceByConformancePacks
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConformancePacks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConformancePacks", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConformancePacks), arg0)
+}
+
+// DescribeAggregateComplianceByConformancePacksPages mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConformancePacksPages(arg0 *configservice.DescribeAggregateComplianceByConformancePacksInput, arg1 func(*configservice.DescribeAggregateComplianceByConformancePacksOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConformancePacksPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAggregateComplianceByConformancePacksPages indicates an expected call of DescribeAggregateComplianceByConformancePacksPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConformancePacksPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConformancePacksPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConformancePacksPages), arg0, arg1)
+}
+
+// DescribeAggregateComplianceByConformancePacksPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConformancePacksPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeAggregateComplianceByConformancePacksInput, arg2 func(*configservice.DescribeAggregateComplianceByConformancePacksOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConformancePacksPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAggregateComplianceByConformancePacksPagesWithContext indicates an expected call of DescribeAggregateComplianceByConformancePacksPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConformancePacksPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConformancePacksPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConformancePacksPagesWithContext), varargs...)
+}
+
+// DescribeAggregateComplianceByConformancePacksRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConformancePacksRequest(arg0 *configservice.DescribeAggregateComplianceByConformancePacksInput) (*request.Request, *configservice.DescribeAggregateComplianceByConformancePacksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConformancePacksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeAggregateComplianceByConformancePacksOutput)
+	return ret0, ret1
+}
+
+// DescribeAggregateComplianceByConformancePacksRequest indicates an expected call of DescribeAggregateComplianceByConformancePacksRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConformancePacksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConformancePacksRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConformancePacksRequest), arg0)
+}
+
+// DescribeAggregateComplianceByConformancePacksWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregateComplianceByConformancePacksWithContext(arg0 context.Context, arg1 *configservice.DescribeAggregateComplianceByConformancePacksInput, arg2 ...request.Option) (*configservice.DescribeAggregateComplianceByConformancePacksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAggregateComplianceByConformancePacksWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeAggregateComplianceByConformancePacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAggregateComplianceByConformancePacksWithContext indicates an expected call of DescribeAggregateComplianceByConformancePacksWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregateComplianceByConformancePacksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregateComplianceByConformancePacksWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregateComplianceByConformancePacksWithContext), varargs...)
+}
+
+// DescribeAggregationAuthorizations mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregationAuthorizations(arg0 *configservice.DescribeAggregationAuthorizationsInput) (*configservice.DescribeAggregationAuthorizationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregationAuthorizations", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeAggregationAuthorizationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAggregationAuthorizations indicates an expected call of DescribeAggregationAuthorizations
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregationAuthorizations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregationAuthorizations", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregationAuthorizations), arg0)
+}
+
+// DescribeAggregationAuthorizationsPages mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregationAuthorizationsPages(arg0 *configservice.DescribeAggregationAuthorizationsInput, arg1 func(*configservice.DescribeAggregationAuthorizationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregationAuthorizationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAggregationAuthorizationsPages indicates an expected call of DescribeAggregationAuthorizationsPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregationAuthorizationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregationAuthorizationsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregationAuthorizationsPages), arg0, arg1)
+}
+
+// DescribeAggregationAuthorizationsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregationAuthorizationsPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeAggregationAuthorizationsInput, arg2 func(*configservice.DescribeAggregationAuthorizationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAggregationAuthorizationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAggregationAuthorizationsPagesWithContext indicates an expected call of DescribeAggregationAuthorizationsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregationAuthorizationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregationAuthorizationsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregationAuthorizationsPagesWithContext), varargs...)
+}
+
+// DescribeAggregationAuthorizationsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregationAuthorizationsRequest(arg0 *configservice.DescribeAggregationAuthorizationsInput) (*request.Request, *configservice.DescribeAggregationAuthorizationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAggregationAuthorizationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeAggregationAuthorizationsOutput)
+	return ret0, ret1
+}
+
+// DescribeAggregationAuthorizationsRequest indicates an expected call of DescribeAggregationAuthorizationsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregationAuthorizationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregationAuthorizationsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregationAuthorizationsRequest), arg0)
+}
+
+// DescribeAggregationAuthorizationsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeAggregationAuthorizationsWithContext(arg0 context.Context, arg1 *configservice.DescribeAggregationAuthorizationsInput, arg2 ...request.Option) (*configservice.DescribeAggregationAuthorizationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAggregationAuthorizationsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeAggregationAuthorizationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAggregationAuthorizationsWithContext indicates an expected call of DescribeAggregationAuthorizationsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeAggregationAuthorizationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAggregationAuthorizationsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeAggregationAuthorizationsWithContext), varargs...)
+}
+
+// DescribeComplianceByConfigRule mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByConfigRule(arg0 *configservice.DescribeComplianceByConfigRuleInput) (*configservice.DescribeComplianceByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeComplianceByConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeComplianceByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeComplianceByConfigRule indicates an expected call of DescribeComplianceByConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByConfigRule), arg0)
+}
+
+// DescribeComplianceByConfigRulePages mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByConfigRulePages(arg0 *configservice.DescribeComplianceByConfigRuleInput, arg1 func(*configservice.DescribeComplianceByConfigRuleOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeComplianceByConfigRulePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeComplianceByConfigRulePages indicates an expected call of DescribeComplianceByConfigRulePages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByConfigRulePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByConfigRulePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByConfigRulePages), arg0, arg1)
+}
+
+// DescribeComplianceByConfigRulePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByConfigRulePagesWithContext(arg0 context.Context, arg1 *configservice.DescribeComplianceByConfigRuleInput, arg2 func(*configservice.DescribeComplianceByConfigRuleOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeComplianceByConfigRulePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeComplianceByConfigRulePagesWithContext indicates an expected call of DescribeComplianceByConfigRulePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByConfigRulePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByConfigRulePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByConfigRulePagesWithContext), varargs...)
+}
+
+// DescribeComplianceByConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByConfigRuleRequest(arg0 *configservice.DescribeComplianceByConfigRuleInput) (*request.Request, *configservice.DescribeComplianceByConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeComplianceByConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeComplianceByConfigRuleOutput)
+	return ret0, ret1
+}
+
+// DescribeComplianceByConfigRuleRequest indicates an expected call of DescribeComplianceByConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByConfigRuleRequest), arg0)
+}
+
+// DescribeComplianceByConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByConfigRuleWithContext(arg0 context.Context, arg1 *configservice.DescribeComplianceByConfigRuleInput, arg2 ...request.Option) (*configservice.DescribeComplianceByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeComplianceByConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeComplianceByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeComplianceByConfigRuleWithContext indicates an expected call of DescribeComplianceByConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByConfigRuleWithContext), varargs...)
+}
+
+// DescribeComplianceByResource mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByResource(arg0 *configservice.DescribeComplianceByResourceInput) (*configservice.DescribeComplianceByResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeComplianceByResource", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeComplianceByResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeComplianceByResource indicates an expected call of DescribeComplianceByResource
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByResource", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByResource), arg0)
+}
+
+// DescribeComplianceByResourcePages mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByResourcePages(arg0 *configservice.DescribeComplianceByResourceInput, arg1 func(*configservice.DescribeComplianceByResourceOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeComplianceByResourcePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeComplianceByResourcePages indicates an expected call of DescribeComplianceByResourcePages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByResourcePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByResourcePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByResourcePages), arg0, arg1)
+}
+
+// DescribeComplianceByResourcePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByResourcePagesWithContext(arg0 context.Context, arg1 *configservice.DescribeComplianceByResourceInput, arg2 func(*configservice.DescribeComplianceByResourceOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeComplianceByResourcePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeComplianceByResourcePagesWithContext indicates an expected call of DescribeComplianceByResourcePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByResourcePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByResourcePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByResourcePagesWithContext), varargs...)
+}
+
+// DescribeComplianceByResourceRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByResourceRequest(arg0 *configservice.DescribeComplianceByResourceInput) (*request.Request, *configservice.DescribeComplianceByResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeComplianceByResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeComplianceByResourceOutput)
+	return ret0, ret1
+}
+
+// DescribeComplianceByResourceRequest indicates an expected call of DescribeComplianceByResourceRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByResourceRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByResourceRequest), arg0)
+}
+
+// DescribeComplianceByResourceWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeComplianceByResourceWithContext(arg0 context.Context, arg1 *configservice.DescribeComplianceByResourceInput, arg2 ...request.Option) (*configservice.DescribeComplianceByResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeComplianceByResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeComplianceByResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeComplianceByResourceWithContext indicates an expected call of DescribeComplianceByResourceWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeComplianceByResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeComplianceByResourceWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeComplianceByResourceWithContext), varargs...)
+}
+
+// DescribeConfigRuleEvaluationStatus mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRuleEvaluationStatus(arg0 *configservice.DescribeConfigRuleEvaluationStatusInput) (*configservice.DescribeConfigRuleEvaluationStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigRuleEvaluationStatus", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConfigRuleEvaluationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigRuleEvaluationStatus indicates an expected call of DescribeConfigRuleEvaluationStatus
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRuleEvaluationStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRuleEvaluationStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRuleEvaluationStatus), arg0)
+}
+
+// DescribeConfigRuleEvaluationStatusPages mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRuleEvaluationStatusPages(arg0 *configservice.DescribeConfigRuleEvaluationStatusInput, arg1 func(*configservice.DescribeConfigRuleEvaluationStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigRuleEvaluationStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigRuleEvaluationStatusPages indicates an expected call of DescribeConfigRuleEvaluationStatusPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRuleEvaluationStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRuleEvaluationStatusPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRuleEvaluationStatusPages), arg0, arg1)
+}
+
+// DescribeConfigRuleEvaluationStatusPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRuleEvaluationStatusPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigRuleEvaluationStatusInput, arg2 func(*configservice.DescribeConfigRuleEvaluationStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigRuleEvaluationStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigRuleEvaluationStatusPagesWithContext indicates an expected call of DescribeConfigRuleEvaluationStatusPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRuleEvaluationStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRuleEvaluationStatusPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRuleEvaluationStatusPagesWithContext), varargs...)
+}
+
+// DescribeConfigRuleEvaluationStatusRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRuleEvaluationStatusRequest(arg0 *configservice.DescribeConfigRuleEvaluationStatusInput) (*request.Request, *configservice.DescribeConfigRuleEvaluationStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigRuleEvaluationStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConfigRuleEvaluationStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeConfigRuleEvaluationStatusRequest indicates an expected call of DescribeConfigRuleEvaluationStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRuleEvaluationStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRuleEvaluationStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRuleEvaluationStatusRequest), arg0)
+}
+
+// DescribeConfigRuleEvaluationStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRuleEvaluationStatusWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigRuleEvaluationStatusInput, arg2 ...request.Option) (*configservice.DescribeConfigRuleEvaluationStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigRuleEvaluationStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConfigRuleEvaluationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigRuleEvaluationStatusWithContext indicates an expected call of DescribeConfigRuleEvaluationStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRuleEvaluationStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRuleEvaluationStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRuleEvaluationStatusWithContext), varargs...)
+}
+
+// DescribeConfigRules mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRules(arg0 *configservice.DescribeConfigRulesInput) (*configservice.DescribeConfigRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigRules", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConfigRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigRules indicates an expected call of DescribeConfigRules
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRules", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRules), arg0)
+}
+
+// DescribeConfigRulesPages mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRulesPages(arg0 *configservice.DescribeConfigRulesInput, arg1 func(*configservice.DescribeConfigRulesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigRulesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigRulesPages indicates an expected call of DescribeConfigRulesPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRulesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRulesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRulesPages), arg0, arg1)
+}
+
+// DescribeConfigRulesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRulesPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigRulesInput, arg2 func(*configservice.DescribeConfigRulesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigRulesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigRulesPagesWithContext indicates an expected call of DescribeConfigRulesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRulesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRulesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRulesPagesWithContext), varargs...)
+}
+
+// DescribeConfigRulesRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRulesRequest(arg0 *configservice.DescribeConfigRulesInput) (*request.Request, *configservice.DescribeConfigRulesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigRulesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConfigRulesOutput)
+	return ret0, ret1
+}
+
+// DescribeConfigRulesRequest indicates an expected call of DescribeConfigRulesRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRulesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRulesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRulesRequest), arg0)
+}
+
+// DescribeConfigRulesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigRulesWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigRulesInput, arg2 ...request.Option) (*configservice.DescribeConfigRulesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigRulesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConfigRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigRulesWithContext indicates an expected call of DescribeConfigRulesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigRulesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigRulesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigRulesWithContext), varargs...)
+}
+
+// DescribeConfigurationAggregatorSourcesStatus mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorSourcesStatus(arg0 *configservice.DescribeConfigurationAggregatorSourcesStatusInput) (*configservice.DescribeConfigurationAggregatorSourcesStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorSourcesStatus", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationAggregatorSourcesStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationAggregatorSourcesStatus indicates an expected call of DescribeConfigurationAggregatorSourcesStatus
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorSourcesStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorSourcesStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorSourcesStatus), arg0)
+}
+
+// DescribeConfigurationAggregatorSourcesStatusPages mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorSourcesStatusPages(arg0 *configservice.DescribeConfigurationAggregatorSourcesStatusInput, arg1 func(*configservice.DescribeConfigurationAggregatorSourcesStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorSourcesStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigurationAggregatorSourcesStatusPages indicates an expected call of DescribeConfigurationAggregatorSourcesStatusPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorSourcesStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorSourcesStatusPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorSourcesStatusPages), arg0, arg1)
+}
+
+// DescribeConfigurationAggregatorSourcesStatusPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorSourcesStatusPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigurationAggregatorSourcesStatusInput, arg2 func(*configservice.DescribeConfigurationAggregatorSourcesStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorSourcesStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigurationAggregatorSourcesStatusPagesWithContext indicates an expected call of DescribeConfigurationAggregatorSourcesStatusPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorSourcesStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorSourcesStatusPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorSourcesStatusPagesWithContext), varargs...)
+}
+
+// DescribeConfigurationAggregatorSourcesStatusRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorSourcesStatusRequest(arg0 *configservice.DescribeConfigurationAggregatorSourcesStatusInput) (*request.Request, *configservice.DescribeConfigurationAggregatorSourcesStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorSourcesStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConfigurationAggregatorSourcesStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeConfigurationAggregatorSourcesStatusRequest indicates an expected call of DescribeConfigurationAggregatorSourcesStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorSourcesStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorSourcesStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorSourcesStatusRequest), arg0)
+}
+
+// DescribeConfigurationAggregatorSourcesStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorSourcesStatusWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigurationAggregatorSourcesStatusInput, arg2 ...request.Option) (*configservice.DescribeConfigurationAggregatorSourcesStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorSourcesStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationAggregatorSourcesStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationAggregatorSourcesStatusWithContext indicates an expected call of DescribeConfigurationAggregatorSourcesStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorSourcesStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorSourcesStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorSourcesStatusWithContext), varargs...)
+}
+
+// DescribeConfigurationAggregators mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregators(arg0 *configservice.DescribeConfigurationAggregatorsInput) (*configservice.DescribeConfigurationAggregatorsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregators", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationAggregatorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationAggregators indicates an expected call of DescribeConfigurationAggregators
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregators(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregators", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregators), arg0)
+}
+
+// DescribeConfigurationAggregatorsPages mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorsPages(arg0 *configservice.DescribeConfigurationAggregatorsInput, arg1 func(*configservice.DescribeConfigurationAggregatorsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigurationAggregatorsPages indicates an expected call of DescribeConfigurationAggregatorsPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorsPages), arg0, arg1)
+}
+
+// DescribeConfigurationAggregatorsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorsPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigurationAggregatorsInput, arg2 func(*configservice.DescribeConfigurationAggregatorsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConfigurationAggregatorsPagesWithContext indicates an expected call of DescribeConfigurationAggregatorsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorsPagesWithContext), varargs...)
+}
+
+// DescribeConfigurationAggregatorsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorsRequest(arg0 *configservice.DescribeConfigurationAggregatorsInput) (*request.Request, *configservice.DescribeConfigurationAggregatorsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConfigurationAggregatorsOutput)
+	return ret0, ret1
+}
+
+// DescribeConfigurationAggregatorsRequest indicates an expected call of DescribeConfigurationAggregatorsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorsRequest), arg0)
+}
+
+// DescribeConfigurationAggregatorsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationAggregatorsWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigurationAggregatorsInput, arg2 ...request.Option) (*configservice.DescribeConfigurationAggregatorsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigurationAggregatorsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationAggregatorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationAggregatorsWithContext indicates an expected call of DescribeConfigurationAggregatorsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationAggregatorsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationAggregatorsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationAggregatorsWithContext), varargs...)
+}
+
+// DescribeConfigurationRecorderStatus mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationRecorderStatus(arg0 *configservice.DescribeConfigurationRecorderStatusInput) (*configservice.DescribeConfigurationRecorderStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationRecorderStatus", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationRecorderStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationRecorderStatus indicates an expected call of DescribeConfigurationRecorderStatus
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationRecorderStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationRecorderStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationRecorderStatus), arg0)
+}
+
+// DescribeConfigurationRecorderStatusRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationRecorderStatusRequest(arg0 *configservice.DescribeConfigurationRecorderStatusInput) (*request.Request, *configservice.DescribeConfigurationRecorderStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationRecorderStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConfigurationRecorderStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeConfigurationRecorderStatusRequest indicates an expected call of DescribeConfigurationRecorderStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationRecorderStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationRecorderStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationRecorderStatusRequest), arg0)
+}
+
+// DescribeConfigurationRecorderStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationRecorderStatusWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigurationRecorderStatusInput, arg2 ...request.Option) (*configservice.DescribeConfigurationRecorderStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigurationRecorderStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationRecorderStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationRecorderStatusWithContext indicates an expected call of DescribeConfigurationRecorderStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationRecorderStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationRecorderStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationRecorderStatusWithContext), varargs...)
+}
+
+// DescribeConfigurationRecorders mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationRecorders(arg0 *configservice.DescribeConfigurationRecordersInput) (*configservice.DescribeConfigurationRecordersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationRecorders", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationRecordersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationRecorders indicates an expected call of DescribeConfigurationRecorders
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationRecorders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationRecorders", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationRecorders), arg0)
+}
+
+// DescribeConfigurationRecordersRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationRecordersRequest(arg0 *configservice.DescribeConfigurationRecordersInput) (*request.Request, *configservice.DescribeConfigurationRecordersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConfigurationRecordersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConfigurationRecordersOutput)
+	return ret0, ret1
+}
+
+// DescribeConfigurationRecordersRequest indicates an expected call of DescribeConfigurationRecordersRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationRecordersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationRecordersRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationRecordersRequest), arg0)
+}
+
+// DescribeConfigurationRecordersWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConfigurationRecordersWithContext(arg0 context.Context, arg1 *configservice.DescribeConfigurationRecordersInput, arg2 ...request.Option) (*configservice.DescribeConfigurationRecordersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConfigurationRecordersWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConfigurationRecordersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConfigurationRecordersWithContext indicates an expected call of DescribeConfigurationRecordersWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConfigurationRecordersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConfigurationRecordersWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConfigurationRecordersWithContext), varargs...)
+}
+
+// DescribeConformancePackCompliance mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackCompliance(arg0 *configservice.DescribeConformancePackComplianceInput) (*configservice.DescribeConformancePackComplianceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePackCompliance", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConformancePackComplianceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConformancePackCompliance indicates an expected call of DescribeConformancePackCompliance
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackCompliance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackCompliance", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackCompliance), arg0)
+}
+
+// DescribeConformancePackCompliancePages mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackCompliancePages(arg0 *configservice.DescribeConformancePackComplianceInput, arg1 func(*configservice.DescribeConformancePackComplianceOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePackCompliancePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConformancePackCompliancePages indicates an expected call of DescribeConformancePackCompliancePages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackCompliancePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackCompliancePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackCompliancePages), arg0, arg1)
+}
+
+// DescribeConformancePackCompliancePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackCompliancePagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConformancePackComplianceInput, arg2 func(*configservice.DescribeConformancePackComplianceOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConformancePackCompliancePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConformancePackCompliancePagesWithContext indicates an expected call of DescribeConformancePackCompliancePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackCompliancePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackCompliancePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackCompliancePagesWithContext), varargs...)
+}
+
+// DescribeConformancePackComplianceRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackComplianceRequest(arg0 *configservice.DescribeConformancePackComplianceInput) (*request.Request, *configservice.DescribeConformancePackComplianceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePackComplianceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConformancePackComplianceOutput)
+	return ret0, ret1
+}
+
+// DescribeConformancePackComplianceRequest indicates an expected call of DescribeConformancePackComplianceRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackComplianceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackComplianceRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackComplianceRequest), arg0)
+}
+
+// DescribeConformancePackComplianceWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackComplianceWithContext(arg0 context.Context, arg1 *configservice.DescribeConformancePackComplianceInput, arg2 ...request.Option) (*configservice.DescribeConformancePackComplianceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConformancePackComplianceWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConformancePackComplianceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConformancePackComplianceWithContext indicates an expected call of DescribeConformancePackComplianceWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackComplianceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackComplianceWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackComplianceWithContext), varargs...)
+}
+
+// DescribeConformancePackStatus mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackStatus(arg0 *configservice.DescribeConformancePackStatusInput) (*configservice.DescribeConformancePackStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePackStatus", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConformancePackStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConformancePackStatus indicates an expected call of DescribeConformancePackStatus
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackStatus), arg0)
+}
+
+// DescribeConformancePackStatusPages mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackStatusPages(arg0 *configservice.DescribeConformancePackStatusInput, arg1 func(*configservice.DescribeConformancePackStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePackStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConformancePackStatusPages indicates an expected call of DescribeConformancePackStatusPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackStatusPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackStatusPages), arg0, arg1)
+}
+
+// DescribeConformancePackStatusPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackStatusPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConformancePackStatusInput, arg2 func(*configservice.DescribeConformancePackStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConformancePackStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConformancePackStatusPagesWithContext indicates an expected call of DescribeConformancePackStatusPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackStatusPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackStatusPagesWithContext), varargs...)
+}
+
+// DescribeConformancePackStatusRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackStatusRequest(arg0 *configservice.DescribeConformancePackStatusInput) (*request.Request, *configservice.DescribeConformancePackStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePackStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConformancePackStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeConformancePackStatusRequest indicates an expected call of DescribeConformancePackStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackStatusRequest), arg0)
+}
+
+// DescribeConformancePackStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePackStatusWithContext(arg0 context.Context, arg1 *configservice.DescribeConformancePackStatusInput, arg2 ...request.Option) (*configservice.DescribeConformancePackStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConformancePackStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConformancePackStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConformancePackStatusWithContext indicates an expected call of DescribeConformancePackStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePackStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePackStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePackStatusWithContext), varargs...)
+}
+
+// DescribeConformancePacks mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePacks(arg0 *configservice.DescribeConformancePacksInput) (*configservice.DescribeConformancePacksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePacks", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeConformancePacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConformancePacks indicates an expected call of DescribeConformancePacks
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePacks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePacks", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePacks), arg0)
+}
+
+// DescribeConformancePacksPages mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePacksPages(arg0 *configservice.DescribeConformancePacksInput, arg1 func(*configservice.DescribeConformancePacksOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePacksPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConformancePacksPages indicates an expected call of DescribeConformancePacksPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePacksPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePacksPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePacksPages), arg0, arg1)
+}
+
+// DescribeConformancePacksPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePacksPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeConformancePacksInput, arg2 func(*configservice.DescribeConformancePacksOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConformancePacksPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeConformancePacksPagesWithContext indicates an expected call of DescribeConformancePacksPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePacksPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePacksPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePacksPagesWithContext), varargs...)
+}
+
+// DescribeConformancePacksRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePacksRequest(arg0 *configservice.DescribeConformancePacksInput) (*request.Request, *configservice.DescribeConformancePacksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeConformancePacksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeConformancePacksOutput)
+	return ret0, ret1
+}
+
+// DescribeConformancePacksRequest indicates an expected call of DescribeConformancePacksRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePacksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePacksRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePacksRequest), arg0)
+}
+
+// DescribeConformancePacksWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeConformancePacksWithContext(arg0 context.Context, arg1 *configservice.DescribeConformancePacksInput, arg2 ...request.Option) (*configservice.DescribeConformancePacksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeConformancePacksWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeConformancePacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeConformancePacksWithContext indicates an expected call of DescribeConformancePacksWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeConformancePacksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeConformancePacksWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeConformancePacksWithContext), varargs...)
+}
+
+// DescribeDeliveryChannelStatus mocks base method
+func (m *MockConfigServiceAPI) DescribeDeliveryChannelStatus(arg0 *configservice.DescribeDeliveryChannelStatusInput) (*configservice.DescribeDeliveryChannelStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryChannelStatus", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeDeliveryChannelStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryChannelStatus indicates an expected call of DescribeDeliveryChannelStatus
+func (mr *MockConfigServiceAPIMockRecorder) DescribeDeliveryChannelStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryChannelStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeDeliveryChannelStatus), arg0)
+}
+
+// DescribeDeliveryChannelStatusRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeDeliveryChannelStatusRequest(arg0 *configservice.DescribeDeliveryChannelStatusInput) (*request.Request, *configservice.DescribeDeliveryChannelStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryChannelStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeDeliveryChannelStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeDeliveryChannelStatusRequest indicates an expected call of DescribeDeliveryChannelStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeDeliveryChannelStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryChannelStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeDeliveryChannelStatusRequest), arg0)
+}
+
+// DescribeDeliveryChannelStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeDeliveryChannelStatusWithContext(arg0 context.Context, arg1 *configservice.DescribeDeliveryChannelStatusInput, arg2 ...request.Option) (*configservice.DescribeDeliveryChannelStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliveryChannelStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeDeliveryChannelStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryChannelStatusWithContext indicates an expected call of DescribeDeliveryChannelStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeDeliveryChannelStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryChannelStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeDeliveryChannelStatusWithContext), varargs...)
+}
+
+// DescribeDeliveryChannels mocks base method
+func (m *MockConfigServiceAPI) DescribeDeliveryChannels(arg0 *configservice.DescribeDeliveryChannelsInput) (*configservice.DescribeDeliveryChannelsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryChannels", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeDeliveryChannelsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryChannels indicates an expected call of DescribeDeliveryChannels
+func (mr *MockConfigServiceAPIMockRecorder) DescribeDeliveryChannels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryChannels", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeDeliveryChannels), arg0)
+}
+
+// DescribeDeliveryChannelsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeDeliveryChannelsRequest(arg0 *configservice.DescribeDeliveryChannelsInput) (*request.Request, *configservice.DescribeDeliveryChannelsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryChannelsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeDeliveryChannelsOutput)
+	return ret0, ret1
+}
+
+// DescribeDeliveryChannelsRequest indicates an expected call of DescribeDeliveryChannelsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeDeliveryChannelsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryChannelsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeDeliveryChannelsRequest), arg0)
+}
+
+// DescribeDeliveryChannelsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeDeliveryChannelsWithContext(arg0 context.Context, arg1 *configservice.DescribeDeliveryChannelsInput, arg2 ...request.Option) (*configservice.DescribeDeliveryChannelsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDeliveryChannelsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeDeliveryChannelsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryChannelsWithContext indicates an expected call of DescribeDeliveryChannelsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeDeliveryChannelsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryChannelsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeDeliveryChannelsWithContext), varargs...)
+}
+
+// DescribeOrganizationConfigRuleStatuses mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRuleStatuses(arg0 *configservice.DescribeOrganizationConfigRuleStatusesInput) (*configservice.DescribeOrganizationConfigRuleStatusesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRuleStatuses", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConfigRuleStatusesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConfigRuleStatuses indicates an expected call of DescribeOrganizationConfigRuleStatuses
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRuleStatuses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRuleStatuses", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRuleStatuses), arg0)
+}
+
+// DescribeOrganizationConfigRuleStatusesPages mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRuleStatusesPages(arg0 *configservice.DescribeOrganizationConfigRuleStatusesInput, arg1 func(*configservice.DescribeOrganizationConfigRuleStatusesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRuleStatusesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConfigRuleStatusesPages indicates an expected call of DescribeOrganizationConfigRuleStatusesPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRuleStatusesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRuleStatusesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRuleStatusesPages), arg0, arg1)
+}
+
+// DescribeOrganizationConfigRuleStatusesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRuleStatusesPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConfigRuleStatusesInput, arg2 func(*configservice.DescribeOrganizationConfigRuleStatusesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRuleStatusesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConfigRuleStatusesPagesWithContext indicates an expected call of DescribeOrganizationConfigRuleStatusesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRuleStatusesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRuleStatusesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRuleStatusesPagesWithContext), varargs...)
+}
+
+// DescribeOrganizationConfigRuleStatusesRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRuleStatusesRequest(arg0 *configservice.DescribeOrganizationConfigRuleStatusesInput) (*request.Request, *configservice.DescribeOrganizationConfigRuleStatusesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRuleStatusesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeOrganizationConfigRuleStatusesOutput)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConfigRuleStatusesRequest indicates an expected call of DescribeOrganizationConfigRuleStatusesRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRuleStatusesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRuleStatusesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRuleStatusesRequest), arg0)
+}
+
+// DescribeOrganizationConfigRuleStatusesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRuleStatusesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConfigRuleStatusesInput, arg2 ...request.Option) (*configservice.DescribeOrganizationConfigRuleStatusesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRuleStatusesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConfigRuleStatusesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConfigRuleStatusesWithContext indicates an expected call of DescribeOrganizationConfigRuleStatusesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRuleStatusesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRuleStatusesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRuleStatusesWithContext), varargs...)
+}
+
+// DescribeOrganizationConfigRules mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRules(arg0 *configservice.DescribeOrganizationConfigRulesInput) (*configservice.DescribeOrganizationConfigRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRules", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConfigRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConfigRules indicates an expected call of DescribeOrganizationConfigRules
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRules", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRules), arg0)
+}
+
+// DescribeOrganizationConfigRulesPages mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRulesPages(arg0 *configservice.DescribeOrganizationConfigRulesInput, arg1 func(*configservice.DescribeOrganizationConfigRulesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRulesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConfigRulesPages indicates an expected call of DescribeOrganizationConfigRulesPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRulesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRulesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRulesPages), arg0, arg1)
+}
+
+// DescribeOrganizationConfigRulesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRulesPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConfigRulesInput, arg2 func(*configservice.DescribeOrganizationConfigRulesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRulesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConfigRulesPagesWithContext indicates an expected call of DescribeOrganizationConfigRulesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRulesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRulesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRulesPagesWithContext), varargs...)
+}
+
+// DescribeOrganizationConfigRulesRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRulesRequest(arg0 *configservice.DescribeOrganizationConfigRulesInput) (*request.Request, *configservice.DescribeOrganizationConfigRulesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRulesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeOrganizationConfigRulesOutput)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConfigRulesRequest indicates an expected call of DescribeOrganizationConfigRulesRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRulesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRulesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRulesRequest), arg0)
+}
+
+// DescribeOrganizationConfigRulesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConfigRulesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConfigRulesInput, arg2 ...request.Option) (*configservice.DescribeOrganizationConfigRulesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConfigRulesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConfigRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConfigRulesWithContext indicates an expected call of DescribeOrganizationConfigRulesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConfigRulesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConfigRulesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConfigRulesWithContext), varargs...)
+}
+
+// DescribeOrganizationConformancePackStatuses mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePackStatuses(arg0 *configservice.DescribeOrganizationConformancePackStatusesInput) (*configservice.DescribeOrganizationConformancePackStatusesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePackStatuses", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConformancePackStatusesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConformancePackStatuses indicates an expected call of DescribeOrganizationConformancePackStatuses
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePackStatuses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePackStatuses", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePackStatuses), arg0)
+}
+
+// DescribeOrganizationConformancePackStatusesPages mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePackStatusesPages(arg0 *configservice.DescribeOrganizationConformancePackStatusesInput, arg1 func(*configservice.DescribeOrganizationConformancePackStatusesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePackStatusesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConformancePackStatusesPages indicates an expected call of DescribeOrganizationConformancePackStatusesPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePackStatusesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePackStatusesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePackStatusesPages), arg0, arg1)
+}
+
+// DescribeOrganizationConformancePackStatusesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePackStatusesPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConformancePackStatusesInput, arg2 func(*configservice.DescribeOrganizationConformancePackStatusesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePackStatusesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConformancePackStatusesPagesWithContext indicates an expected call of DescribeOrganizationConformancePackStatusesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePackStatusesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePackStatusesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePackStatusesPagesWithContext), varargs...)
+}
+
+// DescribeOrganizationConformancePackStatusesRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePackStatusesRequest(arg0 *configservice.DescribeOrganizationConformancePackStatusesInput) (*request.Request, *configservice.DescribeOrganizationConformancePackStatusesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePackStatusesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeOrganizationConformancePackStatusesOutput)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConformancePackStatusesRequest indicates an expected call of DescribeOrganizationConformancePackStatusesRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePackStatusesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePackStatusesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePackStatusesRequest), arg0)
+}
+
+// DescribeOrganizationConformancePackStatusesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePackStatusesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConformancePackStatusesInput, arg2 ...request.Option) (*configservice.DescribeOrganizationConformancePackStatusesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePackStatusesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConformancePackStatusesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConformancePackStatusesWithContext indicates an expected call of DescribeOrganizationConformancePackStatusesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePackStatusesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePackStatusesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePackStatusesWithContext), varargs...)
+}
+
+// DescribeOrganizationConformancePacks mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePacks(arg0 *configservice.DescribeOrganizationConformancePacksInput) (*configservice.DescribeOrganizationConformancePacksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePacks", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConformancePacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConformancePacks indicates an expected call of DescribeOrganizationConformancePacks
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePacks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePacks", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePacks), arg0)
+}
+
+// DescribeOrganizationConformancePacksPages mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePacksPages(arg0 *configservice.DescribeOrganizationConformancePacksInput, arg1 func(*configservice.DescribeOrganizationConformancePacksOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePacksPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConformancePacksPages indicates an expected call of DescribeOrganizationConformancePacksPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePacksPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePacksPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePacksPages), arg0, arg1)
+}
+
+// DescribeOrganizationConformancePacksPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePacksPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConformancePacksInput, arg2 func(*configservice.DescribeOrganizationConformancePacksOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePacksPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOrganizationConformancePacksPagesWithContext indicates an expected call of DescribeOrganizationConformancePacksPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePacksPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePacksPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePacksPagesWithContext), varargs...)
+}
+
+// DescribeOrganizationConformancePacksRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePacksRequest(arg0 *configservice.DescribeOrganizationConformancePacksInput) (*request.Request, *configservice.DescribeOrganizationConformancePacksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePacksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeOrganizationConformancePacksOutput)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConformancePacksRequest indicates an expected call of DescribeOrganizationConformancePacksRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePacksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePacksRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePacksRequest), arg0)
+}
+
+// DescribeOrganizationConformancePacksWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeOrganizationConformancePacksWithContext(arg0 context.Context, arg1 *configservice.DescribeOrganizationConformancePacksInput, arg2 ...request.Option) (*configservice.DescribeOrganizationConformancePacksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOrganizationConformancePacksWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeOrganizationConformancePacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOrganizationConformancePacksWithContext indicates an expected call of DescribeOrganizationConformancePacksWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeOrganizationConformancePacksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOrganizationConformancePacksWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeOrganizationConformancePacksWithContext), varargs...)
+}
+
+// DescribePendingAggregationRequests mocks base method
+func (m *MockConfigServiceAPI) DescribePendingAggregationRequests(arg0 *configservice.DescribePendingAggregationRequestsInput) (*configservice.DescribePendingAggregationRequestsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePendingAggregationRequests", arg0)
+	ret0, _ := ret[0].(*configservice.DescribePendingAggregationRequestsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePendingAggregationRequests indicates an expected call of DescribePendingAggregationRequests
+func (mr *MockConfigServiceAPIMockRecorder) DescribePendingAggregationRequests(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePendingAggregationRequests", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribePendingAggregationRequests), arg0)
+}
+
+// DescribePendingAggregationRequestsPages mocks base method
+func (m *MockConfigServiceAPI) DescribePendingAggregationRequestsPages(arg0 *configservice.DescribePendingAggregationRequestsInput, arg1 func(*configservice.DescribePendingAggregationRequestsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePendingAggregationRequestsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePendingAggregationRequestsPages indicates an expected call of DescribePendingAggregationRequestsPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribePendingAggregationRequestsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePendingAggregationRequestsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribePendingAggregationRequestsPages), arg0, arg1)
+}
+
+// DescribePendingAggregationRequestsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribePendingAggregationRequestsPagesWithContext(arg0 context.Context, arg1 *configservice.DescribePendingAggregationRequestsInput, arg2 func(*configservice.DescribePendingAggregationRequestsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePendingAggregationRequestsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePendingAggregationRequestsPagesWithContext indicates an expected call of DescribePendingAggregationRequestsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribePendingAggregationRequestsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePendingAggregationRequestsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribePendingAggregationRequestsPagesWithContext), varargs...)
+}
+
+// DescribePendingAggregationRequestsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribePendingAggregationRequestsRequest(arg0 *configservice.DescribePendingAggregationRequestsInput) (*request.Request, *configservice.DescribePendingAggregationRequestsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePendingAggregationRequestsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribePendingAggregationRequestsOutput)
+	return ret0, ret1
+}
+
+// DescribePendingAggregationRequestsRequest indicates an expected call of DescribePendingAggregationRequestsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribePendingAggregationRequestsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePendingAggregationRequestsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribePendingAggregationRequestsRequest), arg0)
+}
+
+// DescribePendingAggregationRequestsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribePendingAggregationRequestsWithContext(arg0 context.Context, arg1 *configservice.DescribePendingAggregationRequestsInput, arg2 ...request.Option) (*configservice.DescribePendingAggregationRequestsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePendingAggregationRequestsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribePendingAggregationRequestsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePendingAggregationRequestsWithContext indicates an expected call of DescribePendingAggregationRequestsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribePendingAggregationRequestsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePendingAggregationRequestsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribePendingAggregationRequestsWithContext), varargs...)
+}
+
+// DescribeRemediationConfigurations mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationConfigurations(arg0 *configservice.DescribeRemediationConfigurationsInput) (*configservice.DescribeRemediationConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationConfigurations", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeRemediationConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRemediationConfigurations indicates an expected call of DescribeRemediationConfigurations
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationConfigurations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationConfigurations", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationConfigurations), arg0)
+}
+
+// DescribeRemediationConfigurationsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationConfigurationsRequest(arg0 *configservice.DescribeRemediationConfigurationsInput) (*request.Request, *configservice.DescribeRemediationConfigurationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationConfigurationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeRemediationConfigurationsOutput)
+	return ret0, ret1
+}
+
+// DescribeRemediationConfigurationsRequest indicates an expected call of DescribeRemediationConfigurationsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationConfigurationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationConfigurationsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationConfigurationsRequest), arg0)
+}
+
+// DescribeRemediationConfigurationsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationConfigurationsWithContext(arg0 context.Context, arg1 *configservice.DescribeRemediationConfigurationsInput, arg2 ...request.Option) (*configservice.DescribeRemediationConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRemediationConfigurationsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeRemediationConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRemediationConfigurationsWithContext indicates an expected call of DescribeRemediationConfigurationsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationConfigurationsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationConfigurationsWithContext), varargs...)
+}
+
+// DescribeRemediationExceptions mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExceptions(arg0 *configservice.DescribeRemediationExceptionsInput) (*configservice.DescribeRemediationExceptionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationExceptions", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeRemediationExceptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRemediationExceptions indicates an expected call of DescribeRemediationExceptions
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExceptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExceptions", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExceptions), arg0)
+}
+
+// DescribeRemediationExceptionsPages mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExceptionsPages(arg0 *configservice.DescribeRemediationExceptionsInput, arg1 func(*configservice.DescribeRemediationExceptionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationExceptionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRemediationExceptionsPages indicates an expected call of DescribeRemediationExceptionsPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExceptionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExceptionsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExceptionsPages), arg0, arg1)
+}
+
+// DescribeRemediationExceptionsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExceptionsPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeRemediationExceptionsInput, arg2 func(*configservice.DescribeRemediationExceptionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRemediationExceptionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRemediationExceptionsPagesWithContext indicates an expected call of DescribeRemediationExceptionsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExceptionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExceptionsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExceptionsPagesWithContext), varargs...)
+}
+
+// DescribeRemediationExceptionsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExceptionsRequest(arg0 *configservice.DescribeRemediationExceptionsInput) (*request.Request, *configservice.DescribeRemediationExceptionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationExceptionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeRemediationExceptionsOutput)
+	return ret0, ret1
+}
+
+// DescribeRemediationExceptionsRequest indicates an expected call of DescribeRemediationExceptionsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExceptionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExceptionsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExceptionsRequest), arg0)
+}
+
+// DescribeRemediationExceptionsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExceptionsWithContext(arg0 context.Context, arg1 *configservice.DescribeRemediationExceptionsInput, arg2 ...request.Option) (*configservice.DescribeRemediationExceptionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRemediationExceptionsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeRemediationExceptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRemediationExceptionsWithContext indicates an expected call of DescribeRemediationExceptionsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExceptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExceptionsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExceptionsWithContext), varargs...)
+}
+
+// DescribeRemediationExecutionStatus mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExecutionStatus(arg0 *configservice.DescribeRemediationExecutionStatusInput) (*configservice.DescribeRemediationExecutionStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationExecutionStatus", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeRemediationExecutionStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRemediationExecutionStatus indicates an expected call of DescribeRemediationExecutionStatus
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExecutionStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExecutionStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExecutionStatus), arg0)
+}
+
+// DescribeRemediationExecutionStatusPages mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExecutionStatusPages(arg0 *configservice.DescribeRemediationExecutionStatusInput, arg1 func(*configservice.DescribeRemediationExecutionStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationExecutionStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRemediationExecutionStatusPages indicates an expected call of DescribeRemediationExecutionStatusPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExecutionStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExecutionStatusPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExecutionStatusPages), arg0, arg1)
+}
+
+// DescribeRemediationExecutionStatusPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExecutionStatusPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeRemediationExecutionStatusInput, arg2 func(*configservice.DescribeRemediationExecutionStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRemediationExecutionStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRemediationExecutionStatusPagesWithContext indicates an expected call of DescribeRemediationExecutionStatusPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExecutionStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExecutionStatusPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExecutionStatusPagesWithContext), varargs...)
+}
+
+// DescribeRemediationExecutionStatusRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExecutionStatusRequest(arg0 *configservice.DescribeRemediationExecutionStatusInput) (*request.Request, *configservice.DescribeRemediationExecutionStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRemediationExecutionStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeRemediationExecutionStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeRemediationExecutionStatusRequest indicates an expected call of DescribeRemediationExecutionStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExecutionStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExecutionStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExecutionStatusRequest), arg0)
+}
+
+// DescribeRemediationExecutionStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRemediationExecutionStatusWithContext(arg0 context.Context, arg1 *configservice.DescribeRemediationExecutionStatusInput, arg2 ...request.Option) (*configservice.DescribeRemediationExecutionStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRemediationExecutionStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeRemediationExecutionStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRemediationExecutionStatusWithContext indicates an expected call of DescribeRemediationExecutionStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRemediationExecutionStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRemediationExecutionStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRemediationExecutionStatusWithContext), varargs...)
+}
+
+// DescribeRetentionConfigurations mocks base method
+func (m *MockConfigServiceAPI) DescribeRetentionConfigurations(arg0 *configservice.DescribeRetentionConfigurationsInput) (*configservice.DescribeRetentionConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRetentionConfigurations", arg0)
+	ret0, _ := ret[0].(*configservice.DescribeRetentionConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRetentionConfigurations indicates an expected call of DescribeRetentionConfigurations
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRetentionConfigurations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRetentionConfigurations", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRetentionConfigurations), arg0)
+}
+
+// DescribeRetentionConfigurationsPages mocks base method
+func (m *MockConfigServiceAPI) DescribeRetentionConfigurationsPages(arg0 *configservice.DescribeRetentionConfigurationsInput, arg1 func(*configservice.DescribeRetentionConfigurationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRetentionConfigurationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRetentionConfigurationsPages indicates an expected call of DescribeRetentionConfigurationsPages
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRetentionConfigurationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRetentionConfigurationsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRetentionConfigurationsPages), arg0, arg1)
+}
+
+// DescribeRetentionConfigurationsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRetentionConfigurationsPagesWithContext(arg0 context.Context, arg1 *configservice.DescribeRetentionConfigurationsInput, arg2 func(*configservice.DescribeRetentionConfigurationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRetentionConfigurationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeRetentionConfigurationsPagesWithContext indicates an expected call of DescribeRetentionConfigurationsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRetentionConfigurationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRetentionConfigurationsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRetentionConfigurationsPagesWithContext), varargs...)
+}
+
+// DescribeRetentionConfigurationsRequest mocks base method
+func (m *MockConfigServiceAPI) DescribeRetentionConfigurationsRequest(arg0 *configservice.DescribeRetentionConfigurationsInput) (*request.Request, *configservice.DescribeRetentionConfigurationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRetentionConfigurationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.DescribeRetentionConfigurationsOutput)
+	return ret0, ret1
+}
+
+// DescribeRetentionConfigurationsRequest indicates an expected call of DescribeRetentionConfigurationsRequest
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRetentionConfigurationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRetentionConfigurationsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRetentionConfigurationsRequest), arg0)
+}
+
+// DescribeRetentionConfigurationsWithContext mocks base method
+func (m *MockConfigServiceAPI) DescribeRetentionConfigurationsWithContext(arg0 context.Context, arg1 *configservice.DescribeRetentionConfigurationsInput, arg2 ...request.Option) (*configservice.DescribeRetentionConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRetentionConfigurationsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.DescribeRetentionConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRetentionConfigurationsWithContext indicates an expected call of DescribeRetentionConfigurationsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) DescribeRetentionConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRetentionConfigurationsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).DescribeRetentionConfigurationsWithContext), varargs...)
+}
+
+// GetAggregateComplianceDetailsByConfigRule mocks base method
+func (m *MockConfigServiceAPI) GetAggregateComplianceDetailsByConfigRule(arg0 *configservice.GetAggregateComplianceDetailsByConfigRuleInput) (*configservice.GetAggregateComplianceDetailsByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateComplianceDetailsByConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.GetAggregateComplianceDetailsByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateComplianceDetailsByConfigRule indicates an expected call of GetAggregateComplianceDetailsByConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateComplianceDetailsByConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateComplianceDetailsByConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateComplianceDetailsByConfigRule), arg0)
+}
+
+// GetAggregateComplianceDetailsByConfigRulePages mocks base method
+func (m *MockConfigServiceAPI) GetAggregateComplianceDetailsByConfigRulePages(arg0 *configservice.GetAggregateComplianceDetailsByConfigRuleInput, arg1 func(*configservice.GetAggregateComplianceDetailsByConfigRuleOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateComplianceDetailsByConfigRulePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateComplianceDetailsByConfigRulePages indicates an expected call of GetAggregateComplianceDetailsByConfigRulePages
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateComplianceDetailsByConfigRulePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateComplianceDetailsByConfigRulePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateComplianceDetailsByConfigRulePages), arg0, arg1)
+}
+
+// GetAggregateComplianceDetailsByConfigRulePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateComplianceDetailsByConfigRulePagesWithContext(arg0 context.Context, arg1 *configservice.GetAggregateComplianceDetailsByConfigRuleInput, arg2 func(*configservice.GetAggregateComplianceDetailsByConfigRuleOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateComplianceDetailsByConfigRulePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateComplianceDetailsByConfigRulePagesWithContext indicates an expected call of GetAggregateComplianceDetailsByConfigRulePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateComplianceDetailsByConfigRulePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateComplianceDetailsByConfigRulePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateComplianceDetailsByConfigRulePagesWithContext), varargs...)
+}
+
+// GetAggregateComplianceDetailsByConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) GetAggregateComplianceDetailsByConfigRuleRequest(arg0 *configservice.GetAggregateComplianceDetailsByConfigRuleInput) (*request.Request, *configservice.GetAggregateComplianceDetailsByConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateComplianceDetailsByConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetAggregateComplianceDetailsByConfigRuleOutput)
+	return ret0, ret1
+}
+
+// GetAggregateComplianceDetailsByConfigRuleRequest indicates an expected call of GetAggregateComplianceDetailsByConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateComplianceDetailsByConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateComplianceDetailsByConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateComplianceDetailsByConfigRuleRequest), arg0)
+}
+
+// GetAggregateComplianceDetailsByConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateComplianceDetailsByConfigRuleWithContext(arg0 context.Context, arg1 *configservice.GetAggregateComplianceDetailsByConfigRuleInput, arg2 ...request.Option) (*configservice.GetAggregateComplianceDetailsByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateComplianceDetailsByConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetAggregateComplianceDetailsByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateComplianceDetailsByConfigRuleWithContext indicates an expected call of GetAggregateComplianceDetailsByConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateComplianceDetailsByConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateComplianceDetailsByConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateComplianceDetailsByConfigRuleWithContext), varargs...)
+}
+
+// GetAggregateConfigRuleComplianceSummary mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConfigRuleComplianceSummary(arg0 *configservice.GetAggregateConfigRuleComplianceSummaryInput) (*configservice.GetAggregateConfigRuleComplianceSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateConfigRuleComplianceSummary", arg0)
+	ret0, _ := ret[0].(*configservice.GetAggregateConfigRuleComplianceSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateConfigRuleComplianceSummary indicates an expected call of GetAggregateConfigRuleComplianceSummary
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConfigRuleComplianceSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConfigRuleComplianceSummary", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConfigRuleComplianceSummary), arg0)
+}
+
+// GetAggregateConfigRuleComplianceSummaryPages mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConfigRuleComplianceSummaryPages(arg0 *configservice.GetAggregateConfigRuleComplianceSummaryInput, arg1 func(*configservice.GetAggregateConfigRuleComplianceSummaryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateConfigRuleComplianceSummaryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateConfigRuleComplianceSummaryPages indicates an expected call of GetAggregateConfigRuleComplianceSummaryPages
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConfigRuleComplianceSummaryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConfigRuleComplianceSummaryPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConfigRuleComplianceSummaryPages), arg0, arg1)
+}
+
+// GetAggregateConfigRuleComplianceSummaryPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConfigRuleComplianceSummaryPagesWithContext(arg0 context.Context, arg1 *configservice.GetAggregateConfigRuleComplianceSummaryInput, arg2 func(*configservice.GetAggregateConfigRuleComplianceSummaryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateConfigRuleComplianceSummaryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateConfigRuleComplianceSummaryPagesWithContext indicates an expected call of GetAggregateConfigRuleComplianceSummaryPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConfigRuleComplianceSummaryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConfigRuleComplianceSummaryPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConfigRuleComplianceSummaryPagesWithContext), varargs...)
+}
+
+// GetAggregateConfigRuleComplianceSummaryRequest mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConfigRuleComplianceSummaryRequest(arg0 *configservice.GetAggregateConfigRuleComplianceSummaryInput) (*request.Request, *configservice.GetAggregateConfigRuleComplianceSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateConfigRuleComplianceSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetAggregateConfigRuleComplianceSummaryOutput)
+	return ret0, ret1
+}
+
+// GetAggregateConfigRuleComplianceSummaryRequest indicates an expected call of GetAggregateConfigRuleComplianceSummaryRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConfigRuleComplianceSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConfigRuleComplianceSummaryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConfigRuleComplianceSummaryRequest), arg0)
+}
+
+// GetAggregateConfigRuleComplianceSummaryWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConfigRuleComplianceSummaryWithContext(arg0 context.Context, arg1 *configservice.GetAggregateConfigRuleComplianceSummaryInput, arg2 ...request.Option) (*configservice.GetAggregateConfigRuleComplianceSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateConfigRuleComplianceSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetAggregateConfigRuleComplianceSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateConfigRuleComplianceSummaryWithContext indicates an expected call of GetAggregateConfigRuleComplianceSummaryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConfigRuleComplianceSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConfigRuleComplianceSummaryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConfigRuleComplianceSummaryWithContext), varargs...)
+}
+
+// GetAggregateConformancePackComplianceSummary mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConformancePackComplianceSummary(arg0 *configservice.GetAggregateConformancePackComplianceSummaryInput) (*configservice.GetAggregateConformancePackComplianceSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateConformancePackComplianceSummary", arg0)
+	ret0, _ := ret[0].(*configservice.GetAggregateConformancePackComplianceSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateConformancePackComplianceSummary indicates an expected call of GetAggregateConformancePackComplianceSummary
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConformancePackComplianceSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConformancePackComplianceSummary", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConformancePackComplianceSummary), arg0)
+}
+
+// GetAggregateConformancePackComplianceSummaryPages mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConformancePackComplianceSummaryPages(arg0 *configservice.GetAggregateConformancePackComplianceSummaryInput, arg1 func(*configservice.GetAggregateConformancePackComplianceSummaryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateConformancePackComplianceSummaryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateConformancePackComplianceSummaryPages indicates an expected call of GetAggregateConformancePackComplianceSummaryPages
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConformancePackComplianceSummaryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConformancePackComplianceSummaryPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConformancePackComplianceSummaryPages), arg0, arg1)
+}
+
+// GetAggregateConformancePackComplianceSummaryPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConformancePackComplianceSummaryPagesWithContext(arg0 context.Context, arg1 *configservice.GetAggregateConformancePackComplianceSummaryInput, arg2 func(*configservice.GetAggregateConformancePackComplianceSummaryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateConformancePackComplianceSummaryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateConformancePackComplianceSummaryPagesWithContext indicates an expected call of GetAggregateConformancePackComplianceSummaryPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConformancePackComplianceSummaryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConformancePackComplianceSummaryPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConformancePackComplianceSummaryPagesWithContext), varargs...)
+}
+
+// GetAggregateConformancePackComplianceSummaryRequest mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConformancePackComplianceSummaryRequest(arg0 *configservice.GetAggregateConformancePackComplianceSummaryInput) (*request.Request, *configservice.GetAggregateConformancePackComplianceSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateConformancePackComplianceSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetAggregateConformancePackComplianceSummaryOutput)
+	return ret0, ret1
+}
+
+// GetAggregateConformancePackComplianceSummaryRequest indicates an expected call of GetAggregateConformancePackComplianceSummaryRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConformancePackComplianceSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConformancePackComplianceSummaryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConformancePackComplianceSummaryRequest), arg0)
+}
+
+// GetAggregateConformancePackComplianceSummaryWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateConformancePackComplianceSummaryWithContext(arg0 context.Context, arg1 *configservice.GetAggregateConformancePackComplianceSummaryInput, arg2 ...request.Option) (*configservice.GetAggregateConformancePackComplianceSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateConformancePackComplianceSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetAggregateConformancePackComplianceSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateConformancePackComplianceSummaryWithContext indicates an expected call of GetAggregateConformancePackComplianceSummaryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateConformancePackComplianceSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateConformancePackComplianceSummaryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateConformancePackComplianceSummaryWithContext), varargs...)
+}
+
+// GetAggregateDiscoveredResourceCounts mocks base method
+func (m *MockConfigServiceAPI) GetAggregateDiscoveredResourceCounts(arg0 *configservice.GetAggregateDiscoveredResourceCountsInput) (*configservice.GetAggregateDiscoveredResourceCountsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateDiscoveredResourceCounts", arg0)
+	ret0, _ := ret[0].(*configservice.GetAggregateDiscoveredResourceCountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateDiscoveredResourceCounts indicates an expected call of GetAggregateDiscoveredResourceCounts
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateDiscoveredResourceCounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateDiscoveredResourceCounts", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateDiscoveredResourceCounts), arg0)
+}
+
+// GetAggregateDiscoveredResourceCountsPages mocks base method
+func (m *MockConfigServiceAPI) GetAggregateDiscoveredResourceCountsPages(arg0 *configservice.GetAggregateDiscoveredResourceCountsInput, arg1 func(*configservice.GetAggregateDiscoveredResourceCountsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateDiscoveredResourceCountsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateDiscoveredResourceCountsPages indicates an expected call of GetAggregateDiscoveredResourceCountsPages
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateDiscoveredResourceCountsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateDiscoveredResourceCountsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateDiscoveredResourceCountsPages), arg0, arg1)
+}
+
+// GetAggregateDiscoveredResourceCountsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateDiscoveredResourceCountsPagesWithContext(arg0 context.Context, arg1 *configservice.GetAggregateDiscoveredResourceCountsInput, arg2 func(*configservice.GetAggregateDiscoveredResourceCountsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateDiscoveredResourceCountsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAggregateDiscoveredResourceCountsPagesWithContext indicates an expected call of GetAggregateDiscoveredResourceCountsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateDiscoveredResourceCountsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateDiscoveredResourceCountsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateDiscoveredResourceCountsPagesWithContext), varargs...)
+}
+
+// GetAggregateDiscoveredResourceCountsRequest mocks base method
+func (m *MockConfigServiceAPI) GetAggregateDiscoveredResourceCountsRequest(arg0 *configservice.GetAggregateDiscoveredResourceCountsInput) (*request.Request, *configservice.GetAggregateDiscoveredResourceCountsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateDiscoveredResourceCountsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetAggregateDiscoveredResourceCountsOutput)
+	return ret0, ret1
+}
+
+// GetAggregateDiscoveredResourceCountsRequest indicates an expected call of GetAggregateDiscoveredResourceCountsRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateDiscoveredResourceCountsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateDiscoveredResourceCountsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateDiscoveredResourceCountsRequest), arg0)
+}
+
+// GetAggregateDiscoveredResourceCountsWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateDiscoveredResourceCountsWithContext(arg0 context.Context, arg1 *configservice.GetAggregateDiscoveredResourceCountsInput, arg2 ...request.Option) (*configservice.GetAggregateDiscoveredResourceCountsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateDiscoveredResourceCountsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetAggregateDiscoveredResourceCountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateDiscoveredResourceCountsWithContext indicates an expected call of GetAggregateDiscoveredResourceCountsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateDiscoveredResourceCountsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateDiscoveredResourceCountsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateDiscoveredResourceCountsWithContext), varargs...)
+}
+
+// GetAggregateResourceConfig mocks base method
+func (m *MockConfigServiceAPI) GetAggregateResourceConfig(arg0 *configservice.GetAggregateResourceConfigInput) (*configservice.GetAggregateResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.GetAggregateResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateResourceConfig indicates an expected call of GetAggregateResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateResourceConfig), arg0)
+}
+
+// GetAggregateResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) GetAggregateResourceConfigRequest(arg0 *configservice.GetAggregateResourceConfigInput) (*request.Request, *configservice.GetAggregateResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregateResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetAggregateResourceConfigOutput)
+	return ret0, ret1
+}
+
+// GetAggregateResourceConfigRequest indicates an expected call of GetAggregateResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateResourceConfigRequest), arg0)
+}
+
+// GetAggregateResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) GetAggregateResourceConfigWithContext(arg0 context.Context, arg1 *configservice.GetAggregateResourceConfigInput, arg2 ...request.Option) (*configservice.GetAggregateResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAggregateResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetAggregateResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregateResourceConfigWithContext indicates an expected call of GetAggregateResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetAggregateResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregateResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetAggregateResourceConfigWithContext), varargs...)
+}
+
+// GetComplianceDetailsByConfigRule mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByConfigRule(arg0 *configservice.GetComplianceDetailsByConfigRuleInput) (*configservice.GetComplianceDetailsByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.GetComplianceDetailsByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceDetailsByConfigRule indicates an expected call of GetComplianceDetailsByConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByConfigRule), arg0)
+}
+
+// GetComplianceDetailsByConfigRulePages mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByConfigRulePages(arg0 *configservice.GetComplianceDetailsByConfigRuleInput, arg1 func(*configservice.GetComplianceDetailsByConfigRuleOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByConfigRulePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetComplianceDetailsByConfigRulePages indicates an expected call of GetComplianceDetailsByConfigRulePages
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByConfigRulePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByConfigRulePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByConfigRulePages), arg0, arg1)
+}
+
+// GetComplianceDetailsByConfigRulePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByConfigRulePagesWithContext(arg0 context.Context, arg1 *configservice.GetComplianceDetailsByConfigRuleInput, arg2 func(*configservice.GetComplianceDetailsByConfigRuleOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByConfigRulePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetComplianceDetailsByConfigRulePagesWithContext indicates an expected call of GetComplianceDetailsByConfigRulePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByConfigRulePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByConfigRulePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByConfigRulePagesWithContext), varargs...)
+}
+
+// GetComplianceDetailsByConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByConfigRuleRequest(arg0 *configservice.GetComplianceDetailsByConfigRuleInput) (*request.Request, *configservice.GetComplianceDetailsByConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetComplianceDetailsByConfigRuleOutput)
+	return ret0, ret1
+}
+
+// GetComplianceDetailsByConfigRuleRequest indicates an expected call of GetComplianceDetailsByConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByConfigRuleRequest), arg0)
+}
+
+// GetComplianceDetailsByConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByConfigRuleWithContext(arg0 context.Context, arg1 *configservice.GetComplianceDetailsByConfigRuleInput, arg2 ...request.Option) (*configservice.GetComplianceDetailsByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetComplianceDetailsByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceDetailsByConfigRuleWithContext indicates an expected call of GetComplianceDetailsByConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByConfigRuleWithContext), varargs...)
+}
+
+// GetComplianceDetailsByResource mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByResource(arg0 *configservice.GetComplianceDetailsByResourceInput) (*configservice.GetComplianceDetailsByResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByResource", arg0)
+	ret0, _ := ret[0].(*configservice.GetComplianceDetailsByResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceDetailsByResource indicates an expected call of GetComplianceDetailsByResource
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByResource", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByResource), arg0)
+}
+
+// GetComplianceDetailsByResourcePages mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByResourcePages(arg0 *configservice.GetComplianceDetailsByResourceInput, arg1 func(*configservice.GetComplianceDetailsByResourceOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByResourcePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetComplianceDetailsByResourcePages indicates an expected call of GetComplianceDetailsByResourcePages
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByResourcePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByResourcePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByResourcePages), arg0, arg1)
+}
+
+// GetComplianceDetailsByResourcePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByResourcePagesWithContext(arg0 context.Context, arg1 *configservice.GetComplianceDetailsByResourceInput, arg2 func(*configservice.GetComplianceDetailsByResourceOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByResourcePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetComplianceDetailsByResourcePagesWithContext indicates an expected call of GetComplianceDetailsByResourcePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByResourcePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByResourcePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByResourcePagesWithContext), varargs...)
+}
+
+// GetComplianceDetailsByResourceRequest mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByResourceRequest(arg0 *configservice.GetComplianceDetailsByResourceInput) (*request.Request, *configservice.GetComplianceDetailsByResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetComplianceDetailsByResourceOutput)
+	return ret0, ret1
+}
+
+// GetComplianceDetailsByResourceRequest indicates an expected call of GetComplianceDetailsByResourceRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByResourceRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByResourceRequest), arg0)
+}
+
+// GetComplianceDetailsByResourceWithContext mocks base method
+func (m *MockConfigServiceAPI) GetComplianceDetailsByResourceWithContext(arg0 context.Context, arg1 *configservice.GetComplianceDetailsByResourceInput, arg2 ...request.Option) (*configservice.GetComplianceDetailsByResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComplianceDetailsByResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetComplianceDetailsByResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceDetailsByResourceWithContext indicates an expected call of GetComplianceDetailsByResourceWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceDetailsByResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDetailsByResourceWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceDetailsByResourceWithContext), varargs...)
+}
+
+// GetComplianceSummaryByConfigRule mocks base method
+func (m *MockConfigServiceAPI) GetComplianceSummaryByConfigRule(arg0 *configservice.GetComplianceSummaryByConfigRuleInput) (*configservice.GetComplianceSummaryByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceSummaryByConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.GetComplianceSummaryByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceSummaryByConfigRule indicates an expected call of GetComplianceSummaryByConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceSummaryByConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSummaryByConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceSummaryByConfigRule), arg0)
+}
+
+// GetComplianceSummaryByConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) GetComplianceSummaryByConfigRuleRequest(arg0 *configservice.GetComplianceSummaryByConfigRuleInput) (*request.Request, *configservice.GetComplianceSummaryByConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceSummaryByConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetComplianceSummaryByConfigRuleOutput)
+	return ret0, ret1
+}
+
+// GetComplianceSummaryByConfigRuleRequest indicates an expected call of GetComplianceSummaryByConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceSummaryByConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSummaryByConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceSummaryByConfigRuleRequest), arg0)
+}
+
+// GetComplianceSummaryByConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) GetComplianceSummaryByConfigRuleWithContext(arg0 context.Context, arg1 *configservice.GetComplianceSummaryByConfigRuleInput, arg2 ...request.Option) (*configservice.GetComplianceSummaryByConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComplianceSummaryByConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetComplianceSummaryByConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceSummaryByConfigRuleWithContext indicates an expected call of GetComplianceSummaryByConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceSummaryByConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSummaryByConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceSummaryByConfigRuleWithContext), varargs...)
+}
+
+// GetComplianceSummaryByResourceType mocks base method
+func (m *MockConfigServiceAPI) GetComplianceSummaryByResourceType(arg0 *configservice.GetComplianceSummaryByResourceTypeInput) (*configservice.GetComplianceSummaryByResourceTypeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceSummaryByResourceType", arg0)
+	ret0, _ := ret[0].(*configservice.GetComplianceSummaryByResourceTypeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceSummaryByResourceType indicates an expected call of GetComplianceSummaryByResourceType
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceSummaryByResourceType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSummaryByResourceType", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceSummaryByResourceType), arg0)
+}
+
+// GetComplianceSummaryByResourceTypeRequest mocks base method
+func (m *MockConfigServiceAPI) GetComplianceSummaryByResourceTypeRequest(arg0 *configservice.GetComplianceSummaryByResourceTypeInput) (*request.Request, *configservice.GetComplianceSummaryByResourceTypeOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceSummaryByResourceTypeRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetComplianceSummaryByResourceTypeOutput)
+	return ret0, ret1
+}
+
+// GetComplianceSummaryByResourceTypeRequest indicates an expected call of GetComplianceSummaryByResourceTypeRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceSummaryByResourceTypeRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSummaryByResourceTypeRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceSummaryByResourceTypeRequest), arg0)
+}
+
+// GetComplianceSummaryByResourceTypeWithContext mocks base method
+func (m *MockConfigServiceAPI) GetComplianceSummaryByResourceTypeWithContext(arg0 context.Context, arg1 *configservice.GetComplianceSummaryByResourceTypeInput, arg2 ...request.Option) (*configservice.GetComplianceSummaryByResourceTypeOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComplianceSummaryByResourceTypeWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetComplianceSummaryByResourceTypeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceSummaryByResourceTypeWithContext indicates an expected call of GetComplianceSummaryByResourceTypeWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetComplianceSummaryByResourceTypeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSummaryByResourceTypeWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetComplianceSummaryByResourceTypeWithContext), varargs...)
+}
+
+// GetConformancePackComplianceDetails mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceDetails(arg0 *configservice.GetConformancePackComplianceDetailsInput) (*configservice.GetConformancePackComplianceDetailsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceDetails", arg0)
+	ret0, _ := ret[0].(*configservice.GetConformancePackComplianceDetailsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConformancePackComplianceDetails indicates an expected call of GetConformancePackComplianceDetails
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceDetails(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceDetails", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceDetails), arg0)
+}
+
+// GetConformancePackComplianceDetailsPages mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceDetailsPages(arg0 *configservice.GetConformancePackComplianceDetailsInput, arg1 func(*configservice.GetConformancePackComplianceDetailsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceDetailsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetConformancePackComplianceDetailsPages indicates an expected call of GetConformancePackComplianceDetailsPages
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceDetailsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceDetailsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceDetailsPages), arg0, arg1)
+}
+
+// GetConformancePackComplianceDetailsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceDetailsPagesWithContext(arg0 context.Context, arg1 *configservice.GetConformancePackComplianceDetailsInput, arg2 func(*configservice.GetConformancePackComplianceDetailsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceDetailsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetConformancePackComplianceDetailsPagesWithContext indicates an expected call of GetConformancePackComplianceDetailsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceDetailsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceDetailsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceDetailsPagesWithContext), varargs...)
+}
+
+// GetConformancePackComplianceDetailsRequest mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceDetailsRequest(arg0 *configservice.GetConformancePackComplianceDetailsInput) (*request.Request, *configservice.GetConformancePackComplianceDetailsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceDetailsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetConformancePackComplianceDetailsOutput)
+	return ret0, ret1
+}
+
+// GetConformancePackComplianceDetailsRequest indicates an expected call of GetConformancePackComplianceDetailsRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceDetailsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceDetailsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceDetailsRequest), arg0)
+}
+
+// GetConformancePackComplianceDetailsWithContext mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceDetailsWithContext(arg0 context.Context, arg1 *configservice.GetConformancePackComplianceDetailsInput, arg2 ...request.Option) (*configservice.GetConformancePackComplianceDetailsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceDetailsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetConformancePackComplianceDetailsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConformancePackComplianceDetailsWithContext indicates an expected call of GetConformancePackComplianceDetailsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceDetailsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceDetailsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceDetailsWithContext), varargs...)
+}
+
+// GetConformancePackComplianceSummary mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceSummary(arg0 *configservice.GetConformancePackComplianceSummaryInput) (*configservice.GetConformancePackComplianceSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceSummary", arg0)
+	ret0, _ := ret[0].(*configservice.GetConformancePackComplianceSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConformancePackComplianceSummary indicates an expected call of GetConformancePackComplianceSummary
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceSummary", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceSummary), arg0)
+}
+
+// GetConformancePackComplianceSummaryPages mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceSummaryPages(arg0 *configservice.GetConformancePackComplianceSummaryInput, arg1 func(*configservice.GetConformancePackComplianceSummaryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceSummaryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetConformancePackComplianceSummaryPages indicates an expected call of GetConformancePackComplianceSummaryPages
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceSummaryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceSummaryPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceSummaryPages), arg0, arg1)
+}
+
+// GetConformancePackComplianceSummaryPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceSummaryPagesWithContext(arg0 context.Context, arg1 *configservice.GetConformancePackComplianceSummaryInput, arg2 func(*configservice.GetConformancePackComplianceSummaryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceSummaryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetConformancePackComplianceSummaryPagesWithContext indicates an expected call of GetConformancePackComplianceSummaryPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceSummaryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceSummaryPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceSummaryPagesWithContext), varargs...)
+}
+
+// GetConformancePackComplianceSummaryRequest mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceSummaryRequest(arg0 *configservice.GetConformancePackComplianceSummaryInput) (*request.Request, *configservice.GetConformancePackComplianceSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetConformancePackComplianceSummaryOutput)
+	return ret0, ret1
+}
+
+// GetConformancePackComplianceSummaryRequest indicates an expected call of GetConformancePackComplianceSummaryRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceSummaryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceSummaryRequest), arg0)
+}
+
+// GetConformancePackComplianceSummaryWithContext mocks base method
+func (m *MockConfigServiceAPI) GetConformancePackComplianceSummaryWithContext(arg0 context.Context, arg1 *configservice.GetConformancePackComplianceSummaryInput, arg2 ...request.Option) (*configservice.GetConformancePackComplianceSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConformancePackComplianceSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetConformancePackComplianceSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConformancePackComplianceSummaryWithContext indicates an expected call of GetConformancePackComplianceSummaryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetConformancePackComplianceSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConformancePackComplianceSummaryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetConformancePackComplianceSummaryWithContext), varargs...)
+}
+
+// GetCustomRulePolicy mocks base method
+func (m *MockConfigServiceAPI) GetCustomRulePolicy(arg0 *configservice.GetCustomRulePolicyInput) (*configservice.GetCustomRulePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRulePolicy", arg0)
+	ret0, _ := ret[0].(*configservice.GetCustomRulePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRulePolicy indicates an expected call of GetCustomRulePolicy
+func (mr *MockConfigServiceAPIMockRecorder) GetCustomRulePolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRulePolicy", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetCustomRulePolicy), arg0)
+}
+
+// GetCustomRulePolicyRequest mocks base method
+func (m *MockConfigServiceAPI) GetCustomRulePolicyRequest(arg0 *configservice.GetCustomRulePolicyInput) (*request.Request, *configservice.GetCustomRulePolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRulePolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetCustomRulePolicyOutput)
+	return ret0, ret1
+}
+
+// GetCustomRulePolicyRequest indicates an expected call of GetCustomRulePolicyRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetCustomRulePolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRulePolicyRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetCustomRulePolicyRequest), arg0)
+}
+
+// GetCustomRulePolicyWithContext mocks base method
+func (m *MockConfigServiceAPI) GetCustomRulePolicyWithContext(arg0 context.Context, arg1 *configservice.GetCustomRulePolicyInput, arg2 ...request.Option) (*configservice.GetCustomRulePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCustomRulePolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetCustomRulePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRulePolicyWithContext indicates an expected call of GetCustomRulePolicyWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetCustomRulePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRulePolicyWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetCustomRulePolicyWithContext), varargs...)
+}
+
+// GetDiscoveredResourceCounts mocks base method
+func (m *MockConfigServiceAPI) GetDiscoveredResourceCounts(arg0 *configservice.GetDiscoveredResourceCountsInput) (*configservice.GetDiscoveredResourceCountsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscoveredResourceCounts", arg0)
+	ret0, _ := ret[0].(*configservice.GetDiscoveredResourceCountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscoveredResourceCounts indicates an expected call of GetDiscoveredResourceCounts
+func (mr *MockConfigServiceAPIMockRecorder) GetDiscoveredResourceCounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoveredResourceCounts", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetDiscoveredResourceCounts), arg0)
+}
+
+// GetDiscoveredResourceCountsPages mocks base method
+func (m *MockConfigServiceAPI) GetDiscoveredResourceCountsPages(arg0 *configservice.GetDiscoveredResourceCountsInput, arg1 func(*configservice.GetDiscoveredResourceCountsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscoveredResourceCountsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetDiscoveredResourceCountsPages indicates an expected call of GetDiscoveredResourceCountsPages
+func (mr *MockConfigServiceAPIMockRecorder) GetDiscoveredResourceCountsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoveredResourceCountsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetDiscoveredResourceCountsPages), arg0, arg1)
+}
+
+// GetDiscoveredResourceCountsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetDiscoveredResourceCountsPagesWithContext(arg0 context.Context, arg1 *configservice.GetDiscoveredResourceCountsInput, arg2 func(*configservice.GetDiscoveredResourceCountsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDiscoveredResourceCountsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetDiscoveredResourceCountsPagesWithContext indicates an expected call of GetDiscoveredResourceCountsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetDiscoveredResourceCountsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoveredResourceCountsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetDiscoveredResourceCountsPagesWithContext), varargs...)
+}
+
+// GetDiscoveredResourceCountsRequest mocks base method
+func (m *MockConfigServiceAPI) GetDiscoveredResourceCountsRequest(arg0 *configservice.GetDiscoveredResourceCountsInput) (*request.Request, *configservice.GetDiscoveredResourceCountsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscoveredResourceCountsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetDiscoveredResourceCountsOutput)
+	return ret0, ret1
+}
+
+// GetDiscoveredResourceCountsRequest indicates an expected call of GetDiscoveredResourceCountsRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetDiscoveredResourceCountsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoveredResourceCountsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetDiscoveredResourceCountsRequest), arg0)
+}
+
+// GetDiscoveredResourceCountsWithContext mocks base method
+func (m *MockConfigServiceAPI) GetDiscoveredResourceCountsWithContext(arg0 context.Context, arg1 *configservice.GetDiscoveredResourceCountsInput, arg2 ...request.Option) (*configservice.GetDiscoveredResourceCountsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDiscoveredResourceCountsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetDiscoveredResourceCountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscoveredResourceCountsWithContext indicates an expected call of GetDiscoveredResourceCountsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetDiscoveredResourceCountsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoveredResourceCountsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetDiscoveredResourceCountsWithContext), varargs...)
+}
+
+// GetOrganizationConfigRuleDetailedStatus mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConfigRuleDetailedStatus(arg0 *configservice.GetOrganizationConfigRuleDetailedStatusInput) (*configservice.GetOrganizationConfigRuleDetailedStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationConfigRuleDetailedStatus", arg0)
+	ret0, _ := ret[0].(*configservice.GetOrganizationConfigRuleDetailedStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationConfigRuleDetailedStatus indicates an expected call of GetOrganizationConfigRuleDetailedStatus
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConfigRuleDetailedStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConfigRuleDetailedStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConfigRuleDetailedStatus), arg0)
+}
+
+// GetOrganizationConfigRuleDetailedStatusPages mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConfigRuleDetailedStatusPages(arg0 *configservice.GetOrganizationConfigRuleDetailedStatusInput, arg1 func(*configservice.GetOrganizationConfigRuleDetailedStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationConfigRuleDetailedStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOrganizationConfigRuleDetailedStatusPages indicates an expected call of GetOrganizationConfigRuleDetailedStatusPages
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConfigRuleDetailedStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConfigRuleDetailedStatusPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConfigRuleDetailedStatusPages), arg0, arg1)
+}
+
+// GetOrganizationConfigRuleDetailedStatusPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConfigRuleDetailedStatusPagesWithContext(arg0 context.Context, arg1 *configservice.GetOrganizationConfigRuleDetailedStatusInput, arg2 func(*configservice.GetOrganizationConfigRuleDetailedStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrganizationConfigRuleDetailedStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOrganizationConfigRuleDetailedStatusPagesWithContext indicates an expected call of GetOrganizationConfigRuleDetailedStatusPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConfigRuleDetailedStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConfigRuleDetailedStatusPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConfigRuleDetailedStatusPagesWithContext), varargs...)
+}
+
+// GetOrganizationConfigRuleDetailedStatusRequest mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConfigRuleDetailedStatusRequest(arg0 *configservice.GetOrganizationConfigRuleDetailedStatusInput) (*request.Request, *configservice.GetOrganizationConfigRuleDetailedStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationConfigRuleDetailedStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetOrganizationConfigRuleDetailedStatusOutput)
+	return ret0, ret1
+}
+
+// GetOrganizationConfigRuleDetailedStatusRequest indicates an expected call of GetOrganizationConfigRuleDetailedStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConfigRuleDetailedStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConfigRuleDetailedStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConfigRuleDetailedStatusRequest), arg0)
+}
+
+// GetOrganizationConfigRuleDetailedStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConfigRuleDetailedStatusWithContext(arg0 context.Context, arg1 *configservice.GetOrganizationConfigRuleDetailedStatusInput, arg2 ...request.Option) (*configservice.GetOrganizationConfigRuleDetailedStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrganizationConfigRuleDetailedStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetOrganizationConfigRuleDetailedStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationConfigRuleDetailedStatusWithContext indicates an expected call of GetOrganizationConfigRuleDetailedStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConfigRuleDetailedStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConfigRuleDetailedStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConfigRuleDetailedStatusWithContext), varargs...)
+}
+
+// GetOrganizationConformancePackDetailedStatus mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConformancePackDetailedStatus(arg0 *configservice.GetOrganizationConformancePackDetailedStatusInput) (*configservice.GetOrganizationConformancePackDetailedStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationConformancePackDetailedStatus", arg0)
+	ret0, _ := ret[0].(*configservice.GetOrganizationConformancePackDetailedStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationConformancePackDetailedStatus indicates an expected call of GetOrganizationConformancePackDetailedStatus
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConformancePackDetailedStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConformancePackDetailedStatus", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConformancePackDetailedStatus), arg0)
+}
+
+// GetOrganizationConformancePackDetailedStatusPages mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConformancePackDetailedStatusPages(arg0 *configservice.GetOrganizationConformancePackDetailedStatusInput, arg1 func(*configservice.GetOrganizationConformancePackDetailedStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationConformancePackDetailedStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOrganizationConformancePackDetailedStatusPages indicates an expected call of GetOrganizationConformancePackDetailedStatusPages
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConformancePackDetailedStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConformancePackDetailedStatusPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConformancePackDetailedStatusPages), arg0, arg1)
+}
+
+// GetOrganizationConformancePackDetailedStatusPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConformancePackDetailedStatusPagesWithContext(arg0 context.Context, arg1 *configservice.GetOrganizationConformancePackDetailedStatusInput, arg2 func(*configservice.GetOrganizationConformancePackDetailedStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrganizationConformancePackDetailedStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOrganizationConformancePackDetailedStatusPagesWithContext indicates an expected call of GetOrganizationConformancePackDetailedStatusPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConformancePackDetailedStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConformancePackDetailedStatusPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConformancePackDetailedStatusPagesWithContext), varargs...)
+}
+
+// GetOrganizationConformancePackDetailedStatusRequest mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConformancePackDetailedStatusRequest(arg0 *configservice.GetOrganizationConformancePackDetailedStatusInput) (*request.Request, *configservice.GetOrganizationConformancePackDetailedStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationConformancePackDetailedStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetOrganizationConformancePackDetailedStatusOutput)
+	return ret0, ret1
+}
+
+// GetOrganizationConformancePackDetailedStatusRequest indicates an expected call of GetOrganizationConformancePackDetailedStatusRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConformancePackDetailedStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConformancePackDetailedStatusRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConformancePackDetailedStatusRequest), arg0)
+}
+
+// GetOrganizationConformancePackDetailedStatusWithContext mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationConformancePackDetailedStatusWithContext(arg0 context.Context, arg1 *configservice.GetOrganizationConformancePackDetailedStatusInput, arg2 ...request.Option) (*configservice.GetOrganizationConformancePackDetailedStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrganizationConformancePackDetailedStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetOrganizationConformancePackDetailedStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationConformancePackDetailedStatusWithContext indicates an expected call of GetOrganizationConformancePackDetailedStatusWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationConformancePackDetailedStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationConformancePackDetailedStatusWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationConformancePackDetailedStatusWithContext), varargs...)
+}
+
+// GetOrganizationCustomRulePolicy mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationCustomRulePolicy(arg0 *configservice.GetOrganizationCustomRulePolicyInput) (*configservice.GetOrganizationCustomRulePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationCustomRulePolicy", arg0)
+	ret0, _ := ret[0].(*configservice.GetOrganizationCustomRulePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationCustomRulePolicy indicates an expected call of GetOrganizationCustomRulePolicy
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationCustomRulePolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationCustomRulePolicy", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationCustomRulePolicy), arg0)
+}
+
+// GetOrganizationCustomRulePolicyRequest mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationCustomRulePolicyRequest(arg0 *configservice.GetOrganizationCustomRulePolicyInput) (*request.Request, *configservice.GetOrganizationCustomRulePolicyOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationCustomRulePolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetOrganizationCustomRulePolicyOutput)
+	return ret0, ret1
+}
+
+// GetOrganizationCustomRulePolicyRequest indicates an expected call of GetOrganizationCustomRulePolicyRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationCustomRulePolicyRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationCustomRulePolicyRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationCustomRulePolicyRequest), arg0)
+}
+
+// GetOrganizationCustomRulePolicyWithContext mocks base method
+func (m *MockConfigServiceAPI) GetOrganizationCustomRulePolicyWithContext(arg0 context.Context, arg1 *configservice.GetOrganizationCustomRulePolicyInput, arg2 ...request.Option) (*configservice.GetOrganizationCustomRulePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrganizationCustomRulePolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetOrganizationCustomRulePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationCustomRulePolicyWithContext indicates an expected call of GetOrganizationCustomRulePolicyWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetOrganizationCustomRulePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationCustomRulePolicyWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetOrganizationCustomRulePolicyWithContext), varargs...)
+}
+
+// GetResourceConfigHistory mocks base method
+func (m *MockConfigServiceAPI) GetResourceConfigHistory(arg0 *configservice.GetResourceConfigHistoryInput) (*configservice.GetResourceConfigHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceConfigHistory", arg0)
+	ret0, _ := ret[0].(*configservice.GetResourceConfigHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceConfigHistory indicates an expected call of GetResourceConfigHistory
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceConfigHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceConfigHistory", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceConfigHistory), arg0)
+}
+
+// GetResourceConfigHistoryPages mocks base method
+func (m *MockConfigServiceAPI) GetResourceConfigHistoryPages(arg0 *configservice.GetResourceConfigHistoryInput, arg1 func(*configservice.GetResourceConfigHistoryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceConfigHistoryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetResourceConfigHistoryPages indicates an expected call of GetResourceConfigHistoryPages
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceConfigHistoryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceConfigHistoryPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceConfigHistoryPages), arg0, arg1)
+}
+
+// GetResourceConfigHistoryPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) GetResourceConfigHistoryPagesWithContext(arg0 context.Context, arg1 *configservice.GetResourceConfigHistoryInput, arg2 func(*configservice.GetResourceConfigHistoryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResourceConfigHistoryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetResourceConfigHistoryPagesWithContext indicates an expected call of GetResourceConfigHistoryPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceConfigHistoryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceConfigHistoryPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceConfigHistoryPagesWithContext), varargs...)
+}
+
+// GetResourceConfigHistoryRequest mocks base method
+func (m *MockConfigServiceAPI) GetResourceConfigHistoryRequest(arg0 *configservice.GetResourceConfigHistoryInput) (*request.Request, *configservice.GetResourceConfigHistoryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceConfigHistoryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetResourceConfigHistoryOutput)
+	return ret0, ret1
+}
+
+// GetResourceConfigHistoryRequest indicates an expected call of GetResourceConfigHistoryRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceConfigHistoryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceConfigHistoryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceConfigHistoryRequest), arg0)
+}
+
+// GetResourceConfigHistoryWithContext mocks base method
+func (m *MockConfigServiceAPI) GetResourceConfigHistoryWithContext(arg0 context.Context, arg1 *configservice.GetResourceConfigHistoryInput, arg2 ...request.Option) (*configservice.GetResourceConfigHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResourceConfigHistoryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetResourceConfigHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceConfigHistoryWithContext indicates an expected call of GetResourceConfigHistoryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceConfigHistoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceConfigHistoryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceConfigHistoryWithContext), varargs...)
+}
+
+// GetResourceEvaluationSummary mocks base method
+func (m *MockConfigServiceAPI) GetResourceEvaluationSummary(arg0 *configservice.GetResourceEvaluationSummaryInput) (*configservice.GetResourceEvaluationSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceEvaluationSummary", arg0)
+	ret0, _ := ret[0].(*configservice.GetResourceEvaluationSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceEvaluationSummary indicates an expected call of GetResourceEvaluationSummary
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceEvaluationSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceEvaluationSummary", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceEvaluationSummary), arg0)
+}
+
+// GetResourceEvaluationSummaryRequest mocks base method
+func (m *MockConfigServiceAPI) GetResourceEvaluationSummaryRequest(arg0 *configservice.GetResourceEvaluationSummaryInput) (*request.Request, *configservice.GetResourceEvaluationSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceEvaluationSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetResourceEvaluationSummaryOutput)
+	return ret0, ret1
+}
+
+// GetResourceEvaluationSummaryRequest indicates an expected call of GetResourceEvaluationSummaryRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceEvaluationSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceEvaluationSummaryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceEvaluationSummaryRequest), arg0)
+}
+
+// GetResourceEvaluationSummaryWithContext mocks base method
+func (m *MockConfigServiceAPI) GetResourceEvaluationSummaryWithContext(arg0 context.Context, arg1 *configservice.GetResourceEvaluationSummaryInput, arg2 ...request.Option) (*configservice.GetResourceEvaluationSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResourceEvaluationSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetResourceEvaluationSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceEvaluationSummaryWithContext indicates an expected call of GetResourceEvaluationSummaryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetResourceEvaluationSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceEvaluationSummaryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetResourceEvaluationSummaryWithContext), varargs...)
+}
+
+// GetStoredQuery mocks base method
+func (m *MockConfigServiceAPI) GetStoredQuery(arg0 *configservice.GetStoredQueryInput) (*configservice.GetStoredQueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredQuery", arg0)
+	ret0, _ := ret[0].(*configservice.GetStoredQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredQuery indicates an expected call of GetStoredQuery
+func (mr *MockConfigServiceAPIMockRecorder) GetStoredQuery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredQuery", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetStoredQuery), arg0)
+}
+
+// GetStoredQueryRequest mocks base method
+func (m *MockConfigServiceAPI) GetStoredQueryRequest(arg0 *configservice.GetStoredQueryInput) (*request.Request, *configservice.GetStoredQueryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredQueryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.GetStoredQueryOutput)
+	return ret0, ret1
+}
+
+// GetStoredQueryRequest indicates an expected call of GetStoredQueryRequest
+func (mr *MockConfigServiceAPIMockRecorder) GetStoredQueryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredQueryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetStoredQueryRequest), arg0)
+}
+
+// GetStoredQueryWithContext mocks base method
+func (m *MockConfigServiceAPI) GetStoredQueryWithContext(arg0 context.Context, arg1 *configservice.GetStoredQueryInput, arg2 ...request.Option) (*configservice.GetStoredQueryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoredQueryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.GetStoredQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredQueryWithContext indicates an expected call of GetStoredQueryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) GetStoredQueryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredQueryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).GetStoredQueryWithContext), varargs...)
+}
+
+// ListAggregateDiscoveredResources mocks base method
+func (m *MockConfigServiceAPI) ListAggregateDiscoveredResources(arg0 *configservice.ListAggregateDiscoveredResourcesInput) (*configservice.ListAggregateDiscoveredResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAggregateDiscoveredResources", arg0)
+	ret0, _ := ret[0].(*configservice.ListAggregateDiscoveredResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAggregateDiscoveredResources indicates an expected call of ListAggregateDiscoveredResources
+func (mr *MockConfigServiceAPIMockRecorder) ListAggregateDiscoveredResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregateDiscoveredResources", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListAggregateDiscoveredResources), arg0)
+}
+
+// ListAggregateDiscoveredResourcesPages mocks base method
+func (m *MockConfigServiceAPI) ListAggregateDiscoveredResourcesPages(arg0 *configservice.ListAggregateDiscoveredResourcesInput, arg1 func(*configservice.ListAggregateDiscoveredResourcesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAggregateDiscoveredResourcesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAggregateDiscoveredResourcesPages indicates an expected call of ListAggregateDiscoveredResourcesPages
+func (mr *MockConfigServiceAPIMockRecorder) ListAggregateDiscoveredResourcesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregateDiscoveredResourcesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListAggregateDiscoveredResourcesPages), arg0, arg1)
+}
+
+// ListAggregateDiscoveredResourcesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListAggregateDiscoveredResourcesPagesWithContext(arg0 context.Context, arg1 *configservice.ListAggregateDiscoveredResourcesInput, arg2 func(*configservice.ListAggregateDiscoveredResourcesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAggregateDiscoveredResourcesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAggregateDiscoveredResourcesPagesWithContext indicates an expected call of ListAggregateDiscoveredResourcesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListAggregateDiscoveredResourcesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregateDiscoveredResourcesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListAggregateDiscoveredResourcesPagesWithContext), varargs...)
+}
+
+// ListAggregateDiscoveredResourcesRequest mocks base method
+func (m *MockConfigServiceAPI) ListAggregateDiscoveredResourcesRequest(arg0 *configservice.ListAggregateDiscoveredResourcesInput) (*request.Request, *configservice.ListAggregateDiscoveredResourcesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAggregateDiscoveredResourcesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.ListAggregateDiscoveredResourcesOutput)
+	return ret0, ret1
+}
+
+// ListAggregateDiscoveredResourcesRequest indicates an expected call of ListAggregateDiscoveredResourcesRequest
+func (mr *MockConfigServiceAPIMockRecorder) ListAggregateDiscoveredResourcesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregateDiscoveredResourcesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListAggregateDiscoveredResourcesRequest), arg0)
+}
+
+// ListAggregateDiscoveredResourcesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListAggregateDiscoveredResourcesWithContext(arg0 context.Context, arg1 *configservice.ListAggregateDiscoveredResourcesInput, arg2 ...request.Option) (*configservice.ListAggregateDiscoveredResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAggregateDiscoveredResourcesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.ListAggregateDiscoveredResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAggregateDiscoveredResourcesWithContext indicates an expected call of ListAggregateDiscoveredResourcesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListAggregateDiscoveredResourcesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregateDiscoveredResourcesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListAggregateDiscoveredResourcesWithContext), varargs...)
+}
+
+// ListConformancePackComplianceScores mocks base method
+func (m *MockConfigServiceAPI) ListConformancePackComplianceScores(arg0 *configservice.ListConformancePackComplianceScoresInput) (*configservice.ListConformancePackComplianceScoresOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConformancePackComplianceScores", arg0)
+	ret0, _ := ret[0].(*configservice.ListConformancePackComplianceScoresOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConformancePackComplianceScores indicates an expected call of ListConformancePackComplianceScores
+func (mr *MockConfigServiceAPIMockRecorder) ListConformancePackComplianceScores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConformancePackComplianceScores", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListConformancePackComplianceScores), arg0)
+}
+
+// ListConformancePackComplianceScoresPages mocks base method
+func (m *MockConfigServiceAPI) ListConformancePackComplianceScoresPages(arg0 *configservice.ListConformancePackComplianceScoresInput, arg1 func(*configservice.ListConformancePackComplianceScoresOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConformancePackComplianceScoresPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListConformancePackComplianceScoresPages indicates an expected call of ListConformancePackComplianceScoresPages
+func (mr *MockConfigServiceAPIMockRecorder) ListConformancePackComplianceScoresPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConformancePackComplianceScoresPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListConformancePackComplianceScoresPages), arg0, arg1)
+}
+
+// ListConformancePackComplianceScoresPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListConformancePackComplianceScoresPagesWithContext(arg0 context.Context, arg1 *configservice.ListConformancePackComplianceScoresInput, arg2 func(*configservice.ListConformancePackComplianceScoresOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConformancePackComplianceScoresPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListConformancePackComplianceScoresPagesWithContext indicates an expected call of ListConformancePackComplianceScoresPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListConformancePackComplianceScoresPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConformancePackComplianceScoresPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListConformancePackComplianceScoresPagesWithContext), varargs...)
+}
+
+// ListConformancePackComplianceScoresRequest mocks base method
+func (m *MockConfigServiceAPI) ListConformancePackComplianceScoresRequest(arg0 *configservice.ListConformancePackComplianceScoresInput) (*request.Request, *configservice.ListConformancePackComplianceScoresOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConformancePackComplianceScoresRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.ListConformancePackComplianceScoresOutput)
+	return ret0, ret1
+}
+
+// ListConformancePackComplianceScoresRequest indicates an expected call of ListConformancePackComplianceScoresRequest
+func (mr *MockConfigServiceAPIMockRecorder) ListConformancePackComplianceScoresRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConformancePackComplianceScoresRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListConformancePackComplianceScoresRequest), arg0)
+}
+
+// ListConformancePackComplianceScoresWithContext mocks base method
+func (m *MockConfigServiceAPI) ListConformancePackComplianceScoresWithContext(arg0 context.Context, arg1 *configservice.ListConformancePackComplianceScoresInput, arg2 ...request.Option) (*configservice.ListConformancePackComplianceScoresOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConformancePackComplianceScoresWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.ListConformancePackComplianceScoresOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConformancePackComplianceScoresWithContext indicates an expected call of ListConformancePackComplianceScoresWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListConformancePackComplianceScoresWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConformancePackComplianceScoresWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListConformancePackComplianceScoresWithContext), varargs...)
+}
+
+// ListDiscoveredResources mocks base method
+func (m *MockConfigServiceAPI) ListDiscoveredResources(arg0 *configservice.ListDiscoveredResourcesInput) (*configservice.ListDiscoveredResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiscoveredResources", arg0)
+	ret0, _ := ret[0].(*configservice.ListDiscoveredResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiscoveredResources indicates an expected call of ListDiscoveredResources
+func (mr *MockConfigServiceAPIMockRecorder) ListDiscoveredResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscoveredResources", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListDiscoveredResources), arg0)
+}
+
+// ListDiscoveredResourcesPages mocks base method
+func (m *MockConfigServiceAPI) ListDiscoveredResourcesPages(arg0 *configservice.ListDiscoveredResourcesInput, arg1 func(*configservice.ListDiscoveredResourcesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiscoveredResourcesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDiscoveredResourcesPages indicates an expected call of ListDiscoveredResourcesPages
+func (mr *MockConfigServiceAPIMockRecorder) ListDiscoveredResourcesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscoveredResourcesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListDiscoveredResourcesPages), arg0, arg1)
+}
+
+// ListDiscoveredResourcesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListDiscoveredResourcesPagesWithContext(arg0 context.Context, arg1 *configservice.ListDiscoveredResourcesInput, arg2 func(*configservice.ListDiscoveredResourcesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiscoveredResourcesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDiscoveredResourcesPagesWithContext indicates an expected call of ListDiscoveredResourcesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListDiscoveredResourcesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscoveredResourcesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListDiscoveredResourcesPagesWithContext), varargs...)
+}
+
+// ListDiscoveredResourcesRequest mocks base method
+func (m *MockConfigServiceAPI) ListDiscoveredResourcesRequest(arg0 *configservice.ListDiscoveredResourcesInput) (*request.Request, *configservice.ListDiscoveredResourcesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiscoveredResourcesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.ListDiscoveredResourcesOutput)
+	return ret0, ret1
+}
+
+// ListDiscoveredResourcesRequest indicates an expected call of ListDiscoveredResourcesRequest
+func (mr *MockConfigServiceAPIMockRecorder) ListDiscoveredResourcesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscoveredResourcesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListDiscoveredResourcesRequest), arg0)
+}
+
+// ListDiscoveredResourcesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListDiscoveredResourcesWithContext(arg0 context.Context, arg1 *configservice.ListDiscoveredResourcesInput, arg2 ...request.Option) (*configservice.ListDiscoveredResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiscoveredResourcesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.ListDiscoveredResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiscoveredResourcesWithContext indicates an expected call of ListDiscoveredResourcesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListDiscoveredResourcesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscoveredResourcesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListDiscoveredResourcesWithContext), varargs...)
+}
+
+// ListResourceEvaluations mocks base method
+func (m *MockConfigServiceAPI) ListResourceEvaluations(arg0 *configservice.ListResourceEvaluationsInput) (*configservice.ListResourceEvaluationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceEvaluations", arg0)
+	ret0, _ := ret[0].(*configservice.ListResourceEvaluationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceEvaluations indicates an expected call of ListResourceEvaluations
+func (mr *MockConfigServiceAPIMockRecorder) ListResourceEvaluations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceEvaluations", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListResourceEvaluations), arg0)
+}
+
+// ListResourceEvaluationsPages mocks base method
+func (m *MockConfigServiceAPI) ListResourceEvaluationsPages(arg0 *configservice.ListResourceEvaluationsInput, arg1 func(*configservice.ListResourceEvaluationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceEvaluationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceEvaluationsPages indicates an expected call of ListResourceEvaluationsPages
+func (mr *MockConfigServiceAPIMockRecorder) ListResourceEvaluationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceEvaluationsPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListResourceEvaluationsPages), arg0, arg1)
+}
+
+// ListResourceEvaluationsPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListResourceEvaluationsPagesWithContext(arg0 context.Context, arg1 *configservice.ListResourceEvaluationsInput, arg2 func(*configservice.ListResourceEvaluationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceEvaluationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceEvaluationsPagesWithContext indicates an expected call of ListResourceEvaluationsPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListResourceEvaluationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceEvaluationsPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListResourceEvaluationsPagesWithContext), varargs...)
+}
+
+// ListResourceEvaluationsRequest mocks base method
+func (m *MockConfigServiceAPI) ListResourceEvaluationsRequest(arg0 *configservice.ListResourceEvaluationsInput) (*request.Request, *configservice.ListResourceEvaluationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceEvaluationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.ListResourceEvaluationsOutput)
+	return ret0, ret1
+}
+
+// ListResourceEvaluationsRequest indicates an expected call of ListResourceEvaluationsRequest
+func (mr *MockConfigServiceAPIMockRecorder) ListResourceEvaluationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceEvaluationsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListResourceEvaluationsRequest), arg0)
+}
+
+// ListResourceEvaluationsWithContext mocks base method
+func (m *MockConfigServiceAPI) ListResourceEvaluationsWithContext(arg0 context.Context, arg1 *configservice.ListResourceEvaluationsInput, arg2 ...request.Option) (*configservice.ListResourceEvaluationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceEvaluationsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.ListResourceEvaluationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceEvaluationsWithContext indicates an expected call of ListResourceEvaluationsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListResourceEvaluationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceEvaluationsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListResourceEvaluationsWithContext), varargs...)
+}
+
+// ListStoredQueries mocks base method
+func (m *MockConfigServiceAPI) ListStoredQueries(arg0 *configservice.ListStoredQueriesInput) (*configservice.ListStoredQueriesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoredQueries", arg0)
+	ret0, _ := ret[0].(*configservice.ListStoredQueriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoredQueries indicates an expected call of ListStoredQueries
+func (mr *MockConfigServiceAPIMockRecorder) ListStoredQueries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredQueries", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListStoredQueries), arg0)
+}
+
+// ListStoredQueriesPages mocks base method
+func (m *MockConfigServiceAPI) ListStoredQueriesPages(arg0 *configservice.ListStoredQueriesInput, arg1 func(*configservice.ListStoredQueriesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoredQueriesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStoredQueriesPages indicates an expected call of ListStoredQueriesPages
+func (mr *MockConfigServiceAPIMockRecorder) ListStoredQueriesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredQueriesPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListStoredQueriesPages), arg0, arg1)
+}
+
+// ListStoredQueriesPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListStoredQueriesPagesWithContext(arg0 context.Context, arg1 *configservice.ListStoredQueriesInput, arg2 func(*configservice.ListStoredQueriesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoredQueriesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStoredQueriesPagesWithContext indicates an expected call of ListStoredQueriesPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListStoredQueriesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredQueriesPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListStoredQueriesPagesWithContext), varargs...)
+}
+
+// ListStoredQueriesRequest mocks base method
+func (m *MockConfigServiceAPI) ListStoredQueriesRequest(arg0 *configservice.ListStoredQueriesInput) (*request.Request, *configservice.ListStoredQueriesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoredQueriesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.ListStoredQueriesOutput)
+	return ret0, ret1
+}
+
+// ListStoredQueriesRequest indicates an expected call of ListStoredQueriesRequest
+func (mr *MockConfigServiceAPIMockRecorder) ListStoredQueriesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredQueriesRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListStoredQueriesRequest), arg0)
+}
+
+// ListStoredQueriesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListStoredQueriesWithContext(arg0 context.Context, arg1 *configservice.ListStoredQueriesInput, arg2 ...request.Option) (*configservice.ListStoredQueriesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoredQueriesWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.ListStoredQueriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoredQueriesWithContext indicates an expected call of ListStoredQueriesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListStoredQueriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredQueriesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListStoredQueriesWithContext), varargs...)
+}
+
+// ListTagsForResource mocks base method
+func (m *MockConfigServiceAPI) ListTagsForResource(arg0 *configservice.ListTagsForResourceInput) (*configservice.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResource", arg0)
+	ret0, _ := ret[0].(*configservice.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResource indicates an expected call of ListTagsForResource
+func (mr *MockConfigServiceAPIMockRecorder) ListTagsForResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListTagsForResource), arg0)
+}
+
+// ListTagsForResourcePages mocks base method
+func (m *MockConfigServiceAPI) ListTagsForResourcePages(arg0 *configservice.ListTagsForResourceInput, arg1 func(*configservice.ListTagsForResourceOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResourcePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTagsForResourcePages indicates an expected call of ListTagsForResourcePages
+func (mr *MockConfigServiceAPIMockRecorder) ListTagsForResourcePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourcePages", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListTagsForResourcePages), arg0, arg1)
+}
+
+// ListTagsForResourcePagesWithContext mocks base method
+func (m *MockConfigServiceAPI) ListTagsForResourcePagesWithContext(arg0 context.Context, arg1 *configservice.ListTagsForResourceInput, arg2 func(*configservice.ListTagsForResourceOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsForResourcePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTagsForResourcePagesWithContext indicates an expected call of ListTagsForResourcePagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListTagsForResourcePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourcePagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListTagsForResourcePagesWithContext), varargs...)
+}
+
+// ListTagsForResourceRequest mocks base method
+func (m *MockConfigServiceAPI) ListTagsForResourceRequest(arg0 *configservice.ListTagsForResourceInput) (*request.Request, *configservice.ListTagsForResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.ListTagsForResourceOutput)
+	return ret0, ret1
+}
+
+// ListTagsForResourceRequest indicates an expected call of ListTagsForResourceRequest
+func (mr *MockConfigServiceAPIMockRecorder) ListTagsForResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListTagsForResourceRequest), arg0)
+}
+
+// ListTagsForResourceWithContext mocks base method
+func (m *MockConfigServiceAPI) ListTagsForResourceWithContext(arg0 context.Context, arg1 *configservice.ListTagsForResourceInput, arg2 ...request.Option) (*configservice.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsForResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResourceWithContext indicates an expected call of ListTagsForResourceWithContext
+func (mr *MockConfigServiceAPIMockRecorder) ListTagsForResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).ListTagsForResourceWithContext), varargs...)
+}
+
+// PutAggregationAuthorization mocks base method
+func (m *MockConfigServiceAPI) PutAggregationAuthorization(arg0 *configservice.PutAggregationAuthorizationInput) (*configservice.PutAggregationAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAggregationAuthorization", arg0)
+	ret0, _ := ret[0].(*configservice.PutAggregationAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAggregationAuthorization indicates an expected call of PutAggregationAuthorization
+func (mr *MockConfigServiceAPIMockRecorder) PutAggregationAuthorization(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAggregationAuthorization", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutAggregationAuthorization), arg0)
+}
+
+// PutAggregationAuthorizationRequest mocks base method
+func (m *MockConfigServiceAPI) PutAggregationAuthorizationRequest(arg0 *configservice.PutAggregationAuthorizationInput) (*request.Request, *configservice.PutAggregationAuthorizationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutAggregationAuthorizationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutAggregationAuthorizationOutput)
+	return ret0, ret1
+}
+
+// PutAggregationAuthorizationRequest indicates an expected call of PutAggregationAuthorizationRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutAggregationAuthorizationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAggregationAuthorizationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutAggregationAuthorizationRequest), arg0)
+}
+
+// PutAggregationAuthorizationWithContext mocks base method
+func (m *MockConfigServiceAPI) PutAggregationAuthorizationWithContext(arg0 context.Context, arg1 *configservice.PutAggregationAuthorizationInput, arg2 ...request.Option) (*configservice.PutAggregationAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutAggregationAuthorizationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutAggregationAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutAggregationAuthorizationWithContext indicates an expected call of PutAggregationAuthorizationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutAggregationAuthorizationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAggregationAuthorizationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutAggregationAuthorizationWithContext), varargs...)
+}
+
+// PutConfigRule mocks base method
+func (m *MockConfigServiceAPI) PutConfigRule(arg0 *configservice.PutConfigRuleInput) (*configservice.PutConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.PutConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConfigRule indicates an expected call of PutConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigRule), arg0)
+}
+
+// PutConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) PutConfigRuleRequest(arg0 *configservice.PutConfigRuleInput) (*request.Request, *configservice.PutConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutConfigRuleOutput)
+	return ret0, ret1
+}
+
+// PutConfigRuleRequest indicates an expected call of PutConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigRuleRequest), arg0)
+}
+
+// PutConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) PutConfigRuleWithContext(arg0 context.Context, arg1 *configservice.PutConfigRuleInput, arg2 ...request.Option) (*configservice.PutConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConfigRuleWithContext indicates an expected call of PutConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigRuleWithContext), varargs...)
+}
+
+// PutConfigurationAggregator mocks base method
+func (m *MockConfigServiceAPI) PutConfigurationAggregator(arg0 *configservice.PutConfigurationAggregatorInput) (*configservice.PutConfigurationAggregatorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConfigurationAggregator", arg0)
+	ret0, _ := ret[0].(*configservice.PutConfigurationAggregatorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConfigurationAggregator indicates an expected call of PutConfigurationAggregator
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigurationAggregator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigurationAggregator", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigurationAggregator), arg0)
+}
+
+// PutConfigurationAggregatorRequest mocks base method
+func (m *MockConfigServiceAPI) PutConfigurationAggregatorRequest(arg0 *configservice.PutConfigurationAggregatorInput) (*request.Request, *configservice.PutConfigurationAggregatorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConfigurationAggregatorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutConfigurationAggregatorOutput)
+	return ret0, ret1
+}
+
+// PutConfigurationAggregatorRequest indicates an expected call of PutConfigurationAggregatorRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigurationAggregatorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigurationAggregatorRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigurationAggregatorRequest), arg0)
+}
+
+// PutConfigurationAggregatorWithContext mocks base method
+func (m *MockConfigServiceAPI) PutConfigurationAggregatorWithContext(arg0 context.Context, arg1 *configservice.PutConfigurationAggregatorInput, arg2 ...request.Option) (*configservice.PutConfigurationAggregatorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutConfigurationAggregatorWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutConfigurationAggregatorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConfigurationAggregatorWithContext indicates an expected call of PutConfigurationAggregatorWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigurationAggregatorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigurationAggregatorWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigurationAggregatorWithContext), varargs...)
+}
+
+// PutConfigurationRecorder mocks base method
+func (m *MockConfigServiceAPI) PutConfigurationRecorder(arg0 *configservice.PutConfigurationRecorderInput) (*configservice.PutConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConfigurationRecorder", arg0)
+	ret0, _ := ret[0].(*configservice.PutConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConfigurationRecorder indicates an expected call of PutConfigurationRecorder
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigurationRecorder(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigurationRecorder", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigurationRecorder), arg0)
+}
+
+// PutConfigurationRecorderRequest mocks base method
+func (m *MockConfigServiceAPI) PutConfigurationRecorderRequest(arg0 *configservice.PutConfigurationRecorderInput) (*request.Request, *configservice.PutConfigurationRecorderOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConfigurationRecorderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutConfigurationRecorderOutput)
+	return ret0, ret1
+}
+
+// PutConfigurationRecorderRequest indicates an expected call of PutConfigurationRecorderRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigurationRecorderRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigurationRecorderRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigurationRecorderRequest), arg0)
+}
+
+// PutConfigurationRecorderWithContext mocks base method
+func (m *MockConfigServiceAPI) PutConfigurationRecorderWithContext(arg0 context.Context, arg1 *configservice.PutConfigurationRecorderInput, arg2 ...request.Option) (*configservice.PutConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutConfigurationRecorderWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConfigurationRecorderWithContext indicates an expected call of PutConfigurationRecorderWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutConfigurationRecorderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConfigurationRecorderWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConfigurationRecorderWithContext), varargs...)
+}
+
+// PutConformancePack mocks base method
+func (m *MockConfigServiceAPI) PutConformancePack(arg0 *configservice.PutConformancePackInput) (*configservice.PutConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConformancePack", arg0)
+	ret0, _ := ret[0].(*configservice.PutConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConformancePack indicates an expected call of PutConformancePack
+func (mr *MockConfigServiceAPIMockRecorder) PutConformancePack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConformancePack", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConformancePack), arg0)
+}
+
+// PutConformancePackRequest mocks base method
+func (m *MockConfigServiceAPI) PutConformancePackRequest(arg0 *configservice.PutConformancePackInput) (*request.Request, *configservice.PutConformancePackOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConformancePackRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutConformancePackOutput)
+	return ret0, ret1
+}
+
+// PutConformancePackRequest indicates an expected call of PutConformancePackRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutConformancePackRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConformancePackRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConformancePackRequest), arg0)
+}
+
+// PutConformancePackWithContext mocks base method
+func (m *MockConfigServiceAPI) PutConformancePackWithContext(arg0 context.Context, arg1 *configservice.PutConformancePackInput, arg2 ...request.Option) (*configservice.PutConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutConformancePackWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConformancePackWithContext indicates an expected call of PutConformancePackWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutConformancePackWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConformancePackWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutConformancePackWithContext), varargs...)
+}
+
+// PutDeliveryChannel mocks base method
+func (m *MockConfigServiceAPI) PutDeliveryChannel(arg0 *configservice.PutDeliveryChannelInput) (*configservice.PutDeliveryChannelOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutDeliveryChannel", arg0)
+	ret0, _ := ret[0].(*configservice.PutDeliveryChannelOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutDeliveryChannel indicates an expected call of PutDeliveryChannel
+func (mr *MockConfigServiceAPIMockRecorder) PutDeliveryChannel(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryChannel", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutDeliveryChannel), arg0)
+}
+
+// PutDeliveryChannelRequest mocks base method
+func (m *MockConfigServiceAPI) PutDeliveryChannelRequest(arg0 *configservice.PutDeliveryChannelInput) (*request.Request, *configservice.PutDeliveryChannelOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutDeliveryChannelRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutDeliveryChannelOutput)
+	return ret0, ret1
+}
+
+// PutDeliveryChannelRequest indicates an expected call of PutDeliveryChannelRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutDeliveryChannelRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryChannelRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutDeliveryChannelRequest), arg0)
+}
+
+// PutDeliveryChannelWithContext mocks base method
+func (m *MockConfigServiceAPI) PutDeliveryChannelWithContext(arg0 context.Context, arg1 *configservice.PutDeliveryChannelInput, arg2 ...request.Option) (*configservice.PutDeliveryChannelOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutDeliveryChannelWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutDeliveryChannelOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutDeliveryChannelWithContext indicates an expected call of PutDeliveryChannelWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutDeliveryChannelWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDeliveryChannelWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutDeliveryChannelWithContext), varargs...)
+}
+
+// PutEvaluations mocks base method
+func (m *MockConfigServiceAPI) PutEvaluations(arg0 *configservice.PutEvaluationsInput) (*configservice.PutEvaluationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutEvaluations", arg0)
+	ret0, _ := ret[0].(*configservice.PutEvaluationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEvaluations indicates an expected call of PutEvaluations
+func (mr *MockConfigServiceAPIMockRecorder) PutEvaluations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEvaluations", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutEvaluations), arg0)
+}
+
+// PutEvaluationsRequest mocks base method
+func (m *MockConfigServiceAPI) PutEvaluationsRequest(arg0 *configservice.PutEvaluationsInput) (*request.Request, *configservice.PutEvaluationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutEvaluationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutEvaluationsOutput)
+	return ret0, ret1
+}
+
+// PutEvaluationsRequest indicates an expected call of PutEvaluationsRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutEvaluationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEvaluationsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutEvaluationsRequest), arg0)
+}
+
+// PutEvaluationsWithContext mocks base method
+func (m *MockConfigServiceAPI) PutEvaluationsWithContext(arg0 context.Context, arg1 *configservice.PutEvaluationsInput, arg2 ...request.Option) (*configservice.PutEvaluationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutEvaluationsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutEvaluationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEvaluationsWithContext indicates an expected call of PutEvaluationsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutEvaluationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEvaluationsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutEvaluationsWithContext), varargs...)
+}
+
+// PutExternalEvaluation mocks base method
+func (m *MockConfigServiceAPI) PutExternalEvaluation(arg0 *configservice.PutExternalEvaluationInput) (*configservice.PutExternalEvaluationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutExternalEvaluation", arg0)
+	ret0, _ := ret[0].(*configservice.PutExternalEvaluationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutExternalEvaluation indicates an expected call of PutExternalEvaluation
+func (mr *MockConfigServiceAPIMockRecorder) PutExternalEvaluation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutExternalEvaluation", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutExternalEvaluation), arg0)
+}
+
+// PutExternalEvaluationRequest mocks base method
+func (m *MockConfigServiceAPI) PutExternalEvaluationRequest(arg0 *configservice.PutExternalEvaluationInput) (*request.Request, *configservice.PutExternalEvaluationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutExternalEvaluationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutExternalEvaluationOutput)
+	return ret0, ret1
+}
+
+// PutExternalEvaluationRequest indicates an expected call of PutExternalEvaluationRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutExternalEvaluationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutExternalEvaluationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutExternalEvaluationRequest), arg0)
+}
+
+// PutExternalEvaluationWithContext mocks base method
+func (m *MockConfigServiceAPI) PutExternalEvaluationWithContext(arg0 context.Context, arg1 *configservice.PutExternalEvaluationInput, arg2 ...request.Option) (*configservice.PutExternalEvaluationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutExternalEvaluationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutExternalEvaluationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutExternalEvaluationWithContext indicates an expected call of PutExternalEvaluationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutExternalEvaluationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutExternalEvaluationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutExternalEvaluationWithContext), varargs...)
+}
+
+// PutOrganizationConfigRule mocks base method
+func (m *MockConfigServiceAPI) PutOrganizationConfigRule(arg0 *configservice.PutOrganizationConfigRuleInput) (*configservice.PutOrganizationConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutOrganizationConfigRule", arg0)
+	ret0, _ := ret[0].(*configservice.PutOrganizationConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutOrganizationConfigRule indicates an expected call of PutOrganizationConfigRule
+func (mr *MockConfigServiceAPIMockRecorder) PutOrganizationConfigRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrganizationConfigRule", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutOrganizationConfigRule), arg0)
+}
+
+// PutOrganizationConfigRuleRequest mocks base method
+func (m *MockConfigServiceAPI) PutOrganizationConfigRuleRequest(arg0 *configservice.PutOrganizationConfigRuleInput) (*request.Request, *configservice.PutOrganizationConfigRuleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutOrganizationConfigRuleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutOrganizationConfigRuleOutput)
+	return ret0, ret1
+}
+
+// PutOrganizationConfigRuleRequest indicates an expected call of PutOrganizationConfigRuleRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutOrganizationConfigRuleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrganizationConfigRuleRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutOrganizationConfigRuleRequest), arg0)
+}
+
+// PutOrganizationConfigRuleWithContext mocks base method
+func (m *MockConfigServiceAPI) PutOrganizationConfigRuleWithContext(arg0 context.Context, arg1 *configservice.PutOrganizationConfigRuleInput, arg2 ...request.Option) (*configservice.PutOrganizationConfigRuleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutOrganizationConfigRuleWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutOrganizationConfigRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutOrganizationConfigRuleWithContext indicates an expected call of PutOrganizationConfigRuleWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutOrganizationConfigRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrganizationConfigRuleWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutOrganizationConfigRuleWithContext), varargs...)
+}
+
+// PutOrganizationConformancePack mocks base method
+func (m *MockConfigServiceAPI) PutOrganizationConformancePack(arg0 *configservice.PutOrganizationConformancePackInput) (*configservice.PutOrganizationConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutOrganizationConformancePack", arg0)
+	ret0, _ := ret[0].(*configservice.PutOrganizationConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutOrganizationConformancePack indicates an expected call of PutOrganizationConformancePack
+func (mr *MockConfigServiceAPIMockRecorder) PutOrganizationConformancePack(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrganizationConformancePack", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutOrganizationConformancePack), arg0)
+}
+
+// PutOrganizationConformancePackRequest mocks base method
+func (m *MockConfigServiceAPI) PutOrganizationConformancePackRequest(arg0 *configservice.PutOrganizationConformancePackInput) (*request.Request, *configservice.PutOrganizationConformancePackOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutOrganizationConformancePackRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutOrganizationConformancePackOutput)
+	return ret0, ret1
+}
+
+// PutOrganizationConformancePackRequest indicates an expected call of PutOrganizationConformancePackRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutOrganizationConformancePackRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrganizationConformancePackRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutOrganizationConformancePackRequest), arg0)
+}
+
+// PutOrganizationConformancePackWithContext mocks base method
+func (m *MockConfigServiceAPI) PutOrganizationConformancePackWithContext(arg0 context.Context, arg1 *configservice.PutOrganizationConformancePackInput, arg2 ...request.Option) (*configservice.PutOrganizationConformancePackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutOrganizationConformancePackWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutOrganizationConformancePackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutOrganizationConformancePackWithContext indicates an expected call of PutOrganizationConformancePackWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutOrganizationConformancePackWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutOrganizationConformancePackWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutOrganizationConformancePackWithContext), varargs...)
+}
+
+// PutRemediationConfigurations mocks base method
+func (m *MockConfigServiceAPI) PutRemediationConfigurations(arg0 *configservice.PutRemediationConfigurationsInput) (*configservice.PutRemediationConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRemediationConfigurations", arg0)
+	ret0, _ := ret[0].(*configservice.PutRemediationConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRemediationConfigurations indicates an expected call of PutRemediationConfigurations
+func (mr *MockConfigServiceAPIMockRecorder) PutRemediationConfigurations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRemediationConfigurations", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRemediationConfigurations), arg0)
+}
+
+// PutRemediationConfigurationsRequest mocks base method
+func (m *MockConfigServiceAPI) PutRemediationConfigurationsRequest(arg0 *configservice.PutRemediationConfigurationsInput) (*request.Request, *configservice.PutRemediationConfigurationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRemediationConfigurationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutRemediationConfigurationsOutput)
+	return ret0, ret1
+}
+
+// PutRemediationConfigurationsRequest indicates an expected call of PutRemediationConfigurationsRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutRemediationConfigurationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRemediationConfigurationsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRemediationConfigurationsRequest), arg0)
+}
+
+// PutRemediationConfigurationsWithContext mocks base method
+func (m *MockConfigServiceAPI) PutRemediationConfigurationsWithContext(arg0 context.Context, arg1 *configservice.PutRemediationConfigurationsInput, arg2 ...request.Option) (*configservice.PutRemediationConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRemediationConfigurationsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutRemediationConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRemediationConfigurationsWithContext indicates an expected call of PutRemediationConfigurationsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutRemediationConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRemediationConfigurationsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRemediationConfigurationsWithContext), varargs...)
+}
+
+// PutRemediationExceptions mocks base method
+func (m *MockConfigServiceAPI) PutRemediationExceptions(arg0 *configservice.PutRemediationExceptionsInput) (*configservice.PutRemediationExceptionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRemediationExceptions", arg0)
+	ret0, _ := ret[0].(*configservice.PutRemediationExceptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRemediationExceptions indicates an expected call of PutRemediationExceptions
+func (mr *MockConfigServiceAPIMockRecorder) PutRemediationExceptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRemediationExceptions", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRemediationExceptions), arg0)
+}
+
+// PutRemediationExceptionsRequest mocks base method
+func (m *MockConfigServiceAPI) PutRemediationExceptionsRequest(arg0 *configservice.PutRemediationExceptionsInput) (*request.Request, *configservice.PutRemediationExceptionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRemediationExceptionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutRemediationExceptionsOutput)
+	return ret0, ret1
+}
+
+// PutRemediationExceptionsRequest indicates an expected call of PutRemediationExceptionsRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutRemediationExceptionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRemediationExceptionsRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRemediationExceptionsRequest), arg0)
+}
+
+// PutRemediationExceptionsWithContext mocks base method
+func (m *MockConfigServiceAPI) PutRemediationExceptionsWithContext(arg0 context.Context, arg1 *configservice.PutRemediationExceptionsInput, arg2 ...request.Option) (*configservice.PutRemediationExceptionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRemediationExceptionsWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutRemediationExceptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRemediationExceptionsWithContext indicates an expected call of PutRemediationExceptionsWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutRemediationExceptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRemediationExceptionsWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRemediationExceptionsWithContext), varargs...)
+}
+
+// PutResourceConfig mocks base method
+func (m *MockConfigServiceAPI) PutResourceConfig(arg0 *configservice.PutResourceConfigInput) (*configservice.PutResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.PutResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutResourceConfig indicates an expected call of PutResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) PutResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutResourceConfig), arg0)
+}
+
+// PutResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) PutResourceConfigRequest(arg0 *configservice.PutResourceConfigInput) (*request.Request, *configservice.PutResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutResourceConfigOutput)
+	return ret0, ret1
+}
+
+// PutResourceConfigRequest indicates an expected call of PutResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutResourceConfigRequest), arg0)
+}
+
+// PutResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) PutResourceConfigWithContext(arg0 context.Context, arg1 *configservice.PutResourceConfigInput, arg2 ...request.Option) (*configservice.PutResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutResourceConfigWithContext indicates an expected call of PutResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutResourceConfigWithContext), varargs...)
+}
+
+// PutRetentionConfiguration mocks base method
+func (m *MockConfigServiceAPI) PutRetentionConfiguration(arg0 *configservice.PutRetentionConfigurationInput) (*configservice.PutRetentionConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRetentionConfiguration", arg0)
+	ret0, _ := ret[0].(*configservice.PutRetentionConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRetentionConfiguration indicates an expected call of PutRetentionConfiguration
+func (mr *MockConfigServiceAPIMockRecorder) PutRetentionConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRetentionConfiguration", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRetentionConfiguration), arg0)
+}
+
+// PutRetentionConfigurationRequest mocks base method
+func (m *MockConfigServiceAPI) PutRetentionConfigurationRequest(arg0 *configservice.PutRetentionConfigurationInput) (*request.Request, *configservice.PutRetentionConfigurationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRetentionConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutRetentionConfigurationOutput)
+	return ret0, ret1
+}
+
+// PutRetentionConfigurationRequest indicates an expected call of PutRetentionConfigurationRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutRetentionConfigurationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRetentionConfigurationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRetentionConfigurationRequest), arg0)
+}
+
+// PutRetentionConfigurationWithContext mocks base method
+func (m *MockConfigServiceAPI) PutRetentionConfigurationWithContext(arg0 context.Context, arg1 *configservice.PutRetentionConfigurationInput, arg2 ...request.Option) (*configservice.PutRetentionConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRetentionConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutRetentionConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRetentionConfigurationWithContext indicates an expected call of PutRetentionConfigurationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutRetentionConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRetentionConfigurationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutRetentionConfigurationWithContext), varargs...)
+}
+
+// PutStoredQuery mocks base method
+func (m *MockConfigServiceAPI) PutStoredQuery(arg0 *configservice.PutStoredQueryInput) (*configservice.PutStoredQueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutStoredQuery", arg0)
+	ret0, _ := ret[0].(*configservice.PutStoredQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutStoredQuery indicates an expected call of PutStoredQuery
+func (mr *MockConfigServiceAPIMockRecorder) PutStoredQuery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStoredQuery", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutStoredQuery), arg0)
+}
+
+// PutStoredQueryRequest mocks base method
+func (m *MockConfigServiceAPI) PutStoredQueryRequest(arg0 *configservice.PutStoredQueryInput) (*request.Request, *configservice.PutStoredQueryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutStoredQueryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.PutStoredQueryOutput)
+	return ret0, ret1
+}
+
+// PutStoredQueryRequest indicates an expected call of PutStoredQueryRequest
+func (mr *MockConfigServiceAPIMockRecorder) PutStoredQueryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStoredQueryRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutStoredQueryRequest), arg0)
+}
+
+// PutStoredQueryWithContext mocks base method
+func (m *MockConfigServiceAPI) PutStoredQueryWithContext(arg0 context.Context, arg1 *configservice.PutStoredQueryInput, arg2 ...request.Option) (*configservice.PutStoredQueryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutStoredQueryWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.PutStoredQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutStoredQueryWithContext indicates an expected call of PutStoredQueryWithContext
+func (mr *MockConfigServiceAPIMockRecorder) PutStoredQueryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStoredQueryWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).PutStoredQueryWithContext), varargs...)
+}
+
+// SelectAggregateResourceConfig mocks base method
+func (m *MockConfigServiceAPI) SelectAggregateResourceConfig(arg0 *configservice.SelectAggregateResourceConfigInput) (*configservice.SelectAggregateResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAggregateResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.SelectAggregateResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAggregateResourceConfig indicates an expected call of SelectAggregateResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) SelectAggregateResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAggregateResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectAggregateResourceConfig), arg0)
+}
+
+// SelectAggregateResourceConfigPages mocks base method
+func (m *MockConfigServiceAPI) SelectAggregateResourceConfigPages(arg0 *configservice.SelectAggregateResourceConfigInput, arg1 func(*configservice.SelectAggregateResourceConfigOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAggregateResourceConfigPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectAggregateResourceConfigPages indicates an expected call of SelectAggregateResourceConfigPages
+func (mr *MockConfigServiceAPIMockRecorder) SelectAggregateResourceConfigPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAggregateResourceConfigPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectAggregateResourceConfigPages), arg0, arg1)
+}
+
+// SelectAggregateResourceConfigPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) SelectAggregateResourceConfigPagesWithContext(arg0 context.Context, arg1 *configservice.SelectAggregateResourceConfigInput, arg2 func(*configservice.SelectAggregateResourceConfigOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectAggregateResourceConfigPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectAggregateResourceConfigPagesWithContext indicates an expected call of SelectAggregateResourceConfigPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) SelectAggregateResourceConfigPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAggregateResourceConfigPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectAggregateResourceConfigPagesWithContext), varargs...)
+}
+
+// SelectAggregateResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) SelectAggregateResourceConfigRequest(arg0 *configservice.SelectAggregateResourceConfigInput) (*request.Request, *configservice.SelectAggregateResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAggregateResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.SelectAggregateResourceConfigOutput)
+	return ret0, ret1
+}
+
+// SelectAggregateResourceConfigRequest indicates an expected call of SelectAggregateResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) SelectAggregateResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAggregateResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectAggregateResourceConfigRequest), arg0)
+}
+
+// SelectAggregateResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) SelectAggregateResourceConfigWithContext(arg0 context.Context, arg1 *configservice.SelectAggregateResourceConfigInput, arg2 ...request.Option) (*configservice.SelectAggregateResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectAggregateResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.SelectAggregateResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAggregateResourceConfigWithContext indicates an expected call of SelectAggregateResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) SelectAggregateResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAggregateResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectAggregateResourceConfigWithContext), varargs...)
+}
+
+// SelectResourceConfig mocks base method
+func (m *MockConfigServiceAPI) SelectResourceConfig(arg0 *configservice.SelectResourceConfigInput) (*configservice.SelectResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectResourceConfig", arg0)
+	ret0, _ := ret[0].(*configservice.SelectResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectResourceConfig indicates an expected call of SelectResourceConfig
+func (mr *MockConfigServiceAPIMockRecorder) SelectResourceConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectResourceConfig", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectResourceConfig), arg0)
+}
+
+// SelectResourceConfigPages mocks base method
+func (m *MockConfigServiceAPI) SelectResourceConfigPages(arg0 *configservice.SelectResourceConfigInput, arg1 func(*configservice.SelectResourceConfigOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectResourceConfigPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectResourceConfigPages indicates an expected call of SelectResourceConfigPages
+func (mr *MockConfigServiceAPIMockRecorder) SelectResourceConfigPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectResourceConfigPages", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectResourceConfigPages), arg0, arg1)
+}
+
+// SelectResourceConfigPagesWithContext mocks base method
+func (m *MockConfigServiceAPI) SelectResourceConfigPagesWithContext(arg0 context.Context, arg1 *configservice.SelectResourceConfigInput, arg2 func(*configservice.SelectResourceConfigOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectResourceConfigPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectResourceConfigPagesWithContext indicates an expected call of SelectResourceConfigPagesWithContext
+func (mr *MockConfigServiceAPIMockRecorder) SelectResourceConfigPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectResourceConfigPagesWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectResourceConfigPagesWithContext), varargs...)
+}
+
+// SelectResourceConfigRequest mocks base method
+func (m *MockConfigServiceAPI) SelectResourceConfigRequest(arg0 *configservice.SelectResourceConfigInput) (*request.Request, *configservice.SelectResourceConfigOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectResourceConfigRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.SelectResourceConfigOutput)
+	return ret0, ret1
+}
+
+// SelectResourceConfigRequest indicates an expected call of SelectResourceConfigRequest
+func (mr *MockConfigServiceAPIMockRecorder) SelectResourceConfigRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectResourceConfigRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectResourceConfigRequest), arg0)
+}
+
+// SelectResourceConfigWithContext mocks base method
+func (m *MockConfigServiceAPI) SelectResourceConfigWithContext(arg0 context.Context, arg1 *configservice.SelectResourceConfigInput, arg2 ...request.Option) (*configservice.SelectResourceConfigOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectResourceConfigWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.SelectResourceConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectResourceConfigWithContext indicates an expected call of SelectResourceConfigWithContext
+func (mr *MockConfigServiceAPIMockRecorder) SelectResourceConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectResourceConfigWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).SelectResourceConfigWithContext), varargs...)
+}
+
+// StartConfigRulesEvaluation mocks base method
+func (m *MockConfigServiceAPI) StartConfigRulesEvaluation(arg0 *configservice.StartConfigRulesEvaluationInput) (*configservice.StartConfigRulesEvaluationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConfigRulesEvaluation", arg0)
+	ret0, _ := ret[0].(*configservice.StartConfigRulesEvaluationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConfigRulesEvaluation indicates an expected call of StartConfigRulesEvaluation
+func (mr *MockConfigServiceAPIMockRecorder) StartConfigRulesEvaluation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConfigRulesEvaluation", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartConfigRulesEvaluation), arg0)
+}
+
+// StartConfigRulesEvaluationRequest mocks base method
+func (m *MockConfigServiceAPI) StartConfigRulesEvaluationRequest(arg0 *configservice.StartConfigRulesEvaluationInput) (*request.Request, *configservice.StartConfigRulesEvaluationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConfigRulesEvaluationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.StartConfigRulesEvaluationOutput)
+	return ret0, ret1
+}
+
+// StartConfigRulesEvaluationRequest indicates an expected call of StartConfigRulesEvaluationRequest
+func (mr *MockConfigServiceAPIMockRecorder) StartConfigRulesEvaluationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConfigRulesEvaluationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartConfigRulesEvaluationRequest), arg0)
+}
+
+// StartConfigRulesEvaluationWithContext mocks base method
+func (m *MockConfigServiceAPI) StartConfigRulesEvaluationWithContext(arg0 context.Context, arg1 *configservice.StartConfigRulesEvaluationInput, arg2 ...request.Option) (*configservice.StartConfigRulesEvaluationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartConfigRulesEvaluationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.StartConfigRulesEvaluationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConfigRulesEvaluationWithContext indicates an expected call of StartConfigRulesEvaluationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) StartConfigRulesEvaluationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConfigRulesEvaluationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartConfigRulesEvaluationWithContext), varargs...)
+}
+
+// StartConfigurationRecorder mocks base method
+func (m *MockConfigServiceAPI) StartConfigurationRecorder(arg0 *configservice.StartConfigurationRecorderInput) (*configservice.StartConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConfigurationRecorder", arg0)
+	ret0, _ := ret[0].(*configservice.StartConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConfigurationRecorder indicates an expected call of StartConfigurationRecorder
+func (mr *MockConfigServiceAPIMockRecorder) StartConfigurationRecorder(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConfigurationRecorder", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartConfigurationRecorder), arg0)
+}
+
+// StartConfigurationRecorderRequest mocks base method
+func (m *MockConfigServiceAPI) StartConfigurationRecorderRequest(arg0 *configservice.StartConfigurationRecorderInput) (*request.Request, *configservice.StartConfigurationRecorderOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConfigurationRecorderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.StartConfigurationRecorderOutput)
+	return ret0, ret1
+}
+
+// StartConfigurationRecorderRequest indicates an expected call of StartConfigurationRecorderRequest
+func (mr *MockConfigServiceAPIMockRecorder) StartConfigurationRecorderRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConfigurationRecorderRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartConfigurationRecorderRequest), arg0)
+}
+
+// StartConfigurationRecorderWithContext mocks base method
+func (m *MockConfigServiceAPI) StartConfigurationRecorderWithContext(arg0 context.Context, arg1 *configservice.StartConfigurationRecorderInput, arg2 ...request.Option) (*configservice.StartConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartConfigurationRecorderWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.StartConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConfigurationRecorderWithContext indicates an expected call of StartConfigurationRecorderWithContext
+func (mr *MockConfigServiceAPIMockRecorder) StartConfigurationRecorderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConfigurationRecorderWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartConfigurationRecorderWithContext), varargs...)
+}
+
+// StartRemediationExecution mocks base method
+func (m *MockConfigServiceAPI) StartRemediationExecution(arg0 *configservice.StartRemediationExecutionInput) (*configservice.StartRemediationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRemediationExecution", arg0)
+	ret0, _ := ret[0].(*configservice.StartRemediationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRemediationExecution indicates an expected call of StartRemediationExecution
+func (mr *MockConfigServiceAPIMockRecorder) StartRemediationExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRemediationExecution", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartRemediationExecution), arg0)
+}
+
+// StartRemediationExecutionRequest mocks base method
+func (m *MockConfigServiceAPI) StartRemediationExecutionRequest(arg0 *configservice.StartRemediationExecutionInput) (*request.Request, *configservice.StartRemediationExecutionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRemediationExecutionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.StartRemediationExecutionOutput)
+	return ret0, ret1
+}
+
+// StartRemediationExecutionRequest indicates an expected call of StartRemediationExecutionRequest
+func (mr *MockConfigServiceAPIMockRecorder) StartRemediationExecutionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRemediationExecutionRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartRemediationExecutionRequest), arg0)
+}
+
+// StartRemediationExecutionWithContext mocks base method
+func (m *MockConfigServiceAPI) StartRemediationExecutionWithContext(arg0 context.Context, arg1 *configservice.StartRemediationExecutionInput, arg2 ...request.Option) (*configservice.StartRemediationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartRemediationExecutionWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.StartRemediationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRemediationExecutionWithContext indicates an expected call of StartRemediationExecutionWithContext
+func (mr *MockConfigServiceAPIMockRecorder) StartRemediationExecutionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRemediationExecutionWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartRemediationExecutionWithContext), varargs...)
+}
+
+// StartResourceEvaluation mocks base method
+func (m *MockConfigServiceAPI) StartResourceEvaluation(arg0 *configservice.StartResourceEvaluationInput) (*configservice.StartResourceEvaluationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartResourceEvaluation", arg0)
+	ret0, _ := ret[0].(*configservice.StartResourceEvaluationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartResourceEvaluation indicates an expected call of StartResourceEvaluation
+func (mr *MockConfigServiceAPIMockRecorder) StartResourceEvaluation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResourceEvaluation", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartResourceEvaluation), arg0)
+}
+
+// StartResourceEvaluationRequest mocks base method
+func (m *MockConfigServiceAPI) StartResourceEvaluationRequest(arg0 *configservice.StartResourceEvaluationInput) (*request.Request, *configservice.StartResourceEvaluationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartResourceEvaluationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.StartResourceEvaluationOutput)
+	return ret0, ret1
+}
+
+// StartResourceEvaluationRequest indicates an expected call of StartResourceEvaluationRequest
+func (mr *MockConfigServiceAPIMockRecorder) StartResourceEvaluationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResourceEvaluationRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartResourceEvaluationRequest), arg0)
+}
+
+// StartResourceEvaluationWithContext mocks base method
+func (m *MockConfigServiceAPI) StartResourceEvaluationWithContext(arg0 context.Context, arg1 *configservice.StartResourceEvaluationInput, arg2 ...request.Option) (*configservice.StartResourceEvaluationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartResourceEvaluationWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.StartResourceEvaluationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartResourceEvaluationWithContext indicates an expected call of StartResourceEvaluationWithContext
+func (mr *MockConfigServiceAPIMockRecorder) StartResourceEvaluationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResourceEvaluationWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).StartResourceEvaluationWithContext), varargs...)
+}
+
+// StopConfigurationRecorder mocks base method
+func (m *MockConfigServiceAPI) StopConfigurationRecorder(arg0 *configservice.StopConfigurationRecorderInput) (*configservice.StopConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopConfigurationRecorder", arg0)
+	ret0, _ := ret[0].(*configservice.StopConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopConfigurationRecorder indicates an expected call of StopConfigurationRecorder
+func (mr *MockConfigServiceAPIMockRecorder) StopConfigurationRecorder(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopConfigurationRecorder", reflect.TypeOf((*MockConfigServiceAPI)(nil).StopConfigurationRecorder), arg0)
+}
+
+// StopConfigurationRecorderRequest mocks base method
+func (m *MockConfigServiceAPI) StopConfigurationRecorderRequest(arg0 *configservice.StopConfigurationRecorderInput) (*request.Request, *configservice.StopConfigurationRecorderOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopConfigurationRecorderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.StopConfigurationRecorderOutput)
+	return ret0, ret1
+}
+
+// StopConfigurationRecorderRequest indicates an expected call of StopConfigurationRecorderRequest
+func (mr *MockConfigServiceAPIMockRecorder) StopConfigurationRecorderRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopConfigurationRecorderRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).StopConfigurationRecorderRequest), arg0)
+}
+
+// StopConfigurationRecorderWithContext mocks base method
+func (m *MockConfigServiceAPI) StopConfigurationRecorderWithContext(arg0 context.Context, arg1 *configservice.StopConfigurationRecorderInput, arg2 ...request.Option) (*configservice.StopConfigurationRecorderOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopConfigurationRecorderWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.StopConfigurationRecorderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopConfigurationRecorderWithContext indicates an expected call of StopConfigurationRecorderWithContext
+func (mr *MockConfigServiceAPIMockRecorder) StopConfigurationRecorderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopConfigurationRecorderWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).StopConfigurationRecorderWithContext), varargs...)
+}
+
+// TagResource mocks base method
+func (m *MockConfigServiceAPI) TagResource(arg0 *configservice.TagResourceInput) (*configservice.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResource", arg0)
+	ret0, _ := ret[0].(*configservice.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResource indicates an expected call of TagResource
+func (mr *MockConfigServiceAPIMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockConfigServiceAPI)(nil).TagResource), arg0)
+}
+
+// TagResourceRequest mocks base method
+func (m *MockConfigServiceAPI) TagResourceRequest(arg0 *configservice.TagResourceInput) (*request.Request, *configservice.TagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.TagResourceOutput)
+	return ret0, ret1
+}
+
+// TagResourceRequest indicates an expected call of TagResourceRequest
+func (mr *MockConfigServiceAPIMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).TagResourceRequest), arg0)
+}
+
+// TagResourceWithContext mocks base method
+func (m *MockConfigServiceAPI) TagResourceWithContext(arg0 context.Context, arg1 *configservice.TagResourceInput, arg2 ...request.Option) (*configservice.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResourceWithContext indicates an expected call of TagResourceWithContext
+func (mr *MockConfigServiceAPIMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).TagResourceWithContext), varargs...)
+}
+
+// UntagResource mocks base method
+func (m *MockConfigServiceAPI) UntagResource(arg0 *configservice.UntagResourceInput) (*configservice.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResource", arg0)
+	ret0, _ := ret[0].(*configservice.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResource indicates an expected call of UntagResource
+func (mr *MockConfigServiceAPIMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockConfigServiceAPI)(nil).UntagResource), arg0)
+}
+
+// UntagResourceRequest mocks base method
+func (m *MockConfigServiceAPI) UntagResourceRequest(arg0 *configservice.UntagResourceInput) (*request.Request, *configservice.UntagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*configservice.UntagResourceOutput)
+	return ret0, ret1
+}
+
+// UntagResourceRequest indicates an expected call of UntagResourceRequest
+func (mr *MockConfigServiceAPIMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockConfigServiceAPI)(nil).UntagResourceRequest), arg0)
+}
+
+// UntagResourceWithContext mocks base method
+func (m *MockConfigServiceAPI) UntagResourceWithContext(arg0 context.Context, arg1 *configservice.UntagResourceInput, arg2 ...request.Option) (*configservice.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*configservice.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResourceWithContext indicates an expected call of UntagResourceWithContext
+func (mr *MockConfigServiceAPIMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockConfigServiceAPI)(nil).UntagResourceWithContext), varargs...)
+}
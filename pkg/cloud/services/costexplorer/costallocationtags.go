@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costexplorer
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// ActivateCostAllocationTags activates the cost allocation tags of the cluster which are not active yet.
+// Billing only lists the keys of tags it has seen on resources, so the activation fails for keys which
+// are not known yet, once the known ones are activated.
+func (s *Service) ActivateCostAllocationTags() error {
+	keys := s.scope.CostAllocationTags()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	statuses := map[string]string{}
+	err := s.scope.CostExplorer.ListCostAllocationTagsPages(&costexplorer.ListCostAllocationTagsInput{
+		TagKeys: aws.StringSlice(keys),
+		Type:    aws.String(costexplorer.CostAllocationTagTypeUserDefined),
+	}, func(out *costexplorer.ListCostAllocationTagsOutput, lastPage bool) bool {
+		for _, tag := range out.CostAllocationTags {
+			statuses[aws.StringValue(tag.TagKey)] = aws.StringValue(tag.Status)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list cost allocation tags")
+	}
+
+	var inactive, unknown []string
+	for _, key := range keys {
+		status, ok := statuses[key]
+		switch {
+		case !ok:
+			unknown = append(unknown, key)
+		case status != costexplorer.CostAllocationTagStatusActive:
+			inactive = append(inactive, key)
+		}
+	}
+
+	if len(inactive) > 0 {
+		if err := s.updateCostAllocationTagsStatus(inactive); err != nil {
+			return err
+		}
+	}
+
+	if len(unknown) > 0 {
+		return errors.Errorf("cost allocation tags %s are not known to billing yet", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func (s *Service) updateCostAllocationTagsStatus(keys []string) error {
+	entries := make([]*costexplorer.CostAllocationTagStatusEntry, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, &costexplorer.CostAllocationTagStatusEntry{
+			TagKey: aws.String(key),
+			Status: aws.String(costexplorer.CostAllocationTagStatusActive),
+		})
+	}
+
+	out, err := s.scope.CostExplorer.UpdateCostAllocationTagsStatus(&costexplorer.UpdateCostAllocationTagsStatusInput{
+		CostAllocationTagsStatus: entries,
+	})
+	if err == nil && len(out.Errors) > 0 {
+		err = errors.Errorf("%s: %s", aws.StringValue(out.Errors[0].TagKey), aws.StringValue(out.Errors[0].Message))
+	}
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedActivateCostAllocationTags", "Failed to activate cost allocation tags %s: %v", strings.Join(keys, ", "), err)
+		return errors.Wrapf(err, "failed to activate cost allocation tags %s", strings.Join(keys, ", "))
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulActivateCostAllocationTags", "Activated cost allocation tags %s", strings.Join(keys, ", "))
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costexplorer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/costexplorer/mock_costexploreriface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func expectListCostAllocationTags(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder, keys []string, tags ...*costexplorer.CostAllocationTag) {
+	m.ListCostAllocationTagsPages(gomock.Eq(&costexplorer.ListCostAllocationTagsInput{
+		TagKeys: aws.StringSlice(keys),
+		Type:    aws.String(costexplorer.CostAllocationTagTypeUserDefined),
+	}), gomock.Any()).
+		Do(func(_ *costexplorer.ListCostAllocationTagsInput, fn func(*costexplorer.ListCostAllocationTagsOutput, bool) bool) {
+			fn(&costexplorer.ListCostAllocationTagsOutput{CostAllocationTags: tags}, true)
+		}).
+		Return(nil)
+}
+
+func costAllocationTag(key, status string) *costexplorer.CostAllocationTag {
+	return &costexplorer.CostAllocationTag{
+		TagKey: aws.String(key),
+		Status: aws.String(status),
+		Type:   aws.String(costexplorer.CostAllocationTagTypeUserDefined),
+	}
+}
+
+func TestActivateCostAllocationTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name      string
+		keys      []string
+		expect    func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:   "no cost allocation tags",
+			expect: func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder) {},
+		},
+		{
+			name: "activates the inactive tags",
+			keys: []string{"team", "node", "cost-center"},
+			expect: func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder) {
+				expectListCostAllocationTags(m, []string{"team", "node", "cost-center"},
+					costAllocationTag("team", costexplorer.CostAllocationTagStatusActive),
+					costAllocationTag("node", costexplorer.CostAllocationTagStatusInactive),
+					costAllocationTag("cost-center", costexplorer.CostAllocationTagStatusInactive),
+				)
+				m.UpdateCostAllocationTagsStatus(gomock.Eq(&costexplorer.UpdateCostAllocationTagsStatusInput{
+					CostAllocationTagsStatus: []*costexplorer.CostAllocationTagStatusEntry{
+						{TagKey: aws.String("node"), Status: aws.String(costexplorer.CostAllocationTagStatusActive)},
+						{TagKey: aws.String("cost-center"), Status: aws.String(costexplorer.CostAllocationTagStatusActive)},
+					},
+				})).Return(&costexplorer.UpdateCostAllocationTagsStatusOutput{}, nil)
+			},
+		},
+		{
+			name: "all tags already active",
+			keys: []string{"team"},
+			expect: func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder) {
+				expectListCostAllocationTags(m, []string{"team"},
+					costAllocationTag("team", costexplorer.CostAllocationTagStatusActive),
+				)
+				m.UpdateCostAllocationTagsStatus(gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "activates the known tags but fails on unknown ones",
+			keys: []string{"team", "node"},
+			expect: func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder) {
+				expectListCostAllocationTags(m, []string{"team", "node"},
+					costAllocationTag("team", costexplorer.CostAllocationTagStatusInactive),
+				)
+				m.UpdateCostAllocationTagsStatus(gomock.Eq(&costexplorer.UpdateCostAllocationTagsStatusInput{
+					CostAllocationTagsStatus: []*costexplorer.CostAllocationTagStatusEntry{
+						{TagKey: aws.String("team"), Status: aws.String(costexplorer.CostAllocationTagStatusActive)},
+					},
+				})).Return(&costexplorer.UpdateCostAllocationTagsStatusOutput{}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "activation error of a tag",
+			keys: []string{"team"},
+			expect: func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder) {
+				expectListCostAllocationTags(m, []string{"team"},
+					costAllocationTag("team", costexplorer.CostAllocationTagStatusInactive),
+				)
+				m.UpdateCostAllocationTagsStatus(gomock.Any()).Return(&costexplorer.UpdateCostAllocationTagsStatusOutput{
+					Errors: []*costexplorer.UpdateCostAllocationTagsStatusError{
+						{TagKey: aws.String("team"), Code: aws.String("TagKeysNotFoundException"), Message: aws.String("tag key not found")},
+					},
+				}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "listing the tags fails",
+			keys: []string{"team"},
+			expect: func(m *mock_costexploreriface.MockCostExplorerAPIMockRecorder) {
+				m.ListCostAllocationTagsPages(gomock.Any(), gomock.Any()).Return(errors.New("AccessDeniedException"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			costExplorerMock := mock_costexploreriface.NewMockCostExplorerAPI(mockCtrl)
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					CostExplorer: costExplorerMock,
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{CostAllocationTags: tc.keys},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(costExplorerMock.EXPECT())
+
+			err = NewService(clusterScope).ActivateCostAllocationTags()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// TaskExecutionPollInterval is the interval at which a running execution of the DataSync task of a cluster is
+// checked.
+const TaskExecutionPollInterval = time.Minute
+
+// errTaskExecutionFailed is returned by CheckTaskExecution when the last execution of the task failed.
+var errTaskExecutionFailed = errors.New("DataSync task execution failed")
+
+// ReconcileTask creates the DataSync task of the cluster and starts its first execution, or updates the
+// schedule and filters of the existing task. The task is deleted once it is removed from the spec.
+func (s *Service) ReconcileTask() error {
+	spec := s.scope.DataSyncTask()
+	status := &s.scope.AWSCluster.Status
+	if spec == nil {
+		return s.DeleteTask()
+	}
+
+	if status.DataSyncTaskARN != "" {
+		task, err := s.describeTask(status.DataSyncTaskARN)
+		if err != nil {
+			return err
+		}
+		if task != nil {
+			if err := s.updateTask(spec, task); err != nil {
+				return err
+			}
+		} else {
+			record.Warnf(s.scope.AWSCluster, "DataSyncTaskNotFound", "DataSync task %q was deleted, creating another one", status.DataSyncTaskARN)
+			status.DataSyncTaskARN = ""
+			status.DataSyncTaskExecutionARN = ""
+		}
+	}
+
+	if status.DataSyncTaskARN == "" {
+		taskARN, err := s.createTask(spec)
+		if err != nil {
+			return err
+		}
+		status.DataSyncTaskARN = taskARN
+	}
+
+	// The first execution is started by the controller, the next ones by the schedule of the task.
+	if status.DataSyncTaskExecutionARN != "" {
+		return nil
+	}
+	out, err := s.scope.DataSync.StartTaskExecution(&datasync.StartTaskExecutionInput{
+		TaskArn: aws.String(status.DataSyncTaskARN),
+	})
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedStartDataSyncTaskExecution", "Failed to start DataSync task %q: %v", status.DataSyncTaskARN, err)
+		return errors.Wrapf(err, "failed to start DataSync task %q", status.DataSyncTaskARN)
+	}
+	status.DataSyncTaskExecutionARN = aws.StringValue(out.TaskExecutionArn)
+	record.Eventf(s.scope.AWSCluster, "SuccessfulStartDataSyncTaskExecution", "Started execution %q of DataSync task %q", status.DataSyncTaskExecutionARN, status.DataSyncTaskARN)
+
+	return nil
+}
+
+// CheckTaskExecution returns true once the last execution of the DataSync task of the cluster succeeded, and
+// false while it is running. Failed executions are reported as errors.
+func (s *Service) CheckTaskExecution() (bool, error) {
+	executionARN := s.scope.AWSCluster.Status.DataSyncTaskExecutionARN
+	if executionARN == "" {
+		return true, nil
+	}
+
+	out, err := s.scope.DataSync.DescribeTaskExecution(&datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(executionARN),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe DataSync task execution %q", executionARN)
+	}
+
+	switch aws.StringValue(out.Status) {
+	case datasync.TaskExecutionStatusSuccess:
+		return true, nil
+	case datasync.TaskExecutionStatusError:
+		var code, detail string
+		if out.Result != nil {
+			code, detail = aws.StringValue(out.Result.ErrorCode), aws.StringValue(out.Result.ErrorDetail)
+		}
+		return false, errors.Wrapf(errTaskExecutionFailed, "execution %q failed with %s: %s", executionARN, code, detail)
+	default:
+		return false, nil
+	}
+}
+
+// IsTaskExecutionFailed returns true if the last execution of the DataSync task failed.
+func IsTaskExecutionFailed(err error) bool {
+	return errors.Cause(err) == errTaskExecutionFailed
+}
+
+// DeleteTask deletes the DataSync task of the cluster, if any.
+func (s *Service) DeleteTask() error {
+	status := &s.scope.AWSCluster.Status
+	if status.DataSyncTaskARN == "" {
+		return nil
+	}
+
+	if _, err := s.scope.DataSync.DeleteTask(&datasync.DeleteTaskInput{
+		TaskArn: aws.String(status.DataSyncTaskARN),
+	}); err != nil && !isNotFound(err) {
+		record.Warnf(s.scope.AWSCluster, "FailedDeleteDataSyncTask", "Failed to delete DataSync task %q: %v", status.DataSyncTaskARN, err)
+		return errors.Wrapf(err, "failed to delete DataSync task %q", status.DataSyncTaskARN)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteDataSyncTask", "Deleted DataSync task %q", status.DataSyncTaskARN)
+	status.DataSyncTaskARN = ""
+	status.DataSyncTaskExecutionARN = ""
+	return nil
+}
+
+func (s *Service) createTask(spec *infrav1.DataSyncSpec) (string, error) {
+	name := fmt.Sprintf("%s-datasync", s.scope.Name())
+	input := &datasync.CreateTaskInput{
+		Name:                   aws.String(name),
+		SourceLocationArn:      aws.String(spec.SourceLocationARN),
+		DestinationLocationArn: aws.String(spec.DestinationLocationARN),
+		Includes:               includeFilters(spec),
+		Tags: converters.MapToDataSyncTags(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Additional:  s.scope.AdditionalTags(),
+		})),
+	}
+	if spec.ScheduleCron != "" {
+		input.Schedule = &datasync.TaskSchedule{ScheduleExpression: aws.String(scheduleExpression(spec))}
+	}
+
+	out, err := s.scope.DataSync.CreateTask(input)
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedCreateDataSyncTask", "Failed to create DataSync task %q: %v", name, err)
+		return "", errors.Wrapf(err, "failed to create DataSync task %q", name)
+	}
+
+	taskARN := aws.StringValue(out.TaskArn)
+	record.Eventf(s.scope.AWSCluster, "SuccessfulCreateDataSyncTask", "Created DataSync task %q", taskARN)
+	return taskARN, nil
+}
+
+// updateTask updates the schedule and filters of the task when they changed, and tracks its current execution,
+// which may have been started by its schedule.
+func (s *Service) updateTask(spec *infrav1.DataSyncSpec, task *datasync.DescribeTaskOutput) error {
+	status := &s.scope.AWSCluster.Status
+	if current := aws.StringValue(task.CurrentTaskExecutionArn); current != "" {
+		status.DataSyncTaskExecutionARN = current
+	}
+
+	var currentSchedule string
+	if task.Schedule != nil {
+		currentSchedule = aws.StringValue(task.Schedule.ScheduleExpression)
+	}
+	wantIncludes := includeFilters(spec)
+	if currentSchedule == scheduleExpression(spec) && filterValue(task.Includes) == filterValue(wantIncludes) {
+		return nil
+	}
+
+	// An empty schedule expression removes the schedule of the task, and empty filters its filters.
+	if wantIncludes == nil {
+		wantIncludes = []*datasync.FilterRule{}
+	}
+	if _, err := s.scope.DataSync.UpdateTask(&datasync.UpdateTaskInput{
+		TaskArn:  task.TaskArn,
+		Includes: wantIncludes,
+		Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String(scheduleExpression(spec))},
+	}); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedUpdateDataSyncTask", "Failed to update DataSync task %q: %v", status.DataSyncTaskARN, err)
+		return errors.Wrapf(err, "failed to update DataSync task %q", status.DataSyncTaskARN)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulUpdateDataSyncTask", "Updated schedule and filters of DataSync task %q", status.DataSyncTaskARN)
+	return nil
+}
+
+// describeTask returns the task with the given ARN, or nil if it doesn't exist.
+func (s *Service) describeTask(taskARN string) (*datasync.DescribeTaskOutput, error) {
+	out, err := s.scope.DataSync.DescribeTask(&datasync.DescribeTaskInput{
+		TaskArn: aws.String(taskARN),
+	})
+	switch {
+	case isNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to describe DataSync task %q", taskARN)
+	}
+	return out, nil
+}
+
+// includeFilters returns the include filters of the task, as a single rule matching any of its patterns.
+func includeFilters(spec *infrav1.DataSyncSpec) []*datasync.FilterRule {
+	if len(spec.InclFilters) == 0 {
+		return nil
+	}
+	return []*datasync.FilterRule{{
+		FilterType: aws.String(datasync.FilterTypeSimplePattern),
+		Value:      aws.String(strings.Join(spec.InclFilters, "|")),
+	}}
+}
+
+// filterValue returns the patterns of the filter rules, separated by '|'.
+func filterValue(rules []*datasync.FilterRule) string {
+	values := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		values = append(values, aws.StringValue(rule.Value))
+	}
+	return strings.Join(values, "|")
+}
+
+// scheduleExpression returns the schedule expression of the task, or an empty string without a schedule.
+func scheduleExpression(spec *infrav1.DataSyncSpec) string {
+	if spec.ScheduleCron == "" {
+		return ""
+	}
+	return fmt.Sprintf("cron(%s)", spec.ScheduleCron)
+}
+
+// isNotFound returns true if the error is DataSync's report of a task that doesn't exist, which it doesn't
+// tell apart from other invalid requests but by its message.
+func isNotFound(err error) bool {
+	code, ok := awserrors.Code(err)
+	return ok && code == datasync.ErrCodeInvalidRequestException && strings.Contains(strings.ToLower(awserrors.Message(err)), "not found")
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasync
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/datasync/mock_datasynciface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testSourceLocation      = "arn:aws:datasync:us-east-1:123456789012:location/loc-source"
+	testDestinationLocation = "arn:aws:datasync:us-east-1:123456789012:location/loc-destination"
+	testTaskARN             = "arn:aws:datasync:us-east-1:123456789012:task/task-1"
+	testExecutionARN        = testTaskARN + "/execution/exec-1"
+)
+
+func newTestScope(t *testing.T, dataSyncMock *mock_datasynciface.MockDataSyncAPI, spec *infrav1.DataSyncSpec, status infrav1.AWSClusterStatus) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       infrav1.AWSClusterSpec{DataSync: spec},
+		Status:     status,
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			DataSync: dataSyncMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func testDataSyncSpec() *infrav1.DataSyncSpec {
+	return &infrav1.DataSyncSpec{
+		SourceLocationARN:      testSourceLocation,
+		DestinationLocationARN: testDestinationLocation,
+		ScheduleCron:           "0 2 * * ? *",
+		InclFilters:            []string{"/data/*", "/logs/*"},
+	}
+}
+
+func TestReconcileTask(t *testing.T) {
+	testCases := []struct {
+		name             string
+		spec             *infrav1.DataSyncSpec
+		status           infrav1.AWSClusterStatus
+		expect           func(m *mock_datasynciface.MockDataSyncAPIMockRecorder)
+		wantTaskARN      string
+		wantExecutionARN string
+		wantErr          bool
+	}{
+		{
+			name: "creates the task and starts its first execution",
+			spec: testDataSyncSpec(),
+			expect: func(m *mock_datasynciface.MockDataSyncAPIMockRecorder) {
+				m.CreateTask(gomock.AssignableToTypeOf(&datasync.CreateTaskInput{})).
+					DoAndReturn(func(input *datasync.CreateTaskInput) (*datasync.CreateTaskOutput, error) {
+						if aws.StringValue(input.SourceLocationArn) != testSourceLocation || aws.StringValue(input.DestinationLocationArn) != testDestinationLocation {
+							t.Fatalf("unexpected locations of the task: %v", input)
+						}
+						if aws.StringValue(input.Schedule.ScheduleExpression) != "cron(0 2 * * ? *)" {
+							t.Fatalf("unexpected schedule of the task: %v", input.Schedule)
+						}
+						if len(input.Includes) != 1 || aws.StringValue(input.Includes[0].Value) != "/data/*|/logs/*" {
+							t.Fatalf("unexpected filters of the task: %v", input.Includes)
+						}
+						if len(input.Tags) == 0 {
+							t.Fatalf("expected the task to be tagged")
+						}
+						return &datasync.CreateTaskOutput{TaskArn: aws.String(testTaskARN)}, nil
+					})
+				m.StartTaskExecution(gomock.Eq(&datasync.StartTaskExecutionInput{TaskArn: aws.String(testTaskARN)})).
+					Return(&datasync.StartTaskExecutionOutput{TaskExecutionArn: aws.String(testExecutionARN)}, nil)
+			},
+			wantTaskARN:      testTaskARN,
+			wantExecutionARN: testExecutionARN,
+		},
+		{
+			name:   "updates the schedule and filters of the task and tracks its current execution",
+			spec:   testDataSyncSpec(),
+			status: infrav1.AWSClusterStatus{DataSyncTaskARN: testTaskARN, DataSyncTaskExecutionARN: testExecutionARN},
+			expect: func(m *mock_datasynciface.MockDataSyncAPIMockRecorder) {
+				m.DescribeTask(gomock.Eq(&datasync.DescribeTaskInput{TaskArn: aws.String(testTaskARN)})).
+					Return(&datasync.DescribeTaskOutput{
+						TaskArn:                 aws.String(testTaskARN),
+						CurrentTaskExecutionArn: aws.String(testTaskARN + "/execution/exec-2"),
+					}, nil)
+				m.UpdateTask(gomock.Eq(&datasync.UpdateTaskInput{
+					TaskArn: aws.String(testTaskARN),
+					Includes: []*datasync.FilterRule{
+						{FilterType: aws.String(datasync.FilterTypeSimplePattern), Value: aws.String("/data/*|/logs/*")},
+					},
+					Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String("cron(0 2 * * ? *)")},
+				})).Return(&datasync.UpdateTaskOutput{}, nil)
+			},
+			wantTaskARN:      testTaskARN,
+			wantExecutionARN: testTaskARN + "/execution/exec-2",
+		},
+		{
+			name:   "leaves an up to date task",
+			spec:   testDataSyncSpec(),
+			status: infrav1.AWSClusterStatus{DataSyncTaskARN: testTaskARN, DataSyncTaskExecutionARN: testExecutionARN},
+			expect: func(m *mock_datasynciface.MockDataSyncAPIMockRecorder) {
+				m.DescribeTask(gomock.Any()).
+					Return(&datasync.DescribeTaskOutput{
+						TaskArn:  aws.String(testTaskARN),
+						Schedule: &datasync.TaskSchedule{ScheduleExpression: aws.String("cron(0 2 * * ? *)")},
+						Includes: []*datasync.FilterRule{{Value: aws.String("/data/*|/logs/*")}},
+					}, nil)
+			},
+			wantTaskARN:      testTaskARN,
+			wantExecutionARN: testExecutionARN,
+		},
+		{
+			name:   "creates another task when the task was deleted",
+			spec:   &infrav1.DataSyncSpec{SourceLocationARN: testSourceLocation, DestinationLocationARN: testDestinationLocation},
+			status: infrav1.AWSClusterStatus{DataSyncTaskARN: "arn:aws:datasync:us-east-1:123456789012:task/task-0", DataSyncTaskExecutionARN: testExecutionARN},
+			expect: func(m *mock_datasynciface.MockDataSyncAPIMockRecorder) {
+				m.DescribeTask(gomock.Any()).
+					Return(nil, awserr.New(datasync.ErrCodeInvalidRequestException, "Task not found", nil))
+				m.CreateTask(gomock.AssignableToTypeOf(&datasync.CreateTaskInput{})).
+					DoAndReturn(func(input *datasync.CreateTaskInput) (*datasync.CreateTaskOutput, error) {
+						if input.Schedule != nil || input.Includes != nil {
+							t.Fatalf("expected a task without schedule nor filters, got %v", input)
+						}
+						return &datasync.CreateTaskOutput{TaskArn: aws.String(testTaskARN)}, nil
+					})
+				m.StartTaskExecution(gomock.Any()).
+					Return(&datasync.StartTaskExecutionOutput{TaskExecutionArn: aws.String(testTaskARN + "/execution/exec-2")}, nil)
+			},
+			wantTaskARN:      testTaskARN,
+			wantExecutionARN: testTaskARN + "/execution/exec-2",
+		},
+		{
+			name:   "deletes the task once it is removed from the spec",
+			status: infrav1.AWSClusterStatus{DataSyncTaskARN: testTaskARN, DataSyncTaskExecutionARN: testExecutionARN},
+			expect: func(m *mock_datasynciface.MockDataSyncAPIMockRecorder) {
+				m.DeleteTask(gomock.Eq(&datasync.DeleteTaskInput{TaskArn: aws.String(testTaskARN)})).
+					Return(&datasync.DeleteTaskOutput{}, nil)
+			},
+		},
+		{
+			name: "fails when the task cannot be created",
+			spec: testDataSyncSpec(),
+			expect: func(m *mock_datasynciface.MockDataSyncAPIMockRecorder) {
+				m.CreateTask(gomock.Any()).
+					Return(nil, awserr.New(datasync.ErrCodeInvalidRequestException, "location not accessible", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			dataSyncMock := mock_datasynciface.NewMockDataSyncAPI(mockCtrl)
+			tc.expect(dataSyncMock.EXPECT())
+
+			clusterScope := newTestScope(t, dataSyncMock, tc.spec, tc.status)
+			err := NewService(clusterScope).ReconcileTask()
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			status := clusterScope.AWSCluster.Status
+			if status.DataSyncTaskARN != tc.wantTaskARN || status.DataSyncTaskExecutionARN != tc.wantExecutionARN {
+				t.Fatalf("expected task %q and execution %q, got %q and %q",
+					tc.wantTaskARN, tc.wantExecutionARN, status.DataSyncTaskARN, status.DataSyncTaskExecutionARN)
+			}
+		})
+	}
+}
+
+func TestCheckTaskExecution(t *testing.T) {
+	testCases := []struct {
+		name          string
+		output        *datasync.DescribeTaskExecutionOutput
+		wantCompleted bool
+		wantFailed    bool
+	}{
+		{
+			name:   "execution transferring",
+			output: &datasync.DescribeTaskExecutionOutput{Status: aws.String(datasync.TaskExecutionStatusTransferring)},
+		},
+		{
+			name:          "execution succeeded",
+			output:        &datasync.DescribeTaskExecutionOutput{Status: aws.String(datasync.TaskExecutionStatusSuccess)},
+			wantCompleted: true,
+		},
+		{
+			name: "execution failed",
+			output: &datasync.DescribeTaskExecutionOutput{
+				Status: aws.String(datasync.TaskExecutionStatusError),
+				Result: &datasync.TaskExecutionResultDetail{ErrorCode: aws.String("PermissionDenied"), ErrorDetail: aws.String("access denied")},
+			},
+			wantFailed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			dataSyncMock := mock_datasynciface.NewMockDataSyncAPI(mockCtrl)
+			dataSyncMock.EXPECT().DescribeTaskExecution(gomock.Eq(&datasync.DescribeTaskExecutionInput{TaskExecutionArn: aws.String(testExecutionARN)})).
+				Return(tc.output, nil)
+
+			clusterScope := newTestScope(t, dataSyncMock, testDataSyncSpec(), infrav1.AWSClusterStatus{DataSyncTaskARN: testTaskARN, DataSyncTaskExecutionARN: testExecutionARN})
+			completed, err := NewService(clusterScope).CheckTaskExecution()
+			if completed != tc.wantCompleted {
+				t.Fatalf("expected completed to be %v, got %v", tc.wantCompleted, completed)
+			}
+			if IsTaskExecutionFailed(err) != tc.wantFailed {
+				t.Fatalf("expected failed to be %v, got error %v", tc.wantFailed, err)
+			}
+		})
+	}
+}
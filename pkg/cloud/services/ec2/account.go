@@ -17,8 +17,10 @@ limitations under the License.
 package ec2
 
 import (
+	"context"
 	"sort"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
@@ -42,3 +44,27 @@ func (s *Service) getAvailableZones() ([]string, error) {
 	sort.Strings(zones)
 	return zones, nil
 }
+
+// DiscoverAvailabilityZones returns the names of the available availability zones of the region, sorted.
+// Local Zones and Wavelength Zones are left out.
+func (s *Service) DiscoverAvailabilityZones(ctx context.Context, region string) ([]string, error) {
+	out, err := s.scope.EC2.DescribeAvailabilityZonesWithContext(ctx, &ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Available(),
+			filter.EC2.ZoneTypes("availability-zone"),
+			filter.EC2.RegionName(region),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.AWSCluster, "FailedDescribeAvailableZone", "Failed discovering availability zones of region %q: %v", region, err)
+		return nil, errors.Wrapf(err, "failed to describe availability zones of region %q", region)
+	}
+
+	zones := make([]string, 0, len(out.AvailabilityZones))
+	for _, zone := range out.AvailabilityZones {
+		zones = append(zones, aws.StringValue(zone.ZoneName))
+	}
+
+	sort.Strings(zones)
+	return zones, nil
+}
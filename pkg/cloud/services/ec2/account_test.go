@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestDiscoverAvailabilityZones(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expect    func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name: "zones are returned sorted",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Eq(&ec2.DescribeAvailabilityZonesInput{
+					Filters: []*ec2.Filter{
+						{Name: aws.String("state"), Values: aws.StringSlice([]string{"available"})},
+						{Name: aws.String("zone-type"), Values: aws.StringSlice([]string{"availability-zone"})},
+						{Name: aws.String("region-name"), Values: aws.StringSlice([]string{"us-east-1"})},
+					},
+				})).Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: []*ec2.AvailabilityZone{
+						{ZoneName: aws.String("us-east-1c")},
+						{ZoneName: aws.String("us-east-1a")},
+						{ZoneName: aws.String("us-east-1b")},
+					},
+				}, nil)
+			},
+			expected: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+		},
+		{
+			name: "region without available zones",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+					Return(&ec2.DescribeAvailabilityZonesOutput{}, nil)
+			},
+			expected: []string{},
+		},
+		{
+			name: "describe fails",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAvailabilityZonesWithContext(context.TODO(), gomock.Any()).
+					Return(nil, awserr.New("UnauthorizedOperation", "denied", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{Region: "us-east-1"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			zones, err := s.DiscoverAvailabilityZones(context.TODO(), "us-east-1")
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(zones, tc.expected) {
+				t.Fatalf("expected zones %v, got %v", tc.expected, zones)
+			}
+		})
+	}
+}
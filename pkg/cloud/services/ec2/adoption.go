@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// AdoptResources adopts the existing VPC, subnets and security groups referenced by the network spec of
+// clusters annotated for adoption. They are checked to exist and tagged as owned by the cluster, along with
+// the route tables of the subnets, so that the network reconciliation uses them instead of creating them.
+func (s *Service) AdoptResources() error {
+	if !s.scope.AdoptResources() {
+		return nil
+	}
+
+	s.scope.V(2).Info("Adopting network resources")
+
+	if err := s.adoptResources(); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedAdoptResources", "Failed to adopt network resources: %v", err)
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.ResourcesAdoptedCondition, infrav1.ResourceAdoptionFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+
+	conditions.MarkTrue(s.scope.AWSCluster, infrav1.ResourcesAdoptedCondition)
+	return nil
+}
+
+func (s *Service) adoptResources() error {
+	vpcID := s.scope.VPC().ID
+	if vpcID == "" {
+		return errors.New("the ID of the VPC to adopt must be set in spec.networkSpec.vpc.id")
+	}
+
+	if err := s.adoptVPC(vpcID); err != nil {
+		return err
+	}
+	if err := s.adoptSubnets(vpcID); err != nil {
+		return err
+	}
+	return s.adoptSecurityGroups()
+}
+
+func (s *Service) adoptVPC(id string) error {
+	out, err := s.scope.EC2.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{id}),
+	})
+	if code, _ := awserrors.Code(err); code == awserrors.VPCNotFound || (err == nil && len(out.Vpcs) == 0) {
+		return awserrors.NewNotFound(errors.Errorf("vpc %q to adopt not found", id))
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to describe vpc %q", id)
+	}
+
+	if err := tags.Ensure(converters.TagsToMap(out.Vpcs[0].Tags), &tags.ApplyParams{
+		EC2Client:   s.scope.EC2,
+		BuildParams: s.getVPCTagParams(id),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to tag vpc %q", id)
+	}
+
+	s.scope.V(2).Info("Adopted VPC", "vpc-id", id)
+	return nil
+}
+
+// adoptSubnets tags the subnets of the spec with an ID, and the route tables they are explicitly associated with.
+// Subnets are public when they are set as such or when their route table routes to an internet gateway.
+func (s *Service) adoptSubnets(vpcID string) error {
+	specs := map[string]*infrav1.SubnetSpec{}
+	ids := []string{}
+	for _, sn := range s.scope.Subnets() {
+		if sn.ID != "" {
+			specs[sn.ID] = sn
+			ids = append(ids, sn.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	out, err := s.scope.EC2.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(ids),
+	})
+	if code, _ := awserrors.Code(err); code == awserrors.SubnetNotFound {
+		return awserrors.NewNotFound(errors.Errorf("subnets %v to adopt not found: %v", ids, err))
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to describe subnets %v", ids)
+	}
+
+	found := map[string]*ec2.Subnet{}
+	for _, sn := range out.Subnets {
+		found[aws.StringValue(sn.SubnetId)] = sn
+	}
+	var missing []string
+	for _, id := range ids {
+		if found[id] == nil {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return awserrors.NewNotFound(errors.Errorf("subnets %s to adopt not found", strings.Join(missing, ", ")))
+	}
+
+	rtOut, err := s.scope.EC2.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(vpcID),
+			filter.EC2.SubnetAssociations(ids...),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe route tables of subnets %v", ids)
+	}
+	routeTables := map[string]*ec2.RouteTable{}
+	for _, rt := range rtOut.RouteTables {
+		for _, assoc := range rt.Associations {
+			if assoc.SubnetId != nil {
+				routeTables[*assoc.SubnetId] = rt
+			}
+		}
+	}
+
+	for _, id := range ids {
+		sn := found[id]
+		if aws.StringValue(sn.VpcId) != vpcID {
+			return errors.Errorf("subnet %q to adopt is not in vpc %q", id, vpcID)
+		}
+
+		rt := routeTables[id]
+		public := specs[id].IsPublic
+		if rt != nil {
+			for _, route := range rt.Routes {
+				if route.GatewayId != nil && strings.HasPrefix(*route.GatewayId, "igw") {
+					public = true
+				}
+			}
+		}
+
+		zone := aws.StringValue(sn.AvailabilityZone)
+		if err := tags.Ensure(converters.TagsToMap(sn.Tags), &tags.ApplyParams{
+			EC2Client:   s.scope.EC2,
+			BuildParams: s.getSubnetTagParams(id, public, zone, specs[id].Tags),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to tag subnet %q", id)
+		}
+
+		if rt != nil {
+			if err := tags.Ensure(converters.TagsToMap(rt.Tags), &tags.ApplyParams{
+				EC2Client:   s.scope.EC2,
+				BuildParams: s.getRouteTableTagParams(aws.StringValue(rt.RouteTableId), public, zone),
+			}); err != nil {
+				return errors.Wrapf(err, "failed to tag route table %q", aws.StringValue(rt.RouteTableId))
+			}
+		}
+
+		s.scope.V(2).Info("Adopted subnet", "subnet-id", id, "public", public)
+	}
+
+	return nil
+}
+
+// adoptSecurityGroups tags the security groups overriding the roles of the cluster, and records them
+// in the status of the cluster.
+func (s *Service) adoptSecurityGroups() error {
+	overrides := s.scope.SecurityGroupOverrides()
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	roles := make([]string, 0, len(overrides))
+	ids := make([]string, 0, len(overrides))
+	for role, id := range overrides {
+		roles = append(roles, string(role))
+		ids = append(ids, id)
+	}
+	sort.Strings(roles)
+
+	groups, err := s.describeSecurityGroupsByID(ids)
+	if err != nil {
+		return err
+	}
+
+	if s.scope.Network().SecurityGroups == nil {
+		s.scope.Network().SecurityGroups = make(map[infrav1.SecurityGroupRole]infrav1.SecurityGroup)
+	}
+
+	for _, r := range roles {
+		role := infrav1.SecurityGroupRole(r)
+		sg, ok := groups[overrides[role]]
+		if !ok {
+			return awserrors.NewNotFound(errors.Errorf("security group %q to adopt for role %q not found", overrides[role], role))
+		}
+
+		if err := tags.Ensure(sg.Tags, &tags.ApplyParams{
+			EC2Client:   s.scope.EC2,
+			BuildParams: s.getSecurityGroupTagParams(sg.Name, sg.ID, role),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to tag security group %q", sg.ID)
+		}
+
+		s.scope.SecurityGroups()[role] = sg
+		s.scope.V(2).Info("Adopted security group", "role", role, "security-group-id", sg.ID)
+	}
+
+	return nil
+}
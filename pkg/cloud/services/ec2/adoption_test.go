@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// expectCreateTags expects the resource to be tagged as owned by the test cluster.
+func expectCreateTags(m *mock_ec2iface.MockEC2APIMockRecorder, t *testing.T, id string) {
+	m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+		DoAndReturn(func(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+			if len(input.Resources) != 1 || aws.StringValue(input.Resources[0]) != id {
+				t.Fatalf("expected tags on %q, got %v", id, aws.StringValueSlice(input.Resources))
+			}
+			for _, tag := range input.Tags {
+				if aws.StringValue(tag.Key) == infrav1.ClusterTagKey("test-cluster") && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+					return &ec2.CreateTagsOutput{}, nil
+				}
+			}
+			t.Fatalf("expected %q to be tagged as owned by the cluster, got %v", id, input.Tags)
+			return nil, nil
+		})
+}
+
+func TestAdoptResources(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectErr   bool
+		notFound    bool
+	}{
+		{
+			name: "cluster is not annotated for adoption",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+			},
+		},
+		{
+			name:        "adopts the vpc, subnets, route tables and security groups",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVpcs(gomock.Eq(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-adopt"})})).
+					Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-adopt")}}}, nil)
+				expectCreateTags(m, t, "vpc-adopt")
+
+				m.DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice([]string{"subnet-public", "subnet-private"})})).
+					Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-public"), VpcId: aws.String("vpc-adopt"), AvailabilityZone: aws.String("us-east-1a")},
+						{SubnetId: aws.String("subnet-private"), VpcId: aws.String("vpc-adopt"), AvailabilityZone: aws.String("us-east-1a")},
+					}}, nil)
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-public"),
+							Associations: []*ec2.RouteTableAssociation{{SubnetId: aws.String("subnet-public")}},
+							Routes:       []*ec2.Route{{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")}},
+						},
+					}}, nil)
+				expectCreateTags(m, t, "subnet-public")
+				expectCreateTags(m, t, "rtb-public")
+				expectCreateTags(m, t, "subnet-private")
+
+				m.DescribeSecurityGroups(gomock.Eq(&ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{"sg-node"})})).
+					Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{
+						{GroupId: aws.String("sg-node"), GroupName: aws.String("existing-nodes")},
+					}}, nil)
+				expectCreateTags(m, t, "sg-node")
+
+				m.CreateVpc(gomock.Any()).Times(0)
+				m.CreateSubnet(gomock.Any()).Times(0)
+				m.CreateSecurityGroup(gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:        "vpc to adopt is not found",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVpcs(gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).
+					Return(nil, awserr.New(awserrors.VPCNotFound, "not found", nil))
+			},
+			expectErr: true,
+			notFound:  true,
+		},
+		{
+			name:        "subnet to adopt is not found",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVpcs(gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).
+					Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-adopt")}}}, nil)
+				expectCreateTags(m, t, "vpc-adopt")
+				m.DescribeSubnets(gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+					Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-public"), VpcId: aws.String("vpc-adopt"), AvailabilityZone: aws.String("us-east-1a")},
+					}}, nil)
+			},
+			expectErr: true,
+			notFound:  true,
+		},
+		{
+			name:        "security group to adopt is not found",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVpcs(gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).
+					Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-adopt")}}}, nil)
+				expectCreateTags(m, t, "vpc-adopt")
+				m.DescribeSubnets(gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+					Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-public"), VpcId: aws.String("vpc-adopt"), AvailabilityZone: aws.String("us-east-1a")},
+						{SubnetId: aws.String("subnet-private"), VpcId: aws.String("vpc-adopt"), AvailabilityZone: aws.String("us-east-1a")},
+					}}, nil)
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+				expectCreateTags(m, t, "subnet-public")
+				expectCreateTags(m, t, "subnet-private")
+				m.DescribeSecurityGroups(gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+					Return(nil, awserr.New(awserrors.GroupNotFound, "not found", nil))
+			},
+			expectErr: true,
+			notFound:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{ID: "vpc-adopt"},
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-public"},
+							{ID: "subnet-private"},
+						},
+						SecurityGroupOverrides: map[infrav1.SecurityGroupRole]string{
+							infrav1.SecurityGroupNode: "sg-node",
+						},
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			err = s.AdoptResources()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if tc.notFound && !awserrors.IsNotFound(err) {
+					t.Fatalf("expected a not found error, got %v", err)
+				}
+				if !conditions.IsFalse(awsCluster, infrav1.ResourcesAdoptedCondition) {
+					t.Fatalf("expected condition %s to be false", infrav1.ResourcesAdoptedCondition)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			if tc.annotations == nil {
+				if conditions.Has(awsCluster, infrav1.ResourcesAdoptedCondition) {
+					t.Fatalf("expected no %s condition", infrav1.ResourcesAdoptedCondition)
+				}
+				return
+			}
+			if !conditions.IsTrue(awsCluster, infrav1.ResourcesAdoptedCondition) {
+				t.Fatalf("expected condition %s to be true", infrav1.ResourcesAdoptedCondition)
+			}
+			if got := awsCluster.Status.Network.SecurityGroups[infrav1.SecurityGroupNode].ID; got != "sg-node" {
+				t.Fatalf("expected adopted node security group sg-node, got %q", got)
+			}
+		})
+	}
+}
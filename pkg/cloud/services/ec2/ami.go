@@ -18,6 +18,9 @@ package ec2
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
 	"sort"
 	"strings"
 	"text/template"
@@ -25,8 +28,20 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/imagebuilder"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -48,8 +63,17 @@ const (
 
 	// Amazon's AMI timestamp format
 	createDateTimestampFormat = "2006-01-02T15:04:05.000Z"
+
+	// AMICopiedFromTag is set on AMIs copied from another region, to the source region and AMI ID.
+	AMICopiedFromTag = infrav1.NameAWSProviderPrefix + "copied-from"
+
+	// AMICopyCacheName is the name of the ConfigMap mapping copied AMIs to their copies.
+	AMICopyCacheName = "capa-ami-copy-cache"
 )
 
+// errAMICopyPending is returned by AMILookupChain while the copy of the machine's AMI is not available yet.
+var errAMICopyPending = errors.New("copy of AMI is not available yet")
+
 // AMILookup contains the parameters used to template AMI names used for lookup.
 type AMILookup struct {
 	BaseOS     string
@@ -74,8 +98,9 @@ func amiName(amiNameFormat, baseOS, kubernetesVersion string) (string, error) {
 	return templateBytes.String(), nil
 }
 
-// defaultAMILookup returns the default AMI based on region
-func (s *Service) defaultAMILookup(amiNameFormat, ownerID, baseOS, kubernetesVersion string) (string, error) {
+// defaultAMILookup returns the default AMI based on region. AMIs are restricted to the ones supported by the
+// given Outpost, if any, and to Windows AMIs for Windows instances.
+func (s *Service) defaultAMILookup(amiNameFormat, ownerID, baseOS, kubernetesVersion, outpostARN string, osType infrav1.OSType) (string, error) {
 	if amiNameFormat == "" {
 		amiNameFormat = defaultAmiNameFormat
 	}
@@ -113,6 +138,18 @@ func (s *Service) defaultAMILookup(amiNameFormat, ownerID, baseOS, kubernetesVer
 			},
 		},
 	}
+	if outpostARN != "" {
+		describeImageInput.Filters = append(describeImageInput.Filters, &ec2.Filter{
+			Name:   aws.String("outpost-arn"),
+			Values: []*string{aws.String(outpostARN)},
+		})
+	}
+	if osType == infrav1.OSTypeWindows {
+		describeImageInput.Filters = append(describeImageInput.Filters, &ec2.Filter{
+			Name:   aws.String("platform"),
+			Values: []*string{aws.String("windows")},
+		})
+	}
 
 	out, err := s.scope.EC2.DescribeImages(describeImageInput)
 	if err != nil {
@@ -131,6 +168,108 @@ func (s *Service) defaultAMILookup(amiNameFormat, ownerID, baseOS, kubernetesVer
 	return aws.StringValue(latestImage.ImageId), nil
 }
 
+// AMILookupChain returns the ID of the AMI to launch the machine from. It tries, in order, the
+// AMI ID set on the AWSMachine, the output of the Image Builder pipeline set on the AWSMachine,
+// the SSM parameter path set on the AWSMachine and the latest image matching the image lookup
+// format, organization and base OS for the Machine's Kubernetes version.
+// A step is only tried if the previous ones did not resolve an AMI.
+func (s *Service) AMILookupChain(scope *scope.MachineScope) (string, error) {
+	spec := scope.AWSMachine.Spec
+	var errs []error
+
+	if id := aws.StringValue(spec.AMI.ID); id != "" {
+		if spec.AMISourceRegion != "" && s.amiCopier != nil {
+			copyID, available, err := s.amiCopier.CopyImage(id, spec.AMISourceRegion)
+			if err != nil {
+				return "", err
+			}
+			if !available {
+				return "", errors.Wrapf(errAMICopyPending, "waiting for copy %q of AMI %q", copyID, id)
+			}
+			scope.V(2).Info("Using copy of AMI set in spec.ami.id", "ami-id", id, "copy-id", copyID)
+			return copyID, nil
+		}
+		scope.V(2).Info("Using AMI set in spec.ami.id", "ami-id", id)
+		return id, nil
+	}
+
+	if spec.ImageBuilderPipelineARN != "" {
+		id, err := imagebuilder.NewService(s.scope).PipelineAMI(scope)
+		if err != nil {
+			return "", err
+		}
+		scope.V(2).Info("Using AMI built by spec.imageBuilderPipelineARN", "ami-id", id, "pipeline", spec.ImageBuilderPipelineARN)
+		return id, nil
+	}
+
+	if spec.AMISSMPath != "" {
+		id, err := s.ssmAMILookup(spec.AMISSMPath)
+		if err == nil {
+			scope.V(2).Info("Using AMI resolved from spec.amiSSMPath", "ami-id", id, "path", spec.AMISSMPath)
+			return id, nil
+		}
+		scope.V(2).Info("Failed to resolve AMI from spec.amiSSMPath, falling back to image lookup", "path", spec.AMISSMPath, "error", err.Error())
+		errs = append(errs, err)
+	}
+
+	if scope.Machine.Spec.Version == nil {
+		errs = append(errs, errors.New("cannot look up an AMI without Machine's spec.version"))
+		return "", kerrors.NewAggregate(errs)
+	}
+
+	imageLookupFormat := spec.ImageLookupFormat
+	if imageLookupFormat == "" {
+		imageLookupFormat = scope.AWSCluster.Spec.ImageLookupFormat
+	}
+
+	imageLookupOrg := spec.ImageLookupOrg
+	if imageLookupOrg == "" {
+		imageLookupOrg = scope.AWSCluster.Spec.ImageLookupOrg
+	}
+
+	imageLookupBaseOS := spec.ImageLookupBaseOS
+	if imageLookupBaseOS == "" {
+		imageLookupBaseOS = scope.AWSCluster.Spec.ImageLookupBaseOS
+	}
+
+	id, err := s.defaultAMILookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *scope.Machine.Spec.Version, spec.OutpostARN, spec.OSType)
+	if err != nil {
+		errs = append(errs, err)
+		return "", kerrors.NewAggregate(errs)
+	}
+
+	scope.V(2).Info("Using AMI found by image lookup", "ami-id", id)
+	return id, nil
+}
+
+// ssmAMILookup returns the AMI ID stored in the SSM parameter at the given path.
+// Lookups are cached for the lifetime of the service.
+func (s *Service) ssmAMILookup(path string) (string, error) {
+	if id, ok := s.ssmAMICache[path]; ok {
+		return id, nil
+	}
+
+	out, err := s.scope.SSM.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(path),
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == ssm.ErrCodeParameterNotFound {
+			return "", awserrors.NewNotFound(errors.Errorf("SSM parameter %q not found", path))
+		}
+		record.Eventf(s.scope.AWSCluster, "FailedGetParameter", "Failed to get SSM parameter %q: %v", path, err)
+		return "", errors.Wrapf(err, "failed to get SSM parameter %q", path)
+	}
+
+	if out.Parameter == nil || aws.StringValue(out.Parameter.Value) == "" {
+		return "", errors.Errorf("SSM parameter %q has no value", path)
+	}
+
+	id := aws.StringValue(out.Parameter.Value)
+	s.scope.V(2).Info("Resolved AMI from SSM parameter", "path", path, "ami-id", id)
+	s.ssmAMICache[path] = id
+	return id, nil
+}
+
 type images []*ec2.Image
 
 // Len is the number of elements in the collection.
@@ -202,3 +341,270 @@ func (s *Service) defaultBastionAMILookup(region string) string {
 		return "unknown region"
 	}
 }
+
+// AMICopyCache maps the AMIs copied across regions for clusters to their copies.
+// It is stored in the AMICopyCacheName ConfigMap in the namespace of the controller.
+type AMICopyCache struct {
+	client    client.Client
+	namespace string
+}
+
+// NewAMICopyCache returns a new AMICopyCache stored in the given namespace.
+func NewAMICopyCache(client client.Client, namespace string) *AMICopyCache {
+	return &AMICopyCache{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// configMap returns the ConfigMap of the cache, and whether it already exists.
+func (c *AMICopyCache) configMap() (*corev1.ConfigMap, bool, error) {
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: c.namespace, Name: AMICopyCacheName}
+	if err := c.client.Get(context.TODO(), key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: c.namespace, Name: AMICopyCacheName},
+			}, false, nil
+		}
+		return nil, false, errors.Wrapf(err, "failed to get AMI copy cache %s/%s", c.namespace, AMICopyCacheName)
+	}
+	return cm, true, nil
+}
+
+func (c *AMICopyCache) lookup(key string) (string, error) {
+	cm, _, err := c.configMap()
+	if err != nil {
+		return "", err
+	}
+	return cm.Data[key], nil
+}
+
+// entries returns the entries of the cache whose key starts with the given prefix.
+func (c *AMICopyCache) entries(prefix string) (map[string]string, error) {
+	cm, _, err := c.configMap()
+	if err != nil {
+		return nil, err
+	}
+	entries := map[string]string{}
+	for key, id := range cm.Data {
+		if strings.HasPrefix(key, prefix) {
+			entries[key] = id
+		}
+	}
+	return entries, nil
+}
+
+func (c *AMICopyCache) store(key, id string) error {
+	cm, exists, err := c.configMap()
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = id
+	return c.save(cm, exists)
+}
+
+func (c *AMICopyCache) remove(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	cm, exists, err := c.configMap()
+	if err != nil || !exists {
+		return err
+	}
+	for _, key := range keys {
+		delete(cm.Data, key)
+	}
+	return c.save(cm, exists)
+}
+
+func (c *AMICopyCache) save(cm *corev1.ConfigMap, exists bool) error {
+	if exists {
+		if err := c.client.Update(context.TODO(), cm); err != nil {
+			return errors.Wrapf(err, "failed to update AMI copy cache %s/%s", c.namespace, AMICopyCacheName)
+		}
+		return nil
+	}
+	if err := c.client.Create(context.TODO(), cm); err != nil {
+		return errors.Wrapf(err, "failed to create AMI copy cache %s/%s", c.namespace, AMICopyCacheName)
+	}
+	return nil
+}
+
+// AMICopier copies the AMIs of a cluster's machines from the region they were
+// published in to the region of the cluster.
+type AMICopier struct {
+	scope *scope.ClusterScope
+	cache *AMICopyCache
+}
+
+// NewAMICopier returns a new AMICopier for the given scope, which records the copies it makes in the given cache.
+func NewAMICopier(scope *scope.ClusterScope, cache *AMICopyCache) *AMICopier {
+	return &AMICopier{
+		scope: scope,
+		cache: cache,
+	}
+}
+
+// keyPrefix returns the prefix of the cache keys of the copies made for the cluster in its region.
+func (c *AMICopier) keyPrefix() string {
+	return fmt.Sprintf("%s.%s.%s.", c.scope.Namespace(), c.scope.Name(), c.scope.Region())
+}
+
+func (c *AMICopier) cacheKey(sourceID, sourceRegion string) string {
+	return fmt.Sprintf("%s%s.%s", c.keyPrefix(), sourceRegion, sourceID)
+}
+
+// CopyImage returns the ID of the image to use in the region of the cluster for the
+// given AMI published in the given source region, and whether it is available.
+// If the AMI doesn't exist in the region of the cluster, it is copied there with
+// ec2:CopyImage, and the ID of the copy is returned once it is available.
+func (c *AMICopier) CopyImage(sourceID, sourceRegion string) (string, bool, error) {
+	if sourceRegion == c.scope.Region() {
+		return sourceID, true, nil
+	}
+
+	image, err := c.describeImage(sourceID)
+	if err != nil {
+		return "", false, err
+	}
+	if image != nil {
+		return sourceID, true, nil
+	}
+
+	key := c.cacheKey(sourceID, sourceRegion)
+	copyID, err := c.cache.lookup(key)
+	if err != nil {
+		return "", false, err
+	}
+
+	if copyID != "" {
+		image, err := c.describeImage(copyID)
+		if err != nil {
+			return "", false, err
+		}
+		switch {
+		case image == nil:
+			c.scope.Info("Copy of AMI no longer exists, copying again", "ami-id", sourceID, "copy-id", copyID)
+		case aws.StringValue(image.State) == ec2.ImageStateAvailable:
+			return copyID, true, nil
+		case aws.StringValue(image.State) == ec2.ImageStatePending:
+			c.scope.V(2).Info("Waiting for copy of AMI to become available", "ami-id", sourceID, "copy-id", copyID)
+			return copyID, false, nil
+		default:
+			record.Warnf(c.scope.AWSCluster, "FailedCopyImage", "Copy %q of AMI %q from region %q is %s, copying again",
+				copyID, sourceID, sourceRegion, aws.StringValue(image.State))
+			if err := c.deleteImage(image); err != nil {
+				return "", false, err
+			}
+		}
+	}
+
+	out, err := c.scope.EC2.CopyImage(&ec2.CopyImageInput{
+		ClientToken:   aws.String(fmt.Sprintf("%x", sha256.Sum256([]byte(key)))),
+		Name:          aws.String(fmt.Sprintf("%s-%s-%s", c.scope.Name(), sourceRegion, sourceID)),
+		Description:   aws.String(fmt.Sprintf("Copy of %s from %s for cluster %s", sourceID, sourceRegion, c.scope.Name())),
+		SourceImageId: aws.String(sourceID),
+		SourceRegion:  aws.String(sourceRegion),
+	})
+	if err != nil {
+		record.Warnf(c.scope.AWSCluster, "FailedCopyImage", "Failed to copy AMI %q from region %q: %v", sourceID, sourceRegion, err)
+		return "", false, errors.Wrapf(err, "failed to copy AMI %q from region %q", sourceID, sourceRegion)
+	}
+	copyID = aws.StringValue(out.ImageId)
+
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: c.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-%s", c.scope.Name(), sourceID)),
+		Additional:  c.scope.AdditionalTags(),
+	})
+	tags[AMICopiedFromTag] = fmt.Sprintf("%s/%s", sourceRegion, sourceID)
+	if _, err := c.scope.EC2.CreateTags(&ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{copyID}),
+		Tags:      converters.MapToTags(tags),
+	}); err != nil {
+		return "", false, errors.Wrapf(err, "failed to tag copy %q of AMI %q", copyID, sourceID)
+	}
+
+	if err := c.cache.store(key, copyID); err != nil {
+		return "", false, err
+	}
+
+	record.Eventf(c.scope.AWSCluster, "SuccessfulCopyImage", "Copying AMI %q from region %q to %q", sourceID, sourceRegion, copyID)
+	return copyID, false, nil
+}
+
+// DeleteCopies deregisters the AMIs copied for the cluster in its region, deletes
+// their snapshots and removes them from the cache.
+func (c *AMICopier) DeleteCopies() error {
+	entries, err := c.cache.entries(c.keyPrefix())
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key, copyID := range entries {
+		image, err := c.describeImage(copyID)
+		if err != nil {
+			return err
+		}
+		if image != nil {
+			if err := c.deleteImage(image); err != nil {
+				return err
+			}
+			record.Eventf(c.scope.AWSCluster, "SuccessfulDeregisterImage", "Deregistered copied AMI %q", copyID)
+		}
+		keys = append(keys, key)
+	}
+
+	return c.cache.remove(keys...)
+}
+
+// describeImage returns the image with the given ID in the region of the cluster, or nil if it doesn't exist.
+func (c *AMICopier) describeImage(id string) (*ec2.Image, error) {
+	out, err := c.scope.EC2.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.ImageNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe AMI %q", id)
+	}
+	if len(out.Images) == 0 {
+		return nil, nil
+	}
+	return out.Images[0], nil
+}
+
+// deleteImage deregisters the image and deletes the snapshots of its EBS volumes.
+func (c *AMICopier) deleteImage(image *ec2.Image) error {
+	if _, err := c.scope.EC2.DeregisterImage(&ec2.DeregisterImageInput{ImageId: image.ImageId}); err != nil {
+		if code, ok := awserrors.Code(err); !ok || code != awserrors.ImageNotFound {
+			return errors.Wrapf(err, "failed to deregister AMI %q", aws.StringValue(image.ImageId))
+		}
+	}
+
+	for _, mapping := range image.BlockDeviceMappings {
+		if mapping.Ebs == nil || mapping.Ebs.SnapshotId == nil {
+			continue
+		}
+		if _, err := c.scope.EC2.DeleteSnapshot(&ec2.DeleteSnapshotInput{SnapshotId: mapping.Ebs.SnapshotId}); err != nil {
+			if code, ok := awserrors.Code(err); !ok || code != awserrors.SnapshotNotFound {
+				return errors.Wrapf(err, "failed to delete snapshot %q of AMI %q", aws.StringValue(mapping.Ebs.SnapshotId), aws.StringValue(image.ImageId))
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsAMICopyPending returns true if the error was returned by AMILookupChain because
+// the copy of the machine's AMI is not available yet.
+func IsAMICopyPending(err error) bool {
+	return errors.Cause(err) == errAMICopyPending
+}
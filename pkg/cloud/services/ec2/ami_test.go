@@ -17,16 +17,28 @@ limitations under the License.
 package ec2
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestAMIs(t *testing.T) {
@@ -34,8 +46,10 @@ func TestAMIs(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	testCases := []struct {
-		name   string
-		expect func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		name       string
+		outpostARN string
+		osType     infrav1.OSType
+		expect     func(m *mock_ec2iface.MockEC2APIMockRecorder)
 	}{
 		{
 			name: "simple test",
@@ -59,6 +73,58 @@ func TestAMIs(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name:       "filters on the outpost",
+			outpostARN: testOutpostARN,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.AssignableToTypeOf(&ec2.DescribeImagesInput{})).
+					DoAndReturn(func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+						var found bool
+						for _, f := range input.Filters {
+							if aws.StringValue(f.Name) == "outpost-arn" {
+								found = reflect.DeepEqual(aws.StringValueSlice(f.Values), []string{testOutpostARN})
+							}
+						}
+						if !found {
+							t.Fatalf("expected an outpost-arn filter, got %v", input.Filters)
+						}
+						return &ec2.DescribeImagesOutput{
+							Images: []*ec2.Image{
+								{
+									ImageId:      aws.String("pretty new"),
+									CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+								},
+							},
+						}, nil
+					})
+			},
+		},
+		{
+			name:   "filters on the windows platform",
+			osType: infrav1.OSTypeWindows,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.AssignableToTypeOf(&ec2.DescribeImagesInput{})).
+					DoAndReturn(func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+						var found bool
+						for _, f := range input.Filters {
+							if aws.StringValue(f.Name) == "platform" {
+								found = reflect.DeepEqual(aws.StringValueSlice(f.Values), []string{"windows"})
+							}
+						}
+						if !found {
+							t.Fatalf("expected a platform filter, got %v", input.Filters)
+						}
+						return &ec2.DescribeImagesOutput{
+							Images: []*ec2.Image{
+								{
+									ImageId:      aws.String("pretty new"),
+									CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+								},
+							},
+						}, nil
+					})
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -79,7 +145,7 @@ func TestAMIs(t *testing.T) {
 			tc.expect(ec2Mock.EXPECT())
 
 			s := NewService(scope)
-			id, err := s.defaultAMILookup("", "", "base os-baseos version", "1.11.1")
+			id, err := s.defaultAMILookup("", "", "base os-baseos version", "1.11.1", tc.outpostARN, tc.osType)
 			if err != nil {
 				t.Fatalf("did not expect error calling a mock: %v", err)
 			}
@@ -140,10 +206,485 @@ func TestAMIsWithInvalidCreationDate(t *testing.T) {
 			tc.expect(ec2Mock.EXPECT())
 
 			s := NewService(scope)
-			_, err = s.defaultAMILookup("", "", "base os-baseos version", "1.11.1")
+			_, err = s.defaultAMILookup("", "", "base os-baseos version", "1.11.1", "", "")
 			if err == nil {
 				t.Fatalf("expected an error but did not get one")
 			}
 		})
 	}
 }
+
+func TestSSMAMILookup(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	path := "/aws/service/eks/optimized-ami/1.17/amazon-linux-2/recommended/image_id"
+
+	testCases := []struct {
+		name    string
+		lookups int
+		expect  func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		check   func(id string, err error)
+	}{
+		{
+			name:    "resolves the parameter to an AMI ID",
+			lookups: 1,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Eq(&ssm.GetParameterInput{
+					Name: aws.String(path),
+				})).
+					Return(&ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{Value: aws.String("ami-1234")},
+					}, nil)
+			},
+			check: func(id string, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if id != "ami-1234" {
+					t.Fatalf("expected ami-1234, got %q", id)
+				}
+			},
+		},
+		{
+			name:    "caches the resolved AMI ID",
+			lookups: 3,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).
+					Return(&ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{Value: aws.String("ami-1234")},
+					}, nil).Times(1)
+			},
+			check: func(id string, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if id != "ami-1234" {
+					t.Fatalf("expected ami-1234, got %q", id)
+				}
+			},
+		},
+		{
+			name:    "parameter not found",
+			lookups: 1,
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).
+					Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			check: func(id string, err error) {
+				if !awserrors.IsNotFound(err) {
+					t.Fatalf("expected not found error, got: %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					SSM: ssmMock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(ssmMock.EXPECT())
+
+			s := NewService(scope)
+			for i := 0; i < tc.lookups; i++ {
+				tc.check(s.ssmAMILookup(path))
+			}
+		})
+	}
+}
+
+func TestAMILookupChain(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	path := "/custom/ami"
+	version := "v1.17.3"
+
+	testCases := []struct {
+		name      string
+		spec      infrav1.AWSMachineSpec
+		version   *string
+		expectSSM func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		expectEC2 func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		check     func(id string, err error)
+	}{
+		{
+			name: "ami id wins",
+			spec: infrav1.AWSMachineSpec{
+				AMI:        infrav1.AWSResourceReference{ID: aws.String("ami-custom")},
+				AMISSMPath: path,
+			},
+			version:   &version,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			check: func(id string, err error) {
+				if err != nil || id != "ami-custom" {
+					t.Fatalf("expected ami-custom, got %q, %v", id, err)
+				}
+			},
+		},
+		{
+			name:    "ssm path wins",
+			spec:    infrav1.AWSMachineSpec{AMISSMPath: path},
+			version: &version,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).
+					Return(&ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{Value: aws.String("ami-ssm")},
+					}, nil)
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			check: func(id string, err error) {
+				if err != nil || id != "ami-ssm" {
+					t.Fatalf("expected ami-ssm, got %q, %v", id, err)
+				}
+			},
+		},
+		{
+			name:    "falls back to image lookup when ssm path fails",
+			spec:    infrav1.AWSMachineSpec{AMISSMPath: path},
+			version: &version,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).
+					Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.AssignableToTypeOf(&ec2.DescribeImagesInput{})).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								ImageId:      aws.String("ami-lookup"),
+								CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+							},
+						},
+					}, nil)
+			},
+			check: func(id string, err error) {
+				if err != nil || id != "ami-lookup" {
+					t.Fatalf("expected ami-lookup, got %q, %v", id, err)
+				}
+			},
+		},
+		{
+			name:      "image lookup wins",
+			spec:      infrav1.AWSMachineSpec{},
+			version:   &version,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.AssignableToTypeOf(&ec2.DescribeImagesInput{})).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								ImageId:      aws.String("ami-lookup"),
+								CreationDate: aws.String("2019-02-08T17:02:31.000Z"),
+							},
+						},
+					}, nil)
+			},
+			check: func(id string, err error) {
+				if err != nil || id != "ami-lookup" {
+					t.Fatalf("expected ami-lookup, got %q, %v", id, err)
+				}
+			},
+		},
+		{
+			name:      "image lookup fails",
+			spec:      infrav1.AWSMachineSpec{},
+			version:   &version,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(gomock.AssignableToTypeOf(&ec2.DescribeImagesInput{})).
+					Return(&ec2.DescribeImagesOutput{}, nil)
+			},
+			check: func(id string, err error) {
+				if err == nil {
+					t.Fatalf("expected an error but got %q", id)
+				}
+			},
+		},
+		{
+			name:    "ssm path fails without a version to fall back to",
+			spec:    infrav1.AWSMachineSpec{AMISSMPath: path},
+			version: nil,
+			expectSSM: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).
+					Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			check: func(id string, err error) {
+				if err == nil {
+					t.Fatalf("expected an error but got %q", id)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       tc.spec,
+			}
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+					SSM: ssmMock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:     fake.NewFakeClient(),
+				Cluster:    &clusterv1.Cluster{},
+				Machine:    &clusterv1.Machine{Spec: clusterv1.MachineSpec{Version: tc.version}},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSMachine: awsMachine,
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expectSSM(ssmMock.EXPECT())
+			tc.expectEC2(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			tc.check(s.AMILookupChain(machineScope))
+		})
+	}
+}
+
+const (
+	sourceAMI  = "ami-source"
+	copiedAMI  = "ami-copy"
+	copyKey    = "default.test-cluster.us-east-1.us-west-2.ami-source"
+	copySource = "us-west-2"
+)
+
+func newAMICopierTestScope(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, cache map[string]string) (*scope.ClusterScope, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	objs := []runtime.Object{}
+	if cache != nil {
+		objs = append(objs, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "capa-system", Name: AMICopyCacheName},
+			Data:       cache,
+		})
+	}
+	c := fake.NewFakeClientWithScheme(scheme, objs...)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{Region: "us-east-1"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+		},
+		Client: c,
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	return clusterScope, c
+}
+
+func cachedCopies(t *testing.T, c client.Client) map[string]string {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Namespace: "capa-system", Name: AMICopyCacheName}, cm); err != nil {
+		t.Fatalf("failed to get AMI copy cache: %v", err)
+	}
+	return cm.Data
+}
+
+func expectImage(m *mock_ec2iface.MockEC2APIMockRecorder, id string, image *ec2.Image) {
+	call := m.DescribeImages(gomock.Eq(&ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{id})}))
+	if image == nil {
+		call.Return(nil, awserr.New(awserrors.ImageNotFound, "not found", nil))
+		return
+	}
+	call.Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{image}}, nil)
+}
+
+func TestAMICopierCopyImage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name            string
+		cache           map[string]string
+		expect          func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectID        string
+		expectAvailable bool
+		expectCache     map[string]string
+	}{
+		{
+			name: "uses the AMI if it exists in the region of the cluster",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectImage(m, sourceAMI, &ec2.Image{ImageId: aws.String(sourceAMI), State: aws.String(ec2.ImageStateAvailable)})
+			},
+			expectID:        sourceAMI,
+			expectAvailable: true,
+		},
+		{
+			name: "copies the AMI from its source region and caches the copy",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectImage(m, sourceAMI, nil)
+				m.CopyImage(gomock.AssignableToTypeOf(&ec2.CopyImageInput{})).
+					DoAndReturn(func(input *ec2.CopyImageInput) (*ec2.CopyImageOutput, error) {
+						if aws.StringValue(input.SourceImageId) != sourceAMI || aws.StringValue(input.SourceRegion) != copySource {
+							t.Errorf("unexpected copy of %q from %q", aws.StringValue(input.SourceImageId), aws.StringValue(input.SourceRegion))
+						}
+						return &ec2.CopyImageOutput{ImageId: aws.String(copiedAMI)}, nil
+					})
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					DoAndReturn(func(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+						for _, tag := range input.Tags {
+							if aws.StringValue(tag.Key) == AMICopiedFromTag && aws.StringValue(tag.Value) == "us-west-2/ami-source" {
+								return &ec2.CreateTagsOutput{}, nil
+							}
+						}
+						t.Errorf("expected the copy to be tagged with %q, got %v", AMICopiedFromTag, input.Tags)
+						return &ec2.CreateTagsOutput{}, nil
+					})
+			},
+			expectID:        copiedAMI,
+			expectAvailable: false,
+			expectCache:     map[string]string{copyKey: copiedAMI},
+		},
+		{
+			name:  "waits for a pending copy",
+			cache: map[string]string{copyKey: copiedAMI},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectImage(m, sourceAMI, nil)
+				expectImage(m, copiedAMI, &ec2.Image{ImageId: aws.String(copiedAMI), State: aws.String(ec2.ImageStatePending)})
+			},
+			expectID:        copiedAMI,
+			expectAvailable: false,
+			expectCache:     map[string]string{copyKey: copiedAMI},
+		},
+		{
+			name:  "uses an available copy",
+			cache: map[string]string{copyKey: copiedAMI},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectImage(m, sourceAMI, nil)
+				expectImage(m, copiedAMI, &ec2.Image{ImageId: aws.String(copiedAMI), State: aws.String(ec2.ImageStateAvailable)})
+			},
+			expectID:        copiedAMI,
+			expectAvailable: true,
+			expectCache:     map[string]string{copyKey: copiedAMI},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			clusterScope, c := newAMICopierTestScope(t, ec2Mock, tc.cache)
+			tc.expect(ec2Mock.EXPECT())
+
+			copier := NewAMICopier(clusterScope, NewAMICopyCache(c, "capa-system"))
+			id, available, err := copier.CopyImage(sourceAMI, copySource)
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+			if id != tc.expectID || available != tc.expectAvailable {
+				t.Errorf("expected %q (available: %v), got %q (available: %v)", tc.expectID, tc.expectAvailable, id, available)
+			}
+			if tc.expectCache != nil {
+				if got := cachedCopies(t, c); !reflect.DeepEqual(got, tc.expectCache) {
+					t.Errorf("expected cache %v, got %v", tc.expectCache, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAMILookupChainWaitsForCopy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope, c := newAMICopierTestScope(t, ec2Mock, map[string]string{copyKey: copiedAMI})
+	expectImage(ec2Mock.EXPECT(), sourceAMI, nil)
+	expectImage(ec2Mock.EXPECT(), copiedAMI, &ec2.Image{ImageId: aws.String(copiedAMI), State: aws.String(ec2.ImageStatePending)})
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:     fake.NewFakeClient(),
+		Cluster:    &clusterv1.Cluster{},
+		Machine:    &clusterv1.Machine{},
+		AWSCluster: &infrav1.AWSCluster{},
+		AWSMachine: &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSMachineSpec{
+				AMI:             infrav1.AWSResourceReference{ID: aws.String(sourceAMI)},
+				AMISourceRegion: copySource,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	s := NewService(clusterScope).WithAMICopier(NewAMICopier(clusterScope, NewAMICopyCache(c, "capa-system")))
+	if _, err := s.AMILookupChain(machineScope); !IsAMICopyPending(err) {
+		t.Fatalf("expected the AMI copy to be pending, got %v", err)
+	}
+}
+
+func TestAMICopierDeleteCopies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	otherKey := "default.other-cluster.us-east-1.us-west-2.ami-source"
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope, c := newAMICopierTestScope(t, ec2Mock, map[string]string{
+		copyKey:  copiedAMI,
+		otherKey: "ami-other",
+	})
+
+	expectImage(ec2Mock.EXPECT(), copiedAMI, &ec2.Image{
+		ImageId: aws.String(copiedAMI),
+		State:   aws.String(ec2.ImageStateAvailable),
+		BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+			{DeviceName: aws.String("/dev/sda1"), Ebs: &ec2.EbsBlockDevice{SnapshotId: aws.String("snap-1")}},
+			{DeviceName: aws.String("/dev/sdb"), VirtualName: aws.String("ephemeral0")},
+		},
+	})
+	ec2Mock.EXPECT().DeregisterImage(gomock.Eq(&ec2.DeregisterImageInput{ImageId: aws.String(copiedAMI)})).
+		Return(&ec2.DeregisterImageOutput{}, nil)
+	ec2Mock.EXPECT().DeleteSnapshot(gomock.Eq(&ec2.DeleteSnapshotInput{SnapshotId: aws.String("snap-1")})).
+		Return(&ec2.DeleteSnapshotOutput{}, nil)
+
+	copier := NewAMICopier(clusterScope, NewAMICopyCache(c, "capa-system"))
+	if err := copier.DeleteCopies(); err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	// Copies made for other clusters are kept.
+	expected := map[string]string{otherKey: "ami-other"}
+	if got := cachedCopies(t, c); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected cache %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// CapacityCheckReconciler picks the failure domain an instance is launched in.
+// Failure domains whose availability zone does not offer the instance type, or
+// ran out of capacity for it, are skipped in favour of the next failure domain
+// of the cluster.
+type CapacityCheckReconciler struct {
+	scope *scope.ClusterScope
+}
+
+// NewCapacityCheckReconciler returns a new CapacityCheckReconciler for the given scope.
+func NewCapacityCheckReconciler(scope *scope.ClusterScope) *CapacityCheckReconciler {
+	return &CapacityCheckReconciler{
+		scope: scope,
+	}
+}
+
+// SelectFailureDomain returns the first failure domain, starting at the preferred one and
+// going round-robin through the failure domains of the cluster, whose availability zone
+// offers the instance type of the machine. Exhausted failure domains, in which the
+// instance could not be launched for lack of capacity, are skipped.
+func (r *CapacityCheckReconciler) SelectFailureDomain(machineScope *scope.MachineScope, preferred string, exhausted ...string) (string, error) {
+	instanceType := machineScope.AWSMachine.Spec.InstanceType
+
+	candidates := r.candidates(machineScope, preferred, exhausted)
+	if len(candidates) == 0 {
+		record.Warnf(machineScope.AWSMachine, "InsufficientCapacity",
+			"No failure domain has capacity for instance type %q", instanceType)
+		return "", errors.Errorf("no failure domain has capacity for instance type %q", instanceType)
+	}
+
+	out, err := r.scope.EC2.DescribeInstanceTypeOfferings(&ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			{Name: aws.String("location"), Values: aws.StringSlice(candidates)},
+			{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{instanceType})},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe offerings of instance type %q", instanceType)
+	}
+
+	offered := map[string]bool{}
+	for _, offering := range out.InstanceTypeOfferings {
+		offered[aws.StringValue(offering.Location)] = true
+	}
+
+	for _, zone := range candidates {
+		if offered[zone] {
+			if zone != preferred {
+				record.Eventf(machineScope.AWSMachine, "FailureDomainFallback",
+					"Instance type %q is not available in failure domain %q, using %q instead", instanceType, preferred, zone)
+			}
+			return zone, nil
+		}
+		r.scope.V(2).Info("Instance type not offered in failure domain", "instance-type", instanceType, "failure-domain", zone)
+	}
+
+	record.Warnf(machineScope.AWSMachine, "InsufficientCapacity",
+		"No failure domain has capacity for instance type %q", instanceType)
+	return "", errors.Errorf("no failure domain has capacity for instance type %q", instanceType)
+}
+
+// candidates returns the failure domains with private subnets the machine can be placed in, in the
+// order they should be tried.
+func (r *CapacityCheckReconciler) candidates(machineScope *scope.MachineScope, preferred string, exhausted []string) []string {
+	skip := map[string]bool{}
+	for _, zone := range exhausted {
+		skip[zone] = true
+	}
+
+	zones := []string{}
+	for zone, spec := range r.scope.AWSCluster.Status.FailureDomains {
+		if machineScope.IsControlPlane() && !spec.ControlPlane {
+			continue
+		}
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	// Start at the preferred failure domain, even if it isn't one of the cluster.
+	start := sort.SearchStrings(zones, preferred)
+	if start == len(zones) || zones[start] != preferred {
+		zones = append([]string{preferred}, append(zones[start:], zones[:start]...)...)
+	} else {
+		zones = append(zones[start:], zones[:start]...)
+	}
+
+	candidates := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		if skip[zone] || len(r.scope.Subnets().FilterPrivate().FilterByZone(zone)) == 0 {
+			continue
+		}
+		candidates = append(candidates, zone)
+	}
+	return candidates
+}
+
+// isInsufficientCapacity returns true if the instance could not be launched because its availability zone
+// has no capacity left for the instance type.
+func isInsufficientCapacity(err error) bool {
+	code, ok := awserrors.Code(errors.Cause(err))
+	return ok && code == awserrors.InsufficientCapacity
+}
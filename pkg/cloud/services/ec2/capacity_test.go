@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func offerings(zones ...string) *ec2.DescribeInstanceTypeOfferingsOutput {
+	out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+	for _, zone := range zones {
+		out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, &ec2.InstanceTypeOffering{
+			InstanceType: aws.String("p4d.24xlarge"),
+			Location:     aws.String(zone),
+		})
+	}
+	return out
+}
+
+func TestSelectFailureDomain(t *testing.T) {
+	testCases := []struct {
+		name         string
+		controlPlane bool
+		preferred    string
+		exhausted    []string
+		expect       func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expected     string
+		expectErr    bool
+	}{
+		{
+			name:      "preferred failure domain offers the instance type",
+			preferred: "us-east-1b",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypeOfferings(gomock.Eq(&ec2.DescribeInstanceTypeOfferingsInput{
+					LocationType: aws.String("availability-zone"),
+					Filters: []*ec2.Filter{
+						{Name: aws.String("location"), Values: aws.StringSlice([]string{"us-east-1b", "us-east-1c", "us-east-1a"})},
+						{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{"p4d.24xlarge"})},
+					},
+				})).Return(offerings("us-east-1a", "us-east-1b"), nil)
+			},
+			expected: "us-east-1b",
+		},
+		{
+			name:      "fall back to the next failure domain",
+			preferred: "us-east-1b",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypeOfferings(gomock.Any()).Return(offerings("us-east-1a", "us-east-1c"), nil)
+			},
+			expected: "us-east-1c",
+		},
+		{
+			name:      "skip exhausted failure domains",
+			preferred: "us-east-1b",
+			exhausted: []string{"us-east-1b", "us-east-1c"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypeOfferings(gomock.Eq(&ec2.DescribeInstanceTypeOfferingsInput{
+					LocationType: aws.String("availability-zone"),
+					Filters: []*ec2.Filter{
+						{Name: aws.String("location"), Values: aws.StringSlice([]string{"us-east-1a"})},
+						{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{"p4d.24xlarge"})},
+					},
+				})).Return(offerings("us-east-1a", "us-east-1b", "us-east-1c"), nil)
+			},
+			expected: "us-east-1a",
+		},
+		{
+			name:         "control plane machines only use control plane failure domains",
+			controlPlane: true,
+			preferred:    "us-east-1a",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypeOfferings(gomock.Any()).Return(offerings("us-east-1c"), nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:      "no failure domain offers the instance type",
+			preferred: "us-east-1a",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypeOfferings(gomock.Any()).Return(offerings(), nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:      "all failure domains are exhausted",
+			preferred: "us-east-1a",
+			exhausted: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+							{ID: "subnet-3", AvailabilityZone: "us-east-1c"},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					FailureDomains: clusterv1.FailureDomains{
+						"us-east-1a": clusterv1.FailureDomainSpec{ControlPlane: true},
+						"us-east-1b": clusterv1.FailureDomainSpec{ControlPlane: true},
+						"us-east-1c": clusterv1.FailureDomainSpec{},
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: awsCluster,
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			machine := &clusterv1.Machine{}
+			if tc.controlPlane {
+				machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabelName: ""}
+			}
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:     fake.NewFakeClient(),
+				Cluster:    &clusterv1.Cluster{},
+				Machine:    machine,
+				AWSCluster: awsCluster,
+				AWSMachine: &infrav1.AWSMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       infrav1.AWSMachineSpec{InstanceType: "p4d.24xlarge"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			zone, err := NewCapacityCheckReconciler(clusterScope).SelectFailureDomain(machineScope, tc.preferred, tc.exhausted...)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error but got failure domain %q", zone)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+			if zone != tc.expected {
+				t.Fatalf("expected failure domain %q, got %q", tc.expected, zone)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// EIPPoolReconciler keeps the Elastic IPs of the pool of a cluster allocated until its NAT gateways
+// are created, so that creating them does not wait for their addresses to be allocated.
+type EIPPoolReconciler struct {
+	service *Service
+}
+
+// NewEIPPoolReconciler returns a new EIPPoolReconciler for the given service.
+func NewEIPPoolReconciler(service *Service) *EIPPoolReconciler {
+	return &EIPPoolReconciler{
+		service: service,
+	}
+}
+
+// Reconcile records the unassociated Elastic IPs of the pool in the status of the cluster, allocating
+// the missing ones while its NAT gateways are not ready. Elastic IPs are found by their tags, so that
+// the pool survives a failure to update the status of the cluster.
+func (r *EIPPoolReconciler) Reconcile() error {
+	s := r.service
+	spec := s.scope.EIPPool()
+	if spec == nil || s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Network().PreallocatedEIPs = nil
+		s.deleteCondition(infrav1.EIPPoolReadyCondition)
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling Elastic IP pool", "pre-allocated", spec.PreAllocated)
+
+	ids, err := s.describePooledAddresses()
+	if err != nil {
+		return err
+	}
+
+	if !s.isConditionTrue(infrav1.NatGatewaysReadyCondition) {
+		for len(ids) < spec.PreAllocated {
+			id, err := s.allocateAddress(infrav1.EIPPoolRoleTagValue)
+			if err != nil {
+				s.scope.Network().PreallocatedEIPs = ids
+				return err
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	s.scope.Network().PreallocatedEIPs = ids
+	s.markConditionTrue(infrav1.EIPPoolReadyCondition)
+	return nil
+}
+
+// Delete releases the Elastic IPs of the pool which are not used by a NAT gateway. The ones used by
+// a NAT gateway are released along with the other Elastic IPs of the cluster.
+func (r *EIPPoolReconciler) Delete() error {
+	s := r.service
+	if s.scope.EIPPool() == nil && len(s.scope.Network().PreallocatedEIPs) == 0 {
+		return nil
+	}
+
+	ids, err := s.describePooledAddresses()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if _, err := s.scope.EC2.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: aws.String(id)}); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.AuthFailure); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedReleaseEIP", "Failed to release pre-allocated Elastic IP %q: %v", id, err)
+			return errors.Wrapf(err, "failed to release pre-allocated Elastic IP %q", id)
+		}
+		s.scope.Info("Released pre-allocated Elastic IP", "allocation-id", id)
+	}
+
+	s.scope.Network().PreallocatedEIPs = nil
+	return nil
+}
+
+// describePooledAddresses returns the allocation IDs of the Elastic IPs of the pool which are not
+// associated with a NAT gateway.
+func (s *Service) describePooledAddresses() ([]string, error) {
+	out, err := s.describeAddresses(infrav1.EIPPoolRoleTagValue)
+	if err != nil {
+		record.Eventf(s.scope.AWSCluster, "FailedDescribeAddresses", "Failed to query addresses for role %q: %v", infrav1.EIPPoolRoleTagValue, err)
+		return nil, errors.Wrap(err, "failed to query pre-allocated addresses")
+	}
+
+	var ids []string
+	for _, address := range out.Addresses {
+		if address.AssociationId == nil {
+			ids = append(ids, aws.StringValue(address.AllocationId))
+		}
+	}
+	return ids, nil
+}
+
+// takePreallocatedAddresses removes up to the given number of Elastic IPs from the pool of the
+// cluster and returns their allocation IDs.
+func (s *Service) takePreallocatedAddresses(num int) []string {
+	pool := s.scope.Network().PreallocatedEIPs
+	if num > len(pool) {
+		num = len(pool)
+	}
+	s.scope.Network().PreallocatedEIPs = pool[num:]
+	return pool[:num]
+}
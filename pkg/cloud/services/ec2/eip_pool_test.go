@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newEIPPoolTestScope(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, pool *infrav1.EIPPoolSpec, preallocated []string, subnets infrav1.Subnets) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: subnetsVPCID,
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: subnets,
+				EIPPool: pool,
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.Network{PreallocatedEIPs: preallocated},
+		},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func describePooledAddressesInput() *ec2.DescribeAddressesInput {
+	return &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster("test-cluster"),
+			filter.EC2.ProviderRole(infrav1.EIPPoolRoleTagValue),
+		},
+	}
+}
+
+func TestEIPPoolReconcilerReconcile(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name              string
+		pool              *infrav1.EIPPoolSpec
+		natGatewaysReady  bool
+		expect            func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantPreallocated  []string
+		wantConditionTrue bool
+	}{
+		{
+			name: "no pool, does nothing",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "allocates the missing Elastic IPs of the pool",
+			pool: &infrav1.EIPPoolSpec{PreAllocated: 3},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(describePooledAddressesInput())).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{AllocationId: aws.String("eipalloc-1")},
+							{AllocationId: aws.String("eipalloc-2"), AssociationId: aws.String("eipassoc-2")},
+						},
+					}, nil)
+				gomock.InOrder(
+					m.AllocateAddress(&ec2.AllocateAddressInput{Domain: aws.String("vpc")}).
+						Return(&ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-3")}, nil),
+					m.AllocateAddress(&ec2.AllocateAddressInput{Domain: aws.String("vpc")}).
+						Return(&ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-4")}, nil),
+				)
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					Return(nil, nil).
+					Times(2)
+			},
+			wantPreallocated:  []string{"eipalloc-1", "eipalloc-3", "eipalloc-4"},
+			wantConditionTrue: true,
+		},
+		{
+			name:             "does not allocate Elastic IPs once the NAT gateways are ready",
+			pool:             &infrav1.EIPPoolSpec{PreAllocated: 3},
+			natGatewaysReady: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Eq(describePooledAddressesInput())).
+					Return(&ec2.DescribeAddressesOutput{
+						Addresses: []*ec2.Address{
+							{AllocationId: aws.String("eipalloc-1")},
+						},
+					}, nil)
+				m.AllocateAddress(gomock.Any()).Times(0)
+			},
+			wantPreallocated:  []string{"eipalloc-1"},
+			wantConditionTrue: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			clusterScope := newEIPPoolTestScope(t, ec2Mock, tc.pool, nil, nil)
+			if tc.natGatewaysReady {
+				conditions.MarkTrue(clusterScope.AWSCluster, infrav1.NatGatewaysReadyCondition)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			if err := NewEIPPoolReconciler(NewService(clusterScope)).Reconcile(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if got := clusterScope.Network().PreallocatedEIPs; !reflect.DeepEqual(got, tc.wantPreallocated) {
+				t.Fatalf("expected pre-allocated Elastic IPs %v, got %v", tc.wantPreallocated, got)
+			}
+			if got := conditions.IsTrue(clusterScope.AWSCluster, infrav1.EIPPoolReadyCondition); got != tc.wantConditionTrue {
+				t.Fatalf("expected condition %s to be %v", infrav1.EIPPoolReadyCondition, tc.wantConditionTrue)
+			}
+		})
+	}
+}
+
+func TestReconcileNatGatewaysUsesPreallocatedEIPs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope := newEIPPoolTestScope(t, ec2Mock, &infrav1.EIPPoolSpec{PreAllocated: 2}, []string{"eipalloc-1", "eipalloc-2"}, infrav1.Subnets{
+		{ID: "subnet-1", AvailabilityZone: "us-east-1a", CidrBlock: "10.0.10.0/24", IsPublic: true},
+		{ID: "subnet-2", AvailabilityZone: "us-east-1a", CidrBlock: "10.0.12.0/24", IsPublic: false},
+	})
+
+	m := ec2Mock.EXPECT()
+	m.DescribeNatGatewaysPages(gomock.Any(), gomock.Any()).Return(nil)
+	// The address comes from the pool, so none is described or allocated.
+	m.DescribeAddresses(gomock.Any()).Times(0)
+	m.AllocateAddress(gomock.Any()).Times(0)
+	m.CreateNatGateway(gomock.AssignableToTypeOf(&ec2.CreateNatGatewayInput{})).
+		DoAndReturn(func(input *ec2.CreateNatGatewayInput) (*ec2.CreateNatGatewayOutput, error) {
+			if got := aws.StringValue(input.AllocationId); got != "eipalloc-1" {
+				t.Errorf("expected NAT gateway to use pre-allocated Elastic IP %q, got %q", "eipalloc-1", got)
+			}
+			return &ec2.CreateNatGatewayOutput{
+				NatGateway: &ec2.NatGateway{NatGatewayId: aws.String("natgateway"), SubnetId: input.SubnetId},
+			}, nil
+		})
+	m.WaitUntilNatGatewayAvailable(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{aws.String("natgateway")},
+	}).Return(nil)
+
+	if err := NewService(clusterScope).reconcileNatGateways(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if got := clusterScope.Network().PreallocatedEIPs; !reflect.DeepEqual(got, []string{"eipalloc-2"}) {
+		t.Fatalf("expected Elastic IP %q to be left in the pool, got %v", "eipalloc-2", got)
+	}
+}
+
+func TestEIPPoolReconcilerDelete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope := newEIPPoolTestScope(t, ec2Mock, &infrav1.EIPPoolSpec{PreAllocated: 2}, []string{"eipalloc-1"}, nil)
+
+	m := ec2Mock.EXPECT()
+	m.DescribeAddresses(gomock.Eq(describePooledAddressesInput())).
+		Return(&ec2.DescribeAddressesOutput{
+			Addresses: []*ec2.Address{
+				{AllocationId: aws.String("eipalloc-1")},
+				{AllocationId: aws.String("eipalloc-2"), AssociationId: aws.String("eipassoc-2")},
+			},
+		}, nil)
+	// The Elastic IP used by a NAT gateway is released along with the other ones of the cluster.
+	m.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-1")}).
+		Return(&ec2.ReleaseAddressOutput{}, nil)
+
+	if err := NewEIPPoolReconciler(NewService(clusterScope)).Delete(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if got := clusterScope.Network().PreallocatedEIPs; got != nil {
+		t.Fatalf("expected the pool to be empty, got %v", got)
+	}
+}
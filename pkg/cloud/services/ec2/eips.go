@@ -30,21 +30,33 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 )
 
-func (s *Service) getOrAllocateAddress(role string) (string, error) {
+// getOrAllocateAddresses returns the allocation IDs of the given number of distinct addresses for
+// the role, reusing the unassociated addresses of the role before allocating new ones.
+func (s *Service) getOrAllocateAddresses(num int, role string) ([]string, error) {
 	out, err := s.describeAddresses(role)
 	if err != nil {
 		record.Eventf(s.scope.AWSCluster, "FailedDescribeAddresses", "Failed to query addresses for role %q: %v", role, err)
-		return "", errors.Wrap(err, "failed to query addresses")
+		return nil, errors.Wrap(err, "failed to query addresses")
 	}
 
-	// TODO: better handle multiple addresses returned
+	var ids []string
 	for _, address := range out.Addresses {
+		if len(ids) == num {
+			break
+		}
 		if address.AssociationId == nil {
-			return aws.StringValue(address.AllocationId), nil
+			ids = append(ids, aws.StringValue(address.AllocationId))
 		}
 	}
 
-	return s.allocateAddress(role)
+	for len(ids) < num {
+		id, err := s.allocateAddress(role)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
 func (s *Service) allocateAddress(role string) (string, error) {
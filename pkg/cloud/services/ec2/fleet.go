@@ -0,0 +1,340 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+)
+
+const (
+	launchTemplateNotFound      = "InvalidLaunchTemplateName.NotFoundException"
+	launchTemplateAlreadyExists = "InvalidLaunchTemplateName.AlreadyExistsException"
+)
+
+// errFleetPending is returned by CreateInstance while the EC2 Fleet of a machine in fleet mode has not
+// launched its instance yet.
+var errFleetPending = errors.New("EC2 Fleet has not launched the instance yet")
+
+// IsFleetPending returns true if the error was returned by CreateInstance because the EC2 Fleet of the
+// machine has not launched its instance yet.
+func IsFleetPending(err error) bool {
+	return errors.Cause(err) == errFleetPending
+}
+
+// createFleet creates the launch template and the EC2 Fleet launching the instance of a machine in fleet mode.
+// The fleet overrides the instance type of the template with the instance type requirements of the machine.
+func (s *Service) createFleet(scope *scope.MachineScope, i *infrav1.Instance) error {
+	reqs := scope.AWSMachine.Spec.InstanceTypeRequirements
+	if reqs == nil {
+		return errors.New("instance type requirements must be set in fleet mode")
+	}
+	if err := s.checkImageArchitecture(i.ImageID, reqs.Architecture); err != nil {
+		scope.SetFailureReason(capierrors.CreateMachineError)
+		scope.SetFailureMessage(err)
+		return err
+	}
+
+	input, err := s.runInstancesInput(scope.Role(), i)
+	if err != nil {
+		return err
+	}
+	launchTemplateID, err := s.createFleetLaunchTemplate(scope, input, i.Tags)
+	if err != nil {
+		return err
+	}
+
+	fleetInput := &ec2.CreateFleetInput{
+		Type: aws.String(ec2.FleetTypeRequest),
+		TargetCapacitySpecification: &ec2.TargetCapacitySpecificationRequest{
+			TotalTargetCapacity: aws.Int64(1),
+		},
+		LaunchTemplateConfigs: []*ec2.FleetLaunchTemplateConfigRequest{{
+			LaunchTemplateSpecification: &ec2.FleetLaunchTemplateSpecificationRequest{
+				LaunchTemplateId: aws.String(launchTemplateID),
+				Version:          aws.String("$Latest"),
+			},
+			Overrides: []*ec2.FleetLaunchTemplateOverridesRequest{{
+				SubnetId:             input.SubnetId,
+				InstanceRequirements: instanceRequirements(reqs),
+			}},
+		}},
+		TagSpecifications: []*ec2.TagSpecification{tagSpecification(ec2.ResourceTypeFleet, i.Tags)},
+	}
+	switch strategy := scope.AWSMachine.Spec.FleetAllocationStrategy; strategy {
+	case infrav1.FleetAllocationStrategyDiversified, infrav1.FleetAllocationStrategyCapacityOptimized:
+		fleetInput.TargetCapacitySpecification.DefaultTargetCapacityType = aws.String(ec2.DefaultTargetCapacityTypeSpot)
+		fleetInput.SpotOptions = &ec2.SpotOptionsRequest{AllocationStrategy: aws.String(string(strategy))}
+	default:
+		fleetInput.TargetCapacitySpecification.DefaultTargetCapacityType = aws.String(ec2.DefaultTargetCapacityTypeOnDemand)
+		fleetInput.OnDemandOptions = &ec2.OnDemandOptionsRequest{AllocationStrategy: aws.String(ec2.FleetOnDemandAllocationStrategyLowestPrice)}
+	}
+
+	out, err := s.scope.EC2.CreateFleet(fleetInput)
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedCreateFleet", "Failed to create EC2 Fleet: %v", err)
+		if err := s.deleteFleetLaunchTemplate(scope); err != nil {
+			s.scope.Error(err, "failed to delete launch template of EC2 Fleet")
+		}
+		return errors.Wrap(err, "failed to create EC2 Fleet")
+	}
+
+	fleetID := aws.StringValue(out.FleetId)
+	scope.AWSMachine.Status.FleetID = aws.String(fleetID)
+	record.Eventf(scope.AWSMachine, "SuccessfulCreateFleet", "Created EC2 Fleet %q", fleetID)
+	return nil
+}
+
+// createFleetLaunchTemplate creates the launch template of the EC2 Fleet of a machine from the input of the
+// RunInstances call that would launch its instance. A template left over by a previous attempt is replaced.
+func (s *Service) createFleetLaunchTemplate(scope *scope.MachineScope, input *ec2.RunInstancesInput, tags infrav1.Tags) (string, error) {
+	name := fleetLaunchTemplateName(scope)
+	createInput := &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+		LaunchTemplateData: launchTemplateData(input),
+		TagSpecifications:  []*ec2.TagSpecification{tagSpecification(ec2.ResourceTypeLaunchTemplate, tags)},
+	}
+
+	out, err := s.scope.EC2.CreateLaunchTemplate(createInput)
+	if code, _ := awserrors.Code(err); code == launchTemplateAlreadyExists {
+		if err := s.deleteFleetLaunchTemplate(scope); err != nil {
+			return "", err
+		}
+		out, err = s.scope.EC2.CreateLaunchTemplate(createInput)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create launch template %q", name)
+	}
+	return aws.StringValue(out.LaunchTemplate.LaunchTemplateId), nil
+}
+
+// fleetInstance returns the instance launched by the EC2 Fleet of a machine in fleet mode, or errFleetPending
+// until the fleet has launched it. Fleets that failed are deleted for the next reconciliation to create another one.
+func (s *Service) fleetInstance(scope *scope.MachineScope) (*infrav1.Instance, error) {
+	fleetID := aws.StringValue(scope.AWSMachine.Status.FleetID)
+
+	out, err := s.scope.EC2.DescribeFleets(&ec2.DescribeFleetsInput{
+		FleetIds: aws.StringSlice([]string{fleetID}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe EC2 Fleet %q", fleetID)
+	}
+	if len(out.Fleets) == 0 {
+		scope.AWSMachine.Status.FleetID = nil
+		return nil, errors.Errorf("EC2 Fleet %q not found", fleetID)
+	}
+
+	fleet := out.Fleets[0]
+	switch aws.StringValue(fleet.FleetState) {
+	case ec2.FleetStateCodeFailed, ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating:
+		return nil, s.failFleet(scope, fleet)
+	}
+	if aws.Float64Value(fleet.FulfilledCapacity) < 1 {
+		if aws.StringValue(fleet.ActivityStatus) == ec2.FleetActivityStatusError {
+			return nil, s.failFleet(scope, fleet)
+		}
+		return nil, errors.Wrapf(errFleetPending, "EC2 Fleet %q is %s", fleetID, aws.StringValue(fleet.ActivityStatus))
+	}
+
+	instances, err := s.scope.EC2.DescribeFleetInstances(&ec2.DescribeFleetInstancesInput{
+		FleetId: aws.String(fleetID),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instances of EC2 Fleet %q", fleetID)
+	}
+	if len(instances.ActiveInstances) == 0 {
+		return nil, errors.Wrapf(errFleetPending, "EC2 Fleet %q has no active instance", fleetID)
+	}
+
+	instanceID := instances.ActiveInstances[0].InstanceId
+	instance, err := s.InstanceIfExists(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, errors.Wrapf(errFleetPending, "instance %q of EC2 Fleet %q is not running yet", aws.StringValue(instanceID), fleetID)
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulCreate", "Created new %s instance with id %q of type %q with EC2 Fleet %q", scope.Role(), instance.ID, instance.Type, fleetID)
+	return instance, nil
+}
+
+// failFleet deletes a fleet that failed to launch the instance of a machine, and returns the error it reported.
+func (s *Service) failFleet(scope *scope.MachineScope, fleet *ec2.FleetData) error {
+	fleetID := aws.StringValue(fleet.FleetId)
+	messages := make([]string, 0, len(fleet.Errors))
+	for _, e := range fleet.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+	}
+	err := errors.Errorf("EC2 Fleet %q failed to launch the instance: %s", fleetID, strings.Join(messages, "; "))
+	record.Warnf(scope.AWSMachine, "FailedCreate", "Failed to create instance: %v", err)
+
+	if deleteErr := s.DeleteFleet(scope, true); deleteErr != nil {
+		return errors.Wrapf(deleteErr, "failed to delete EC2 Fleet %q that failed to launch the instance", fleetID)
+	}
+	return err
+}
+
+// DeleteFleet deletes the EC2 Fleet of a machine in fleet mode and its launch template, terminating the
+// instance it launched if terminateInstances is set.
+func (s *Service) DeleteFleet(scope *scope.MachineScope, terminateInstances bool) error {
+	if scope.AWSMachine.Status.FleetID == nil {
+		return nil
+	}
+	fleetID := *scope.AWSMachine.Status.FleetID
+
+	out, err := s.scope.EC2.DeleteFleets(&ec2.DeleteFleetsInput{
+		FleetIds:           aws.StringSlice([]string{fleetID}),
+		TerminateInstances: aws.Bool(terminateInstances),
+	})
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedDeleteFleet", "Failed to delete EC2 Fleet %q: %v", fleetID, err)
+		return errors.Wrapf(err, "failed to delete EC2 Fleet %q", fleetID)
+	}
+	for _, unsuccessful := range out.UnsuccessfulFleetDeletions {
+		if unsuccessful.Error == nil || aws.StringValue(unsuccessful.Error.Code) == ec2.DeleteFleetErrorCodeFleetIdDoesNotExist {
+			continue
+		}
+		record.Warnf(scope.AWSMachine, "FailedDeleteFleet", "Failed to delete EC2 Fleet %q: %s", fleetID, aws.StringValue(unsuccessful.Error.Message))
+		return errors.Errorf("failed to delete EC2 Fleet %q: %s: %s", fleetID, aws.StringValue(unsuccessful.Error.Code), aws.StringValue(unsuccessful.Error.Message))
+	}
+
+	if err := s.deleteFleetLaunchTemplate(scope); err != nil {
+		return err
+	}
+
+	scope.AWSMachine.Status.FleetID = nil
+	record.Eventf(scope.AWSMachine, "SuccessfulDeleteFleet", "Deleted EC2 Fleet %q", fleetID)
+	return nil
+}
+
+func (s *Service) deleteFleetLaunchTemplate(scope *scope.MachineScope) error {
+	name := fleetLaunchTemplateName(scope)
+	_, err := s.scope.EC2.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+	})
+	if code, _ := awserrors.Code(err); err != nil && code != launchTemplateNotFound {
+		return errors.Wrapf(err, "failed to delete launch template %q", name)
+	}
+	return nil
+}
+
+// checkImageArchitecture checks that the image has the architecture of the instance types the fleet may launch,
+// as the fleet only launches instance types of the architecture of the image.
+func (s *Service) checkImageArchitecture(imageID, architecture string) error {
+	if architecture == "" {
+		architecture = infrav1.ArchitectureX8664
+	}
+	out, err := s.scope.EC2.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe image %q", imageID)
+	}
+	if len(out.Images) == 0 {
+		return errors.Errorf("no images returned when looking up ID %q", imageID)
+	}
+	if arch := aws.StringValue(out.Images[0].Architecture); arch != architecture {
+		return errors.Errorf("image %q has architecture %q, but the instance type requirements have architecture %q", imageID, arch, architecture)
+	}
+	return nil
+}
+
+// fleetLaunchTemplateName returns the name of the launch template of the EC2 Fleet of a machine.
+func fleetLaunchTemplateName(scope *scope.MachineScope) string {
+	return fmt.Sprintf("%s/%s", scope.Namespace(), scope.Name())
+}
+
+// instanceRequirements returns the instance requirements of the EC2 Fleet overrides.
+func instanceRequirements(reqs *infrav1.InstanceTypeRequirements) *ec2.InstanceRequirementsRequest {
+	return &ec2.InstanceRequirementsRequest{
+		VCpuCount: &ec2.VCpuCountRangeRequest{
+			Min: aws.Int64(reqs.MinVCPUs),
+			Max: reqs.MaxVCPUs,
+		},
+		MemoryMiB: &ec2.MemoryMiBRequest{
+			Min: aws.Int64(reqs.MinMemoryMiB),
+			Max: reqs.MaxMemoryMiB,
+		},
+	}
+}
+
+// launchTemplateData returns the launch template data launching the same instance as the RunInstances input,
+// but for its instance type.
+func launchTemplateData(input *ec2.RunInstancesInput) *ec2.RequestLaunchTemplateData {
+	data := &ec2.RequestLaunchTemplateData{
+		ImageId:          input.ImageId,
+		KeyName:          input.KeyName,
+		EbsOptimized:     input.EbsOptimized,
+		UserData:         input.UserData,
+		SecurityGroupIds: input.SecurityGroupIds,
+	}
+	if input.IamInstanceProfile != nil {
+		data.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Name: input.IamInstanceProfile.Name,
+		}
+	}
+	for _, mapping := range input.BlockDeviceMappings {
+		templateMapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName:  mapping.DeviceName,
+			VirtualName: mapping.VirtualName,
+		}
+		if ebs := mapping.Ebs; ebs != nil {
+			templateMapping.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+				DeleteOnTermination: ebs.DeleteOnTermination,
+				Encrypted:           ebs.Encrypted,
+				Iops:                ebs.Iops,
+				KmsKeyId:            ebs.KmsKeyId,
+				VolumeSize:          ebs.VolumeSize,
+				VolumeType:          ebs.VolumeType,
+			}
+		}
+		data.BlockDeviceMappings = append(data.BlockDeviceMappings, templateMapping)
+	}
+	if input.Monitoring != nil {
+		data.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{Enabled: input.Monitoring.Enabled}
+	}
+	if input.InstanceInitiatedShutdownBehavior != nil {
+		data.InstanceInitiatedShutdownBehavior = input.InstanceInitiatedShutdownBehavior
+	}
+	if input.Placement != nil {
+		data.Placement = &ec2.LaunchTemplatePlacementRequest{GroupName: input.Placement.GroupName}
+	}
+	for _, spec := range input.TagSpecifications {
+		data.TagSpecifications = append(data.TagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: spec.ResourceType,
+			Tags:         spec.Tags,
+		})
+	}
+	return data
+}
+
+// tagSpecification returns the specification of the tags of a resource at creation.
+func tagSpecification(resourceType string, tags infrav1.Tags) *ec2.TagSpecification {
+	spec := instanceTagSpecification(tags)
+	spec.ResourceType = aws.String(resourceType)
+	return spec
+}
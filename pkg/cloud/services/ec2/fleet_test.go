@@ -0,0 +1,335 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testFleetLaunchTemplateName = "default/machine-1"
+
+func newFleetTestScopes(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, strategy infrav1.FleetAllocationStrategy, fleetID *string) (*scope.ClusterScope, *scope.MachineScope) {
+	awsCluster := &infrav1.AWSCluster{}
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    cluster,
+		AWSCluster: awsCluster,
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:     fake.NewFakeClientWithScheme(scheme.Scheme),
+		Cluster:    cluster,
+		Machine:    &clusterv1.Machine{},
+		AWSCluster: awsCluster,
+		AWSMachine: &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "default"},
+			Spec: infrav1.AWSMachineSpec{
+				FleetMode:               true,
+				FleetAllocationStrategy: strategy,
+				InstanceTypeRequirements: &infrav1.InstanceTypeRequirements{
+					MinVCPUs:     2,
+					MaxVCPUs:     pointer.Int64Ptr(4),
+					MinMemoryMiB: 4096,
+				},
+			},
+			Status: infrav1.AWSMachineStatus{FleetID: fleetID},
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	return clusterScope, machineScope
+}
+
+func describeFleetImage(m *mock_ec2iface.MockEC2APIMockRecorder, architecture string) {
+	m.DescribeImages(gomock.Eq(&ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"ami-1"})})).
+		Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{ImageId: aws.String("ami-1"), Architecture: aws.String(architecture)}}}, nil)
+}
+
+func TestCreateFleet(t *testing.T) {
+	instance := &infrav1.Instance{
+		ImageID:          "ami-1",
+		SubnetID:         "subnet-1",
+		SecurityGroupIDs: []string{"sg-1"},
+		UserData:         aws.String("dXNlcmRhdGE="),
+		Tags:             infrav1.Tags{"Name": "machine-1"},
+	}
+	requirements := &ec2.InstanceRequirementsRequest{
+		VCpuCount: &ec2.VCpuCountRangeRequest{Min: aws.Int64(2), Max: aws.Int64(4)},
+		MemoryMiB: &ec2.MemoryMiBRequest{Min: aws.Int64(4096)},
+	}
+	expectLaunchTemplate := func(m *mock_ec2iface.MockEC2APIMockRecorder) *gomock.Call {
+		return m.CreateLaunchTemplate(gomock.AssignableToTypeOf(&ec2.CreateLaunchTemplateInput{})).
+			DoAndReturn(func(input *ec2.CreateLaunchTemplateInput) (*ec2.CreateLaunchTemplateOutput, error) {
+				data := input.LaunchTemplateData
+				if aws.StringValue(input.LaunchTemplateName) != testFleetLaunchTemplateName {
+					t.Fatalf("expected launch template %q, got %q", testFleetLaunchTemplateName, aws.StringValue(input.LaunchTemplateName))
+				}
+				if aws.StringValue(data.ImageId) != "ami-1" || aws.StringValue(data.UserData) != "dXNlcmRhdGE=" || aws.StringValue(data.SecurityGroupIds[0]) != "sg-1" {
+					t.Fatalf("expected the launch template to launch the instance, got %v", data)
+				}
+				if len(data.TagSpecifications) != 1 || aws.StringValue(data.TagSpecifications[0].ResourceType) != ec2.ResourceTypeInstance {
+					t.Fatalf("expected the launch template to tag the instance, got %v", data.TagSpecifications)
+				}
+				return &ec2.CreateLaunchTemplateOutput{LaunchTemplate: &ec2.LaunchTemplate{LaunchTemplateId: aws.String("lt-1")}}, nil
+			})
+	}
+
+	testCases := []struct {
+		name        string
+		strategy    infrav1.FleetAllocationStrategy
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantFleetID string
+		wantErr     bool
+	}{
+		{
+			name: "creates an On-Demand fleet with the lowest price strategy by default",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleetImage(m, "x86_64")
+				expectLaunchTemplate(m)
+				m.CreateFleet(gomock.AssignableToTypeOf(&ec2.CreateFleetInput{})).
+					DoAndReturn(func(input *ec2.CreateFleetInput) (*ec2.CreateFleetOutput, error) {
+						if aws.StringValue(input.Type) != ec2.FleetTypeRequest || aws.Int64Value(input.TargetCapacitySpecification.TotalTargetCapacity) != 1 {
+							t.Fatalf("expected a request for one instance, got %v", input)
+						}
+						if aws.StringValue(input.TargetCapacitySpecification.DefaultTargetCapacityType) != ec2.DefaultTargetCapacityTypeOnDemand ||
+							aws.StringValue(input.OnDemandOptions.AllocationStrategy) != ec2.FleetOnDemandAllocationStrategyLowestPrice || input.SpotOptions != nil {
+							t.Fatalf("expected On-Demand capacity with the lowest price strategy, got %v", input)
+						}
+						config := input.LaunchTemplateConfigs[0]
+						if aws.StringValue(config.LaunchTemplateSpecification.LaunchTemplateId) != "lt-1" {
+							t.Fatalf("expected the fleet to use launch template lt-1, got %v", config.LaunchTemplateSpecification)
+						}
+						override := config.Overrides[0]
+						if aws.StringValue(override.SubnetId) != "subnet-1" || override.InstanceRequirements.GoString() != requirements.GoString() {
+							t.Fatalf("expected the fleet to launch the instance in subnet-1 with requirements %v, got %v", requirements, override)
+						}
+						return &ec2.CreateFleetOutput{FleetId: aws.String("fleet-1")}, nil
+					})
+			},
+			wantFleetID: "fleet-1",
+		},
+		{
+			name:     "creates a Spot fleet with the capacity optimized strategy",
+			strategy: infrav1.FleetAllocationStrategyCapacityOptimized,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleetImage(m, "x86_64")
+				expectLaunchTemplate(m)
+				m.CreateFleet(gomock.AssignableToTypeOf(&ec2.CreateFleetInput{})).
+					DoAndReturn(func(input *ec2.CreateFleetInput) (*ec2.CreateFleetOutput, error) {
+						if aws.StringValue(input.TargetCapacitySpecification.DefaultTargetCapacityType) != ec2.DefaultTargetCapacityTypeSpot ||
+							aws.StringValue(input.SpotOptions.AllocationStrategy) != ec2.SpotAllocationStrategyCapacityOptimized || input.OnDemandOptions != nil {
+							t.Fatalf("expected Spot capacity with the capacity optimized strategy, got %v", input)
+						}
+						return &ec2.CreateFleetOutput{FleetId: aws.String("fleet-1")}, nil
+					})
+			},
+			wantFleetID: "fleet-1",
+		},
+		{
+			name: "replaces a launch template left over by a previous attempt",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleetImage(m, "x86_64")
+				gomock.InOrder(
+					m.CreateLaunchTemplate(gomock.Any()).Return(nil, awserr.New(launchTemplateAlreadyExists, "already exists", nil)),
+					m.DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateName: aws.String(testFleetLaunchTemplateName)})).
+						Return(&ec2.DeleteLaunchTemplateOutput{}, nil),
+					expectLaunchTemplate(m),
+				)
+				m.CreateFleet(gomock.Any()).Return(&ec2.CreateFleetOutput{FleetId: aws.String("fleet-1")}, nil)
+			},
+			wantFleetID: "fleet-1",
+		},
+		{
+			name: "fails when the image doesn't have the architecture of the requirements",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleetImage(m, "arm64")
+			},
+			wantErr: true,
+		},
+		{
+			name: "deletes the launch template when the fleet can't be created",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleetImage(m, "x86_64")
+				expectLaunchTemplate(m)
+				m.CreateFleet(gomock.Any()).Return(nil, errors.New("unauthorized"))
+				m.DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateName: aws.String(testFleetLaunchTemplateName)})).
+					Return(&ec2.DeleteLaunchTemplateOutput{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+			clusterScope, machineScope := newFleetTestScopes(t, ec2Mock, tc.strategy, nil)
+
+			err := NewService(clusterScope).createFleet(machineScope, instance)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if fleetID := aws.StringValue(machineScope.AWSMachine.Status.FleetID); fleetID != tc.wantFleetID {
+				t.Fatalf("expected fleet %q, got %q", tc.wantFleetID, fleetID)
+			}
+		})
+	}
+}
+
+func TestFleetInstance(t *testing.T) {
+	describeFleet := func(m *mock_ec2iface.MockEC2APIMockRecorder, fleet *ec2.FleetData) {
+		fleet.FleetId = aws.String("fleet-1")
+		m.DescribeFleets(gomock.Eq(&ec2.DescribeFleetsInput{FleetIds: aws.StringSlice([]string{"fleet-1"})})).
+			Return(&ec2.DescribeFleetsOutput{Fleets: []*ec2.FleetData{fleet}}, nil)
+	}
+	deleteFleet := func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+		m.DeleteFleets(gomock.Eq(&ec2.DeleteFleetsInput{FleetIds: aws.StringSlice([]string{"fleet-1"}), TerminateInstances: aws.Bool(true)})).
+			Return(&ec2.DeleteFleetsOutput{}, nil)
+		m.DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateName: aws.String(testFleetLaunchTemplateName)})).
+			Return(&ec2.DeleteLaunchTemplateOutput{}, nil)
+	}
+
+	testCases := []struct {
+		name           string
+		expect         func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantInstanceID string
+		wantPending    bool
+		wantErr        bool
+		wantFleetID    string
+	}{
+		{
+			name: "waits for the fleet to be fulfilled",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleet(m, &ec2.FleetData{
+					FleetState:        aws.String(ec2.FleetStateCodeActive),
+					ActivityStatus:    aws.String(ec2.FleetActivityStatusPendingFulfillment),
+					FulfilledCapacity: aws.Float64(0),
+				})
+			},
+			wantPending: true,
+			wantFleetID: "fleet-1",
+		},
+		{
+			name: "returns the instance launched by the fleet",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleet(m, &ec2.FleetData{
+					FleetState:        aws.String(ec2.FleetStateCodeActive),
+					ActivityStatus:    aws.String(ec2.FleetActivityStatusFulfilled),
+					FulfilledCapacity: aws.Float64(1),
+				})
+				m.DescribeFleetInstances(gomock.Eq(&ec2.DescribeFleetInstancesInput{FleetId: aws.String("fleet-1")})).
+					Return(&ec2.DescribeFleetInstancesOutput{ActiveInstances: []*ec2.ActiveInstance{{InstanceId: aws.String("i-1"), InstanceType: aws.String("m5a.large")}}}, nil)
+				m.DescribeInstances(gomock.Eq(&ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice([]string{"i-1"})})).
+					Return(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{
+							InstanceId:   aws.String("i-1"),
+							InstanceType: aws.String("m5a.large"),
+							State:        &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+							Placement:    &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+						}}}},
+					}, nil)
+			},
+			wantInstanceID: "i-1",
+			wantFleetID:    "fleet-1",
+		},
+		{
+			name: "deletes a fleet that failed to launch the instance",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeFleet(m, &ec2.FleetData{
+					FleetState:        aws.String(ec2.FleetStateCodeActive),
+					ActivityStatus:    aws.String(ec2.FleetActivityStatusError),
+					FulfilledCapacity: aws.Float64(0),
+					Errors: []*ec2.DescribeFleetError{{
+						ErrorCode:    aws.String("InsufficientInstanceCapacity"),
+						ErrorMessage: aws.String("no capacity"),
+					}},
+				})
+				deleteFleet(m)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+			clusterScope, machineScope := newFleetTestScopes(t, ec2Mock, "", aws.String("fleet-1"))
+
+			instance, err := NewService(clusterScope).CreateInstance(machineScope, nil)
+			if IsFleetPending(err) != tc.wantPending {
+				t.Fatalf("expected pending: %v, got: %v", tc.wantPending, err)
+			}
+			if !tc.wantPending && tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantInstanceID != "" && (instance == nil || instance.ID != tc.wantInstanceID) {
+				t.Fatalf("expected instance %q, got %v", tc.wantInstanceID, instance)
+			}
+			if fleetID := aws.StringValue(machineScope.AWSMachine.Status.FleetID); fleetID != tc.wantFleetID {
+				t.Fatalf("expected fleet %q, got %q", tc.wantFleetID, fleetID)
+			}
+		})
+	}
+}
+
+func TestDeleteFleet(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DeleteFleets(gomock.Eq(&ec2.DeleteFleetsInput{FleetIds: aws.StringSlice([]string{"fleet-1"}), TerminateInstances: aws.Bool(false)})).
+		Return(&ec2.DeleteFleetsOutput{UnsuccessfulFleetDeletions: []*ec2.DeleteFleetErrorItem{{
+			FleetId: aws.String("fleet-1"),
+			Error:   &ec2.DeleteFleetError{Code: aws.String(ec2.DeleteFleetErrorCodeFleetIdDoesNotExist)},
+		}}}, nil)
+	ec2Mock.EXPECT().DeleteLaunchTemplate(gomock.Eq(&ec2.DeleteLaunchTemplateInput{LaunchTemplateName: aws.String(testFleetLaunchTemplateName)})).
+		Return(nil, awserr.New(launchTemplateNotFound, "not found", nil))
+	clusterScope, machineScope := newFleetTestScopes(t, ec2Mock, "", aws.String("fleet-1"))
+
+	if err := NewService(clusterScope).DeleteFleet(machineScope, false); err != nil {
+		t.Fatalf("failed to delete fleet: %v", err)
+	}
+	if machineScope.AWSMachine.Status.FleetID != nil {
+		t.Fatalf("expected the fleet to be cleared, got %q", *machineScope.AWSMachine.Status.FleetID)
+	}
+}
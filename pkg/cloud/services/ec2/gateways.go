@@ -19,8 +19,6 @@ package ec2
 import (
 	"fmt"
 
-	"sigs.k8s.io/cluster-api/util/conditions"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
@@ -72,7 +70,7 @@ func (s *Service) reconcileInternetGateways() error {
 		record.Warnf(s.scope.AWSCluster, "FailedTagInternetGateway", "Failed to tag managed Internet Gateway %q: %v", gateway.InternetGatewayId, err)
 		return errors.Wrapf(err, "failed to tag internet gateway %q", *gateway.InternetGatewayId)
 	}
-	conditions.MarkTrue(s.scope.AWSCluster, infrav1.InternetGatewayReadyCondition)
+	s.markConditionTrue(infrav1.InternetGatewayReadyCondition)
 	return nil
 }
 
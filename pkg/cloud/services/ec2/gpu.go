@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+)
+
+const gpuVerificationCommand = "nvidia-smi"
+
+// gpuVerificationBackoff bounds how long a reconcile waits for nvidia-smi to complete. Verification is
+// retried on the next reconcile when it has not completed in time.
+var gpuVerificationBackoff = kwait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Steps:    5,
+}
+
+// VerifyGPUDrivers runs nvidia-smi on the instance through SSM and returns true once it exited 0.
+// It returns false without an error while the instance is not registered with SSM yet or the
+// command has not completed, and an error when nvidia-smi failed.
+func (s *Service) VerifyGPUDrivers(instanceID string) (bool, error) {
+	out, err := s.scope.SSM.SendCommand(&ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  []*string{aws.String(instanceID)},
+		Parameters: map[string][]*string{
+			"commands": {aws.String(gpuVerificationCommand)},
+		},
+		Comment: aws.String("Cluster API Provider AWS GPU driver verification"),
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == ssm.ErrCodeInvalidInstanceId {
+			s.scope.V(2).Info("Instance is not registered with SSM yet, skipping GPU verification", "instance-id", instanceID)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to send GPU verification command to instance %q", instanceID)
+	}
+
+	var invocation *ssm.GetCommandInvocationOutput
+	checkInvocation := func() (bool, error) {
+		invocation, err = s.scope.SSM.GetCommandInvocation(&ssm.GetCommandInvocationInput{
+			CommandId:  out.Command.CommandId,
+			InstanceId: aws.String(instanceID),
+		})
+		if err != nil {
+			return false, err
+		}
+		switch aws.StringValue(invocation.Status) {
+		case ssm.CommandInvocationStatusPending, ssm.CommandInvocationStatusInProgress, ssm.CommandInvocationStatusDelayed:
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if err := wait.WaitForWithRetryable(gpuVerificationBackoff, checkInvocation, ssm.ErrCodeInvocationDoesNotExist); err != nil {
+		if err == kwait.ErrWaitTimeout {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get GPU verification result of instance %q", instanceID)
+	}
+
+	if aws.StringValue(invocation.Status) != ssm.CommandInvocationStatusSuccess {
+		return false, errors.Errorf("%s on instance %q finished with status %s and exit code %d: %s",
+			gpuVerificationCommand, instanceID, aws.StringValue(invocation.Status), aws.Int64Value(invocation.ResponseCode), aws.StringValue(invocation.StandardErrorContent))
+	}
+
+	return true, nil
+}
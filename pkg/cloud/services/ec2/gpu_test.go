@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestVerifyGPUDrivers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	backoff := gpuVerificationBackoff
+	defer func() { gpuVerificationBackoff = backoff }()
+	gpuVerificationBackoff.Duration = time.Millisecond
+	gpuVerificationBackoff.Steps = 2
+
+	sendCommand := func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+		m.SendCommand(gomock.Eq(&ssm.SendCommandInput{
+			DocumentName: aws.String("AWS-RunShellScript"),
+			InstanceIds:  []*string{aws.String("i-gpu")},
+			Parameters: map[string][]*string{
+				"commands": {aws.String("nvidia-smi")},
+			},
+			Comment: aws.String("Cluster API Provider AWS GPU driver verification"),
+		})).
+			Return(&ssm.SendCommandOutput{Command: &ssm.Command{CommandId: aws.String("cmd-1")}}, nil)
+	}
+	invocation := func(status string, code int64) *ssm.GetCommandInvocationOutput {
+		return &ssm.GetCommandInvocationOutput{
+			Status:               aws.String(status),
+			ResponseCode:         aws.Int64(code),
+			StandardErrorContent: aws.String(""),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		expect    func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		wantReady bool
+		wantErr   bool
+	}{
+		{
+			name: "nvidia-smi succeeds",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				sendCommand(m)
+				m.GetCommandInvocation(gomock.Eq(&ssm.GetCommandInvocationInput{
+					CommandId:  aws.String("cmd-1"),
+					InstanceId: aws.String("i-gpu"),
+				})).
+					Return(nil, awserr.New(ssm.ErrCodeInvocationDoesNotExist, "not yet", nil))
+				m.GetCommandInvocation(gomock.Any()).
+					Return(invocation(ssm.CommandInvocationStatusSuccess, 0), nil)
+			},
+			wantReady: true,
+		},
+		{
+			name: "nvidia-smi fails",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				sendCommand(m)
+				m.GetCommandInvocation(gomock.Any()).
+					Return(invocation(ssm.CommandInvocationStatusFailed, 9), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "nvidia-smi has not completed",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				sendCommand(m)
+				m.GetCommandInvocation(gomock.Any()).
+					Return(invocation(ssm.CommandInvocationStatusInProgress, -1), nil).
+					Times(2)
+			},
+		},
+		{
+			name: "instance is not registered with SSM",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.SendCommand(gomock.Any()).
+					Return(nil, awserr.New(ssm.ErrCodeInvalidInstanceId, "not registered", nil))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					SSM: ssmMock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(ssmMock.EXPECT())
+
+			s := NewService(scope)
+			ready, err := s.VerifyGPUDrivers("i-gpu")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("VerifyGPUDrivers() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if ready != tc.wantReady {
+				t.Fatalf("expected ready to be %v, got %v", tc.wantReady, ready)
+			}
+		})
+	}
+}
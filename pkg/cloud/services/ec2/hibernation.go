@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// maxHibernationMemoryGiB is the largest amount of instance memory that can be hibernated.
+const maxHibernationMemoryGiB = 150
+
+// hibernationInstanceTypes maps the instance types supporting hibernation to their memory in GiB.
+// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/hibernating-prerequisites.html
+var hibernationInstanceTypes = map[string]float64{
+	"c3.large":    3.75,
+	"c3.xlarge":   7.5,
+	"c3.2xlarge":  15,
+	"c3.4xlarge":  30,
+	"c3.8xlarge":  60,
+	"c4.large":    3.75,
+	"c4.xlarge":   7.5,
+	"c4.2xlarge":  15,
+	"c4.4xlarge":  30,
+	"c4.8xlarge":  60,
+	"c5.large":    4,
+	"c5.xlarge":   8,
+	"c5.2xlarge":  16,
+	"c5.4xlarge":  32,
+	"c5.9xlarge":  72,
+	"c5.12xlarge": 96,
+	"c5.18xlarge": 144,
+	"c5.24xlarge": 192,
+	"m3.medium":   3.75,
+	"m3.large":    7.5,
+	"m3.xlarge":   15,
+	"m3.2xlarge":  30,
+	"m4.large":    8,
+	"m4.xlarge":   16,
+	"m4.2xlarge":  32,
+	"m4.4xlarge":  64,
+	"m4.10xlarge": 160,
+	"m5.large":    8,
+	"m5.xlarge":   16,
+	"m5.2xlarge":  32,
+	"m5.4xlarge":  64,
+	"m5.8xlarge":  128,
+	"m5.12xlarge": 192,
+	"r3.large":    15.25,
+	"r3.xlarge":   30.5,
+	"r3.2xlarge":  61,
+	"r3.4xlarge":  122,
+	"r3.8xlarge":  244,
+	"r4.large":    15.25,
+	"r4.xlarge":   30.5,
+	"r4.2xlarge":  61,
+	"r4.4xlarge":  122,
+	"r5.large":    16,
+	"r5.xlarge":   32,
+	"r5.2xlarge":  64,
+	"r5.4xlarge":  128,
+	"t2.nano":     0.5,
+	"t2.micro":    1,
+	"t2.small":    2,
+	"t2.medium":   4,
+	"t2.large":    8,
+	"t2.xlarge":   16,
+	"t2.2xlarge":  32,
+	"t3.nano":     0.5,
+	"t3.micro":    1,
+	"t3.small":    2,
+	"t3.medium":   4,
+	"t3.large":    8,
+	"t3.xlarge":   16,
+	"t3.2xlarge":  32,
+}
+
+// HibernationSupported returns true if instances of the given type can be hibernated.
+func HibernationSupported(instanceType string) bool {
+	memory, ok := hibernationInstanceTypes[instanceType]
+	return ok && memory <= maxHibernationMemoryGiB
+}
+
+// hibernationSupported returns true if instances of the given type can be hibernated,
+// describing the instance type for types that are missing from the static table.
+func (s *Service) hibernationSupported(instanceType string) (bool, error) {
+	if HibernationSupported(instanceType) {
+		return true, nil
+	}
+	if _, ok := hibernationInstanceTypes[instanceType]; ok {
+		return false, nil
+	}
+
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return false, err
+	}
+
+	if info.MemoryInfo == nil {
+		return false, nil
+	}
+
+	memoryGiB := float64(aws.Int64Value(info.MemoryInfo.SizeInMiB)) / 1024
+	return aws.BoolValue(info.HibernationSupported) && memoryGiB <= maxHibernationMemoryGiB, nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import "testing"
+
+func TestHibernationSupported(t *testing.T) {
+	testCases := []struct {
+		instanceType string
+		expected     bool
+	}{
+		{instanceType: "m5.large", expected: true},
+		{instanceType: "t3.micro", expected: true},
+		{instanceType: "c5.18xlarge", expected: true},
+		{instanceType: "c5.24xlarge", expected: false},
+		{instanceType: "r3.8xlarge", expected: false},
+		{instanceType: "p3.2xlarge", expected: false},
+		{instanceType: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.instanceType, func(t *testing.T) {
+			if actual := HibernationSupported(tc.instanceType); actual != tc.expected {
+				t.Fatalf("expected HibernationSupported(%q) to be %v, got %v", tc.instanceType, tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+func (s *Service) reconcileInstanceConnectEndpoint() error {
+	spec := s.scope.InstanceConnectEndpoint()
+	if spec == nil {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling EC2 Instance Connect Endpoint")
+
+	endpoint, err := s.describeInstanceConnectEndpoint()
+	if err != nil {
+		return err
+	}
+
+	if endpoint == nil {
+		subnetID := spec.SubnetID
+		if subnetID == "" {
+			private := s.scope.Subnets().FilterPrivate()
+			if len(private) == 0 || private[0].ID == "" {
+				return errors.New("failed to create EC2 Instance Connect Endpoint, no private subnets available")
+			}
+			subnetID = private[0].ID
+		}
+
+		endpoint, err = s.createInstanceConnectEndpoint(subnetID, spec.SecurityGroupIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if aws.StringValue(endpoint.State) != ec2.Ec2InstanceConnectEndpointStateCreateComplete {
+		endpoint, err = s.waitForInstanceConnectEndpoint(aws.StringValue(endpoint.InstanceConnectEndpointId), ec2.Ec2InstanceConnectEndpointStateCreateComplete)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().InstanceConnectEndpoint = &infrav1.InstanceConnectEndpoint{
+		ID:      aws.StringValue(endpoint.InstanceConnectEndpointId),
+		DNSName: aws.StringValue(endpoint.DnsName),
+	}
+	conditions.MarkTrue(s.scope.AWSCluster, infrav1.InstanceConnectEndpointReadyCondition)
+	return nil
+}
+
+func (s *Service) deleteInstanceConnectEndpoint() error {
+	endpoint, err := s.describeInstanceConnectEndpoint()
+	if err != nil {
+		return err
+	}
+	if endpoint == nil {
+		s.scope.Network().InstanceConnectEndpoint = nil
+		return nil
+	}
+
+	id := aws.StringValue(endpoint.InstanceConnectEndpointId)
+	if aws.StringValue(endpoint.State) != ec2.Ec2InstanceConnectEndpointStateDeleteInProgress {
+		if _, err := s.scope.EC2.DeleteInstanceConnectEndpoint(&ec2.DeleteInstanceConnectEndpointInput{
+			InstanceConnectEndpointId: aws.String(id),
+		}); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedDeleteInstanceConnectEndpoint", "Failed to delete EC2 Instance Connect Endpoint %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete EC2 Instance Connect Endpoint %q", id)
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteInstanceConnectEndpoint", "Deleted EC2 Instance Connect Endpoint %q", id)
+	}
+
+	// The endpoint's network interface has to be gone before its subnet and security groups can be deleted.
+	if _, err := s.waitForInstanceConnectEndpoint(id, ec2.Ec2InstanceConnectEndpointStateDeleteComplete); err != nil {
+		return err
+	}
+
+	s.scope.Network().InstanceConnectEndpoint = nil
+	s.scope.Info("Deleted EC2 Instance Connect Endpoint", "instance-connect-endpoint-id", id)
+	return nil
+}
+
+// describeInstanceConnectEndpoint returns the EC2 Instance Connect Endpoint owned by the cluster,
+// or nil if there is none.
+func (s *Service) describeInstanceConnectEndpoint() (*ec2.Ec2InstanceConnectEndpoint, error) {
+	input := &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}
+
+	var endpoint *ec2.Ec2InstanceConnectEndpoint
+	err := s.scope.EC2.DescribeInstanceConnectEndpointsPages(input, func(page *ec2.DescribeInstanceConnectEndpointsOutput, lastPage bool) bool {
+		for _, e := range page.InstanceConnectEndpoints {
+			if aws.StringValue(e.State) == ec2.Ec2InstanceConnectEndpointStateDeleteComplete {
+				continue
+			}
+			endpoint = e
+		}
+		return !lastPage
+	})
+	if err != nil {
+		record.Eventf(s.scope.AWSCluster, "FailedDescribeInstanceConnectEndpoints", "Failed to describe EC2 Instance Connect Endpoints in VPC %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe EC2 Instance Connect Endpoints in VPC %q", s.scope.VPC().ID)
+	}
+
+	return endpoint, nil
+}
+
+func (s *Service) createInstanceConnectEndpoint(subnetID string, securityGroupIDs []string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	out, err := s.scope.EC2.CreateInstanceConnectEndpoint(&ec2.CreateInstanceConnectEndpointInput{
+		SubnetId:          aws.String(subnetID),
+		SecurityGroupIds:  aws.StringSlice(securityGroupIDs),
+		TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeInstanceConnectEndpoint, s.getInstanceConnectEndpointTagParams())},
+	})
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedCreateInstanceConnectEndpoint", "Failed to create EC2 Instance Connect Endpoint in subnet %q: %v", subnetID, err)
+		return nil, errors.Wrapf(err, "failed to create EC2 Instance Connect Endpoint in subnet %q", subnetID)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulCreateInstanceConnectEndpoint", "Created EC2 Instance Connect Endpoint %q", aws.StringValue(out.InstanceConnectEndpoint.InstanceConnectEndpointId))
+	return out.InstanceConnectEndpoint, nil
+}
+
+// waitForInstanceConnectEndpoint waits until the endpoint is in the given state and returns it.
+func (s *Service) waitForInstanceConnectEndpoint(id, state string) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	var endpoint *ec2.Ec2InstanceConnectEndpoint
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		out, err := s.scope.EC2.DescribeInstanceConnectEndpoints(&ec2.DescribeInstanceConnectEndpointsInput{
+			InstanceConnectEndpointIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(out.InstanceConnectEndpoints) == 0 {
+			return false, errors.Errorf("no EC2 Instance Connect Endpoint returned for id %q", id)
+		}
+
+		endpoint = out.InstanceConnectEndpoints[0]
+		switch current := aws.StringValue(endpoint.State); current {
+		case state:
+			return true, nil
+		case ec2.Ec2InstanceConnectEndpointStateCreateFailed, ec2.Ec2InstanceConnectEndpointStateDeleteFailed:
+			return false, errors.Errorf("in %s state: %s", current, aws.StringValue(endpoint.StateMessage))
+		}
+		return false, nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to wait for EC2 Instance Connect Endpoint %q to be in state %q", id, state)
+	}
+
+	return endpoint, nil
+}
+
+func (s *Service) getInstanceConnectEndpointTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-eice", s.scope.Name())),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
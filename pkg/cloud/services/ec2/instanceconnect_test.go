@@ -0,0 +1,233 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func instanceConnectEndpoint(id, state string) *ec2.Ec2InstanceConnectEndpoint {
+	return &ec2.Ec2InstanceConnectEndpoint{
+		InstanceConnectEndpointId: aws.String(id),
+		DnsName:                   aws.String(id + ".ec2-instance-connect-endpoint.us-east-1.amazonaws.com"),
+		State:                     aws.String(state),
+	}
+}
+
+func expectDescribeClusterInstanceConnectEndpoints(m *mock_ec2iface.MockEC2APIMockRecorder, endpoints ...*ec2.Ec2InstanceConnectEndpoint) {
+	m.DescribeInstanceConnectEndpointsPages(gomock.Eq(&ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{"vpc-eice"})},
+			{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Values: aws.StringSlice([]string{"owned"})},
+		},
+	}), gomock.Any()).
+		Do(func(_ *ec2.DescribeInstanceConnectEndpointsInput, fn func(*ec2.DescribeInstanceConnectEndpointsOutput, bool) bool) {
+			fn(&ec2.DescribeInstanceConnectEndpointsOutput{InstanceConnectEndpoints: endpoints}, true)
+		}).
+		Return(nil)
+}
+
+func expectDescribeInstanceConnectEndpoint(m *mock_ec2iface.MockEC2APIMockRecorder, endpoint *ec2.Ec2InstanceConnectEndpoint) {
+	m.DescribeInstanceConnectEndpoints(gomock.Eq(&ec2.DescribeInstanceConnectEndpointsInput{
+		InstanceConnectEndpointIds: []*string{endpoint.InstanceConnectEndpointId},
+	})).
+		Return(&ec2.DescribeInstanceConnectEndpointsOutput{
+			InstanceConnectEndpoints: []*ec2.Ec2InstanceConnectEndpoint{endpoint},
+		}, nil)
+}
+
+func TestReconcileInstanceConnectEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		spec   *infrav1.InstanceConnectEndpointSpec
+		expect func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantID string
+	}{
+		{
+			name: "no instance connect endpoint configured",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+			},
+		},
+		{
+			name: "creates an endpoint in the first private subnet",
+			spec: &infrav1.InstanceConnectEndpointSpec{SecurityGroupIDs: []string{"sg-eice"}},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeClusterInstanceConnectEndpoints(m)
+				m.CreateInstanceConnectEndpoint(gomock.AssignableToTypeOf(&ec2.CreateInstanceConnectEndpointInput{})).
+					DoAndReturn(func(input *ec2.CreateInstanceConnectEndpointInput) (*ec2.CreateInstanceConnectEndpointOutput, error) {
+						if aws.StringValue(input.SubnetId) != "subnet-private" {
+							t.Fatalf("expected endpoint in subnet-private, got %q", aws.StringValue(input.SubnetId))
+						}
+						if len(input.SecurityGroupIds) != 1 || aws.StringValue(input.SecurityGroupIds[0]) != "sg-eice" {
+							t.Fatalf("expected security group sg-eice, got %v", aws.StringValueSlice(input.SecurityGroupIds))
+						}
+						if aws.StringValue(input.TagSpecifications[0].ResourceType) != ec2.ResourceTypeInstanceConnectEndpoint {
+							t.Fatalf("expected instance connect endpoint tags, got %v", input.TagSpecifications)
+						}
+						return &ec2.CreateInstanceConnectEndpointOutput{
+							InstanceConnectEndpoint: instanceConnectEndpoint("eice-1", ec2.Ec2InstanceConnectEndpointStateCreateInProgress),
+						}, nil
+					})
+				expectDescribeInstanceConnectEndpoint(m, instanceConnectEndpoint("eice-1", ec2.Ec2InstanceConnectEndpointStateCreateComplete))
+			},
+			wantID: "eice-1",
+		},
+		{
+			name: "adopts an existing endpoint",
+			spec: &infrav1.InstanceConnectEndpointSpec{SubnetID: "subnet-private", SecurityGroupIDs: []string{"sg-eice"}},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeClusterInstanceConnectEndpoints(m,
+					instanceConnectEndpoint("eice-old", ec2.Ec2InstanceConnectEndpointStateDeleteComplete),
+					instanceConnectEndpoint("eice-2", ec2.Ec2InstanceConnectEndpointStateCreateComplete),
+				)
+				m.CreateInstanceConnectEndpoint(gomock.Any()).Times(0)
+			},
+			wantID: "eice-2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{ID: "vpc-eice"},
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-public", IsPublic: true},
+							{ID: "subnet-private", IsPublic: false},
+						},
+						InstanceConnectEndpoint: tc.spec,
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			if err := s.reconcileInstanceConnectEndpoint(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			status := awsCluster.Status.Network.InstanceConnectEndpoint
+			if tc.wantID == "" {
+				if status != nil {
+					t.Fatalf("expected no instance connect endpoint status, got %v", status)
+				}
+				return
+			}
+			if status == nil || status.ID != tc.wantID || status.DNSName == "" {
+				t.Fatalf("expected instance connect endpoint %q with a DNS name, got %v", tc.wantID, status)
+			}
+		})
+	}
+}
+
+func TestDeleteInstanceConnectEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		expect func(m *mock_ec2iface.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "no endpoint to delete",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeClusterInstanceConnectEndpoints(m)
+				m.DeleteInstanceConnectEndpoint(gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "deletes the endpoint and waits for it to be gone",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeClusterInstanceConnectEndpoints(m, instanceConnectEndpoint("eice-1", ec2.Ec2InstanceConnectEndpointStateCreateComplete))
+				m.DeleteInstanceConnectEndpoint(gomock.Eq(&ec2.DeleteInstanceConnectEndpointInput{
+					InstanceConnectEndpointId: aws.String("eice-1"),
+				})).
+					Return(&ec2.DeleteInstanceConnectEndpointOutput{}, nil)
+				expectDescribeInstanceConnectEndpoint(m, instanceConnectEndpoint("eice-1", ec2.Ec2InstanceConnectEndpointStateDeleteComplete))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{ID: "vpc-eice"},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						InstanceConnectEndpoint: &infrav1.InstanceConnectEndpoint{ID: "eice-1"},
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(clusterScope)
+			if err := s.deleteInstanceConnectEndpoint(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if awsCluster.Status.Network.InstanceConnectEndpoint != nil {
+				t.Fatalf("expected instance connect endpoint status to be cleared")
+			}
+		})
+	}
+}
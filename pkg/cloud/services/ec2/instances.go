@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/utils/pointer"
@@ -106,11 +107,37 @@ func (s *Service) InstanceIfExists(id *string) (*infrav1.Instance, error) {
 func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*infrav1.Instance, error) {
 	s.scope.V(2).Info("Creating an instance for a machine")
 
+	if s.scope.IsRemoteRegion() && scope.IsControlPlane() {
+		return nil, errors.New("control plane machines cannot be created in the cluster's remote region")
+	}
+
+	// The instance of machines in fleet mode is launched by their EC2 Fleet once it is created.
+	if scope.AWSMachine.Spec.FleetMode && scope.AWSMachine.Status.FleetID != nil {
+		return s.fleetInstance(scope)
+	}
+
 	input := &infrav1.Instance{
-		Type:              scope.AWSMachine.Spec.InstanceType,
-		IAMProfile:        scope.AWSMachine.Spec.IAMInstanceProfile,
-		RootVolume:        scope.AWSMachine.Spec.RootVolume,
-		NetworkInterfaces: scope.AWSMachine.Spec.NetworkInterfaces,
+		Type:                 scope.AWSMachine.Spec.InstanceType,
+		IAMProfile:           scope.AWSMachine.Spec.IAMInstanceProfile,
+		RootVolume:           scope.AWSMachine.Spec.RootVolume,
+		Hibernation:          scope.AWSMachine.Spec.Hibernation,
+		NitroEnclavesEnabled: scope.AWSMachine.Spec.NitroEnclavesEnabled,
+		ShutdownBehavior:     scope.AWSMachine.Spec.ShutdownBehavior,
+		DetailedMonitoring:   scope.AWSMachine.Spec.DetailedMonitoring,
+		CPUOptions:           scope.AWSMachine.Spec.CPUOptions,
+		CreditSpecification:  scope.AWSMachine.Spec.CreditSpecification,
+		EphemeralStorage:     scope.AWSMachine.Spec.EphemeralStorage,
+		NetworkInterfaces:    scope.AWSMachine.Spec.NetworkInterfaces,
+		LaunchTemplate:       scope.AWSMachine.Spec.LaunchTemplateRef,
+	}
+
+	// The instance type of machines launched from a launch template may come from the template,
+	// and the one of machines in fleet mode is picked by the fleet, the options depending on it
+	// can't be checked then.
+	if (input.Type == "" && input.LaunchTemplate != nil) || scope.AWSMachine.Spec.FleetMode {
+		input.EBSOptimized = scope.AWSMachine.Spec.EBSOptimized
+	} else if err := s.configureInstanceType(scope, input); err != nil {
+		return nil, err
 	}
 
 	// Make sure to use the MachineScope here to get the merger of AWSCluster and AWSMachine tags
@@ -126,37 +153,27 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 		Additional:  additionalTags,
 	})
 
-	var err error
+	if scope.AWSMachine.Spec.RestoreFromSnapshot {
+		return s.restoreInstance(scope, input.Tags, userData)
+	}
+
 	// Pick image from the machine configuration, or use a default one.
-	if scope.AWSMachine.Spec.AMI.ID != nil { // nolint:nestif
-		input.ImageID = *scope.AWSMachine.Spec.AMI.ID
-	} else {
-		if scope.Machine.Spec.Version == nil {
-			err := errors.New("Either AWSMachine's spec.ami.id or Machine's spec.version must be defined")
+	// Machines launched from a launch template use its image unless one is set explicitly.
+	hasImage := scope.AWSMachine.Spec.AMI.ID != nil || scope.AWSMachine.Spec.AMISSMPath != "" || scope.AWSMachine.Spec.ImageBuilderPipelineARN != ""
+	if input.LaunchTemplate == nil || hasImage {
+		if !hasImage && scope.Machine.Spec.Version == nil {
+			err := errors.New("Either AWSMachine's spec.ami.id, spec.imageBuilderPipelineARN, spec.amiSSMPath or Machine's spec.version must be defined")
 			scope.SetFailureReason(capierrors.CreateMachineError)
 			scope.SetFailureMessage(err)
 			return nil, err
 		}
 
-		imageLookupFormat := scope.AWSMachine.Spec.ImageLookupFormat
-		if imageLookupFormat == "" {
-			imageLookupFormat = scope.AWSCluster.Spec.ImageLookupFormat
-		}
-
-		imageLookupOrg := scope.AWSMachine.Spec.ImageLookupOrg
-		if imageLookupOrg == "" {
-			imageLookupOrg = scope.AWSCluster.Spec.ImageLookupOrg
-		}
-
-		imageLookupBaseOS := scope.AWSMachine.Spec.ImageLookupBaseOS
-		if imageLookupBaseOS == "" {
-			imageLookupBaseOS = scope.AWSCluster.Spec.ImageLookupBaseOS
-		}
-
-		input.ImageID, err = s.defaultAMILookup(imageLookupFormat, imageLookupOrg, imageLookupBaseOS, *scope.Machine.Spec.Version)
+		imageID, err := s.AMILookupChain(scope)
 		if err != nil {
 			return nil, err
 		}
+		input.ImageID = imageID
+		scope.AWSMachine.Status.AMIID = aws.String(input.ImageID)
 	}
 
 	// Prefer AWSMachine.Spec.FailureDomain for now while migrating to the use of
@@ -186,7 +203,18 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 				),
 			)
 		}
-		input.SubnetID = subnets[0].ID
+
+		// The instance is moved to another failure domain of the cluster when the type isn't offered in this one.
+		// The fleet of machines in fleet mode only picks instance types offered in the failure domain instead.
+		zone := *failureDomain
+		if !scope.AWSMachine.Spec.FleetMode {
+			var err error
+			if zone, err = NewCapacityCheckReconciler(s.scope).SelectFailureDomain(scope, *failureDomain); err != nil {
+				return nil, err
+			}
+		}
+		scope.AWSMachine.Status.SelectedFailureDomain = aws.String(zone)
+		input.SubnetID = s.scope.Subnets().FilterPrivate().FilterByZone(zone)[0].ID
 
 		// TODO(vincepri): Define a tag that would allow to pick a preferred subnet in an AZ when working
 		// with control plane machines.
@@ -202,6 +230,16 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 		input.SubnetID = sns[0].ID
 	}
 
+	// Instances attached to existing network interfaces get the subnet of the interfaces instead.
+	if len(input.NetworkInterfaces) == 0 {
+		subnetID, err := s.selectSubnetWithAvailableIPs(scope, input.SubnetID)
+		if err != nil {
+			return nil, err
+		}
+		input.SubnetID = subnetID
+		scope.AWSMachine.Status.SubnetID = aws.String(subnetID)
+	}
+
 	if s.scope.Network().APIServerELB.DNSName == "" {
 		record.Eventf(s.scope.AWSCluster, "FailedCreateInstance", "Failed to run controlplane, APIServer ELB not available")
 		return nil, awserrors.NewFailedDependency(
@@ -209,6 +247,7 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 		)
 	}
 	if !scope.UserDataIsUncompressed() {
+		var err error
 		userData, err = userdata.GzipBytes(userData)
 		if err != nil {
 			return nil, errors.New("failed to gzip userdata")
@@ -217,17 +256,22 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 
 	input.UserData = pointer.StringPtr(base64.StdEncoding.EncodeToString(userData))
 
-	// Set security groups.
-	ids, err := s.GetCoreSecurityGroups(scope)
-	if err != nil {
-		return nil, err
+	// Set security groups. Machines launched from a launch template get the security groups of the template.
+	if input.LaunchTemplate == nil {
+		ids, err := s.GetCoreSecurityGroups(scope)
+		if err != nil {
+			return nil, err
+		}
+		input.SecurityGroupIDs = append(input.SecurityGroupIDs, ids...)
 	}
-	input.SecurityGroupIDs = append(input.SecurityGroupIDs, ids...)
 
 	// If SSHKeyName WAS NOT provided in the AWSMachine Spec, fallback to the value provided in the AWSCluster Spec.
-	// If a value was not provided in the AWSCluster Spec, then use the defaultSSHKeyName
+	// If a value was not provided in the AWSCluster Spec, then use the defaultSSHKeyName.
+	// Machines launched from a launch template get the key of the template instead.
+	// Windows instances only get the key set in the AWSMachine Spec, as the others are meant for SSH access
+	// to Linux instances.
 	input.SSHKeyName = scope.AWSMachine.Spec.SSHKeyName
-	if input.SSHKeyName == nil {
+	if input.SSHKeyName == nil && input.LaunchTemplate == nil && scope.AWSMachine.Spec.OSType != infrav1.OSTypeWindows {
 		if scope.AWSCluster.Spec.SSHKeyName != nil {
 			input.SSHKeyName = scope.AWSCluster.Spec.SSHKeyName
 		} else {
@@ -235,8 +279,45 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 		}
 	}
 
+	placementGroupName, err := NewPlacementGroupReconciler(s.scope).Reconcile(scope)
+	if err != nil {
+		return nil, err
+	}
+	input.PlacementGroupName = placementGroupName
+
+	if scope.AWSMachine.Spec.FleetMode {
+		s.scope.V(2).Info("Creating EC2 Fleet", "machine-role", scope.Role())
+		if err := s.createFleet(scope, input); err != nil {
+			return nil, err
+		}
+		return nil, errors.Wrapf(errFleetPending, "created EC2 Fleet %q", aws.StringValue(scope.AWSMachine.Status.FleetID))
+	}
+
 	s.scope.V(2).Info("Running instance", "machine-role", scope.Role())
-	out, err := s.runInstance(scope.Role(), input)
+	out, err := s.runMachineInstance(scope, input)
+
+	// Retry in the next failure domain when the selected one ran out of capacity for the instance type.
+	var exhausted []string
+	for err != nil && isInsufficientCapacity(err) && scope.AWSMachine.Status.SelectedFailureDomain != nil && len(input.NetworkInterfaces) == 0 {
+		exhausted = append(exhausted, *scope.AWSMachine.Status.SelectedFailureDomain)
+		record.Warnf(scope.AWSMachine, "InsufficientCapacity", "Failure domain %q has no capacity for instance type %q",
+			*scope.AWSMachine.Status.SelectedFailureDomain, input.Type)
+
+		zone, selectErr := NewCapacityCheckReconciler(s.scope).SelectFailureDomain(scope, *failureDomain, exhausted...)
+		if selectErr != nil {
+			err = selectErr
+			break
+		}
+		scope.AWSMachine.Status.SelectedFailureDomain = aws.String(zone)
+		subnetID, selectErr := s.selectSubnetWithAvailableIPs(scope, s.scope.Subnets().FilterPrivate().FilterByZone(zone)[0].ID)
+		if selectErr != nil {
+			err = selectErr
+			break
+		}
+		input.SubnetID = subnetID
+		scope.AWSMachine.Status.SubnetID = aws.String(subnetID)
+		out, err = s.runMachineInstance(scope, input)
+	}
 	if err != nil {
 		// Only record the failure event if the error is not related to failed dependencies.
 		// This is to avoid spamming failure events since the machine will be requeued by the actuator.
@@ -259,9 +340,70 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte) (*i
 	return out, nil
 }
 
+// configureInstanceType checks the options of the instance depending on its type, and sets whether
+// the instance is EBS optimized.
+func (s *Service) configureInstanceType(scope *scope.MachineScope, input *infrav1.Instance) error {
+	if input.Hibernation {
+		supported, err := s.hibernationSupported(input.Type)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			return errors.Errorf("instance type %q does not support hibernation", input.Type)
+		}
+	}
+
+	if input.NitroEnclavesEnabled {
+		if err := s.validateNitroEnclaves(input.Type); err != nil {
+			scope.SetFailureReason(capierrors.CreateMachineError)
+			scope.SetFailureMessage(err)
+			return err
+		}
+	}
+
+	if settings := scope.AWSMachine.Spec.ENAExpressSettings; settings != nil && settings.Enabled {
+		if err := s.validateENAExpress(input.Type); err != nil {
+			scope.SetFailureReason(capierrors.CreateMachineError)
+			scope.SetFailureMessage(err)
+			return err
+		}
+	}
+
+	if input.CPUOptions != nil {
+		if err := s.validateCPUOptions(input.Type, input.CPUOptions); err != nil {
+			scope.SetFailureReason(capierrors.CreateMachineError)
+			scope.SetFailureMessage(err)
+			return err
+		}
+	}
+
+	if len(input.EphemeralStorage) > 0 {
+		if err := s.validateEphemeralStorage(input.Type, input.EphemeralStorage); err != nil {
+			scope.SetFailureReason(capierrors.CreateMachineError)
+			scope.SetFailureMessage(err)
+			return err
+		}
+	}
+
+	ebsOptimized, ebsBandwidth, err := s.ebsOptimization(input.Type, scope.AWSMachine.Spec.EBSOptimized)
+	if err != nil {
+		return err
+	}
+	input.EBSOptimized = ebsOptimized
+	scope.AWSMachine.Status.EBSBaselineBandwidthMbps = ebsBandwidth
+
+	return nil
+}
+
 // GetCoreSecurityGroups looks up the security group IDs managed by this actuator
 // They are considered "core" to its proper functioning
 func (s *Service) GetCoreSecurityGroups(scope *scope.MachineScope) ([]string, error) {
+	// The core security groups belong to the cluster's VPC and can't be used in
+	// a remote region, where instances get the remote VPC's default security group.
+	if s.scope.IsRemoteRegion() {
+		return nil, nil
+	}
+
 	// These are common across both controlplane and node machines
 	sgRoles := []infrav1.SecurityGroupRole{
 		infrav1.SecurityGroupNode,
@@ -283,6 +425,10 @@ func (s *Service) GetCoreSecurityGroups(scope *scope.MachineScope) ([]string, er
 			)
 		}
 		ids = append(ids, s.scope.SecurityGroups()[sg].ID)
+		// Instances also get the ingress rules moved to the overflow security group of the role.
+		if overflow, ok := s.scope.SecurityGroups()[overflowRole(sg)]; ok {
+			ids = append(ids, overflow.ID)
+		}
 	}
 	return ids, nil
 }
@@ -304,6 +450,20 @@ func (s *Service) TerminateInstance(instanceID string) error {
 	return nil
 }
 
+// StartInstance starts a stopped EC2 instance.
+func (s *Service) StartInstance(instanceID string) error {
+	s.scope.V(2).Info("Attempting to start instance", "instance-id", instanceID)
+
+	if _, err := s.scope.EC2.StartInstances(&ec2.StartInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to start instance with id %q", instanceID)
+	}
+
+	s.scope.V(2).Info("Started instance", "instance-id", instanceID)
+	return nil
+}
+
 // TerminateInstanceAndWait terminates and waits
 // for an EC2 instance to terminate.
 func (s *Service) TerminateInstanceAndWait(instanceID string) error {
@@ -325,9 +485,45 @@ func (s *Service) TerminateInstanceAndWait(instanceID string) error {
 }
 
 func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instance, error) {
+	input, err := s.runInstancesInput(role, i)
+	if err != nil {
+		return nil, err
+	}
+	return s.launchInstance(input)
+}
+
+// runMachineInstance runs the instance of a machine, and stores its launch snapshot once it is running
+// when the machine has one.
+func (s *Service) runMachineInstance(scope *scope.MachineScope, i *infrav1.Instance) (*infrav1.Instance, error) {
+	input, err := s.runInstancesInput(scope.Role(), i)
+	if err != nil {
+		return nil, err
+	}
+
+	// Termination protection of Windows instances is explicitly disabled, whatever their launch template sets,
+	// for the controller to be able to terminate them.
+	if scope.AWSMachine.Spec.OSType == infrav1.OSTypeWindows {
+		input.DisableApiTermination = aws.Bool(false)
+	}
+
+	out, err := s.launchInstance(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// The instance is running already, failing to snapshot its launch configuration must not launch it again.
+	if scope.AWSMachine.Spec.LaunchSnapshot != nil {
+		if err := s.saveLaunchSnapshot(scope, input); err != nil {
+			s.scope.Error(err, "failed to save launch snapshot", "instance-id", out.ID)
+			record.Warnf(scope.AWSMachine, "FailedSaveLaunchSnapshot", "Failed to save launch snapshot of instance %q: %v", out.ID, err)
+		}
+	}
+	return out, nil
+}
+
+// runInstancesInput returns the input of the RunInstances call launching the instance.
+func (s *Service) runInstancesInput(role string, i *infrav1.Instance) (*ec2.RunInstancesInput, error) {
 	input := &ec2.RunInstancesInput{
-		InstanceType: aws.String(i.Type),
-		ImageId:      aws.String(i.ImageID),
 		KeyName:      i.SSHKeyName,
 		EbsOptimized: i.EBSOptimized,
 		MaxCount:     aws.Int64(1),
@@ -335,6 +531,23 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		UserData:     i.UserData,
 	}
 
+	// The instance type and image of instances launched from a launch template are only set when overridden.
+	if i.LaunchTemplate == nil || i.Type != "" {
+		input.InstanceType = aws.String(i.Type)
+	}
+	if i.LaunchTemplate == nil || i.ImageID != "" {
+		input.ImageId = aws.String(i.ImageID)
+	}
+
+	if i.LaunchTemplate != nil {
+		input.LaunchTemplate = &ec2.LaunchTemplateSpecification{
+			LaunchTemplateId: aws.String(i.LaunchTemplate.ID),
+		}
+		if i.LaunchTemplate.Version != "" {
+			input.LaunchTemplate.Version = aws.String(i.LaunchTemplate.Version)
+		}
+	}
+
 	s.scope.V(2).Info("userData size", "bytes", len(*i.UserData), "role", role)
 
 	if len(i.NetworkInterfaces) > 0 {
@@ -356,6 +569,12 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 	}
 
+	if i.NitroEnclavesEnabled {
+		input.EnclaveOptions = &ec2.EnclaveOptionsRequest{
+			Enabled: aws.Bool(true),
+		}
+	}
+
 	if i.IAMProfile != "" {
 		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{
 			Name: aws.String(i.IAMProfile),
@@ -363,6 +582,10 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	}
 
 	if i.RootVolume != nil { // nolint:nestif
+		if i.ImageID == "" {
+			return nil, errors.New("root volume of instances launched from a launch template requires an explicit image")
+		}
+
 		rootDeviceName, err := s.getImageRootDevice(i.ImageID)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to get root volume from image %q", i.ImageID)
@@ -404,18 +627,69 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 	}
 
-	if len(i.Tags) > 0 {
-		spec := &ec2.TagSpecification{ResourceType: aws.String(ec2.ResourceTypeInstance)}
-		for key, value := range i.Tags {
-			spec.Tags = append(spec.Tags, &ec2.Tag{
-				Key:   aws.String(key),
-				Value: aws.String(value),
-			})
+	for _, storage := range i.EphemeralStorage {
+		input.BlockDeviceMappings = append(input.BlockDeviceMappings, &ec2.BlockDeviceMapping{
+			DeviceName:  aws.String(storage.DeviceName),
+			VirtualName: aws.String(storage.VirtualName),
+		})
+	}
+
+	if i.Hibernation {
+		input.HibernationOptions = &ec2.HibernationOptionsRequest{
+			Configured: aws.Bool(true),
 		}
+	}
 
-		input.TagSpecifications = append(input.TagSpecifications, spec)
+	if i.ShutdownBehavior != "" {
+		input.InstanceInitiatedShutdownBehavior = aws.String(string(i.ShutdownBehavior))
 	}
 
+	if i.CPUOptions != nil {
+		input.CpuOptions = &ec2.CpuOptionsRequest{
+			CoreCount:      aws.Int64(i.CPUOptions.CoreCount),
+			ThreadsPerCore: aws.Int64(i.CPUOptions.ThreadsPerCore),
+		}
+	}
+
+	if i.CreditSpecification != "" && infrav1.IsBurstableInstanceType(i.Type) {
+		input.CreditSpecification = &ec2.CreditSpecificationRequest{
+			CpuCredits: aws.String(i.CreditSpecification),
+		}
+	}
+
+	if i.DetailedMonitoring {
+		input.Monitoring = &ec2.RunInstancesMonitoringEnabled{
+			Enabled: aws.Bool(true),
+		}
+	}
+
+	if i.PlacementGroupName != "" {
+		input.Placement = &ec2.Placement{
+			GroupName: aws.String(i.PlacementGroupName),
+		}
+	}
+
+	if len(i.Tags) > 0 {
+		input.TagSpecifications = append(input.TagSpecifications, instanceTagSpecification(i.Tags))
+	}
+
+	return input, nil
+}
+
+// instanceTagSpecification returns the specification of the tags of an instance at launch.
+func instanceTagSpecification(tags infrav1.Tags) *ec2.TagSpecification {
+	spec := &ec2.TagSpecification{ResourceType: aws.String(ec2.ResourceTypeInstance)}
+	for key, value := range tags {
+		spec.Tags = append(spec.Tags, &ec2.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+	return spec
+}
+
+// launchInstance runs an instance with the given input and waits for it to be running.
+func (s *Service) launchInstance(input *ec2.RunInstancesInput) (*infrav1.Instance, error) {
 	out, err := s.scope.EC2.RunInstances(input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to run instance")
@@ -490,6 +764,153 @@ func (s *Service) UpdateInstanceSecurityGroups(instanceID string, ids []string)
 	return nil
 }
 
+// UpdateInstanceIAMProfile associates the given IAM instance profile with the
+// EC2 instance, replacing the current association if there is one.
+func (s *Service) UpdateInstanceIAMProfile(instanceID, profileName string) error {
+	s.scope.V(2).Info("Attempting to update IAM instance profile on instance", "instance-id", instanceID, "iam-profile", profileName)
+
+	profile, err := s.scope.IAM.GetInstanceProfile(&iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == iam.ErrCodeNoSuchEntityException {
+			return awserrors.NewNotFound(errors.Errorf("IAM instance profile %q not found", profileName))
+		}
+		return errors.Wrapf(err, "failed to get IAM instance profile %q", profileName)
+	}
+
+	specification := &ec2.IamInstanceProfileSpecification{
+		Arn: profile.InstanceProfile.Arn,
+	}
+
+	out, err := s.scope.EC2.DescribeIamInstanceProfileAssociations(&ec2.DescribeIamInstanceProfileAssociationsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.InstanceID(instanceID),
+			filter.EC2.IAMInstanceProfileAssociationStates(ec2.IamInstanceProfileAssociationStateAssociated),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe IAM instance profile associations for instance %q", instanceID)
+	}
+
+	if len(out.IamInstanceProfileAssociations) == 0 {
+		if _, err := s.scope.EC2.AssociateIamInstanceProfile(&ec2.AssociateIamInstanceProfileInput{
+			InstanceId:         aws.String(instanceID),
+			IamInstanceProfile: specification,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to associate IAM instance profile %q with instance %q", profileName, instanceID)
+		}
+		return nil
+	}
+
+	if _, err := s.scope.EC2.ReplaceIamInstanceProfileAssociation(&ec2.ReplaceIamInstanceProfileAssociationInput{
+		AssociationId:      out.IamInstanceProfileAssociations[0].AssociationId,
+		IamInstanceProfile: specification,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to replace IAM instance profile of instance %q with %q", instanceID, profileName)
+	}
+
+	return nil
+}
+
+// UpdateInstanceMonitoring enables or disables detailed monitoring of the EC2 instance.
+func (s *Service) UpdateInstanceMonitoring(instanceID string, enabled bool) error {
+	s.scope.V(2).Info("Attempting to update detailed monitoring on instance", "instance-id", instanceID, "enabled", enabled)
+
+	ids := aws.StringSlice([]string{instanceID})
+	if enabled {
+		if _, err := s.scope.EC2.MonitorInstances(&ec2.MonitorInstancesInput{InstanceIds: ids}); err != nil {
+			return errors.Wrapf(err, "failed to enable detailed monitoring on instance %q", instanceID)
+		}
+		return nil
+	}
+
+	if _, err := s.scope.EC2.UnmonitorInstances(&ec2.UnmonitorInstancesInput{InstanceIds: ids}); err != nil {
+		return errors.Wrapf(err, "failed to disable detailed monitoring on instance %q", instanceID)
+	}
+	return nil
+}
+
+// UpdateInstanceCreditSpecification sets the CPU credit option of a burstable
+// EC2 instance, and returns true if it was changed.
+func (s *Service) UpdateInstanceCreditSpecification(instanceID, cpuCredits string) (bool, error) {
+	out, err := s.scope.EC2.DescribeInstanceCreditSpecifications(&ec2.DescribeInstanceCreditSpecificationsInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe credit specification of instance %q", instanceID)
+	}
+	if len(out.InstanceCreditSpecifications) > 0 && aws.StringValue(out.InstanceCreditSpecifications[0].CpuCredits) == cpuCredits {
+		return false, nil
+	}
+
+	s.scope.V(2).Info("Attempting to update credit specification on instance", "instance-id", instanceID, "cpu-credits", cpuCredits)
+
+	res, err := s.scope.EC2.ModifyInstanceCreditSpecification(&ec2.ModifyInstanceCreditSpecificationInput{
+		InstanceCreditSpecifications: []*ec2.InstanceCreditSpecificationRequest{
+			{
+				InstanceId: aws.String(instanceID),
+				CpuCredits: aws.String(cpuCredits),
+			},
+		},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to modify credit specification of instance %q", instanceID)
+	}
+	for _, item := range res.UnsuccessfulInstanceCreditSpecifications {
+		if item.Error != nil {
+			return false, errors.Errorf("failed to modify credit specification of instance %q: %s: %s", instanceID, aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+		}
+	}
+
+	return true, nil
+}
+
+// UpdateInstanceENAExpress configures ENA Express on the network interfaces attached to the instance,
+// and returns whether any of them was modified.
+func (s *Service) UpdateInstanceENAExpress(instanceID string, settings infrav1.ENAExpressSpec) (bool, error) {
+	enis, err := s.getInstanceENIs(instanceID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get ENIs for instance %q", instanceID)
+	}
+
+	changed := false
+	for _, eni := range enis {
+		if eni.Attachment == nil {
+			continue
+		}
+		var enabled, udpEnabled bool
+		if current := eni.Attachment.EnaSrdSpecification; current != nil {
+			enabled = aws.BoolValue(current.EnaSrdEnabled)
+			if current.EnaSrdUdpSpecification != nil {
+				udpEnabled = aws.BoolValue(current.EnaSrdUdpSpecification.EnaSrdUdpEnabled)
+			}
+		}
+		if enabled == settings.Enabled && udpEnabled == settings.UDPEnabled {
+			continue
+		}
+
+		eniID := aws.StringValue(eni.NetworkInterfaceId)
+		s.scope.V(2).Info("Attempting to update ENA Express on network interface", "instance-id", instanceID, "network-interface-id", eniID,
+			"enabled", settings.Enabled, "udp-enabled", settings.UDPEnabled)
+
+		if _, err := s.scope.EC2.ModifyNetworkInterfaceAttribute(&ec2.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: aws.String(eniID),
+			EnaSrdSpecification: &ec2.EnaSrdSpecification{
+				EnaSrdEnabled: aws.Bool(settings.Enabled),
+				EnaSrdUdpSpecification: &ec2.EnaSrdUdpSpecification{
+					EnaSrdUdpEnabled: aws.Bool(settings.UDPEnabled),
+				},
+			},
+		}); err != nil {
+			return changed, errors.Wrapf(err, "failed to modify ENA Express of network interface %q of instance %q", eniID, instanceID)
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
 // UpdateResourceTags updates the tags for an instance.
 // This will be called if there is anything to create (update) or delete.
 // We may not always have to perform each action, so we check what we're
@@ -608,6 +1029,10 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 		EBSOptimized: v.EbsOptimized,
 	}
 
+	if v.EnclaveOptions != nil {
+		i.NitroEnclavesEnabled = aws.BoolValue(v.EnclaveOptions.Enabled)
+	}
+
 	// Extract IAM Instance Profile name from ARN
 	// TODO: Handle this comparison more safely, perhaps by querying IAM for the
 	// instance profile ARN and comparing to the ARN returned by EC2
@@ -618,6 +1043,17 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 		}
 	}
 
+	if v.HibernationOptions != nil {
+		i.Hibernation = aws.BoolValue(v.HibernationOptions.Configured)
+	}
+
+	if v.Monitoring != nil {
+		switch aws.StringValue(v.Monitoring.State) {
+		case ec2.MonitoringStateEnabled, ec2.MonitoringStatePending:
+			i.DetailedMonitoring = true
+		}
+	}
+
 	for _, sg := range v.SecurityGroups {
 		i.SecurityGroupIDs = append(i.SecurityGroupIDs, *sg.GroupId)
 	}
@@ -629,6 +1065,7 @@ func (s *Service) SDKToInstance(v *ec2.Instance) (*infrav1.Instance, error) {
 	i.Addresses = s.getInstanceAddresses(v)
 
 	i.AvailabilityZone = aws.StringValue(v.Placement.AvailabilityZone)
+	i.PlacementGroupName = aws.StringValue(v.Placement.GroupName)
 
 	return i, nil
 }
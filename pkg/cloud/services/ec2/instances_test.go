@@ -17,11 +17,13 @@ limitations under the License.
 package ec2
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb/mock_elbiface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam/mock_iamiface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -261,6 +264,384 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestUpdateInstanceIAMProfile(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	profileArn := "arn:aws:iam::123456789012:instance-profile/new-profile"
+
+	testCases := []struct {
+		name      string
+		expectIAM func(m *mock_iamiface.MockIAMAPIMockRecorder)
+		expectEC2 func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		check     func(err error)
+	}{
+		{
+			name: "replaces existing association",
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetInstanceProfile(gomock.Eq(&iam.GetInstanceProfileInput{
+					InstanceProfileName: aws.String("new-profile"),
+				})).
+					Return(&iam.GetInstanceProfileOutput{
+						InstanceProfile: &iam.InstanceProfile{Arn: aws.String(profileArn)},
+					}, nil)
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeIamInstanceProfileAssociations(gomock.AssignableToTypeOf(&ec2.DescribeIamInstanceProfileAssociationsInput{})).
+					Return(&ec2.DescribeIamInstanceProfileAssociationsOutput{
+						IamInstanceProfileAssociations: []*ec2.IamInstanceProfileAssociation{
+							{AssociationId: aws.String("iip-assoc-1")},
+						},
+					}, nil)
+				m.ReplaceIamInstanceProfileAssociation(gomock.Eq(&ec2.ReplaceIamInstanceProfileAssociationInput{
+					AssociationId:      aws.String("iip-assoc-1"),
+					IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Arn: aws.String(profileArn)},
+				})).
+					Return(&ec2.ReplaceIamInstanceProfileAssociationOutput{}, nil)
+			},
+			check: func(err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "associates profile when there is no association",
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetInstanceProfile(gomock.Any()).
+					Return(&iam.GetInstanceProfileOutput{
+						InstanceProfile: &iam.InstanceProfile{Arn: aws.String(profileArn)},
+					}, nil)
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeIamInstanceProfileAssociations(gomock.AssignableToTypeOf(&ec2.DescribeIamInstanceProfileAssociationsInput{})).
+					Return(&ec2.DescribeIamInstanceProfileAssociationsOutput{}, nil)
+				m.AssociateIamInstanceProfile(gomock.Eq(&ec2.AssociateIamInstanceProfileInput{
+					InstanceId:         aws.String("i-exist"),
+					IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Arn: aws.String(profileArn)},
+				})).
+					Return(&ec2.AssociateIamInstanceProfileOutput{}, nil)
+			},
+			check: func(err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "profile does not exist",
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.GetInstanceProfile(gomock.Any()).
+					Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			check: func(err error) {
+				if !awserrors.IsNotFound(err) {
+					t.Fatalf("expected not found error, got: %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+					IAM: iamMock,
+				},
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expectIAM(iamMock.EXPECT())
+			tc.expectEC2(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			err = s.UpdateInstanceIAMProfile("i-exist", "new-profile")
+			tc.check(err)
+		})
+	}
+}
+
+func TestUpdateInstanceMonitoring(t *testing.T) {
+	testCases := []struct {
+		name    string
+		enabled bool
+		expect  func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name:    "enables detailed monitoring",
+			enabled: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.MonitorInstances(gomock.Eq(&ec2.MonitorInstancesInput{
+					InstanceIds: []*string{aws.String("i-exist")},
+				})).
+					Return(&ec2.MonitorInstancesOutput{}, nil)
+			},
+		},
+		{
+			name:    "disables detailed monitoring",
+			enabled: false,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.UnmonitorInstances(gomock.Eq(&ec2.UnmonitorInstancesInput{
+					InstanceIds: []*string{aws.String("i-exist")},
+				})).
+					Return(&ec2.UnmonitorInstancesOutput{}, nil)
+			},
+		},
+		{
+			name:    "fails to enable detailed monitoring",
+			enabled: true,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.MonitorInstances(gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			if err := s.UpdateInstanceMonitoring("i-exist", tc.enabled); (err != nil) != tc.wantErr {
+				t.Fatalf("UpdateInstanceMonitoring() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateInstanceCreditSpecification(t *testing.T) {
+	describe := func(m *mock_ec2iface.MockEC2APIMockRecorder, credits string) {
+		m.DescribeInstanceCreditSpecifications(gomock.Eq(&ec2.DescribeInstanceCreditSpecificationsInput{
+			InstanceIds: []*string{aws.String("i-exist")},
+		})).
+			Return(&ec2.DescribeInstanceCreditSpecificationsOutput{
+				InstanceCreditSpecifications: []*ec2.InstanceCreditSpecification{
+					{InstanceId: aws.String("i-exist"), CpuCredits: aws.String(credits)},
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantChanged bool
+		wantErr     bool
+	}{
+		{
+			name: "credit specification is up to date",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describe(m, "unlimited")
+			},
+		},
+		{
+			name: "modifies the credit specification",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describe(m, "standard")
+				m.ModifyInstanceCreditSpecification(gomock.Eq(&ec2.ModifyInstanceCreditSpecificationInput{
+					InstanceCreditSpecifications: []*ec2.InstanceCreditSpecificationRequest{
+						{InstanceId: aws.String("i-exist"), CpuCredits: aws.String("unlimited")},
+					},
+				})).
+					Return(&ec2.ModifyInstanceCreditSpecificationOutput{}, nil)
+			},
+			wantChanged: true,
+		},
+		{
+			name: "modification is unsuccessful",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describe(m, "standard")
+				m.ModifyInstanceCreditSpecification(gomock.Any()).
+					Return(&ec2.ModifyInstanceCreditSpecificationOutput{
+						UnsuccessfulInstanceCreditSpecifications: []*ec2.UnsuccessfulInstanceCreditSpecificationItem{
+							{
+								InstanceId: aws.String("i-exist"),
+								Error: &ec2.UnsuccessfulInstanceCreditSpecificationItemError{
+									Code:    aws.String(ec2.UnsuccessfulInstanceCreditSpecificationErrorCodeIncorrectInstanceState),
+									Message: aws.String("instance is stopping"),
+								},
+							},
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			changed, err := s.UpdateInstanceCreditSpecification("i-exist", "unlimited")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("UpdateInstanceCreditSpecification() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if changed != tc.wantChanged {
+				t.Fatalf("expected changed to be %v, got %v", tc.wantChanged, changed)
+			}
+		})
+	}
+}
+
+func TestUpdateInstanceENAExpress(t *testing.T) {
+	describe := func(m *mock_ec2iface.MockEC2APIMockRecorder, current *ec2.AttachmentEnaSrdSpecification) {
+		m.DescribeNetworkInterfaces(gomock.Eq(&ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("attachment.instance-id"),
+					Values: []*string{aws.String("i-exist")},
+				},
+			},
+		})).
+			Return(&ec2.DescribeNetworkInterfacesOutput{
+				NetworkInterfaces: []*ec2.NetworkInterface{
+					{
+						NetworkInterfaceId: aws.String("eni-1"),
+						Attachment: &ec2.NetworkInterfaceAttachment{
+							DeviceIndex:         aws.Int64(0),
+							EnaSrdSpecification: current,
+						},
+					},
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantChanged bool
+		wantErr     bool
+	}{
+		{
+			name: "ena express is up to date",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describe(m, &ec2.AttachmentEnaSrdSpecification{
+					EnaSrdEnabled: aws.Bool(true),
+					EnaSrdUdpSpecification: &ec2.AttachmentEnaSrdUdpSpecification{
+						EnaSrdUdpEnabled: aws.Bool(true),
+					},
+				})
+			},
+		},
+		{
+			name: "enables ena express",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describe(m, nil)
+				m.ModifyNetworkInterfaceAttribute(gomock.Eq(&ec2.ModifyNetworkInterfaceAttributeInput{
+					NetworkInterfaceId: aws.String("eni-1"),
+					EnaSrdSpecification: &ec2.EnaSrdSpecification{
+						EnaSrdEnabled: aws.Bool(true),
+						EnaSrdUdpSpecification: &ec2.EnaSrdUdpSpecification{
+							EnaSrdUdpEnabled: aws.Bool(true),
+						},
+					},
+				})).
+					Return(&ec2.ModifyNetworkInterfaceAttributeOutput{}, nil)
+			},
+			wantChanged: true,
+		},
+		{
+			name: "modification fails",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describe(m, &ec2.AttachmentEnaSrdSpecification{EnaSrdEnabled: aws.Bool(true)})
+				m.ModifyNetworkInterfaceAttribute(gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency(errors.New("ena express is not supported")))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			changed, err := s.UpdateInstanceENAExpress("i-exist", infrav1.ENAExpressSpec{Enabled: true, UDPEnabled: true})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("UpdateInstanceENAExpress() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if changed != tc.wantChanged {
+				t.Fatalf("expected changed to be %v, got %v", tc.wantChanged, changed)
+			}
+		})
+	}
+}
+
+// expectEBSInstanceType expects the instance type to be described for its EBS
+// optimization support. Described instance types are cached across tests.
+func expectEBSInstanceType(m *mock_ec2iface.MockEC2APIMockRecorder, instanceType, support string) {
+	m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(instanceType)},
+	})).
+		Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				{
+					InstanceType: aws.String(instanceType),
+					EbsInfo: &ec2.EbsInfo{
+						EbsOptimizedSupport: aws.String(support),
+						EbsOptimizedInfo:    &ec2.EbsOptimizedInfo{BaselineBandwidthInMbps: aws.Int64(650)},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -304,11 +685,2178 @@ func TestCreateInstance(t *testing.T) {
 					NetworkSpec: infrav1.NetworkSpec{
 						Subnets: infrav1.Subnets{
 							&infrav1.SubnetSpec{
-								ID:       "subnet-1",
-								IsPublic: false,
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m. // TODO: Restore these parameters, but with the tags as well
+					RunInstances(gomock.Any()).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with detailed monitoring",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				DetailedMonitoring: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.Monitoring == nil || !aws.BoolValue(input.Monitoring.Enabled) {
+							t.Fatalf("expected detailed monitoring to be enabled, got %v", input.Monitoring)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								Monitoring: &ec2.Monitoring{
+									State: aws.String(ec2.MonitoringStatePending),
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !instance.DetailedMonitoring {
+					t.Fatalf("expected instance to have detailed monitoring")
+				}
+			},
+		},
+		{
+			name: "with cpu options",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "c5.xlarge",
+				CPUOptions: &infrav1.CPUOptionsSpec{
+					CoreCount:      2,
+					ThreadsPerCore: 1,
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5.xlarge"),
+								VCpuInfo: &ec2.VCpuInfo{
+									ValidCores:          aws.Int64Slice([]int64{2, 4}),
+									ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.CpuOptions == nil || aws.Int64Value(input.CpuOptions.CoreCount) != 2 || aws.Int64Value(input.CpuOptions.ThreadsPerCore) != 1 {
+							t.Fatalf("expected 2 cores with 1 thread per core, got %v", input.CpuOptions)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("c5.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with detailed monitoring",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				DetailedMonitoring: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5.xlarge"),
+								VCpuInfo: &ec2.VCpuInfo{
+									ValidCores:          aws.Int64Slice([]int64{2, 4}),
+									ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.Monitoring == nil || !aws.BoolValue(input.Monitoring.Enabled) {
+							t.Fatalf("expected detailed monitoring to be enabled, got %v", input.Monitoring)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("c5.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								Monitoring: &ec2.Monitoring{
+									State: aws.String(ec2.MonitoringStatePending),
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !instance.DetailedMonitoring {
+					t.Fatalf("expected instance to have detailed monitoring")
+				}
+			},
+		},
+		{
+			name: "with cpu options unsupported by the instance type",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "c5.2xlarge",
+				CPUOptions: &infrav1.CPUOptionsSpec{
+					CoreCount:      3,
+					ThreadsPerCore: 2,
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5.2xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5.2xlarge"),
+								VCpuInfo: &ec2.VCpuInfo{
+									ValidCores:          aws.Int64Slice([]int64{2, 4}),
+									ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.RunInstances(gomock.Any()).Times(0)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for a core count the instance type doesn't support")
+				}
+			},
+		},
+		{
+			name: "with ephemeral storage",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "i3.xlarge",
+				EphemeralStorage: []infrav1.EphemeralStorageSpec{
+					{VirtualName: "ephemeral0", DeviceName: "/dev/sdb"},
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("i3.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType:             aws.String("i3.xlarge"),
+								InstanceStorageSupported: aws.Bool(true),
+								InstanceStorageInfo: &ec2.InstanceStorageInfo{
+									Disks: []*ec2.DiskInfo{{Count: aws.Int64(1), SizeInGB: aws.Int64(950)}},
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if len(input.BlockDeviceMappings) != 1 {
+							t.Fatalf("expected 1 block device mapping, got %v", input.BlockDeviceMappings)
+						}
+						mapping := input.BlockDeviceMappings[0]
+						if aws.StringValue(mapping.VirtualName) != "ephemeral0" || aws.StringValue(mapping.DeviceName) != "/dev/sdb" || mapping.Ebs != nil {
+							t.Fatalf("expected ephemeral0 mapped to /dev/sdb without EBS, got %v", mapping)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("i3.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with ephemeral storage on an instance type without instance store",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.2xlarge",
+				EphemeralStorage: []infrav1.EphemeralStorageSpec{
+					{VirtualName: "ephemeral0", DeviceName: "/dev/sdb"},
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("m5.2xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType:             aws.String("m5.2xlarge"),
+								InstanceStorageSupported: aws.Bool(false),
+							},
+						},
+					}, nil).AnyTimes()
+				m.RunInstances(gomock.Any()).Times(0)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for an instance type without instance store volumes")
+				}
+			},
+		},
+		{
+			name: "with unlimited cpu credits",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:        "t3.medium",
+				CreditSpecification: infrav1.CPUCreditsUnlimited,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "t3.medium", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.CreditSpecification == nil || aws.StringValue(input.CreditSpecification.CpuCredits) != "unlimited" {
+							t.Fatalf("expected unlimited cpu credits, got %v", input.CreditSpecification)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("t3.medium"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with detailed monitoring",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				DetailedMonitoring: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "t3.medium", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.Monitoring == nil || !aws.BoolValue(input.Monitoring.Enabled) {
+							t.Fatalf("expected detailed monitoring to be enabled, got %v", input.Monitoring)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("t3.medium"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								Monitoring: &ec2.Monitoring{
+									State: aws.String(ec2.MonitoringStatePending),
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !instance.DetailedMonitoring {
+					t.Fatalf("expected instance to have detailed monitoring")
+				}
+			},
+		},
+		{
+			name: "with cpu options",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "c5.xlarge",
+				CPUOptions: &infrav1.CPUOptionsSpec{
+					CoreCount:      2,
+					ThreadsPerCore: 1,
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5.xlarge"),
+								VCpuInfo: &ec2.VCpuInfo{
+									ValidCores:          aws.Int64Slice([]int64{2, 4}),
+									ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.CpuOptions == nil || aws.Int64Value(input.CpuOptions.CoreCount) != 2 || aws.Int64Value(input.CpuOptions.ThreadsPerCore) != 1 {
+							t.Fatalf("expected 2 cores with 1 thread per core, got %v", input.CpuOptions)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("c5.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with detailed monitoring",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "m5.large",
+				DetailedMonitoring: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5.xlarge"),
+								VCpuInfo: &ec2.VCpuInfo{
+									ValidCores:          aws.Int64Slice([]int64{2, 4}),
+									ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.Monitoring == nil || !aws.BoolValue(input.Monitoring.Enabled) {
+							t.Fatalf("expected detailed monitoring to be enabled, got %v", input.Monitoring)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("c5.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								Monitoring: &ec2.Monitoring{
+									State: aws.String(ec2.MonitoringStatePending),
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !instance.DetailedMonitoring {
+					t.Fatalf("expected instance to have detailed monitoring")
+				}
+			},
+		},
+		{
+			name: "with cpu options unsupported by the instance type",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "c5.2xlarge",
+				CPUOptions: &infrav1.CPUOptionsSpec{
+					CoreCount:      3,
+					ThreadsPerCore: 2,
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5.2xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5.2xlarge"),
+								VCpuInfo: &ec2.VCpuInfo{
+									ValidCores:          aws.Int64Slice([]int64{2, 4}),
+									ValidThreadsPerCore: aws.Int64Slice([]int64{1, 2}),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.RunInstances(gomock.Any()).Times(0)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for a core count the instance type doesn't support")
+				}
+			},
+		},
+		{
+			name: "with EBS optimization supported by the instance type",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "c4.large",
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "c4.large", ec2.EbsOptimizedSupportSupported)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if aws.BoolValue(input.EbsOptimized) != true {
+							t.Fatalf("expected EbsOptimized to be true, got %v", input.EbsOptimized)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("c4.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with EBS optimization disabled",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.xlarge",
+				EBSOptimized: aws.Bool(false),
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.xlarge", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if aws.BoolValue(input.EbsOptimized) != false {
+							t.Fatalf("expected EbsOptimized to be false, got %v", input.EbsOptimized)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with hibernation",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				Hibernation:  true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.HibernationOptions == nil || !aws.BoolValue(input.HibernationOptions.Configured) {
+							t.Fatalf("expected hibernation to be configured, got %v", input.HibernationOptions)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								HibernationOptions: &ec2.HibernationOptions{
+									Configured: aws.Bool(true),
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !instance.Hibernation {
+					t.Fatalf("expected instance to be configured for hibernation")
+				}
+			},
+		},
+		{
+			name: "with stop shutdown behavior",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:     "m5.large",
+				ShutdownBehavior: infrav1.ShutdownBehaviorStop,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if aws.StringValue(input.InstanceInitiatedShutdownBehavior) != ec2.ShutdownBehaviorStop {
+							t.Fatalf("expected the instance to stop on shutdown, got %v", input.InstanceInitiatedShutdownBehavior)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with windows",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "m5.large",
+				OSType:       infrav1.OSTypeWindows,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.KeyName != nil {
+							t.Fatalf("expected no key pair, got %q", aws.StringValue(input.KeyName))
+						}
+						if input.DisableApiTermination == nil || aws.BoolValue(input.DisableApiTermination) {
+							t.Fatalf("expected termination protection to be disabled, got %v", input.DisableApiTermination)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m5.large"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with hibernation on an unsupported instance type",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType: "p3.16xlarge",
+				Hibernation:  true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("p3.16xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType:         aws.String("p3.16xlarge"),
+								HibernationSupported: aws.Bool(false),
+								MemoryInfo:           &ec2.MemoryInfo{SizeInMiB: aws.Int64(499712)},
+							},
+						},
+					}, nil).AnyTimes()
+				m.RunInstances(gomock.Any()).Times(0)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for an instance type without hibernation support")
+				}
+			},
+		},
+		{
+			name: "with launch template",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				LaunchTemplateRef: &infrav1.LaunchTemplateRef{
+					ID:      "lt-0123456789abcdef0",
+					Version: "3",
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+					SSHKeyName: aws.String("cluster-key"),
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						expected := &ec2.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+							Version:          aws.String("3"),
+						}
+						if !reflect.DeepEqual(input.LaunchTemplate, expected) {
+							t.Fatalf("expected launch template %v, got %v", expected, input.LaunchTemplate)
+						}
+						if input.ImageId != nil || input.InstanceType != nil || input.KeyName != nil {
+							t.Fatalf("expected image, instance type and key to come from the launch template, got %v", input)
+						}
+						if input.SecurityGroupIds != nil || input.BlockDeviceMappings != nil {
+							t.Fatalf("expected security groups and block devices to come from the launch template, got %v", input)
+						}
+						if aws.StringValue(input.SubnetId) != "subnet-1" {
+							t.Fatalf("expected subnet %q, got %q", "subnet-1", aws.StringValue(input.SubnetId))
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								InstanceId:   aws.String("two"),
+								InstanceType: aws.String("m5.large"),
+								SubnetId:     aws.String("subnet-1"),
+								ImageId:      aws.String("ami-from-template"),
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if instance.ImageID != "ami-from-template" {
+					t.Fatalf("expected image of the launch template, got %q", instance.ImageID)
+				}
+			},
+		},
+		{
+			name: "with launch template and overridden instance type",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				LaunchTemplateRef: &infrav1.LaunchTemplateRef{
+					ID: "lt-0123456789abcdef0",
+				},
+				InstanceType: "m5.xlarge",
+				SSHKeyName:   aws.String("machine-key"),
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+					SSHKeyName: aws.String("cluster-key"),
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.xlarge", ec2.EbsOptimizedSupportDefault)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						expected := &ec2.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+						}
+						if !reflect.DeepEqual(input.LaunchTemplate, expected) {
+							t.Fatalf("expected launch template %v, got %v", expected, input.LaunchTemplate)
+						}
+						if aws.StringValue(input.InstanceType) != "m5.xlarge" || aws.StringValue(input.KeyName) != "machine-key" {
+							t.Fatalf("expected overridden instance type and key, got %v", input)
+						}
+						if input.ImageId != nil || input.SecurityGroupIds != nil {
+							t.Fatalf("expected image and security groups to come from the launch template, got %v", input)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								InstanceId:   aws.String("two"),
+								InstanceType: aws.String("m5.xlarge"),
+								SubnetId:     aws.String("subnet-1"),
+								ImageId:      aws.String("ami-from-template"),
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
+		{
+			name: "with launch template and a root volume without an image",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				LaunchTemplateRef: &infrav1.LaunchTemplateRef{
+					ID: "lt-0123456789abcdef0",
+				},
+				RootVolume: &infrav1.RootVolume{
+					Size: 16,
+				},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+						},
+					},
+					SSHKeyName: aws.String("cluster-key"),
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for a root volume without an image")
+				}
+			},
+		},
+		{
+			name: "with availability zone",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:  "m5.2xlarge",
+				FailureDomain: aws.String("us-east-1c"),
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:               "subnet-1",
+								AvailabilityZone: "us-east-1a",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-2",
+								AvailabilityZone: "us-east-1b",
+								IsPublic:         false,
+							},
+							&infrav1.SubnetSpec{
+								ID:               "subnet-3",
+								AvailabilityZone: "us-east-1c",
+								IsPublic:         false,
 							},
 							&infrav1.SubnetSpec{
-								IsPublic: false,
+								ID:               "subnet-3-public",
+								AvailabilityZone: "us-east-1c",
+								IsPublic:         true,
 							},
 						},
 					},
@@ -333,6 +2881,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.2xlarge", ec2.EbsOptimizedSupportDefault)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -342,7 +2891,16 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					}, nil)
-				m. // TODO: Restore these parameters, but with the tags as well
+
+				m.
+					DescribeInstanceTypeOfferings(gomock.Any()).
+					Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+						InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+							{InstanceType: aws.String("m5.2xlarge"), Location: aws.String("us-east-1c")},
+						},
+					}, nil)
+
+				m.
 					RunInstances(gomock.Any()).
 					Return(&ec2.Reservation{
 						Instances: []*ec2.Instance{
@@ -355,7 +2913,7 @@ func TestCreateInstance(t *testing.T) {
 								},
 								InstanceId:     aws.String("two"),
 								InstanceType:   aws.String("m5.large"),
-								SubnetId:       aws.String("subnet-1"),
+								SubnetId:       aws.String("subnet-3"),
 								ImageId:        aws.String("ami-1"),
 								RootDeviceName: aws.String("device-1"),
 								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
@@ -372,6 +2930,7 @@ func TestCreateInstance(t *testing.T) {
 							},
 						},
 					}, nil)
+
 				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
 			},
@@ -379,10 +2938,14 @@ func TestCreateInstance(t *testing.T) {
 				if err != nil {
 					t.Fatalf("did not expect error: %v", err)
 				}
+
+				if instance.SubnetID != "subnet-3" {
+					t.Fatalf("expected subnet-3 from availability zone us-east-1c, got %q", instance.SubnetID)
+				}
 			},
 		},
 		{
-			name: "with availability zone",
+			name: "with availability zone out of capacity",
 			machine: clusterv1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{"set": "node"},
@@ -428,6 +2991,11 @@ func TestCreateInstance(t *testing.T) {
 					},
 				},
 				Status: infrav1.AWSClusterStatus{
+					FailureDomains: clusterv1.FailureDomains{
+						"us-east-1a": clusterv1.FailureDomainSpec{},
+						"us-east-1b": clusterv1.FailureDomainSpec{},
+						"us-east-1c": clusterv1.FailureDomainSpec{},
+					},
 					Network: infrav1.Network{
 						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
 							infrav1.SecurityGroupControlPlane: {
@@ -447,6 +3015,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.2xlarge", ec2.EbsOptimizedSupportDefault)
 				m.
 					DescribeImages(gomock.Any()).
 					Return(&ec2.DescribeImagesOutput{
@@ -457,6 +3026,24 @@ func TestCreateInstance(t *testing.T) {
 						},
 					}, nil)
 
+				gomock.InOrder(
+					m.DescribeInstanceTypeOfferings(gomock.Any()).
+						Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+							InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+								{InstanceType: aws.String("m5.2xlarge"), Location: aws.String("us-east-1a")},
+								{InstanceType: aws.String("m5.2xlarge"), Location: aws.String("us-east-1c")},
+							},
+						}, nil),
+					m.RunInstances(gomock.Any()).
+						Return(nil, awserr.New(awserrors.InsufficientCapacity, "insufficient capacity", nil)),
+					m.DescribeInstanceTypeOfferings(gomock.Any()).
+						Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+							InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+								{InstanceType: aws.String("m5.2xlarge"), Location: aws.String("us-east-1a")},
+							},
+						}, nil),
+				)
+
 				m.
 					RunInstances(gomock.Any()).
 					Return(&ec2.Reservation{
@@ -470,7 +3057,7 @@ func TestCreateInstance(t *testing.T) {
 								},
 								InstanceId:     aws.String("two"),
 								InstanceType:   aws.String("m5.large"),
-								SubnetId:       aws.String("subnet-3"),
+								SubnetId:       aws.String("subnet-1"),
 								ImageId:        aws.String("ami-1"),
 								RootDeviceName: aws.String("device-1"),
 								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
@@ -496,8 +3083,8 @@ func TestCreateInstance(t *testing.T) {
 					t.Fatalf("did not expect error: %v", err)
 				}
 
-				if instance.SubnetID != "subnet-3" {
-					t.Fatalf("expected subnet-3 from availability zone us-east-1c, got %q", instance.SubnetID)
+				if instance.SubnetID != "subnet-1" {
+					t.Fatalf("expected subnet-1 from availability zone us-east-1a, got %q", instance.SubnetID)
 				}
 			},
 		},
@@ -552,6 +3139,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
 				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
@@ -681,6 +3269,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
 				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
@@ -811,6 +3400,7 @@ func TestCreateInstance(t *testing.T) {
 				},
 			},
 			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectEBSInstanceType(m, "m5.large", ec2.EbsOptimizedSupportDefault)
 				amiName, err := amiName("capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*", "ubuntu-18.04", "v1.16.1")
 				if err != nil {
 					t.Fatalf("Failed to process ami format: %v", err)
@@ -889,6 +3479,276 @@ func TestCreateInstance(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "with nitro enclaves",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:         "m6i.xlarge",
+				NitroEnclavesEnabled: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("m6i.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType:         aws.String("m6i.xlarge"),
+								NitroEnclavesSupport: aws.String(ec2.NitroEnclavesSupportSupported),
+							},
+						},
+					}, nil).AnyTimes()
+				m.
+					DescribeImages(gomock.Any()).
+					Return(&ec2.DescribeImagesOutput{
+						Images: []*ec2.Image{
+							{
+								Name: aws.String("ami-1"),
+							},
+						},
+					}, nil)
+				m.
+					RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					Do(func(input *ec2.RunInstancesInput) {
+						if input.EnclaveOptions == nil || !aws.BoolValue(input.EnclaveOptions.Enabled) {
+							t.Fatalf("expected nitro enclaves to be enabled, got %v", input.EnclaveOptions)
+						}
+					}).
+					Return(&ec2.Reservation{
+						Instances: []*ec2.Instance{
+							{
+								State: &ec2.InstanceState{
+									Name: aws.String(ec2.InstanceStateNamePending),
+								},
+								IamInstanceProfile: &ec2.IamInstanceProfile{
+									Arn: aws.String("arn:aws:iam::123456789012:instance-profile/foo"),
+								},
+								InstanceId:     aws.String("two"),
+								InstanceType:   aws.String("m6i.xlarge"),
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("ami-1"),
+								RootDeviceName: aws.String("device-1"),
+								BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+									{
+										DeviceName: aws.String("device-1"),
+										Ebs: &ec2.EbsInstanceBlockDevice{
+											VolumeId: aws.String("volume-1"),
+										},
+									},
+								},
+								Placement: &ec2.Placement{
+									AvailabilityZone: &az,
+								},
+								EnclaveOptions: &ec2.EnclaveOptions{
+									Enabled: aws.Bool(true),
+								},
+							},
+						},
+					}, nil)
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if !instance.NitroEnclavesEnabled {
+					t.Fatalf("expected instance to be enabled for nitro enclaves")
+				}
+			},
+		},
+		{
+			name: "with nitro enclaves on an instance type without enclave support",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:         "t3a.xlarge",
+				NitroEnclavesEnabled: true,
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("t3a.xlarge")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType:         aws.String("t3a.xlarge"),
+								NitroEnclavesSupport: aws.String(ec2.NitroEnclavesSupportUnsupported),
+							},
+						},
+					}, nil).AnyTimes()
+				m.RunInstances(gomock.Any()).Times(0)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for an instance type without nitro enclave support")
+				}
+			},
+		},
+		{
+			name: "with ena express on an instance type without ena express support",
+			machine: clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"set": "node"},
+				},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: pointer.StringPtr("bootstrap-data"),
+					},
+				},
+			},
+			machineConfig: &infrav1.AWSMachineSpec{
+				AMI: infrav1.AWSResourceReference{
+					ID: aws.String("abc"),
+				},
+				InstanceType:       "c5n.large",
+				ENAExpressSettings: &infrav1.ENAExpressSpec{Enabled: true},
+			},
+			awsCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							&infrav1.SubnetSpec{
+								ID:       "subnet-1",
+								IsPublic: false,
+							},
+							&infrav1.SubnetSpec{
+								IsPublic: false,
+							},
+						},
+					},
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.Network{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {
+								ID: "1",
+							},
+							infrav1.SecurityGroupNode: {
+								ID: "2",
+							},
+							infrav1.SecurityGroupLB: {
+								ID: "3",
+							},
+						},
+						APIServerELB: infrav1.ClassicELB{
+							DNSName: "test-apiserver.us-east-1.aws",
+						},
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []*string{aws.String("c5n.large")},
+				})).
+					Return(&ec2.DescribeInstanceTypesOutput{
+						InstanceTypes: []*ec2.InstanceTypeInfo{
+							{
+								InstanceType: aws.String("c5n.large"),
+								NetworkInfo: &ec2.NetworkInfo{
+									EnaSupport:      aws.String(ec2.EnaSupportRequired),
+									EnaSrdSupported: aws.Bool(false),
+								},
+							},
+						},
+					}, nil).AnyTimes()
+				m.RunInstances(gomock.Any()).Times(0)
+			},
+			check: func(instance *infrav1.Instance, err error) {
+				if err == nil {
+					t.Fatalf("expected an error for an instance type without ena express support")
+				}
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -949,6 +3809,15 @@ func TestCreateInstance(t *testing.T) {
 			}
 			machineScope.AWSMachine.Spec = *tc.machineConfig
 			tc.expect(ec2Mock.EXPECT())
+			// Subnets have enough available IP addresses unless the test case expects otherwise.
+			ec2Mock.EXPECT().DescribeSubnets(gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+				DoAndReturn(func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+					out := &ec2.DescribeSubnetsOutput{}
+					for _, id := range input.SubnetIds {
+						out.Subnets = append(out.Subnets, &ec2.Subnet{SubnetId: id, AvailableIpAddressCount: aws.Int64(250)})
+					}
+					return out, nil
+				}).AnyTimes()
 
 			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
 				Client: client,
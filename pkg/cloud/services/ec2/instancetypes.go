@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+)
+
+// defaultInstanceTypeCacheTTL is how long described instance types are cached for.
+const defaultInstanceTypeCacheTTL = time.Hour
+
+// instanceTypes is shared by all services, as a service only lives for a single reconcile.
+var instanceTypes = NewInstanceTypeCache(defaultInstanceTypeCacheTTL)
+
+// InstanceTypeCache caches the results of DescribeInstanceTypes by instance type.
+// It is safe for concurrent use.
+type InstanceTypeCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	cache sync.Map
+}
+
+type instanceTypeCacheEntry struct {
+	expires time.Time
+	info    ec2.InstanceTypeInfo
+}
+
+// NewInstanceTypeCache returns a new cache in which entries expire after the given duration.
+func NewInstanceTypeCache(ttl time.Duration) *InstanceTypeCache {
+	return &InstanceTypeCache{
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// Get returns the description of the instance type, calling DescribeInstanceTypes
+// with the given client if it isn't cached or its cache entry has expired.
+func (c *InstanceTypeCache) Get(client ec2iface.EC2API, instanceType string) (*ec2.InstanceTypeInfo, error) {
+	if v, ok := c.cache.Load(instanceType); ok {
+		entry := v.(instanceTypeCacheEntry)
+		if c.now().Before(entry.expires) {
+			info := entry.info
+			return &info, nil
+		}
+	}
+
+	out, err := client.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []*string{aws.String(instanceType)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if len(out.InstanceTypes) == 0 || out.InstanceTypes[0] == nil {
+		return nil, awserrors.NewNotFound(errors.Errorf("instance type %q not found", instanceType))
+	}
+
+	info := *out.InstanceTypes[0]
+	c.cache.Store(instanceType, instanceTypeCacheEntry{
+		expires: c.now().Add(c.ttl),
+		info:    info,
+	})
+
+	return &info, nil
+}
+
+// validateNitroEnclaves checks that the instance type supports Nitro Enclaves.
+func (s *Service) validateNitroEnclaves(instanceType string) error {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return err
+	}
+	if aws.StringValue(info.NitroEnclavesSupport) != ec2.NitroEnclavesSupportSupported {
+		return errors.Errorf("instance type %q does not support Nitro Enclaves", instanceType)
+	}
+	return nil
+}
+
+// validateENAExpress checks that the instance type supports ENA Express.
+func (s *Service) validateENAExpress(instanceType string) error {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return err
+	}
+	if info.NetworkInfo == nil || aws.StringValue(info.NetworkInfo.EnaSupport) == ec2.EnaSupportUnsupported || !aws.BoolValue(info.NetworkInfo.EnaSrdSupported) {
+		return errors.Errorf("instance type %q does not support ENA Express", instanceType)
+	}
+	return nil
+}
+
+// ebsOptimization returns whether instances of the given type should be
+// EBS-optimized, defaulting to the instance type's support when requested is
+// nil, along with the baseline EBS bandwidth of optimized instances.
+func (s *Service) ebsOptimization(instanceType string, requested *bool) (*bool, *int64, error) {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	optimized := requested
+	if info.EbsInfo == nil {
+		return optimized, nil, nil
+	}
+
+	if optimized == nil {
+		switch aws.StringValue(info.EbsInfo.EbsOptimizedSupport) {
+		case ec2.EbsOptimizedSupportSupported, ec2.EbsOptimizedSupportDefault:
+			optimized = aws.Bool(true)
+		}
+	}
+
+	if !aws.BoolValue(optimized) || info.EbsInfo.EbsOptimizedInfo == nil {
+		return optimized, nil, nil
+	}
+	return optimized, info.EbsInfo.EbsOptimizedInfo.BaselineBandwidthInMbps, nil
+}
+
+// validateCPUOptions checks that the core count and threads per core are
+// supported by the instance type.
+func (s *Service) validateCPUOptions(instanceType string, opts *infrav1.CPUOptionsSpec) error {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return err
+	}
+	if info.VCpuInfo == nil {
+		return nil
+	}
+
+	if cores := info.VCpuInfo.ValidCores; len(cores) > 0 && !containsInt64(cores, opts.CoreCount) {
+		return errors.Errorf("instance type %q does not support %d CPU cores, valid core counts are %v", instanceType, opts.CoreCount, aws.Int64ValueSlice(cores))
+	}
+	if threads := info.VCpuInfo.ValidThreadsPerCore; len(threads) > 0 && !containsInt64(threads, opts.ThreadsPerCore) {
+		return errors.Errorf("instance type %q does not support %d threads per core, valid values are %v", instanceType, opts.ThreadsPerCore, aws.Int64ValueSlice(threads))
+	}
+	return nil
+}
+
+// validateEphemeralStorage checks that the instance type has an instance store
+// volume for each of the given mappings.
+func (s *Service) validateEphemeralStorage(instanceType string, storage []infrav1.EphemeralStorageSpec) error {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return err
+	}
+	if !aws.BoolValue(info.InstanceStorageSupported) || info.InstanceStorageInfo == nil {
+		return errors.Errorf("instance type %q does not have instance store volumes", instanceType)
+	}
+
+	var volumes int64
+	for _, disk := range info.InstanceStorageInfo.Disks {
+		volumes += aws.Int64Value(disk.Count)
+	}
+	for _, st := range storage {
+		var index int64
+		if _, err := fmt.Sscanf(st.VirtualName, "ephemeral%d", &index); err != nil || index >= volumes {
+			return errors.Errorf("instance type %q has %d instance store volumes, %q is not one of them", instanceType, volumes, st.VirtualName)
+		}
+	}
+	return nil
+}
+
+func containsInt64(list []*int64, v int64) bool {
+	for _, i := range list {
+		if aws.Int64Value(i) == v {
+			return true
+		}
+	}
+	return false
+}
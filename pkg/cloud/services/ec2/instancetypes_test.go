@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+)
+
+func describeInstanceTypeMemory(input *ec2.DescribeInstanceTypesInput) (*ec2.DescribeInstanceTypesOutput, error) {
+	return &ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			{
+				InstanceType: input.InstanceTypes[0],
+				MemoryInfo:   &ec2.MemoryInfo{SizeInMiB: aws.Int64(int64(len(aws.StringValue(input.InstanceTypes[0]))))},
+			},
+		},
+	}, nil
+}
+
+func TestInstanceTypeCache(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeInstanceTypes(gomock.AssignableToTypeOf(&ec2.DescribeInstanceTypesInput{})).
+		DoAndReturn(describeInstanceTypeMemory).Times(2)
+
+	now := time.Now()
+	cache := NewInstanceTypeCache(time.Hour)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		info, err := cache.Get(ec2Mock, "m5.large")
+		if err != nil {
+			t.Fatalf("did not expect error: %v", err)
+		}
+		if aws.StringValue(info.InstanceType) != "m5.large" {
+			t.Fatalf("expected m5.large, got %q", aws.StringValue(info.InstanceType))
+		}
+	}
+
+	// Expired entries are described again.
+	now = now.Add(2 * time.Hour)
+	if _, err := cache.Get(ec2Mock, "m5.large"); err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+}
+
+func TestInstanceTypeCacheNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeInstanceTypes(gomock.AssignableToTypeOf(&ec2.DescribeInstanceTypesInput{})).
+		Return(&ec2.DescribeInstanceTypesOutput{}, nil)
+
+	cache := NewInstanceTypeCache(time.Hour)
+	if _, err := cache.Get(ec2Mock, "unknown.large"); err == nil {
+		t.Fatalf("expected an error for an unknown instance type")
+	}
+}
+
+func TestInstanceTypeCacheConcurrentAccess(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeInstanceTypes(gomock.AssignableToTypeOf(&ec2.DescribeInstanceTypesInput{})).
+		DoAndReturn(describeInstanceTypeMemory).AnyTimes()
+
+	cache := NewInstanceTypeCache(time.Hour)
+	instanceTypes := []string{"t3.micro", "m5.large", "c5.2xlarge", "r5.4xlarge"}
+
+	t.Run("group", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			instanceType := instanceTypes[i%len(instanceTypes)]
+			t.Run(fmt.Sprintf("%s-%d", instanceType, i), func(t *testing.T) {
+				t.Parallel()
+				info, err := cache.Get(ec2Mock, instanceType)
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if aws.StringValue(info.InstanceType) != instanceType {
+					t.Fatalf("expected %q, got %q", instanceType, aws.StringValue(info.InstanceType))
+				}
+				if aws.Int64Value(info.MemoryInfo.SizeInMiB) != int64(len(instanceType)) {
+					t.Fatalf("unexpected memory for %q: %d", instanceType, aws.Int64Value(info.MemoryInfo.SizeInMiB))
+				}
+			})
+		}
+	})
+
+	for _, instanceType := range instanceTypes {
+		if _, ok := cache.cache.Load(instanceType); !ok {
+			t.Fatalf("expected %q to be cached", instanceType)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// launchSnapshotName returns the name of the ConfigMap, or the key of the S3 object, holding the launch
+// snapshot of the machine.
+func launchSnapshotName(scope *scope.MachineScope) string {
+	if name := scope.AWSMachine.Spec.LaunchSnapshot.Name; name != "" {
+		return name
+	}
+	return fmt.Sprintf("%s-launch-snapshot", scope.Name())
+}
+
+// saveLaunchSnapshot stores the input the instance of the machine was launched with, minus its user data
+// which holds the bootstrap secrets of the machine.
+func (s *Service) saveLaunchSnapshot(scope *scope.MachineScope, input *ec2.RunInstancesInput) error {
+	snapshot := *input
+	snapshot.UserData = nil
+	data, err := json.Marshal(&snapshot)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal launch snapshot")
+	}
+
+	name := launchSnapshotName(scope)
+	spec := scope.AWSMachine.Spec.LaunchSnapshot
+	switch spec.StorageType {
+	case infrav1.LaunchSnapshotStorageS3:
+		if _, err := s.scope.S3.PutObject(&s3.PutObjectInput{
+			Bucket:               aws.String(spec.Bucket),
+			Key:                  aws.String(name),
+			Body:                 bytes.NewReader(data),
+			ContentType:          aws.String("application/json"),
+			ServerSideEncryption: aws.String(s3.ServerSideEncryptionAes256),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to put launch snapshot %q in S3 bucket %q", name, spec.Bucket)
+		}
+	default:
+		if err := scope.StoreLaunchSnapshotConfigMap(name, data); err != nil {
+			return err
+		}
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulSaveLaunchSnapshot", "Saved launch snapshot %q", name)
+	return nil
+}
+
+// loadLaunchSnapshot returns the input stored in the launch snapshot of the machine.
+func (s *Service) loadLaunchSnapshot(scope *scope.MachineScope) (*ec2.RunInstancesInput, error) {
+	name := launchSnapshotName(scope)
+	spec := scope.AWSMachine.Spec.LaunchSnapshot
+
+	var data []byte
+	switch spec.StorageType {
+	case infrav1.LaunchSnapshotStorageS3:
+		out, err := s.scope.S3.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(spec.Bucket),
+			Key:    aws.String(name),
+		})
+		if code, _ := awserrors.Code(err); code == s3.ErrCodeNoSuchKey {
+			break
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "failed to get launch snapshot %q from S3 bucket %q", name, spec.Bucket)
+		}
+		defer out.Body.Close()
+		if data, err = ioutil.ReadAll(out.Body); err != nil {
+			return nil, errors.Wrapf(err, "failed to read launch snapshot %q from S3 bucket %q", name, spec.Bucket)
+		}
+	default:
+		var err error
+		if data, err = scope.GetLaunchSnapshotConfigMap(name); err != nil {
+			return nil, err
+		}
+	}
+	if data == nil {
+		return nil, errors.Errorf("launch snapshot %q not found", name)
+	}
+
+	input := &ec2.RunInstancesInput{}
+	if err := json.Unmarshal(data, input); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal launch snapshot %q", name)
+	}
+	return input, nil
+}
+
+// restoreInstance runs the instance of a machine from its launch snapshot. Only the tags of the instance,
+// which identify its machine, and its user data are set from the machine.
+func (s *Service) restoreInstance(scope *scope.MachineScope, tags infrav1.Tags, userData []byte) (*infrav1.Instance, error) {
+	input, err := s.loadLaunchSnapshot(scope)
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedCreate", "Failed to restore instance from launch snapshot: %v", err)
+		return nil, err
+	}
+
+	if !scope.UserDataIsUncompressed() {
+		if userData, err = userdata.GzipBytes(userData); err != nil {
+			return nil, errors.New("failed to gzip userdata")
+		}
+	}
+	input.UserData = aws.String(base64.StdEncoding.EncodeToString(userData))
+
+	tagSpecifications := make([]*ec2.TagSpecification, 0, len(input.TagSpecifications)+1)
+	for _, spec := range input.TagSpecifications {
+		if aws.StringValue(spec.ResourceType) != ec2.ResourceTypeInstance {
+			tagSpecifications = append(tagSpecifications, spec)
+		}
+	}
+	input.TagSpecifications = append(tagSpecifications, instanceTagSpecification(tags))
+
+	s.scope.V(2).Info("Restoring instance from launch snapshot", "machine-role", scope.Role(), "snapshot", launchSnapshotName(scope))
+	out, err := s.launchInstance(input)
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedCreate", "Failed to restore instance from launch snapshot: %v", err)
+		return nil, err
+	}
+	if input.SubnetId != nil {
+		scope.AWSMachine.Status.SubnetID = aws.String(*input.SubnetId)
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulCreate", "Restored %s instance with id %q from launch snapshot %q", scope.Role(), out.ID, launchSnapshotName(scope))
+	return out, nil
+}
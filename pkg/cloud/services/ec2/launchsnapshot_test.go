@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3/mock_s3iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newLaunchSnapshotTestScopes(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, s3Mock *mock_s3iface.MockS3API, spec infrav1.AWSMachineSpec) (*scope.ClusterScope, *scope.MachineScope, client.Client) {
+	awsCluster := &infrav1.AWSCluster{}
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    cluster,
+		AWSCluster: awsCluster,
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+			S3:  s3Mock,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:     c,
+		Cluster:    cluster,
+		Machine:    &clusterv1.Machine{},
+		AWSCluster: awsCluster,
+		AWSMachine: &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "default"},
+			Spec:       spec,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	return clusterScope, machineScope, c
+}
+
+func launchSnapshotInput() *ec2.RunInstancesInput {
+	return &ec2.RunInstancesInput{
+		ImageId:      aws.String("ami-1"),
+		InstanceType: aws.String("m5.large"),
+		SubnetId:     aws.String("subnet-1"),
+		MinCount:     aws.Int64(1),
+		MaxCount:     aws.Int64(1),
+		UserData:     aws.String("c2VjcmV0"),
+		TagSpecifications: []*ec2.TagSpecification{
+			instanceTagSpecification(infrav1.Tags{"Name": "machine-0"}),
+			{ResourceType: aws.String(ec2.ResourceTypeVolume), Tags: []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("a")}}},
+		},
+	}
+}
+
+func TestSaveLaunchSnapshotToS3(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+	s3Mock.EXPECT().PutObject(gomock.AssignableToTypeOf(&s3.PutObjectInput{})).
+		DoAndReturn(func(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+			if aws.StringValue(input.Bucket) != "snapshots" || aws.StringValue(input.Key) != "machine-1-launch-snapshot" {
+				t.Fatalf("unexpected object %q of bucket %q", aws.StringValue(input.Key), aws.StringValue(input.Bucket))
+			}
+			body, err := ioutil.ReadAll(input.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			snapshot := &ec2.RunInstancesInput{}
+			if err := json.Unmarshal(body, snapshot); err != nil {
+				t.Fatal(err)
+			}
+			if snapshot.UserData != nil || aws.StringValue(snapshot.ImageId) != "ami-1" {
+				t.Fatalf("expected the snapshot of the input without user data, got %v", snapshot)
+			}
+			return &s3.PutObjectOutput{}, nil
+		})
+
+	clusterScope, machineScope, _ := newLaunchSnapshotTestScopes(t, nil, s3Mock, infrav1.AWSMachineSpec{
+		LaunchSnapshot: &infrav1.LaunchSnapshotSpec{StorageType: infrav1.LaunchSnapshotStorageS3, Bucket: "snapshots"},
+	})
+	input := launchSnapshotInput()
+	if err := NewService(clusterScope).saveLaunchSnapshot(machineScope, input); err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	if input.UserData == nil {
+		t.Fatal("expected the user data of the input to be left alone")
+	}
+}
+
+func TestRestoreInstanceFromConfigMap(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	spec := infrav1.AWSMachineSpec{
+		LaunchSnapshot:       &infrav1.LaunchSnapshotSpec{StorageType: infrav1.LaunchSnapshotStorageConfigMap, Name: "workers"},
+		RestoreFromSnapshot:  true,
+		UncompressedUserData: aws.Bool(true),
+	}
+	clusterScope, machineScope, c := newLaunchSnapshotTestScopes(t, ec2Mock, nil, spec)
+	s := NewService(clusterScope)
+
+	if err := s.saveLaunchSnapshot(machineScope, launchSnapshotInput()); err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "workers"}, configMap); err != nil {
+		t.Fatalf("expected the snapshot ConfigMap: %v", err)
+	}
+	if strings.Contains(configMap.Data["runInstancesInput.json"], "c2VjcmV0") {
+		t.Fatal("expected the snapshot not to hold the user data")
+	}
+
+	ec2Mock.EXPECT().RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+		DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+			if aws.StringValue(input.ImageId) != "ami-1" || aws.StringValue(input.SubnetId) != "subnet-1" {
+				t.Fatalf("expected the input of the snapshot, got %v", input)
+			}
+			if aws.StringValue(input.UserData) != "dXNlckRhdGE=" {
+				t.Fatalf("expected the user data of the machine, got %q", aws.StringValue(input.UserData))
+			}
+			if len(input.TagSpecifications) != 2 || aws.StringValue(input.TagSpecifications[1].Tags[0].Value) != "machine-1" {
+				t.Fatalf("expected the tags of the machine along with the volume tags of the snapshot, got %v", input.TagSpecifications)
+			}
+			return &ec2.Reservation{Instances: []*ec2.Instance{{
+				InstanceId: aws.String("i-1"),
+				State:      &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNamePending)},
+				Placement:  &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+			}}}, nil
+		})
+	ec2Mock.EXPECT().WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	instance, err := s.restoreInstance(machineScope, infrav1.Tags{"Name": "machine-1"}, []byte("userData"))
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	if instance.ID != "i-1" || aws.StringValue(machineScope.AWSMachine.Status.SubnetID) != "subnet-1" {
+		t.Fatalf("expected instance i-1 in subnet-1, got %q in %v", instance.ID, machineScope.AWSMachine.Status.SubnetID)
+	}
+}
+
+func TestRestoreInstanceWithoutSnapshot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+	s3Mock.EXPECT().GetObject(gomock.Eq(&s3.GetObjectInput{
+		Bucket: aws.String("snapshots"),
+		Key:    aws.String("machine-1-launch-snapshot"),
+	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil))
+
+	clusterScope, machineScope, _ := newLaunchSnapshotTestScopes(t, nil, s3Mock, infrav1.AWSMachineSpec{
+		LaunchSnapshot:      &infrav1.LaunchSnapshotSpec{StorageType: infrav1.LaunchSnapshotStorageS3, Bucket: "snapshots"},
+		RestoreFromSnapshot: true,
+	})
+	if _, err := NewService(clusterScope).restoreInstance(machineScope, nil, []byte("userData")); err == nil {
+		t.Fatal("expected an error")
+	}
+}
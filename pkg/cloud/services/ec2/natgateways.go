@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
@@ -67,6 +68,7 @@ func (s *Service) reconcileNatGateways() error {
 		return err
 	}
 
+	var missing []*infrav1.SubnetSpec
 	for _, sn := range s.scope.Subnets().FilterPublic() {
 		if sn.ID == "" {
 			continue
@@ -90,6 +92,10 @@ func (s *Service) reconcileNatGateways() error {
 			continue
 		}
 
+		missing = append(missing, sn)
+	}
+
+	if len(missing) > 0 {
 		// set NatGatewayCreationStarted if the condition has never been set before
 		if !conditions.Has(s.scope.AWSCluster, infrav1.NatGatewaysReadyCondition) {
 			conditions.MarkFalse(s.scope.AWSCluster, infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysCreationStartedReason, clusterv1.ConditionSeverityInfo, "")
@@ -98,12 +104,33 @@ func (s *Service) reconcileNatGateways() error {
 			}
 		}
 
-		ng, err := s.createNatGateway(sn.ID)
-		if err != nil {
-			return err
+		// Addresses are handed out before the NAT gateways are created, so that concurrent
+		// creations don't pick the same unassociated address. Pre-allocated ones go first.
+		ips := s.takePreallocatedAddresses(len(missing))
+		if len(ips) < len(missing) {
+			allocated, err := s.getOrAllocateAddresses(len(missing)-len(ips), infrav1.APIServerRoleTagValue)
+			if err != nil {
+				return errors.Wrap(err, "failed to create IP addresses for NAT gateways")
+			}
+			ips = append(ips, allocated...)
 		}
 
-		sn.NatGatewayID = ng.NatGatewayId
+		// NAT gateways take minutes to become available, so they are created concurrently.
+		var g errgroup.Group
+		for i := range missing {
+			sn, ip := missing[i], ips[i]
+			g.Go(func() error {
+				ng, err := s.createNatGateway(sn.ID, ip)
+				if err != nil {
+					return err
+				}
+				sn.NatGatewayID = ng.NatGatewayId
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
 	}
 	conditions.MarkTrue(s.scope.AWSCluster, infrav1.NatGatewaysReadyCondition)
 	return nil
@@ -182,14 +209,10 @@ func (s *Service) getNatGatewayTagParams(id string) infrav1.BuildParams {
 	}
 }
 
-func (s *Service) createNatGateway(subnetID string) (*ec2.NatGateway, error) {
-	ip, err := s.getOrAllocateAddress(infrav1.APIServerRoleTagValue)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create IP address for NAT gateway for subnet ID %q", subnetID)
-	}
-
+func (s *Service) createNatGateway(subnetID, ip string) (*ec2.NatGateway, error) {
 	var out *ec2.CreateNatGatewayOutput
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		var err error
 		if out, err = s.scope.EC2.CreateNatGateway(&ec2.CreateNatGatewayInput{
 			SubnetId:          aws.String(subnetID),
 			AllocationId:      aws.String(ip),
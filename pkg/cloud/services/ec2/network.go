@@ -27,6 +27,10 @@ import (
 func (s *Service) ReconcileNetwork() (err error) {
 	s.scope.V(2).Info("Reconciling network for cluster", "cluster-name", s.scope.Cluster.Name, "cluster-namespace", s.scope.Cluster.Namespace)
 
+	// Report what the reconciliation changed, even when it fails part way.
+	desired := s.networkStatus()
+	defer s.logNetworkDiff(desired)
+
 	// VPC.
 	if err := s.reconcileVPC(); err != nil {
 		conditions.MarkFalse(s.scope.AWSCluster, infrav1.VpcReadyCondition, infrav1.VpcReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
@@ -34,35 +38,33 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	conditions.MarkTrue(s.scope.AWSCluster, infrav1.VpcReadyCondition)
 
-	// Subnets.
-	if err := s.reconcileSubnets(); err != nil {
-		conditions.MarkFalse(s.scope.AWSCluster, infrav1.SubnetsReadyCondition, infrav1.SubnetsReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+	// Subnets, Internet Gateways, NAT Gateways and routing tables.
+	if err := NewParallelNetworkReconciler(s).Reconcile(); err != nil {
 		return err
 	}
 
-	// Internet Gateways.
-	if err := s.reconcileInternetGateways(); err != nil {
-		conditions.MarkFalse(s.scope.AWSCluster, infrav1.InternetGatewayReadyCondition, infrav1.InternetGatewayFailedReason, clusterv1.ConditionSeverityError, err.Error())
+	// Security groups.
+	if err := s.reconcileSecurityGroups(); err != nil {
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.ClusterSecurityGroupsReadyCondition, infrav1.ClusterSecurityGroupReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return err
 	}
 
-	// NAT Gateways.
-	if err := s.reconcileNatGateways(); err != nil {
-		conditions.MarkFalse(s.scope.AWSCluster, infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+	// EC2 Instance Connect Endpoint.
+	if err := s.reconcileInstanceConnectEndpoint(); err != nil {
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.InstanceConnectEndpointReadyCondition, infrav1.InstanceConnectEndpointReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return err
 	}
 
-	// Routing tables.
-	if err := s.reconcileRouteTables(); err != nil {
-		conditions.MarkFalse(s.scope.AWSCluster, infrav1.RouteTablesReadyCondition, infrav1.RouteTableReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+	// VPC endpoint policies.
+	if err := s.reconcileVPCEndpointPolicies(); err != nil {
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.VPCEndpointPoliciesReadyCondition, infrav1.VPCEndpointPolicyReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return err
 	}
 
-	// Security groups.
-	if err := s.reconcileSecurityGroups(); err != nil {
-		conditions.MarkFalse(s.scope.AWSCluster, infrav1.ClusterSecurityGroupsReadyCondition, infrav1.ClusterSecurityGroupReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
-		return err
-	}
+	// The reconciliation records the IDs of the resources it created in the network spec, report them as
+	// the provisioned topology of the cluster.
+	s.scope.AWSCluster.Status.Network.VPC = s.scope.VPC().DeepCopy()
+	s.scope.AWSCluster.Status.Network.Subnets = s.scope.Subnets().DeepCopy()
 
 	s.scope.V(2).Info("Reconcile network completed successfully")
 	return nil
@@ -72,6 +74,11 @@ func (s *Service) ReconcileNetwork() (err error) {
 func (s *Service) DeleteNetwork() (err error) {
 	s.scope.V(2).Info("Deleting network")
 
+	// Pre-allocated EIPs, which don't depend on the VPC.
+	if err := NewEIPPoolReconciler(s).Delete(); err != nil {
+		return err
+	}
+
 	// Search for a previously created and tagged VPC
 	vpc, err := s.describeVPC()
 	if err != nil {
@@ -83,6 +90,11 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	vpc.DeepCopyInto(s.scope.VPC())
 
+	// EC2 Instance Connect Endpoint.
+	if err := s.deleteInstanceConnectEndpoint(); err != nil {
+		return err
+	}
+
 	// Security groups.
 	if err := s.deleteSecurityGroups(); err != nil {
 		return err
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"golang.org/x/sync/errgroup"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ParallelNetworkReconciler reconciles the network resources of a cluster which depend on its VPC,
+// running the steps which don't depend on each other concurrently: the internet gateways, the subnets
+// and the Elastic IP pool once the VPC is ready, then the NAT gateways, which need the subnets, and finally the
+// route tables, which need all of them.
+type ParallelNetworkReconciler struct {
+	service *Service
+}
+
+// NewParallelNetworkReconciler returns a new ParallelNetworkReconciler for the given service.
+func NewParallelNetworkReconciler(service *Service) *ParallelNetworkReconciler {
+	return &ParallelNetworkReconciler{
+		service: service,
+	}
+}
+
+// Reconcile reconciles the internet gateways, subnets, Elastic IP pool, NAT gateways and route tables
+// of the VPC of the cluster, which must be ready. Every step is idempotent, so a reconciliation failing part way
+// is resumed by the next one. Concurrent steps all run to completion, and the first error is returned.
+func (r *ParallelNetworkReconciler) Reconcile() error {
+	s := r.service
+
+	var g errgroup.Group
+	g.Go(func() error {
+		if err := s.reconcileInternetGateways(); err != nil {
+			s.markConditionFalse(infrav1.InternetGatewayReadyCondition, infrav1.InternetGatewayFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		if err := s.reconcileSubnets(); err != nil {
+			s.markConditionFalse(infrav1.SubnetsReadyCondition, infrav1.SubnetsReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		if err := NewEIPPoolReconciler(s).Reconcile(); err != nil {
+			s.markConditionFalse(infrav1.EIPPoolReadyCondition, infrav1.EIPPoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+			return err
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// NAT gateways are created in the public subnets, concurrently, using the Elastic IPs of the pool first.
+	if err := s.reconcileNatGateways(); err != nil {
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+
+	// Route tables route to the internet gateways and the NAT gateways, and are associated with the subnets.
+	if err := s.reconcileRouteTables(); err != nil {
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.RouteTablesReadyCondition, infrav1.RouteTableReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// markConditionTrue marks the given condition of the AWSCluster true, from steps which may run concurrently.
+func (s *Service) markConditionTrue(t clusterv1.ConditionType) {
+	s.conditionsLock.Lock()
+	defer s.conditionsLock.Unlock()
+	conditions.MarkTrue(s.scope.AWSCluster, t)
+}
+
+// markConditionFalse marks the given condition of the AWSCluster false, from steps which may run concurrently.
+func (s *Service) markConditionFalse(t clusterv1.ConditionType, reason string, severity clusterv1.ConditionSeverity, message string) {
+	s.conditionsLock.Lock()
+	defer s.conditionsLock.Unlock()
+	conditions.MarkFalse(s.scope.AWSCluster, t, reason, severity, "%s", message)
+}
+
+// deleteCondition deletes the given condition of the AWSCluster, from steps which may run concurrently.
+func (s *Service) deleteCondition(t clusterv1.ConditionType) {
+	s.conditionsLock.Lock()
+	defer s.conditionsLock.Unlock()
+	conditions.Delete(s.scope.AWSCluster, t)
+}
+
+// isConditionTrue returns true if the given condition of the AWSCluster is true, from steps which may run concurrently.
+func (s *Service) isConditionTrue(t clusterv1.ConditionType) bool {
+	s.conditionsLock.Lock()
+	defer s.conditionsLock.Unlock()
+	return conditions.IsTrue(s.scope.AWSCluster, t)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestParallelNetworkReconcilerRunsInternetGatewaysAndSubnetsConcurrently(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	// Each step counts itself as started, then waits for the other one to start before finishing.
+	// Run one after the other, the first step would time out waiting.
+	var started sync.WaitGroup
+	started.Add(2)
+	bothStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(bothStarted)
+	}()
+	var timedOut int32
+	step := func() error {
+		started.Done()
+		select {
+		case <-bothStarted:
+		case <-time.After(5 * time.Second):
+			atomic.StoreInt32(&timedOut, 1)
+		}
+		return errors.New("RequestLimitExceeded")
+	}
+
+	ec2Mock.EXPECT().DescribeInternetGateways(gomock.AssignableToTypeOf(&ec2.DescribeInternetGatewaysInput{})).
+		DoAndReturn(func(_ *ec2.DescribeInternetGatewaysInput) (*ec2.DescribeInternetGatewaysOutput, error) {
+			return nil, step()
+		})
+	ec2Mock.EXPECT().DescribeSubnets(gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+		DoAndReturn(func(_ *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+			return nil, step()
+		})
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						ID: "vpc-parallel",
+						Tags: infrav1.Tags{
+							infrav1.ClusterTagKey("test-cluster"): "owned",
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	// The NAT gateways and route tables are not reconciled, as they need the failed steps.
+	if err := NewParallelNetworkReconciler(NewService(clusterScope)).Reconcile(); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	if atomic.LoadInt32(&timedOut) == 1 {
+		t.Fatal("expected internet gateways and subnets to be reconciled concurrently")
+	}
+	for _, condition := range []clusterv1.ConditionType{infrav1.InternetGatewayReadyCondition, infrav1.SubnetsReadyCondition} {
+		if !conditions.IsFalse(clusterScope.AWSCluster, condition) {
+			t.Fatalf("expected condition %s to be false", condition)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+const (
+	// maxReconcileReasonDiffs is the number of differences listed in the ReconcileReasonAnnotation.
+	maxReconcileReasonDiffs = 5
+
+	resourceTypeVPC           = "vpc"
+	resourceTypeSubnet        = "subnet"
+	resourceTypeSecurityGroup = "security-group"
+)
+
+// NetworkStatus is the state of the network resources of a cluster.
+type NetworkStatus struct {
+	VPC            infrav1.VPCSpec
+	Subnets        infrav1.Subnets
+	SecurityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+}
+
+// ResourceDiff is a difference between the desired and the actual state of a network resource.
+// The Field of resources which were added or removed is empty, their ID being the value.
+type ResourceDiff struct {
+	ResourceType string
+	ID           string
+	Field        string
+	WantValue    string
+	GotValue     string
+}
+
+// String returns a string representation of the difference.
+func (d ResourceDiff) String() string {
+	switch {
+	case d.Field == "" && d.WantValue == "":
+		return fmt.Sprintf("%s %s added", d.ResourceType, d.ID)
+	case d.Field == "":
+		return fmt.Sprintf("%s %s removed", d.ResourceType, d.ID)
+	default:
+		return fmt.Sprintf("%s %s %s: %q -> %q", d.ResourceType, d.ID, d.Field, d.WantValue, d.GotValue)
+	}
+}
+
+// networkStatus returns a copy of the current state of the network of the cluster.
+func (s *Service) networkStatus() NetworkStatus {
+	network := s.scope.AWSCluster.Spec.NetworkSpec.DeepCopy()
+	status := NetworkStatus{
+		VPC:            network.VPC,
+		Subnets:        network.Subnets,
+		SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{},
+	}
+	for role, sg := range s.scope.SecurityGroups() {
+		status.SecurityGroups[role] = *sg.DeepCopy()
+	}
+	return status
+}
+
+// logNetworkDiff logs the differences between the network of the cluster before and after it was reconciled,
+// and summarizes them in the ReconcileReasonAnnotation of the AWSCluster.
+func (s *Service) logNetworkDiff(desired NetworkStatus) {
+	diffs := diffCloudResources(desired, s.networkStatus())
+	if len(diffs) == 0 {
+		return
+	}
+
+	summary := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		summary = append(summary, d.String())
+		s.scope.V(4).Info("Network resource changed", "resource-type", d.ResourceType, "id", d.ID, "field", d.Field, "want", d.WantValue, "got", d.GotValue)
+	}
+
+	if len(summary) > maxReconcileReasonDiffs {
+		summary = append(summary[:maxReconcileReasonDiffs], fmt.Sprintf("and %d more", len(summary)-maxReconcileReasonDiffs))
+	}
+	if s.scope.AWSCluster.Annotations == nil {
+		s.scope.AWSCluster.Annotations = map[string]string{}
+	}
+	s.scope.AWSCluster.Annotations[infrav1.ReconcileReasonAnnotation] = strings.Join(summary, "; ")
+}
+
+// diffCloudResources returns the differences between the desired and the actual state of the network.
+// Subnets are matched by ID, or by CIDR block for subnets which didn't exist yet.
+func diffCloudResources(desired, actual NetworkStatus) []ResourceDiff {
+	var diffs []ResourceDiff
+
+	vpcID := actual.VPC.ID
+	if vpcID == "" {
+		vpcID = desired.VPC.ID
+	}
+	diffs = appendFieldDiffs(diffs, resourceTypeVPC, vpcID, vpcFields(&desired.VPC), vpcFields(&actual.VPC))
+
+	matched := map[*infrav1.SubnetSpec]bool{}
+	for _, want := range desired.Subnets {
+		got := findSubnet(actual.Subnets, want)
+		if got == nil {
+			diffs = append(diffs, ResourceDiff{ResourceType: resourceTypeSubnet, ID: subnetKey(want), WantValue: subnetKey(want)})
+			continue
+		}
+		matched[got] = true
+		diffs = appendFieldDiffs(diffs, resourceTypeSubnet, subnetKey(got), subnetFields(want), subnetFields(got))
+	}
+	for _, got := range actual.Subnets {
+		if !matched[got] {
+			diffs = append(diffs, ResourceDiff{ResourceType: resourceTypeSubnet, ID: subnetKey(got), GotValue: subnetKey(got)})
+		}
+	}
+
+	roles := map[infrav1.SecurityGroupRole]bool{}
+	for role := range desired.SecurityGroups {
+		roles[role] = true
+	}
+	for role := range actual.SecurityGroups {
+		roles[role] = true
+	}
+	sortedRoles := make([]string, 0, len(roles))
+	for role := range roles {
+		sortedRoles = append(sortedRoles, string(role))
+	}
+	sort.Strings(sortedRoles)
+	for _, role := range sortedRoles {
+		want, wantOK := desired.SecurityGroups[infrav1.SecurityGroupRole(role)]
+		got, gotOK := actual.SecurityGroups[infrav1.SecurityGroupRole(role)]
+		switch {
+		case !gotOK:
+			diffs = append(diffs, ResourceDiff{ResourceType: resourceTypeSecurityGroup, ID: role, WantValue: want.ID})
+		case !wantOK:
+			diffs = append(diffs, ResourceDiff{ResourceType: resourceTypeSecurityGroup, ID: role, GotValue: got.ID})
+		case want.ID != got.ID:
+			diffs = append(diffs, ResourceDiff{ResourceType: resourceTypeSecurityGroup, ID: role, Field: "id", WantValue: want.ID, GotValue: got.ID})
+		}
+	}
+
+	return diffs
+}
+
+// field is the name and value of a field of a resource, in the order they are compared.
+type field struct {
+	name  string
+	value string
+}
+
+func appendFieldDiffs(diffs []ResourceDiff, resourceType, id string, want, got []field) []ResourceDiff {
+	for i := range want {
+		if want[i].value != got[i].value {
+			diffs = append(diffs, ResourceDiff{
+				ResourceType: resourceType,
+				ID:           id,
+				Field:        want[i].name,
+				WantValue:    want[i].value,
+				GotValue:     got[i].value,
+			})
+		}
+	}
+	return diffs
+}
+
+func vpcFields(vpc *infrav1.VPCSpec) []field {
+	return []field{
+		{name: "id", value: vpc.ID},
+		{name: "cidrBlock", value: vpc.CidrBlock},
+		{name: "internetGatewayId", value: aws.StringValue(vpc.InternetGatewayID)},
+	}
+}
+
+func subnetFields(subnet *infrav1.SubnetSpec) []field {
+	return []field{
+		{name: "id", value: subnet.ID},
+		{name: "cidrBlock", value: subnet.CidrBlock},
+		{name: "availabilityZone", value: subnet.AvailabilityZone},
+		{name: "isPublic", value: strconv.FormatBool(subnet.IsPublic)},
+		{name: "routeTableId", value: aws.StringValue(subnet.RouteTableID)},
+		{name: "natGatewayId", value: aws.StringValue(subnet.NatGatewayID)},
+	}
+}
+
+func findSubnet(subnets infrav1.Subnets, subnet *infrav1.SubnetSpec) *infrav1.SubnetSpec {
+	for _, sn := range subnets {
+		if subnet.ID != "" && sn.ID == subnet.ID {
+			return sn
+		}
+		if subnet.ID == "" && subnet.CidrBlock != "" && sn.CidrBlock == subnet.CidrBlock {
+			return sn
+		}
+	}
+	return nil
+}
+
+// subnetKey identifies a subnet by its ID, or by its CIDR block until it is created.
+func subnetKey(subnet *infrav1.SubnetSpec) string {
+	if subnet.ID != "" {
+		return subnet.ID
+	}
+	return subnet.CidrBlock
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+func TestDiffCloudResources(t *testing.T) {
+	testCases := []struct {
+		name     string
+		desired  NetworkStatus
+		actual   NetworkStatus
+		expected []ResourceDiff
+	}{
+		{
+			name: "no changes",
+			desired: NetworkStatus{
+				VPC:     infrav1.VPCSpec{ID: "vpc-1", CidrBlock: "10.0.0.0/16"},
+				Subnets: infrav1.Subnets{{ID: "subnet-1", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"}},
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-1"},
+				},
+			},
+			actual: NetworkStatus{
+				VPC:     infrav1.VPCSpec{ID: "vpc-1", CidrBlock: "10.0.0.0/16"},
+				Subnets: infrav1.Subnets{{ID: "subnet-1", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"}},
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-1"},
+				},
+			},
+		},
+		{
+			name: "added resources",
+			desired: NetworkStatus{
+				VPC:     infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"},
+				Subnets: infrav1.Subnets{{CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"}},
+			},
+			actual: NetworkStatus{
+				VPC: infrav1.VPCSpec{ID: "vpc-1", CidrBlock: "10.0.0.0/16", InternetGatewayID: aws.String("igw-1")},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-1", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a"},
+					{ID: "subnet-2", CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1a"},
+				},
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-1"},
+				},
+			},
+			expected: []ResourceDiff{
+				{ResourceType: "vpc", ID: "vpc-1", Field: "id", WantValue: "", GotValue: "vpc-1"},
+				{ResourceType: "vpc", ID: "vpc-1", Field: "internetGatewayId", WantValue: "", GotValue: "igw-1"},
+				{ResourceType: "subnet", ID: "subnet-1", Field: "id", WantValue: "", GotValue: "subnet-1"},
+				{ResourceType: "subnet", ID: "subnet-2", GotValue: "subnet-2"},
+				{ResourceType: "security-group", ID: "node", GotValue: "sg-1"},
+			},
+		},
+		{
+			name: "removed resources",
+			desired: NetworkStatus{
+				VPC: infrav1.VPCSpec{ID: "vpc-1"},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-1", CidrBlock: "10.0.0.0/24"},
+					{ID: "subnet-2", CidrBlock: "10.0.1.0/24"},
+				},
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupBastion: {ID: "sg-1"},
+				},
+			},
+			actual: NetworkStatus{
+				VPC:     infrav1.VPCSpec{ID: "vpc-1"},
+				Subnets: infrav1.Subnets{{ID: "subnet-1", CidrBlock: "10.0.0.0/24"}},
+			},
+			expected: []ResourceDiff{
+				{ResourceType: "subnet", ID: "subnet-2", WantValue: "subnet-2"},
+				{ResourceType: "security-group", ID: "bastion", WantValue: "sg-1"},
+			},
+		},
+		{
+			name: "changed resources",
+			desired: NetworkStatus{
+				VPC: infrav1.VPCSpec{ID: "vpc-1", InternetGatewayID: aws.String("igw-1")},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-1", IsPublic: true, RouteTableID: aws.String("rtb-1"), NatGatewayID: aws.String("nat-1")},
+				},
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-1"},
+				},
+			},
+			actual: NetworkStatus{
+				VPC: infrav1.VPCSpec{ID: "vpc-1", InternetGatewayID: aws.String("igw-2")},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-1", IsPublic: true, RouteTableID: aws.String("rtb-2"), NatGatewayID: aws.String("nat-1")},
+				},
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-2"},
+				},
+			},
+			expected: []ResourceDiff{
+				{ResourceType: "vpc", ID: "vpc-1", Field: "internetGatewayId", WantValue: "igw-1", GotValue: "igw-2"},
+				{ResourceType: "subnet", ID: "subnet-1", Field: "routeTableId", WantValue: "rtb-1", GotValue: "rtb-2"},
+				{ResourceType: "security-group", ID: "node", Field: "id", WantValue: "sg-1", GotValue: "sg-2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := diffCloudResources(tc.desired, tc.actual); !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceDiffString(t *testing.T) {
+	testCases := []struct {
+		diff     ResourceDiff
+		expected string
+	}{
+		{
+			diff:     ResourceDiff{ResourceType: "subnet", ID: "subnet-1", GotValue: "subnet-1"},
+			expected: "subnet subnet-1 added",
+		},
+		{
+			diff:     ResourceDiff{ResourceType: "subnet", ID: "subnet-1", WantValue: "subnet-1"},
+			expected: "subnet subnet-1 removed",
+		},
+		{
+			diff:     ResourceDiff{ResourceType: "subnet", ID: "subnet-1", Field: "routeTableId", GotValue: "rtb-1"},
+			expected: `subnet subnet-1 routeTableId: "" -> "rtb-1"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			if got := tc.diff.String(); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
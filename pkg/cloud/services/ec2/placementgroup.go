@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	placementGroupNotFound      = "InvalidPlacementGroup.Unknown"
+	placementGroupAlreadyExists = "InvalidPlacementGroup.Duplicate"
+)
+
+// PlacementGroupReconciler manages the cluster placement groups shared by the
+// AWSMachines of a cluster with the same placement group affinity key.
+type PlacementGroupReconciler struct {
+	scope *scope.ClusterScope
+}
+
+// NewPlacementGroupReconciler returns a new PlacementGroupReconciler for the given scope.
+func NewPlacementGroupReconciler(scope *scope.ClusterScope) *PlacementGroupReconciler {
+	return &PlacementGroupReconciler{
+		scope: scope,
+	}
+}
+
+// Reconcile returns the name of the placement group of the machine, creating
+// it if it doesn't exist yet. It returns an empty name for machines without a
+// placement group affinity key.
+func (r *PlacementGroupReconciler) Reconcile(machineScope *scope.MachineScope) (string, error) {
+	key := machineScope.AWSMachine.Spec.PlacementGroupAffinityKey
+	if key == "" {
+		return "", nil
+	}
+	name := r.placementGroupName(key)
+
+	exists, err := r.exists(name)
+	if err != nil || exists {
+		return name, err
+	}
+
+	_, err = r.scope.EC2.CreatePlacementGroup(&ec2.CreatePlacementGroupInput{
+		GroupName: aws.String(name),
+		Strategy:  aws.String(ec2.PlacementStrategyCluster),
+		TagSpecifications: []*ec2.TagSpecification{
+			tagSpecification(ec2.ResourceTypePlacementGroup, infrav1.Build(infrav1.BuildParams{
+				ClusterName: r.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(name),
+				Additional:  r.scope.AdditionalTags(),
+			})),
+		},
+	})
+	// Another machine with the same key may have created the placement group concurrently.
+	if code, _ := awserrors.Code(err); code == placementGroupAlreadyExists {
+		return name, nil
+	}
+	if err != nil {
+		record.Warnf(machineScope.AWSMachine, "FailedCreatePlacementGroup", "Failed to create placement group %q: %v", name, err)
+		return "", errors.Wrapf(err, "failed to create placement group %q", name)
+	}
+
+	record.Eventf(machineScope.AWSMachine, "SuccessfulCreatePlacementGroup", "Created placement group %q", name)
+	return name, nil
+}
+
+// Delete deletes the placement group of a deleted machine once no other
+// AWSMachine of the cluster references it. AWSMachines being deleted too are
+// not counted, the instances still running in the placement group keep it from
+// being deleted until they are terminated.
+func (r *PlacementGroupReconciler) Delete(machineScope *scope.MachineScope) error {
+	key := machineScope.AWSMachine.Spec.PlacementGroupAffinityKey
+	if key == "" {
+		return nil
+	}
+	name := r.placementGroupName(key)
+
+	machines, err := r.scope.ListAWSMachines(context.TODO())
+	if err != nil {
+		return err
+	}
+	for _, m := range machines {
+		if m.UID != machineScope.AWSMachine.UID && m.DeletionTimestamp.IsZero() && m.Spec.PlacementGroupAffinityKey == key {
+			machineScope.V(2).Info("Placement group is still used by another machine", "placement-group", name, "machine", m.Name)
+			return nil
+		}
+	}
+
+	_, err = r.scope.EC2.DeletePlacementGroup(&ec2.DeletePlacementGroupInput{
+		GroupName: aws.String(name),
+	})
+	if code, _ := awserrors.Code(err); code == placementGroupNotFound {
+		return nil
+	}
+	if err != nil {
+		record.Warnf(machineScope.AWSMachine, "FailedDeletePlacementGroup", "Failed to delete placement group %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete placement group %q", name)
+	}
+
+	record.Eventf(machineScope.AWSMachine, "SuccessfulDeletePlacementGroup", "Deleted placement group %q", name)
+	return nil
+}
+
+// DeletePlacementGroup deletes the placement group of a deleted machine once no other machine of the
+// cluster references it.
+func (s *Service) DeletePlacementGroup(scope *scope.MachineScope) error {
+	return NewPlacementGroupReconciler(s.scope).Delete(scope)
+}
+
+func (r *PlacementGroupReconciler) exists(name string) (bool, error) {
+	out, err := r.scope.EC2.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{
+		GroupNames: aws.StringSlice([]string{name}),
+	})
+	if code, _ := awserrors.Code(err); code == placementGroupNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to describe placement group %q", name)
+	}
+	for _, group := range out.PlacementGroups {
+		if aws.StringValue(group.State) != ec2.PlacementGroupStateDeleting && aws.StringValue(group.State) != ec2.PlacementGroupStateDeleted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// placementGroupName returns the name of the placement group of the machines of the cluster with the given key.
+func (r *PlacementGroupReconciler) placementGroupName(key string) string {
+	return fmt.Sprintf("%s-%s", r.scope.Name(), key)
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newPlacementGroupTestMachine(name, key string) *infrav1.AWSMachine {
+	return &infrav1.AWSMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name),
+			Labels:    map[string]string{clusterv1.ClusterLabelName: "test-cluster"},
+		},
+		Spec: infrav1.AWSMachineSpec{PlacementGroupAffinityKey: key},
+	}
+}
+
+func newPlacementGroupTestScopes(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, machine *infrav1.AWSMachine, others ...*infrav1.AWSMachine) (*scope.ClusterScope, *scope.MachineScope) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	objects := []runtime.Object{machine}
+	for _, m := range others {
+		objects = append(objects, m)
+	}
+	client := fake.NewFakeClientWithScheme(scheme, objects...)
+
+	awsCluster := &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:     client,
+		Cluster:    cluster,
+		AWSCluster: awsCluster,
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:     client,
+		Cluster:    cluster,
+		Machine:    &clusterv1.Machine{},
+		AWSCluster: awsCluster,
+		AWSMachine: machine,
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	return clusterScope, machineScope
+}
+
+func TestPlacementGroupReconcile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		key      string
+		expect   func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name: "no affinity key",
+		},
+		{
+			name: "creates the placement group",
+			key:  "db",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Eq(&ec2.DescribePlacementGroupsInput{GroupNames: aws.StringSlice([]string{"test-cluster-db"})})).
+					Return(nil, awserr.New(placementGroupNotFound, "unknown", nil))
+				m.CreatePlacementGroup(gomock.AssignableToTypeOf(&ec2.CreatePlacementGroupInput{})).
+					DoAndReturn(func(input *ec2.CreatePlacementGroupInput) (*ec2.CreatePlacementGroupOutput, error) {
+						if aws.StringValue(input.GroupName) != "test-cluster-db" || aws.StringValue(input.Strategy) != ec2.PlacementStrategyCluster {
+							t.Fatalf("expected cluster placement group test-cluster-db, got %v", input)
+						}
+						if len(input.TagSpecifications) != 1 || aws.StringValue(input.TagSpecifications[0].ResourceType) != ec2.ResourceTypePlacementGroup {
+							t.Fatalf("expected the placement group to be tagged, got %v", input.TagSpecifications)
+						}
+						return &ec2.CreatePlacementGroupOutput{}, nil
+					})
+			},
+			wantName: "test-cluster-db",
+		},
+		{
+			name: "shares the placement group of another machine",
+			key:  "db",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Any()).Return(&ec2.DescribePlacementGroupsOutput{
+					PlacementGroups: []*ec2.PlacementGroup{{GroupName: aws.String("test-cluster-db"), State: aws.String(ec2.PlacementGroupStateAvailable)}},
+				}, nil)
+			},
+			wantName: "test-cluster-db",
+		},
+		{
+			name: "placement group created concurrently",
+			key:  "db",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Any()).Return(&ec2.DescribePlacementGroupsOutput{}, nil)
+				m.CreatePlacementGroup(gomock.Any()).Return(nil, awserr.New(placementGroupAlreadyExists, "duplicate", nil))
+			},
+			wantName: "test-cluster-db",
+		},
+		{
+			name: "create fails",
+			key:  "db",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribePlacementGroups(gomock.Any()).Return(&ec2.DescribePlacementGroupsOutput{}, nil)
+				m.CreatePlacementGroup(gomock.Any()).Return(nil, errors.New("limit exceeded"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, machineScope := newPlacementGroupTestScopes(t, ec2Mock, newPlacementGroupTestMachine("machine-1", tc.key))
+			name, err := NewPlacementGroupReconciler(clusterScope).Reconcile(machineScope)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if name != tc.wantName {
+				t.Fatalf("expected placement group %q, got %q", tc.wantName, name)
+			}
+		})
+	}
+}
+
+func TestPlacementGroupDelete(t *testing.T) {
+	deleting := newPlacementGroupTestMachine("machine-2", "db")
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	testCases := []struct {
+		name    string
+		others  []*infrav1.AWSMachine
+		expect  func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "deletes the placement group of the last machine",
+			others: []*infrav1.AWSMachine{
+				newPlacementGroupTestMachine("machine-2", "web"),
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeletePlacementGroup(gomock.Eq(&ec2.DeletePlacementGroupInput{GroupName: aws.String("test-cluster-db")})).
+					Return(&ec2.DeletePlacementGroupOutput{}, nil)
+			},
+		},
+		{
+			name: "keeps the placement group used by another machine",
+			others: []*infrav1.AWSMachine{
+				newPlacementGroupTestMachine("machine-2", "db"),
+			},
+		},
+		{
+			name:   "machines being deleted do not keep the placement group",
+			others: []*infrav1.AWSMachine{deleting},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeletePlacementGroup(gomock.Any()).Return(&ec2.DeletePlacementGroupOutput{}, nil)
+			},
+		},
+		{
+			name: "placement group already deleted",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeletePlacementGroup(gomock.Any()).Return(nil, awserr.New(placementGroupNotFound, "unknown", nil))
+			},
+		},
+		{
+			name: "placement group still in use by terminating instances",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeletePlacementGroup(gomock.Any()).Return(nil, awserr.New("InvalidPlacementGroup.InUse", "in use", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, machineScope := newPlacementGroupTestScopes(t, ec2Mock, newPlacementGroupTestMachine("machine-1", "db"), tc.others...)
+			err := NewPlacementGroupReconciler(clusterScope).Delete(machineScope)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+// IPv4 prefixes assigned by prefix delegation are /28 prefixes, each holding 16 addresses.
+const addressesPerIPv4Prefix = 16
+
+// AssignIPv4Prefixes assigns as many IPv4 prefixes to the primary network interface of the instance as its
+// free address slots allow, and returns the prefixes of the interface. The prefixes of an interface which
+// already has some, e.g. assigned by a previous reconcile, are returned without assigning others.
+func (s *Service) AssignIPv4Prefixes(instanceID, instanceType string) ([]string, error) {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(info.Hypervisor) != ec2.InstanceTypeHypervisorNitro || info.NetworkInfo == nil {
+		return nil, errors.Errorf("instance type %q does not support prefix delegation, which requires the Nitro System", instanceType)
+	}
+
+	enis, err := s.getInstanceENIs(instanceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get ENIs for instance %q", instanceID)
+	}
+	var primary *ec2.NetworkInterface
+	for _, eni := range enis {
+		if eni.Attachment != nil && aws.Int64Value(eni.Attachment.DeviceIndex) == 0 {
+			primary = eni
+			break
+		}
+	}
+	if primary == nil {
+		return nil, errors.Errorf("instance %q has no primary network interface", instanceID)
+	}
+	eniID := aws.StringValue(primary.NetworkInterfaceId)
+
+	if len(primary.Ipv4Prefixes) > 0 {
+		prefixes := make([]string, 0, len(primary.Ipv4Prefixes))
+		for _, prefix := range primary.Ipv4Prefixes {
+			prefixes = append(prefixes, aws.StringValue(prefix.Ipv4Prefix))
+		}
+		return prefixes, nil
+	}
+
+	// Each prefix takes the slot of a secondary IP address of the interface.
+	count := aws.Int64Value(info.NetworkInfo.Ipv4AddressesPerInterface) - int64(len(primary.PrivateIpAddresses))
+	if count <= 0 {
+		return nil, errors.Errorf("network interface %q of instance %q has no free address slot for IPv4 prefixes", eniID, instanceID)
+	}
+
+	s.scope.V(2).Info("Attempting to assign IPv4 prefixes to network interface", "instance-id", instanceID, "network-interface-id", eniID, "count", count)
+	out, err := s.scope.EC2.AssignPrivateIpAddresses(&ec2.AssignPrivateIpAddressesInput{
+		NetworkInterfaceId: aws.String(eniID),
+		AllowReassignment:  aws.Bool(false),
+		Ipv4PrefixCount:    aws.Int64(count),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to assign IPv4 prefixes to network interface %q of instance %q", eniID, instanceID)
+	}
+
+	prefixes := make([]string, 0, len(out.AssignedIpv4Prefixes))
+	for _, prefix := range out.AssignedIpv4Prefixes {
+		prefixes = append(prefixes, aws.StringValue(prefix.Ipv4Prefix))
+	}
+	return prefixes, nil
+}
+
+// MaxPodsWithPrefixDelegation returns the maximum number of pods instances of the given type can run with the
+// IPv4 prefixes of the AWS VPC CNI, as computed by the max-pods calculator of Amazon EKS: every secondary
+// address slot of every network interface holds a prefix, plus the two host network pods of the CNI and
+// kube-proxy, up to 110 pods for instance types with less than 30 vCPUs and 250 pods otherwise.
+func (s *Service) MaxPodsWithPrefixDelegation(instanceType string) (int64, error) {
+	info, err := instanceTypes.Get(s.scope.EC2, instanceType)
+	if err != nil {
+		return 0, err
+	}
+	if info.NetworkInfo == nil {
+		return 0, errors.Errorf("instance type %q has no network information", instanceType)
+	}
+
+	interfaces := aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces)
+	addresses := aws.Int64Value(info.NetworkInfo.Ipv4AddressesPerInterface)
+	maxPods := interfaces*(addresses-1)*addressesPerIPv4Prefix + 2
+
+	limit := int64(110)
+	if info.VCpuInfo != nil && aws.Int64Value(info.VCpuInfo.DefaultVCpus) >= 30 {
+		limit = 250
+	}
+	if maxPods > limit {
+		maxPods = limit
+	}
+	return maxPods, nil
+}
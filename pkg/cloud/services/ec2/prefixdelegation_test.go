@@ -0,0 +1,208 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func newPrefixDelegationTestService(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API) *Service {
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+		AWSCluster: &infrav1.AWSCluster{},
+		AWSClients: scope.AWSClients{EC2: ec2Mock},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	return NewService(clusterScope)
+}
+
+func describePrefixInstanceType(m *mock_ec2iface.MockEC2APIMockRecorder, hypervisor string, vcpus, interfaces, addresses int64) {
+	m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{InstanceTypes: aws.StringSlice([]string{"m5.large"})})).
+		Return(&ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{{
+			InstanceType: aws.String("m5.large"),
+			Hypervisor:   aws.String(hypervisor),
+			VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vcpus)},
+			NetworkInfo: &ec2.NetworkInfo{
+				MaximumNetworkInterfaces:  aws.Int64(interfaces),
+				Ipv4AddressesPerInterface: aws.Int64(addresses),
+			},
+		}}}, nil)
+}
+
+func primaryNetworkInterface(prefixes ...string) *ec2.NetworkInterface {
+	eni := &ec2.NetworkInterface{
+		NetworkInterfaceId: aws.String("eni-primary"),
+		Attachment:         &ec2.NetworkInterfaceAttachment{DeviceIndex: aws.Int64(0)},
+		PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{{PrivateIpAddress: aws.String("10.0.1.10"), Primary: aws.Bool(true)}},
+	}
+	for _, prefix := range prefixes {
+		eni.Ipv4Prefixes = append(eni.Ipv4Prefixes, &ec2.Ipv4PrefixSpecification{Ipv4Prefix: aws.String(prefix)})
+	}
+	return eni
+}
+
+func TestAssignIPv4Prefixes(t *testing.T) {
+	describeENIs := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{{Name: aws.String("attachment.instance-id"), Values: aws.StringSlice([]string{"i-1"})}},
+	}
+	secondary := &ec2.NetworkInterface{
+		NetworkInterfaceId: aws.String("eni-secondary"),
+		Attachment:         &ec2.NetworkInterfaceAttachment{DeviceIndex: aws.Int64(1)},
+	}
+
+	testCases := []struct {
+		name         string
+		expect       func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantPrefixes []string
+		wantErr      bool
+	}{
+		{
+			name: "assigns a prefix to every free address slot of the primary interface",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describePrefixInstanceType(m, ec2.InstanceTypeHypervisorNitro, 2, 3, 10)
+				m.DescribeNetworkInterfaces(gomock.Eq(describeENIs)).
+					Return(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{secondary, primaryNetworkInterface()}}, nil)
+				m.AssignPrivateIpAddresses(gomock.Eq(&ec2.AssignPrivateIpAddressesInput{
+					NetworkInterfaceId: aws.String("eni-primary"),
+					AllowReassignment:  aws.Bool(false),
+					Ipv4PrefixCount:    aws.Int64(9),
+				})).Return(&ec2.AssignPrivateIpAddressesOutput{
+					NetworkInterfaceId: aws.String("eni-primary"),
+					AssignedIpv4Prefixes: []*ec2.Ipv4PrefixSpecification{
+						{Ipv4Prefix: aws.String("10.0.1.32/28")},
+						{Ipv4Prefix: aws.String("10.0.1.48/28")},
+					},
+				}, nil)
+			},
+			wantPrefixes: []string{"10.0.1.32/28", "10.0.1.48/28"},
+		},
+		{
+			name: "returns the prefixes already assigned to the primary interface",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describePrefixInstanceType(m, ec2.InstanceTypeHypervisorNitro, 2, 3, 10)
+				m.DescribeNetworkInterfaces(gomock.Eq(describeENIs)).
+					Return(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{primaryNetworkInterface("10.0.1.32/28")}}, nil)
+			},
+			wantPrefixes: []string{"10.0.1.32/28"},
+		},
+		{
+			name: "fails for instance types not built on the Nitro System",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describePrefixInstanceType(m, ec2.InstanceTypeHypervisorXen, 2, 3, 10)
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails without a primary interface",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describePrefixInstanceType(m, ec2.InstanceTypeHypervisorNitro, 2, 3, 10)
+				m.DescribeNetworkInterfaces(gomock.Eq(describeENIs)).
+					Return(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []*ec2.NetworkInterface{secondary}}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			cache := instanceTypes
+			defer func() { instanceTypes = cache }()
+			instanceTypes = NewInstanceTypeCache(time.Hour)
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			prefixes, err := newPrefixDelegationTestService(t, ec2Mock).AssignIPv4Prefixes("i-1", "m5.large")
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(prefixes, tc.wantPrefixes) {
+				t.Fatalf("expected prefixes %v, got %v", tc.wantPrefixes, prefixes)
+			}
+		})
+	}
+}
+
+func TestMaxPodsWithPrefixDelegation(t *testing.T) {
+	testCases := []struct {
+		name       string
+		vcpus      int64
+		interfaces int64
+		addresses  int64
+		want       int64
+	}{
+		{
+			name:       "fills every secondary address slot of every interface with a prefix",
+			vcpus:      2,
+			interfaces: 3,
+			addresses:  2,
+			want:       3*1*16 + 2,
+		},
+		{
+			name:       "limits instance types with less than 30 vCPUs to 110 pods",
+			vcpus:      2,
+			interfaces: 3,
+			addresses:  10,
+			want:       110,
+		},
+		{
+			name:       "limits larger instance types to 250 pods",
+			vcpus:      48,
+			interfaces: 15,
+			addresses:  50,
+			want:       250,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			cache := instanceTypes
+			defer func() { instanceTypes = cache }()
+			instanceTypes = NewInstanceTypeCache(time.Hour)
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			describePrefixInstanceType(ec2Mock.EXPECT(), ec2.InstanceTypeHypervisorNitro, tc.vcpus, tc.interfaces, tc.addresses)
+
+			maxPods, err := newPrefixDelegationTestService(t, ec2Mock).MaxPodsWithPrefixDelegation("m5.large")
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+			if maxPods != tc.want {
+				t.Fatalf("expected %d pods, got %d", tc.want, maxPods)
+			}
+		})
+	}
+}
@@ -0,0 +1,494 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// RemoteVPCReconciler provisions the VPC of a cluster's remote region and
+// connects it to the cluster's VPC through a transit gateway.
+type RemoteVPCReconciler struct {
+	scope *scope.ClusterScope
+
+	// remote is the EC2 client for the remote region. The EC2 client of the
+	// scope is used for the cluster's own region.
+	remote ec2iface.EC2API
+}
+
+// NewRemoteVPCReconciler returns a new RemoteVPCReconciler for the given scope.
+func NewRemoteVPCReconciler(scope *scope.ClusterScope) *RemoteVPCReconciler {
+	return &RemoteVPCReconciler{
+		scope:  scope,
+		remote: scope.RemoteEC2,
+	}
+}
+
+func (r *RemoteVPCReconciler) status() *infrav1.RemoteRegionStatus {
+	if r.scope.Network().RemoteRegion == nil {
+		r.scope.Network().RemoteRegion = &infrav1.RemoteRegionStatus{}
+	}
+	return r.scope.Network().RemoteRegion
+}
+
+// Reconcile creates the remote VPC, its subnets and route table, attaches it
+// to the transit gateway, and routes traffic between both VPCs.
+func (r *RemoteVPCReconciler) Reconcile() error {
+	spec := r.scope.RemoteRegion()
+	if spec == nil {
+		return nil
+	}
+
+	r.scope.V(2).Info("Reconciling remote region network", "region", spec.Region)
+
+	if err := r.reconcileVPC(spec); err != nil {
+		return err
+	}
+	if err := r.reconcileSubnets(spec); err != nil {
+		return err
+	}
+	if err := r.reconcileAttachment(spec); err != nil {
+		return err
+	}
+	if err := r.reconcileRemoteRouteTable(spec); err != nil {
+		return err
+	}
+	if err := r.reconcileLocalRoutes(spec); err != nil {
+		return err
+	}
+
+	r.scope.V(2).Info("Reconcile remote region network completed successfully", "region", spec.Region)
+	return nil
+}
+
+func (r *RemoteVPCReconciler) reconcileVPC(spec *infrav1.RemoteRegionSpec) error {
+	id, err := r.describeVPC()
+	if err != nil && !awserrors.IsNotFound(err) {
+		return err
+	}
+	if id != "" {
+		r.status().VPCID = id
+		return nil
+	}
+
+	out, err := r.remote.CreateVpc(&ec2.CreateVpcInput{
+		CidrBlock: aws.String(spec.VPCCidr),
+	})
+	if err != nil {
+		record.Warnf(r.scope.AWSCluster, "FailedCreateRemoteVPC", "Failed to create new managed VPC in region %q: %v", spec.Region, err)
+		return errors.Wrapf(err, "failed to create vpc in region %q", spec.Region)
+	}
+	id = aws.StringValue(out.Vpc.VpcId)
+
+	if err := r.remote.WaitUntilVpcAvailable(&ec2.DescribeVpcsInput{VpcIds: []*string{out.Vpc.VpcId}}); err != nil {
+		return errors.Wrapf(err, "failed to wait for vpc %q", id)
+	}
+	if err := r.tag(id, "vpc", infrav1.CommonRoleTagValue, awserrors.VPCNotFound); err != nil {
+		return err
+	}
+
+	record.Eventf(r.scope.AWSCluster, "SuccessfulCreateRemoteVPC", "Created new managed VPC %q in region %q", id, spec.Region)
+	r.status().VPCID = id
+	return nil
+}
+
+func (r *RemoteVPCReconciler) describeVPC() (string, error) {
+	input := &ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPCStates(ec2.VpcStatePending, ec2.VpcStateAvailable),
+		},
+	}
+	if id := r.status().VPCID; id != "" {
+		input.VpcIds = []*string{aws.String(id)}
+	} else {
+		input.Filters = append(input.Filters, filter.EC2.Cluster(r.scope.Name()))
+	}
+
+	out, err := r.remote.DescribeVpcs(input)
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.VPCNotFound {
+			return "", awserrors.NewNotFound(err)
+		}
+		return "", errors.Wrap(err, "failed to query ec2 for remote VPCs")
+	}
+
+	switch len(out.Vpcs) {
+	case 0:
+		return "", awserrors.NewNotFound(errors.New("could not find remote vpc"))
+	case 1:
+		return aws.StringValue(out.Vpcs[0].VpcId), nil
+	default:
+		return "", awserrors.NewConflict(errors.Errorf("found more than one remote vpc for cluster %q", r.scope.Name()))
+	}
+}
+
+func (r *RemoteVPCReconciler) reconcileSubnets(spec *infrav1.RemoteRegionSpec) error {
+	vpcID := r.status().VPCID
+
+	out, err := r.remote.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(vpcID),
+			filter.EC2.SubnetStates(ec2.SubnetStatePending, ec2.SubnetStateAvailable),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe subnets in remote vpc %q", vpcID)
+	}
+	existing := map[string]string{}
+	for _, sn := range out.Subnets {
+		existing[aws.StringValue(sn.CidrBlock)] = aws.StringValue(sn.SubnetId)
+	}
+
+	for _, sn := range spec.Subnets {
+		if id, ok := existing[sn.CidrBlock]; ok {
+			sn.ID = id
+			continue
+		}
+
+		created, err := r.remote.CreateSubnet(&ec2.CreateSubnetInput{
+			VpcId:            aws.String(vpcID),
+			CidrBlock:        aws.String(sn.CidrBlock),
+			AvailabilityZone: aws.String(sn.AvailabilityZone),
+		})
+		if err != nil {
+			record.Warnf(r.scope.AWSCluster, "FailedCreateRemoteSubnet", "Failed creating new managed Subnet in region %q: %v", spec.Region, err)
+			return errors.Wrapf(err, "failed to create subnet in remote vpc %q", vpcID)
+		}
+		sn.ID = aws.StringValue(created.Subnet.SubnetId)
+
+		if err := r.remote.WaitUntilSubnetAvailable(&ec2.DescribeSubnetsInput{SubnetIds: []*string{created.Subnet.SubnetId}}); err != nil {
+			return errors.Wrapf(err, "failed to wait for subnet %q", sn.ID)
+		}
+		if err := r.tag(sn.ID, fmt.Sprintf("subnet-private-%s", sn.AvailabilityZone), infrav1.PrivateRoleTagValue, awserrors.SubnetNotFound); err != nil {
+			return err
+		}
+		record.Eventf(r.scope.AWSCluster, "SuccessfulCreateRemoteSubnet", "Created new managed Subnet %q in region %q", sn.ID, spec.Region)
+	}
+
+	return nil
+}
+
+func (r *RemoteVPCReconciler) describeAttachment(spec *infrav1.RemoteRegionSpec) (*ec2.TransitGatewayVpcAttachment, error) {
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("transit-gateway-id"), Values: aws.StringSlice([]string{spec.TransitGatewayID})},
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{r.status().VPCID})},
+			{Name: aws.String("state"), Values: aws.StringSlice([]string{
+				ec2.TransitGatewayAttachmentStatePendingAcceptance,
+				ec2.TransitGatewayAttachmentStatePending,
+				ec2.TransitGatewayAttachmentStateAvailable,
+				ec2.TransitGatewayAttachmentStateModifying,
+				ec2.TransitGatewayAttachmentStateDeleting,
+			})},
+		},
+	}
+
+	out, err := r.remote.DescribeTransitGatewayVpcAttachments(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe transit gateway attachments for remote vpc %q", r.status().VPCID)
+	}
+	if len(out.TransitGatewayVpcAttachments) == 0 {
+		return nil, awserrors.NewNotFound(errors.Errorf("no transit gateway attachment found for remote vpc %q", r.status().VPCID))
+	}
+	return out.TransitGatewayVpcAttachments[0], nil
+}
+
+func (r *RemoteVPCReconciler) reconcileAttachment(spec *infrav1.RemoteRegionSpec) error {
+	attachment, err := r.describeAttachment(spec)
+	if err != nil && !awserrors.IsNotFound(err) {
+		return err
+	}
+
+	if attachment == nil {
+		// A VPC attachment uses one subnet per availability zone.
+		var subnetIDs []string
+		zones := map[string]bool{}
+		for _, sn := range spec.Subnets {
+			if !zones[sn.AvailabilityZone] {
+				zones[sn.AvailabilityZone] = true
+				subnetIDs = append(subnetIDs, sn.ID)
+			}
+		}
+		if len(subnetIDs) == 0 {
+			return errors.New("at least one remote subnet is required to attach the remote vpc to a transit gateway")
+		}
+
+		out, err := r.remote.CreateTransitGatewayVpcAttachment(&ec2.CreateTransitGatewayVpcAttachmentInput{
+			TransitGatewayId: aws.String(spec.TransitGatewayID),
+			VpcId:            aws.String(r.status().VPCID),
+			SubnetIds:        aws.StringSlice(subnetIDs),
+		})
+		if err != nil {
+			record.Warnf(r.scope.AWSCluster, "FailedCreateTransitGatewayAttachment", "Failed to attach remote VPC %q to transit gateway %q: %v", r.status().VPCID, spec.TransitGatewayID, err)
+			return errors.Wrapf(err, "failed to attach remote vpc %q to transit gateway %q", r.status().VPCID, spec.TransitGatewayID)
+		}
+		attachment = out.TransitGatewayVpcAttachment
+		if err := r.tag(aws.StringValue(attachment.TransitGatewayAttachmentId), "tgw-attachment", infrav1.CommonRoleTagValue, awserrors.TransitGatewayAttachmentNotFound); err != nil {
+			return err
+		}
+		record.Eventf(r.scope.AWSCluster, "SuccessfulCreateTransitGatewayAttachment", "Attached remote VPC %q to transit gateway %q", r.status().VPCID, spec.TransitGatewayID)
+	}
+
+	r.status().TransitGatewayAttachmentID = aws.StringValue(attachment.TransitGatewayAttachmentId)
+
+	if state := aws.StringValue(attachment.State); state != ec2.TransitGatewayAttachmentStateAvailable {
+		return awserrors.NewFailedDependency(errors.Errorf("transit gateway attachment %q is %s", r.status().TransitGatewayAttachmentID, state))
+	}
+	return nil
+}
+
+func (r *RemoteVPCReconciler) reconcileRemoteRouteTable(spec *infrav1.RemoteRegionSpec) error {
+	vpcID := r.status().VPCID
+
+	out, err := r.remote.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(vpcID),
+			filter.EC2.Cluster(r.scope.Name()),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe route tables in remote vpc %q", vpcID)
+	}
+
+	var rt *ec2.RouteTable
+	if len(out.RouteTables) > 0 {
+		rt = out.RouteTables[0]
+	} else {
+		created, err := r.remote.CreateRouteTable(&ec2.CreateRouteTableInput{VpcId: aws.String(vpcID)})
+		if err != nil {
+			record.Warnf(r.scope.AWSCluster, "FailedCreateRemoteRouteTable", "Failed to create managed RouteTable in region %q: %v", spec.Region, err)
+			return errors.Wrapf(err, "failed to create route table in remote vpc %q", vpcID)
+		}
+		rt = created.RouteTable
+		if err := r.tag(aws.StringValue(rt.RouteTableId), "rt-private", infrav1.PrivateRoleTagValue, awserrors.RouteTableNotFound); err != nil {
+			return err
+		}
+		record.Eventf(r.scope.AWSCluster, "SuccessfulCreateRemoteRouteTable", "Created managed RouteTable %q in region %q", aws.StringValue(rt.RouteTableId), spec.Region)
+	}
+	rtID := aws.StringValue(rt.RouteTableId)
+	r.status().RouteTableID = rtID
+
+	associated := map[string]bool{}
+	for _, assoc := range rt.Associations {
+		associated[aws.StringValue(assoc.SubnetId)] = true
+	}
+	for _, sn := range spec.Subnets {
+		if !associated[sn.ID] {
+			if _, err := r.remote.AssociateRouteTable(&ec2.AssociateRouteTableInput{
+				RouteTableId: aws.String(rtID),
+				SubnetId:     aws.String(sn.ID),
+			}); err != nil {
+				return errors.Wrapf(err, "failed to associate route table %q with subnet %q", rtID, sn.ID)
+			}
+		}
+		sn.RouteTableID = aws.String(rtID)
+	}
+
+	for _, route := range rt.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) == r.scope.VPC().CidrBlock &&
+			aws.StringValue(route.TransitGatewayId) == spec.TransitGatewayID {
+			return nil
+		}
+	}
+	if _, err := r.remote.CreateRoute(&ec2.CreateRouteInput{
+		RouteTableId:         aws.String(rtID),
+		DestinationCidrBlock: aws.String(r.scope.VPC().CidrBlock),
+		TransitGatewayId:     aws.String(spec.TransitGatewayID),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create route to %q in remote route table %q", r.scope.VPC().CidrBlock, rtID)
+	}
+	return nil
+}
+
+// localTransitGateway returns the transit gateway the cluster's own VPC is attached to.
+func (r *RemoteVPCReconciler) localTransitGateway() (string, error) {
+	out, err := r.scope.EC2.DescribeTransitGatewayVpcAttachments(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{r.scope.VPC().ID})},
+			{Name: aws.String("state"), Values: aws.StringSlice([]string{ec2.TransitGatewayAttachmentStateAvailable})},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe transit gateway attachments for vpc %q", r.scope.VPC().ID)
+	}
+	if len(out.TransitGatewayVpcAttachments) == 0 {
+		return "", awserrors.NewFailedDependency(errors.Errorf("vpc %q is not attached to a transit gateway", r.scope.VPC().ID))
+	}
+	return aws.StringValue(out.TransitGatewayVpcAttachments[0].TransitGatewayId), nil
+}
+
+// localRouteTables returns the IDs of the route tables of the cluster's own subnets.
+func (r *RemoteVPCReconciler) localRouteTables() []string {
+	var ids []string
+	seen := map[string]bool{}
+	for _, sn := range r.scope.Subnets() {
+		if id := aws.StringValue(sn.RouteTableID); id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (r *RemoteVPCReconciler) reconcileLocalRoutes(spec *infrav1.RemoteRegionSpec) error {
+	tgwID, err := r.localTransitGateway()
+	if err != nil {
+		return err
+	}
+
+	for _, rtID := range r.localRouteTables() {
+		if _, err := r.scope.EC2.CreateRoute(&ec2.CreateRouteInput{
+			RouteTableId:         aws.String(rtID),
+			DestinationCidrBlock: aws.String(spec.VPCCidr),
+			TransitGatewayId:     aws.String(tgwID),
+		}); err != nil {
+			if code, ok := awserrors.Code(err); ok && code == awserrors.RouteAlreadyExists {
+				continue
+			}
+			record.Warnf(r.scope.AWSCluster, "FailedCreateRoute", "Failed to create route to remote VPC on RouteTable %q: %v", rtID, err)
+			return errors.Wrapf(err, "failed to create route to %q in route table %q", spec.VPCCidr, rtID)
+		}
+	}
+	return nil
+}
+
+// Delete removes the routes to the remote VPC and deletes the transit gateway
+// attachment, route table, subnets and VPC in the remote region.
+func (r *RemoteVPCReconciler) Delete() error {
+	spec := r.scope.RemoteRegion()
+	if spec == nil {
+		return nil
+	}
+
+	r.scope.V(2).Info("Deleting remote region network", "region", spec.Region)
+
+	for _, rtID := range r.localRouteTables() {
+		if _, err := r.scope.EC2.DeleteRoute(&ec2.DeleteRouteInput{
+			RouteTableId:         aws.String(rtID),
+			DestinationCidrBlock: aws.String(spec.VPCCidr),
+		}); err != nil {
+			if code, ok := awserrors.Code(err); ok && (code == awserrors.RouteNotFound || code == awserrors.RouteTableNotFound) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to delete route to %q from route table %q", spec.VPCCidr, rtID)
+		}
+	}
+
+	id, err := r.describeVPC()
+	if awserrors.IsNotFound(err) {
+		r.scope.Network().RemoteRegion = nil
+		return nil
+	} else if err != nil {
+		return err
+	}
+	r.status().VPCID = id
+
+	attachment, err := r.describeAttachment(spec)
+	if err != nil && !awserrors.IsNotFound(err) {
+		return err
+	}
+	if attachment != nil {
+		attachmentID := aws.StringValue(attachment.TransitGatewayAttachmentId)
+		if aws.StringValue(attachment.State) != ec2.TransitGatewayAttachmentStateDeleting {
+			if _, err := r.remote.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+				TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+			}); err != nil {
+				record.Warnf(r.scope.AWSCluster, "FailedDeleteTransitGatewayAttachment", "Failed to delete transit gateway attachment %q: %v", attachmentID, err)
+				return errors.Wrapf(err, "failed to delete transit gateway attachment %q", attachmentID)
+			}
+			record.Eventf(r.scope.AWSCluster, "SuccessfulDeleteTransitGatewayAttachment", "Deleted transit gateway attachment %q", attachmentID)
+		}
+		// The attachment's network interfaces must be gone before the subnets can be deleted.
+		return awserrors.NewFailedDependency(errors.Errorf("waiting for transit gateway attachment %q to be deleted", attachmentID))
+	}
+
+	subnets, err := r.remote.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{filter.EC2.VPC(id)},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe subnets in remote vpc %q", id)
+	}
+	for _, sn := range subnets.Subnets {
+		if _, err := r.remote.DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: sn.SubnetId}); err != nil {
+			record.Warnf(r.scope.AWSCluster, "FailedDeleteRemoteSubnet", "Failed to delete managed Subnet %q: %v", aws.StringValue(sn.SubnetId), err)
+			return errors.Wrapf(err, "failed to delete subnet %q", aws.StringValue(sn.SubnetId))
+		}
+		record.Eventf(r.scope.AWSCluster, "SuccessfulDeleteRemoteSubnet", "Deleted managed Subnet %q", aws.StringValue(sn.SubnetId))
+	}
+
+	routeTables, err := r.remote.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{filter.EC2.VPC(id), filter.EC2.Cluster(r.scope.Name())},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe route tables in remote vpc %q", id)
+	}
+	for _, rt := range routeTables.RouteTables {
+		if _, err := r.remote.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: rt.RouteTableId}); err != nil {
+			if code, ok := awserrors.Code(err); ok && code == awserrors.RouteTableNotFound {
+				continue
+			}
+			return errors.Wrapf(err, "failed to delete route table %q", aws.StringValue(rt.RouteTableId))
+		}
+	}
+
+	if _, err := r.remote.DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String(id)}); err != nil {
+		if code, ok := awserrors.Code(err); !ok || code != awserrors.VPCNotFound {
+			record.Warnf(r.scope.AWSCluster, "FailedDeleteRemoteVPC", "Failed to delete managed VPC %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete vpc %q", id)
+		}
+	}
+	record.Eventf(r.scope.AWSCluster, "SuccessfulDeleteRemoteVPC", "Deleted managed VPC %q in region %q", id, spec.Region)
+
+	r.scope.Network().RemoteRegion = nil
+	return nil
+}
+
+func (r *RemoteVPCReconciler) tag(id, suffix, role string, notFoundCodes ...string) error {
+	params := infrav1.BuildParams{
+		ClusterName: r.scope.Name(),
+		ResourceID:  id,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-remote-%s", r.scope.Name(), suffix)),
+		Role:        aws.String(role),
+		Additional:  r.scope.AdditionalTags(),
+	}
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if err := tags.Apply(&tags.ApplyParams{
+			EC2Client:   r.remote,
+			BuildParams: params,
+		}); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, notFoundCodes...); err != nil {
+		record.Warnf(r.scope.AWSCluster, "FailedTagRemoteResource", "Failed to tag remote resource %q: %v", id, err)
+		return errors.Wrapf(err, "failed to tag %q", id)
+	}
+	return nil
+}
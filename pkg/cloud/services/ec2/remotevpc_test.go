@@ -0,0 +1,274 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newRemoteVPCTestScope(t *testing.T, local, remote *mock_ec2iface.MockEC2API, status *infrav1.RemoteRegionStatus) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:        "vpc-local",
+					CidrBlock: "10.0.0.0/16",
+				},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-local-private", AvailabilityZone: "us-east-1a", RouteTableID: aws.String("rtb-local-private")},
+					{ID: "subnet-local-public", AvailabilityZone: "us-east-1a", IsPublic: true, RouteTableID: aws.String("rtb-local-public")},
+				},
+				RemoteRegion: &infrav1.RemoteRegionSpec{
+					Region:           "us-west-2",
+					VPCCidr:          "10.1.0.0/16",
+					TransitGatewayID: "tgw-remote",
+					Subnets: infrav1.Subnets{
+						{CidrBlock: "10.1.0.0/24", AvailabilityZone: "us-west-2a"},
+						{CidrBlock: "10.1.1.0/24", AvailabilityZone: "us-west-2b"},
+					},
+				},
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.Network{RemoteRegion: status},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2:       local,
+			RemoteEC2: remote,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestRemoteVPCReconcile(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	local := mock_ec2iface.NewMockEC2API(mockCtrl)
+	remote := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope := newRemoteVPCTestScope(t, local, remote, nil)
+
+	remote.EXPECT().CreateTags(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	// VPC.
+	remote.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil)
+	remote.EXPECT().CreateVpc(&ec2.CreateVpcInput{CidrBlock: aws.String("10.1.0.0/16")}).
+		Return(&ec2.CreateVpcOutput{Vpc: &ec2.Vpc{VpcId: aws.String("vpc-remote"), CidrBlock: aws.String("10.1.0.0/16")}}, nil)
+	remote.EXPECT().WaitUntilVpcAvailable(gomock.Any()).Return(nil)
+
+	// Subnets: the first one already exists.
+	remote.EXPECT().DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-remote-a"), CidrBlock: aws.String("10.1.0.0/24")}},
+	}, nil)
+	remote.EXPECT().CreateSubnet(&ec2.CreateSubnetInput{
+		VpcId:            aws.String("vpc-remote"),
+		CidrBlock:        aws.String("10.1.1.0/24"),
+		AvailabilityZone: aws.String("us-west-2b"),
+	}).Return(&ec2.CreateSubnetOutput{Subnet: &ec2.Subnet{SubnetId: aws.String("subnet-remote-b")}}, nil)
+	remote.EXPECT().WaitUntilSubnetAvailable(gomock.Any()).Return(nil)
+
+	// Transit gateway attachment.
+	remote.EXPECT().DescribeTransitGatewayVpcAttachments(gomock.Any()).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{}, nil)
+	remote.EXPECT().CreateTransitGatewayVpcAttachment(&ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: aws.String("tgw-remote"),
+		VpcId:            aws.String("vpc-remote"),
+		SubnetIds:        aws.StringSlice([]string{"subnet-remote-a", "subnet-remote-b"}),
+	}).Return(&ec2.CreateTransitGatewayVpcAttachmentOutput{
+		TransitGatewayVpcAttachment: &ec2.TransitGatewayVpcAttachment{
+			TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+			State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+		},
+	}, nil)
+
+	r := NewRemoteVPCReconciler(clusterScope)
+	err := r.Reconcile()
+	if !awserrors.IsFailedDependency(err) {
+		t.Fatalf("expected a failed dependency error while the attachment is pending, got %v", err)
+	}
+
+	// Once the attachment is available, the route tables in both regions are updated.
+	remote.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{
+		Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-remote")}},
+	}, nil)
+	remote.EXPECT().DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-remote-a"), CidrBlock: aws.String("10.1.0.0/24")},
+			{SubnetId: aws.String("subnet-remote-b"), CidrBlock: aws.String("10.1.1.0/24")},
+		},
+	}, nil)
+	remote.EXPECT().DescribeTransitGatewayVpcAttachments(gomock.Any()).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+		TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{{
+			TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+			State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+		}},
+	}, nil)
+	remote.EXPECT().DescribeRouteTables(gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{}, nil)
+	remote.EXPECT().CreateRouteTable(&ec2.CreateRouteTableInput{VpcId: aws.String("vpc-remote")}).
+		Return(&ec2.CreateRouteTableOutput{RouteTable: &ec2.RouteTable{RouteTableId: aws.String("rtb-remote")}}, nil)
+	remote.EXPECT().AssociateRouteTable(&ec2.AssociateRouteTableInput{RouteTableId: aws.String("rtb-remote"), SubnetId: aws.String("subnet-remote-a")}).
+		Return(&ec2.AssociateRouteTableOutput{}, nil)
+	remote.EXPECT().AssociateRouteTable(&ec2.AssociateRouteTableInput{RouteTableId: aws.String("rtb-remote"), SubnetId: aws.String("subnet-remote-b")}).
+		Return(&ec2.AssociateRouteTableOutput{}, nil)
+	remote.EXPECT().CreateRoute(&ec2.CreateRouteInput{
+		RouteTableId:         aws.String("rtb-remote"),
+		DestinationCidrBlock: aws.String("10.0.0.0/16"),
+		TransitGatewayId:     aws.String("tgw-remote"),
+	}).Return(&ec2.CreateRouteOutput{}, nil)
+
+	local.EXPECT().DescribeTransitGatewayVpcAttachments(gomock.Any()).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+		TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{{TransitGatewayId: aws.String("tgw-local")}},
+	}, nil)
+	local.EXPECT().CreateRoute(&ec2.CreateRouteInput{
+		RouteTableId:         aws.String("rtb-local-private"),
+		DestinationCidrBlock: aws.String("10.1.0.0/16"),
+		TransitGatewayId:     aws.String("tgw-local"),
+	}).Return(nil, awserr.New(awserrors.RouteAlreadyExists, "route already exists", nil))
+	local.EXPECT().CreateRoute(&ec2.CreateRouteInput{
+		RouteTableId:         aws.String("rtb-local-public"),
+		DestinationCidrBlock: aws.String("10.1.0.0/16"),
+		TransitGatewayId:     aws.String("tgw-local"),
+	}).Return(&ec2.CreateRouteOutput{}, nil)
+
+	if err := r.Reconcile(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	status := clusterScope.Network().RemoteRegion
+	if status.VPCID != "vpc-remote" || status.TransitGatewayAttachmentID != "tgw-attach-1" || status.RouteTableID != "rtb-remote" {
+		t.Fatalf("unexpected remote region status: %+v", status)
+	}
+	for _, sn := range clusterScope.RemoteRegion().Subnets {
+		if sn.ID == "" || aws.StringValue(sn.RouteTableID) != "rtb-remote" {
+			t.Fatalf("expected remote subnet %q to be recorded with its route table, got %+v", sn.CidrBlock, sn)
+		}
+	}
+}
+
+func TestRemoteVPCDelete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	local := mock_ec2iface.NewMockEC2API(mockCtrl)
+	remote := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope := newRemoteVPCTestScope(t, local, remote, &infrav1.RemoteRegionStatus{
+		VPCID:                      "vpc-remote",
+		RouteTableID:               "rtb-remote",
+		TransitGatewayAttachmentID: "tgw-attach-1",
+	})
+
+	local.EXPECT().DeleteRoute(&ec2.DeleteRouteInput{
+		RouteTableId:         aws.String("rtb-local-private"),
+		DestinationCidrBlock: aws.String("10.1.0.0/16"),
+	}).Return(&ec2.DeleteRouteOutput{}, nil).Times(2)
+	local.EXPECT().DeleteRoute(&ec2.DeleteRouteInput{
+		RouteTableId:         aws.String("rtb-local-public"),
+		DestinationCidrBlock: aws.String("10.1.0.0/16"),
+	}).Return(&ec2.DeleteRouteOutput{}, nil).Times(2)
+	remote.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{
+		Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-remote")}},
+	}, nil).Times(2)
+
+	// The attachment is deleted first, and the rest of the network once it's gone.
+	remote.EXPECT().DescribeTransitGatewayVpcAttachments(gomock.Any()).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+		TransitGatewayVpcAttachments: []*ec2.TransitGatewayVpcAttachment{{
+			TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+			State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+		}},
+	}, nil)
+	remote.EXPECT().DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+	}).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+
+	r := NewRemoteVPCReconciler(clusterScope)
+	if err := r.Delete(); !awserrors.IsFailedDependency(err) {
+		t.Fatalf("expected a failed dependency error while the attachment is deleted, got %v", err)
+	}
+
+	remote.EXPECT().DescribeTransitGatewayVpcAttachments(gomock.Any()).Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{}, nil)
+	remote.EXPECT().DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-remote-a")}},
+	}, nil)
+	remote.EXPECT().DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: aws.String("subnet-remote-a")}).Return(&ec2.DeleteSubnetOutput{}, nil)
+	remote.EXPECT().DescribeRouteTables(gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{{RouteTableId: aws.String("rtb-remote")}},
+	}, nil)
+	remote.EXPECT().DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: aws.String("rtb-remote")}).Return(&ec2.DeleteRouteTableOutput{}, nil)
+	remote.EXPECT().DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-remote")}).Return(&ec2.DeleteVpcOutput{}, nil)
+
+	if err := r.Delete(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if clusterScope.Network().RemoteRegion != nil {
+		t.Fatalf("expected the remote region status to be cleared, got %+v", clusterScope.Network().RemoteRegion)
+	}
+}
+
+func TestRemoteRegionScope(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	local := mock_ec2iface.NewMockEC2API(mockCtrl)
+	remote := mock_ec2iface.NewMockEC2API(mockCtrl)
+	clusterScope := newRemoteVPCTestScope(t, local, remote, nil)
+	clusterScope.RemoteRegion().Subnets[0].ID = "subnet-remote-a"
+
+	if !clusterScope.IsRemoteSubnet("subnet-remote-a") || clusterScope.IsRemoteSubnet("subnet-local-private") {
+		t.Fatal("expected only remote subnets to be reported as remote")
+	}
+
+	remoteScope := clusterScope.RemoteRegionScope()
+	if remoteScope.EC2 != remote || remoteScope.Region() != "us-west-2" || !remoteScope.IsRemoteRegion() {
+		t.Fatalf("expected the remote scope to target us-west-2 with the remote client")
+	}
+	if clusterScope.EC2 != local || clusterScope.Region() != "us-east-1" {
+		t.Fatalf("expected the cluster scope to be unchanged")
+	}
+
+	ids, err := NewService(remoteScope).GetCoreSecurityGroups(&scope.MachineScope{})
+	if err != nil || len(ids) != 0 {
+		t.Fatalf("expected no core security groups in the remote region, got %v, %v", ids, err)
+	}
+}
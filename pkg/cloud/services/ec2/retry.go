@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+)
+
+// RetryRule is the retry decision of a RetryPolicy for an AWS error code.
+type RetryRule struct {
+	// Retry is true if requests failing with the error code are retried.
+	Retry bool
+	// Delay is the time waited before each retry.
+	Delay time.Duration
+	// Jitter randomizes the delay by up to this fraction of it, in either direction, so that the requests
+	// throttled together are not retried together.
+	Jitter float64
+	// MaxRetries caps the number of retries of a request failing with the error code. The maximum of the
+	// retryer of the session applies when it is zero.
+	MaxRetries int
+}
+
+// RetryPolicy maps AWS error codes to their retry decision. Requests failing with codes the policy doesn't list
+// are left to the retryer of the session.
+type RetryPolicy map[string]RetryRule
+
+// DefaultRetryPolicy retries throttled requests shortly, and requests failing for lack of capacity a couple of
+// times after the capacity had a chance to free up, before the instance is launched in another failure domain.
+var DefaultRetryPolicy = RetryPolicy{
+	"RequestLimitExceeded":         {Retry: true, Delay: 5 * time.Second, Jitter: 0.5},
+	awserrors.InsufficientCapacity: {Retry: true, Delay: 30 * time.Second, MaxRetries: 2},
+	"InvalidParameterValue":        {Retry: false},
+}
+
+// ShouldRetry returns whether requests failing with the given error code are retried, and the delay before
+// each retry, before jitter. Codes the policy doesn't list are not retried.
+func (p RetryPolicy) ShouldRetry(errCode string) (bool, time.Duration) {
+	rule := p[errCode]
+	return rule.Retry, rule.Delay
+}
+
+// Handler returns the middleware applying the policy to AWS requests, to be added to their build handlers.
+// It counts the retries of each request in its context, see RetryCount.
+func (p RetryPolicy) Handler(logger logr.Logger) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "capa/retry-policy",
+		Fn: func(r *request.Request) {
+			r.SetContext(context.WithValue(r.Context(), retryCountKey{}, new(int32)))
+			r.Retryer = &policyRetryer{Retryer: r.Retryer, policy: p, logger: logger}
+		},
+	}
+}
+
+type retryCountKey struct{}
+
+// RetryCount returns the number of times the request of the given context was retried.
+func RetryCount(ctx context.Context) int {
+	count, ok := ctx.Value(retryCountKey{}).(*int32)
+	if !ok {
+		return 0
+	}
+	return int(atomic.LoadInt32(count))
+}
+
+// policyRetryer decides the retries of requests failing with the error codes of its policy, and defers to the
+// retryer of the session for the others.
+type policyRetryer struct {
+	request.Retryer
+	policy RetryPolicy
+	logger logr.Logger
+}
+
+// rule returns the rule of the policy for the error of the request, if any.
+func (r *policyRetryer) rule(req *request.Request) (RetryRule, bool) {
+	code, ok := awserrors.Code(req.Error)
+	if !ok {
+		return RetryRule{}, false
+	}
+	rule, ok := r.policy[code]
+	return rule, ok
+}
+
+func (r *policyRetryer) ShouldRetry(req *request.Request) bool {
+	rule, ok := r.rule(req)
+	if !ok {
+		return r.Retryer.ShouldRetry(req)
+	}
+	return rule.Retry && (rule.MaxRetries == 0 || req.RetryCount < rule.MaxRetries)
+}
+
+// RetryRules is only called for requests that are retried, so it counts the retry.
+func (r *policyRetryer) RetryRules(req *request.Request) time.Duration {
+	rule, ok := r.rule(req)
+	delay := rule.jitteredDelay()
+	if !ok {
+		delay = r.Retryer.RetryRules(req)
+	}
+
+	retries := int32(1)
+	if count, ok := req.Context().Value(retryCountKey{}).(*int32); ok {
+		retries = atomic.AddInt32(count, 1)
+	}
+	code, _ := awserrors.Code(req.Error)
+	r.logger.V(2).Info("Retrying AWS request", "service", req.ClientInfo.ServiceName, "operation", req.Operation.Name,
+		"code", code, "retry", retries, "delay", delay)
+	return delay
+}
+
+// jitteredDelay returns the delay of the rule, randomized by its jitter.
+func (r RetryRule) jitteredDelay() time.Duration {
+	if r.Jitter <= 0 {
+		return r.Delay
+	}
+	return r.Delay + time.Duration((2*rand.Float64()-1)*r.Jitter*float64(r.Delay))
+}
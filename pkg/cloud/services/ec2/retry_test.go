@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"k8s.io/klog/klogr"
+)
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	testCases := []struct {
+		code          string
+		expectRetry   bool
+		expectedDelay time.Duration
+	}{
+		{
+			code:          "RequestLimitExceeded",
+			expectRetry:   true,
+			expectedDelay: 5 * time.Second,
+		},
+		{
+			code:          "InsufficientInstanceCapacity",
+			expectRetry:   true,
+			expectedDelay: 30 * time.Second,
+		},
+		{
+			code:        "InvalidParameterValue",
+			expectRetry: false,
+		},
+		{
+			code:        "InvalidVpcID.NotFound",
+			expectRetry: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code, func(t *testing.T) {
+			retry, delay := DefaultRetryPolicy.ShouldRetry(tc.code)
+			if retry != tc.expectRetry || delay != tc.expectedDelay {
+				t.Fatalf("expected (%v, %v), got (%v, %v)", tc.expectRetry, tc.expectedDelay, retry, delay)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyHandler(t *testing.T) {
+	testCases := []struct {
+		name string
+		// errs are the errors of the attempts of the request, which succeeds once they are exhausted.
+		errs           []error
+		expectErr      bool
+		expectRetries  int
+		expectedDelays []time.Duration
+		// jitter is the fraction by which the delays may differ from the expected delays.
+		jitter float64
+	}{
+		{
+			name: "retries throttled requests after the jittered delay of the policy",
+			errs: []error{
+				awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+				awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			},
+			expectRetries:  2,
+			expectedDelays: []time.Duration{5 * time.Second, 5 * time.Second},
+			jitter:         0.5,
+		},
+		{
+			name: "caps the retries of requests failing for lack of capacity",
+			errs: []error{
+				awserr.New("InsufficientInstanceCapacity", "no capacity", nil),
+				awserr.New("InsufficientInstanceCapacity", "no capacity", nil),
+				awserr.New("InsufficientInstanceCapacity", "no capacity", nil),
+			},
+			expectErr:      true,
+			expectRetries:  2,
+			expectedDelays: []time.Duration{30 * time.Second, 30 * time.Second},
+		},
+		{
+			name:      "doesn't retry invalid parameters",
+			errs:      []error{awserr.New("InvalidParameterValue", "bad value", nil)},
+			expectErr: true,
+		},
+		{
+			name: "defers to the retryer of the session for other errors",
+			errs: []error{
+				awserr.New(request.ErrCodeResponseTimeout, "timeout", nil),
+				awserr.New("InvalidVpcID.NotFound", "not found", nil),
+			},
+			expectErr:     true,
+			expectRetries: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var delays []time.Duration
+			cfg := aws.Config{SleepDelay: func(delay time.Duration) { delays = append(delays, delay) }}
+
+			var handlers request.Handlers
+			handlers.Build.PushBackNamed(DefaultRetryPolicy.Handler(klogr.New()))
+			attempt := 0
+			handlers.Send.PushBack(func(r *request.Request) {
+				r.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}}
+				if attempt < len(tc.errs) {
+					r.Error = tc.errs[attempt]
+				}
+				attempt++
+			})
+			handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+			req := request.New(cfg, metadata.ClientInfo{ServiceName: "ec2"}, handlers, client.DefaultRetryer{NumMaxRetries: 5},
+				&request.Operation{Name: "RunInstances"}, nil, nil)
+			err := req.Send()
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.expectErr, err)
+			}
+			if retries := RetryCount(req.Context()); retries != tc.expectRetries {
+				t.Fatalf("expected %d retries in the context of the request, got %d", tc.expectRetries, retries)
+			}
+			if tc.expectedDelays != nil && !equalDurations(delays, tc.expectedDelays, tc.jitter) {
+				t.Fatalf("expected delays %v, got %v", tc.expectedDelays, delays)
+			}
+		})
+	}
+}
+
+// equalDurations returns whether the durations of a are within the given fraction of those of b.
+func equalDurations(a, b []time.Duration, fraction float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if diff := a[i] - b[i]; diff > time.Duration(fraction*float64(b[i])) || -diff > time.Duration(fraction*float64(b[i])) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+)
+
+const (
+	// cloudWatchAgentNamespace is the CloudWatch namespace the CloudWatch agent publishes its metrics to.
+	cloudWatchAgentNamespace = "CWAgent"
+
+	// diskUsedPercentMetric is the CloudWatch agent metric holding the disk usage of a filesystem.
+	diskUsedPercentMetric = "disk_used_percent"
+
+	// diskUsedPercentWindow is how far back the latest disk_used_percent datapoint is looked up.
+	diskUsedPercentWindow = 10 * time.Minute
+)
+
+// growRootFilesystemCommands grow the partition of the root filesystem to the size of its volume,
+// then the filesystem itself. growpart exits 1 when the partition already fills the volume.
+var growRootFilesystemCommands = []string{
+	"set -e",
+	`ROOT_DEVICE=$(findmnt -n -o SOURCE /)`,
+	`growpart "/dev/$(lsblk -n -o PKNAME "$ROOT_DEVICE")" "$(cat /sys/class/block/$(basename "$ROOT_DEVICE")/partition)" || [ $? -eq 1 ]`,
+	`resize2fs "$ROOT_DEVICE"`,
+}
+
+// rootVolumeBackoff bounds how long ExpandRootVolume and GrowRootFilesystem wait for the volume
+// modification and the SSM command to complete.
+var rootVolumeBackoff = wait.NewBackoff()
+
+// RootVolumeUsedPercent returns the latest usage of the root filesystem of the instance, in percent, as
+// reported by the CloudWatch agent. It returns nil when the agent does not report it.
+func (s *Service) RootVolumeUsedPercent(instanceID string) (*float64, error) {
+	var metric *cloudwatch.Metric
+	err := s.scope.CloudWatch.ListMetricsPages(&cloudwatch.ListMetricsInput{
+		Namespace:  aws.String(cloudWatchAgentNamespace),
+		MetricName: aws.String(diskUsedPercentMetric),
+		Dimensions: []*cloudwatch.DimensionFilter{
+			{Name: aws.String("InstanceId"), Value: aws.String(instanceID)},
+			{Name: aws.String("path"), Value: aws.String("/")},
+		},
+	}, func(out *cloudwatch.ListMetricsOutput, _ bool) bool {
+		if len(out.Metrics) > 0 {
+			metric = out.Metrics[0]
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s metrics of instance %q", diskUsedPercentMetric, instanceID)
+	}
+	if metric == nil {
+		return nil, nil
+	}
+
+	// The agent publishes disk_used_percent with the filesystem's device and type as extra dimensions,
+	// which GetMetricStatistics needs to match exactly.
+	now := time.Now()
+	out, err := s.scope.CloudWatch.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Namespace:  metric.Namespace,
+		MetricName: metric.MetricName,
+		Dimensions: metric.Dimensions,
+		StartTime:  aws.Time(now.Add(-diskUsedPercentWindow)),
+		EndTime:    aws.Time(now),
+		Period:     aws.Int64(60),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticMaximum}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s of instance %q", diskUsedPercentMetric, instanceID)
+	}
+
+	var latest *cloudwatch.Datapoint
+	for _, dp := range out.Datapoints {
+		if latest == nil || aws.TimeValue(dp.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = dp
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	return latest.Maximum, nil
+}
+
+// ExpandRootVolume grows the root volume of the instance by 20%, without exceeding maxSizeGiB, and waits
+// for the new size to be usable. It returns the size of the volume, and false when it was already
+// maxSizeGiB large.
+func (s *Service) ExpandRootVolume(instanceID string, maxSizeGiB int64) (int64, bool, error) {
+	volumeID, err := s.rootVolumeID(instanceID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	volumes, err := s.scope.EC2.DescribeVolumes(&ec2.DescribeVolumesInput{
+		VolumeIds: []*string{aws.String(volumeID)},
+	})
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "failed to describe root volume %q of instance %q", volumeID, instanceID)
+	}
+	if len(volumes.Volumes) == 0 {
+		return 0, false, errors.Errorf("root volume %q of instance %q not found", volumeID, instanceID)
+	}
+
+	size := aws.Int64Value(volumes.Volumes[0].Size)
+	newSize := (size*12 + 9) / 10
+	if newSize > maxSizeGiB {
+		newSize = maxSizeGiB
+	}
+	if newSize <= size {
+		return size, false, nil
+	}
+
+	s.scope.Info("Expanding root volume", "instance-id", instanceID, "volume-id", volumeID, "size", size, "new-size", newSize)
+
+	if _, err := s.scope.EC2.ModifyVolume(&ec2.ModifyVolumeInput{
+		VolumeId: aws.String(volumeID),
+		Size:     aws.Int64(newSize),
+	}); err != nil {
+		return 0, false, errors.Wrapf(err, "failed to expand root volume %q of instance %q to %dGiB", volumeID, instanceID, newSize)
+	}
+
+	// The new size can be used as soon as the modification is optimizing.
+	checkModification := func() (bool, error) {
+		out, err := s.scope.EC2.DescribeVolumesModifications(&ec2.DescribeVolumesModificationsInput{
+			VolumeIds: []*string{aws.String(volumeID)},
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, m := range out.VolumesModifications {
+			switch aws.StringValue(m.ModificationState) {
+			case ec2.VolumeModificationStateOptimizing, ec2.VolumeModificationStateCompleted:
+				return true, nil
+			case ec2.VolumeModificationStateFailed:
+				return false, errors.Errorf("modification of root volume %q failed: %s", volumeID, aws.StringValue(m.StatusMessage))
+			}
+		}
+		return false, nil
+	}
+
+	if err := wait.WaitForWithRetryable(rootVolumeBackoff, checkModification); err != nil {
+		return 0, false, errors.Wrapf(err, "failed to wait for root volume %q of instance %q to be expanded", volumeID, instanceID)
+	}
+
+	return newSize, true, nil
+}
+
+// GrowRootFilesystem runs growpart and resize2fs on the instance through SSM, so that its root filesystem
+// uses all of the root volume.
+func (s *Service) GrowRootFilesystem(instanceID string) error {
+	out, err := s.scope.SSM.SendCommand(&ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  []*string{aws.String(instanceID)},
+		Parameters: map[string][]*string{
+			"commands": aws.StringSlice(growRootFilesystemCommands),
+		},
+		Comment: aws.String("Cluster API Provider AWS root filesystem expansion"),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to send root filesystem expansion command to instance %q", instanceID)
+	}
+
+	var invocation *ssm.GetCommandInvocationOutput
+	checkInvocation := func() (bool, error) {
+		invocation, err = s.scope.SSM.GetCommandInvocation(&ssm.GetCommandInvocationInput{
+			CommandId:  out.Command.CommandId,
+			InstanceId: aws.String(instanceID),
+		})
+		if err != nil {
+			return false, err
+		}
+		switch aws.StringValue(invocation.Status) {
+		case ssm.CommandInvocationStatusPending, ssm.CommandInvocationStatusInProgress, ssm.CommandInvocationStatusDelayed:
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if err := wait.WaitForWithRetryable(rootVolumeBackoff, checkInvocation, ssm.ErrCodeInvocationDoesNotExist); err != nil {
+		if err == kwait.ErrWaitTimeout {
+			return errors.Errorf("root filesystem expansion of instance %q did not complete in time", instanceID)
+		}
+		return errors.Wrapf(err, "failed to get root filesystem expansion result of instance %q", instanceID)
+	}
+
+	if aws.StringValue(invocation.Status) != ssm.CommandInvocationStatusSuccess {
+		return errors.Errorf("root filesystem expansion of instance %q finished with status %s and exit code %d: %s",
+			instanceID, aws.StringValue(invocation.Status), aws.Int64Value(invocation.ResponseCode), aws.StringValue(invocation.StandardErrorContent))
+	}
+
+	return nil
+}
+
+// rootVolumeID returns the ID of the EBS volume attached as the root device of the instance.
+func (s *Service) rootVolumeID(instanceID string) (string, error) {
+	out, err := s.scope.EC2.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe instance %q", instanceID)
+	}
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return "", errors.Errorf("instance %q not found", instanceID)
+	}
+
+	instance := out.Reservations[0].Instances[0]
+	for _, mapping := range instance.BlockDeviceMappings {
+		if aws.StringValue(mapping.DeviceName) == aws.StringValue(instance.RootDeviceName) && mapping.Ebs != nil {
+			return aws.StringValue(mapping.Ebs.VolumeId), nil
+		}
+	}
+
+	return "", errors.Errorf("instance %q has no EBS root volume", instanceID)
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/cloudwatch/mock_cloudwatchiface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestRootVolumeUsedPercent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rootMetric := &cloudwatch.Metric{
+		Namespace:  aws.String("CWAgent"),
+		MetricName: aws.String("disk_used_percent"),
+		Dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("InstanceId"), Value: aws.String("i-1")},
+			{Name: aws.String("path"), Value: aws.String("/")},
+			{Name: aws.String("device"), Value: aws.String("nvme0n1p1")},
+			{Name: aws.String("fstype"), Value: aws.String("ext4")},
+		},
+	}
+	listMetrics := func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder, metrics ...*cloudwatch.Metric) {
+		m.ListMetricsPages(gomock.Eq(&cloudwatch.ListMetricsInput{
+			Namespace:  aws.String("CWAgent"),
+			MetricName: aws.String("disk_used_percent"),
+			Dimensions: []*cloudwatch.DimensionFilter{
+				{Name: aws.String("InstanceId"), Value: aws.String("i-1")},
+				{Name: aws.String("path"), Value: aws.String("/")},
+			},
+		}), gomock.Any()).DoAndReturn(func(_ *cloudwatch.ListMetricsInput, fn func(*cloudwatch.ListMetricsOutput, bool) bool) error {
+			fn(&cloudwatch.ListMetricsOutput{Metrics: metrics}, true)
+			return nil
+		})
+	}
+	now := time.Now()
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder)
+		want    *float64
+		wantErr bool
+	}{
+		{
+			name: "returns the latest datapoint",
+			expect: func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder) {
+				listMetrics(m, rootMetric)
+				m.GetMetricStatistics(gomock.Any()).
+					DoAndReturn(func(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error) {
+						if len(input.Dimensions) != 4 {
+							t.Fatalf("expected the dimensions of the listed metric, got %v", input.Dimensions)
+						}
+						return &cloudwatch.GetMetricStatisticsOutput{
+							Datapoints: []*cloudwatch.Datapoint{
+								{Timestamp: aws.Time(now.Add(-3 * time.Minute)), Maximum: aws.Float64(91)},
+								{Timestamp: aws.Time(now.Add(-time.Minute)), Maximum: aws.Float64(42)},
+								{Timestamp: aws.Time(now.Add(-2 * time.Minute)), Maximum: aws.Float64(90)},
+							},
+						}, nil
+					})
+			},
+			want: aws.Float64(42),
+		},
+		{
+			name: "CloudWatch agent does not report the metric",
+			expect: func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder) {
+				listMetrics(m)
+			},
+		},
+		{
+			name: "no recent datapoints",
+			expect: func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder) {
+				listMetrics(m, rootMetric)
+				m.GetMetricStatistics(gomock.Any()).Return(&cloudwatch.GetMetricStatisticsOutput{}, nil)
+			},
+		},
+		{
+			name: "fails to list metrics",
+			expect: func(m *mock_cloudwatchiface.MockCloudWatchAPIMockRecorder) {
+				m.ListMetricsPages(gomock.Any(), gomock.Any()).Return(awserr.New("AccessDenied", "denied", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cwMock := mock_cloudwatchiface.NewMockCloudWatchAPI(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					CloudWatch: cwMock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(cwMock.EXPECT())
+
+			s := NewService(scope)
+			got, err := s.RootVolumeUsedPercent("i-1")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RootVolumeUsedPercent() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if aws.Float64Value(got) != aws.Float64Value(tc.want) || (got == nil) != (tc.want == nil) {
+				t.Fatalf("expected used percent %v, got %v", aws.Float64Value(tc.want), aws.Float64Value(got))
+			}
+		})
+	}
+}
+
+func TestExpandRootVolume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	backoff := rootVolumeBackoff
+	defer func() { rootVolumeBackoff = backoff }()
+	rootVolumeBackoff.Duration = time.Millisecond
+	rootVolumeBackoff.Steps = 2
+
+	describeRootVolume := func(m *mock_ec2iface.MockEC2APIMockRecorder, size int64) {
+		m.DescribeInstances(gomock.Eq(&ec2.DescribeInstancesInput{
+			InstanceIds: []*string{aws.String("i-1")},
+		})).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{{
+				Instances: []*ec2.Instance{{
+					InstanceId:     aws.String("i-1"),
+					RootDeviceName: aws.String("/dev/xvda"),
+					BlockDeviceMappings: []*ec2.InstanceBlockDeviceMapping{
+						{DeviceName: aws.String("/dev/sdb"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-data")}},
+						{DeviceName: aws.String("/dev/xvda"), Ebs: &ec2.EbsInstanceBlockDevice{VolumeId: aws.String("vol-root")}},
+					},
+				}},
+			}},
+		}, nil)
+		m.DescribeVolumes(gomock.Eq(&ec2.DescribeVolumesInput{
+			VolumeIds: []*string{aws.String("vol-root")},
+		})).Return(&ec2.DescribeVolumesOutput{
+			Volumes: []*ec2.Volume{{VolumeId: aws.String("vol-root"), Size: aws.Int64(size)}},
+		}, nil)
+	}
+	modificationState := func(state string) *ec2.DescribeVolumesModificationsOutput {
+		return &ec2.DescribeVolumesModificationsOutput{
+			VolumesModifications: []*ec2.VolumeModification{
+				{VolumeId: aws.String("vol-root"), ModificationState: aws.String(state)},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		maxSizeGiB   int64
+		expect       func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantSize     int64
+		wantExpanded bool
+		wantErr      bool
+	}{
+		{
+			name:       "grows the volume by 20%",
+			maxSizeGiB: 100,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeRootVolume(m, 32)
+				m.ModifyVolume(gomock.Eq(&ec2.ModifyVolumeInput{
+					VolumeId: aws.String("vol-root"),
+					Size:     aws.Int64(39),
+				})).Return(&ec2.ModifyVolumeOutput{}, nil)
+				m.DescribeVolumesModifications(gomock.Eq(&ec2.DescribeVolumesModificationsInput{
+					VolumeIds: []*string{aws.String("vol-root")},
+				})).Return(modificationState(ec2.VolumeModificationStateModifying), nil)
+				m.DescribeVolumesModifications(gomock.Any()).Return(modificationState(ec2.VolumeModificationStateOptimizing), nil)
+			},
+			wantSize:     39,
+			wantExpanded: true,
+		},
+		{
+			name:       "growth is capped at the maximum size",
+			maxSizeGiB: 35,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeRootVolume(m, 32)
+				m.ModifyVolume(gomock.Eq(&ec2.ModifyVolumeInput{
+					VolumeId: aws.String("vol-root"),
+					Size:     aws.Int64(35),
+				})).Return(&ec2.ModifyVolumeOutput{}, nil)
+				m.DescribeVolumesModifications(gomock.Any()).Return(modificationState(ec2.VolumeModificationStateCompleted), nil)
+			},
+			wantSize:     35,
+			wantExpanded: true,
+		},
+		{
+			name:       "volume already has the maximum size",
+			maxSizeGiB: 32,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeRootVolume(m, 32)
+			},
+			wantSize: 32,
+		},
+		{
+			name:       "volume modification fails",
+			maxSizeGiB: 100,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeRootVolume(m, 32)
+				m.ModifyVolume(gomock.Any()).Return(&ec2.ModifyVolumeOutput{}, nil)
+				m.DescribeVolumesModifications(gomock.Any()).Return(modificationState(ec2.VolumeModificationStateFailed), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "volume was modified recently",
+			maxSizeGiB: 100,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				describeRootVolume(m, 32)
+				m.ModifyVolume(gomock.Any()).Return(nil, awserr.New("VolumeModificationRateExceeded", "rate exceeded", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			size, expanded, err := s.ExpandRootVolume("i-1", tc.maxSizeGiB)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ExpandRootVolume() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if size != tc.wantSize {
+				t.Fatalf("expected size %d, got %d", tc.wantSize, size)
+			}
+			if expanded != tc.wantExpanded {
+				t.Fatalf("expected expanded to be %v, got %v", tc.wantExpanded, expanded)
+			}
+		})
+	}
+}
+
+func TestGrowRootFilesystem(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	backoff := rootVolumeBackoff
+	defer func() { rootVolumeBackoff = backoff }()
+	rootVolumeBackoff.Duration = time.Millisecond
+	rootVolumeBackoff.Steps = 2
+
+	sendCommand := func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+		m.SendCommand(gomock.Eq(&ssm.SendCommandInput{
+			DocumentName: aws.String("AWS-RunShellScript"),
+			InstanceIds:  []*string{aws.String("i-1")},
+			Parameters: map[string][]*string{
+				"commands": aws.StringSlice(growRootFilesystemCommands),
+			},
+			Comment: aws.String("Cluster API Provider AWS root filesystem expansion"),
+		})).
+			Return(&ssm.SendCommandOutput{Command: &ssm.Command{CommandId: aws.String("cmd-1")}}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "filesystem is grown",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				sendCommand(m)
+				m.GetCommandInvocation(gomock.Eq(&ssm.GetCommandInvocationInput{
+					CommandId:  aws.String("cmd-1"),
+					InstanceId: aws.String("i-1"),
+				})).
+					Return(nil, awserr.New(ssm.ErrCodeInvocationDoesNotExist, "not yet", nil))
+				m.GetCommandInvocation(gomock.Any()).
+					Return(&ssm.GetCommandInvocationOutput{Status: aws.String(ssm.CommandInvocationStatusSuccess)}, nil)
+			},
+		},
+		{
+			name: "resize2fs fails",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				sendCommand(m)
+				m.GetCommandInvocation(gomock.Any()).
+					Return(&ssm.GetCommandInvocationOutput{
+						Status:               aws.String(ssm.CommandInvocationStatusFailed),
+						ResponseCode:         aws.Int64(1),
+						StandardErrorContent: aws.String("resize2fs: Bad magic number in super-block"),
+					}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "command does not complete",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				sendCommand(m)
+				m.GetCommandInvocation(gomock.Any()).
+					Return(&ssm.GetCommandInvocationOutput{Status: aws.String(ssm.CommandInvocationStatusInProgress)}, nil).
+					Times(2)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					SSM: ssmMock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(ssmMock.EXPECT())
+
+			s := NewService(scope)
+			if err := s.GrowRootFilesystem("i-1"); (err != nil) != tc.wantErr {
+				t.Fatalf("GrowRootFilesystem() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
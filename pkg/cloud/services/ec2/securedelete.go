@@ -0,0 +1,355 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// defaultSecureDeleteTimeout is how long the shredder instance may take to wipe the volumes of an instance
+	// when its machine doesn't set SecureDeleteTimeout.
+	defaultSecureDeleteTimeout = time.Hour
+
+	// shredDeviceLetters are the last letters of the device names the volumes are attached to the shredder
+	// instance as, from /dev/sdf.
+	shredDeviceLetters = "fghijklmnopqrstuvwxyz"
+)
+
+// errVolumeWipeFailed is returned by SecureDeleteInstance when the shredder instance failed to wipe the volumes.
+var errVolumeWipeFailed = errors.New("volumes could not be wiped")
+
+// shredFunctionCommands define shred_volume, which overwrites the device of the volume with the given ID and
+// device name once with random data, then with zeros. EBS volumes are NVMe devices named after their ID on
+// Nitro instances, and Xen devices named after their device name otherwise.
+var shredFunctionCommands = []string{
+	"set -e",
+	`shred_volume() {`,
+	`  for i in $(seq 60); do`,
+	`    for device in "/dev/disk/by-id/nvme-Amazon_Elastic_Block_Store_${1/-/}" "$2" "/dev/xvd${2#/dev/sd}"; do`,
+	`      if [ -b "$device" ]; then shred -vzn 1 "$(readlink -f "$device")"; return; fi`,
+	`    done`,
+	`    sleep 5`,
+	`  done`,
+	`  echo "device of volume $1 not found" >&2`,
+	`  return 1`,
+	`}`,
+}
+
+// SecureDeleteInstance wipes the EBS volumes of the stopped instance of a machine before it is terminated, one
+// step at a time, and returns true once they are deleted. The instance is stopped, then its volumes are
+// attached to a shredder instance launched from the same image in the same subnet, which overwrites them
+// through SSM. The volumes are then deleted, along with the shredder instance.
+func (s *Service) SecureDeleteInstance(scope *scope.MachineScope, instance *infrav1.Instance) (bool, error) {
+	status := &scope.AWSMachine.Status
+
+	switch instance.State {
+	case infrav1.InstanceStateStopped:
+	case infrav1.InstanceStatePending, infrav1.InstanceStateStopping:
+		return false, nil
+	default:
+		if _, err := s.scope.EC2.StopInstances(&ec2.StopInstancesInput{
+			InstanceIds: aws.StringSlice([]string{instance.ID}),
+		}); err != nil {
+			return false, errors.Wrapf(err, "failed to stop instance %q", instance.ID)
+		}
+		record.Eventf(scope.AWSMachine, "SuccessfulStopInstance", "Stopped instance %q to wipe its volumes", instance.ID)
+		return false, nil
+	}
+
+	if len(status.SecureDeleteVolumeIDs) == 0 {
+		volumeIDs, err := s.instanceVolumeIDs(instance.ID)
+		if err != nil {
+			return false, err
+		}
+		if len(volumeIDs) == 0 {
+			return true, nil
+		}
+		if len(volumeIDs) > len(shredDeviceLetters) {
+			return false, errors.Errorf("instance %q has %d volumes, more than the %d a shredder instance can wipe", instance.ID, len(volumeIDs), len(shredDeviceLetters))
+		}
+		status.SecureDeleteVolumeIDs = volumeIDs
+	}
+
+	volumes, err := s.describeVolumes(status.SecureDeleteVolumeIDs)
+	if err != nil {
+		return false, err
+	}
+	if len(volumes) == 0 {
+		// The volumes are wiped and deleted.
+		return true, s.terminateShredder(scope)
+	}
+
+	shredder, err := s.reconcileShredder(scope, instance)
+	if err != nil || shredder.State != infrav1.InstanceStateRunning {
+		return false, err
+	}
+
+	command, err := s.shredCommand(shredder.ID)
+	if err != nil {
+		return false, err
+	}
+	if command == nil {
+		attached, err := s.attachVolumesToShredder(instance.ID, shredder.ID, status.SecureDeleteVolumeIDs, volumes)
+		if err != nil || !attached {
+			return false, err
+		}
+		return false, s.sendShredCommand(scope, shredder.ID, status.SecureDeleteVolumeIDs)
+	}
+
+	switch aws.StringValue(command.Status) {
+	case ssm.CommandStatusSuccess:
+		return false, s.deleteVolumes(scope, volumes)
+	case ssm.CommandStatusFailed, ssm.CommandStatusTimedOut, ssm.CommandStatusCancelled, ssm.CommandStatusCancelling:
+		return false, errors.Wrapf(errVolumeWipeFailed, "command %q of shredder instance %q finished with status %s",
+			aws.StringValue(command.CommandId), shredder.ID, aws.StringValue(command.Status))
+	}
+	return false, nil
+}
+
+// IsVolumeWipeFailed returns true if the volumes of an instance could not be wiped by the shredder instance.
+func IsVolumeWipeFailed(err error) bool {
+	return errors.Cause(err) == errVolumeWipeFailed
+}
+
+// instanceVolumeIDs returns the IDs of the EBS volumes attached to the instance.
+func (s *Service) instanceVolumeIDs(instanceID string) ([]string, error) {
+	out, err := s.scope.EC2.DescribeVolumes(&ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("attachment.instance-id"), Values: aws.StringSlice([]string{instanceID})},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe volumes of instance %q", instanceID)
+	}
+
+	volumeIDs := make([]string, 0, len(out.Volumes))
+	for _, volume := range out.Volumes {
+		volumeIDs = append(volumeIDs, aws.StringValue(volume.VolumeId))
+	}
+	return volumeIDs, nil
+}
+
+// describeVolumes returns the volumes with the given IDs which still exist, by ID.
+func (s *Service) describeVolumes(volumeIDs []string) (map[string]*ec2.Volume, error) {
+	out, err := s.scope.EC2.DescribeVolumes(&ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("volume-id"), Values: aws.StringSlice(volumeIDs)},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe volumes %v", volumeIDs)
+	}
+
+	volumes := make(map[string]*ec2.Volume, len(out.Volumes))
+	for _, volume := range out.Volumes {
+		volumes[aws.StringValue(volume.VolumeId)] = volume
+	}
+	return volumes, nil
+}
+
+// reconcileShredder returns the shredder instance of the machine, launching it like its instance when it
+// doesn't exist yet or was terminated.
+func (s *Service) reconcileShredder(scope *scope.MachineScope, instance *infrav1.Instance) (*infrav1.Instance, error) {
+	status := &scope.AWSMachine.Status
+
+	if status.SecureDeleteInstanceID != nil {
+		shredder, err := s.InstanceIfExists(status.SecureDeleteInstanceID)
+		if err != nil {
+			return nil, err
+		}
+		if shredder != nil && shredder.State != infrav1.InstanceStateShuttingDown && shredder.State != infrav1.InstanceStateTerminated {
+			return shredder, nil
+		}
+		record.Warnf(scope.AWSMachine, "ShredderTerminated", "Shredder instance %q was terminated, launching another one", aws.StringValue(status.SecureDeleteInstanceID))
+	}
+
+	// The shredder runs the image of the instance, without user data so that it doesn't join the cluster.
+	shredder, err := s.runInstance(infrav1.ShredderRoleTagValue, &infrav1.Instance{
+		Type:             instance.Type,
+		ImageID:          instance.ImageID,
+		SubnetID:         instance.SubnetID,
+		SecurityGroupIDs: instance.SecurityGroupIDs,
+		IAMProfile:       instance.IAMProfile,
+		UserData:         aws.String(""),
+		Tags: infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(fmt.Sprintf("%s-shredder", scope.Name())),
+			Role:        aws.String(infrav1.ShredderRoleTagValue),
+			Additional:  s.scope.AdditionalTags(),
+		}),
+	})
+	if err != nil {
+		record.Warnf(scope.AWSMachine, "FailedLaunchShredder", "Failed to launch shredder instance: %v", err)
+		return nil, errors.Wrapf(err, "failed to launch shredder instance for instance %q", instance.ID)
+	}
+
+	status.SecureDeleteInstanceID = aws.String(shredder.ID)
+	record.Eventf(scope.AWSMachine, "SuccessfulLaunchShredder", "Launched shredder instance %q to wipe the volumes of instance %q", shredder.ID, instance.ID)
+	return shredder, nil
+}
+
+// attachVolumesToShredder moves the volumes from the instance to the shredder instance, and returns true once
+// they are all attached to it.
+func (s *Service) attachVolumesToShredder(instanceID, shredderID string, volumeIDs []string, volumes map[string]*ec2.Volume) (bool, error) {
+	attached := true
+	for i, volumeID := range volumeIDs {
+		volume, ok := volumes[volumeID]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case aws.StringValue(volume.State) == ec2.VolumeStateAvailable:
+			if _, err := s.scope.EC2.AttachVolume(&ec2.AttachVolumeInput{
+				VolumeId:   aws.String(volumeID),
+				InstanceId: aws.String(shredderID),
+				Device:     aws.String(shredDeviceName(i)),
+			}); err != nil {
+				return false, errors.Wrapf(err, "failed to attach volume %q to shredder instance %q", volumeID, shredderID)
+			}
+			attached = false
+		case isAttachedTo(volume, shredderID):
+		case isAttachedTo(volume, instanceID):
+			if _, err := s.scope.EC2.DetachVolume(&ec2.DetachVolumeInput{
+				VolumeId:   aws.String(volumeID),
+				InstanceId: aws.String(instanceID),
+			}); err != nil {
+				return false, errors.Wrapf(err, "failed to detach volume %q from instance %q", volumeID, instanceID)
+			}
+			attached = false
+		default:
+			// The volume is being attached or detached.
+			attached = false
+		}
+	}
+	return attached, nil
+}
+
+// shredCommand returns the last command sent to the shredder instance, if any.
+func (s *Service) shredCommand(shredderID string) (*ssm.Command, error) {
+	out, err := s.scope.SSM.ListCommands(&ssm.ListCommandsInput{
+		InstanceId: aws.String(shredderID),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list commands of shredder instance %q", shredderID)
+	}
+	if len(out.Commands) == 0 {
+		return nil, nil
+	}
+	return out.Commands[0], nil
+}
+
+// sendShredCommand sends the command wiping the volumes to the shredder instance, bounded by the
+// SecureDeleteTimeout of the machine.
+func (s *Service) sendShredCommand(scope *scope.MachineScope, shredderID string, volumeIDs []string) error {
+	timeout := defaultSecureDeleteTimeout
+	if t := scope.AWSMachine.Spec.SecureDeleteTimeout; t != nil {
+		timeout = t.Duration
+	}
+	seconds := strconv.FormatInt(int64(timeout/time.Second), 10)
+
+	commands := append([]string{}, shredFunctionCommands...)
+	for i, volumeID := range volumeIDs {
+		commands = append(commands, fmt.Sprintf("shred_volume %s %s", volumeID, shredDeviceName(i)))
+	}
+
+	if _, err := s.scope.SSM.SendCommand(&ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  aws.StringSlice([]string{shredderID}),
+		Parameters: map[string][]*string{
+			"commands":         aws.StringSlice(commands),
+			"executionTimeout": aws.StringSlice([]string{seconds}),
+		},
+		// The SSM agent of the shredder may take a while to register once it is running.
+		TimeoutSeconds: aws.Int64(int64(defaultSecureDeleteTimeout / time.Second)),
+		Comment:        aws.String("Cluster API Provider AWS secure delete"),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to send shred command to shredder instance %q", shredderID)
+	}
+
+	record.Eventf(scope.AWSMachine, "SuccessfulStartShred", "Wiping volumes %v with shredder instance %q", volumeIDs, shredderID)
+	return nil
+}
+
+// deleteVolumes detaches the wiped volumes from the shredder instance and deletes them.
+func (s *Service) deleteVolumes(scope *scope.MachineScope, volumes map[string]*ec2.Volume) error {
+	for volumeID, volume := range volumes {
+		switch aws.StringValue(volume.State) {
+		case ec2.VolumeStateAvailable:
+			if _, err := s.scope.EC2.DeleteVolume(&ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)}); err != nil {
+				return errors.Wrapf(err, "failed to delete volume %q", volumeID)
+			}
+			record.Eventf(scope.AWSMachine, "SuccessfulDeleteVolume", "Deleted wiped volume %q", volumeID)
+		case ec2.VolumeStateInUse:
+			if len(volume.Attachments) > 0 && aws.StringValue(volume.Attachments[0].State) == ec2.VolumeAttachmentStateAttached {
+				if _, err := s.scope.EC2.DetachVolume(&ec2.DetachVolumeInput{VolumeId: aws.String(volumeID)}); err != nil {
+					return errors.Wrapf(err, "failed to detach volume %q from shredder instance", volumeID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// terminateShredder terminates the shredder instance of the machine, if any.
+func (s *Service) terminateShredder(scope *scope.MachineScope) error {
+	shredderID := scope.AWSMachine.Status.SecureDeleteInstanceID
+	if shredderID == nil {
+		return nil
+	}
+
+	shredder, err := s.InstanceIfExists(shredderID)
+	if err != nil {
+		return err
+	}
+	if shredder == nil || shredder.State == infrav1.InstanceStateShuttingDown || shredder.State == infrav1.InstanceStateTerminated {
+		return nil
+	}
+
+	if err := s.TerminateInstance(shredder.ID); err != nil {
+		return err
+	}
+	record.Eventf(scope.AWSMachine, "SuccessfulTerminateShredder", "Terminated shredder instance %q", shredder.ID)
+	return nil
+}
+
+// isAttachedTo returns true if the volume is attached to the given instance.
+func isAttachedTo(volume *ec2.Volume, instanceID string) bool {
+	for _, attachment := range volume.Attachments {
+		if aws.StringValue(attachment.InstanceId) == instanceID && aws.StringValue(attachment.State) == ec2.VolumeAttachmentStateAttached {
+			return true
+		}
+	}
+	return false
+}
+
+// shredDeviceName returns the device name of the volume at the given index on the shredder instance.
+func shredDeviceName(i int) string {
+	return fmt.Sprintf("/dev/sd%c", shredDeviceLetters[i])
+}
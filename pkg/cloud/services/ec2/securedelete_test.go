@@ -0,0 +1,321 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newSecureDeleteTestScopes(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, ssmMock *mock_ssmiface.MockSSMAPI) (*scope.ClusterScope, *scope.MachineScope) {
+	awsCluster := &infrav1.AWSCluster{}
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    cluster,
+		AWSCluster: awsCluster,
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+			SSM: ssmMock,
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:     fake.NewFakeClientWithScheme(scheme.Scheme),
+		Cluster:    cluster,
+		Machine:    &clusterv1.Machine{},
+		AWSCluster: awsCluster,
+		AWSMachine: &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "default"},
+			Spec: infrav1.AWSMachineSpec{
+				SecureDelete:        true,
+				SecureDeleteTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("did not expect err: %v", err)
+	}
+	return clusterScope, machineScope
+}
+
+func secureDeleteVolume(id, state, instanceID string) *ec2.Volume {
+	volume := &ec2.Volume{VolumeId: aws.String(id), State: aws.String(state)}
+	if instanceID != "" {
+		volume.Attachments = []*ec2.VolumeAttachment{
+			{InstanceId: aws.String(instanceID), State: aws.String(ec2.VolumeAttachmentStateAttached)},
+		}
+	}
+	return volume
+}
+
+func describeShredder(m *mock_ec2iface.MockEC2APIMockRecorder, state string) {
+	m.DescribeInstances(gomock.Eq(&ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice([]string{"i-shredder"})})).
+		Return(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{
+				InstanceId: aws.String("i-shredder"),
+				State:      &ec2.InstanceState{Name: aws.String(state)},
+				Placement:  &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+			}}}},
+		}, nil)
+}
+
+func describeVolumesByID(m *mock_ec2iface.MockEC2APIMockRecorder, volumes ...*ec2.Volume) {
+	m.DescribeVolumes(gomock.Eq(&ec2.DescribeVolumesInput{
+		Filters: []*ec2.Filter{{Name: aws.String("volume-id"), Values: aws.StringSlice([]string{"vol-root", "vol-data"})}},
+	})).Return(&ec2.DescribeVolumesOutput{Volumes: volumes}, nil)
+}
+
+func TestSecureDeleteInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+	clusterScope, machineScope := newSecureDeleteTestScopes(t, ec2Mock, ssmMock)
+	s := NewService(clusterScope)
+
+	instance := &infrav1.Instance{
+		ID:               "i-1",
+		State:            infrav1.InstanceStateRunning,
+		Type:             "m5.large",
+		ImageID:          "ami-1",
+		SubnetID:         "subnet-1",
+		SecurityGroupIDs: []string{"sg-1"},
+		IAMProfile:       "nodes",
+	}
+
+	// Each step is a reconciliation of the deleted machine, with the state of the instance it finds.
+	steps := []struct {
+		name     string
+		state    infrav1.InstanceState
+		expect   func(m *mock_ec2iface.MockEC2APIMockRecorder, ssm *mock_ssmiface.MockSSMAPIMockRecorder)
+		wantDone bool
+	}{
+		{
+			name:  "stops the instance",
+			state: infrav1.InstanceStateRunning,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, _ *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.StopInstances(gomock.Eq(&ec2.StopInstancesInput{InstanceIds: aws.StringSlice([]string{"i-1"})})).
+					Return(&ec2.StopInstancesOutput{}, nil)
+			},
+		},
+		{
+			name:   "waits for the instance to stop",
+			state:  infrav1.InstanceStateStopping,
+			expect: func(_ *mock_ec2iface.MockEC2APIMockRecorder, _ *mock_ssmiface.MockSSMAPIMockRecorder) {},
+		},
+		{
+			name:  "launches the shredder instance like the instance",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, _ *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.DescribeVolumes(gomock.Eq(&ec2.DescribeVolumesInput{
+					Filters: []*ec2.Filter{{Name: aws.String("attachment.instance-id"), Values: aws.StringSlice([]string{"i-1"})}},
+				})).Return(&ec2.DescribeVolumesOutput{Volumes: []*ec2.Volume{
+					secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-1"),
+					secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-1"),
+				}}, nil)
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-1"),
+					secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-1"),
+				)
+				m.RunInstances(gomock.AssignableToTypeOf(&ec2.RunInstancesInput{})).
+					DoAndReturn(func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						if aws.StringValue(input.ImageId) != "ami-1" || aws.StringValue(input.SubnetId) != "subnet-1" ||
+							aws.StringValue(input.IamInstanceProfile.Name) != "nodes" || aws.StringValue(input.UserData) != "" {
+							t.Fatalf("expected the shredder instance to be launched like the instance without user data, got %v", input)
+						}
+						return &ec2.Reservation{Instances: []*ec2.Instance{{
+							InstanceId: aws.String("i-shredder"),
+							State:      &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNamePending)},
+							Placement:  &ec2.Placement{AvailabilityZone: aws.String("us-east-1a")},
+						}}}, nil
+					})
+				m.WaitUntilInstanceRunningWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "detaches the volumes from the instance",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, ssmMock *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-1"),
+					secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-1"),
+				)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				ssmMock.ListCommands(gomock.Eq(&ssm.ListCommandsInput{InstanceId: aws.String("i-shredder")})).
+					Return(&ssm.ListCommandsOutput{}, nil)
+				m.DetachVolume(gomock.Eq(&ec2.DetachVolumeInput{VolumeId: aws.String("vol-root"), InstanceId: aws.String("i-1")})).
+					Return(&ec2.VolumeAttachment{}, nil)
+				m.DetachVolume(gomock.Eq(&ec2.DetachVolumeInput{VolumeId: aws.String("vol-data"), InstanceId: aws.String("i-1")})).
+					Return(&ec2.VolumeAttachment{}, nil)
+			},
+		},
+		{
+			name:  "attaches the volumes to the shredder instance",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, ssmMock *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateAvailable, ""),
+					secureDeleteVolume("vol-data", ec2.VolumeStateAvailable, ""),
+				)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				ssmMock.ListCommands(gomock.Any()).Return(&ssm.ListCommandsOutput{}, nil)
+				m.AttachVolume(gomock.Eq(&ec2.AttachVolumeInput{VolumeId: aws.String("vol-root"), InstanceId: aws.String("i-shredder"), Device: aws.String("/dev/sdf")})).
+					Return(&ec2.VolumeAttachment{}, nil)
+				m.AttachVolume(gomock.Eq(&ec2.AttachVolumeInput{VolumeId: aws.String("vol-data"), InstanceId: aws.String("i-shredder"), Device: aws.String("/dev/sdg")})).
+					Return(&ec2.VolumeAttachment{}, nil)
+			},
+		},
+		{
+			name:  "sends the shred command to the shredder instance",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, ssmMock *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-shredder"),
+					secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-shredder"),
+				)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				ssmMock.ListCommands(gomock.Any()).Return(&ssm.ListCommandsOutput{}, nil)
+				ssmMock.SendCommand(gomock.AssignableToTypeOf(&ssm.SendCommandInput{})).
+					DoAndReturn(func(input *ssm.SendCommandInput) (*ssm.SendCommandOutput, error) {
+						commands := strings.Join(aws.StringValueSlice(input.Parameters["commands"]), "\n")
+						if !strings.Contains(commands, "shred -vzn 1") ||
+							!strings.Contains(commands, "shred_volume vol-root /dev/sdf") ||
+							!strings.Contains(commands, "shred_volume vol-data /dev/sdg") {
+							t.Fatalf("expected the command to shred both volumes, got %q", commands)
+						}
+						if timeout := aws.StringValueSlice(input.Parameters["executionTimeout"]); len(timeout) != 1 || timeout[0] != "7200" {
+							t.Fatalf("expected the command to time out after the secure delete timeout, got %v", timeout)
+						}
+						return &ssm.SendCommandOutput{}, nil
+					})
+			},
+		},
+		{
+			name:  "waits for the shred command",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, ssmMock *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-shredder"),
+					secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-shredder"),
+				)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				ssmMock.ListCommands(gomock.Any()).
+					Return(&ssm.ListCommandsOutput{Commands: []*ssm.Command{{Status: aws.String(ssm.CommandStatusInProgress)}}}, nil)
+			},
+		},
+		{
+			name:  "detaches the wiped volumes from the shredder instance",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, ssmMock *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-shredder"),
+					secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-shredder"),
+				)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				ssmMock.ListCommands(gomock.Any()).
+					Return(&ssm.ListCommandsOutput{Commands: []*ssm.Command{{Status: aws.String(ssm.CommandStatusSuccess)}}}, nil)
+				m.DetachVolume(gomock.Eq(&ec2.DetachVolumeInput{VolumeId: aws.String("vol-root")})).Return(&ec2.VolumeAttachment{}, nil)
+				m.DetachVolume(gomock.Eq(&ec2.DetachVolumeInput{VolumeId: aws.String("vol-data")})).Return(&ec2.VolumeAttachment{}, nil)
+			},
+		},
+		{
+			name:  "deletes the wiped volumes",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, ssmMock *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m,
+					secureDeleteVolume("vol-root", ec2.VolumeStateAvailable, ""),
+					secureDeleteVolume("vol-data", ec2.VolumeStateAvailable, ""),
+				)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				ssmMock.ListCommands(gomock.Any()).
+					Return(&ssm.ListCommandsOutput{Commands: []*ssm.Command{{Status: aws.String(ssm.CommandStatusSuccess)}}}, nil)
+				m.DeleteVolume(gomock.Eq(&ec2.DeleteVolumeInput{VolumeId: aws.String("vol-root")})).Return(&ec2.DeleteVolumeOutput{}, nil)
+				m.DeleteVolume(gomock.Eq(&ec2.DeleteVolumeInput{VolumeId: aws.String("vol-data")})).Return(&ec2.DeleteVolumeOutput{}, nil)
+			},
+		},
+		{
+			name:  "terminates the shredder instance",
+			state: infrav1.InstanceStateStopped,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder, _ *mock_ssmiface.MockSSMAPIMockRecorder) {
+				describeVolumesByID(m)
+				describeShredder(m, ec2.InstanceStateNameRunning)
+				m.TerminateInstances(gomock.Eq(&ec2.TerminateInstancesInput{InstanceIds: aws.StringSlice([]string{"i-shredder"})})).
+					Return(&ec2.TerminateInstancesOutput{}, nil)
+			},
+			wantDone: true,
+		},
+	}
+
+	for _, step := range steps {
+		step.expect(ec2Mock.EXPECT(), ssmMock.EXPECT())
+		instance.State = step.state
+
+		done, err := s.SecureDeleteInstance(machineScope, instance)
+		if err != nil {
+			t.Fatalf("%s: did not expect err: %v", step.name, err)
+		}
+		if done != step.wantDone {
+			t.Fatalf("%s: expected done to be %v, got %v", step.name, step.wantDone, done)
+		}
+	}
+
+	status := machineScope.AWSMachine.Status
+	if aws.StringValue(status.SecureDeleteInstanceID) != "i-shredder" {
+		t.Fatalf("expected the shredder instance to be tracked, got %v", aws.StringValue(status.SecureDeleteInstanceID))
+	}
+}
+
+func TestSecureDeleteInstanceFailedCommand(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+	clusterScope, machineScope := newSecureDeleteTestScopes(t, ec2Mock, ssmMock)
+	machineScope.AWSMachine.Status.SecureDeleteInstanceID = aws.String("i-shredder")
+	machineScope.AWSMachine.Status.SecureDeleteVolumeIDs = []string{"vol-root", "vol-data"}
+
+	describeVolumesByID(ec2Mock.EXPECT(),
+		secureDeleteVolume("vol-root", ec2.VolumeStateInUse, "i-shredder"),
+		secureDeleteVolume("vol-data", ec2.VolumeStateInUse, "i-shredder"),
+	)
+	describeShredder(ec2Mock.EXPECT(), ec2.InstanceStateNameRunning)
+	ssmMock.EXPECT().ListCommands(gomock.Any()).
+		Return(&ssm.ListCommandsOutput{Commands: []*ssm.Command{{CommandId: aws.String("cmd-1"), Status: aws.String(ssm.CommandStatusTimedOut)}}}, nil)
+
+	done, err := NewService(clusterScope).SecureDeleteInstance(machineScope, &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateStopped})
+	if done || !IsVolumeWipeFailed(err) {
+		t.Fatalf("expected the volume wipe to fail, got done %v and err %v", done, err)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// securityGroupRuleThreshold is the number of ingress rule entries a managed security group holds before the
+	// next rules are moved to its overflow security group. It leaves some room under the default quota of 60
+	// inbound rules per security group for rules added out of band.
+	securityGroupRuleThreshold = 55
+
+	overflowRoleSuffix = "-overflow"
+)
+
+// overflowRole returns the role of the overflow security group of the given role.
+func overflowRole(role infrav1.SecurityGroupRole) infrav1.SecurityGroupRole {
+	return role + overflowRoleSuffix
+}
+
+// isOverflowRole returns whether the role is the role of an overflow security group.
+func isOverflowRole(role infrav1.SecurityGroupRole) bool {
+	return strings.HasSuffix(string(role), overflowRoleSuffix)
+}
+
+// ruleCount returns the number of entries an ingress rule counts for against the rule limit of a security group,
+// which is the number of its sources.
+func ruleCount(rule *infrav1.IngressRule) int {
+	if count := len(rule.CidrBlocks) + len(rule.SourceSecurityGroupIDs); count > 0 {
+		return count
+	}
+	return 1
+}
+
+// splitIngressRules splits the rules into the ones that fit within the rule threshold, in order, and the ones
+// that overflow it.
+func splitIngressRules(rules infrav1.IngressRules) (kept, overflow infrav1.IngressRules) {
+	count := 0
+	for i, rule := range rules {
+		count += ruleCount(rule)
+		if count > securityGroupRuleThreshold {
+			return rules[:i], rules[i:]
+		}
+	}
+	return rules, nil
+}
+
+// reconcileOverflowSecurityGroup authorizes the rules in the overflow security group of the role, which is created
+// when it is first needed. An overflow security group that is no longer needed is emptied but kept, as instances
+// may still be attached to it, and deleted along with the cluster.
+func (s *Service) reconcileOverflowSecurityGroup(role infrav1.SecurityGroupRole, existing map[string]infrav1.SecurityGroup, rules infrav1.IngressRules) error {
+	overflow := overflowRole(role)
+	sg, ok := existing[s.getSecurityGroupName(s.scope.Name(), overflow)]
+	if !ok {
+		if len(rules) == 0 {
+			delete(s.scope.SecurityGroups(), overflow)
+			return nil
+		}
+
+		input := s.getDefaultSecurityGroup(overflow)
+		if err := s.createSecurityGroup(overflow, input); err != nil {
+			return err
+		}
+		sg = infrav1.SecurityGroup{
+			ID:   aws.StringValue(input.GroupId),
+			Name: aws.StringValue(input.GroupName),
+		}
+		record.Warnf(s.scope.AWSCluster, "SecurityGroupOverflow", "Ingress rules of SecurityGroup %q exceed %d rules, moved %d of them to SecurityGroup %q",
+			s.scope.SecurityGroups()[role].ID, securityGroupRuleThreshold, len(rules), sg.ID)
+	}
+	s.scope.SecurityGroups()[overflow] = sg
+
+	return s.reconcileIngressRules(sg.ID, sg.IngressRules, rules)
+}
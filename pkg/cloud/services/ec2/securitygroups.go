@@ -19,6 +19,7 @@ package ec2
 import (
 	"fmt"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/cluster-api/util/conditions"
 
 	errlist "k8s.io/apimachinery/pkg/util/errors"
@@ -61,6 +62,19 @@ func (s *Service) reconcileSecurityGroups() error {
 		return err
 	}
 
+	overrides := s.scope.SecurityGroupOverrides()
+	overrideIDs := make([]string, 0, len(overrides))
+	for _, id := range overrides {
+		overrideIDs = append(overrideIDs, id)
+	}
+	var overrideGroups map[string]infrav1.SecurityGroup
+	if len(overrideIDs) > 0 {
+		overrideGroups, err = s.describeSecurityGroupsByID(overrideIDs)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Declare all security group roles that the reconcile loop takes care of.
 	roles := []infrav1.SecurityGroupRole{
 		infrav1.SecurityGroupBastion,
@@ -73,6 +87,17 @@ func (s *Service) reconcileSecurityGroups() error {
 	// First iteration makes sure that the security group are valid and fully created.
 	for i := range roles {
 		role := roles[i]
+
+		// Existing security groups are used as is for the roles they override.
+		if id, ok := overrides[role]; ok {
+			existing, ok := overrideGroups[id]
+			if !ok {
+				return errors.Errorf("security group %q overriding role %q not found", id, role)
+			}
+			s.scope.SecurityGroups()[role] = existing
+			continue
+		}
+
 		sg := s.getDefaultSecurityGroup(role)
 		existing, ok := sgs[*sg.GroupName]
 
@@ -107,54 +132,85 @@ func (s *Service) reconcileSecurityGroups() error {
 	}
 
 	// Second iteration creates or updates all permissions on the security group to match
-	// the specified ingress rules.
-	for i := range s.scope.SecurityGroups() {
-		sg := s.scope.SecurityGroups()[i]
+	// the specified ingress rules. Rules beyond the rule threshold of a security group are
+	// moved to its overflow security group.
+	overflowing := []string{}
+	for _, role := range roles {
+		sg := s.scope.SecurityGroups()[role]
 		if sg.Tags.HasAWSCloudProviderOwned(s.scope.Name()) {
 			// skip rule reconciliation, as we expect the in-cluster cloud integration to manage them
 			continue
 		}
-		current := sg.IngressRules
+		if _, ok := overrides[role]; ok {
+			// skip rule reconciliation, as the rules of existing security groups are managed by their owner
+			continue
+		}
 
-		want, err := s.getSecurityGroupIngressRules(i)
+		want, err := s.getSecurityGroupIngressRules(role)
 		if err != nil {
 			return err
 		}
+		want, overflow := splitIngressRules(want)
 
-		toRevoke := current.Difference(want)
-		if len(toRevoke) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.revokeSecurityGroupIngressRules(sg.ID, toRevoke); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return errors.Wrapf(err, "failed to revoke security group ingress rules for %q", sg.ID)
-			}
-
-			s.scope.V(2).Info("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", sg.ID)
+		if err := s.reconcileIngressRules(sg.ID, sg.IngressRules, want); err != nil {
+			return err
+		}
+		if err := s.reconcileOverflowSecurityGroup(role, sgs, overflow); err != nil {
+			return err
+		}
+		if len(overflow) > 0 {
+			overflowing = append(overflowing, sg.ID)
 		}
+	}
 
-		toAuthorize := want.Difference(current)
-		if len(toAuthorize) > 0 {
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				if err := s.authorizeSecurityGroupIngressRules(sg.ID, toAuthorize); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return err
+	if len(overflowing) > 0 {
+		conditions.MarkFalse(s.scope.AWSCluster, infrav1.SecurityGroupRulesWithinLimitCondition, infrav1.SecurityGroupOverflowReason, clusterv1.ConditionSeverityWarning,
+			"Ingress rules of security groups %v exceed %d rules and were moved to overflow security groups", overflowing, securityGroupRuleThreshold)
+	} else {
+		conditions.Delete(s.scope.AWSCluster, infrav1.SecurityGroupRulesWithinLimitCondition)
+	}
+	conditions.MarkTrue(s.scope.AWSCluster, infrav1.ClusterSecurityGroupsReadyCondition)
+	return nil
+}
+
+// reconcileIngressRules revokes the current ingress rules of the security group that are not wanted
+// and authorizes the missing ones.
+func (s *Service) reconcileIngressRules(id string, current, want infrav1.IngressRules) error {
+	toRevoke := current.Difference(want)
+	if len(toRevoke) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.revokeSecurityGroupIngressRules(id, toRevoke); err != nil {
+				return false, err
 			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to revoke security group ingress rules for %q", id)
+		}
+
+		s.scope.V(2).Info("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", id)
+	}
 
-			s.scope.V(2).Info("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
+	toAuthorize := want.Difference(current)
+	if len(toAuthorize) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.authorizeSecurityGroupIngressRules(id, toAuthorize); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return err
 		}
+
+		s.scope.V(2).Info("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", id)
 	}
-	conditions.MarkTrue(s.scope.AWSCluster, infrav1.ClusterSecurityGroupsReadyCondition)
 	return nil
 }
 
 func (s *Service) deleteSecurityGroups() error {
-	for _, sg := range s.scope.SecurityGroups() {
+	for role, sg := range s.scope.SecurityGroups() {
+		if s.isUnownedOverride(role, &sg) {
+			continue
+		}
 		current := sg.IngressRules
 
 		if err := s.revokeAllSecurityGroupIngressRules(sg.ID); awserrors.IsIgnorableSecurityGroupError(err) != nil {
@@ -166,6 +222,9 @@ func (s *Service) deleteSecurityGroups() error {
 
 	for i := range s.scope.SecurityGroups() {
 		sg := s.scope.SecurityGroups()[i]
+		if s.isUnownedOverride(i, &sg) {
+			continue
+		}
 		if err := s.deleteSecurityGroup(&sg, "managed"); err != nil {
 			return err
 		}
@@ -258,6 +317,38 @@ func (s *Service) describeSecurityGroupsByName() (map[string]infrav1.SecurityGro
 	return res, nil
 }
 
+// describeSecurityGroupsByID returns the security groups with the given IDs, keyed by ID.
+func (s *Service) describeSecurityGroupsByID(ids []string) (map[string]infrav1.SecurityGroup, error) {
+	out, err := s.scope.EC2.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(ids),
+	})
+	if code, _ := awserrors.Code(err); code == awserrors.GroupNotFound {
+		return nil, awserrors.NewNotFound(errors.Errorf("security groups %v not found: %v", ids, err))
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe security groups %v", ids)
+	}
+
+	res := make(map[string]infrav1.SecurityGroup, len(out.SecurityGroups))
+	for _, ec2sg := range out.SecurityGroups {
+		sg := makeInfraSecurityGroup(ec2sg)
+
+		for _, ec2rule := range ec2sg.IpPermissions {
+			sg.IngressRules = append(sg.IngressRules, ingressRuleFromSDKType(ec2rule))
+		}
+
+		res[sg.ID] = sg
+	}
+
+	return res, nil
+}
+
+// isUnownedOverride returns whether the security group overrides its role without being adopted by the cluster,
+// in which case it must be left as is.
+func (s *Service) isUnownedOverride(role infrav1.SecurityGroupRole, sg *infrav1.SecurityGroup) bool {
+	id, ok := s.scope.SecurityGroupOverrides()[role]
+	return ok && id == sg.ID && !sg.Tags.HasOwned(s.scope.Name())
+}
+
 func makeInfraSecurityGroup(ec2sg *ec2.SecurityGroup) infrav1.SecurityGroup {
 	return infrav1.SecurityGroup{
 		ID:   *ec2sg.GroupId,
@@ -365,6 +456,45 @@ func (s *Service) defaultSSHIngressRule(sourceSecurityGroupID string) *infrav1.I
 	}
 }
 
+// instanceConnectEndpointIngressRules allows SSH from the security groups of
+// the cluster's EC2 Instance Connect Endpoint, if any.
+func (s *Service) instanceConnectEndpointIngressRules() infrav1.IngressRules {
+	endpoint := s.scope.InstanceConnectEndpoint()
+	if endpoint == nil || len(endpoint.SecurityGroupIDs) == 0 {
+		return nil
+	}
+	return infrav1.IngressRules{
+		{
+			Description:            "SSH from EC2 Instance Connect Endpoint",
+			Protocol:               infrav1.SecurityGroupProtocolTCP,
+			FromPort:               22,
+			ToPort:                 22,
+			SourceSecurityGroupIDs: endpoint.SecurityGroupIDs,
+		},
+	}
+}
+
+// additionalAPIServerIngressRules opens the port of each additional control plane
+// endpoint, as their load balancers share the API server load balancer security group.
+func (s *Service) additionalAPIServerIngressRules() infrav1.IngressRules {
+	rules := infrav1.IngressRules{}
+	ports := map[int32]bool{s.scope.APIServerPort(): true}
+	for _, endpoint := range s.scope.AWSCluster.Spec.AdditionalControlPlaneEndpoints {
+		if ports[endpoint.Port] {
+			continue
+		}
+		ports[endpoint.Port] = true
+		rules = append(rules, &infrav1.IngressRule{
+			Description: fmt.Sprintf("Kubernetes API (additional endpoint on port %d)", endpoint.Port),
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    int64(endpoint.Port),
+			ToPort:      int64(endpoint.Port),
+			CidrBlocks:  []string{anyIPv4CidrBlock},
+		})
+	}
+	return rules
+}
+
 func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (infrav1.IngressRules, error) {
 	// Set source of CNI ingress rules to be control plane and node security groups
 	cniRules := make(infrav1.IngressRules, len(s.scope.CNIIngressRules()))
@@ -421,7 +551,7 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 				SourceSecurityGroupIDs: []string{s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID},
 			},
 		}
-		return append(cniRules, rules...), nil
+		return append(append(cniRules, rules...), s.instanceConnectEndpointIngressRules()...), nil
 
 	case infrav1.SecurityGroupNode:
 		rules := infrav1.IngressRules{
@@ -445,9 +575,9 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 				},
 			},
 		}
-		return append(cniRules, rules...), nil
+		return append(append(cniRules, rules...), s.instanceConnectEndpointIngressRules()...), nil
 	case infrav1.SecurityGroupAPIServerLB:
-		return infrav1.IngressRules{
+		rules := infrav1.IngressRules{
 			{
 				Description: "Kubernetes API",
 				Protocol:    infrav1.SecurityGroupProtocolTCP,
@@ -455,7 +585,8 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 				ToPort:      int64(s.scope.APIServerPort()),
 				CidrBlocks:  []string{anyIPv4CidrBlock},
 			},
-		}, nil
+		}
+		return append(rules, s.additionalAPIServerIngressRules()...), nil
 	case infrav1.SecurityGroupLB:
 		// We hand this group off to the in-cluster cloud provider, so these rules aren't used
 		return infrav1.IngressRules{}, nil
@@ -483,6 +614,9 @@ func (s *Service) getSecurityGroupTagParams(name, id string, role infrav1.Securi
 	if role == infrav1.SecurityGroupLB {
 		additional[infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())] = string(infrav1.ResourceLifecycleOwned)
 	}
+	if isOverflowRole(role) {
+		additional[infrav1.OverflowSecurityGroupTagKey] = "true"
+	}
 	return infrav1.BuildParams{
 		ClusterName: s.scope.Name(),
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
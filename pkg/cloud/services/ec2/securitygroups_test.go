@@ -303,6 +303,212 @@ func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	}
 }
 
+func TestAPIServerLBSecurityGroupOpensAdditionalEndpointPorts(t *testing.T) {
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				AdditionalControlPlaneEndpoints: []clusterv1.APIEndpoint{
+					{Port: 8443},
+					{Port: 9443},
+					{Port: 8443},
+					{Port: 6443},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	s := NewService(scope)
+	rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupAPIServerLB)
+	if err != nil {
+		t.Fatalf("Failed to lookup apiserver load balancer security group ingress rules: %v", err)
+	}
+
+	ports := map[int64]int{}
+	for _, r := range rules {
+		if r.FromPort != r.ToPort {
+			t.Fatalf("expected rule %q to open a single port, got %d-%d", r.Description, r.FromPort, r.ToPort)
+		}
+		ports[r.FromPort]++
+	}
+	expected := map[int64]int{6443: 1, 8443: 1, 9443: 1}
+	if !reflect.DeepEqual(ports, expected) {
+		t.Fatalf("expected one rule per port %v, got %v", expected, ports)
+	}
+}
+
+func TestSplitIngressRules(t *testing.T) {
+	rules := infrav1.IngressRules{}
+	for i := 0; i < 27; i++ {
+		rules = append(rules, &infrav1.IngressRule{
+			Description: fmt.Sprintf("rule %d", i),
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    int64(1000 + i),
+			ToPort:      int64(1000 + i),
+			CidrBlocks:  []string{"10.0.0.0/16", "10.1.0.0/16"},
+		})
+	}
+
+	kept, overflow := splitIngressRules(rules[:10])
+	if len(kept) != 10 || len(overflow) != 0 {
+		t.Fatalf("expected 10 rules to be kept and none to overflow, got %d and %d", len(kept), len(overflow))
+	}
+
+	// Each rule counts for its two CIDR blocks, so 27 rules fit within the threshold and the 28th overflows it.
+	kept, overflow = splitIngressRules(rules)
+	if len(kept) != 27 || len(overflow) != 0 {
+		t.Fatalf("expected 27 rules to be kept and none to overflow, got %d and %d", len(kept), len(overflow))
+	}
+	rules = append(rules, &infrav1.IngressRule{
+		Description:            "rule 27",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               2000,
+		ToPort:                 2000,
+		SourceSecurityGroupIDs: []string{"sg-1", "sg-2"},
+	})
+	kept, overflow = splitIngressRules(rules)
+	if len(kept) != 27 || len(overflow) != 1 || overflow[0].Description != "rule 27" {
+		t.Fatalf("expected the last rule to overflow, got %d rules kept and %v overflowing", len(kept), overflow)
+	}
+}
+
+func TestReconcileOverflowSecurityGroup(t *testing.T) {
+	overflowRules := infrav1.IngressRules{
+		{
+			Description: "rule 1",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    8080,
+			ToPort:      8080,
+			CidrBlocks:  []string{"10.0.0.0/16"},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		existing     map[string]infrav1.SecurityGroup
+		rules        infrav1.IngressRules
+		expect       func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantOverflow string
+	}{
+		{
+			name:  "creates the overflow security group of rules over the threshold",
+			rules: overflowRules,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				create := m.CreateSecurityGroup(gomock.Eq(&ec2.CreateSecurityGroupInput{
+					VpcId:       aws.String("vpc-securitygroups"),
+					GroupName:   aws.String("test-cluster-node-overflow"),
+					Description: aws.String("Kubernetes cluster test-cluster: node-overflow"),
+				})).
+					Return(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-node-overflow")}, nil)
+
+				m.CreateTags(matchesTags(&ec2.CreateTagsInput{
+					Resources: []*string{aws.String("sg-node-overflow")},
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Value: aws.String("owned"),
+						}, {
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+							Value: aws.String("node-overflow"),
+						}, {
+							Key:   aws.String("Name"),
+							Value: aws.String("test-cluster-node-overflow"),
+						}, {
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/overflow-sg"),
+							Value: aws.String("true"),
+						},
+					},
+				})).
+					Return(nil, nil).
+					After(create)
+
+				m.AuthorizeSecurityGroupIngress(gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{})).
+					DoAndReturn(func(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+						if aws.StringValue(input.GroupId) != "sg-node-overflow" || len(input.IpPermissions) != 1 {
+							t.Fatalf("expected one rule to be authorized in the overflow security group, got %v", input)
+						}
+						return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+					}).
+					After(create)
+			},
+			wantOverflow: "sg-node-overflow",
+		},
+		{
+			name: "empties the existing overflow security group once no longer needed",
+			existing: map[string]infrav1.SecurityGroup{
+				"test-cluster-node-overflow": {
+					ID:           "sg-node-overflow",
+					Name:         "test-cluster-node-overflow",
+					IngressRules: overflowRules,
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupIngress(gomock.AssignableToTypeOf(&ec2.RevokeSecurityGroupIngressInput{})).
+					DoAndReturn(func(input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+						if aws.StringValue(input.GroupId) != "sg-node-overflow" || len(input.IpPermissions) != 1 {
+							t.Fatalf("expected one rule to be revoked from the overflow security group, got %v", input)
+						}
+						return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+					})
+			},
+			wantOverflow: "sg-node-overflow",
+		},
+		{
+			name:   "does nothing without rules over the threshold",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{ID: "vpc-securitygroups"},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.Network{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupNode: {ID: "sg-node", Name: "test-cluster-node"},
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			if err := s.reconcileOverflowSecurityGroup(infrav1.SecurityGroupNode, tc.existing, tc.rules); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if got := scope.SecurityGroups()[overflowRole(infrav1.SecurityGroupNode)].ID; got != tc.wantOverflow {
+				t.Fatalf("expected overflow security group %q, got %q", tc.wantOverflow, got)
+			}
+		})
+	}
+}
+
 func matchesTags(input *ec2.CreateTagsInput) gomock.Matcher {
 	return tagMatcher{input}
 }
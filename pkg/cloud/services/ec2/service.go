@@ -17,6 +17,8 @@ limitations under the License.
 package ec2
 
 import (
+	"sync"
+
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 )
 
@@ -25,11 +27,28 @@ import (
 // One alternative is to have a large list of functions from the ec2 client.
 type Service struct {
 	scope *scope.ClusterScope
+
+	// ssmAMICache holds the AMI IDs resolved from SSM parameters, keyed by parameter path.
+	ssmAMICache map[string]string
+
+	// amiCopier copies the AMIs of machines that don't exist in the region of the cluster, if set.
+	amiCopier *AMICopier
+
+	// conditionsLock serializes the updates of the conditions of the AWSCluster by the
+	// network steps run concurrently by the ParallelNetworkReconciler.
+	conditionsLock sync.Mutex
 }
 
 // NewService returns a new service given the ec2 api client.
 func NewService(scope *scope.ClusterScope) *Service {
 	return &Service{
-		scope: scope,
+		scope:       scope,
+		ssmAMICache: map[string]string{},
 	}
 }
+
+// WithAMICopier sets the AMICopier used to copy the AMIs of machines published in another region.
+func (s *Service) WithAMICopier(copier *AMICopier) *Service {
+	s.amiCopier = copier
+	return s
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// cloud-init prints the fingerprints and the public keys of the SSH host keys of an instance to its
+// console between these markers, the fingerprints being prefixed with "ec2: ".
+const (
+	sshHostKeyFingerprintsBegin = "-----BEGIN SSH HOST KEY FINGERPRINTS-----"
+	sshHostKeyFingerprintsEnd   = "-----END SSH HOST KEY FINGERPRINTS-----"
+	sshHostKeysBegin            = "-----BEGIN SSH HOST KEY KEYS-----"
+	sshHostKeysEnd              = "-----END SSH HOST KEY KEYS-----"
+)
+
+// SSHHostKeyMismatchError is returned when an SSH host key fingerprint printed to the console of an
+// instance does not match any of the SSH host keys printed along with it.
+type SSHHostKeyMismatchError struct {
+	InstanceID  string
+	Fingerprint string
+}
+
+func (e *SSHHostKeyMismatchError) Error() string {
+	return fmt.Sprintf("SSH host key fingerprint %s of instance %q does not match any of its SSH host keys", e.Fingerprint, e.InstanceID)
+}
+
+// IsSSHHostKeyMismatch returns true if the error is an SSHHostKeyMismatchError.
+func IsSSHHostKeyMismatch(err error) bool {
+	_, ok := errors.Cause(err).(*SSHHostKeyMismatchError)
+	return ok
+}
+
+// VerifyInstanceSSHKey verifies the SSH host key fingerprints printed to the console of the instance
+// against the fingerprints computed from its SSH host keys, and returns true once they all match.
+// It returns false without an error while the console output does not hold them yet, and an
+// SSHHostKeyMismatchError when a fingerprint does not match.
+func (s *Service) VerifyInstanceSSHKey(instanceID string) (bool, error) {
+	output, err := s.GetConsoleOutput(instanceID)
+	if err != nil {
+		return false, err
+	}
+
+	fingerprints, keys := parseSSHHostKeys(output)
+	if len(fingerprints) == 0 || len(keys) == 0 {
+		s.scope.V(2).Info("Console output does not hold the SSH host keys yet, skipping SSH key verification", "instance-id", instanceID)
+		return false, nil
+	}
+
+	known := map[string]bool{}
+	for _, line := range keys {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to parse SSH host key %q of instance %q", line, instanceID)
+		}
+		known[ssh.FingerprintSHA256(key)] = true
+		known[ssh.FingerprintLegacyMD5(key)] = true
+	}
+
+	for _, fingerprint := range fingerprints {
+		if !known[fingerprint] {
+			return false, &SSHHostKeyMismatchError{InstanceID: instanceID, Fingerprint: fingerprint}
+		}
+	}
+
+	return true, nil
+}
+
+// parseSSHHostKeys returns the SSH host key fingerprints and the public keys of the SSH host keys
+// printed to a console output. Fingerprints are printed as "<bits> <fingerprint> <comment> (<type>)",
+// MD5 fingerprints optionally being prefixed with "MD5:". Blocks which have not been printed up to
+// their end marker yet are ignored.
+func parseSSHHostKeys(output string) (fingerprints, keys []string) {
+	var inFingerprints, inKeys bool
+	var pendingFingerprints, pendingKeys []string
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimSpace(strings.TrimPrefix(line, "ec2:"))
+		switch {
+		case strings.HasSuffix(line, sshHostKeyFingerprintsBegin):
+			inFingerprints, pendingFingerprints = true, nil
+		case strings.HasSuffix(line, sshHostKeyFingerprintsEnd):
+			inFingerprints, fingerprints = false, pendingFingerprints
+		case strings.HasSuffix(line, sshHostKeysBegin):
+			inKeys, pendingKeys = true, nil
+		case strings.HasSuffix(line, sshHostKeysEnd):
+			inKeys, keys = false, pendingKeys
+		case inFingerprints:
+			if fields := strings.Fields(line); len(fields) >= 2 {
+				pendingFingerprints = append(pendingFingerprints, strings.TrimPrefix(fields[1], "MD5:"))
+			}
+		case inKeys && line != "":
+			pendingKeys = append(pendingKeys, line)
+		}
+	}
+	return fingerprints, keys
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+const testSSHHostKeysConsoleOutput = `[   42.113412] cloud-init[1024]: Cloud-init v. 20.2 running 'modules:final'
+ec2:
+ec2: #############################################################
+ec2: -----BEGIN SSH HOST KEY FINGERPRINTS-----
+ec2: 256 SHA256:wtC4gLXbbWRX9txYlh9q3uxX/bIjKY43ukxTirIOWhs root@ip-10-0-0-12 (ECDSA)
+ec2: 256 SHA256:8shoPVQyI/PGA1B84gNbH04Q9tQmH/CtBFQzKudh/Xo root@ip-10-0-0-12 (ED25519)
+ec2: -----END SSH HOST KEY FINGERPRINTS-----
+ec2: #############################################################
+-----BEGIN SSH HOST KEY KEYS-----
+ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBPxl/fJ/TWo7rk2GbMIFeku1gZ46hywwoQfNtwO0o8jKMBatQE+JOADVpEMZyvxS1w+nE3YDfGFioWFRIwf1ObA= root@ip-10-0-0-12
+ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIP7iS/8hrS2dKDD0EqtqdQhxJ+nklNA8mkQYPcuA+ba/ root@ip-10-0-0-12
+-----END SSH HOST KEY KEYS-----
+[   42.301207] cloud-init[1024]: Cloud-init v. 20.2 finished
+`
+
+func TestVerifyInstanceSSHKey(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	consoleOutput := func(m *mock_ec2iface.MockEC2APIMockRecorder, output string) {
+		m.GetConsoleOutput(gomock.Eq(&ec2.GetConsoleOutputInput{
+			InstanceId: aws.String("i-ssh"),
+			Latest:     aws.Bool(true),
+		})).
+			Return(&ec2.GetConsoleOutputOutput{Output: aws.String(base64.StdEncoding.EncodeToString([]byte(output)))}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		expect       func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantVerified bool
+		wantErr      bool
+		wantMismatch bool
+	}{
+		{
+			name: "fingerprints match the host keys",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				consoleOutput(m, testSSHHostKeysConsoleOutput)
+			},
+			wantVerified: true,
+		},
+		{
+			name: "MD5 fingerprints match the host keys",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				consoleOutput(m, strings.Replace(testSSHHostKeysConsoleOutput,
+					"SHA256:8shoPVQyI/PGA1B84gNbH04Q9tQmH/CtBFQzKudh/Xo", "MD5:ac:54:0a:e9:0f:f0:64:1b:4c:7d:e9:d4:c0:11:57:f1", 1))
+			},
+			wantVerified: true,
+		},
+		{
+			name: "fingerprint does not match the host keys",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				consoleOutput(m, strings.Replace(testSSHHostKeysConsoleOutput,
+					"SHA256:8shoPVQyI/PGA1B84gNbH04Q9tQmH/CtBFQzKudh/Xo", "SHA256:9tipQWRzJ/QHB2C95hOcI15R0uRnI/DuCFRaLveh/Yp", 1))
+			},
+			wantErr:      true,
+			wantMismatch: true,
+		},
+		{
+			name: "host keys have not been printed yet",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				consoleOutput(m, testSSHHostKeysConsoleOutput[:strings.Index(testSSHHostKeysConsoleOutput, sshHostKeysEnd)])
+			},
+		},
+		{
+			name: "console output is empty",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				consoleOutput(m, "")
+			},
+		},
+		{
+			name: "console output cannot be retrieved",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.GetConsoleOutput(gomock.Any()).Return(nil, errors.New("UnauthorizedOperation"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			verified, err := s.VerifyInstanceSSHKey("i-ssh")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("VerifyInstanceSSHKey() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if IsSSHHostKeyMismatch(err) != tc.wantMismatch {
+				t.Fatalf("expected mismatch to be %v, got error %v", tc.wantMismatch, err)
+			}
+			if verified != tc.wantVerified {
+				t.Fatalf("expected verified to be %v, got %v", tc.wantVerified, verified)
+			}
+		})
+	}
+}
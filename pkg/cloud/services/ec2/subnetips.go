@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// defaultMinAvailableIPs is the minimum number of available IP addresses the subnet of an instance
+// must have, when its AWSMachine does not set one.
+const defaultMinAvailableIPs = 10
+
+// errSubnetFull is returned by CreateInstance when no subnet the instance can be launched in has
+// enough available IP addresses.
+var errSubnetFull = errors.New("subnet does not have enough available IP addresses")
+
+// IsSubnetFull returns true if the error was returned by CreateInstance because the subnets the
+// instance can be launched in do not have enough available IP addresses.
+func IsSubnetFull(err error) bool {
+	return errors.Cause(err) == errSubnetFull
+}
+
+// selectSubnetWithAvailableIPs returns the given subnet if it has enough available IP addresses
+// for the instance, or else the first other private subnet of the cluster in the same availability
+// zone which has. Subnets set in the AWSMachine spec are never replaced. The subnets of the Outpost
+// of the machine, if any, are tried first.
+func (s *Service) selectSubnetWithAvailableIPs(scope *scope.MachineScope, subnetID string) (string, error) {
+	minAvailableIPs := int64(defaultMinAvailableIPs)
+	if scope.AWSMachine.Spec.MinAvailableIPs != nil {
+		minAvailableIPs = *scope.AWSMachine.Spec.MinAvailableIPs
+	}
+	outpostARN := scope.AWSMachine.Spec.OutpostARN
+	if minAvailableIPs == 0 && outpostARN == "" {
+		return subnetID, nil
+	}
+
+	candidates := []string{subnetID}
+	if spec := scope.AWSMachine.Spec.Subnet; spec == nil || spec.ID == nil {
+		if subnet := s.scope.Subnets().FindByID(subnetID); subnet != nil {
+			for _, sn := range s.scope.Subnets().FilterPrivate().FilterByZone(subnet.AvailabilityZone) {
+				if sn.ID != subnetID {
+					candidates = append(candidates, sn.ID)
+				}
+			}
+		}
+	}
+
+	// The subnets are described one by one, so that the lookups of the machines reconciled at once are shared.
+	available := map[string]int64{}
+	outposts := map[string]string{}
+	for _, id := range candidates {
+		sn, err := s.DescribeSubnetsCached(id)
+		if err != nil {
+			return "", err
+		}
+		available[id] = aws.Int64Value(sn.AvailableIpAddressCount)
+		outposts[id] = aws.StringValue(sn.OutpostArn)
+	}
+
+	if outpostARN != "" {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return outposts[candidates[i]] == outpostARN && outposts[candidates[j]] != outpostARN
+		})
+	}
+
+	for _, id := range candidates {
+		if available[id] >= minAvailableIPs {
+			if outpostARN != "" && outposts[id] == outpostARN {
+				scope.V(2).Info("Using subnet of the Outpost", "subnet-id", id, "outpost-arn", outpostARN)
+			}
+			if id != subnetID && available[subnetID] < minAvailableIPs {
+				record.Eventf(scope.AWSMachine, "SubnetFallback",
+					"Subnet %q has fewer than %d available IP addresses, using %q instead", subnetID, minAvailableIPs, id)
+			}
+			return id, nil
+		}
+	}
+
+	record.Warnf(scope.AWSMachine, "SubnetFull", "Subnets %v have fewer than %d available IP addresses", candidates, minAvailableIPs)
+	return "", errors.Wrapf(errSubnetFull, "subnets %v have fewer than %d available IP addresses", candidates, minAvailableIPs)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testOutpostARN = "arn:aws:outposts:us-east-1:111111111111:outpost/op-1"
+
+// expectAvailableIPs expects each of the given subnets to be described once, and returns them
+// with the given number of available IP addresses and Outpost.
+func expectAvailableIPs(m *mock_ec2iface.MockEC2APIMockRecorder, counts map[string]int64, outposts map[string]string) {
+	for id, count := range counts {
+		subnet := &ec2.Subnet{SubnetId: aws.String(id), AvailableIpAddressCount: aws.Int64(count)}
+		if outpost, ok := outposts[id]; ok {
+			subnet.OutpostArn = aws.String(outpost)
+		}
+		m.DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{
+			SubnetIds: aws.StringSlice([]string{id}),
+		})).Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{subnet}}, nil)
+	}
+}
+
+func TestSelectSubnetWithAvailableIPs(t *testing.T) {
+	testCases := []struct {
+		name      string
+		spec      infrav1.AWSMachineSpec
+		subnetID  string
+		expect    func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "subnet has available IP addresses",
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 10, "subnet-2": 200}, nil)
+			},
+			expected: "subnet-1",
+		},
+		{
+			name:     "fall back to the next subnet of the availability zone",
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 9, "subnet-2": 200}, nil)
+			},
+			expected: "subnet-2",
+		},
+		{
+			name:     "all subnets of the availability zone are full",
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 0, "subnet-2": 3}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:     "subnets of the spec are not replaced",
+			spec:     infrav1.AWSMachineSpec{Subnet: &infrav1.AWSResourceReference{ID: aws.String("subnet-1")}},
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 5}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:     "minimum of the spec",
+			spec:     infrav1.AWSMachineSpec{MinAvailableIPs: aws.Int64(5)},
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 5, "subnet-2": 200}, nil)
+			},
+			expected: "subnet-1",
+		},
+		{
+			name:     "check disabled",
+			spec:     infrav1.AWSMachineSpec{MinAvailableIPs: aws.Int64(0)},
+			subnetID: "subnet-1",
+			expected: "subnet-1",
+		},
+		{
+			name:     "subnets of the outpost are preferred",
+			spec:     infrav1.AWSMachineSpec{OutpostARN: testOutpostARN},
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 200, "subnet-2": 200}, map[string]string{"subnet-2": testOutpostARN})
+			},
+			expected: "subnet-2",
+		},
+		{
+			name:     "full subnets of the outpost fall back to the other subnets",
+			spec:     infrav1.AWSMachineSpec{OutpostARN: testOutpostARN},
+			subnetID: "subnet-1",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectAvailableIPs(m, map[string]int64{"subnet-1": 200, "subnet-2": 3}, map[string]string{"subnet-2": testOutpostARN})
+			},
+			expected: "subnet-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{
+							{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-2", AvailabilityZone: "us-east-1a"},
+							{ID: "subnet-public", AvailabilityZone: "us-east-1a", IsPublic: true},
+							{ID: "subnet-3", AvailabilityZone: "us-east-1b"},
+						},
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: awsCluster,
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:     fake.NewFakeClient(),
+				Cluster:    &clusterv1.Cluster{},
+				Machine:    &clusterv1.Machine{},
+				AWSCluster: awsCluster,
+				AWSMachine: &infrav1.AWSMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       tc.spec,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			subnetID, err := NewService(clusterScope).selectSubnetWithAvailableIPs(machineScope, tc.subnetID)
+			if tc.expectErr {
+				if !IsSubnetFull(err) {
+					t.Fatalf("expected a subnet full error, got subnet %q and error %v", subnetID, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+			if subnetID != tc.expected {
+				t.Fatalf("expected subnet %q, got %q", tc.expected, subnetID)
+			}
+		})
+	}
+}
@@ -22,8 +22,6 @@ import (
 	"sort"
 	"strings"
 
-	"sigs.k8s.io/cluster-api/util/conditions"
-
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
@@ -31,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
@@ -132,7 +131,7 @@ func (s *Service) reconcileSubnets() error {
 	}
 
 	s.scope.V(2).Info("Subnets available", "subnets", subnets)
-	conditions.MarkTrue(s.scope.AWSCluster, infrav1.SubnetsReadyCondition)
+	s.markConditionTrue(infrav1.SubnetsReadyCondition)
 	return nil
 }
 
@@ -286,6 +285,36 @@ func (s *Service) describeVpcSubnets() (infrav1.Subnets, error) {
 	return subnets, nil
 }
 
+// describeSubnetGroup collapses concurrent DescribeSubnets calls for the same subnet,
+// which happen when many machines in the same subnet are reconciled at once.
+var describeSubnetGroup singleflight.Group
+
+// DescribeSubnetsCached returns the subnet with the given ID. Concurrent calls for the same
+// subnet with the same identity are collapsed into a single DescribeSubnets request whose
+// result is shared.
+func (s *Service) DescribeSubnetsCached(subnetID string) (*ec2.Subnet, error) {
+	key := fmt.Sprintf("%s/%s/%s", s.scope.RoleARN(), s.scope.Region(), subnetID)
+	v, err, _ := describeSubnetGroup.Do(key, func() (interface{}, error) {
+		out, err := s.scope.EC2.DescribeSubnets(&ec2.DescribeSubnetsInput{
+			SubnetIds: []*string{aws.String(subnetID)},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe subnet %q", subnetID)
+		}
+		if len(out.Subnets) == 0 {
+			return nil, awserrors.NewNotFound(errors.Errorf("subnet %q not found", subnetID))
+		}
+		return out.Subnets[0], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a copy, as the result is shared between all callers.
+	subnet := *v.(*ec2.Subnet)
+	return &subnet, nil
+}
+
 func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, error) {
 	out, err := s.scope.EC2.CreateSubnet(&ec2.CreateSubnetInput{
 		VpcId:            aws.String(s.scope.VPC().ID),
@@ -20,11 +20,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
@@ -1355,3 +1358,116 @@ func TestDiscoverSubnets(t *testing.T) {
 		})
 	}
 }
+
+func TestDescribeSubnetsCached(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	release := make(chan struct{})
+	ec2Mock.EXPECT().DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{
+		SubnetIds: []*string{aws.String("subnet-1")},
+	})).
+		DoAndReturn(func(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+			<-release
+			return &ec2.DescribeSubnetsOutput{
+				Subnets: []*ec2.Subnet{
+					{
+						SubnetId:         aws.String("subnet-1"),
+						AvailabilityZone: aws.String("us-east-1a"),
+					},
+				},
+			}, nil
+		}).Times(1)
+
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	const callers = 50
+	var started, done sync.WaitGroup
+	started.Add(callers)
+	done.Add(callers)
+	errs := make(chan error, callers)
+
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer done.Done()
+			s := NewService(scope)
+			started.Done()
+			subnet, err := s.DescribeSubnetsCached("subnet-1")
+			if err != nil {
+				errs <- err
+				return
+			}
+			if aws.StringValue(subnet.SubnetId) != "subnet-1" {
+				errs <- errors.Errorf("expected subnet-1, got %q", aws.StringValue(subnet.SubnetId))
+			}
+		}()
+	}
+
+	// Give every caller the chance to join the in-flight request before releasing it.
+	started.Wait()
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	done.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("got an unexpected error: %v", err)
+	}
+}
+
+func TestDescribeSubnetsCachedPerIdentity(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	release := make(chan struct{})
+	ec2Mock.EXPECT().DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{
+		SubnetIds: []*string{aws.String("subnet-1")},
+	})).
+		DoAndReturn(func(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+			<-release
+			return &ec2.DescribeSubnetsOutput{
+				Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-1")}},
+			}, nil
+		}).Times(2)
+
+	var done sync.WaitGroup
+	for _, roleARN := range []string{"arn:aws:iam::111111111111:role/capa", "arn:aws:iam::222222222222:role/capa"} {
+		scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Cluster:    &clusterv1.Cluster{},
+			AWSCluster: &infrav1.AWSCluster{},
+			AWSClients: scope.AWSClients{
+				EC2: ec2Mock,
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create test context: %v", err)
+		}
+		scope.AWSCluster.Spec.RoleARN = roleARN
+
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			if _, err := NewService(scope).DescribeSubnetsCached("subnet-1"); err != nil {
+				t.Errorf("got an unexpected error: %v", err)
+			}
+		}()
+	}
+
+	// Keep both requests in flight at once, so that they would be collapsed if they shared a key.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	done.Wait()
+}
@@ -72,6 +72,7 @@ func (s *Service) reconcileVPC() error {
 	// restored here, but that's ok. It is restored by reconcileInternetGateways, which is invoked after this.
 	vpc.AvailabilityZoneSelection = s.scope.VPC().AvailabilityZoneSelection
 	vpc.AvailabilityZoneUsageLimit = s.scope.VPC().AvailabilityZoneUsageLimit
+	vpc.Tenancy = s.scope.VPC().Tenancy
 
 	if vpc.IsUnmanaged(s.scope.Name()) {
 		vpc.DeepCopyInto(s.scope.VPC())
@@ -178,6 +179,10 @@ func (s *Service) createVPC() (*infrav1.VPCSpec, error) {
 		CidrBlock: aws.String(s.scope.VPC().CidrBlock),
 	}
 
+	if s.scope.VPC().Tenancy == ec2.TenancyDedicated {
+		input.InstanceTenancy = aws.String(ec2.TenancyDedicated)
+	}
+
 	out, err := s.scope.EC2.CreateVpc(input)
 	if err != nil {
 		record.Warnf(s.scope.AWSCluster, "FailedCreateVPC", "Failed to create new managed VPC: %v", err)
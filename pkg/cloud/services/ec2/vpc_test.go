@@ -156,7 +156,66 @@ func TestReconcileVPC(t *testing.T) {
 				})).
 					Return(&ec2.DescribeVpcsOutput{}, nil)
 
-				m.CreateVpc(gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).
+				m.CreateVpc(gomock.Eq(&ec2.CreateVpcInput{
+					CidrBlock: aws.String("10.0.0.0/16"),
+				})).
+					Return(&ec2.CreateVpcOutput{
+						Vpc: &ec2.Vpc{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-new"),
+							CidrBlock: aws.String("10.1.0.0/16"),
+						},
+					}, nil)
+
+				m.DescribeVpcAttribute(gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeFalse).MinTimes(1)
+
+				m.ModifyVpcAttribute(gomock.AssignableToTypeOf(&ec2.ModifyVpcAttributeInput{})).
+					Return(&ec2.ModifyVpcAttributeOutput{}, nil).Times(2)
+
+				m.WaitUntilVpcAvailable(gomock.Eq(&ec2.DescribeVpcsInput{
+					VpcIds: []*string{aws.String("vpc-new")},
+				})).
+					Return(nil)
+
+				m.CreateTags(gomock.AssignableToTypeOf(&ec2.CreateTagsInput{})).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:  "managed vpc does not exist with dedicated tenancy",
+			input: &infrav1.VPCSpec{AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection, Tenancy: "dedicated"},
+			expected: &infrav1.VPCSpec{
+				ID:        "vpc-new",
+				CidrBlock: "10.1.0.0/16",
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "test-cluster-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+				Tenancy:                    "dedicated",
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVpcs(gomock.Eq(&ec2.DescribeVpcsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{ec2.VpcStatePending, ec2.VpcStateAvailable}),
+						},
+						{
+							Name:   aws.String("tag-key"),
+							Values: aws.StringSlice([]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"}),
+						},
+					},
+				})).
+					Return(&ec2.DescribeVpcsOutput{}, nil)
+
+				m.CreateVpc(gomock.Eq(&ec2.CreateVpcInput{
+					CidrBlock:       aws.String("10.0.0.0/16"),
+					InstanceTenancy: aws.String("dedicated"),
+				})).
 					Return(&ec2.CreateVpcOutput{
 						Vpc: &ec2.Vpc{
 							State:     aws.String("available"),
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// reconcileVPCEndpointPolicies applies the endpoint policies of the cluster to the VPC endpoints of its VPC,
+// by service name. A policy is checked against the IAM policy simulator before being applied.
+func (s *Service) reconcileVPCEndpointPolicies() error {
+	spec := s.scope.VPCEndpoints()
+	if spec == nil || len(spec.EndpointPolicies) == 0 {
+		conditions.Delete(s.scope.AWSCluster, infrav1.VPCEndpointPoliciesReadyCondition)
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling VPC endpoint policies")
+
+	for _, policy := range spec.EndpointPolicies {
+		endpoints, err := s.describeVPCEndpoints(policy.ServiceName)
+		if err != nil {
+			return err
+		}
+
+		validated := false
+		for _, endpoint := range endpoints {
+			if sameEndpointPolicy(aws.StringValue(endpoint.PolicyDocument), policy.PolicyDocument) {
+				continue
+			}
+
+			if !validated {
+				if err := s.validateEndpointPolicy(policy); err != nil {
+					return err
+				}
+				validated = true
+			}
+
+			if err := s.modifyVPCEndpointPolicy(aws.StringValue(endpoint.VpcEndpointId), policy); err != nil {
+				return err
+			}
+		}
+	}
+
+	conditions.MarkTrue(s.scope.AWSCluster, infrav1.VPCEndpointPoliciesReadyCondition)
+	return nil
+}
+
+// describeVPCEndpoints returns the pending and available VPC endpoints of the service in the cluster's VPC.
+func (s *Service) describeVPCEndpoints(serviceName string) ([]*ec2.VpcEndpoint, error) {
+	input := &ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ServiceName(serviceName),
+			filter.EC2.VPCEndpointStates("pendingAcceptance", "pending", "available"),
+		},
+	}
+
+	var endpoints []*ec2.VpcEndpoint
+	err := s.scope.EC2.DescribeVpcEndpointsPages(input, func(page *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+		endpoints = append(endpoints, page.VpcEndpoints...)
+		return !lastPage
+	})
+	if err != nil {
+		record.Eventf(s.scope.AWSCluster, "FailedDescribeVPCEndpoints", "Failed to describe VPC endpoints of service %q in VPC %q: %v", serviceName, s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe VPC endpoints of service %q in VPC %q", serviceName, s.scope.VPC().ID)
+	}
+
+	return endpoints, nil
+}
+
+func (s *Service) modifyVPCEndpointPolicy(id string, policy infrav1.EndpointPolicySpec) error {
+	if _, err := s.scope.EC2.ModifyVpcEndpoint(&ec2.ModifyVpcEndpointInput{
+		VpcEndpointId:  aws.String(id),
+		PolicyDocument: aws.String(policy.PolicyDocument),
+	}); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedModifyVPCEndpointPolicy", "Failed to apply policy to VPC endpoint %q: %v", id, err)
+		return errors.Wrapf(err, "failed to apply policy to VPC endpoint %q", id)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulModifyVPCEndpointPolicy", "Applied policy to VPC endpoint %q of service %q", id, policy.ServiceName)
+	return nil
+}
+
+// validateEndpointPolicy runs the statements of the policy through the IAM policy simulator, which rejects
+// malformed policies. Simulating a resource policy requires an IAM user as the caller, so the principals of the
+// statements are left out and the policy is simulated as an identity policy instead.
+func (s *Service) validateEndpointPolicy(policy infrav1.EndpointPolicySpec) error {
+	document, actions, err := simulatedEndpointPolicy(policy.PolicyDocument)
+	if err != nil {
+		return errors.Wrapf(err, "invalid policy of VPC endpoints of service %q", policy.ServiceName)
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+
+	if _, err := s.scope.IAM.SimulateCustomPolicy(&iam.SimulateCustomPolicyInput{
+		PolicyInputList: aws.StringSlice([]string{document}),
+		ActionNames:     aws.StringSlice(actions),
+	}); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedValidateVPCEndpointPolicy", "Policy of VPC endpoints of service %q failed simulation: %v", policy.ServiceName, err)
+		return errors.Wrapf(err, "failed to simulate policy of VPC endpoints of service %q", policy.ServiceName)
+	}
+
+	return nil
+}
+
+// simulatedEndpointPolicy returns the endpoint policy without the principals of its statements, along with the
+// actions it refers to.
+func simulatedEndpointPolicy(document string) (string, []string, error) {
+	policy := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return "", nil, err
+	}
+
+	statements, ok := policy["Statement"].([]interface{})
+	if !ok {
+		statements = []interface{}{policy["Statement"]}
+	}
+
+	var actions []string
+	for _, statement := range statements {
+		statement, ok := statement.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		delete(statement, "Principal")
+		delete(statement, "NotPrincipal")
+
+		switch action := statement["Action"].(type) {
+		case string:
+			actions = append(actions, action)
+		case []interface{}:
+			for _, a := range action {
+				if a, ok := a.(string); ok {
+					actions = append(actions, a)
+				}
+			}
+		}
+	}
+
+	out, err := json.Marshal(policy)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(out), actions, nil
+}
+
+// sameEndpointPolicy returns whether both JSON policy documents are equivalent.
+func sameEndpointPolicy(a, b string) bool {
+	var docA, docB interface{}
+	if err := json.Unmarshal([]byte(a), &docA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &docB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(docA, docB)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam/mock_iamiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const s3EndpointPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`
+
+func expectDescribeVPCEndpoints(m *mock_ec2iface.MockEC2APIMockRecorder, serviceName string, endpoints ...*ec2.VpcEndpoint) {
+	m.DescribeVpcEndpointsPages(gomock.Eq(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{"vpc-endpoints"})},
+			{Name: aws.String("service-name"), Values: aws.StringSlice([]string{serviceName})},
+			{Name: aws.String("vpc-endpoint-state"), Values: aws.StringSlice([]string{"pendingAcceptance", "pending", "available"})},
+		},
+	}), gomock.Any()).
+		Do(func(_ *ec2.DescribeVpcEndpointsInput, fn func(*ec2.DescribeVpcEndpointsOutput, bool) bool) {
+			fn(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: endpoints}, true)
+		}).
+		Return(nil)
+}
+
+func TestReconcileVPCEndpointPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name      string
+		spec      *infrav1.VPCEndpointsSpec
+		expectEC2 func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectIAM func(m *mock_iamiface.MockIAMAPIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:      "no vpc endpoint policies configured",
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {},
+		},
+		{
+			name: "applies the policy to the endpoints of the service",
+			spec: &infrav1.VPCEndpointsSpec{
+				EndpointPolicies: []infrav1.EndpointPolicySpec{
+					{ServiceName: "com.amazonaws.us-east-1.s3", PolicyDocument: s3EndpointPolicy},
+				},
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeVPCEndpoints(m, "com.amazonaws.us-east-1.s3",
+					&ec2.VpcEndpoint{VpcEndpointId: aws.String("vpce-1"), PolicyDocument: aws.String(`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`)},
+					&ec2.VpcEndpoint{VpcEndpointId: aws.String("vpce-2")},
+				)
+				m.ModifyVpcEndpoint(gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:  aws.String("vpce-1"),
+					PolicyDocument: aws.String(s3EndpointPolicy),
+				})).Return(&ec2.ModifyVpcEndpointOutput{Return: aws.Bool(true)}, nil)
+				m.ModifyVpcEndpoint(gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:  aws.String("vpce-2"),
+					PolicyDocument: aws.String(s3EndpointPolicy),
+				})).Return(&ec2.ModifyVpcEndpointOutput{Return: aws.Bool(true)}, nil)
+			},
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.SimulateCustomPolicy(gomock.AssignableToTypeOf(&iam.SimulateCustomPolicyInput{})).
+					DoAndReturn(func(input *iam.SimulateCustomPolicyInput) (*iam.SimulatePolicyResponse, error) {
+						if got := aws.StringValueSlice(input.ActionNames); len(got) != 2 || got[0] != "s3:GetObject" || got[1] != "s3:ListBucket" {
+							t.Fatalf("expected the actions of the policy to be simulated, got %v", got)
+						}
+						if strings.Contains(aws.StringValue(input.PolicyInputList[0]), "Principal") {
+							t.Fatalf("expected the principals to be left out of the simulated policy, got %s", aws.StringValue(input.PolicyInputList[0]))
+						}
+						return &iam.SimulatePolicyResponse{}, nil
+					})
+			},
+		},
+		{
+			name: "endpoint with an equivalent policy",
+			spec: &infrav1.VPCEndpointsSpec{
+				EndpointPolicies: []infrav1.EndpointPolicySpec{
+					{ServiceName: "com.amazonaws.us-east-1.s3", PolicyDocument: s3EndpointPolicy},
+				},
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeVPCEndpoints(m, "com.amazonaws.us-east-1.s3",
+					&ec2.VpcEndpoint{VpcEndpointId: aws.String("vpce-1"), PolicyDocument: aws.String(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Principal": "*", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*"}]
+}`)},
+				)
+			},
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {},
+		},
+		{
+			name: "policy rejected by the simulator",
+			spec: &infrav1.VPCEndpointsSpec{
+				EndpointPolicies: []infrav1.EndpointPolicySpec{
+					{ServiceName: "com.amazonaws.us-east-1.s3", PolicyDocument: `{"Statement":[{"Effect":"Maybe","Action":"s3:GetObject","Resource":"*"}]}`},
+				},
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				expectDescribeVPCEndpoints(m, "com.amazonaws.us-east-1.s3", &ec2.VpcEndpoint{VpcEndpointId: aws.String("vpce-1")})
+			},
+			expectIAM: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				m.SimulateCustomPolicy(gomock.Any()).
+					Return(nil, awserr.New(iam.ErrCodeInvalidInputException, "Syntax errors in policy", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC:          infrav1.VPCSpec{ID: "vpc-endpoints"},
+						VPCEndpoints: tc.spec,
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+					IAM: iamMock,
+				},
+				AWSCluster: awsCluster,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expectEC2(ec2Mock.EXPECT())
+			tc.expectIAM(iamMock.EXPECT())
+
+			s := NewService(clusterScope)
+			err = s.reconcileVPCEndpointPolicies()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if tc.spec != nil && !conditions.IsTrue(awsCluster, infrav1.VPCEndpointPoliciesReadyCondition) {
+				t.Fatalf("expected condition %s to be true", infrav1.VPCEndpointPoliciesReadyCondition)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+// WindowsPasswordAvailable returns true once the Administrator password generated by EC2Launch for the
+// Windows instance can be retrieved, which shows that the instance finished booting. The password stays
+// encrypted with the key pair of the instance and is not kept.
+func (s *Service) WindowsPasswordAvailable(instanceID string) (bool, error) {
+	out, err := s.scope.EC2.GetPasswordData(&ec2.GetPasswordDataInput{
+		InstanceId: aws.String(instanceID),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get password data of instance %q", instanceID)
+	}
+
+	if aws.StringValue(out.PasswordData) == "" {
+		s.scope.V(2).Info("Password of Windows instance is not generated yet", "instance-id", instanceID)
+		return false, nil
+	}
+	return true, nil
+}
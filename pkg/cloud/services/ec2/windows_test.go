@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestWindowsPasswordAvailable(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expect        func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantAvailable bool
+		wantErr       bool
+	}{
+		{
+			name: "password is available",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.GetPasswordData(gomock.Eq(&ec2.GetPasswordDataInput{InstanceId: aws.String("i-windows")})).
+					Return(&ec2.GetPasswordDataOutput{PasswordData: aws.String("ZW5jcnlwdGVk")}, nil)
+			},
+			wantAvailable: true,
+		},
+		{
+			name: "password is not generated yet",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.GetPasswordData(gomock.Eq(&ec2.GetPasswordDataInput{InstanceId: aws.String("i-windows")})).
+					Return(&ec2.GetPasswordDataOutput{PasswordData: aws.String("")}, nil)
+			},
+		},
+		{
+			name: "fails to get the password data",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.GetPasswordData(gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+				},
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			tc.expect(ec2Mock.EXPECT())
+
+			available, err := NewService(scope).WindowsPasswordAvailable("i-windows")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("WindowsPasswordAvailable() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if available != tc.wantAvailable {
+				t.Fatalf("expected password availability %v, got %v", tc.wantAvailable, available)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/hash"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
 
 // ResourceGroups are filtered by ARN identifier: https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arns-syntax
@@ -50,28 +51,86 @@ func (s *Service) ReconcileLoadbalancers() error {
 		return err
 	}
 
+	apiELB, err := s.reconcileClassicELB(spec)
+	if err != nil {
+		return err
+	}
+
+	// TODO(vincepri): check if anything has changed and reconcile as necessary.
+	apiELB.DeepCopyInto(&s.scope.Network().APIServerELB)
+	s.scope.V(4).Info("Control plane load balancer", "api-server-elb", apiELB)
+
+	if err := s.reconcileAdditionalAPIServerELBs(); err != nil {
+		return err
+	}
+
+	s.scope.V(2).Info("Reconcile load balancers completed successfully")
+	return nil
+}
+
+// reconcileAdditionalAPIServerELBs creates a classic load balancer for each additional control plane endpoint,
+// and deletes the ones previously created for endpoints that have since been removed from the spec.
+func (s *Service) reconcileAdditionalAPIServerELBs() error {
+	desired := sets.NewString()
+	elbs := make([]infrav1.ClassicELB, 0, len(s.scope.AWSCluster.Spec.AdditionalControlPlaneEndpoints))
+
+	for _, endpoint := range s.scope.AWSCluster.Spec.AdditionalControlPlaneEndpoints {
+		spec, err := s.getAdditionalAPIServerClassicELBSpec(endpoint)
+		if err != nil {
+			return err
+		}
+
+		apiELB, err := s.reconcileClassicELB(spec)
+		if err != nil {
+			return err
+		}
+
+		desired.Insert(apiELB.Name)
+		elbs = append(elbs, *apiELB)
+	}
+
+	for _, existing := range s.scope.Network().AdditionalAPIServerELBs {
+		if desired.Has(existing.Name) {
+			continue
+		}
+
+		s.scope.V(2).Info("Deleting additional apiserver load balancer", "elb-name", existing.Name)
+		if err := s.deleteClassicELB(existing.Name); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedDeleteELB", "Failed to delete additional apiserver load balancer %q: %v", existing.Name, err)
+			return errors.Wrapf(err, "failed to delete additional apiserver load balancer %q", existing.Name)
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteELB", "Deleted additional apiserver load balancer %q", existing.Name)
+	}
+
+	s.scope.Network().AdditionalAPIServerELBs = elbs
+	return nil
+}
+
+// reconcileClassicELB describes or creates the classic load balancer defined by the given spec,
+// and reconciles its attributes, tags, subnets and security groups.
+func (s *Service) reconcileClassicELB(spec *infrav1.ClassicELB) (*infrav1.ClassicELB, error) {
 	// Describe or create.
 	apiELB, err := s.describeClassicELB(spec.Name)
 	if IsNotFound(err) {
 		apiELB, err = s.createClassicELB(spec)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		s.scope.V(2).Info("Created new classic load balancer for apiserver", "api-server-elb-name", apiELB.Name)
 	} else if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !reflect.DeepEqual(spec.Attributes, apiELB.Attributes) {
 		err := s.configureAttributes(apiELB.Name, spec.Attributes)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := s.reconcileELBTags(apiELB.Name, spec.Tags); err != nil {
-		return errors.Wrapf(err, "failed to reconcile tags for apiserver load balancer %q", apiELB.Name)
+		return nil, errors.Wrapf(err, "failed to reconcile tags for apiserver load balancer %q", apiELB.Name)
 	}
 
 	// Reconcile the subnets and availability zones from the spec
@@ -82,7 +141,7 @@ func (s *Service) ReconcileLoadbalancers() error {
 			Subnets:          aws.StringSlice(spec.SubnetIDs),
 		})
 		if err != nil {
-			return errors.Wrapf(err, "failed to attach apiserver load balancer %q to subnets", apiELB.Name)
+			return nil, errors.Wrapf(err, "failed to attach apiserver load balancer %q to subnets", apiELB.Name)
 		}
 	}
 	if len(apiELB.AvailabilityZones) != len(spec.AvailabilityZones) {
@@ -96,16 +155,11 @@ func (s *Service) ReconcileLoadbalancers() error {
 			SecurityGroups:   aws.StringSlice(spec.SecurityGroupIDs),
 		})
 		if err != nil {
-			return errors.Wrapf(err, "failed to apply security groups to load balancer %q", apiELB.Name)
+			return nil, errors.Wrapf(err, "failed to apply security groups to load balancer %q", apiELB.Name)
 		}
 	}
 
-	// TODO(vincepri): check if anything has changed and reconcile as necessary.
-	apiELB.DeepCopyInto(&s.scope.Network().APIServerELB)
-	s.scope.V(4).Info("Control plane load balancer", "api-server-elb", apiELB)
-
-	s.scope.V(2).Info("Reconcile load balancers completed successfully")
-	return nil
+	return apiELB, nil
 }
 
 // GetAPIServerDNSName returns the DNS name endpoint for the API server
@@ -167,13 +221,28 @@ func (s *Service) RegisterInstanceWithClassicELB(instanceID, loadBalancer string
 	return nil
 }
 
-// InstanceIsRegisteredWithAPIServerELB returns true if the instance is already registered with the APIServer ELB.
+// InstanceIsRegisteredWithAPIServerELB returns true if the instance is already registered with the APIServer ELB,
+// as well as with the load balancers of any additional control plane endpoints.
 func (s *Service) InstanceIsRegisteredWithAPIServerELB(i *infrav1.Instance) (bool, error) {
-	name, err := GenerateELBName(s.scope.Name())
+	names, err := s.apiServerELBNames()
 	if err != nil {
 		return false, err
 	}
 
+	for _, name := range names {
+		registered, err := s.instanceIsRegisteredWithClassicELB(i, name)
+		if err != nil {
+			return false, err
+		}
+		if !registered {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (s *Service) instanceIsRegisteredWithClassicELB(i *infrav1.Instance, name string) (bool, error) {
 	input := &elb.DescribeLoadBalancersInput{
 		LoadBalancerNames: aws.StringSlice([]string{name}),
 	}
@@ -195,12 +264,24 @@ func (s *Service) InstanceIsRegisteredWithAPIServerELB(i *infrav1.Instance) (boo
 	return false, nil
 }
 
-// RegisterInstanceWithAPIServerELB registers an instance with a classic ELB
+// RegisterInstanceWithAPIServerELB registers an instance with a classic ELB,
+// as well as with the load balancers of any additional control plane endpoints.
 func (s *Service) RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error {
-	name, err := GenerateELBName(s.scope.Name())
+	names, err := s.apiServerELBNames()
 	if err != nil {
 		return err
 	}
+
+	for _, name := range names {
+		if err := s.registerInstanceWithClassicELB(i, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) registerInstanceWithClassicELB(i *infrav1.Instance, name string) error {
 	out, err := s.describeClassicELB(name)
 	if err != nil {
 		return err
@@ -232,19 +313,30 @@ func (s *Service) RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error {
 	return err
 }
 
-// DeregisterInstanceFromAPIServerELB de-registers an instance from a classic ELB
+// DeregisterInstanceFromAPIServerELB de-registers an instance from a classic ELB,
+// as well as from the load balancers of any additional control plane endpoints.
 func (s *Service) DeregisterInstanceFromAPIServerELB(i *infrav1.Instance) error {
-	name, err := GenerateELBName(s.scope.Name())
+	names, err := s.apiServerELBNames()
 	if err != nil {
 		return err
 	}
 
+	for _, name := range names {
+		if err := s.deregisterInstanceFromClassicELB(i, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deregisterInstanceFromClassicELB(i *infrav1.Instance, name string) error {
 	input := &elb.DeregisterInstancesFromLoadBalancerInput{
 		Instances:        []*elb.Instance{{InstanceId: aws.String(i.ID)}},
 		LoadBalancerName: aws.String(name),
 	}
 
-	_, err = s.scope.ELB.DeregisterInstancesFromLoadBalancer(input)
+	_, err := s.scope.ELB.DeregisterInstancesFromLoadBalancer(input)
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -259,6 +351,22 @@ func (s *Service) DeregisterInstanceFromAPIServerELB(i *infrav1.Instance) error
 	return err
 }
 
+// apiServerELBNames returns the names of the APIServer ELB and of the load balancers
+// created for the additional control plane endpoints.
+func (s *Service) apiServerELBNames() ([]string, error) {
+	name, err := GenerateELBName(s.scope.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{name}
+	for _, additional := range s.scope.Network().AdditionalAPIServerELBs {
+		names = append(names, additional.Name)
+	}
+
+	return names, nil
+}
+
 // GenerateELBName generates a formatted ELB name via either
 // concatenating the cluster name to the "-apiserver" suffix
 // or computing a hash for clusters with names above 32 characters.
@@ -276,6 +384,23 @@ func GenerateELBName(clusterName string) (string, error) {
 	return elbName, nil
 }
 
+// GenerateAdditionalELBName generates a formatted ELB name for an additional
+// control plane endpoint listening on the given port, falling back to a hash
+// for names above 32 characters.
+func GenerateAdditionalELBName(clusterName string, port int32) (string, error) {
+	standardELBName := fmt.Sprintf("%s-%d", generateStandardELBName(clusterName), port)
+	if len(standardELBName) <= 32 {
+		return standardELBName, nil
+	}
+
+	elbName, err := generateHashedELBName(fmt.Sprintf("%s-%d", clusterName, port))
+	if err != nil {
+		return "", err
+	}
+
+	return elbName, nil
+}
+
 // generateStandardELBName generates a formatted ELB name based on cluster
 // and ELB name
 func generateStandardELBName(clusterName string) string {
@@ -300,13 +425,33 @@ func (s *Service) getAPIServerClassicELBSpec() (*infrav1.ClassicELB, error) {
 	if err != nil {
 		return nil, err
 	}
+	return s.getClassicELBSpec(elbName, s.scope.ControlPlaneLoadBalancerScheme(), int64(s.scope.APIServerPort())), nil
+}
+
+func (s *Service) getAdditionalAPIServerClassicELBSpec(endpoint clusterv1.APIEndpoint) (*infrav1.ClassicELB, error) {
+	elbName, err := GenerateAdditionalELBName(s.scope.Name(), endpoint.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	// Additional endpoints are served on the opposite side of the control plane load balancer,
+	// so that a cluster can expose both an internal and an internet-facing API endpoint.
+	scheme := infrav1.ClassicELBSchemeInternal
+	if s.scope.ControlPlaneLoadBalancerScheme() == infrav1.ClassicELBSchemeInternal {
+		scheme = infrav1.ClassicELBSchemeInternetFacing
+	}
+
+	return s.getClassicELBSpec(elbName, scheme, int64(endpoint.Port)), nil
+}
+
+func (s *Service) getClassicELBSpec(elbName string, scheme infrav1.ClassicELBScheme, port int64) *infrav1.ClassicELB {
 	res := &infrav1.ClassicELB{
 		Name:   elbName,
-		Scheme: s.scope.ControlPlaneLoadBalancerScheme(),
+		Scheme: scheme,
 		Listeners: []*infrav1.ClassicELBListener{
 			{
 				Protocol:         infrav1.ClassicELBProtocolTCP,
-				Port:             int64(s.scope.APIServerPort()),
+				Port:             port,
 				InstanceProtocol: infrav1.ClassicELBProtocolTCP,
 				InstancePort:     6443,
 			},
@@ -338,7 +483,7 @@ func (s *Service) getAPIServerClassicELBSpec() (*infrav1.ClassicELB, error) {
 	// The load balancer APIs require us to only attach one subnet for each AZ.
 	subnets := s.scope.Subnets().FilterPrivate()
 
-	if s.scope.ControlPlaneLoadBalancerScheme() == infrav1.ClassicELBSchemeInternetFacing {
+	if scheme == infrav1.ClassicELBSchemeInternetFacing {
 		subnets = s.scope.Subnets().FilterPublic()
 	}
 
@@ -355,7 +500,7 @@ subnetLoop:
 		res.SubnetIDs = append(res.SubnetIDs, sn.ID)
 	}
 
-	return res, nil
+	return res
 }
 
 func (s *Service) createClassicELB(spec *infrav1.ClassicELB) (*infrav1.ClassicELB, error) {
@@ -19,9 +19,14 @@ package elb
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb/mock_elbiface"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
 
@@ -123,3 +128,149 @@ func TestGetAPIServerClassicELBSpec_ControlPlaneLoadBalancer(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileAdditionalAPIServerELBs(t *testing.T) {
+	expectCreate := func(m *mock_elbiface.MockELBAPIMockRecorder, name string) {
+		m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+			LoadBalancerNames: aws.StringSlice([]string{name}),
+		})).Return(&elb.DescribeLoadBalancersOutput{}, nil)
+		m.CreateLoadBalancer(gomock.Any()).
+			DoAndReturn(func(input *elb.CreateLoadBalancerInput) (*elb.CreateLoadBalancerOutput, error) {
+				if e, a := string(infrav1.ClassicELBSchemeInternal), aws.StringValue(input.Scheme); e != a {
+					t.Errorf("scheme: expected %q, got %q", e, a)
+				}
+				return &elb.CreateLoadBalancerOutput{DNSName: aws.String(name + ".elb.amazonaws.com")}, nil
+			})
+		m.ConfigureHealthCheck(gomock.Any()).Return(&elb.ConfigureHealthCheckOutput{}, nil)
+		m.DescribeTags(gomock.Eq(&elb.DescribeTagsInput{
+			LoadBalancerNames: aws.StringSlice([]string{name}),
+		})).Return(&elb.DescribeTagsOutput{TagDescriptions: []*elb.TagDescription{{}}}, nil)
+		m.AddTags(gomock.Any()).Return(&elb.AddTagsOutput{}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		endpoints     []clusterv1.APIEndpoint
+		existing      []infrav1.ClassicELB
+		expect        func(m *mock_elbiface.MockELBAPIMockRecorder)
+		expectedNames []string
+	}{
+		{
+			name: "creates a load balancer for each additional endpoint",
+			endpoints: []clusterv1.APIEndpoint{
+				{Port: 6443},
+				{Port: 8443},
+			},
+			expect: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				expectCreate(m, "foo-apiserver-6443")
+				expectCreate(m, "foo-apiserver-8443")
+			},
+			expectedNames: []string{"foo-apiserver-6443", "foo-apiserver-8443"},
+		},
+		{
+			name: "deletes the load balancer of a removed endpoint",
+			endpoints: []clusterv1.APIEndpoint{
+				{Port: 6443},
+			},
+			existing: []infrav1.ClassicELB{
+				{Name: "foo-apiserver-6443"},
+				{Name: "foo-apiserver-8443"},
+			},
+			expect: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+					LoadBalancerNames: aws.StringSlice([]string{"foo-apiserver-6443"}),
+				})).Return(&elb.DescribeLoadBalancersOutput{
+					LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+						{
+							LoadBalancerName: aws.String("foo-apiserver-6443"),
+							Scheme:           aws.String(string(infrav1.ClassicELBSchemeInternal)),
+							Subnets:          aws.StringSlice([]string{"subnet-private"}),
+							SecurityGroups:   aws.StringSlice([]string{"sg-apiserver-lb"}),
+							VPCId:            aws.String("vpc-id"),
+							DNSName:          aws.String("foo-apiserver-6443.elb.amazonaws.com"),
+						},
+					},
+				}, nil)
+				m.DescribeLoadBalancerAttributes(gomock.Eq(&elb.DescribeLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String("foo-apiserver-6443"),
+				})).Return(&elb.DescribeLoadBalancerAttributesOutput{
+					LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+						ConnectionSettings:     &elb.ConnectionSettings{IdleTimeout: aws.Int64(600)},
+						CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{Enabled: aws.Bool(false)},
+					},
+				}, nil)
+				m.DescribeTags(gomock.Any()).Return(&elb.DescribeTagsOutput{TagDescriptions: []*elb.TagDescription{{}}}, nil)
+				m.AddTags(gomock.Any()).Return(&elb.AddTagsOutput{}, nil)
+				m.DeleteLoadBalancer(gomock.Eq(&elb.DeleteLoadBalancerInput{
+					LoadBalancerName: aws.String("foo-apiserver-8443"),
+				})).Return(&elb.DeleteLoadBalancerOutput{}, nil)
+			},
+			expectedNames: []string{"foo-apiserver-6443"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			elbMock := mock_elbiface.NewMockELBAPI(mockCtrl)
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				AWSClients: scope.AWSClients{
+					ELB: elbMock,
+				},
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "foo",
+					},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						AdditionalControlPlaneEndpoints: tc.endpoints,
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID: "vpc-id",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									ID:               "subnet-private",
+									AvailabilityZone: "us-east-1a",
+								},
+								{
+									ID:               "subnet-public",
+									AvailabilityZone: "us-east-1a",
+									IsPublic:         true,
+								},
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.Network{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupAPIServerLB: {ID: "sg-apiserver-lb"},
+							},
+							AdditionalAPIServerELBs: tc.existing,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(elbMock.EXPECT())
+
+			s := NewService(clusterScope)
+			g.Expect(s.reconcileAdditionalAPIServerELBs()).To(Succeed())
+
+			names := []string{}
+			for _, lb := range clusterScope.Network().AdditionalAPIServerELBs {
+				g.Expect(lb.DNSName).To(Equal(lb.Name + ".elb.amazonaws.com"))
+				names = append(names, lb.Name)
+			}
+			g.Expect(names).To(Equal(tc.expectedNames))
+		})
+	}
+}
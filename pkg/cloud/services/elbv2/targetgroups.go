@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+)
+
+// DeregisterInstance deregisters the instance from the target groups of the cluster's VPC
+// it is registered in, and returns true once connection draining has completed in all of them.
+// Targets are only deregistered once, targets already draining are left alone.
+func (s *Service) DeregisterInstance(instanceID string) (bool, error) {
+	targetGroups, err := s.vpcTargetGroups()
+	if err != nil {
+		return false, err
+	}
+
+	drained := true
+	for _, arn := range targetGroups {
+		out, err := s.scope.ELBV2.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+			TargetGroupArn: aws.String(arn),
+		})
+		if err != nil {
+			if isTargetGroupNotFound(err) {
+				continue
+			}
+			return false, errors.Wrapf(err, "failed to describe health of targets of target group %q", arn)
+		}
+
+		var targets []*elbv2.TargetDescription
+		for _, desc := range out.TargetHealthDescriptions {
+			if aws.StringValue(desc.Target.Id) != instanceID {
+				continue
+			}
+			// Deregistered targets are reported as draining until connection draining completes, then disappear.
+			drained = false
+			if desc.TargetHealth == nil || aws.StringValue(desc.TargetHealth.State) != elbv2.TargetHealthStateEnumDraining {
+				targets = append(targets, desc.Target)
+			}
+		}
+		if len(targets) == 0 {
+			continue
+		}
+
+		s.scope.V(2).Info("Deregistering instance from target group", "instance-id", instanceID, "target-group", arn)
+		if _, err := s.scope.ELBV2.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(arn),
+			Targets:        targets,
+		}); err != nil && !isTargetGroupNotFound(err) {
+			return false, errors.Wrapf(err, "failed to deregister instance %q from target group %q", instanceID, arn)
+		}
+	}
+
+	return drained, nil
+}
+
+// vpcTargetGroups returns the ARNs of the target groups in the cluster's VPC.
+func (s *Service) vpcTargetGroups() ([]string, error) {
+	vpcID := s.scope.VPC().ID
+	var arns []string
+	err := s.scope.ELBV2.DescribeTargetGroupsPages(&elbv2.DescribeTargetGroupsInput{}, func(out *elbv2.DescribeTargetGroupsOutput, _ bool) bool {
+		for _, tg := range out.TargetGroups {
+			if aws.StringValue(tg.VpcId) == vpcID {
+				arns = append(arns, aws.StringValue(tg.TargetGroupArn))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe target groups")
+	}
+	return arns, nil
+}
+
+func isTargetGroupNotFound(err error) bool {
+	code, ok := awserrors.Code(err)
+	return ok && code == elbv2.ErrCodeTargetGroupNotFoundException
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elbv2/mock_elbv2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	instanceID     = "i-1"
+	targetGroupARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/ingress/1"
+)
+
+func newELBV2TestScope(t *testing.T, elbv2Mock *mock_elbv2iface.MockELBV2API) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-1"},
+			},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			ELBV2: elbv2Mock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func expectTargetGroups(m *mock_elbv2iface.MockELBV2APIMockRecorder) {
+	m.DescribeTargetGroupsPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *elbv2.DescribeTargetGroupsInput, fn func(*elbv2.DescribeTargetGroupsOutput, bool) bool) error {
+			fn(&elbv2.DescribeTargetGroupsOutput{
+				TargetGroups: []*elbv2.TargetGroup{
+					{TargetGroupArn: aws.String(targetGroupARN), VpcId: aws.String("vpc-1")},
+					{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/other/2"), VpcId: aws.String("vpc-2")},
+				},
+			}, true)
+			return nil
+		})
+}
+
+func expectTargetHealth(m *mock_elbv2iface.MockELBV2APIMockRecorder, states ...string) {
+	out := &elbv2.DescribeTargetHealthOutput{
+		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+			{
+				Target:       &elbv2.TargetDescription{Id: aws.String("i-2"), Port: aws.Int64(80)},
+				TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)},
+			},
+		},
+	}
+	for _, state := range states {
+		out.TargetHealthDescriptions = append(out.TargetHealthDescriptions, &elbv2.TargetHealthDescription{
+			Target:       &elbv2.TargetDescription{Id: aws.String(instanceID), Port: aws.Int64(80)},
+			TargetHealth: &elbv2.TargetHealth{State: aws.String(state)},
+		})
+	}
+	m.DescribeTargetHealth(gomock.Eq(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})).Return(out, nil)
+}
+
+func TestDeregisterInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	elbv2Mock := mock_elbv2iface.NewMockELBV2API(mockCtrl)
+	s := NewService(newELBV2TestScope(t, elbv2Mock))
+
+	// The registered instance is deregistered, and draining starts.
+	expectTargetGroups(elbv2Mock.EXPECT())
+	expectTargetHealth(elbv2Mock.EXPECT(), elbv2.TargetHealthStateEnumHealthy)
+	elbv2Mock.EXPECT().DeregisterTargets(gomock.Eq(&elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+		Targets:        []*elbv2.TargetDescription{{Id: aws.String(instanceID), Port: aws.Int64(80)}},
+	})).Return(&elbv2.DeregisterTargetsOutput{}, nil)
+
+	drained, err := s.DeregisterInstance(instanceID)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if drained {
+		t.Fatal("expected connection draining to be in progress")
+	}
+
+	// The instance is still draining, it isn't deregistered again.
+	expectTargetGroups(elbv2Mock.EXPECT())
+	expectTargetHealth(elbv2Mock.EXPECT(), elbv2.TargetHealthStateEnumDraining)
+
+	drained, err = s.DeregisterInstance(instanceID)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if drained {
+		t.Fatal("expected connection draining to be in progress")
+	}
+
+	// Draining completed, the instance is no longer a target.
+	expectTargetGroups(elbv2Mock.EXPECT())
+	expectTargetHealth(elbv2Mock.EXPECT())
+
+	drained, err = s.DeregisterInstance(instanceID)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if !drained {
+		t.Fatal("expected connection draining to be completed")
+	}
+}
+
+func TestDeregisterInstanceTargetGroupDeleted(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	elbv2Mock := mock_elbv2iface.NewMockELBV2API(mockCtrl)
+	s := NewService(newELBV2TestScope(t, elbv2Mock))
+
+	expectTargetGroups(elbv2Mock.EXPECT())
+	elbv2Mock.EXPECT().DescribeTargetHealth(gomock.Any()).
+		Return(nil, awserr.New(elbv2.ErrCodeTargetGroupNotFoundException, "not found", nil))
+
+	drained, err := s.DeregisterInstance(instanceID)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if !drained {
+		t.Fatal("expected connection draining to be completed")
+	}
+}
+
+func TestDeregisterInstanceError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	elbv2Mock := mock_elbv2iface.NewMockELBV2API(mockCtrl)
+	s := NewService(newELBV2TestScope(t, elbv2Mock))
+
+	expectTargetGroups(elbv2Mock.EXPECT())
+	expectTargetHealth(elbv2Mock.EXPECT(), elbv2.TargetHealthStateEnumHealthy)
+	elbv2Mock.EXPECT().DeregisterTargets(gomock.Any()).
+		Return(nil, awserr.New("AccessDenied", "denied", nil))
+
+	if _, err := s.DeregisterInstance(instanceID); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// interruptionRule is an EventBridge rule sending a kind of interruption events to an interruption queue.
+type interruptionRule struct {
+	suffix     string
+	source     string
+	detailType string
+}
+
+// interruptionPattern is the event pattern of an interruption rule.
+type interruptionPattern struct {
+	Source     []string `json:"source"`
+	DetailType []string `json:"detail-type"`
+}
+
+// interruptionRules are the interruption rules of a component of the cluster handling the interruption of
+// nodes, such as Karpenter, named after the cluster and the component.
+type interruptionRules struct {
+	// component is the name of the component in the rule names, such as "karpenter".
+	component string
+	// description is the name of the component in the rule descriptions, events and errors.
+	description string
+	targetID    string
+	rules       []interruptionRule
+}
+
+// reconcileInterruptionRules creates the interruption rules of a component, targeting the given queue.
+func (s *Service) reconcileInterruptionRules(rules interruptionRules, queueARN string) error {
+	for _, rule := range rules.rules {
+		name := s.interruptionRuleName(rules, rule)
+		pattern := interruptionPattern{Source: []string{rule.source}, DetailType: []string{rule.detailType}}
+
+		existing, err := s.scope.EventBridge.DescribeRule(&eventbridge.DescribeRuleInput{
+			Name: aws.String(name),
+		})
+		if code, _ := awserrors.Code(err); code == eventbridge.ErrCodeResourceNotFoundException {
+			existing = nil
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to describe %s interruption rule %q", rules.description, name)
+		}
+
+		if existing == nil || !sameInterruptionPattern(aws.StringValue(existing.EventPattern), pattern) {
+			if err := s.putInterruptionRule(rules, name, pattern, existing == nil); err != nil {
+				return err
+			}
+		}
+
+		if err := s.reconcileRuleTarget(name, rules.targetID, queueARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteInterruptionRules deletes the interruption rules of a component along with their target.
+func (s *Service) deleteInterruptionRules(rules interruptionRules) error {
+	for _, rule := range rules.rules {
+		name := s.interruptionRuleName(rules, rule)
+		_, err := s.scope.EventBridge.RemoveTargets(&eventbridge.RemoveTargetsInput{
+			Rule: aws.String(name),
+			Ids:  aws.StringSlice([]string{rules.targetID}),
+		})
+		if code, _ := awserrors.Code(err); code == eventbridge.ErrCodeResourceNotFoundException {
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to remove target of %s interruption rule %q", rules.description, name)
+		}
+
+		if _, err := s.scope.EventBridge.DeleteRule(&eventbridge.DeleteRuleInput{
+			Name: aws.String(name),
+		}); err != nil {
+			if code, _ := awserrors.Code(err); code != eventbridge.ErrCodeResourceNotFoundException {
+				record.Warnf(s.scope.AWSCluster, "FailedDeleteInterruptionRule", "Failed to delete %s interruption rule %q: %v", rules.description, name, err)
+				return errors.Wrapf(err, "failed to delete %s interruption rule %q", rules.description, name)
+			}
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteInterruptionRule", "Deleted %s interruption rule %q", rules.description, name)
+	}
+
+	return nil
+}
+
+func (s *Service) putInterruptionRule(rules interruptionRules, name string, pattern interruptionPattern, create bool) error {
+	eventPattern, err := json.Marshal(pattern)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal event pattern of %s interruption rule %q", rules.description, name)
+	}
+
+	input := &eventbridge.PutRuleInput{
+		Name:         aws.String(name),
+		Description:  aws.String(fmt.Sprintf("Interruption events for the %s of cluster %s", rules.description, s.scope.Name())),
+		EventPattern: aws.String(string(eventPattern)),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}
+	if create {
+		input.Tags = ruleTags(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Additional:  s.scope.AdditionalTags(),
+		}))
+	}
+
+	if _, err := s.scope.EventBridge.PutRule(input); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedPutInterruptionRule", "Failed to put %s interruption rule %q: %v", rules.description, name, err)
+		return errors.Wrapf(err, "failed to put %s interruption rule %q", rules.description, name)
+	}
+
+	if create {
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateInterruptionRule", "Created %s interruption rule %q", rules.description, name)
+	}
+	return nil
+}
+
+func (s *Service) interruptionRuleName(rules interruptionRules, rule interruptionRule) string {
+	return fmt.Sprintf("%s-%s-%s", s.scope.Name(), rules.component, rule.suffix)
+}
+
+// sameInterruptionPattern returns whether the event pattern of an existing rule matches the given pattern.
+func sameInterruptionPattern(eventPattern string, pattern interruptionPattern) bool {
+	existing := interruptionPattern{}
+	if err := json.Unmarshal([]byte(eventPattern), &existing); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(existing, pattern)
+}
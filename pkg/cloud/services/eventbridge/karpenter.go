@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"github.com/pkg/errors"
+)
+
+// KarpenterInterruptionTargetID is the ID of the target of the Karpenter interruption rules.
+const KarpenterInterruptionTargetID = "karpenter-interruption-queue"
+
+// karpenterInterruptionRules are the rules of the events Karpenter handles ahead of the interruption of nodes.
+var karpenterInterruptionRules = interruptionRules{
+	component:   "karpenter",
+	description: "Karpenter installation",
+	targetID:    KarpenterInterruptionTargetID,
+	rules: []interruptionRule{
+		{suffix: "scheduled-change", source: "aws.health", detailType: "AWS Health Event"},
+		{suffix: "spot-interruption", source: stateChangeSource, detailType: "EC2 Spot Instance Interruption Warning"},
+		{suffix: "rebalance", source: stateChangeSource, detailType: "EC2 Instance Rebalance Recommendation"},
+		{suffix: "instance-state-change", source: stateChangeSource, detailType: stateChangeDetailType},
+	},
+}
+
+// ReconcileKarpenterInterruptionRules creates the EventBridge rules sending the interruption events of the
+// account to the interruption queue of the cluster's Karpenter installation. The queue must be reconciled first.
+func (s *Service) ReconcileKarpenterInterruptionRules() error {
+	if s.scope.Karpenter() == nil {
+		return nil
+	}
+	queueARN := s.scope.KarpenterStatus().InterruptionQueueARN
+	if queueARN == "" {
+		return errors.New("interruption queue of Karpenter not created yet")
+	}
+
+	return s.reconcileInterruptionRules(karpenterInterruptionRules, queueARN)
+}
+
+// DeleteKarpenterInterruptionRules deletes the interruption rules of the cluster's Karpenter installation along
+// with their target.
+func (s *Service) DeleteKarpenterInterruptionRules() error {
+	if s.scope.Karpenter() == nil && s.scope.AWSCluster.Status.Karpenter == nil {
+		return nil
+	}
+
+	return s.deleteInterruptionRules(karpenterInterruptionRules)
+}
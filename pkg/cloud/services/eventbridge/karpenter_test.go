@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge/mock_eventbridgeiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testQueueARN = "arn:aws:sqs:us-east-1:123456789012:test-cluster-karpenter-interruption"
+
+func newKarpenterTestScope(t *testing.T, eventBridgeMock *mock_eventbridgeiface.MockEventBridgeAPI, queueARN string) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:    "us-east-1",
+			Karpenter: &infrav1.KarpenterSpec{Version: "v0.27.0", IAMRoleARN: "arn:aws:iam::123456789012:role/nodes"},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Karpenter: &infrav1.KarpenterStatus{InterruptionQueueARN: queueARN},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSClients: scope.AWSClients{
+			EventBridge: eventBridgeMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestReconcileKarpenterInterruptionRules(t *testing.T) {
+	t.Run("creates the rules targeting the interruption queue", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+		for _, suffix := range []string{"scheduled-change", "spot-interruption", "rebalance", "instance-state-change"} {
+			name := "test-cluster-karpenter-" + suffix
+			eventBridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{Name: aws.String(name)}).
+				Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "not found", nil))
+			eventBridgeMock.EXPECT().PutRule(gomock.AssignableToTypeOf(&eventbridge.PutRuleInput{})).
+				DoAndReturn(func(input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error) {
+					if aws.StringValue(input.Name) != name || len(input.Tags) == 0 {
+						t.Errorf("expected rule %q to be created with tags, got %v", name, input)
+					}
+					return &eventbridge.PutRuleOutput{}, nil
+				})
+			eventBridgeMock.EXPECT().ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{Rule: aws.String(name)}).
+				Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+			eventBridgeMock.EXPECT().PutTargets(&eventbridge.PutTargetsInput{
+				Rule:    aws.String(name),
+				Targets: []*eventbridge.Target{{Id: aws.String(KarpenterInterruptionTargetID), Arn: aws.String(testQueueARN)}},
+			}).Return(&eventbridge.PutTargetsOutput{}, nil)
+		}
+
+		s := NewService(newKarpenterTestScope(t, eventBridgeMock, testQueueARN))
+		if err := s.ReconcileKarpenterInterruptionRules(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("leaves up to date rules alone", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+		for _, rule := range karpenterInterruptionRules.rules {
+			name := "test-cluster-karpenter-" + rule.suffix
+			pattern := `{"source":["` + rule.source + `"],"detail-type":["` + rule.detailType + `"]}`
+			eventBridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{Name: aws.String(name)}).
+				Return(&eventbridge.DescribeRuleOutput{Name: aws.String(name), EventPattern: aws.String(pattern)}, nil)
+			eventBridgeMock.EXPECT().ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{Rule: aws.String(name)}).
+				Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{Id: aws.String(KarpenterInterruptionTargetID), Arn: aws.String(testQueueARN)}},
+				}, nil)
+		}
+
+		s := NewService(newKarpenterTestScope(t, eventBridgeMock, testQueueARN))
+		if err := s.ReconcileKarpenterInterruptionRules(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("fails without interruption queue", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+		s := NewService(newKarpenterTestScope(t, eventBridgeMock, ""))
+		if err := s.ReconcileKarpenterInterruptionRules(); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestDeleteKarpenterInterruptionRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+	notFound := awserr.New(eventbridge.ErrCodeResourceNotFoundException, "not found", nil)
+	for i, rule := range karpenterInterruptionRules.rules {
+		name := "test-cluster-karpenter-" + rule.suffix
+		// Rules which are already gone are skipped.
+		if i == 0 {
+			eventBridgeMock.EXPECT().RemoveTargets(gomock.Any()).Return(nil, notFound)
+			continue
+		}
+		eventBridgeMock.EXPECT().RemoveTargets(&eventbridge.RemoveTargetsInput{
+			Rule: aws.String(name),
+			Ids:  aws.StringSlice([]string{KarpenterInterruptionTargetID}),
+		}).Return(&eventbridge.RemoveTargetsOutput{}, nil)
+		eventBridgeMock.EXPECT().DeleteRule(&eventbridge.DeleteRuleInput{Name: aws.String(name)}).Return(&eventbridge.DeleteRuleOutput{}, nil)
+	}
+
+	s := NewService(newKarpenterTestScope(t, eventBridgeMock, testQueueARN))
+	if err := s.DeleteKarpenterInterruptionRules(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+// NodeTerminationHandlerTargetID is the ID of the target of the node termination handler rules.
+const NodeTerminationHandlerTargetID = "nth-queue"
+
+// nodeTerminationHandlerRules are the rules of the events the AWS Node Termination Handler handles in queue
+// processor mode.
+var nodeTerminationHandlerRules = interruptionRules{
+	component:   "nth",
+	description: "node termination handler",
+	targetID:    NodeTerminationHandlerTargetID,
+	rules: []interruptionRule{
+		{suffix: "scheduled-change", source: "aws.health", detailType: "AWS Health Event"},
+		{suffix: "spot-interruption", source: stateChangeSource, detailType: "EC2 Spot Instance Interruption Warning"},
+		{suffix: "rebalance", source: stateChangeSource, detailType: "EC2 Instance Rebalance Recommendation"},
+		{suffix: "instance-state-change", source: stateChangeSource, detailType: stateChangeDetailType},
+		{suffix: "asg-lifecycle", source: "aws.autoscaling", detailType: "EC2 Instance-terminate Lifecycle Action"},
+	},
+}
+
+// ReconcileNodeTerminationHandlerRules creates the EventBridge rules sending the interruption events of the
+// account to the node termination handler queue created for the cluster. Queues provided by the user are
+// expected to be wired to their own rules.
+func (s *Service) ReconcileNodeTerminationHandlerRules(queueARN string) error {
+	if nth := s.scope.NodeTerminationHandler(); nth == nil || !nth.CreateQueue {
+		return nil
+	}
+
+	return s.reconcileInterruptionRules(nodeTerminationHandlerRules, queueARN)
+}
+
+// DeleteNodeTerminationHandlerRules deletes the node termination handler rules of the cluster along with
+// their target.
+func (s *Service) DeleteNodeTerminationHandlerRules() error {
+	if s.scope.NodeTerminationHandler() == nil && s.scope.AWSCluster.Status.NTHQueueURL == "" {
+		return nil
+	}
+
+	return s.deleteInterruptionRules(nodeTerminationHandlerRules)
+}
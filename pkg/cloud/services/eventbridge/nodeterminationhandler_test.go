@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge/mock_eventbridgeiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNTHQueueARN = "arn:aws:sqs:us-east-1:123456789012:test-cluster-nth"
+
+func newNTHTestScope(t *testing.T, eventBridgeMock *mock_eventbridgeiface.MockEventBridgeAPI, nth *infrav1.NTHSpec) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:                 "us-east-1",
+			NodeTerminationHandler: nth,
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSClients: scope.AWSClients{
+			EventBridge: eventBridgeMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestReconcileNodeTerminationHandlerRules(t *testing.T) {
+	t.Run("creates the rules targeting the created queue", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+		for _, rule := range nodeTerminationHandlerRules.rules {
+			name := "test-cluster-nth-" + rule.suffix
+			eventBridgeMock.EXPECT().DescribeRule(&eventbridge.DescribeRuleInput{Name: aws.String(name)}).
+				Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "not found", nil))
+			eventBridgeMock.EXPECT().PutRule(gomock.AssignableToTypeOf(&eventbridge.PutRuleInput{})).
+				DoAndReturn(func(input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error) {
+					if aws.StringValue(input.Name) != name || len(input.Tags) == 0 {
+						t.Fatalf("unexpected rule %q with tags %v", aws.StringValue(input.Name), input.Tags)
+					}
+					return &eventbridge.PutRuleOutput{}, nil
+				})
+			eventBridgeMock.EXPECT().ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{Rule: aws.String(name)}).
+				Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+			eventBridgeMock.EXPECT().PutTargets(&eventbridge.PutTargetsInput{
+				Rule:    aws.String(name),
+				Targets: []*eventbridge.Target{{Id: aws.String(NodeTerminationHandlerTargetID), Arn: aws.String(testNTHQueueARN)}},
+			}).Return(&eventbridge.PutTargetsOutput{}, nil)
+		}
+
+		s := NewService(newNTHTestScope(t, eventBridgeMock, &infrav1.NTHSpec{CreateQueue: true}))
+		if err := s.ReconcileNodeTerminationHandlerRules(testNTHQueueARN); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("leaves the rules of a provided queue to the user", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+		s := NewService(newNTHTestScope(t, eventBridgeMock, &infrav1.NTHSpec{QueueARN: testNTHQueueARN}))
+		if err := s.ReconcileNodeTerminationHandlerRules(testNTHQueueARN); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestDeleteNodeTerminationHandlerRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+	for _, rule := range nodeTerminationHandlerRules.rules {
+		name := "test-cluster-nth-" + rule.suffix
+		eventBridgeMock.EXPECT().RemoveTargets(&eventbridge.RemoveTargetsInput{
+			Rule: aws.String(name),
+			Ids:  aws.StringSlice([]string{NodeTerminationHandlerTargetID}),
+		}).Return(&eventbridge.RemoveTargetsOutput{}, nil)
+		eventBridgeMock.EXPECT().DeleteRule(&eventbridge.DeleteRuleInput{Name: aws.String(name)}).Return(&eventbridge.DeleteRuleOutput{}, nil)
+	}
+
+	s := NewService(newNTHTestScope(t, eventBridgeMock, &infrav1.NTHSpec{CreateQueue: true}))
+	if err := s.DeleteNodeTerminationHandlerRules(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
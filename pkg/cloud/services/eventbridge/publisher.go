@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+)
+
+const (
+	// EventSource is the source of the events sent by the controller.
+	EventSource = "cluster-api-provider-aws"
+
+	// DefaultDetailType is the detail type of the events when the cluster doesn't set one.
+	DefaultDetailType = "AWSMachine Lifecycle Event"
+)
+
+// InstanceLifecycleState is a state transition of the instance of an AWSMachine.
+type InstanceLifecycleState string
+
+var (
+	// InstanceLaunched is sent once the instance of a machine is launched.
+	InstanceLaunched = InstanceLifecycleState("launched")
+
+	// InstanceReady is sent once a machine becomes ready.
+	InstanceReady = InstanceLifecycleState("ready")
+
+	// InstanceTerminated is sent once the instance of a machine is terminated.
+	InstanceTerminated = InstanceLifecycleState("terminated")
+)
+
+// instanceEventDetail is the JSON detail of the events.
+type instanceEventDetail struct {
+	InstanceID  string            `json:"instanceID"`
+	MachineName string            `json:"machineName"`
+	State       string            `json:"state"`
+	Tags        map[string]string `json:"tags"`
+}
+
+// EventBridgePublisher sends the lifecycle events of AWSMachines to the
+// EventBridge event bus of their cluster.
+type EventBridgePublisher struct {
+	scope *scope.ClusterScope
+}
+
+// NewEventBridgePublisher returns a new EventBridgePublisher for the given scope.
+func NewEventBridgePublisher(scope *scope.ClusterScope) *EventBridgePublisher {
+	return &EventBridgePublisher{
+		scope: scope,
+	}
+}
+
+// Publish sends an event for the given state transition of the instance of a
+// machine. It does nothing if the cluster has no event bus configured.
+func (p *EventBridgePublisher) Publish(machineName string, instance *infrav1.Instance, state InstanceLifecycleState) error {
+	spec := p.scope.EventBus()
+	if spec == nil {
+		return nil
+	}
+
+	detailType := spec.DetailType
+	if detailType == "" {
+		detailType = DefaultDetailType
+	}
+
+	detail, err := json.Marshal(instanceEventDetail{
+		InstanceID:  instance.ID,
+		MachineName: machineName,
+		State:       string(state),
+		Tags:        instance.Tags,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s event of instance %q", state, instance.ID)
+	}
+
+	out, err := p.scope.EventBridge.PutEvents(&eventbridge.PutEventsInput{
+		Entries: []*eventbridge.PutEventsRequestEntry{
+			{
+				EventBusName: aws.String(spec.EventBusName),
+				Source:       aws.String(EventSource),
+				DetailType:   aws.String(detailType),
+				Detail:       aws.String(string(detail)),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to send %s event of instance %q to event bus %q", state, instance.ID, spec.EventBusName)
+	}
+	if aws.Int64Value(out.FailedEntryCount) > 0 && len(out.Entries) > 0 {
+		return errors.Errorf("failed to send %s event of instance %q to event bus %q: %s: %s", state, instance.ID, spec.EventBusName,
+			aws.StringValue(out.Entries[0].ErrorCode), aws.StringValue(out.Entries[0].ErrorMessage))
+	}
+
+	p.scope.V(2).Info("Sent instance lifecycle event", "state", state, "instance-id", instance.ID, "event-bus", spec.EventBusName)
+	return nil
+}
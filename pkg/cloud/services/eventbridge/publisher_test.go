@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge/mock_eventbridgeiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newEventBridgeTestScope(t *testing.T, eventBridgeMock *mock_eventbridgeiface.MockEventBridgeAPI, spec *infrav1.EventBridgeSpec) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:      "us-east-1",
+			EventBridge: spec,
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSClients: scope.AWSClients{
+			EventBridge: eventBridgeMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestPublish(t *testing.T) {
+	instance := &infrav1.Instance{
+		ID:   "i-1234",
+		Tags: infrav1.Tags{"Name": "test-machine"},
+	}
+
+	testCases := []struct {
+		name               string
+		spec               *infrav1.EventBridgeSpec
+		state              InstanceLifecycleState
+		expectedDetailType string
+	}{
+		{
+			name:               "launched with the configured detail type",
+			spec:               &infrav1.EventBridgeSpec{EventBusName: "machines", DetailType: "Machine Event"},
+			state:              InstanceLaunched,
+			expectedDetailType: "Machine Event",
+		},
+		{
+			name:               "ready with the default detail type",
+			spec:               &infrav1.EventBridgeSpec{EventBusName: "machines"},
+			state:              InstanceReady,
+			expectedDetailType: DefaultDetailType,
+		},
+		{
+			name:               "terminated on an event bus given by ARN",
+			spec:               &infrav1.EventBridgeSpec{EventBusName: "arn:aws:events:us-east-1:123456789012:event-bus/machines", DetailType: "Machine Event"},
+			state:              InstanceTerminated,
+			expectedDetailType: "Machine Event",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			eventBridgeMock.EXPECT().PutEvents(gomock.Any()).DoAndReturn(func(input *eventbridge.PutEventsInput) (*eventbridge.PutEventsOutput, error) {
+				if len(input.Entries) != 1 {
+					t.Fatalf("expected a single entry, got %d", len(input.Entries))
+				}
+				entry := input.Entries[0]
+				if got := aws.StringValue(entry.EventBusName); got != tc.spec.EventBusName {
+					t.Errorf("expected event bus %q, got %q", tc.spec.EventBusName, got)
+				}
+				if got := aws.StringValue(entry.DetailType); got != tc.expectedDetailType {
+					t.Errorf("expected detail type %q, got %q", tc.expectedDetailType, got)
+				}
+				if got := aws.StringValue(entry.Source); got != EventSource {
+					t.Errorf("expected source %q, got %q", EventSource, got)
+				}
+
+				var detail instanceEventDetail
+				if err := json.Unmarshal([]byte(aws.StringValue(entry.Detail)), &detail); err != nil {
+					t.Fatalf("failed to unmarshal detail: %v", err)
+				}
+				expected := instanceEventDetail{
+					InstanceID:  "i-1234",
+					MachineName: "test-machine",
+					State:       string(tc.state),
+					Tags:        map[string]string{"Name": "test-machine"},
+				}
+				if !reflect.DeepEqual(detail, expected) {
+					t.Errorf("expected detail %+v, got %+v", expected, detail)
+				}
+				return &eventbridge.PutEventsOutput{FailedEntryCount: aws.Int64(0)}, nil
+			})
+
+			p := NewEventBridgePublisher(newEventBridgeTestScope(t, eventBridgeMock, tc.spec))
+			if err := p.Publish("test-machine", instance, tc.state); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestPublishWithoutEventBus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	p := NewEventBridgePublisher(newEventBridgeTestScope(t, eventBridgeMock, nil))
+	if err := p.Publish("test-machine", &infrav1.Instance{ID: "i-1234"}, InstanceLaunched); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestPublishFailure(t *testing.T) {
+	testCases := []struct {
+		name   string
+		output *eventbridge.PutEventsOutput
+		err    error
+	}{
+		{
+			name: "request failure",
+			err:  errors.New("AccessDeniedException"),
+		},
+		{
+			name: "failed entry",
+			output: &eventbridge.PutEventsOutput{
+				FailedEntryCount: aws.Int64(1),
+				Entries: []*eventbridge.PutEventsResultEntry{
+					{ErrorCode: aws.String("InternalFailure"), ErrorMessage: aws.String("internal failure")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			eventBridgeMock.EXPECT().PutEvents(gomock.Any()).Return(tc.output, tc.err)
+
+			p := NewEventBridgePublisher(newEventBridgeTestScope(t, eventBridgeMock, &infrav1.EventBridgeSpec{EventBusName: "machines"}))
+			if err := p.Publish("test-machine", &infrav1.Instance{ID: "i-1234"}, InstanceLaunched); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// TerminationAlertTargetID is the ID of the target of the termination alert rule.
+	TerminationAlertTargetID = "termination-alert"
+
+	stateChangeSource     = "aws.ec2"
+	stateChangeDetailType = "EC2 Instance State-change Notification"
+)
+
+// terminationPattern is the event pattern of the termination alert rule.
+type terminationPattern struct {
+	Source     []string                 `json:"source"`
+	DetailType []string                 `json:"detail-type"`
+	Detail     terminationPatternDetail `json:"detail"`
+}
+
+type terminationPatternDetail struct {
+	State      []string `json:"state"`
+	InstanceID []string `json:"instance-id,omitempty"`
+}
+
+// ReconcileTerminationAlerts creates or updates the EventBridge rule sending the notifications of the cluster's
+// instances reaching the terminated state to the target of the termination alerts.
+// State-change notifications don't carry the tags of instances, so the rule matches the IDs of the instances
+// tagged with the cluster, as found by the last reconciliation. It is disabled while the cluster has no instances.
+func (s *Service) ReconcileTerminationAlerts() error {
+	spec := s.scope.TerminationAlerts()
+	if spec == nil {
+		return nil
+	}
+
+	instanceIDs, err := s.clusterInstanceIDs()
+	if err != nil {
+		return err
+	}
+
+	name := s.terminationAlertRuleName()
+	pattern := terminationPattern{
+		Source:     []string{stateChangeSource},
+		DetailType: []string{stateChangeDetailType},
+		Detail: terminationPatternDetail{
+			State:      []string{ec2.InstanceStateNameTerminated},
+			InstanceID: instanceIDs,
+		},
+	}
+	state := eventbridge.RuleStateEnabled
+	if len(instanceIDs) == 0 {
+		state = eventbridge.RuleStateDisabled
+	}
+
+	existing, err := s.scope.EventBridge.DescribeRule(&eventbridge.DescribeRuleInput{
+		Name: aws.String(name),
+	})
+	if code, _ := awserrors.Code(err); code == eventbridge.ErrCodeResourceNotFoundException {
+		existing = nil
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to describe termination alert rule %q", name)
+	}
+
+	ruleARN := ""
+	if existing != nil {
+		ruleARN = aws.StringValue(existing.Arn)
+	}
+	if existing == nil || aws.StringValue(existing.State) != state || !samePattern(aws.StringValue(existing.EventPattern), pattern) {
+		ruleARN, err = s.putTerminationAlertRule(name, pattern, state, existing == nil)
+		if err != nil {
+			return err
+		}
+	}
+	spec.EventBridgeRuleARN = ruleARN
+
+	return s.reconcileRuleTarget(name, TerminationAlertTargetID, spec.TargetARN)
+}
+
+// DeleteTerminationAlerts deletes the termination alert rule of the cluster along with its target.
+func (s *Service) DeleteTerminationAlerts() error {
+	spec := s.scope.TerminationAlerts()
+	if spec == nil {
+		return nil
+	}
+
+	name := s.terminationAlertRuleName()
+	_, err := s.scope.EventBridge.RemoveTargets(&eventbridge.RemoveTargetsInput{
+		Rule: aws.String(name),
+		Ids:  aws.StringSlice([]string{TerminationAlertTargetID}),
+	})
+	if code, _ := awserrors.Code(err); code == eventbridge.ErrCodeResourceNotFoundException {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to remove target of termination alert rule %q", name)
+	}
+
+	if _, err := s.scope.EventBridge.DeleteRule(&eventbridge.DeleteRuleInput{
+		Name: aws.String(name),
+	}); err != nil {
+		if code, _ := awserrors.Code(err); code != eventbridge.ErrCodeResourceNotFoundException {
+			record.Warnf(s.scope.AWSCluster, "FailedDeleteTerminationAlertRule", "Failed to delete termination alert rule %q: %v", name, err)
+			return errors.Wrapf(err, "failed to delete termination alert rule %q", name)
+		}
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteTerminationAlertRule", "Deleted termination alert rule %q", name)
+	spec.EventBridgeRuleARN = ""
+	return nil
+}
+
+func (s *Service) putTerminationAlertRule(name string, pattern terminationPattern, state string, create bool) (string, error) {
+	eventPattern, err := json.Marshal(pattern)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal event pattern of termination alert rule %q", name)
+	}
+
+	input := &eventbridge.PutRuleInput{
+		Name:         aws.String(name),
+		Description:  aws.String(fmt.Sprintf("Termination alerts of the instances of cluster %s", s.scope.Name())),
+		EventPattern: aws.String(string(eventPattern)),
+		State:        aws.String(state),
+	}
+	if create {
+		input.Tags = ruleTags(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Additional:  s.scope.AdditionalTags(),
+		}))
+	}
+
+	out, err := s.scope.EventBridge.PutRule(input)
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedPutTerminationAlertRule", "Failed to put termination alert rule %q: %v", name, err)
+		return "", errors.Wrapf(err, "failed to put termination alert rule %q", name)
+	}
+
+	if create {
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateTerminationAlertRule", "Created termination alert rule %q", name)
+	}
+	s.scope.V(2).Info("Put termination alert rule", "rule", name, "state", state, "instances", len(pattern.Detail.InstanceID))
+	return aws.StringValue(out.RuleArn), nil
+}
+
+// reconcileRuleTarget puts the target with the given ID of the rule unless it already targets the given ARN.
+func (s *Service) reconcileRuleTarget(name, targetID, targetARN string) error {
+	out, err := s.scope.EventBridge.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{
+		Rule: aws.String(name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list targets of rule %q", name)
+	}
+	for _, target := range out.Targets {
+		if aws.StringValue(target.Id) == targetID && aws.StringValue(target.Arn) == targetARN {
+			return nil
+		}
+	}
+
+	putOut, err := s.scope.EventBridge.PutTargets(&eventbridge.PutTargetsInput{
+		Rule: aws.String(name),
+		Targets: []*eventbridge.Target{
+			{
+				Id:  aws.String(targetID),
+				Arn: aws.String(targetARN),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to put target %q of rule %q", targetARN, name)
+	}
+	if aws.Int64Value(putOut.FailedEntryCount) > 0 && len(putOut.FailedEntries) > 0 {
+		return errors.Errorf("failed to put target %q of rule %q: %s: %s", targetARN, name,
+			aws.StringValue(putOut.FailedEntries[0].ErrorCode), aws.StringValue(putOut.FailedEntries[0].ErrorMessage))
+	}
+
+	s.scope.V(2).Info("Put rule target", "rule", name, "target", targetARN)
+	return nil
+}
+
+// clusterInstanceIDs returns the sorted IDs of the instances tagged with the cluster which are not terminated yet.
+func (s *Service) clusterInstanceIDs() ([]string, error) {
+	var ids []string
+	err := s.scope.EC2.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.Cluster(s.scope.Name()),
+			filter.EC2.InstanceStates(
+				ec2.InstanceStateNamePending,
+				ec2.InstanceStateNameRunning,
+				ec2.InstanceStateNameShuttingDown,
+				ec2.InstanceStateNameStopping,
+				ec2.InstanceStateNameStopped,
+			),
+		},
+	}, func(out *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range out.Reservations {
+			for _, instance := range reservation.Instances {
+				ids = append(ids, aws.StringValue(instance.InstanceId))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instances of cluster %q", s.scope.Name())
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (s *Service) terminationAlertRuleName() string {
+	return fmt.Sprintf("%s-termination-alerts", s.scope.Name())
+}
+
+// samePattern returns whether the event pattern of an existing rule matches the given pattern.
+func samePattern(eventPattern string, pattern terminationPattern) bool {
+	existing := terminationPattern{}
+	if err := json.Unmarshal([]byte(eventPattern), &existing); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(existing, pattern)
+}
+
+func ruleTags(tags infrav1.Tags) []*eventbridge.Tag {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([]*eventbridge.Tag, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, &eventbridge.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return res
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge/mock_eventbridgeiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testRuleName  = "test-cluster-termination-alerts"
+	testRuleARN   = "arn:aws:events:us-east-1:123456789012:rule/test-cluster-termination-alerts"
+	testTargetARN = "arn:aws:sns:us-east-1:123456789012:alerts"
+)
+
+func newTerminationAlertsTestScope(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, eventBridgeMock *mock_eventbridgeiface.MockEventBridgeAPI, spec *infrav1.TerminationAlertSpec) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:            "us-east-1",
+			TerminationAlerts: spec,
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2:         ec2Mock,
+			EventBridge: eventBridgeMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+// expectInstances expects the instances of the cluster to be described, returning the given instance IDs.
+func expectInstances(m *mock_ec2iface.MockEC2APIMockRecorder, ids ...string) {
+	m.DescribeInstancesPages(gomock.AssignableToTypeOf(&ec2.DescribeInstancesInput{}), gomock.Any()).
+		DoAndReturn(func(input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+			instances := []*ec2.Instance{}
+			for _, id := range ids {
+				instances = append(instances, &ec2.Instance{InstanceId: aws.String(id)})
+			}
+			fn(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: instances}}}, true)
+			return nil
+		})
+}
+
+func eventPattern(t *testing.T, ids ...string) string {
+	pattern, err := json.Marshal(terminationPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{"EC2 Instance State-change Notification"},
+		Detail: terminationPatternDetail{
+			State:      []string{"terminated"},
+			InstanceID: ids,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pattern)
+}
+
+func TestReconcileTerminationAlerts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		expect func(t *testing.T, e *mock_ec2iface.MockEC2APIMockRecorder, m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
+	}{
+		{
+			name: "creates the rule and its target",
+			expect: func(t *testing.T, e *mock_ec2iface.MockEC2APIMockRecorder, m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				expectInstances(e, "i-2", "i-1")
+				m.DescribeRule(gomock.Eq(&eventbridge.DescribeRuleInput{Name: aws.String(testRuleName)})).
+					Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "not found", nil))
+				m.PutRule(gomock.AssignableToTypeOf(&eventbridge.PutRuleInput{})).
+					DoAndReturn(func(input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error) {
+						if aws.StringValue(input.EventPattern) != eventPattern(t, "i-1", "i-2") {
+							t.Fatalf("unexpected event pattern %s", aws.StringValue(input.EventPattern))
+						}
+						if aws.StringValue(input.State) != eventbridge.RuleStateEnabled {
+							t.Fatalf("expected rule to be enabled, got %s", aws.StringValue(input.State))
+						}
+						owned := false
+						for _, tag := range input.Tags {
+							if aws.StringValue(tag.Key) == infrav1.ClusterTagKey("test-cluster") && aws.StringValue(tag.Value) == "owned" {
+								owned = true
+							}
+						}
+						if !owned {
+							t.Fatalf("expected rule to be tagged as owned by the cluster, got %v", input.Tags)
+						}
+						return &eventbridge.PutRuleOutput{RuleArn: aws.String(testRuleARN)}, nil
+					})
+				m.ListTargetsByRule(gomock.Eq(&eventbridge.ListTargetsByRuleInput{Rule: aws.String(testRuleName)})).
+					Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(gomock.Eq(&eventbridge.PutTargetsInput{
+					Rule:    aws.String(testRuleName),
+					Targets: []*eventbridge.Target{{Id: aws.String(TerminationAlertTargetID), Arn: aws.String(testTargetARN)}},
+				})).Return(&eventbridge.PutTargetsOutput{}, nil)
+			},
+		},
+		{
+			name: "leaves an up to date rule and target unchanged",
+			expect: func(t *testing.T, e *mock_ec2iface.MockEC2APIMockRecorder, m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				expectInstances(e, "i-1")
+				m.DescribeRule(gomock.Any()).Return(&eventbridge.DescribeRuleOutput{
+					Arn:          aws.String(testRuleARN),
+					EventPattern: aws.String(eventPattern(t, "i-1")),
+					State:        aws.String(eventbridge.RuleStateEnabled),
+				}, nil)
+				m.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{Id: aws.String(TerminationAlertTargetID), Arn: aws.String(testTargetARN)}},
+				}, nil)
+			},
+		},
+		{
+			name: "updates the instances of the rule without tagging it again",
+			expect: func(t *testing.T, e *mock_ec2iface.MockEC2APIMockRecorder, m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				expectInstances(e, "i-1", "i-3")
+				m.DescribeRule(gomock.Any()).Return(&eventbridge.DescribeRuleOutput{
+					Arn:          aws.String(testRuleARN),
+					EventPattern: aws.String(eventPattern(t, "i-1", "i-2")),
+					State:        aws.String(eventbridge.RuleStateEnabled),
+				}, nil)
+				m.PutRule(gomock.AssignableToTypeOf(&eventbridge.PutRuleInput{})).
+					DoAndReturn(func(input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error) {
+						if aws.StringValue(input.EventPattern) != eventPattern(t, "i-1", "i-3") {
+							t.Fatalf("unexpected event pattern %s", aws.StringValue(input.EventPattern))
+						}
+						if input.Tags != nil {
+							t.Fatalf("expected existing rule not to be tagged, got %v", input.Tags)
+						}
+						return &eventbridge.PutRuleOutput{RuleArn: aws.String(testRuleARN)}, nil
+					})
+				m.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{Id: aws.String(TerminationAlertTargetID), Arn: aws.String(testTargetARN)}},
+				}, nil)
+			},
+		},
+		{
+			name: "disables the rule of clusters without instances and updates a changed target",
+			expect: func(t *testing.T, e *mock_ec2iface.MockEC2APIMockRecorder, m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				expectInstances(e)
+				m.DescribeRule(gomock.Any()).Return(&eventbridge.DescribeRuleOutput{
+					Arn:          aws.String(testRuleARN),
+					EventPattern: aws.String(eventPattern(t, "i-1")),
+					State:        aws.String(eventbridge.RuleStateEnabled),
+				}, nil)
+				m.PutRule(gomock.AssignableToTypeOf(&eventbridge.PutRuleInput{})).
+					DoAndReturn(func(input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error) {
+						if aws.StringValue(input.State) != eventbridge.RuleStateDisabled {
+							t.Fatalf("expected rule to be disabled, got %s", aws.StringValue(input.State))
+						}
+						return &eventbridge.PutRuleOutput{RuleArn: aws.String(testRuleARN)}, nil
+					})
+				m.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []*eventbridge.Target{{Id: aws.String(TerminationAlertTargetID), Arn: aws.String("arn:aws:sqs:us-east-1:123456789012:old")}},
+				}, nil)
+				m.PutTargets(gomock.Any()).Return(&eventbridge.PutTargetsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			spec := &infrav1.TerminationAlertSpec{TargetARN: testTargetARN}
+			clusterScope := newTerminationAlertsTestScope(t, ec2Mock, eventBridgeMock, spec)
+
+			tc.expect(t, ec2Mock.EXPECT(), eventBridgeMock.EXPECT())
+
+			if err := NewService(clusterScope).ReconcileTerminationAlerts(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if spec.EventBridgeRuleARN != testRuleARN {
+				t.Fatalf("expected rule ARN %q, got %q", testRuleARN, spec.EventBridgeRuleARN)
+			}
+		})
+	}
+}
+
+func TestReconcileTerminationAlertsFailedTarget(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+	clusterScope := newTerminationAlertsTestScope(t, ec2Mock, eventBridgeMock, &infrav1.TerminationAlertSpec{TargetARN: testTargetARN})
+
+	expectInstances(ec2Mock.EXPECT(), "i-1")
+	eventBridgeMock.EXPECT().DescribeRule(gomock.Any()).Return(&eventbridge.DescribeRuleOutput{
+		Arn:          aws.String(testRuleARN),
+		EventPattern: aws.String(eventPattern(t, "i-1")),
+		State:        aws.String(eventbridge.RuleStateEnabled),
+	}, nil)
+	eventBridgeMock.EXPECT().ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+	eventBridgeMock.EXPECT().PutTargets(gomock.Any()).Return(&eventbridge.PutTargetsOutput{
+		FailedEntryCount: aws.Int64(1),
+		FailedEntries: []*eventbridge.PutTargetsResultEntry{
+			{TargetId: aws.String(TerminationAlertTargetID), ErrorCode: aws.String("AccessDenied"), ErrorMessage: aws.String("denied")},
+		},
+	}, nil)
+
+	if err := NewService(clusterScope).ReconcileTerminationAlerts(); err == nil {
+		t.Fatal("expected error but got none")
+	}
+}
+
+func TestDeleteTerminationAlerts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name   string
+		spec   *infrav1.TerminationAlertSpec
+		expect func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
+	}{
+		{
+			name: "deletes the rule and its target",
+			spec: &infrav1.TerminationAlertSpec{TargetARN: testTargetARN, EventBridgeRuleARN: testRuleARN},
+			expect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(gomock.Eq(&eventbridge.RemoveTargetsInput{
+					Rule: aws.String(testRuleName),
+					Ids:  aws.StringSlice([]string{TerminationAlertTargetID}),
+				})).Return(&eventbridge.RemoveTargetsOutput{}, nil)
+				m.DeleteRule(gomock.Eq(&eventbridge.DeleteRuleInput{Name: aws.String(testRuleName)})).
+					Return(&eventbridge.DeleteRuleOutput{}, nil)
+			},
+		},
+		{
+			name: "rule was already deleted",
+			spec: &infrav1.TerminationAlertSpec{TargetARN: testTargetARN, EventBridgeRuleARN: testRuleARN},
+			expect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(gomock.Any()).
+					Return(nil, awserr.New(eventbridge.ErrCodeResourceNotFoundException, "not found", nil))
+			},
+		},
+		{
+			name:   "cluster without termination alerts",
+			expect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			clusterScope := newTerminationAlertsTestScope(t, mock_ec2iface.NewMockEC2API(mockCtrl), eventBridgeMock, tc.spec)
+
+			tc.expect(eventBridgeMock.EXPECT())
+
+			if err := NewService(clusterScope).DeleteTerminationAlerts(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSamePattern(t *testing.T) {
+	pattern := terminationPattern{
+		Source:     []string{"aws.ec2"},
+		DetailType: []string{"EC2 Instance State-change Notification"},
+		Detail:     terminationPatternDetail{State: []string{"terminated"}, InstanceID: []string{"i-1"}},
+	}
+
+	testCases := []struct {
+		name         string
+		eventPattern string
+		expect       bool
+	}{
+		{
+			name:         "same pattern formatted differently",
+			eventPattern: `{"detail": {"instance-id": ["i-1"], "state": ["terminated"]}, "detail-type": ["EC2 Instance State-change Notification"], "source": ["aws.ec2"]}`,
+			expect:       true,
+		},
+		{
+			name:         "other instances",
+			eventPattern: `{"source": ["aws.ec2"], "detail-type": ["EC2 Instance State-change Notification"], "detail": {"state": ["terminated"], "instance-id": ["i-2"]}}`,
+		},
+		{
+			name:         "invalid pattern",
+			eventPattern: `{`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := samePattern(tc.eventPattern, pattern); got != tc.expect {
+				t.Fatalf("expected %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fis
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// ReconcileExperimentTemplates creates the FIS experiment templates of the cluster that do not exist yet,
+// and deletes the ones that were removed from its spec.
+func (s *Service) ReconcileExperimentTemplates() error {
+	status := &s.scope.AWSCluster.Status
+	desired := map[string]bool{}
+
+	var existing map[string]string
+	for _, spec := range s.scope.FISExperimentTemplates() {
+		desired[spec.Name] = true
+		if _, ok := status.FISExperimentTemplateIDs[spec.Name]; ok {
+			continue
+		}
+
+		// Templates created by a previous reconcile whose status was not persisted are adopted.
+		if existing == nil {
+			var err error
+			if existing, err = s.listClusterTemplates(); err != nil {
+				return err
+			}
+		}
+
+		id, ok := existing[spec.Name]
+		if !ok {
+			var err error
+			if id, err = s.createExperimentTemplate(spec); err != nil {
+				return err
+			}
+		}
+
+		if status.FISExperimentTemplateIDs == nil {
+			status.FISExperimentTemplateIDs = map[string]string{}
+		}
+		status.FISExperimentTemplateIDs[spec.Name] = id
+	}
+
+	for _, name := range sortedTemplateNames(status.FISExperimentTemplateIDs) {
+		if desired[name] {
+			continue
+		}
+		if err := s.deleteExperimentTemplate(name, status.FISExperimentTemplateIDs[name]); err != nil {
+			return err
+		}
+		delete(status.FISExperimentTemplateIDs, name)
+	}
+	if len(status.FISExperimentTemplateIDs) == 0 {
+		status.FISExperimentTemplateIDs = nil
+	}
+
+	return nil
+}
+
+// DeleteExperimentTemplates deletes all FIS experiment templates of the cluster.
+func (s *Service) DeleteExperimentTemplates() error {
+	status := &s.scope.AWSCluster.Status
+	for _, name := range sortedTemplateNames(status.FISExperimentTemplateIDs) {
+		if err := s.deleteExperimentTemplate(name, status.FISExperimentTemplateIDs[name]); err != nil {
+			return err
+		}
+		delete(status.FISExperimentTemplateIDs, name)
+	}
+	status.FISExperimentTemplateIDs = nil
+
+	return nil
+}
+
+// StartExperiment starts an experiment from the cluster's FIS experiment template with the given name,
+// and returns the ID of the experiment.
+func (s *Service) StartExperiment(name string) (string, error) {
+	id, ok := s.scope.AWSCluster.Status.FISExperimentTemplateIDs[name]
+	if !ok {
+		return "", errors.Errorf("FIS experiment template %q does not exist", name)
+	}
+
+	out, err := s.scope.FIS.StartExperiment(&fis.StartExperimentInput{
+		ExperimentTemplateId: aws.String(id),
+		Tags:                 aws.StringMap(s.templateTags(name)),
+	})
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedStartFISExperiment", "Failed to start experiment from FIS experiment template %q: %v", name, err)
+		return "", errors.Wrapf(err, "failed to start experiment from FIS experiment template %q", name)
+	}
+
+	experimentID := aws.StringValue(out.Experiment.Id)
+	record.Eventf(s.scope.AWSCluster, "SuccessfulStartFISExperiment", "Started experiment %q from FIS experiment template %q", experimentID, name)
+	return experimentID, nil
+}
+
+func (s *Service) createExperimentTemplate(spec infrav1.FISTemplateSpec) (string, error) {
+	input := &fis.CreateExperimentTemplateInput{
+		Description: aws.String(spec.Description),
+		RoleArn:     aws.String(spec.RoleARN),
+		StopConditions: []*fis.CreateExperimentTemplateStopConditionInput{
+			{Source: aws.String("none")},
+		},
+		Targets: map[string]*fis.CreateExperimentTemplateTargetInput{},
+		Actions: map[string]*fis.CreateExperimentTemplateActionInput{},
+		Tags:    aws.StringMap(s.templateTags(spec.Name)),
+	}
+
+	for _, target := range spec.Targets {
+		selectionMode := target.SelectionMode
+		if selectionMode == "" {
+			selectionMode = "ALL"
+		}
+		targetInput := &fis.CreateExperimentTemplateTargetInput{
+			ResourceType:  aws.String(target.ResourceType),
+			SelectionMode: aws.String(selectionMode),
+		}
+		switch {
+		case len(target.ResourceARNs) > 0:
+			targetInput.ResourceArns = aws.StringSlice(target.ResourceARNs)
+		case len(target.ResourceTags) > 0:
+			targetInput.ResourceTags = aws.StringMap(target.ResourceTags)
+		default:
+			targetInput.ResourceTags = aws.StringMap(map[string]string{
+				infrav1.ClusterTagKey(s.scope.Name()): string(infrav1.ResourceLifecycleOwned),
+			})
+		}
+		input.Targets[target.Name] = targetInput
+	}
+
+	for _, action := range spec.Actions {
+		actionInput := &fis.CreateExperimentTemplateActionInput{
+			ActionId: aws.String(action.ActionID),
+		}
+		if len(action.Parameters) > 0 {
+			actionInput.Parameters = aws.StringMap(action.Parameters)
+		}
+		if len(action.Targets) > 0 {
+			actionInput.Targets = aws.StringMap(action.Targets)
+		}
+		if len(action.StartAfter) > 0 {
+			actionInput.StartAfter = aws.StringSlice(action.StartAfter)
+		}
+		input.Actions[action.Name] = actionInput
+	}
+
+	out, err := s.scope.FIS.CreateExperimentTemplate(input)
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedCreateFISExperimentTemplate", "Failed to create FIS experiment template %q: %v", spec.Name, err)
+		return "", errors.Wrapf(err, "failed to create FIS experiment template %q", spec.Name)
+	}
+
+	id := aws.StringValue(out.ExperimentTemplate.Id)
+	record.Eventf(s.scope.AWSCluster, "SuccessfulCreateFISExperimentTemplate", "Created FIS experiment template %q with ID %q", spec.Name, id)
+	return id, nil
+}
+
+func (s *Service) deleteExperimentTemplate(name, id string) error {
+	if _, err := s.scope.FIS.DeleteExperimentTemplate(&fis.DeleteExperimentTemplateInput{
+		Id: aws.String(id),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); ok && code == fis.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		record.Warnf(s.scope.AWSCluster, "FailedDeleteFISExperimentTemplate", "Failed to delete FIS experiment template %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete FIS experiment template %q", name)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteFISExperimentTemplate", "Deleted FIS experiment template %q", name)
+	return nil
+}
+
+// listClusterTemplates returns the IDs of the experiment templates owned by the cluster, by name.
+func (s *Service) listClusterTemplates() (map[string]string, error) {
+	clusterTag := infrav1.ClusterTagKey(s.scope.Name())
+	templates := map[string]string{}
+	err := s.scope.FIS.ListExperimentTemplatesPages(&fis.ListExperimentTemplatesInput{}, func(out *fis.ListExperimentTemplatesOutput, _ bool) bool {
+		for _, t := range out.ExperimentTemplates {
+			if aws.StringValue(t.Tags[clusterTag]) != string(infrav1.ResourceLifecycleOwned) {
+				continue
+			}
+			if name := aws.StringValue(t.Tags["Name"]); name != "" {
+				templates[name] = aws.StringValue(t.Id)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list FIS experiment templates")
+	}
+
+	return templates, nil
+}
+
+func (s *Service) templateTags(name string) infrav1.Tags {
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Additional:  s.scope.AdditionalTags(),
+	})
+}
+
+func sortedTemplateNames(ids map[string]string) []string {
+	names := make([]string, 0, len(ids))
+	for name := range ids {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/fis/mock_fisiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var stopInstances = infrav1.FISTemplateSpec{
+	Name:        "stop-instances",
+	Description: "Stop the instances of the cluster",
+	RoleARN:     "arn:aws:iam::123456789012:role/fis",
+	Targets: []infrav1.FISTargetSpec{
+		{Name: "instances", ResourceType: "aws:ec2:instance"},
+	},
+	Actions: []infrav1.FISActionSpec{
+		{
+			Name:       "stop",
+			ActionID:   "aws:ec2:stop-instances",
+			Parameters: map[string]string{"startInstancesAfterDuration": "PT5M"},
+			Targets:    map[string]string{"Instances": "instances"},
+		},
+	},
+}
+
+var expectedTags = map[string]*string{
+	"Name": aws.String("stop-instances"),
+	"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": aws.String("owned"),
+}
+
+func newFISTestScope(t *testing.T, fisMock *mock_fisiface.MockFISAPI, awsCluster *infrav1.AWSCluster) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			FIS: fisMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func listTemplates(templates ...*fis.ExperimentTemplateSummary) func(*fis.ListExperimentTemplatesInput, func(*fis.ListExperimentTemplatesOutput, bool) bool) error {
+	return func(_ *fis.ListExperimentTemplatesInput, fn func(*fis.ListExperimentTemplatesOutput, bool) bool) error {
+		fn(&fis.ListExperimentTemplatesOutput{ExperimentTemplates: templates}, true)
+		return nil
+	}
+}
+
+func TestReconcileExperimentTemplates(t *testing.T) {
+	testCases := []struct {
+		name        string
+		templates   []infrav1.FISTemplateSpec
+		templateIDs map[string]string
+		expect      func(m *mock_fisiface.MockFISAPIMockRecorder)
+		expectedIDs map[string]string
+		expectErr   bool
+	}{
+		{
+			name: "cluster without experiment templates",
+		},
+		{
+			name:      "create a missing template",
+			templates: []infrav1.FISTemplateSpec{stopInstances},
+			expect: func(m *mock_fisiface.MockFISAPIMockRecorder) {
+				m.ListExperimentTemplatesPages(gomock.Any(), gomock.Any()).DoAndReturn(listTemplates())
+				m.CreateExperimentTemplate(gomock.Eq(&fis.CreateExperimentTemplateInput{
+					Description: aws.String("Stop the instances of the cluster"),
+					RoleArn:     aws.String("arn:aws:iam::123456789012:role/fis"),
+					StopConditions: []*fis.CreateExperimentTemplateStopConditionInput{
+						{Source: aws.String("none")},
+					},
+					Targets: map[string]*fis.CreateExperimentTemplateTargetInput{
+						"instances": {
+							ResourceType:  aws.String("aws:ec2:instance"),
+							SelectionMode: aws.String("ALL"),
+							ResourceTags: map[string]*string{
+								"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": aws.String("owned"),
+							},
+						},
+					},
+					Actions: map[string]*fis.CreateExperimentTemplateActionInput{
+						"stop": {
+							ActionId:   aws.String("aws:ec2:stop-instances"),
+							Parameters: map[string]*string{"startInstancesAfterDuration": aws.String("PT5M")},
+							Targets:    map[string]*string{"Instances": aws.String("instances")},
+						},
+					},
+					Tags: expectedTags,
+				})).Return(&fis.CreateExperimentTemplateOutput{
+					ExperimentTemplate: &fis.ExperimentTemplate{Id: aws.String("EXT1")},
+				}, nil)
+			},
+			expectedIDs: map[string]string{"stop-instances": "EXT1"},
+		},
+		{
+			name:        "template already in status",
+			templates:   []infrav1.FISTemplateSpec{stopInstances},
+			templateIDs: map[string]string{"stop-instances": "EXT1"},
+			expectedIDs: map[string]string{"stop-instances": "EXT1"},
+		},
+		{
+			name:      "adopt a template created by a previous reconcile",
+			templates: []infrav1.FISTemplateSpec{stopInstances},
+			expect: func(m *mock_fisiface.MockFISAPIMockRecorder) {
+				m.ListExperimentTemplatesPages(gomock.Any(), gomock.Any()).DoAndReturn(listTemplates(
+					&fis.ExperimentTemplateSummary{
+						Id:   aws.String("EXT-OTHER"),
+						Tags: map[string]*string{"Name": aws.String("stop-instances")},
+					},
+					&fis.ExperimentTemplateSummary{Id: aws.String("EXT1"), Tags: expectedTags},
+				))
+			},
+			expectedIDs: map[string]string{"stop-instances": "EXT1"},
+		},
+		{
+			name:        "delete templates removed from the spec",
+			templateIDs: map[string]string{"stop-instances": "EXT1", "gone": "EXT2"},
+			expect: func(m *mock_fisiface.MockFISAPIMockRecorder) {
+				m.DeleteExperimentTemplate(gomock.Eq(&fis.DeleteExperimentTemplateInput{Id: aws.String("EXT2")})).
+					Return(nil, awserr.New(fis.ErrCodeResourceNotFoundException, "not found", nil))
+				m.DeleteExperimentTemplate(gomock.Eq(&fis.DeleteExperimentTemplateInput{Id: aws.String("EXT1")})).
+					Return(&fis.DeleteExperimentTemplateOutput{}, nil)
+			},
+		},
+		{
+			name:      "template creation fails",
+			templates: []infrav1.FISTemplateSpec{stopInstances},
+			expect: func(m *mock_fisiface.MockFISAPIMockRecorder) {
+				m.ListExperimentTemplatesPages(gomock.Any(), gomock.Any()).DoAndReturn(listTemplates())
+				m.CreateExperimentTemplate(gomock.Any()).
+					Return(nil, awserr.New(fis.ErrCodeValidationException, "invalid action", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			fisMock := mock_fisiface.NewMockFISAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(fisMock.EXPECT())
+			}
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					Region:                 "us-east-1",
+					FISExperimentTemplates: tc.templates,
+				},
+				Status: infrav1.AWSClusterStatus{FISExperimentTemplateIDs: tc.templateIDs},
+			}
+			s := NewService(newFISTestScope(t, fisMock, awsCluster))
+			err := s.ReconcileExperimentTemplates()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if ids := awsCluster.Status.FISExperimentTemplateIDs; !reflect.DeepEqual(ids, tc.expectedIDs) {
+				t.Fatalf("expected template IDs %v, got %v", tc.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestDeleteExperimentTemplates(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	fisMock := mock_fisiface.NewMockFISAPI(mockCtrl)
+	fisMock.EXPECT().DeleteExperimentTemplate(gomock.Eq(&fis.DeleteExperimentTemplateInput{Id: aws.String("EXT1")})).
+		Return(&fis.DeleteExperimentTemplateOutput{}, nil)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:                 "us-east-1",
+			FISExperimentTemplates: []infrav1.FISTemplateSpec{stopInstances},
+		},
+		Status: infrav1.AWSClusterStatus{FISExperimentTemplateIDs: map[string]string{"stop-instances": "EXT1"}},
+	}
+	s := NewService(newFISTestScope(t, fisMock, awsCluster))
+	if err := s.DeleteExperimentTemplates(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if awsCluster.Status.FISExperimentTemplateIDs != nil {
+		t.Fatalf("expected template IDs to be cleared, got %v", awsCluster.Status.FISExperimentTemplateIDs)
+	}
+}
+
+func TestStartExperiment(t *testing.T) {
+	testCases := []struct {
+		name       string
+		template   string
+		expect     func(m *mock_fisiface.MockFISAPIMockRecorder)
+		expectedID string
+		expectErr  bool
+	}{
+		{
+			name:     "start an experiment",
+			template: "stop-instances",
+			expect: func(m *mock_fisiface.MockFISAPIMockRecorder) {
+				m.StartExperiment(gomock.Eq(&fis.StartExperimentInput{
+					ExperimentTemplateId: aws.String("EXT1"),
+					Tags:                 expectedTags,
+				})).Return(&fis.StartExperimentOutput{
+					Experiment: &fis.Experiment{Id: aws.String("EXP1")},
+				}, nil)
+			},
+			expectedID: "EXP1",
+		},
+		{
+			name:      "unknown template",
+			template:  "missing",
+			expectErr: true,
+		},
+		{
+			name:     "experiment fails to start",
+			template: "stop-instances",
+			expect: func(m *mock_fisiface.MockFISAPIMockRecorder) {
+				m.StartExperiment(gomock.Any()).
+					Return(nil, awserr.New(fis.ErrCodeConflictException, "already running", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			fisMock := mock_fisiface.NewMockFISAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(fisMock.EXPECT())
+			}
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+				Status:     infrav1.AWSClusterStatus{FISExperimentTemplateIDs: map[string]string{"stop-instances": "EXT1"}},
+			}
+			s := NewService(newFISTestScope(t, fisMock, awsCluster))
+			id, err := s.StartExperiment(tc.template)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if id != tc.expectedID {
+				t.Fatalf("expected experiment ID %q, got %q", tc.expectedID, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+)
+
+// Types of the resources the garbage collector deletes, as they appear in their ARN.
+const (
+	ResourceTypeNATGateway    = "natgateway"
+	ResourceTypeElasticIP     = "elastic-ip"
+	ResourceTypeSecurityGroup = "security-group"
+)
+
+// deletionOrder makes sure NAT gateways release their elastic IPs before the addresses are released.
+var deletionOrder = map[string]int{
+	ResourceTypeNATGateway:    0,
+	ResourceTypeElasticIP:     1,
+	ResourceTypeSecurityGroup: 2,
+}
+
+// ClusterResource is an AWS resource owned by a cluster.
+type ClusterResource struct {
+	// ClusterNamespace is the namespace of the cluster owning the resource.
+	ClusterNamespace string
+	// ClusterName is the name of the cluster owning the resource.
+	ClusterName string
+	// Type is the type of the resource, one of the ResourceType constants.
+	Type string
+	// ID is the ID of the resource.
+	ID string
+}
+
+// ListClusterResources returns the NAT gateways, elastic IPs and security groups that are tagged as owned
+// by a cluster of the management cluster with the given ID, in the order they should be deleted. Resources
+// of other management clusters, or created before their clusters were tagged with their namespace, are
+// never returned.
+func (s *Service) ListClusterResources(managementClusterID string) ([]ClusterResource, error) {
+	if managementClusterID == "" {
+		return nil, errors.New("a management cluster ID is required to list cluster resources")
+	}
+	input := &rgapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{
+			"ec2:" + ResourceTypeNATGateway,
+			"ec2:" + ResourceTypeElasticIP,
+			"ec2:" + ResourceTypeSecurityGroup,
+		}),
+		TagFilters: []*rgapi.TagFilter{{
+			Key:    aws.String(infrav1.ManagementClusterTagKey),
+			Values: aws.StringSlice([]string{managementClusterID}),
+		}},
+	}
+
+	var resources []ClusterResource
+	var parseErr error
+	err := s.resourceTagging.GetResourcesPages(input, func(out *rgapi.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range out.ResourceTagMappingList {
+			clusterName := ownerCluster(mapping.Tags)
+			namespace := tagValue(mapping.Tags, infrav1.ClusterNamespaceTagKey)
+			if clusterName == "" || namespace == "" || tagValue(mapping.Tags, infrav1.ManagementClusterTagKey) != managementClusterID {
+				continue
+			}
+
+			parsed, err := arn.Parse(aws.StringValue(mapping.ResourceARN))
+			if err != nil {
+				parseErr = errors.Wrapf(err, "failed to parse ARN %q", aws.StringValue(mapping.ResourceARN))
+				return false
+			}
+			parts := strings.SplitN(parsed.Resource, "/", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			resources = append(resources, ClusterResource{
+				ClusterNamespace: namespace,
+				ClusterName:      clusterName,
+				Type:             parts[0],
+				ID:               parts[1],
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cluster resources")
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return deletionOrder[resources[i].Type] < deletionOrder[resources[j].Type]
+	})
+	return resources, nil
+}
+
+// DeleteResource deletes a resource returned by ListClusterResources. Resources that no longer exist are ignored.
+func (s *Service) DeleteResource(resource ClusterResource) error {
+	var err error
+	switch resource.Type {
+	case ResourceTypeNATGateway:
+		_, err = s.ec2.DeleteNatGateway(&ec2.DeleteNatGatewayInput{NatGatewayId: aws.String(resource.ID)})
+	case ResourceTypeElasticIP:
+		_, err = s.ec2.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: aws.String(resource.ID)})
+	case ResourceTypeSecurityGroup:
+		_, err = s.ec2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: aws.String(resource.ID)})
+	default:
+		return errors.Errorf("unsupported resource type %q", resource.Type)
+	}
+	if err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete %s %q of cluster %s/%s", resource.Type, resource.ID, resource.ClusterNamespace, resource.ClusterName)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	code, _ := awserrors.Code(err)
+	switch code {
+	case awserrors.NATGatewayNotFound, awserrors.AllocationIDNotFound, awserrors.GroupNotFound:
+		return true
+	}
+	return false
+}
+
+// ownerCluster returns the name of the cluster owning a resource, or an empty string if it isn't owned by a cluster.
+func ownerCluster(tags []*rgapi.Tag) string {
+	for _, tag := range tags {
+		key := aws.StringValue(tag.Key)
+		if strings.HasPrefix(key, infrav1.NameAWSProviderOwned) && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+			return strings.TrimPrefix(key, infrav1.NameAWSProviderOwned)
+		}
+	}
+	return ""
+}
+
+// tagValue returns the value of the tag with the given key, or an empty string if there is no such tag.
+func tagValue(tags []*rgapi.Tag, key string) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
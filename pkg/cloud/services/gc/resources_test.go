@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	rgapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/golang/mock/gomock"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/gc/mock_resourcegroupstaggingapiiface"
+)
+
+func tagMapping(resourceARN string, tags map[string]string) *rgapi.ResourceTagMapping {
+	mapping := &rgapi.ResourceTagMapping{ResourceARN: aws.String(resourceARN)}
+	for key, value := range tags {
+		mapping.Tags = append(mapping.Tags, &rgapi.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return mapping
+}
+
+func TestListClusterResources(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	taggingMock := mock_resourcegroupstaggingapiiface.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
+
+	taggingMock.EXPECT().GetResourcesPages(gomock.Eq(&rgapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{"ec2:natgateway", "ec2:elastic-ip", "ec2:security-group"}),
+		TagFilters: []*rgapi.TagFilter{{
+			Key:    aws.String("sigs.k8s.io/cluster-api-provider-aws/management-cluster"),
+			Values: aws.StringSlice([]string{"mgmt"}),
+		}},
+	}), gomock.Any()).DoAndReturn(func(_ *rgapi.GetResourcesInput, fn func(*rgapi.GetResourcesOutput, bool) bool) error {
+		fn(&rgapi.GetResourcesOutput{
+			ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/deleted":    "owned",
+					"sigs.k8s.io/cluster-api-provider-aws/management-cluster": "mgmt",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster-namespace":  "default",
+				}),
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:elastic-ip/eipalloc-1", map[string]string{
+					"Name": "deleted-eip-nat",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/deleted":    "owned",
+					"sigs.k8s.io/cluster-api-provider-aws/management-cluster": "mgmt",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster-namespace":  "default",
+				}),
+			},
+		}, false)
+		fn(&rgapi.GetResourcesOutput{
+			ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:natgateway/nat-1", map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/deleted":    "owned",
+					"sigs.k8s.io/cluster-api-provider-aws/management-cluster": "mgmt",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster-namespace":  "default",
+				}),
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:security-group/sg-shared", map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/deleted": "shared",
+				}),
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:natgateway/nat-other-mgmt", map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/deleted":    "owned",
+					"sigs.k8s.io/cluster-api-provider-aws/management-cluster": "other",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster-namespace":  "default",
+				}),
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:natgateway/nat-no-namespace", map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/deleted":    "owned",
+					"sigs.k8s.io/cluster-api-provider-aws/management-cluster": "mgmt",
+				}),
+				tagMapping("arn:aws:ec2:us-east-1:123456789012:elastic-ip/eipalloc-other", map[string]string{
+					"Name": "unrelated",
+				}),
+			},
+		}, true)
+		return nil
+	})
+
+	resources, err := NewService(nil, taggingMock).ListClusterResources("mgmt")
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	expected := []ClusterResource{
+		{ClusterNamespace: "default", ClusterName: "deleted", Type: ResourceTypeNATGateway, ID: "nat-1"},
+		{ClusterNamespace: "default", ClusterName: "deleted", Type: ResourceTypeElasticIP, ID: "eipalloc-1"},
+		{ClusterNamespace: "default", ClusterName: "deleted", Type: ResourceTypeSecurityGroup, ID: "sg-1"},
+	}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Fatalf("expected resources %v, got %v", expected, resources)
+	}
+}
+
+func TestListClusterResourcesRequiresManagementClusterID(t *testing.T) {
+	if _, err := NewService(nil, nil).ListClusterResources(""); err == nil {
+		t.Fatal("expected an error without a management cluster ID")
+	}
+}
+
+func TestDeleteResource(t *testing.T) {
+	testCases := []struct {
+		name      string
+		resource  ClusterResource
+		expect    func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:     "release an elastic IP",
+			resource: ClusterResource{ClusterName: "deleted", Type: ResourceTypeElasticIP, ID: "eipalloc-1"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.ReleaseAddress(gomock.Eq(&ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-1")})).
+					Return(&ec2.ReleaseAddressOutput{}, nil)
+			},
+		},
+		{
+			name:     "delete a NAT gateway",
+			resource: ClusterResource{ClusterName: "deleted", Type: ResourceTypeNATGateway, ID: "nat-1"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeleteNatGateway(gomock.Eq(&ec2.DeleteNatGatewayInput{NatGatewayId: aws.String("nat-1")})).
+					Return(&ec2.DeleteNatGatewayOutput{}, nil)
+			},
+		},
+		{
+			name:     "security group already deleted",
+			resource: ClusterResource{ClusterName: "deleted", Type: ResourceTypeSecurityGroup, ID: "sg-1"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeleteSecurityGroup(gomock.Eq(&ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-1")})).
+					Return(nil, awserr.New("InvalidGroup.NotFound", "not found", nil))
+			},
+		},
+		{
+			name:     "security group still in use",
+			resource: ClusterResource{ClusterName: "deleted", Type: ResourceTypeSecurityGroup, ID: "sg-1"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DeleteSecurityGroup(gomock.Any()).
+					Return(nil, awserr.New("DependencyViolation", "in use", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			err := NewService(ec2Mock, nil).DeleteResource(tc.resource)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+		})
+	}
+}
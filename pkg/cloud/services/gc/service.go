@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// Service finds and deletes the AWS resources of a region owned by clusters.
+// Unlike the other services it is not scoped to a cluster.
+type Service struct {
+	ec2             ec2iface.EC2API
+	resourceTagging resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+}
+
+// NewService returns a new service given the api clients.
+func NewService(ec2Client ec2iface.EC2API, resourceTagging resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI) *Service {
+	return &Service{
+		ec2:             ec2Client,
+		resourceTagging: resourceTagging,
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/api/iam/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// KarpenterNamespace is the namespace Karpenter is installed in.
+	KarpenterNamespace = "karpenter"
+
+	// KarpenterServiceAccountName is the name of the service account of the Karpenter controller.
+	KarpenterServiceAccountName = "karpenter"
+
+	// karpenterControllerPolicyName is the name of the inline policy of the role of the Karpenter controller.
+	karpenterControllerPolicyName = "karpenter-controller"
+)
+
+// KarpenterControllerRoleName returns the name of the IAM role of the Karpenter controller of the cluster.
+func KarpenterControllerRoleName(clusterName string) string {
+	return fmt.Sprintf("%s-karpenter", clusterName)
+}
+
+// KarpenterInstanceProfileName returns the name of the instance profile of the nodes launched by the Karpenter
+// installation of the cluster.
+func KarpenterInstanceProfileName(clusterName string) string {
+	return fmt.Sprintf("%s-karpenter-node", clusterName)
+}
+
+// ReconcileKarpenterIAM creates the IAM role assumed by the Karpenter controller through IRSA, with a policy
+// allowing it to launch nodes and to consume its interruption queue, and the instance profile of the nodes it
+// launches. The interruption queue must be reconciled first.
+func (s *Service) ReconcileKarpenterIAM() error {
+	spec := s.scope.Karpenter()
+	if spec == nil {
+		return nil
+	}
+	status := s.scope.KarpenterStatus()
+
+	nodeRoleName, err := roleNameFromARN(spec.IAMRoleARN)
+	if err != nil {
+		return err
+	}
+
+	providerARN, err := s.oidcProviderARN()
+	if err != nil {
+		return err
+	}
+	roleSpec := s.karpenterControllerRoleSpec()
+	roleARN, err := s.reconcileServiceAccountRole(providerARN, roleSpec)
+	if err != nil {
+		return err
+	}
+	policy, err := karpenterControllerPolicy(spec.IAMRoleARN, status.InterruptionQueueARN)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build policy of IAM role %q", roleSpec.RoleName)
+	}
+	if err := s.reconcileInlinePolicy(roleSpec.RoleName, karpenterControllerPolicyName, policy); err != nil {
+		return err
+	}
+	status.ControllerRoleARN = roleARN
+
+	profileName := KarpenterInstanceProfileName(s.scope.Name())
+	if err := s.reconcileInstanceProfile(profileName, nodeRoleName); err != nil {
+		return err
+	}
+	status.InstanceProfileName = profileName
+
+	return nil
+}
+
+// DeleteKarpenterIAM deletes the instance profile of the nodes launched by Karpenter and the IAM role of the
+// Karpenter controller, if any.
+func (s *Service) DeleteKarpenterIAM() error {
+	status := s.scope.AWSCluster.Status.Karpenter
+	if status == nil {
+		return nil
+	}
+
+	if status.InstanceProfileName != "" {
+		if err := s.deleteInstanceProfile(status.InstanceProfileName); err != nil {
+			return err
+		}
+		status.InstanceProfileName = ""
+	}
+
+	if status.ControllerRoleARN != "" {
+		roleName := KarpenterControllerRoleName(s.scope.Name())
+		if _, err := s.scope.IAM.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(karpenterControllerPolicyName),
+		}); err != nil && !isNoSuchEntity(err) {
+			return errors.Wrapf(err, "failed to delete policy %q of IAM role %q", karpenterControllerPolicyName, roleName)
+		}
+		if err := s.deleteRole(roleName); err != nil {
+			return err
+		}
+		status.ControllerRoleARN = ""
+	}
+
+	return nil
+}
+
+func (s *Service) karpenterControllerRoleSpec() infrav1.ServiceAccountRoleSpec {
+	return infrav1.ServiceAccountRoleSpec{
+		Namespace:          KarpenterNamespace,
+		ServiceAccountName: KarpenterServiceAccountName,
+		RoleName:           KarpenterControllerRoleName(s.scope.Name()),
+	}
+}
+
+// reconcileInlinePolicy puts the inline policy of the role unless it is already up to date.
+func (s *Service) reconcileInlinePolicy(roleName, policyName, policy string) error {
+	out, err := s.scope.IAM.GetRolePolicy(&iam.GetRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(policyName),
+	})
+	switch {
+	case isNoSuchEntity(err):
+	case err != nil:
+		return errors.Wrapf(err, "failed to get policy %q of IAM role %q", policyName, roleName)
+	default:
+		current, err := url.QueryUnescape(aws.StringValue(out.PolicyDocument))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode policy %q of IAM role %q", policyName, roleName)
+		}
+		if samePolicy(current, policy) {
+			return nil
+		}
+	}
+
+	if _, err := s.scope.IAM.PutRolePolicy(&iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(policyName),
+		PolicyDocument: aws.String(policy),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to put policy %q of IAM role %q", policyName, roleName)
+	}
+	s.scope.V(2).Info("Put inline policy of IAM role", "role", roleName, "policy", policyName)
+	return nil
+}
+
+// reconcileInstanceProfile creates the instance profile and makes the given role its only role.
+func (s *Service) reconcileInstanceProfile(profileName, roleName string) error {
+	var roles []*iam.Role
+	out, err := s.scope.IAM.GetInstanceProfile(&iam.GetInstanceProfileInput{InstanceProfileName: aws.String(profileName)})
+	switch {
+	case isNoSuchEntity(err):
+		if _, err := s.scope.IAM.CreateInstanceProfile(&iam.CreateInstanceProfileInput{
+			InstanceProfileName: aws.String(profileName),
+			Tags:                s.roleTags(profileName),
+		}); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedCreateInstanceProfile", "Failed to create instance profile %q: %v", profileName, err)
+			return errors.Wrapf(err, "failed to create instance profile %q", profileName)
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateInstanceProfile", "Created instance profile %q", profileName)
+	case err != nil:
+		return errors.Wrapf(err, "failed to get instance profile %q", profileName)
+	default:
+		if !s.isOwnedProfile(out.InstanceProfile) {
+			return errors.Errorf("instance profile %q already exists and is not owned by the cluster", profileName)
+		}
+		roles = out.InstanceProfile.Roles
+	}
+
+	found := false
+	for _, role := range roles {
+		if aws.StringValue(role.RoleName) == roleName {
+			found = true
+			continue
+		}
+		if _, err := s.scope.IAM.RemoveRoleFromInstanceProfile(&iam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(profileName),
+			RoleName:            role.RoleName,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to remove IAM role %q from instance profile %q", aws.StringValue(role.RoleName), profileName)
+		}
+	}
+	if found {
+		return nil
+	}
+
+	if _, err := s.scope.IAM.AddRoleToInstanceProfile(&iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+		RoleName:            aws.String(roleName),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add IAM role %q to instance profile %q", roleName, profileName)
+	}
+	return nil
+}
+
+func (s *Service) deleteInstanceProfile(profileName string) error {
+	out, err := s.scope.IAM.GetInstanceProfile(&iam.GetInstanceProfileInput{InstanceProfileName: aws.String(profileName)})
+	if isNoSuchEntity(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance profile %q", profileName)
+	}
+
+	for _, role := range out.InstanceProfile.Roles {
+		if _, err := s.scope.IAM.RemoveRoleFromInstanceProfile(&iam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(profileName),
+			RoleName:            role.RoleName,
+		}); err != nil && !isNoSuchEntity(err) {
+			return errors.Wrapf(err, "failed to remove IAM role %q from instance profile %q", aws.StringValue(role.RoleName), profileName)
+		}
+	}
+
+	if _, err := s.scope.IAM.DeleteInstanceProfile(&iam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+	}); err != nil && !isNoSuchEntity(err) {
+		record.Warnf(s.scope.AWSCluster, "FailedDeleteInstanceProfile", "Failed to delete instance profile %q: %v", profileName, err)
+		return errors.Wrapf(err, "failed to delete instance profile %q", profileName)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteInstanceProfile", "Deleted instance profile %q", profileName)
+	return nil
+}
+
+func (s *Service) isOwnedProfile(profile *iam.InstanceProfile) bool {
+	key := infrav1.ClusterTagKey(s.scope.Name())
+	for _, tag := range profile.Tags {
+		if aws.StringValue(tag.Key) == key && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+			return true
+		}
+	}
+	return false
+}
+
+// karpenterControllerPolicy returns the policy allowing the Karpenter controller to launch and terminate nodes
+// with the node role, and to consume the events of its interruption queue.
+func karpenterControllerPolicy(nodeRoleARN, queueARN string) (string, error) {
+	return converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: iamv1.Statements{
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{"*"},
+				Action: iamv1.Actions{
+					"ec2:CreateFleet",
+					"ec2:CreateLaunchTemplate",
+					"ec2:CreateTags",
+					"ec2:DeleteLaunchTemplate",
+					"ec2:DescribeAvailabilityZones",
+					"ec2:DescribeImages",
+					"ec2:DescribeInstances",
+					"ec2:DescribeInstanceTypeOfferings",
+					"ec2:DescribeInstanceTypes",
+					"ec2:DescribeLaunchTemplates",
+					"ec2:DescribeSecurityGroups",
+					"ec2:DescribeSpotPriceHistory",
+					"ec2:DescribeSubnets",
+					"ec2:RunInstances",
+					"ec2:TerminateInstances",
+					"pricing:GetProducts",
+					"ssm:GetParameter",
+				},
+			},
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{nodeRoleARN},
+				Action:   iamv1.Actions{"iam:PassRole"},
+			},
+			{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources{queueARN},
+				Action: iamv1.Actions{
+					"sqs:DeleteMessage",
+					"sqs:GetQueueAttributes",
+					"sqs:GetQueueUrl",
+					"sqs:ReceiveMessage",
+				},
+			},
+		},
+	})
+}
+
+// roleNameFromARN returns the name of the IAM role with the given ARN, without its path.
+func roleNameFromARN(roleARN string) (string, error) {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil || !strings.HasPrefix(parsed.Resource, "role/") {
+		return "", errors.Errorf("invalid IAM role ARN %q", roleARN)
+	}
+	return parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:], nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam/mock_iamiface"
+)
+
+const (
+	karpenterRoleARN = "arn:aws:iam::123456789012:role/test-cluster-karpenter"
+	nodeRoleARN      = "arn:aws:iam::123456789012:role/nodes/karpenter-node"
+	queueARN         = "arn:aws:sqs:us-east-1:123456789012:test-cluster-karpenter-interruption"
+)
+
+func newKarpenterCluster() *infrav1.AWSCluster {
+	return &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			OIDCIssuerURL: "https://oidc.example.com/test",
+			Karpenter:     &infrav1.KarpenterSpec{Version: "v0.27.0", IAMRoleARN: nodeRoleARN},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Karpenter: &infrav1.KarpenterStatus{InterruptionQueueARN: queueARN},
+		},
+	}
+}
+
+func TestReconcileKarpenterIAMCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Eq(&iam.GetRoleInput{RoleName: aws.String("test-cluster-karpenter")})).
+		Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	m.CreateRole(gomock.AssignableToTypeOf(&iam.CreateRoleInput{})).
+		DoAndReturn(func(input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+			if policy := aws.StringValue(input.AssumeRolePolicyDocument); !strings.Contains(policy, "system:serviceaccount:karpenter:karpenter") {
+				t.Errorf("expected a trust policy for the karpenter service account, got %s", policy)
+			}
+			return &iam.CreateRoleOutput{Role: &iam.Role{Arn: aws.String(karpenterRoleARN)}}, nil
+		})
+	expectAttachedPolicies(m, "test-cluster-karpenter")
+	m.GetRolePolicy(gomock.Eq(&iam.GetRolePolicyInput{
+		RoleName:   aws.String("test-cluster-karpenter"),
+		PolicyName: aws.String("karpenter-controller"),
+	})).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	m.PutRolePolicy(gomock.AssignableToTypeOf(&iam.PutRolePolicyInput{})).
+		DoAndReturn(func(input *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+			policy := aws.StringValue(input.PolicyDocument)
+			if !strings.Contains(policy, nodeRoleARN) || !strings.Contains(policy, queueARN) {
+				t.Errorf("expected a policy passing the node role and reading the interruption queue, got %s", policy)
+			}
+			return &iam.PutRolePolicyOutput{}, nil
+		})
+	m.GetInstanceProfile(gomock.Eq(&iam.GetInstanceProfileInput{InstanceProfileName: aws.String("test-cluster-karpenter-node")})).
+		Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	m.CreateInstanceProfile(gomock.AssignableToTypeOf(&iam.CreateInstanceProfileInput{})).
+		Return(&iam.CreateInstanceProfileOutput{}, nil)
+	m.AddRoleToInstanceProfile(gomock.Eq(&iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String("test-cluster-karpenter-node"),
+		RoleName:            aws.String("karpenter-node"),
+	})).Return(&iam.AddRoleToInstanceProfileOutput{}, nil)
+
+	clusterScope := newIAMTestScope(t, iamMock, newKarpenterCluster())
+	if err := NewService(clusterScope).ReconcileKarpenterIAM(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	status := clusterScope.AWSCluster.Status.Karpenter
+	if status.ControllerRoleARN != karpenterRoleARN || status.InstanceProfileName != "test-cluster-karpenter-node" {
+		t.Fatalf("expected the controller role and instance profile in the status, got %+v", status)
+	}
+}
+
+func TestReconcileKarpenterIAMUpToDate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	trustPolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+		`"Principal":{"Federated":"` + providerARN + `"},` +
+		`"Action":"sts:AssumeRoleWithWebIdentity","Condition":{"StringEquals":{` +
+		`"oidc.example.com/test:aud":"sts.amazonaws.com",` +
+		`"oidc.example.com/test:sub":"system:serviceaccount:karpenter:karpenter"}}}]}`
+	policy, err := karpenterControllerPolicy(nodeRoleARN, queueARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// IAM returns compact, URL encoded policies.
+	compact := map[string]interface{}{}
+	_ = json.Unmarshal([]byte(policy), &compact)
+	compactPolicy, _ := json.Marshal(compact)
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{Role: &iam.Role{
+		Arn:                      aws.String(karpenterRoleARN),
+		AssumeRolePolicyDocument: aws.String(url.QueryEscape(trustPolicy)),
+		Tags:                     ownedTags,
+	}}, nil)
+	expectAttachedPolicies(m, "test-cluster-karpenter")
+	m.GetRolePolicy(gomock.Any()).Return(&iam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(string(compactPolicy)))}, nil)
+	m.GetInstanceProfile(gomock.Any()).Return(&iam.GetInstanceProfileOutput{InstanceProfile: &iam.InstanceProfile{
+		Roles: []*iam.Role{{RoleName: aws.String("karpenter-node")}},
+		Tags:  ownedTags,
+	}}, nil)
+
+	if err := NewService(newIAMTestScope(t, iamMock, newKarpenterCluster())).ReconcileKarpenterIAM(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestReconcileKarpenterIAMReplacesNodeRole(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	m.CreateRole(gomock.Any()).Return(&iam.CreateRoleOutput{Role: &iam.Role{Arn: aws.String(karpenterRoleARN)}}, nil)
+	expectAttachedPolicies(m, "test-cluster-karpenter")
+	m.GetRolePolicy(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	m.PutRolePolicy(gomock.Any()).Return(&iam.PutRolePolicyOutput{}, nil)
+	m.GetInstanceProfile(gomock.Any()).Return(&iam.GetInstanceProfileOutput{InstanceProfile: &iam.InstanceProfile{
+		Roles: []*iam.Role{{RoleName: aws.String("previous-node")}},
+		Tags:  ownedTags,
+	}}, nil)
+	m.RemoveRoleFromInstanceProfile(gomock.Eq(&iam.RemoveRoleFromInstanceProfileInput{
+		InstanceProfileName: aws.String("test-cluster-karpenter-node"),
+		RoleName:            aws.String("previous-node"),
+	})).Return(&iam.RemoveRoleFromInstanceProfileOutput{}, nil)
+	m.AddRoleToInstanceProfile(gomock.Eq(&iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String("test-cluster-karpenter-node"),
+		RoleName:            aws.String("karpenter-node"),
+	})).Return(&iam.AddRoleToInstanceProfileOutput{}, nil)
+
+	if err := NewService(newIAMTestScope(t, iamMock, newKarpenterCluster())).ReconcileKarpenterIAM(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestDeleteKarpenterIAM(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	awsCluster := newKarpenterCluster()
+	awsCluster.Status.Karpenter.ControllerRoleARN = karpenterRoleARN
+	awsCluster.Status.Karpenter.InstanceProfileName = "test-cluster-karpenter-node"
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	m.GetInstanceProfile(gomock.Any()).Return(&iam.GetInstanceProfileOutput{InstanceProfile: &iam.InstanceProfile{
+		Roles: []*iam.Role{{RoleName: aws.String("karpenter-node")}},
+	}}, nil)
+	m.RemoveRoleFromInstanceProfile(gomock.Any()).Return(&iam.RemoveRoleFromInstanceProfileOutput{}, nil)
+	m.DeleteInstanceProfile(gomock.Eq(&iam.DeleteInstanceProfileInput{InstanceProfileName: aws.String("test-cluster-karpenter-node")})).
+		Return(&iam.DeleteInstanceProfileOutput{}, nil)
+	m.DeleteRolePolicy(gomock.Eq(&iam.DeleteRolePolicyInput{
+		RoleName:   aws.String("test-cluster-karpenter"),
+		PolicyName: aws.String("karpenter-controller"),
+	})).Return(&iam.DeleteRolePolicyOutput{}, nil)
+	expectAttachedPolicies(m, "test-cluster-karpenter")
+	m.DeleteRole(gomock.Eq(&iam.DeleteRoleInput{RoleName: aws.String("test-cluster-karpenter")})).Return(&iam.DeleteRoleOutput{}, nil)
+
+	clusterScope := newIAMTestScope(t, iamMock, awsCluster)
+	if err := NewService(clusterScope).DeleteKarpenterIAM(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if status := clusterScope.AWSCluster.Status.Karpenter; status.ControllerRoleARN != "" || status.InstanceProfileName != "" {
+		t.Fatalf("expected the controller role and instance profile to be removed from the status, got %+v", status)
+	}
+}
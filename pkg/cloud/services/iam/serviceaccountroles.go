@@ -0,0 +1,367 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/api/iam/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// stsAudience is the audience of the service account tokens exchanged for IAM credentials.
+const stsAudience = "sts.amazonaws.com"
+
+// ReconcileServiceAccountRoles creates the IAM roles of the cluster's service accounts, keeps their
+// trust policies and attached policies up to date, and deletes the roles removed from the spec when
+// DeleteUnusedRoles is set. Roles removed from the spec otherwise are left in the account.
+func (s *Service) ReconcileServiceAccountRoles() error {
+	status := &s.scope.AWSCluster.Status
+	desired := map[string]bool{}
+
+	if specs := s.scope.ServiceAccountRoles(); len(specs) > 0 {
+		providerARN, err := s.oidcProviderARN()
+		if err != nil {
+			return err
+		}
+
+		for _, spec := range specs {
+			desired[spec.RoleName] = true
+			arn, err := s.reconcileServiceAccountRole(providerARN, spec)
+			if err != nil {
+				return err
+			}
+			if status.ServiceAccountRoles == nil {
+				status.ServiceAccountRoles = map[string]string{}
+			}
+			status.ServiceAccountRoles[spec.RoleName] = arn
+		}
+	}
+
+	for _, name := range sortedRoleNames(status.ServiceAccountRoles) {
+		if desired[name] {
+			continue
+		}
+		if s.scope.AWSCluster.Spec.DeleteUnusedRoles {
+			if err := s.deleteRole(name); err != nil {
+				return err
+			}
+		}
+		delete(status.ServiceAccountRoles, name)
+	}
+	if len(status.ServiceAccountRoles) == 0 {
+		status.ServiceAccountRoles = nil
+	}
+
+	return nil
+}
+
+// DeleteServiceAccountRoles deletes all IAM roles created for the cluster's service accounts.
+func (s *Service) DeleteServiceAccountRoles() error {
+	status := &s.scope.AWSCluster.Status
+	for _, name := range sortedRoleNames(status.ServiceAccountRoles) {
+		if err := s.deleteRole(name); err != nil {
+			return err
+		}
+		delete(status.ServiceAccountRoles, name)
+	}
+	status.ServiceAccountRoles = nil
+
+	return nil
+}
+
+// reconcileServiceAccountRole creates the role, or updates the trust policy of an existing role owned by
+// the cluster, attaches the policies of its spec, and returns its ARN.
+func (s *Service) reconcileServiceAccountRole(providerARN string, spec infrav1.ServiceAccountRoleSpec) (string, error) {
+	trustPolicy, err := s.trustPolicy(providerARN, spec)
+	if err != nil {
+		return "", err
+	}
+
+	var role *iam.Role
+	out, err := s.scope.IAM.GetRole(&iam.GetRoleInput{RoleName: aws.String(spec.RoleName)})
+	switch {
+	case isNoSuchEntity(err):
+		created, err := s.scope.IAM.CreateRole(&iam.CreateRoleInput{
+			RoleName:                 aws.String(spec.RoleName),
+			AssumeRolePolicyDocument: aws.String(trustPolicy),
+			Description:              aws.String(fmt.Sprintf("Role of service account %s/%s", spec.Namespace, spec.ServiceAccountName)),
+			Tags:                     s.roleTags(spec.RoleName),
+		})
+		if err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedCreateServiceAccountRole", "Failed to create IAM role %q: %v", spec.RoleName, err)
+			return "", errors.Wrapf(err, "failed to create IAM role %q", spec.RoleName)
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateServiceAccountRole", "Created IAM role %q for service account %s/%s", spec.RoleName, spec.Namespace, spec.ServiceAccountName)
+		role = created.Role
+	case err != nil:
+		return "", errors.Wrapf(err, "failed to get IAM role %q", spec.RoleName)
+	default:
+		role = out.Role
+		if !s.isOwned(role) {
+			return "", errors.Errorf("IAM role %q already exists and is not owned by the cluster", spec.RoleName)
+		}
+
+		current, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to decode trust policy of IAM role %q", spec.RoleName)
+		}
+		if !samePolicy(current, trustPolicy) {
+			if _, err := s.scope.IAM.UpdateAssumeRolePolicy(&iam.UpdateAssumeRolePolicyInput{
+				RoleName:       aws.String(spec.RoleName),
+				PolicyDocument: aws.String(trustPolicy),
+			}); err != nil {
+				record.Warnf(s.scope.AWSCluster, "FailedUpdateServiceAccountRole", "Failed to update trust policy of IAM role %q: %v", spec.RoleName, err)
+				return "", errors.Wrapf(err, "failed to update trust policy of IAM role %q", spec.RoleName)
+			}
+			record.Eventf(s.scope.AWSCluster, "SuccessfulUpdateServiceAccountRole", "Updated trust policy of IAM role %q", spec.RoleName)
+		}
+	}
+
+	if err := s.reconcileRolePolicies(spec); err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(role.Arn), nil
+}
+
+// reconcileRolePolicies attaches the policies of the spec to the role and detaches the other ones.
+func (s *Service) reconcileRolePolicies(spec infrav1.ServiceAccountRoleSpec) error {
+	attached, err := s.attachedPolicies(spec.RoleName)
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]bool{}
+	for _, arn := range spec.PolicyARNs {
+		desired[arn] = true
+		if attached[arn] {
+			continue
+		}
+		if _, err := s.scope.IAM.AttachRolePolicy(&iam.AttachRolePolicyInput{
+			RoleName:  aws.String(spec.RoleName),
+			PolicyArn: aws.String(arn),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to attach policy %q to IAM role %q", arn, spec.RoleName)
+		}
+	}
+
+	for _, arn := range sortedPolicyARNs(attached) {
+		if desired[arn] {
+			continue
+		}
+		if err := s.detachPolicy(spec.RoleName, arn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deleteRole(name string) error {
+	attached, err := s.attachedPolicies(name)
+	if isNoSuchEntity(errors.Cause(err)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, arn := range sortedPolicyARNs(attached) {
+		if err := s.detachPolicy(name, arn); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.scope.IAM.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(name)}); err != nil {
+		if isNoSuchEntity(err) {
+			return nil
+		}
+		record.Warnf(s.scope.AWSCluster, "FailedDeleteServiceAccountRole", "Failed to delete IAM role %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete IAM role %q", name)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteServiceAccountRole", "Deleted IAM role %q", name)
+	return nil
+}
+
+func (s *Service) attachedPolicies(roleName string) (map[string]bool, error) {
+	attached := map[string]bool{}
+	err := s.scope.IAM.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}, func(out *iam.ListAttachedRolePoliciesOutput, _ bool) bool {
+		for _, p := range out.AttachedPolicies {
+			attached[aws.StringValue(p.PolicyArn)] = true
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list policies attached to IAM role %q", roleName)
+	}
+	return attached, nil
+}
+
+func (s *Service) detachPolicy(roleName, arn string) error {
+	if _, err := s.scope.IAM.DetachRolePolicy(&iam.DetachRolePolicyInput{
+		RoleName:  aws.String(roleName),
+		PolicyArn: aws.String(arn),
+	}); err != nil && !isNoSuchEntity(err) {
+		return errors.Wrapf(err, "failed to detach policy %q from IAM role %q", arn, roleName)
+	}
+	return nil
+}
+
+// oidcProviderARN returns the ARN of the IAM OIDC identity provider of the cluster's issuer.
+func (s *Service) oidcProviderARN() (string, error) {
+	out, err := s.scope.IAM.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list IAM OIDC identity providers")
+	}
+
+	suffix := ":oidc-provider/" + s.issuer()
+	for _, p := range out.OpenIDConnectProviderList {
+		if arn := aws.StringValue(p.Arn); strings.HasSuffix(arn, suffix) {
+			return arn, nil
+		}
+	}
+	return "", errors.Errorf("no IAM OIDC identity provider found for issuer %q", s.scope.AWSCluster.Spec.OIDCIssuerURL)
+}
+
+// issuer returns the issuer URL of the cluster without its scheme, as used in IAM condition keys.
+func (s *Service) issuer() string {
+	return strings.TrimSuffix(strings.TrimPrefix(s.scope.AWSCluster.Spec.OIDCIssuerURL, "https://"), "/")
+}
+
+// trustPolicy returns the policy allowing the service account of the spec to assume its role
+// with a token issued by the cluster.
+func (s *Service) trustPolicy(providerARN string, spec infrav1.ServiceAccountRoleSpec) (string, error) {
+	issuer := s.issuer()
+	return converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: iamv1.Statements{
+			{
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalFederated: iamv1.PrincipalID{providerARN}},
+				Action:    iamv1.Actions{"sts:AssumeRoleWithWebIdentity"},
+				Condition: iamv1.Conditions{
+					iamv1.StringEquals: map[string]string{
+						issuer + ":sub": fmt.Sprintf("system:serviceaccount:%s:%s", spec.Namespace, spec.ServiceAccountName),
+						issuer + ":aud": stsAudience,
+					},
+				},
+			},
+		},
+	})
+}
+
+func (s *Service) roleTags(name string) []*iam.Tag {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	iamTags := make([]*iam.Tag, 0, len(keys))
+	for _, k := range keys {
+		iamTags = append(iamTags, &iam.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return iamTags
+}
+
+func (s *Service) isOwned(role *iam.Role) bool {
+	key := infrav1.ClusterTagKey(s.scope.Name())
+	for _, tag := range role.Tags {
+		if aws.StringValue(tag.Key) == key && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+			return true
+		}
+	}
+	return false
+}
+
+// samePolicy returns true if both JSON policy documents are equivalent. IAM returns single
+// element lists as plain values, so these are normalized before comparing the documents.
+func samePolicy(a, b string) bool {
+	var docA, docB interface{}
+	if err := json.Unmarshal([]byte(a), &docA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &docB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizePolicy(docA), normalizePolicy(docB))
+}
+
+func normalizePolicy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		if len(v) == 1 {
+			return normalizePolicy(v[0])
+		}
+		for i := range v {
+			v[i] = normalizePolicy(v[i])
+		}
+		return v
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = normalizePolicy(v[k])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isNoSuchEntity(err error) bool {
+	code, ok := awserrors.Code(err)
+	return ok && code == iam.ErrCodeNoSuchEntityException
+}
+
+func sortedRoleNames(arns map[string]string) []string {
+	names := make([]string, 0, len(arns))
+	for name := range arns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedPolicyARNs(attached map[string]bool) []string {
+	arns := make([]string, 0, len(attached))
+	for arn := range attached {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+	return arns
+}
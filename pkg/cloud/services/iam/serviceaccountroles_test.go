@@ -0,0 +1,300 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam/mock_iamiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	providerARN = "arn:aws:iam::123456789012:oidc-provider/oidc.example.com/test"
+	roleARN     = "arn:aws:iam::123456789012:role/test-ebs-csi"
+	policyARN   = "arn:aws:iam::aws:policy/service-role/AmazonEBSCSIDriverPolicy"
+)
+
+// awsTrustPolicy is the trust policy of the ebs-csi-controller role, as returned by IAM.
+const awsTrustPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+	`"Principal":{"Federated":"arn:aws:iam::123456789012:oidc-provider/oidc.example.com/test"},` +
+	`"Action":"sts:AssumeRoleWithWebIdentity","Condition":{"StringEquals":{` +
+	`"oidc.example.com/test:aud":"sts.amazonaws.com",` +
+	`"oidc.example.com/test:sub":"system:serviceaccount:kube-system:ebs-csi-controller"}}}]}`
+
+var ebsCSIRole = infrav1.ServiceAccountRoleSpec{
+	Namespace:          "kube-system",
+	ServiceAccountName: "ebs-csi-controller",
+	RoleName:           "test-ebs-csi",
+	PolicyARNs:         []string{policyARN},
+}
+
+var ownedTags = []*iam.Tag{
+	{Key: aws.String("Name"), Value: aws.String("test-ebs-csi")},
+	{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+}
+
+func newIAMTestScope(t *testing.T, iamMock *mock_iamiface.MockIAMAPI, awsCluster *infrav1.AWSCluster) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			IAM: iamMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func newIRSACluster(roles ...infrav1.ServiceAccountRoleSpec) *infrav1.AWSCluster {
+	return &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			OIDCIssuerURL:      "https://oidc.example.com/test",
+			ServiceAccountRole: roles,
+		},
+	}
+}
+
+func expectOIDCProviders(m *mock_iamiface.MockIAMAPIMockRecorder) {
+	m.ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+		OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+			{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/oidc.example.com/other")},
+			{Arn: aws.String(providerARN)},
+		},
+	}, nil)
+}
+
+func expectAttachedPolicies(m *mock_iamiface.MockIAMAPIMockRecorder, roleName string, arns ...string) {
+	m.ListAttachedRolePoliciesPages(gomock.Eq(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}), gomock.Any()).
+		DoAndReturn(func(_ *iam.ListAttachedRolePoliciesInput, fn func(*iam.ListAttachedRolePoliciesOutput, bool) bool) error {
+			out := &iam.ListAttachedRolePoliciesOutput{}
+			for _, arn := range arns {
+				out.AttachedPolicies = append(out.AttachedPolicies, &iam.AttachedPolicy{PolicyArn: aws.String(arn)})
+			}
+			fn(out, true)
+			return nil
+		})
+}
+
+func TestReconcileServiceAccountRolesCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Eq(&iam.GetRoleInput{RoleName: aws.String("test-ebs-csi")})).
+		Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	m.CreateRole(gomock.AssignableToTypeOf(&iam.CreateRoleInput{})).
+		DoAndReturn(func(input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+			if !samePolicy(aws.StringValue(input.AssumeRolePolicyDocument), awsTrustPolicy) {
+				t.Errorf("unexpected trust policy: %s", aws.StringValue(input.AssumeRolePolicyDocument))
+			}
+			if !reflect.DeepEqual(input.Tags, ownedTags) {
+				t.Errorf("unexpected tags: %v", input.Tags)
+			}
+			return &iam.CreateRoleOutput{Role: &iam.Role{Arn: aws.String(roleARN)}}, nil
+		})
+	expectAttachedPolicies(m, "test-ebs-csi")
+	m.AttachRolePolicy(gomock.Eq(&iam.AttachRolePolicyInput{
+		RoleName:  aws.String("test-ebs-csi"),
+		PolicyArn: aws.String(policyARN),
+	})).Return(&iam.AttachRolePolicyOutput{}, nil)
+
+	s := NewService(newIAMTestScope(t, iamMock, newIRSACluster(ebsCSIRole)))
+	if err := s.ReconcileServiceAccountRoles(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"test-ebs-csi": roleARN}
+	if got := s.scope.AWSCluster.Status.ServiceAccountRoles; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected status %v, got %v", expected, got)
+	}
+}
+
+func TestReconcileServiceAccountRolesUpToDate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{
+		Role: &iam.Role{
+			Arn:                      aws.String(roleARN),
+			AssumeRolePolicyDocument: aws.String(url.QueryEscape(awsTrustPolicy)),
+			Tags:                     ownedTags,
+		},
+	}, nil)
+	expectAttachedPolicies(m, "test-ebs-csi", policyARN)
+
+	s := NewService(newIAMTestScope(t, iamMock, newIRSACluster(ebsCSIRole)))
+	if err := s.ReconcileServiceAccountRoles(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestReconcileServiceAccountRolesUpdateTrustPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// The service account of the role moved to another namespace, and the role lost a policy.
+	role := ebsCSIRole
+	role.Namespace = "storage"
+	role.PolicyARNs = nil
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{
+		Role: &iam.Role{
+			Arn:                      aws.String(roleARN),
+			AssumeRolePolicyDocument: aws.String(url.QueryEscape(awsTrustPolicy)),
+			Tags:                     ownedTags,
+		},
+	}, nil)
+	m.UpdateAssumeRolePolicy(gomock.AssignableToTypeOf(&iam.UpdateAssumeRolePolicyInput{})).
+		DoAndReturn(func(input *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+			if samePolicy(aws.StringValue(input.PolicyDocument), awsTrustPolicy) {
+				t.Error("expected the trust policy to change")
+			}
+			return &iam.UpdateAssumeRolePolicyOutput{}, nil
+		})
+	expectAttachedPolicies(m, "test-ebs-csi", policyARN)
+	m.DetachRolePolicy(gomock.Eq(&iam.DetachRolePolicyInput{
+		RoleName:  aws.String("test-ebs-csi"),
+		PolicyArn: aws.String(policyARN),
+	})).Return(&iam.DetachRolePolicyOutput{}, nil)
+
+	s := NewService(newIAMTestScope(t, iamMock, newIRSACluster(role)))
+	if err := s.ReconcileServiceAccountRoles(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestReconcileServiceAccountRolesNotOwned(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	expectOIDCProviders(m)
+	m.GetRole(gomock.Any()).Return(&iam.GetRoleOutput{
+		Role: &iam.Role{Arn: aws.String(roleARN)},
+	}, nil)
+
+	s := NewService(newIAMTestScope(t, iamMock, newIRSACluster(ebsCSIRole)))
+	if err := s.ReconcileServiceAccountRoles(); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestReconcileServiceAccountRolesDeleteUnused(t *testing.T) {
+	testCases := []struct {
+		name              string
+		deleteUnusedRoles bool
+		expect            func(m *mock_iamiface.MockIAMAPIMockRecorder)
+	}{
+		{
+			name:              "unused roles are deleted",
+			deleteUnusedRoles: true,
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {
+				expectAttachedPolicies(m, "test-ebs-csi", policyARN)
+				m.DetachRolePolicy(gomock.Eq(&iam.DetachRolePolicyInput{
+					RoleName:  aws.String("test-ebs-csi"),
+					PolicyArn: aws.String(policyARN),
+				})).Return(&iam.DetachRolePolicyOutput{}, nil)
+				m.DeleteRole(gomock.Eq(&iam.DeleteRoleInput{RoleName: aws.String("test-ebs-csi")})).
+					Return(&iam.DeleteRoleOutput{}, nil)
+			},
+		},
+		{
+			name:   "unused roles are left in the account",
+			expect: func(m *mock_iamiface.MockIAMAPIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+			tc.expect(iamMock.EXPECT())
+
+			awsCluster := newIRSACluster()
+			awsCluster.Spec.DeleteUnusedRoles = tc.deleteUnusedRoles
+			awsCluster.Status.ServiceAccountRoles = map[string]string{"test-ebs-csi": roleARN}
+
+			s := NewService(newIAMTestScope(t, iamMock, awsCluster))
+			if err := s.ReconcileServiceAccountRoles(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if s.scope.AWSCluster.Status.ServiceAccountRoles != nil {
+				t.Fatalf("expected status to be empty, got %v", s.scope.AWSCluster.Status.ServiceAccountRoles)
+			}
+		})
+	}
+}
+
+func TestDeleteServiceAccountRoles(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	iamMock := mock_iamiface.NewMockIAMAPI(mockCtrl)
+	m := iamMock.EXPECT()
+	// Roles deleted out of band are ignored.
+	m.ListAttachedRolePoliciesPages(gomock.Eq(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String("test-autoscaler")}), gomock.Any()).
+		Return(awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+	expectAttachedPolicies(m, "test-ebs-csi")
+	m.DeleteRole(gomock.Eq(&iam.DeleteRoleInput{RoleName: aws.String("test-ebs-csi")})).
+		Return(&iam.DeleteRoleOutput{}, nil)
+
+	awsCluster := newIRSACluster(ebsCSIRole)
+	awsCluster.Status.ServiceAccountRoles = map[string]string{
+		"test-ebs-csi":    roleARN,
+		"test-autoscaler": "arn:aws:iam::123456789012:role/test-autoscaler",
+	}
+
+	s := NewService(newIAMTestScope(t, iamMock, awsCluster))
+	if err := s.DeleteServiceAccountRoles(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if s.scope.AWSCluster.Status.ServiceAccountRoles != nil {
+		t.Fatalf("expected status to be empty, got %v", s.scope.AWSCluster.Status.ServiceAccountRoles)
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// errImageBuildPending is returned by PipelineAMI while the image built for the machine is not available yet.
+var errImageBuildPending = errors.New("image build is not available yet")
+
+// IsImageBuildPending returns true if the error was returned by PipelineAMI because the image
+// built for the machine is not available yet.
+func IsImageBuildPending(err error) bool {
+	return errors.Cause(err) == errImageBuildPending
+}
+
+// PipelineAMI returns the ID of the AMI output in the region of the cluster by the Image Builder pipeline
+// of the machine. When the machine triggers a new build, it starts an execution of the pipeline, records
+// the image being built in the status of the machine and returns an error satisfying IsImageBuildPending
+// until the image is available. Otherwise it returns the AMI of the latest available image of the pipeline.
+func (s *Service) PipelineAMI(machineScope *scope.MachineScope) (string, error) {
+	spec := machineScope.AWSMachine.Spec
+	if !spec.TriggerNewBuild {
+		return s.latestPipelineAMI(spec.ImageBuilderPipelineARN)
+	}
+
+	if machineScope.AWSMachine.Status.ImageBuildVersionARN == nil {
+		arn, err := s.startPipelineExecution(machineScope)
+		if err != nil {
+			return "", err
+		}
+		machineScope.AWSMachine.Status.ImageBuildVersionARN = aws.String(arn)
+		return "", errors.Wrapf(errImageBuildPending, "started build %q of pipeline %q", arn, spec.ImageBuilderPipelineARN)
+	}
+
+	return s.builtAMI(aws.StringValue(machineScope.AWSMachine.Status.ImageBuildVersionARN))
+}
+
+// startPipelineExecution starts an execution of the Image Builder pipeline of the machine and returns
+// the ARN of the image being built. The UID of the machine is used as the idempotency token, so that
+// the same build is returned if the status of the machine could not be updated.
+func (s *Service) startPipelineExecution(machineScope *scope.MachineScope) (string, error) {
+	pipelineARN := machineScope.AWSMachine.Spec.ImageBuilderPipelineARN
+	input := &imagebuilder.StartImagePipelineExecutionInput{
+		ImagePipelineArn: aws.String(pipelineARN),
+	}
+	if uid := string(machineScope.AWSMachine.UID); uid != "" {
+		input.ClientToken = aws.String(uid)
+	}
+
+	out, err := s.scope.ImageBuilder.StartImagePipelineExecution(input)
+	if err != nil {
+		record.Warnf(machineScope.AWSMachine, "FailedStartImagePipelineExecution", "Failed to start execution of image pipeline %q: %v", pipelineARN, err)
+		return "", errors.Wrapf(err, "failed to start execution of image pipeline %q", pipelineARN)
+	}
+
+	arn := aws.StringValue(out.ImageBuildVersionArn)
+	record.Eventf(machineScope.AWSMachine, "SuccessfulStartImagePipelineExecution", "Started execution of image pipeline %q building %q", pipelineARN, arn)
+	return arn, nil
+}
+
+// builtAMI returns the AMI of the given image once it is available.
+func (s *Service) builtAMI(imageBuildVersionARN string) (string, error) {
+	out, err := s.scope.ImageBuilder.GetImage(&imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(imageBuildVersionARN),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get image %q", imageBuildVersionARN)
+	}
+	if out.Image == nil || out.Image.State == nil {
+		return "", errors.Wrapf(errImageBuildPending, "image %q has no state yet", imageBuildVersionARN)
+	}
+
+	switch status := aws.StringValue(out.Image.State.Status); status {
+	case imagebuilder.ImageStatusAvailable:
+		return s.regionAMI(imageBuildVersionARN, out.Image.OutputResources)
+	case imagebuilder.ImageStatusFailed, imagebuilder.ImageStatusCancelled,
+		imagebuilder.ImageStatusDeprecated, imagebuilder.ImageStatusDeleted:
+		return "", errors.Errorf("image %q is %s: %s", imageBuildVersionARN, status, aws.StringValue(out.Image.State.Reason))
+	default:
+		return "", errors.Wrapf(errImageBuildPending, "image %q is %s", imageBuildVersionARN, status)
+	}
+}
+
+// latestPipelineAMI returns the AMI of the latest available image built by the given pipeline.
+func (s *Service) latestPipelineAMI(pipelineARN string) (string, error) {
+	var latest *imagebuilder.ImageSummary
+	err := s.scope.ImageBuilder.ListImagePipelineImagesPages(&imagebuilder.ListImagePipelineImagesInput{
+		ImagePipelineArn: aws.String(pipelineARN),
+	}, func(out *imagebuilder.ListImagePipelineImagesOutput, lastPage bool) bool {
+		for _, image := range out.ImageSummaryList {
+			if image.State == nil || aws.StringValue(image.State.Status) != imagebuilder.ImageStatusAvailable {
+				continue
+			}
+			// Creation dates are ISO 8601 timestamps, which sort lexicographically.
+			if latest == nil || aws.StringValue(image.DateCreated) > aws.StringValue(latest.DateCreated) {
+				latest = image
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list images of image pipeline %q", pipelineARN)
+	}
+	if latest == nil {
+		return "", errors.Errorf("image pipeline %q has no available image", pipelineARN)
+	}
+
+	return s.regionAMI(aws.StringValue(latest.Arn), latest.OutputResources)
+}
+
+// regionAMI returns the AMI of an image distributed to the region of the cluster.
+func (s *Service) regionAMI(imageARN string, resources *imagebuilder.OutputResources) (string, error) {
+	if resources != nil {
+		for _, ami := range resources.Amis {
+			if aws.StringValue(ami.Region) == s.scope.Region() {
+				return aws.StringValue(ami.Image), nil
+			}
+		}
+	}
+	return "", errors.Errorf("image %q has no AMI in region %q", imageARN, s.scope.Region())
+}
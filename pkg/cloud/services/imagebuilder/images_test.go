@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/imagebuilder/mock_imagebuilderiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testPipelineARN = "arn:aws:imagebuilder:us-east-1:123456789012:image-pipeline/capa-ubuntu"
+	testImageARN    = "arn:aws:imagebuilder:us-east-1:123456789012:image/capa-ubuntu/1.0.0/2"
+)
+
+func outputAMIs(amis map[string]string) *imagebuilder.OutputResources {
+	resources := &imagebuilder.OutputResources{}
+	for region, id := range amis {
+		resources.Amis = append(resources.Amis, &imagebuilder.Ami{Region: aws.String(region), Image: aws.String(id)})
+	}
+	return resources
+}
+
+func imageSummary(arn, status, created string, amis map[string]string) *imagebuilder.ImageSummary {
+	return &imagebuilder.ImageSummary{
+		Arn:             aws.String(arn),
+		DateCreated:     aws.String(created),
+		State:           &imagebuilder.ImageState{Status: aws.String(status)},
+		OutputResources: outputAMIs(amis),
+	}
+}
+
+func TestPipelineAMI(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	getImage := func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder, status string) {
+		m.GetImage(gomock.Eq(&imagebuilder.GetImageInput{ImageBuildVersionArn: aws.String(testImageARN)})).
+			Return(&imagebuilder.GetImageOutput{
+				Image: &imagebuilder.Image{
+					Arn:             aws.String(testImageARN),
+					State:           &imagebuilder.ImageState{Status: aws.String(status), Reason: aws.String("build step failed")},
+					OutputResources: outputAMIs(map[string]string{"us-east-1": "ami-east", "us-west-2": "ami-west"}),
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name             string
+		triggerNewBuild  bool
+		buildVersionARN  *string
+		expect           func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder)
+		wantAMI          string
+		wantPending      bool
+		wantErr          bool
+		wantBuildVersion string
+	}{
+		{
+			name: "uses the latest available image of the pipeline",
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				m.ListImagePipelineImagesPages(gomock.Eq(&imagebuilder.ListImagePipelineImagesInput{
+					ImagePipelineArn: aws.String(testPipelineARN),
+				}), gomock.Any()).
+					Do(func(_ *imagebuilder.ListImagePipelineImagesInput, fn func(*imagebuilder.ListImagePipelineImagesOutput, bool) bool) {
+						fn(&imagebuilder.ListImagePipelineImagesOutput{
+							ImageSummaryList: []*imagebuilder.ImageSummary{
+								imageSummary("image/1", imagebuilder.ImageStatusAvailable, "2020-11-02T10:00:00.000Z", map[string]string{"us-east-1": "ami-old"}),
+								imageSummary("image/3", imagebuilder.ImageStatusBuilding, "2020-11-04T10:00:00.000Z", nil),
+							},
+						}, false)
+						fn(&imagebuilder.ListImagePipelineImagesOutput{
+							ImageSummaryList: []*imagebuilder.ImageSummary{
+								imageSummary("image/2", imagebuilder.ImageStatusAvailable, "2020-11-03T10:00:00.000Z", map[string]string{"us-east-1": "ami-latest"}),
+							},
+						}, true)
+					}).
+					Return(nil)
+			},
+			wantAMI: "ami-latest",
+		},
+		{
+			name: "pipeline has no available image",
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				m.ListImagePipelineImagesPages(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:            "starts a build of the pipeline",
+			triggerNewBuild: true,
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				m.StartImagePipelineExecution(gomock.Eq(&imagebuilder.StartImagePipelineExecutionInput{
+					ImagePipelineArn: aws.String(testPipelineARN),
+					ClientToken:      aws.String("machine-uid"),
+				})).
+					Return(&imagebuilder.StartImagePipelineExecutionOutput{ImageBuildVersionArn: aws.String(testImageARN)}, nil)
+			},
+			wantPending:      true,
+			wantBuildVersion: testImageARN,
+		},
+		{
+			name:            "starting a build fails",
+			triggerNewBuild: true,
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				m.StartImagePipelineExecution(gomock.Any()).Return(nil, errors.New("ResourceNotFoundException"))
+			},
+			wantErr: true,
+		},
+		{
+			name:            "waits for the build to complete",
+			triggerNewBuild: true,
+			buildVersionARN: aws.String(testImageARN),
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				getImage(m, imagebuilder.ImageStatusTesting)
+			},
+			wantPending:      true,
+			wantBuildVersion: testImageARN,
+		},
+		{
+			name:            "uses the AMI of the completed build in the region of the cluster",
+			triggerNewBuild: true,
+			buildVersionARN: aws.String(testImageARN),
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				getImage(m, imagebuilder.ImageStatusAvailable)
+			},
+			wantAMI:          "ami-east",
+			wantBuildVersion: testImageARN,
+		},
+		{
+			name:            "build failed",
+			triggerNewBuild: true,
+			buildVersionARN: aws.String(testImageARN),
+			expect: func(m *mock_imagebuilderiface.MockImagebuilderAPIMockRecorder) {
+				getImage(m, imagebuilder.ImageStatusFailed)
+			},
+			wantErr:          true,
+			wantBuildVersion: testImageARN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			imageBuilderMock := mock_imagebuilderiface.NewMockImagebuilderAPI(mockCtrl)
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{Region: "us-east-1"},
+				},
+				AWSClients: scope.AWSClients{
+					ImageBuilder: imageBuilderMock,
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:     fake.NewFakeClient(),
+				Cluster:    &clusterv1.Cluster{},
+				Machine:    &clusterv1.Machine{},
+				AWSCluster: &infrav1.AWSCluster{},
+				AWSMachine: &infrav1.AWSMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", UID: "machine-uid"},
+					Spec: infrav1.AWSMachineSpec{
+						ImageBuilderPipelineARN: testPipelineARN,
+						TriggerNewBuild:         tc.triggerNewBuild,
+					},
+					Status: infrav1.AWSMachineStatus{ImageBuildVersionARN: tc.buildVersionARN},
+				},
+			})
+			if err != nil {
+				t.Fatalf("did not expect err: %v", err)
+			}
+
+			tc.expect(imageBuilderMock.EXPECT())
+
+			id, err := NewService(clusterScope).PipelineAMI(machineScope)
+			if IsImageBuildPending(err) != tc.wantPending {
+				t.Fatalf("expected pending to be %v, got error %v", tc.wantPending, err)
+			}
+			if !tc.wantPending && (err != nil) != tc.wantErr {
+				t.Fatalf("PipelineAMI() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if id != tc.wantAMI {
+				t.Fatalf("expected AMI %q, got %q", tc.wantAMI, id)
+			}
+			if got := aws.StringValue(machineScope.AWSMachine.Status.ImageBuildVersionARN); got != tc.wantBuildVersion {
+				t.Fatalf("expected image build version %q, got %q", tc.wantBuildVersion, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspector
+
+import (
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+)
+
+// IsUnavailable returns true if the error means Amazon Inspector can't be used by the controller,
+// either because it isn't enabled for the account or because the controller isn't allowed to call it.
+func IsUnavailable(err error) bool {
+	if code, ok := awserrors.Code(errors.Cause(err)); ok {
+		if code == inspector2.ErrCodeAccessDeniedException || code == "AccessDenied" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspector
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// ListInstanceFindings returns the active Amazon Inspector package vulnerability findings
+// for the given instance.
+func (s *Service) ListInstanceFindings(instanceID string) ([]infrav1.VulnerabilityFinding, error) {
+	input := &inspector2.ListFindingsInput{
+		FilterCriteria: &inspector2.FilterCriteria{
+			ResourceId: []*inspector2.StringFilter{
+				{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String(instanceID)},
+			},
+			FindingStatus: []*inspector2.StringFilter{
+				{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String(inspector2.FindingStatusActive)},
+			},
+			FindingType: []*inspector2.StringFilter{
+				{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String(inspector2.FindingTypePackageVulnerability)},
+			},
+		},
+	}
+
+	var findings []infrav1.VulnerabilityFinding
+	err := s.scope.Inspector2.ListFindingsPages(input, func(out *inspector2.ListFindingsOutput, _ bool) bool {
+		for _, f := range out.Findings {
+			finding := infrav1.VulnerabilityFinding{
+				Severity:     aws.StringValue(f.Severity),
+				Title:        aws.StringValue(f.Title),
+				FixAvailable: aws.StringValue(f.FixAvailable) == inspector2.FixAvailableYes,
+			}
+			if f.PackageVulnerabilityDetails != nil {
+				finding.CVE = aws.StringValue(f.PackageVulnerabilityDetails.VulnerabilityId)
+			}
+			findings = append(findings, finding)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list Amazon Inspector findings for instance %q", instanceID)
+	}
+
+	return findings, nil
+}
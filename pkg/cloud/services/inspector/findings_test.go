@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/inspector/mock_inspector2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newInspectorTestScope(t *testing.T, inspectorMock *mock_inspector2iface.MockInspector2API) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			Inspector2: inspectorMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestListInstanceFindings(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expect    func(m *mock_inspector2iface.MockInspector2APIMockRecorder)
+		expected  []infrav1.VulnerabilityFinding
+		expectErr bool
+	}{
+		{
+			name: "instance without findings",
+			expect: func(m *mock_inspector2iface.MockInspector2APIMockRecorder) {
+				m.ListFindingsPages(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ *inspector2.ListFindingsInput, fn func(*inspector2.ListFindingsOutput, bool) bool) error {
+						fn(&inspector2.ListFindingsOutput{}, true)
+						return nil
+					})
+			},
+		},
+		{
+			name: "findings on several pages",
+			expect: func(m *mock_inspector2iface.MockInspector2APIMockRecorder) {
+				m.ListFindingsPages(gomock.Eq(&inspector2.ListFindingsInput{
+					FilterCriteria: &inspector2.FilterCriteria{
+						ResourceId: []*inspector2.StringFilter{
+							{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String("i-1")},
+						},
+						FindingStatus: []*inspector2.StringFilter{
+							{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String(inspector2.FindingStatusActive)},
+						},
+						FindingType: []*inspector2.StringFilter{
+							{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String(inspector2.FindingTypePackageVulnerability)},
+						},
+					},
+				}), gomock.Any()).DoAndReturn(func(_ *inspector2.ListFindingsInput, fn func(*inspector2.ListFindingsOutput, bool) bool) error {
+					fn(&inspector2.ListFindingsOutput{
+						Findings: []*inspector2.Finding{
+							{
+								Severity:     aws.String(inspector2.SeverityCritical),
+								Title:        aws.String("CVE-2021-44228 - log4j"),
+								FixAvailable: aws.String(inspector2.FixAvailableYes),
+								PackageVulnerabilityDetails: &inspector2.PackageVulnerabilityDetails{
+									VulnerabilityId: aws.String("CVE-2021-44228"),
+								},
+							},
+						},
+					}, false)
+					fn(&inspector2.ListFindingsOutput{
+						Findings: []*inspector2.Finding{
+							{
+								Severity:     aws.String(inspector2.SeverityLow),
+								Title:        aws.String("CVE-2020-0001 - openssl"),
+								FixAvailable: aws.String(inspector2.FixAvailableNo),
+							},
+						},
+					}, true)
+					return nil
+				})
+			},
+			expected: []infrav1.VulnerabilityFinding{
+				{Severity: inspector2.SeverityCritical, Title: "CVE-2021-44228 - log4j", CVE: "CVE-2021-44228", FixAvailable: true},
+				{Severity: inspector2.SeverityLow, Title: "CVE-2020-0001 - openssl"},
+			},
+		},
+		{
+			name: "inspector is not enabled",
+			expect: func(m *mock_inspector2iface.MockInspector2APIMockRecorder) {
+				m.ListFindingsPages(gomock.Any(), gomock.Any()).
+					Return(awserr.New(inspector2.ErrCodeAccessDeniedException, "denied", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			inspectorMock := mock_inspector2iface.NewMockInspector2API(mockCtrl)
+			tc.expect(inspectorMock.EXPECT())
+
+			s := NewService(newInspectorTestScope(t, inspectorMock))
+			findings, err := s.ListInstanceFindings("i-1")
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if !IsUnavailable(err) {
+					t.Fatalf("expected the error to mean Amazon Inspector is unavailable, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(findings, tc.expected) {
+				t.Fatalf("expected findings %v, got %v", tc.expected, findings)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package services
 import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/gc"
 )
 
 // EC2MachineInterface encapsulates the methods exposed to the machine
@@ -26,18 +27,52 @@ import (
 type EC2MachineInterface interface {
 	InstanceIfExists(id *string) (*infrav1.Instance, error)
 	TerminateInstance(id string) error
+	StartInstance(id string) error
 	CreateInstance(scope *scope.MachineScope, userData []byte) (*infrav1.Instance, error)
 	GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error)
+	DeleteFleet(scope *scope.MachineScope, terminateInstances bool) error
+	DeletePlacementGroup(scope *scope.MachineScope) error
 
 	GetCoreSecurityGroups(machine *scope.MachineScope) ([]string, error)
 	GetInstanceSecurityGroups(instanceID string) (map[string][]string, error)
 	UpdateInstanceSecurityGroups(id string, securityGroups []string) error
+	UpdateInstanceIAMProfile(instanceID, profileName string) error
+	UpdateInstanceMonitoring(instanceID string, enabled bool) error
+	UpdateInstanceCreditSpecification(instanceID, cpuCredits string) (bool, error)
+	VerifyGPUDrivers(instanceID string) (bool, error)
+	VerifyInstanceSSHKey(instanceID string) (bool, error)
+	WindowsPasswordAvailable(instanceID string) (bool, error)
+	UpdateInstanceENAExpress(instanceID string, settings infrav1.ENAExpressSpec) (bool, error)
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
+	AssignIPv4Prefixes(instanceID, instanceType string) ([]string, error)
+	MaxPodsWithPrefixDelegation(instanceType string) (int64, error)
 
+	SecureDeleteInstance(scope *scope.MachineScope, instance *infrav1.Instance) (bool, error)
 	TerminateInstanceAndWait(instanceID string) error
 	DetachSecurityGroupsFromNetworkInterface(groups []string, interfaceID string) error
 }
 
+// GarbageCollectorInterface encapsulates the methods exposed to the garbage
+// collector
+type GarbageCollectorInterface interface {
+	ListClusterResources(managementClusterID string) ([]gc.ClusterResource, error)
+	DeleteResource(resource gc.ClusterResource) error
+}
+
+// InspectorInterface encapsulates the methods exposed to the inspector
+// controller
+type InspectorInterface interface {
+	ListInstanceFindings(instanceID string) ([]infrav1.VulnerabilityFinding, error)
+}
+
+// RootDiskInterface encapsulates the methods exposed to the root disk
+// controller
+type RootDiskInterface interface {
+	RootVolumeUsedPercent(instanceID string) (*float64, error)
+	ExpandRootVolume(instanceID string, maxSizeGiB int64) (int64, bool, error)
+	GrowRootFilesystem(instanceID string) error
+}
+
 // SecretsManagerInterface encapsulated the methods exposed to the
 // machine actuator
 type SecretsManagerInterface interface {
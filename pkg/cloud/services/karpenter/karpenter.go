@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eventbridge"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sqs"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileKarpenter creates the interruption queue, the EventBridge rules and the IAM resources of the
+// cluster's Karpenter installation, then installs Karpenter in the cluster. It returns false while the
+// control plane of the cluster is not initialized yet, as Karpenter can only be installed afterwards.
+// Karpenter is only installed again when its version changes, the other settings being fixed for the
+// lifetime of the cluster.
+func (s *Service) ReconcileKarpenter() (bool, error) {
+	spec := s.scope.Karpenter()
+	if spec == nil {
+		return true, nil
+	}
+
+	if err := sqs.NewService(s.scope).ReconcileInterruptionQueue(); err != nil {
+		return false, err
+	}
+	if err := eventbridge.NewService(s.scope).ReconcileKarpenterInterruptionRules(); err != nil {
+		return false, err
+	}
+	if err := iam.NewService(s.scope).ReconcileKarpenterIAM(); err != nil {
+		return false, err
+	}
+
+	status := s.scope.KarpenterStatus()
+	if status.InstalledVersion == spec.Version {
+		return true, nil
+	}
+	if !s.scope.Cluster.Status.ControlPlaneInitialized {
+		s.scope.V(2).Info("Waiting for the control plane to be initialized before installing Karpenter")
+		return false, nil
+	}
+
+	if err := s.install(); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedInstallKarpenter", "Failed to install Karpenter %s: %v", spec.Version, err)
+		return false, err
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulInstallKarpenter", "Installed Karpenter %s", spec.Version)
+	status.InstalledVersion = spec.Version
+	return true, nil
+}
+
+// install applies the manifests of Karpenter to the cluster.
+func (s *Service) install() error {
+	workloadClient, err := s.getWorkloadClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to create client for workload cluster")
+	}
+	for _, obj := range s.manifests() {
+		if err := apply(context.TODO(), workloadClient, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteKarpenter deletes the AWS resources of the cluster's Karpenter installation. Karpenter itself goes
+// away with the cluster.
+func (s *Service) DeleteKarpenter() error {
+	if err := eventbridge.NewService(s.scope).DeleteKarpenterInterruptionRules(); err != nil {
+		return err
+	}
+	if err := iam.NewService(s.scope).DeleteKarpenterIAM(); err != nil {
+		return err
+	}
+	if err := sqs.NewService(s.scope).DeleteInterruptionQueue(); err != nil {
+		return err
+	}
+	s.scope.AWSCluster.Status.Karpenter = nil
+	return nil
+}
+
+func (s *Service) getWorkloadClient() (client.Client, error) {
+	if s.workloadClient != nil {
+		return s.workloadClient, nil
+	}
+	return s.scope.WorkloadClient(context.TODO())
+}
+
+// apply creates the object, or replaces the existing one.
+func apply(ctx context.Context, c client.Client, desired runtime.Object) error {
+	key, err := client.ObjectKeyFromObject(desired)
+	if err != nil {
+		return err
+	}
+	gvk := desired.GetObjectKind().GroupVersionKind()
+
+	existing := desired.DeepCopyObject()
+	if err := c.Get(ctx, key, existing); apierrors.IsNotFound(err) {
+		if err := c.Create(ctx, desired); err != nil {
+			return errors.Wrapf(err, "failed to create %s %s", gvk.Kind, key)
+		}
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to get %s %s", gvk.Kind, key)
+	}
+
+	existingMeta, err := meta.Accessor(existing)
+	if err != nil {
+		return err
+	}
+	desiredMeta, err := meta.Accessor(desired)
+	if err != nil {
+		return err
+	}
+	desiredMeta.SetResourceVersion(existingMeta.GetResourceVersion())
+	if err := c.Update(ctx, desired); err != nil {
+		return errors.Wrapf(err, "failed to update %s %s", gvk.Kind, key)
+	}
+	return nil
+}
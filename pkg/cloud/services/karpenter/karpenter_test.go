@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const controllerRoleARN = "arn:aws:iam::123456789012:role/test-cluster-karpenter"
+
+func newKarpenterTestService(t *testing.T, spec *infrav1.KarpenterSpec) (*Service, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:               "us-east-1",
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "test.elb.amazonaws.com", Port: 6443},
+			Karpenter:            spec,
+		},
+		Status: infrav1.AWSClusterStatus{
+			Karpenter: &infrav1.KarpenterStatus{
+				ControllerRoleARN:   controllerRoleARN,
+				InstanceProfileName: "test-cluster-karpenter-node",
+			},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Status:     clusterv1.ClusterStatus{ControlPlaneInitialized: true},
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	workloadClient := fake.NewFakeClientWithScheme(clientgoscheme.Scheme)
+	s := NewService(clusterScope)
+	s.workloadClient = workloadClient
+	return s, workloadClient
+}
+
+func TestReconcileKarpenterWithoutSpec(t *testing.T) {
+	s, _ := newKarpenterTestService(t, nil)
+
+	ready, err := s.ReconcileKarpenter()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatal("expected a cluster without Karpenter to be ready")
+	}
+}
+
+func TestInstall(t *testing.T) {
+	spec := &infrav1.KarpenterSpec{Version: "v0.27.0", IAMRoleARN: "arn:aws:iam::123456789012:role/nodes"}
+	s, workloadClient := newKarpenterTestService(t, spec)
+
+	if err := s.install(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, name := range []string{"provisioners.karpenter.sh", "awsnodetemplates.karpenter.k8s.aws"} {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"})
+		if err := workloadClient.Get(context.TODO(), client.ObjectKey{Name: name}, crd); err != nil {
+			t.Fatalf("expected CustomResourceDefinition %s to be created: %v", name, err)
+		}
+	}
+
+	settings := &corev1.ConfigMap{}
+	if err := workloadClient.Get(context.TODO(), client.ObjectKey{Namespace: "karpenter", Name: globalSettingsName}, settings); err != nil {
+		t.Fatalf("expected the global settings to be created: %v", err)
+	}
+	expectedSettings := map[string]string{
+		"aws.clusterName":            "test-cluster",
+		"aws.clusterEndpoint":        "https://test.elb.amazonaws.com:6443",
+		"aws.defaultInstanceProfile": "test-cluster-karpenter-node",
+		"aws.interruptionQueueName":  "test-cluster-karpenter-interruption",
+	}
+	for key, value := range expectedSettings {
+		if settings.Data[key] != value {
+			t.Errorf("expected setting %s to be %q, got %q", key, value, settings.Data[key])
+		}
+	}
+
+	deployment := karpenterDeployment(t, workloadClient)
+	if image := deployment.Spec.Template.Spec.Containers[0].Image; image != "public.ecr.aws/karpenter/controller:v0.27.0" {
+		t.Errorf("unexpected image %q", image)
+	}
+	env := map[string]string{}
+	for _, e := range deployment.Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env["AWS_ROLE_ARN"] != controllerRoleARN || env["AWS_REGION"] != "us-east-1" {
+		t.Errorf("expected the controller to assume %q in us-east-1, got %v", controllerRoleARN, env)
+	}
+
+	// Installing another version updates the existing objects.
+	spec.Version = "v0.28.0"
+	if err := s.install(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deployment = karpenterDeployment(t, workloadClient)
+	if image := deployment.Spec.Template.Spec.Containers[0].Image; image != "public.ecr.aws/karpenter/controller:v0.28.0" {
+		t.Errorf("expected the image to be updated, got %q", image)
+	}
+}
+
+func karpenterDeployment(t *testing.T, c client.Client) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Namespace: "karpenter", Name: "karpenter"}, deployment); err != nil {
+		t.Fatalf("expected the Karpenter deployment to be created: %v", err)
+	}
+	return deployment
+}
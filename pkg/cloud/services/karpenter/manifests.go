@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sqs"
+)
+
+const (
+	// imageRepository is the repository of the Karpenter controller image.
+	imageRepository = "public.ecr.aws/karpenter/controller"
+
+	// globalSettingsName is the name of the ConfigMap holding the settings of Karpenter.
+	globalSettingsName = "karpenter-global-settings"
+
+	// webIdentityTokenPath is the path of the service account token exchanged for the credentials of the
+	// controller role, where the EKS pod identity webhook would mount it.
+	webIdentityTokenPath = "/var/run/secrets/eks.amazonaws.com/serviceaccount"
+)
+
+// manifests returns the objects making up the Karpenter installation of the cluster, in the order they
+// must be applied.
+func (s *Service) manifests() []runtime.Object {
+	name := iam.KarpenterServiceAccountName
+	namespace := iam.KarpenterNamespace
+	labels := map[string]string{"app.kubernetes.io/name": "karpenter"}
+	objectMeta := metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}
+	status := s.scope.KarpenterStatus()
+
+	return []runtime.Object{
+		crd("karpenter.sh", "v1alpha5", "Provisioner", "provisioners"),
+		crd("karpenter.k8s.aws", "v1alpha1", "AWSNodeTemplate", "awsnodetemplates"),
+		&corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+		},
+		&corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+			ObjectMeta: objectMeta,
+		},
+		&rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"karpenter.sh", "karpenter.k8s.aws"},
+					Resources: []string{"provisioners", "provisioners/status", "awsnodetemplates", "awsnodetemplates/status"},
+					Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"nodes"},
+					Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods", "namespaces", "persistentvolumes", "persistentvolumeclaims", "configmaps"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods/eviction"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     []string{"create", "patch"},
+				},
+				{
+					APIGroups: []string{"apps"},
+					Resources: []string{"daemonsets", "deployments", "replicasets", "statefulsets"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{"policy"},
+					Resources: []string{"poddisruptionbudgets"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{"storage.k8s.io"},
+					Resources: []string{"storageclasses", "csinodes"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{"coordination.k8s.io"},
+					Resources: []string{"leases"},
+					Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+				},
+			},
+		},
+		&rbacv1.ClusterRoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: namespace, Name: name}},
+		},
+		&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: globalSettingsName, Namespace: namespace, Labels: labels},
+			Data: map[string]string{
+				"aws.clusterName":            s.scope.Name(),
+				"aws.clusterEndpoint":        fmt.Sprintf("https://%s:%d", s.scope.AWSCluster.Spec.ControlPlaneEndpoint.Host, s.scope.AWSCluster.Spec.ControlPlaneEndpoint.Port),
+				"aws.defaultInstanceProfile": status.InstanceProfileName,
+				"aws.interruptionQueueName":  sqs.InterruptionQueueName(s.scope.Name()),
+			},
+		},
+		s.deployment(objectMeta),
+	}
+}
+
+// deployment returns the Deployment of the Karpenter controller. The credentials of the controller role are
+// obtained with a projected service account token, as the workload cluster has no pod identity webhook to
+// inject them. The Karpenter webhooks are disabled, as their certificates are not managed here.
+func (s *Service) deployment(objectMeta metav1.ObjectMeta) *appsv1.Deployment {
+	spec := s.scope.Karpenter()
+	status := s.scope.KarpenterStatus()
+
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: objectMeta,
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{MatchLabels: objectMeta.Labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: objectMeta.Labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: objectMeta.Name,
+					Containers: []corev1.Container{
+						{
+							Name:  "controller",
+							Image: fmt.Sprintf("%s:%s", imageRepository, spec.Version),
+							Env: []corev1.EnvVar{
+								{
+									Name:      "SYSTEM_NAMESPACE",
+									ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}},
+								},
+								{Name: "AWS_REGION", Value: s.scope.Region()},
+								{Name: "DISABLE_WEBHOOK", Value: "true"},
+								{Name: "AWS_ROLE_ARN", Value: status.ControllerRoleARN},
+								{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: webIdentityTokenPath + "/token"},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "aws-iam-token", MountPath: webIdentityTokenPath, ReadOnly: true},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "aws-iam-token",
+							VolumeSource: corev1.VolumeSource{
+								Projected: &corev1.ProjectedVolumeSource{
+									Sources: []corev1.VolumeProjection{
+										{
+											ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+												Audience:          "sts.amazonaws.com",
+												ExpirationSeconds: pointer.Int64Ptr(86400),
+												Path:              "token",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// crd returns a CustomResourceDefinition for a cluster scoped Karpenter resource. Its schema accepts any
+// field, Karpenter validating its resources itself.
+func crd(group, version, kind, plural string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"name": plural + "." + group,
+			},
+			"spec": map[string]interface{}{
+				"group": group,
+				"scope": "Cluster",
+				"names": map[string]interface{}{
+					"kind":     kind,
+					"listKind": kind + "List",
+					"plural":   plural,
+					"singular": strings.ToLower(kind),
+				},
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name":    version,
+						"served":  true,
+						"storage": true,
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
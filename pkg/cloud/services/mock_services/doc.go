@@ -19,4 +19,10 @@ limitations under the License.
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ec2_machine_interface_mock.go > _ec2_machine_interface_mock.go && mv _ec2_machine_interface_mock.go ec2_machine_interface_mock.go"
 //go:generate ../../../../hack/tools/bin/mockgen -destination secretsmanager_machine_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services SecretsManagerInterface
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt secretsmanager_machine_interface_mock.go > _secretsmanager_machine_interface_mock.go && mv _secretsmanager_machine_interface_mock.go secretsmanager_machine_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination inspector_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services InspectorInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt inspector_interface_mock.go > _inspector_interface_mock.go && mv _inspector_interface_mock.go inspector_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination root_disk_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services RootDiskInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt root_disk_interface_mock.go > _root_disk_interface_mock.go && mv _root_disk_interface_mock.go root_disk_interface_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination garbage_collector_interface_mock.go -package mock_services sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services GarbageCollectorInterface
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt garbage_collector_interface_mock.go > _garbage_collector_interface_mock.go && mv _garbage_collector_interface_mock.go garbage_collector_interface_mock.go"
 package mock_services //nolint
@@ -50,6 +50,21 @@ func (m *MockEC2MachineInterface) EXPECT() *MockEC2MachineInterfaceMockRecorder
 	return m.recorder
 }
 
+// AssignIPv4Prefixes mocks base method
+func (m *MockEC2MachineInterface) AssignIPv4Prefixes(arg0, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignIPv4Prefixes", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignIPv4Prefixes indicates an expected call of AssignIPv4Prefixes
+func (mr *MockEC2MachineInterfaceMockRecorder) AssignIPv4Prefixes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignIPv4Prefixes", reflect.TypeOf((*MockEC2MachineInterface)(nil).AssignIPv4Prefixes), arg0, arg1)
+}
+
 // CreateInstance mocks base method
 func (m *MockEC2MachineInterface) CreateInstance(arg0 *scope.MachineScope, arg1 []byte) (*v1alpha3.Instance, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,34 @@ func (mr *MockEC2MachineInterfaceMockRecorder) CreateInstance(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockEC2MachineInterface)(nil).CreateInstance), arg0, arg1)
 }
 
+// DeleteFleet mocks base method
+func (m *MockEC2MachineInterface) DeleteFleet(arg0 *scope.MachineScope, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFleet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFleet indicates an expected call of DeleteFleet
+func (mr *MockEC2MachineInterfaceMockRecorder) DeleteFleet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFleet", reflect.TypeOf((*MockEC2MachineInterface)(nil).DeleteFleet), arg0, arg1)
+}
+
+// DeletePlacementGroup mocks base method
+func (m *MockEC2MachineInterface) DeletePlacementGroup(arg0 *scope.MachineScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlacementGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlacementGroup indicates an expected call of DeletePlacementGroup
+func (mr *MockEC2MachineInterfaceMockRecorder) DeletePlacementGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockEC2MachineInterface)(nil).DeletePlacementGroup), arg0)
+}
+
 // DetachSecurityGroupsFromNetworkInterface mocks base method
 func (m *MockEC2MachineInterface) DetachSecurityGroupsFromNetworkInterface(arg0 []string, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -139,6 +182,50 @@ func (mr *MockEC2MachineInterfaceMockRecorder) InstanceIfExists(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceIfExists", reflect.TypeOf((*MockEC2MachineInterface)(nil).InstanceIfExists), arg0)
 }
 
+// MaxPodsWithPrefixDelegation mocks base method
+func (m *MockEC2MachineInterface) MaxPodsWithPrefixDelegation(arg0 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxPodsWithPrefixDelegation", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaxPodsWithPrefixDelegation indicates an expected call of MaxPodsWithPrefixDelegation
+func (mr *MockEC2MachineInterfaceMockRecorder) MaxPodsWithPrefixDelegation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxPodsWithPrefixDelegation", reflect.TypeOf((*MockEC2MachineInterface)(nil).MaxPodsWithPrefixDelegation), arg0)
+}
+
+// SecureDeleteInstance mocks base method
+func (m *MockEC2MachineInterface) SecureDeleteInstance(arg0 *scope.MachineScope, arg1 *v1alpha3.Instance) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecureDeleteInstance", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecureDeleteInstance indicates an expected call of SecureDeleteInstance
+func (mr *MockEC2MachineInterfaceMockRecorder) SecureDeleteInstance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecureDeleteInstance", reflect.TypeOf((*MockEC2MachineInterface)(nil).SecureDeleteInstance), arg0, arg1)
+}
+
+// StartInstance mocks base method
+func (m *MockEC2MachineInterface) StartInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartInstance indicates an expected call of StartInstance
+func (mr *MockEC2MachineInterfaceMockRecorder) StartInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstance", reflect.TypeOf((*MockEC2MachineInterface)(nil).StartInstance), arg0)
+}
+
 // TerminateInstance mocks base method
 func (m *MockEC2MachineInterface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -167,6 +254,64 @@ func (mr *MockEC2MachineInterfaceMockRecorder) TerminateInstanceAndWait(arg0 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstanceAndWait", reflect.TypeOf((*MockEC2MachineInterface)(nil).TerminateInstanceAndWait), arg0)
 }
 
+// UpdateInstanceCreditSpecification mocks base method
+func (m *MockEC2MachineInterface) UpdateInstanceCreditSpecification(arg0, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceCreditSpecification", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstanceCreditSpecification indicates an expected call of UpdateInstanceCreditSpecification
+func (mr *MockEC2MachineInterfaceMockRecorder) UpdateInstanceCreditSpecification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceCreditSpecification", reflect.TypeOf((*MockEC2MachineInterface)(nil).UpdateInstanceCreditSpecification), arg0, arg1)
+}
+
+// UpdateInstanceENAExpress mocks base method
+func (m *MockEC2MachineInterface) UpdateInstanceENAExpress(arg0 string, arg1 v1alpha3.ENAExpressSpec) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceENAExpress", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstanceENAExpress indicates an expected call of UpdateInstanceENAExpress
+func (mr *MockEC2MachineInterfaceMockRecorder) UpdateInstanceENAExpress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceENAExpress", reflect.TypeOf((*MockEC2MachineInterface)(nil).UpdateInstanceENAExpress), arg0, arg1)
+}
+
+// UpdateInstanceIAMProfile mocks base method
+func (m *MockEC2MachineInterface) UpdateInstanceIAMProfile(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceIAMProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstanceIAMProfile indicates an expected call of UpdateInstanceIAMProfile
+func (mr *MockEC2MachineInterfaceMockRecorder) UpdateInstanceIAMProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceIAMProfile", reflect.TypeOf((*MockEC2MachineInterface)(nil).UpdateInstanceIAMProfile), arg0, arg1)
+}
+
+// UpdateInstanceMonitoring mocks base method
+func (m *MockEC2MachineInterface) UpdateInstanceMonitoring(arg0 string, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceMonitoring", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstanceMonitoring indicates an expected call of UpdateInstanceMonitoring
+func (mr *MockEC2MachineInterfaceMockRecorder) UpdateInstanceMonitoring(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceMonitoring", reflect.TypeOf((*MockEC2MachineInterface)(nil).UpdateInstanceMonitoring), arg0, arg1)
+}
+
 // UpdateInstanceSecurityGroups mocks base method
 func (m *MockEC2MachineInterface) UpdateInstanceSecurityGroups(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -194,3 +339,48 @@ func (mr *MockEC2MachineInterfaceMockRecorder) UpdateResourceTags(arg0, arg1, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceTags", reflect.TypeOf((*MockEC2MachineInterface)(nil).UpdateResourceTags), arg0, arg1, arg2)
 }
+
+// VerifyGPUDrivers mocks base method
+func (m *MockEC2MachineInterface) VerifyGPUDrivers(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyGPUDrivers", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyGPUDrivers indicates an expected call of VerifyGPUDrivers
+func (mr *MockEC2MachineInterfaceMockRecorder) VerifyGPUDrivers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyGPUDrivers", reflect.TypeOf((*MockEC2MachineInterface)(nil).VerifyGPUDrivers), arg0)
+}
+
+// VerifyInstanceSSHKey mocks base method
+func (m *MockEC2MachineInterface) VerifyInstanceSSHKey(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyInstanceSSHKey", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyInstanceSSHKey indicates an expected call of VerifyInstanceSSHKey
+func (mr *MockEC2MachineInterfaceMockRecorder) VerifyInstanceSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyInstanceSSHKey", reflect.TypeOf((*MockEC2MachineInterface)(nil).VerifyInstanceSSHKey), arg0)
+}
+
+// WindowsPasswordAvailable mocks base method
+func (m *MockEC2MachineInterface) WindowsPasswordAvailable(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WindowsPasswordAvailable", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WindowsPasswordAvailable indicates an expected call of WindowsPasswordAvailable
+func (mr *MockEC2MachineInterfaceMockRecorder) WindowsPasswordAvailable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WindowsPasswordAvailable", reflect.TypeOf((*MockEC2MachineInterface)(nil).WindowsPasswordAvailable), arg0)
+}
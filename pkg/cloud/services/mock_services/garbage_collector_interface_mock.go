@@ -0,0 +1,79 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services (interfaces: GarbageCollectorInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	gc "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/gc"
+)
+
+// MockGarbageCollectorInterface is a mock of GarbageCollectorInterface interface
+type MockGarbageCollectorInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGarbageCollectorInterfaceMockRecorder
+}
+
+// MockGarbageCollectorInterfaceMockRecorder is the mock recorder for MockGarbageCollectorInterface
+type MockGarbageCollectorInterfaceMockRecorder struct {
+	mock *MockGarbageCollectorInterface
+}
+
+// NewMockGarbageCollectorInterface creates a new mock instance
+func NewMockGarbageCollectorInterface(ctrl *gomock.Controller) *MockGarbageCollectorInterface {
+	mock := &MockGarbageCollectorInterface{ctrl: ctrl}
+	mock.recorder = &MockGarbageCollectorInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGarbageCollectorInterface) EXPECT() *MockGarbageCollectorInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteResource mocks base method
+func (m *MockGarbageCollectorInterface) DeleteResource(arg0 gc.ClusterResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResource", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResource indicates an expected call of DeleteResource
+func (mr *MockGarbageCollectorInterfaceMockRecorder) DeleteResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResource", reflect.TypeOf((*MockGarbageCollectorInterface)(nil).DeleteResource), arg0)
+}
+
+// ListClusterResources mocks base method
+func (m *MockGarbageCollectorInterface) ListClusterResources(arg0 string) ([]gc.ClusterResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterResources", arg0)
+	ret0, _ := ret[0].([]gc.ClusterResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterResources indicates an expected call of ListClusterResources
+func (mr *MockGarbageCollectorInterfaceMockRecorder) ListClusterResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterResources", reflect.TypeOf((*MockGarbageCollectorInterface)(nil).ListClusterResources), arg0)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services (interfaces: InspectorInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	v1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// MockInspectorInterface is a mock of InspectorInterface interface
+type MockInspectorInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInspectorInterfaceMockRecorder
+}
+
+// MockInspectorInterfaceMockRecorder is the mock recorder for MockInspectorInterface
+type MockInspectorInterfaceMockRecorder struct {
+	mock *MockInspectorInterface
+}
+
+// NewMockInspectorInterface creates a new mock instance
+func NewMockInspectorInterface(ctrl *gomock.Controller) *MockInspectorInterface {
+	mock := &MockInspectorInterface{ctrl: ctrl}
+	mock.recorder = &MockInspectorInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInspectorInterface) EXPECT() *MockInspectorInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ListInstanceFindings mocks base method
+func (m *MockInspectorInterface) ListInstanceFindings(arg0 string) ([]v1alpha3.VulnerabilityFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceFindings", arg0)
+	ret0, _ := ret[0].([]v1alpha3.VulnerabilityFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceFindings indicates an expected call of ListInstanceFindings
+func (mr *MockInspectorInterfaceMockRecorder) ListInstanceFindings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceFindings", reflect.TypeOf((*MockInspectorInterface)(nil).ListInstanceFindings), arg0)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services (interfaces: RootDiskInterface)
+
+// Package mock_services is a generated GoMock package.
+package mock_services
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockRootDiskInterface is a mock of RootDiskInterface interface
+type MockRootDiskInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRootDiskInterfaceMockRecorder
+}
+
+// MockRootDiskInterfaceMockRecorder is the mock recorder for MockRootDiskInterface
+type MockRootDiskInterfaceMockRecorder struct {
+	mock *MockRootDiskInterface
+}
+
+// NewMockRootDiskInterface creates a new mock instance
+func NewMockRootDiskInterface(ctrl *gomock.Controller) *MockRootDiskInterface {
+	mock := &MockRootDiskInterface{ctrl: ctrl}
+	mock.recorder = &MockRootDiskInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRootDiskInterface) EXPECT() *MockRootDiskInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ExpandRootVolume mocks base method
+func (m *MockRootDiskInterface) ExpandRootVolume(arg0 string, arg1 int64) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpandRootVolume", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExpandRootVolume indicates an expected call of ExpandRootVolume
+func (mr *MockRootDiskInterfaceMockRecorder) ExpandRootVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandRootVolume", reflect.TypeOf((*MockRootDiskInterface)(nil).ExpandRootVolume), arg0, arg1)
+}
+
+// GrowRootFilesystem mocks base method
+func (m *MockRootDiskInterface) GrowRootFilesystem(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrowRootFilesystem", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrowRootFilesystem indicates an expected call of GrowRootFilesystem
+func (mr *MockRootDiskInterfaceMockRecorder) GrowRootFilesystem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrowRootFilesystem", reflect.TypeOf((*MockRootDiskInterface)(nil).GrowRootFilesystem), arg0)
+}
+
+// RootVolumeUsedPercent mocks base method
+func (m *MockRootDiskInterface) RootVolumeUsedPercent(arg0 string) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RootVolumeUsedPercent", arg0)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RootVolumeUsedPercent indicates an expected call of RootVolumeUsedPercent
+func (mr *MockRootDiskInterfaceMockRecorder) RootVolumeUsedPercent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RootVolumeUsedPercent", reflect.TypeOf((*MockRootDiskInterface)(nil).RootVolumeUsedPercent), arg0)
+}
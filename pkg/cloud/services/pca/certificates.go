@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pca
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	"sigs.k8s.io/cluster-api/util/certs"
+)
+
+const (
+	// certificateValidityDays is the validity of the certificates issued by the private CA.
+	certificateValidityDays = 365
+	// certificateRenewBefore is how long before their expiry certificates are renewed.
+	certificateRenewBefore = 30 * 24 * time.Hour
+
+	// caCertKey is the key of the secret data holding the certificate chain of the private CA.
+	caCertKey = "ca.crt"
+)
+
+// etcdCertificate describes a certificate issued for etcd by the private CA.
+type etcdCertificate struct {
+	// suffix is appended to the cluster name to name the secret of the certificate.
+	suffix      string
+	subject     pkix.Name
+	dnsNames    func(s *Service) []string
+	ipAddresses []net.IP
+}
+
+var etcdCertificates = []etcdCertificate{
+	{
+		suffix:      "etcd-peer",
+		subject:     pkix.Name{CommonName: "etcd-peer"},
+		dnsNames:    (*Service).peerDNSNames,
+		ipAddresses: []net.IP{net.IPv4(127, 0, 0, 1)},
+	},
+	{
+		suffix:   "apiserver-etcd-client",
+		subject:  pkix.Name{CommonName: "kube-apiserver-etcd-client", Organization: []string{"system:masters"}},
+		dnsNames: func(*Service) []string { return nil },
+	},
+}
+
+// secretName returns the name of the secret holding the certificate of the given cluster.
+func (c etcdCertificate) secretName(clusterName string) string {
+	return fmt.Sprintf("%s-%s", clusterName, c.suffix)
+}
+
+// ReconcileCertificates issues the etcd peer and client certificates of the cluster
+// from its private CA and stores them in Secrets. Certificates are renewed
+// 30 days before they expire.
+func (s *Service) ReconcileCertificates() error {
+	caARN := s.scope.PrivateCAARN()
+	if caARN == "" {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling certificates issued by private CA", "ca-arn", caARN)
+
+	for _, c := range etcdCertificates {
+		name := c.secretName(s.scope.Name())
+		secret, err := s.scope.GetSecret(name)
+		if err != nil {
+			return err
+		}
+
+		if secret != nil && !needsRenewal(secret, time.Now()) {
+			continue
+		}
+
+		if err := s.issueCertificate(caARN, c, name); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedIssueCertificate", "Failed to issue certificate %q: %v", name, err)
+			return err
+		}
+
+		if secret == nil {
+			record.Eventf(s.scope.AWSCluster, "SuccessfulIssueCertificate", "Issued certificate %q", name)
+		} else {
+			record.Eventf(s.scope.AWSCluster, "SuccessfulRenewCertificate", "Renewed certificate %q", name)
+		}
+	}
+
+	return nil
+}
+
+// issueCertificate generates a key and CSR for the certificate, has the private CA sign it,
+// and stores the key, certificate and CA chain in the secret with the given name.
+func (s *Service) issueCertificate(caARN string, c etcdCertificate, name string) error {
+	signingAlgorithm, err := s.signingAlgorithm(caARN)
+	if err != nil {
+		return err
+	}
+
+	key, err := certs.NewPrivateKey()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate private key")
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     c.subject,
+		DNSNames:    c.dnsNames(s),
+		IPAddresses: c.ipAddresses,
+	}, key)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create certificate request for %q", name)
+	}
+
+	out, err := s.scope.ACMPCA.IssueCertificate(&acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		Csr:                     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+		SigningAlgorithm:        aws.String(signingAlgorithm),
+		Validity: &acmpca.Validity{
+			Type:  aws.String(acmpca.ValidityPeriodTypeDays),
+			Value: aws.Int64(certificateValidityDays),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to issue certificate for %q", name)
+	}
+
+	input := &acmpca.GetCertificateInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		CertificateArn:          out.CertificateArn,
+	}
+	if err := s.scope.ACMPCA.WaitUntilCertificateIssued(input); err != nil {
+		return errors.Wrapf(err, "failed to wait for certificate %q to be issued", aws.StringValue(out.CertificateArn))
+	}
+	cert, err := s.scope.ACMPCA.GetCertificate(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get certificate %q", aws.StringValue(out.CertificateArn))
+	}
+
+	return s.scope.SaveSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       []byte(aws.StringValue(cert.Certificate)),
+			corev1.TLSPrivateKeyKey: certs.EncodePrivateKeyPEM(key),
+			caCertKey:               []byte(aws.StringValue(cert.CertificateChain)),
+		},
+	})
+}
+
+// signingAlgorithm returns the signing algorithm configured for the private CA.
+func (s *Service) signingAlgorithm(caARN string) (string, error) {
+	out, err := s.scope.ACMPCA.DescribeCertificateAuthority(&acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(caARN),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe private CA %q", caARN)
+	}
+	ca := out.CertificateAuthority
+	if ca == nil || ca.CertificateAuthorityConfiguration == nil {
+		return "", errors.Errorf("private CA %q has no configuration", caARN)
+	}
+	if status := aws.StringValue(ca.Status); status != acmpca.CertificateAuthorityStatusActive {
+		return "", errors.Errorf("private CA %q is %s", caARN, status)
+	}
+	return aws.StringValue(ca.CertificateAuthorityConfiguration.SigningAlgorithm), nil
+}
+
+// peerDNSNames returns the DNS names of the etcd peer certificate, which covers the
+// private DNS names of the control plane instances in the region of the cluster.
+func (s *Service) peerDNSNames() []string {
+	domain := fmt.Sprintf("%s.compute.internal", s.scope.Region())
+	if s.scope.Region() == "us-east-1" {
+		domain = "ec2.internal"
+	}
+	return []string{"localhost", "*." + domain}
+}
+
+// needsRenewal returns true if the secret holds no valid certificate, or one that
+// expires within certificateRenewBefore of now.
+func needsRenewal(secret *corev1.Secret, now time.Time) bool {
+	cert, err := certs.DecodeCertPEM(secret.Data[corev1.TLSCertKey])
+	if err != nil || cert == nil {
+		return true
+	}
+	return now.Add(certificateRenewBefore).After(cert.NotAfter)
+}
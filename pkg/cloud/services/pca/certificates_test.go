@@ -0,0 +1,243 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pca
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/pca/mock_acmpcaiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	caARN   = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/ca"
+	certARN = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/ca/certificate/1"
+	chain   = "-----BEGIN CERTIFICATE-----\nchain\n-----END CERTIFICATE-----\n"
+)
+
+func newPCATestScope(t *testing.T, pcaMock *mock_acmpcaiface.MockACMPCAAPI, objs ...runtime.Object) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "1"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:               "us-east-1",
+			CertificateAuthority: &infrav1.CertificateAuthoritySpec{PrivateCAARN: caARN},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSClients: scope.AWSClients{
+			ACMPCA: pcaMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, append(objs, awsCluster)...),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+// certificatePEM returns a self-signed certificate expiring at the given time.
+func certificatePEM(t *testing.T, notAfter time.Time) []byte {
+	key, err := certs.NewPrivateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "etcd"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return certs.EncodeCertPEM(cert)
+}
+
+func certificateSecret(name string, cert []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: cert},
+	}
+}
+
+func expectIssueCertificate(m *mock_acmpcaiface.MockACMPCAAPIMockRecorder, cert []byte) {
+	m.DescribeCertificateAuthority(gomock.Eq(&acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(caARN),
+	})).Return(&acmpca.DescribeCertificateAuthorityOutput{
+		CertificateAuthority: &acmpca.CertificateAuthority{
+			Status: aws.String(acmpca.CertificateAuthorityStatusActive),
+			CertificateAuthorityConfiguration: &acmpca.CertificateAuthorityConfiguration{
+				SigningAlgorithm: aws.String(acmpca.SigningAlgorithmSha256withrsa),
+			},
+		},
+	}, nil)
+	m.IssueCertificate(gomock.Any()).DoAndReturn(func(input *acmpca.IssueCertificateInput) (*acmpca.IssueCertificateOutput, error) {
+		if aws.StringValue(input.SigningAlgorithm) != acmpca.SigningAlgorithmSha256withrsa {
+			return nil, errors.New("unexpected signing algorithm")
+		}
+		if aws.Int64Value(input.Validity.Value) != certificateValidityDays {
+			return nil, errors.New("unexpected validity")
+		}
+		return &acmpca.IssueCertificateOutput{CertificateArn: aws.String(certARN)}, nil
+	})
+	getInput := &acmpca.GetCertificateInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		CertificateArn:          aws.String(certARN),
+	}
+	m.WaitUntilCertificateIssued(gomock.Eq(getInput)).Return(nil)
+	m.GetCertificate(gomock.Eq(getInput)).Return(&acmpca.GetCertificateOutput{
+		Certificate:      aws.String(string(cert)),
+		CertificateChain: aws.String(chain),
+	}, nil)
+}
+
+func TestReconcileCertificatesIssuesMissingCertificates(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pcaMock := mock_acmpcaiface.NewMockACMPCAAPI(mockCtrl)
+	clusterScope := newPCATestScope(t, pcaMock)
+
+	cert := certificatePEM(t, time.Now().Add(365*24*time.Hour))
+	expectIssueCertificate(pcaMock.EXPECT(), cert)
+	expectIssueCertificate(pcaMock.EXPECT(), cert)
+
+	if err := NewService(clusterScope).ReconcileCertificates(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	for _, name := range []string{"test-cluster-etcd-peer", "test-cluster-apiserver-etcd-client"} {
+		secret, err := clusterScope.GetSecret(name)
+		if err != nil || secret == nil {
+			t.Fatalf("expected secret %q to be stored, got %v", name, err)
+		}
+		if secret.Type != corev1.SecretTypeTLS {
+			t.Errorf("expected secret %q to be of type %q, got %q", name, corev1.SecretTypeTLS, secret.Type)
+		}
+		if string(secret.Data[corev1.TLSCertKey]) != string(cert) {
+			t.Errorf("expected secret %q to hold the issued certificate", name)
+		}
+		if string(secret.Data[caCertKey]) != chain {
+			t.Errorf("expected secret %q to hold the certificate chain", name)
+		}
+		if _, err := certs.DecodePrivateKeyPEM(secret.Data[corev1.TLSPrivateKeyKey]); err != nil {
+			t.Errorf("expected secret %q to hold the private key, got %v", name, err)
+		}
+		if secret.Labels[clusterv1.ClusterLabelName] != "test-cluster" {
+			t.Errorf("expected secret %q to be labeled with the cluster name", name)
+		}
+		if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Kind != "AWSCluster" {
+			t.Errorf("expected secret %q to be owned by the AWSCluster, got %v", name, secret.OwnerReferences)
+		}
+	}
+}
+
+func TestReconcileCertificatesRenewsExpiringCertificates(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pcaMock := mock_acmpcaiface.NewMockACMPCAAPI(mockCtrl)
+	clusterScope := newPCATestScope(t, pcaMock,
+		certificateSecret("test-cluster-etcd-peer", certificatePEM(t, time.Now().Add(10*24*time.Hour))),
+		certificateSecret("test-cluster-apiserver-etcd-client", certificatePEM(t, time.Now().Add(100*24*time.Hour))),
+	)
+
+	// Only the peer certificate expires within 30 days.
+	renewed := certificatePEM(t, time.Now().Add(365*24*time.Hour))
+	expectIssueCertificate(pcaMock.EXPECT(), renewed)
+
+	if err := NewService(clusterScope).ReconcileCertificates(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	secret, err := clusterScope.GetSecret("test-cluster-etcd-peer")
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if string(secret.Data[corev1.TLSCertKey]) != string(renewed) {
+		t.Errorf("expected the peer certificate to be renewed")
+	}
+	if string(secret.Data[caCertKey]) != chain {
+		t.Errorf("expected the renewed secret to hold the certificate chain")
+	}
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name   string
+		secret *corev1.Secret
+		expect bool
+	}{
+		{
+			name:   "no certificate",
+			secret: &corev1.Secret{},
+			expect: true,
+		},
+		{
+			name:   "certificate expiring within 30 days",
+			secret: certificateSecret("a", certificatePEM(t, now.Add(29*24*time.Hour))),
+			expect: true,
+		},
+		{
+			name:   "certificate expiring in more than 30 days",
+			secret: certificateSecret("a", certificatePEM(t, now.Add(31*24*time.Hour))),
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := needsRenewal(tc.secret, now); got != tc.expect {
+				t.Errorf("expected needsRenewal to be %v, got %v", tc.expect, got)
+			}
+		})
+	}
+}
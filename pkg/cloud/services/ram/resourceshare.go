@@ -0,0 +1,282 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/ram/ramiface"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// AcceptResourceShare accepts the pending invitation to a resource share
+// created by another account, so that the shared subnets can be used by the
+// cluster. It must run before the network is reconciled.
+func (s *Service) AcceptResourceShare() error {
+	share := s.scope.RAMShare()
+	if share == nil || share.ResourceShareARN == "" {
+		return nil
+	}
+
+	s.scope.V(2).Info("Accepting resource share", "resource-share-arn", share.ResourceShareARN)
+
+	if err := s.acceptInvitations(s.scope.RAM, share.ResourceShareARN); err != nil {
+		return err
+	}
+
+	s.scope.Network().ResourceShareARN = share.ResourceShareARN
+	return nil
+}
+
+// acceptInvitations accepts the pending invitations to the given resource
+// share with the given client, which must target the region of the share.
+func (s *Service) acceptInvitations(client ramiface.RAMAPI, shareARN string) error {
+	var pending []*string
+	err := client.GetResourceShareInvitationsPages(&ram.GetResourceShareInvitationsInput{
+		ResourceShareArns: aws.StringSlice([]string{shareARN}),
+	}, func(out *ram.GetResourceShareInvitationsOutput, _ bool) bool {
+		for _, invitation := range out.ResourceShareInvitations {
+			if aws.StringValue(invitation.Status) == ram.ResourceShareInvitationStatusPending {
+				pending = append(pending, invitation.ResourceShareInvitationArn)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get invitations to resource share %q", shareARN)
+	}
+
+	// Shares within an AWS organization don't need to be accepted, so there
+	// may be no invitation at all.
+	for _, invitationARN := range pending {
+		_, err := client.AcceptResourceShareInvitation(&ram.AcceptResourceShareInvitationInput{
+			ResourceShareInvitationArn: invitationARN,
+		})
+		if code, _ := awserrors.Code(err); err != nil && code != ram.ErrCodeResourceShareInvitationAlreadyAcceptedException {
+			record.Warnf(s.scope.AWSCluster, "FailedAcceptResourceShareInvitation", "Failed to accept invitation to resource share %q: %v", shareARN, err)
+			return errors.Wrapf(err, "failed to accept invitation %q", aws.StringValue(invitationARN))
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulAcceptResourceShareInvitation", "Accepted invitation to resource share %q", shareARN)
+	}
+	return nil
+}
+
+// ReconcileResourceShare shares the cluster's subnets with the configured
+// principals, creating the resource share if needed. It must run after the
+// network is reconciled.
+func (s *Service) ReconcileResourceShare() error {
+	share := s.scope.RAMShare()
+	if share == nil || len(share.PrincipalARNs) == 0 {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling resource share")
+
+	subnetARNs, err := s.subnetARNs()
+	if err != nil {
+		return err
+	}
+
+	shareARN := s.scope.Network().ResourceShareARN
+	if shareARN == "" {
+		shareARN, err = s.findResourceShare()
+		if err != nil {
+			return err
+		}
+	}
+
+	if shareARN == "" {
+		out, err := s.scope.RAM.CreateResourceShare(&ram.CreateResourceShareInput{
+			Name:                    aws.String(s.resourceShareName()),
+			ResourceArns:            aws.StringSlice(subnetARNs),
+			Principals:              aws.StringSlice(share.PrincipalARNs),
+			AllowExternalPrincipals: aws.Bool(true),
+			Tags: converters.MapToRAMTags(infrav1.Build(infrav1.BuildParams{
+				ClusterName: s.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(s.resourceShareName()),
+				Role:        aws.String(infrav1.CommonRoleTagValue),
+				Additional:  s.scope.AdditionalTags(),
+			})),
+		})
+		if err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedCreateResourceShare", "Failed to create resource share: %v", err)
+			return errors.Wrap(err, "failed to create resource share")
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateResourceShare", "Created resource share %q", aws.StringValue(out.ResourceShare.ResourceShareArn))
+		s.scope.Network().ResourceShareARN = aws.StringValue(out.ResourceShare.ResourceShareArn)
+		return nil
+	}
+	s.scope.Network().ResourceShareARN = shareARN
+
+	sharedSubnets, err := s.associations(shareARN, ram.ResourceShareAssociationTypeResource)
+	if err != nil {
+		return err
+	}
+	sharedPrincipals, err := s.associations(shareARN, ram.ResourceShareAssociationTypePrincipal)
+	if err != nil {
+		return err
+	}
+
+	missingSubnets := difference(subnetARNs, sharedSubnets)
+	missingPrincipals := difference(share.PrincipalARNs, sharedPrincipals)
+	if len(missingSubnets) > 0 || len(missingPrincipals) > 0 {
+		input := &ram.AssociateResourceShareInput{ResourceShareArn: aws.String(shareARN)}
+		if len(missingSubnets) > 0 {
+			input.ResourceArns = aws.StringSlice(missingSubnets)
+		}
+		if len(missingPrincipals) > 0 {
+			input.Principals = aws.StringSlice(missingPrincipals)
+		}
+		if _, err := s.scope.RAM.AssociateResourceShare(input); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedAssociateResourceShare", "Failed to associate resource share %q: %v", shareARN, err)
+			return errors.Wrapf(err, "failed to associate resource share %q", shareARN)
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulAssociateResourceShare", "Associated resource share %q with %d subnets and %d principals", shareARN, len(missingSubnets), len(missingPrincipals))
+	}
+
+	if removed := difference(sharedPrincipals, share.PrincipalARNs); len(removed) > 0 {
+		if _, err := s.scope.RAM.DisassociateResourceShare(&ram.DisassociateResourceShareInput{
+			ResourceShareArn: aws.String(shareARN),
+			Principals:       aws.StringSlice(removed),
+		}); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedDisassociateResourceShare", "Failed to disassociate principals from resource share %q: %v", shareARN, err)
+			return errors.Wrapf(err, "failed to disassociate principals from resource share %q", shareARN)
+		}
+		record.Eventf(s.scope.AWSCluster, "SuccessfulDisassociateResourceShare", "Disassociated %d principals from resource share %q", len(removed), shareARN)
+	}
+
+	return nil
+}
+
+// DeleteResourceShare deletes the resource share created for the cluster's
+// subnets. Accepted shares are owned by another account and are left alone.
+func (s *Service) DeleteResourceShare() error {
+	share := s.scope.RAMShare()
+	shareARN := s.scope.Network().ResourceShareARN
+	if share == nil || len(share.PrincipalARNs) == 0 || shareARN == "" {
+		s.scope.Network().ResourceShareARN = ""
+		return nil
+	}
+
+	s.scope.V(2).Info("Deleting resource share", "resource-share-arn", shareARN)
+
+	_, err := s.scope.RAM.DeleteResourceShare(&ram.DeleteResourceShareInput{
+		ResourceShareArn: aws.String(shareARN),
+	})
+	if code, _ := awserrors.Code(err); err != nil && code != ram.ErrCodeUnknownResourceException {
+		record.Warnf(s.scope.AWSCluster, "FailedDeleteResourceShare", "Failed to delete resource share %q: %v", shareARN, err)
+		return errors.Wrapf(err, "failed to delete resource share %q", shareARN)
+	}
+	if err == nil {
+		record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteResourceShare", "Deleted resource share %q", shareARN)
+	}
+
+	s.scope.Network().ResourceShareARN = ""
+	return nil
+}
+
+func (s *Service) resourceShareName() string {
+	return fmt.Sprintf("%s-subnets", s.scope.Name())
+}
+
+// findResourceShare looks up a share created by a previous reconcile whose
+// ARN could not be stored in the status.
+func (s *Service) findResourceShare() (string, error) {
+	out, err := s.scope.RAM.GetResourceShares(&ram.GetResourceSharesInput{
+		ResourceOwner:       aws.String(ram.ResourceOwnerSelf),
+		Name:                aws.String(s.resourceShareName()),
+		ResourceShareStatus: aws.String(ram.ResourceShareStatusActive),
+		TagFilters: []*ram.TagFilter{{
+			TagKey:    aws.String(infrav1.ClusterTagKey(s.scope.Name())),
+			TagValues: aws.StringSlice([]string{string(infrav1.ResourceLifecycleOwned)}),
+		}},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get resource shares")
+	}
+	if len(out.ResourceShares) == 0 {
+		return "", nil
+	}
+	return aws.StringValue(out.ResourceShares[0].ResourceShareArn), nil
+}
+
+// subnetARNs returns the ARNs of the cluster's subnets.
+func (s *Service) subnetARNs() ([]string, error) {
+	var ids []*string
+	for _, sn := range s.scope.Subnets() {
+		if sn.ID != "" {
+			ids = append(ids, aws.String(sn.ID))
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	out, err := s.scope.EC2.DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: ids})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe subnets")
+	}
+	arns := make([]string, 0, len(out.Subnets))
+	for _, sn := range out.Subnets {
+		arns = append(arns, aws.StringValue(sn.SubnetArn))
+	}
+	return arns, nil
+}
+
+// associations returns the resources or principals associated with a share.
+func (s *Service) associations(shareARN, associationType string) ([]string, error) {
+	var associated []string
+	err := s.scope.RAM.GetResourceShareAssociationsPages(&ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{shareARN}),
+	}, func(out *ram.GetResourceShareAssociationsOutput, _ bool) bool {
+		for _, association := range out.ResourceShareAssociations {
+			switch aws.StringValue(association.Status) {
+			case ram.ResourceShareAssociationStatusAssociating, ram.ResourceShareAssociationStatusAssociated:
+				associated = append(associated, aws.StringValue(association.AssociatedEntity))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get associations of resource share %q", shareARN)
+	}
+	return associated, nil
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+	var out []string
+	for _, v := range a {
+		if !in[v] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ram/mock_ramiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testShareARN      = "arn:aws:ram:us-east-1:111111111111:resource-share/share-1"
+	testInvitationARN = "arn:aws:ram:us-east-1:111111111111:resource-share-invitation/invite-1"
+	testSubnetARN1    = "arn:aws:ec2:us-east-1:111111111111:subnet/subnet-1"
+	testSubnetARN2    = "arn:aws:ec2:us-east-1:111111111111:subnet/subnet-2"
+)
+
+func newRAMTestScope(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, ramMock *mock_ramiface.MockRAMAPI, share *infrav1.RAMShareSpec, shareARN string) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			NetworkSpec: infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+					{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+				},
+				RAMShare: share,
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.Network{ResourceShareARN: shareARN},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2: ec2Mock,
+			RAM: ramMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func expectSubnetARNs(m *mock_ec2iface.MockEC2APIMockRecorder) {
+	m.DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+	})).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-1"), SubnetArn: aws.String(testSubnetARN1)},
+			{SubnetId: aws.String("subnet-2"), SubnetArn: aws.String(testSubnetARN2)},
+		},
+	}, nil)
+}
+
+func expectAssociations(m *mock_ramiface.MockRAMAPIMockRecorder, associationType string, entities ...string) {
+	m.GetResourceShareAssociationsPages(gomock.Eq(&ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{testShareARN}),
+	}), gomock.Any()).DoAndReturn(func(_ *ram.GetResourceShareAssociationsInput, fn func(*ram.GetResourceShareAssociationsOutput, bool) bool) error {
+		out := &ram.GetResourceShareAssociationsOutput{}
+		for _, e := range entities {
+			out.ResourceShareAssociations = append(out.ResourceShareAssociations, &ram.ResourceShareAssociation{
+				AssociatedEntity: aws.String(e),
+				Status:           aws.String(ram.ResourceShareAssociationStatusAssociated),
+			})
+		}
+		fn(out, true)
+		return nil
+	})
+}
+
+func TestReconcileResourceShare(t *testing.T) {
+	testCases := []struct {
+		name     string
+		share    *infrav1.RAMShareSpec
+		shareARN string
+		expect   func(e *mock_ec2iface.MockEC2APIMockRecorder, r *mock_ramiface.MockRAMAPIMockRecorder)
+	}{
+		{
+			name:  "no ram share",
+			share: nil,
+		},
+		{
+			name:  "accepted shares are left to AcceptResourceShare",
+			share: &infrav1.RAMShareSpec{ResourceShareARN: testShareARN},
+		},
+		{
+			name:  "creates the resource share",
+			share: &infrav1.RAMShareSpec{PrincipalARNs: []string{"222222222222"}},
+			expect: func(e *mock_ec2iface.MockEC2APIMockRecorder, r *mock_ramiface.MockRAMAPIMockRecorder) {
+				expectSubnetARNs(e)
+				r.GetResourceShares(gomock.Any()).Return(&ram.GetResourceSharesOutput{}, nil)
+				r.CreateResourceShare(gomock.Any()).
+					DoAndReturn(func(input *ram.CreateResourceShareInput) (*ram.CreateResourceShareOutput, error) {
+						if aws.StringValue(input.Name) != "test-cluster-subnets" {
+							t.Errorf("unexpected resource share name %q", aws.StringValue(input.Name))
+						}
+						if len(input.ResourceArns) != 2 || len(input.Principals) != 1 {
+							t.Errorf("unexpected resource share input %v", input)
+						}
+						return &ram.CreateResourceShareOutput{
+							ResourceShare: &ram.ResourceShare{ResourceShareArn: aws.String(testShareARN)},
+						}, nil
+					})
+			},
+		},
+		{
+			name:  "adopts a resource share missing from the status",
+			share: &infrav1.RAMShareSpec{PrincipalARNs: []string{"222222222222"}},
+			expect: func(e *mock_ec2iface.MockEC2APIMockRecorder, r *mock_ramiface.MockRAMAPIMockRecorder) {
+				expectSubnetARNs(e)
+				r.GetResourceShares(gomock.Any()).Return(&ram.GetResourceSharesOutput{
+					ResourceShares: []*ram.ResourceShare{{ResourceShareArn: aws.String(testShareARN)}},
+				}, nil)
+				expectAssociations(r, ram.ResourceShareAssociationTypeResource, testSubnetARN1, testSubnetARN2)
+				expectAssociations(r, ram.ResourceShareAssociationTypePrincipal, "222222222222")
+			},
+		},
+		{
+			name:     "associates new subnets and principals, disassociates removed principals",
+			share:    &infrav1.RAMShareSpec{PrincipalARNs: []string{"222222222222", "333333333333"}},
+			shareARN: testShareARN,
+			expect: func(e *mock_ec2iface.MockEC2APIMockRecorder, r *mock_ramiface.MockRAMAPIMockRecorder) {
+				expectSubnetARNs(e)
+				expectAssociations(r, ram.ResourceShareAssociationTypeResource, testSubnetARN1)
+				expectAssociations(r, ram.ResourceShareAssociationTypePrincipal, "222222222222", "444444444444")
+				r.AssociateResourceShare(gomock.Eq(&ram.AssociateResourceShareInput{
+					ResourceShareArn: aws.String(testShareARN),
+					ResourceArns:     aws.StringSlice([]string{testSubnetARN2}),
+					Principals:       aws.StringSlice([]string{"333333333333"}),
+				})).Return(&ram.AssociateResourceShareOutput{}, nil)
+				r.DisassociateResourceShare(gomock.Eq(&ram.DisassociateResourceShareInput{
+					ResourceShareArn: aws.String(testShareARN),
+					Principals:       aws.StringSlice([]string{"444444444444"}),
+				})).Return(&ram.DisassociateResourceShareOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			ramMock := mock_ramiface.NewMockRAMAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT(), ramMock.EXPECT())
+			}
+
+			s := NewService(newRAMTestScope(t, ec2Mock, ramMock, tc.share, tc.shareARN))
+			if err := s.ReconcileResourceShare(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			if tc.share != nil && len(tc.share.PrincipalARNs) > 0 && s.scope.Network().ResourceShareARN != testShareARN {
+				t.Fatalf("expected resource share ARN %q in status, got %q", testShareARN, s.scope.Network().ResourceShareARN)
+			}
+		})
+	}
+}
+
+func TestAcceptResourceShare(t *testing.T) {
+	testCases := []struct {
+		name        string
+		invitations []*ram.ResourceShareInvitation
+		acceptErr   error
+		expectErr   bool
+	}{
+		{
+			name: "accepts pending invitations",
+			invitations: []*ram.ResourceShareInvitation{
+				{ResourceShareInvitationArn: aws.String(testInvitationARN), Status: aws.String(ram.ResourceShareInvitationStatusPending)},
+				{ResourceShareInvitationArn: aws.String("arn:old"), Status: aws.String(ram.ResourceShareInvitationStatusExpired)},
+			},
+		},
+		{
+			name: "no invitation for shares within an organization",
+		},
+		{
+			name: "invitation accepted concurrently",
+			invitations: []*ram.ResourceShareInvitation{
+				{ResourceShareInvitationArn: aws.String(testInvitationARN), Status: aws.String(ram.ResourceShareInvitationStatusPending)},
+			},
+			acceptErr: awserr.New(ram.ErrCodeResourceShareInvitationAlreadyAcceptedException, "accepted", nil),
+		},
+		{
+			name: "accept fails",
+			invitations: []*ram.ResourceShareInvitation{
+				{ResourceShareInvitationArn: aws.String(testInvitationARN), Status: aws.String(ram.ResourceShareInvitationStatusPending)},
+			},
+			acceptErr: awserr.New(ram.ErrCodeResourceShareInvitationExpiredException, "expired", nil),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ramMock := mock_ramiface.NewMockRAMAPI(mockCtrl)
+
+			ramMock.EXPECT().GetResourceShareInvitationsPages(gomock.Eq(&ram.GetResourceShareInvitationsInput{
+				ResourceShareArns: aws.StringSlice([]string{testShareARN}),
+			}), gomock.Any()).DoAndReturn(func(_ *ram.GetResourceShareInvitationsInput, fn func(*ram.GetResourceShareInvitationsOutput, bool) bool) error {
+				fn(&ram.GetResourceShareInvitationsOutput{ResourceShareInvitations: tc.invitations}, true)
+				return nil
+			})
+			if len(tc.invitations) > 0 {
+				ramMock.EXPECT().AcceptResourceShareInvitation(gomock.Eq(&ram.AcceptResourceShareInvitationInput{
+					ResourceShareInvitationArn: aws.String(testInvitationARN),
+				})).Return(&ram.AcceptResourceShareInvitationOutput{}, tc.acceptErr)
+			}
+
+			s := NewService(newRAMTestScope(t, nil, ramMock, &infrav1.RAMShareSpec{ResourceShareARN: testShareARN}, ""))
+			err := s.AcceptResourceShare()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if s.scope.Network().ResourceShareARN != testShareARN {
+				t.Fatalf("expected resource share ARN %q in status, got %q", testShareARN, s.scope.Network().ResourceShareARN)
+			}
+		})
+	}
+}
+
+func TestDeleteResourceShare(t *testing.T) {
+	testCases := []struct {
+		name      string
+		share     *infrav1.RAMShareSpec
+		deleteErr error
+		expectErr bool
+	}{
+		{
+			name:  "deletes the owned resource share",
+			share: &infrav1.RAMShareSpec{PrincipalARNs: []string{"222222222222"}},
+		},
+		{
+			name:      "resource share already deleted",
+			share:     &infrav1.RAMShareSpec{PrincipalARNs: []string{"222222222222"}},
+			deleteErr: awserr.New(ram.ErrCodeUnknownResourceException, "not found", nil),
+		},
+		{
+			name:      "delete fails",
+			share:     &infrav1.RAMShareSpec{PrincipalARNs: []string{"222222222222"}},
+			deleteErr: awserr.New(ram.ErrCodeOperationNotPermittedException, "denied", nil),
+			expectErr: true,
+		},
+		{
+			name:  "accepted resource shares are not deleted",
+			share: &infrav1.RAMShareSpec{ResourceShareARN: testShareARN},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ramMock := mock_ramiface.NewMockRAMAPI(mockCtrl)
+
+			if len(tc.share.PrincipalARNs) > 0 {
+				ramMock.EXPECT().DeleteResourceShare(gomock.Eq(&ram.DeleteResourceShareInput{
+					ResourceShareArn: aws.String(testShareARN),
+				})).Return(&ram.DeleteResourceShareOutput{}, tc.deleteErr)
+			}
+
+			s := NewService(newRAMTestScope(t, nil, ramMock, tc.share, testShareARN))
+			err := s.DeleteResourceShare()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if s.scope.Network().ResourceShareARN != "" {
+				t.Fatalf("expected resource share ARN to be cleared, got %q", s.scope.Network().ResourceShareARN)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+)
+
+const (
+	// TransitGatewaySharePollInterval is the interval at which a shared transit gateway that is not visible yet
+	// is checked again.
+	TransitGatewaySharePollInterval = 30 * time.Second
+
+	// TransitGatewayShareTimeout is how long a shared transit gateway may take to become visible once the
+	// invitation to its resource share is accepted.
+	TransitGatewayShareTimeout = 10 * time.Minute
+)
+
+// errTransitGatewaySharePending is returned by AcceptTransitGatewayShare while the shared transit gateway is not
+// visible to the cluster's account yet.
+var errTransitGatewaySharePending = errors.New("shared transit gateway is not available yet")
+
+// AcceptTransitGatewayShare accepts the pending invitation to the resource share of the transit gateway of the
+// cluster's remote region, and checks that the transit gateway is available to the cluster's account. It must run
+// before the remote VPC is attached to the transit gateway.
+func (s *Service) AcceptTransitGatewayShare() error {
+	remote := s.scope.RemoteRegion()
+	if remote == nil || remote.TransitGatewayRAMShareARN == "" {
+		return nil
+	}
+
+	s.scope.V(2).Info("Accepting transit gateway resource share", "resource-share-arn", remote.TransitGatewayRAMShareARN, "region", remote.Region)
+
+	// RAM is regional, the share lives in the region of the transit gateway.
+	if err := s.acceptInvitations(s.scope.RemoteRAM, remote.TransitGatewayRAMShareARN); err != nil {
+		return err
+	}
+
+	out, err := s.scope.RemoteEC2.DescribeTransitGateways(&ec2.DescribeTransitGatewaysInput{
+		TransitGatewayIds: aws.StringSlice([]string{remote.TransitGatewayID}),
+	})
+	if code, _ := awserrors.Code(err); code == awserrors.TransitGatewayNotFound {
+		return errors.Wrapf(errTransitGatewaySharePending, "transit gateway %q is not visible yet", remote.TransitGatewayID)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe transit gateway %q", remote.TransitGatewayID)
+	}
+	if len(out.TransitGateways) == 0 {
+		return errors.Wrapf(errTransitGatewaySharePending, "transit gateway %q is not visible yet", remote.TransitGatewayID)
+	}
+	if state := aws.StringValue(out.TransitGateways[0].State); state != ec2.TransitGatewayStateAvailable {
+		return errors.Wrapf(errTransitGatewaySharePending, "transit gateway %q is %s", remote.TransitGatewayID, state)
+	}
+
+	return nil
+}
+
+// IsTransitGatewaySharePending returns true if the shared transit gateway is not available yet.
+func IsTransitGatewaySharePending(err error) bool {
+	return errors.Cause(err) == errTransitGatewaySharePending
+}
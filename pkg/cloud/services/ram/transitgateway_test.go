@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ram/mock_ramiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testTransitGatewayShareARN = "arn:aws:ram:us-west-2:333333333333:resource-share/share-tgw"
+
+func newTransitGatewayShareTestScope(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, ramMock *mock_ramiface.MockRAMAPI) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			NetworkSpec: infrav1.NetworkSpec{
+				RemoteRegion: &infrav1.RemoteRegionSpec{
+					Region:                    "us-west-2",
+					VPCCidr:                   "10.1.0.0/16",
+					TransitGatewayID:          "tgw-remote",
+					TransitGatewayRAMShareARN: testTransitGatewayShareARN,
+				},
+			},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			RemoteEC2: ec2Mock,
+			RemoteRAM: ramMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestAcceptTransitGatewayShare(t *testing.T) {
+	pending := []*ram.ResourceShareInvitation{
+		{ResourceShareInvitationArn: aws.String(testInvitationARN), Status: aws.String(ram.ResourceShareInvitationStatusPending)},
+	}
+
+	testCases := []struct {
+		name          string
+		invitations   []*ram.ResourceShareInvitation
+		acceptErr     error
+		gateways      []*ec2.TransitGateway
+		describeErr   error
+		expectPending bool
+		expectErr     bool
+	}{
+		{
+			name:        "accepts the invitation of an available transit gateway",
+			invitations: pending,
+			gateways:    []*ec2.TransitGateway{{TransitGatewayId: aws.String("tgw-remote"), State: aws.String(ec2.TransitGatewayStateAvailable)}},
+		},
+		{
+			name:     "no invitation once accepted",
+			gateways: []*ec2.TransitGateway{{TransitGatewayId: aws.String("tgw-remote"), State: aws.String(ec2.TransitGatewayStateAvailable)}},
+		},
+		{
+			name:          "waits for the transit gateway to be visible",
+			invitations:   pending,
+			describeErr:   awserr.New(awserrors.TransitGatewayNotFound, "not found", nil),
+			expectPending: true,
+		},
+		{
+			name:          "waits for the transit gateway to be listed",
+			expectPending: true,
+		},
+		{
+			name:          "waits for the transit gateway to be available",
+			gateways:      []*ec2.TransitGateway{{TransitGatewayId: aws.String("tgw-remote"), State: aws.String(ec2.TransitGatewayStatePending)}},
+			expectPending: true,
+		},
+		{
+			name:        "accept fails",
+			invitations: pending,
+			acceptErr:   awserr.New(ram.ErrCodeResourceShareInvitationExpiredException, "expired", nil),
+			expectErr:   true,
+		},
+		{
+			name:        "describe fails",
+			describeErr: errors.New("access denied"),
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			ramMock := mock_ramiface.NewMockRAMAPI(mockCtrl)
+
+			ramMock.EXPECT().GetResourceShareInvitationsPages(gomock.Eq(&ram.GetResourceShareInvitationsInput{
+				ResourceShareArns: aws.StringSlice([]string{testTransitGatewayShareARN}),
+			}), gomock.Any()).DoAndReturn(func(_ *ram.GetResourceShareInvitationsInput, fn func(*ram.GetResourceShareInvitationsOutput, bool) bool) error {
+				fn(&ram.GetResourceShareInvitationsOutput{ResourceShareInvitations: tc.invitations}, true)
+				return nil
+			})
+			if len(tc.invitations) > 0 {
+				ramMock.EXPECT().AcceptResourceShareInvitation(gomock.Eq(&ram.AcceptResourceShareInvitationInput{
+					ResourceShareInvitationArn: aws.String(testInvitationARN),
+				})).Return(&ram.AcceptResourceShareInvitationOutput{}, tc.acceptErr)
+			}
+			if tc.acceptErr == nil {
+				ec2Mock.EXPECT().DescribeTransitGateways(gomock.Eq(&ec2.DescribeTransitGatewaysInput{
+					TransitGatewayIds: aws.StringSlice([]string{"tgw-remote"}),
+				})).Return(&ec2.DescribeTransitGatewaysOutput{TransitGateways: tc.gateways}, tc.describeErr)
+			}
+
+			err := NewService(newTransitGatewayShareTestScope(t, ec2Mock, ramMock)).AcceptTransitGatewayShare()
+			if IsTransitGatewaySharePending(err) != tc.expectPending {
+				t.Fatalf("expected pending: %v, got: %v", tc.expectPending, err)
+			}
+			if tc.expectErr != (err != nil && !tc.expectPending) {
+				t.Fatalf("expected error: %v, got: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestAcceptTransitGatewayShareWithoutShare(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusterScope := newTransitGatewayShareTestScope(t, mock_ec2iface.NewMockEC2API(mockCtrl), mock_ramiface.NewMockRAMAPI(mockCtrl))
+	clusterScope.AWSCluster.Spec.NetworkSpec.RemoteRegion.TransitGatewayRAMShareARN = ""
+	if err := NewService(clusterScope).AcceptTransitGatewayShare(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
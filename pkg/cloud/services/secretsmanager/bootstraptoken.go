@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraputil "k8s.io/cluster-bootstrap/token/util"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// bootstrapTokenGracePeriod is how long tokens stay valid after their rotation is due,
+	// so that nodes which read the previous token can still join the cluster.
+	bootstrapTokenGracePeriod = 24 * time.Hour
+
+	// bootstrapTokenGroups are the extra groups of the tokens, the ones of the tokens issued by kubeadm.
+	bootstrapTokenGroups = "system:bootstrappers:kubeadm:default-node-token"
+)
+
+// BootstrapTokenSecretName returns the name of the secret holding the node bootstrap token of the cluster.
+func BootstrapTokenSecretName(clusterName string) string {
+	return path.Join(entryPrefix, clusterName, "bootstrap-token")
+}
+
+// ReconcileBootstrapToken stores a node bootstrap token of the cluster in AWS Secrets Manager and
+// rotates it every RotationDays. The rotation is delegated to the Lambda function of the cluster's
+// BootstrapTokenRotation if any, otherwise the controller regenerates the token through the
+// Kubernetes API of the cluster.
+func (s *Service) ReconcileBootstrapToken() error {
+	spec := s.scope.BootstrapTokenRotation()
+	if spec == nil {
+		return nil
+	}
+
+	name := BootstrapTokenSecretName(s.scope.Name())
+	secret, err := s.describeSecret(name)
+	if err != nil {
+		return err
+	}
+
+	if spec.RotationLambdaARN != "" {
+		return s.reconcileLambdaRotation(name, secret, spec)
+	}
+	return s.reconcileTokenRegeneration(name, secret, spec)
+}
+
+// DeleteBootstrapToken deletes the secret holding the node bootstrap token of the cluster, if any.
+func (s *Service) DeleteBootstrapToken() error {
+	if s.scope.BootstrapTokenRotation() == nil && s.scope.AWSCluster.Status.BootstrapTokenSecretARN == "" {
+		return nil
+	}
+
+	name := BootstrapTokenSecretName(s.scope.Name())
+	if _, err := s.scope.SecretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(name),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); !ok || code != secretsmanager.ErrCodeResourceNotFoundException {
+			return errors.Wrapf(err, "failed to delete bootstrap token secret %q", name)
+		}
+	}
+
+	s.scope.AWSCluster.Status.BootstrapTokenSecretARN = ""
+	return nil
+}
+
+// reconcileLambdaRotation enables the rotation of the secret by the Lambda function, creating the
+// secret with an initial token first. The initial token is immediately rotated by the function,
+// which is responsible for creating the tokens in the cluster.
+func (s *Service) reconcileLambdaRotation(name string, secret *secretsmanager.DescribeSecretOutput, spec *infrav1.RotationSpec) error {
+	arn := ""
+	if secret == nil {
+		token, err := bootstraputil.GenerateBootstrapToken()
+		if err != nil {
+			return errors.Wrap(err, "failed to generate bootstrap token")
+		}
+		if arn, err = s.createBootstrapTokenSecret(name, token); err != nil {
+			return err
+		}
+	} else {
+		arn = aws.StringValue(secret.ARN)
+	}
+	s.scope.AWSCluster.Status.BootstrapTokenSecretARN = arn
+
+	if secret != nil && aws.BoolValue(secret.RotationEnabled) &&
+		aws.StringValue(secret.RotationLambdaARN) == spec.RotationLambdaARN &&
+		secret.RotationRules != nil && aws.Int64Value(secret.RotationRules.AutomaticallyAfterDays) == spec.RotationDays {
+		return nil
+	}
+
+	if _, err := s.scope.SecretsManager.RotateSecret(&secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(arn),
+		RotationLambdaARN: aws.String(spec.RotationLambdaARN),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(spec.RotationDays),
+		},
+	}); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedRotateBootstrapToken", "Failed to enable rotation of bootstrap token secret %q: %v", name, err)
+		return errors.Wrapf(err, "failed to enable rotation of bootstrap token secret %q", name)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulRotateBootstrapToken", "Enabled rotation of bootstrap token secret %q by %q", name, spec.RotationLambdaARN)
+	return nil
+}
+
+// reconcileTokenRegeneration creates a new bootstrap token in the cluster and stores it in the
+// secret every RotationDays. The previous tokens expire on their own.
+func (s *Service) reconcileTokenRegeneration(name string, secret *secretsmanager.DescribeSecretOutput, spec *infrav1.RotationSpec) error {
+	interval := time.Duration(spec.RotationDays) * 24 * time.Hour
+
+	if secret != nil {
+		s.scope.AWSCluster.Status.BootstrapTokenSecretARN = aws.StringValue(secret.ARN)
+
+		// The secret was rotated by a Lambda function before, which must not overwrite the tokens of the controller.
+		if aws.BoolValue(secret.RotationEnabled) {
+			if _, err := s.scope.SecretsManager.CancelRotateSecret(&secretsmanager.CancelRotateSecretInput{
+				SecretId: secret.ARN,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to disable rotation of bootstrap token secret %q", name)
+			}
+		}
+
+		lastChanged := aws.TimeValue(secret.LastChangedDate)
+		if lastChanged.IsZero() {
+			lastChanged = aws.TimeValue(secret.CreatedDate)
+		}
+		if time.Since(lastChanged) < interval {
+			return nil
+		}
+	}
+
+	// Tokens can only be created once the API server of the cluster is up.
+	if !s.scope.Cluster.Status.ControlPlaneInitialized {
+		s.scope.V(2).Info("Waiting for the control plane to be initialized before generating a bootstrap token")
+		return nil
+	}
+
+	workloadClient, err := s.getWorkloadClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to create client for workload cluster")
+	}
+
+	token, err := bootstraputil.GenerateBootstrapToken()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate bootstrap token")
+	}
+	if err := workloadClient.Create(context.TODO(), bootstrapTokenSecret(token, time.Now().Add(interval+bootstrapTokenGracePeriod))); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedRotateBootstrapToken", "Failed to create bootstrap token in workload cluster: %v", err)
+		return errors.Wrap(err, "failed to create bootstrap token in workload cluster")
+	}
+
+	if secret == nil {
+		arn, err := s.createBootstrapTokenSecret(name, token)
+		if err != nil {
+			return err
+		}
+		s.scope.AWSCluster.Status.BootstrapTokenSecretARN = arn
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateBootstrapToken", "Created bootstrap token secret %q", name)
+		return nil
+	}
+
+	if _, err := s.scope.SecretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     secret.ARN,
+		SecretString: aws.String(token),
+	}); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedRotateBootstrapToken", "Failed to store bootstrap token in secret %q: %v", name, err)
+		return errors.Wrapf(err, "failed to store bootstrap token in secret %q", name)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulRotateBootstrapToken", "Rotated bootstrap token secret %q", name)
+	return nil
+}
+
+// describeSecret returns the secret with the given name, or nil if it doesn't exist.
+func (s *Service) describeSecret(name string) (*secretsmanager.DescribeSecretOutput, error) {
+	out, err := s.scope.SecretsManager.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(name),
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == secretsmanager.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe secret %q", name)
+	}
+	// Secrets scheduled for deletion can't be updated, they are recreated once deleted.
+	if out.DeletedDate != nil {
+		return nil, errors.Errorf("secret %q is scheduled for deletion", name)
+	}
+	return out, nil
+}
+
+// createBootstrapTokenSecret creates the secret holding the bootstrap token of the cluster and returns its ARN.
+func (s *Service) createBootstrapTokenSecret(name, token string) (string, error) {
+	out, err := s.scope.SecretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(name),
+		Description:  aws.String("Node bootstrap token of cluster " + s.scope.Name()),
+		SecretString: aws.String(token),
+		Tags: converters.MapToSecretsManagerTags(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.Name(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        aws.String(name),
+			Additional:  s.scope.AdditionalTags(),
+		})),
+	})
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedCreateBootstrapToken", "Failed to create bootstrap token secret %q: %v", name, err)
+		return "", errors.Wrapf(err, "failed to create bootstrap token secret %q", name)
+	}
+	return aws.StringValue(out.ARN), nil
+}
+
+func (s *Service) getWorkloadClient() (client.Client, error) {
+	if s.workloadClient != nil {
+		return s.workloadClient, nil
+	}
+	return s.scope.WorkloadClient(context.TODO())
+}
+
+// bootstrapTokenSecret returns the secret of the given bootstrap token, as created by kubeadm.
+func bootstrapTokenSecret(token string, expiration time.Time) *corev1.Secret {
+	parts := strings.Split(token, ".")
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstraputil.BootstrapTokenSecretName(parts[0]),
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: bootstrapapi.SecretTypeBootstrapToken,
+		StringData: map[string]string{
+			bootstrapapi.BootstrapTokenDescriptionKey:      "Node bootstrap token rotated by cluster-api-provider-aws",
+			bootstrapapi.BootstrapTokenIDKey:               parts[0],
+			bootstrapapi.BootstrapTokenSecretKey:           parts[1],
+			bootstrapapi.BootstrapTokenExpirationKey:       expiration.UTC().Format(time.RFC3339),
+			bootstrapapi.BootstrapTokenUsageAuthentication: "true",
+			bootstrapapi.BootstrapTokenUsageSigningKey:     "true",
+			bootstrapapi.BootstrapTokenExtraGroupsKey:      bootstrapTokenGroups,
+		},
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager/mock_secretsmanageriface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	lambdaARN = "arn:aws:lambda:us-east-1:123456789012:function:rotate-bootstrap-token"
+	secretARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:aws.cluster.x-k8s.io/test-cluster/bootstrap-token-abcdef"
+)
+
+var secretName = BootstrapTokenSecretName("test-cluster")
+
+func newBootstrapTokenTestService(t *testing.T, smMock *mock_secretsmanageriface.MockSecretsManagerAPI, spec *infrav1.RotationSpec, controlPlaneInitialized bool) (*Service, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:                 "us-east-1",
+			BootstrapTokenRotation: spec,
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Status:     clusterv1.ClusterStatus{ControlPlaneInitialized: controlPlaneInitialized},
+		},
+		AWSClients: scope.AWSClients{
+			SecretsManager: smMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	workloadClient := fake.NewFakeClientWithScheme(clientgoscheme.Scheme)
+	s := NewService(clusterScope)
+	s.workloadClient = workloadClient
+	return s, workloadClient
+}
+
+// workloadToken returns the token created in the workload cluster, failing unless there's exactly one.
+func workloadToken(t *testing.T, c client.Client) string {
+	secrets := &corev1.SecretList{}
+	if err := c.List(context.TODO(), secrets, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		t.Fatalf("failed to list secrets: %v", err)
+	}
+	if len(secrets.Items) != 1 {
+		t.Fatalf("expected a single bootstrap token in the workload cluster, got %d", len(secrets.Items))
+	}
+	secret := secrets.Items[0]
+	if secret.Type != bootstrapapi.SecretTypeBootstrapToken {
+		t.Errorf("expected a secret of type %q, got %q", bootstrapapi.SecretTypeBootstrapToken, secret.Type)
+	}
+	if secret.StringData[bootstrapapi.BootstrapTokenUsageAuthentication] != "true" {
+		t.Errorf("expected the token to be usable for authentication")
+	}
+	return secret.StringData[bootstrapapi.BootstrapTokenIDKey] + "." + secret.StringData[bootstrapapi.BootstrapTokenSecretKey]
+}
+
+func notFound() error {
+	return awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)
+}
+
+func TestReconcileBootstrapTokenWithLambda(t *testing.T) {
+	spec := &infrav1.RotationSpec{RotationLambdaARN: lambdaARN, RotationDays: 7}
+	rotateInput := &secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(secretARN),
+		RotationLambdaARN: aws.String(lambdaARN),
+		RotationRules:     &secretsmanager.RotationRulesType{AutomaticallyAfterDays: aws.Int64(7)},
+	}
+
+	testCases := []struct {
+		name   string
+		expect func(m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder)
+	}{
+		{
+			name: "creates the secret and enables its rotation",
+			expect: func(m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder) {
+				m.DescribeSecret(gomock.Eq(&secretsmanager.DescribeSecretInput{SecretId: aws.String(secretName)})).Return(nil, notFound())
+				m.CreateSecret(gomock.Any()).DoAndReturn(func(input *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+					if aws.StringValue(input.Name) != secretName {
+						t.Errorf("expected secret %q, got %q", secretName, aws.StringValue(input.Name))
+					}
+					return &secretsmanager.CreateSecretOutput{ARN: aws.String(secretARN)}, nil
+				})
+				m.RotateSecret(gomock.Eq(rotateInput)).Return(&secretsmanager.RotateSecretOutput{}, nil)
+			},
+		},
+		{
+			name: "updates the rotation interval",
+			expect: func(m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder) {
+				m.DescribeSecret(gomock.Any()).Return(&secretsmanager.DescribeSecretOutput{
+					ARN:               aws.String(secretARN),
+					RotationEnabled:   aws.Bool(true),
+					RotationLambdaARN: aws.String(lambdaARN),
+					RotationRules:     &secretsmanager.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+				}, nil)
+				m.RotateSecret(gomock.Eq(rotateInput)).Return(&secretsmanager.RotateSecretOutput{}, nil)
+			},
+		},
+		{
+			name: "does nothing when the rotation is up to date",
+			expect: func(m *mock_secretsmanageriface.MockSecretsManagerAPIMockRecorder) {
+				m.DescribeSecret(gomock.Any()).Return(&secretsmanager.DescribeSecretOutput{
+					ARN:               aws.String(secretARN),
+					RotationEnabled:   aws.Bool(true),
+					RotationLambdaARN: aws.String(lambdaARN),
+					RotationRules:     &secretsmanager.RotationRulesType{AutomaticallyAfterDays: aws.Int64(7)},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+			tc.expect(smMock.EXPECT())
+
+			s, _ := newBootstrapTokenTestService(t, smMock, spec, true)
+			if err := s.ReconcileBootstrapToken(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if got := s.scope.AWSCluster.Status.BootstrapTokenSecretARN; got != secretARN {
+				t.Errorf("expected the secret ARN to be %q, got %q", secretARN, got)
+			}
+		})
+	}
+}
+
+func TestReconcileBootstrapTokenWithoutLambda(t *testing.T) {
+	spec := &infrav1.RotationSpec{RotationDays: 7}
+
+	t.Run("creates the secret with a new token", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+		s, workloadClient := newBootstrapTokenTestService(t, smMock, spec, true)
+
+		var stored string
+		smMock.EXPECT().DescribeSecret(gomock.Any()).Return(nil, notFound())
+		smMock.EXPECT().CreateSecret(gomock.Any()).DoAndReturn(func(input *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+			stored = aws.StringValue(input.SecretString)
+			return &secretsmanager.CreateSecretOutput{ARN: aws.String(secretARN)}, nil
+		})
+
+		if err := s.ReconcileBootstrapToken(); err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		if token := workloadToken(t, workloadClient); stored != token {
+			t.Errorf("expected the secret to hold token %q, got %q", token, stored)
+		}
+		if got := s.scope.AWSCluster.Status.BootstrapTokenSecretARN; got != secretARN {
+			t.Errorf("expected the secret ARN to be %q, got %q", secretARN, got)
+		}
+	})
+
+	t.Run("rotates an expired token", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+		s, workloadClient := newBootstrapTokenTestService(t, smMock, spec, true)
+
+		var stored string
+		smMock.EXPECT().DescribeSecret(gomock.Any()).Return(&secretsmanager.DescribeSecretOutput{
+			ARN:             aws.String(secretARN),
+			LastChangedDate: aws.Time(time.Now().Add(-8 * 24 * time.Hour)),
+		}, nil)
+		smMock.EXPECT().PutSecretValue(gomock.Any()).DoAndReturn(func(input *secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error) {
+			if aws.StringValue(input.SecretId) != secretARN {
+				t.Errorf("expected secret %q, got %q", secretARN, aws.StringValue(input.SecretId))
+			}
+			stored = aws.StringValue(input.SecretString)
+			return &secretsmanager.PutSecretValueOutput{}, nil
+		})
+
+		if err := s.ReconcileBootstrapToken(); err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		if token := workloadToken(t, workloadClient); stored != token {
+			t.Errorf("expected the secret to hold token %q, got %q", token, stored)
+		}
+	})
+
+	t.Run("disables the rotation by a previous Lambda function", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+		s, _ := newBootstrapTokenTestService(t, smMock, spec, true)
+
+		smMock.EXPECT().DescribeSecret(gomock.Any()).Return(&secretsmanager.DescribeSecretOutput{
+			ARN:             aws.String(secretARN),
+			RotationEnabled: aws.Bool(true),
+			LastChangedDate: aws.Time(time.Now()),
+		}, nil)
+		smMock.EXPECT().CancelRotateSecret(gomock.Eq(&secretsmanager.CancelRotateSecretInput{
+			SecretId: aws.String(secretARN),
+		})).Return(&secretsmanager.CancelRotateSecretOutput{}, nil)
+
+		if err := s.ReconcileBootstrapToken(); err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does nothing while the token is valid", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+		s, _ := newBootstrapTokenTestService(t, smMock, spec, true)
+
+		smMock.EXPECT().DescribeSecret(gomock.Any()).Return(&secretsmanager.DescribeSecretOutput{
+			ARN:             aws.String(secretARN),
+			LastChangedDate: aws.Time(time.Now().Add(-24 * time.Hour)),
+		}, nil)
+
+		if err := s.ReconcileBootstrapToken(); err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+	})
+
+	t.Run("waits for the control plane to be initialized", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+		s, _ := newBootstrapTokenTestService(t, smMock, spec, false)
+
+		smMock.EXPECT().DescribeSecret(gomock.Any()).Return(nil, notFound())
+
+		if err := s.ReconcileBootstrapToken(); err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		if got := s.scope.AWSCluster.Status.BootstrapTokenSecretARN; got != "" {
+			t.Errorf("expected no secret ARN, got %q", got)
+		}
+	})
+}
+
+func TestDeleteBootstrapToken(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	smMock := mock_secretsmanageriface.NewMockSecretsManagerAPI(mockCtrl)
+	s, _ := newBootstrapTokenTestService(t, smMock, &infrav1.RotationSpec{RotationDays: 7}, true)
+	s.scope.AWSCluster.Status.BootstrapTokenSecretARN = secretARN
+
+	smMock.EXPECT().DeleteSecret(gomock.Eq(&secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(secretName),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})).Return(nil, notFound())
+
+	if err := s.DeleteBootstrapToken(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if got := s.scope.AWSCluster.Status.BootstrapTokenSecretARN; got != "" {
+		t.Errorf("expected the secret ARN to be cleared, got %q", got)
+	}
+}
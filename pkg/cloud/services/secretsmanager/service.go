@@ -18,6 +18,7 @@ package secretsmanager
 
 import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Service holds a collection of interfaces.
@@ -25,6 +26,9 @@ import (
 // One alternative is to have a large list of functions from the ec2 client.
 type Service struct {
 	scope *scope.ClusterScope
+
+	// workloadClient overrides the client for the workload cluster built from its kubeconfig.
+	workloadClient client.Client
 }
 
 // NewService returns a new service given the api clients.
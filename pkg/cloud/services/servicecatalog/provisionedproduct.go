@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/cloudformation"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// ReconcileProvisionedProduct provisions the Service Catalog product referenced by the cluster,
+// waits for it to become available and imports the VPC and subnets of the cluster from the
+// outputs of its CloudFormation stack. It must run before the network is reconciled.
+func (s *Service) ReconcileProvisionedProduct() error {
+	ref := s.scope.ServiceCatalogRef()
+	if ref == nil {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling Service Catalog provisioned product", "product-id", ref.ProductID)
+
+	product, err := s.describeProvisionedProduct()
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+		product, err = s.provisionProduct(ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	id := aws.StringValue(product.Id)
+	s.scope.AWSCluster.Status.ProvisionedProductID = id
+
+	if isInProgress(product) {
+		product, err = s.waitForProvisionedProduct(id)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return errors.Errorf("Service Catalog provisioned product %q was terminated", id)
+		}
+	}
+	if status := aws.StringValue(product.Status); status != servicecatalog.ProvisionedProductStatusAvailable {
+		return errors.Errorf("Service Catalog provisioned product %q is in status %s: %s", id, status, aws.StringValue(product.StatusMessage))
+	}
+
+	outputs, err := s.getProvisionedProductOutputs(id)
+	if err != nil {
+		return err
+	}
+
+	source := fmt.Sprintf("Service Catalog provisioned product %q", id)
+	if _, err := cloudformation.NewService(s.scope).ImportOutputs(source, ref.OutputKeyMappings, outputs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteProvisionedProduct terminates the Service Catalog product provisioned for the cluster
+// and waits until its resources are deleted. It must run after the network is deleted.
+func (s *Service) DeleteProvisionedProduct() error {
+	if s.scope.ServiceCatalogRef() == nil {
+		return nil
+	}
+
+	product, err := s.describeProvisionedProduct()
+	if err != nil {
+		return err
+	}
+	if product != nil && isInProgress(product) {
+		// A product can not be terminated while it is still being provisioned.
+		product, err = s.waitForProvisionedProduct(aws.StringValue(product.Id))
+		if err != nil {
+			return err
+		}
+	}
+	if product == nil {
+		s.scope.AWSCluster.Status.ProvisionedProductID = ""
+		return nil
+	}
+
+	id := aws.StringValue(product.Id)
+	if _, err := s.scope.ServiceCatalog.TerminateProvisionedProduct(&servicecatalog.TerminateProvisionedProductInput{
+		ProvisionedProductId: aws.String(id),
+	}); err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedTerminateProvisionedProduct", "Failed to terminate Service Catalog provisioned product %q: %v", id, err)
+		return errors.Wrapf(err, "failed to terminate Service Catalog provisioned product %q", id)
+	}
+	record.Eventf(s.scope.AWSCluster, "SuccessfulTerminateProvisionedProduct", "Terminated Service Catalog provisioned product %q", id)
+
+	product, err = s.waitForProvisionedProduct(id)
+	if err != nil {
+		return err
+	}
+	if product != nil {
+		return errors.Errorf("failed to terminate Service Catalog provisioned product %q, in status %s: %s", id, aws.StringValue(product.Status), aws.StringValue(product.StatusMessage))
+	}
+
+	s.scope.AWSCluster.Status.ProvisionedProductID = ""
+	s.scope.Info("Deleted Service Catalog provisioned product", "provisioned-product-id", id)
+	return nil
+}
+
+// describeProvisionedProduct returns the product provisioned for the cluster, or nil if there is none.
+// The product is looked up by name, so that it is found even if its ID was never recorded in the status.
+func (s *Service) describeProvisionedProduct() (*servicecatalog.ProvisionedProductDetail, error) {
+	name := s.provisionedProductName()
+	out, err := s.scope.ServiceCatalog.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+		Name: aws.String(name),
+	})
+	if code, _ := awserrors.Code(err); code == servicecatalog.ErrCodeResourceNotFoundException {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe Service Catalog provisioned product %q", name)
+	}
+
+	return out.ProvisionedProductDetail, nil
+}
+
+func (s *Service) provisionProduct(ref *infrav1.ServiceCatalogRef) (*servicecatalog.ProvisionedProductDetail, error) {
+	input := &servicecatalog.ProvisionProductInput{
+		ProductId:              aws.String(ref.ProductID),
+		ProvisioningArtifactId: aws.String(ref.ProvisioningArtifactID),
+		ProvisionedProductName: aws.String(s.provisionedProductName()),
+		// Tags are propagated to the resources of the product, which must not look like resources
+		// owned by the cluster or they would be deleted with the network.
+		Tags: converters.MapToServiceCatalogTags(s.scope.AdditionalTags()),
+	}
+
+	// Sort the parameters so that the request is stable.
+	keys := make([]string, 0, len(ref.Parameters))
+	for k := range ref.Parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		input.ProvisioningParameters = append(input.ProvisioningParameters, &servicecatalog.ProvisioningParameter{
+			Key:   aws.String(k),
+			Value: aws.String(ref.Parameters[k]),
+		})
+	}
+
+	out, err := s.scope.ServiceCatalog.ProvisionProduct(input)
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedProvisionProduct", "Failed to provision Service Catalog product %q: %v", ref.ProductID, err)
+		return nil, errors.Wrapf(err, "failed to provision Service Catalog product %q", ref.ProductID)
+	}
+
+	id := aws.StringValue(out.RecordDetail.ProvisionedProductId)
+	record.Eventf(s.scope.AWSCluster, "SuccessfulProvisionProduct", "Provisioned Service Catalog product %q as %q", ref.ProductID, id)
+	return &servicecatalog.ProvisionedProductDetail{
+		Id:     aws.String(id),
+		Status: aws.String(servicecatalog.ProvisionedProductStatusUnderChange),
+	}, nil
+}
+
+// waitForProvisionedProduct waits until no operation is in progress on the provisioned product and
+// returns it, or nil once the product has been terminated.
+func (s *Service) waitForProvisionedProduct(id string) (*servicecatalog.ProvisionedProductDetail, error) {
+	var product *servicecatalog.ProvisionedProductDetail
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		out, err := s.scope.ServiceCatalog.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+			Id: aws.String(id),
+		})
+		if code, _ := awserrors.Code(err); code == servicecatalog.ErrCodeResourceNotFoundException {
+			product = nil
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		product = out.ProvisionedProductDetail
+		return !isInProgress(product), nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to wait for Service Catalog provisioned product %q", id)
+	}
+
+	return product, nil
+}
+
+func (s *Service) getProvisionedProductOutputs(id string) (map[string]string, error) {
+	outputs := map[string]string{}
+	if err := s.scope.ServiceCatalog.GetProvisionedProductOutputsPages(&servicecatalog.GetProvisionedProductOutputsInput{
+		ProvisionedProductId: aws.String(id),
+	}, func(page *servicecatalog.GetProvisionedProductOutputsOutput, _ bool) bool {
+		for _, o := range page.Outputs {
+			outputs[aws.StringValue(o.OutputKey)] = aws.StringValue(o.OutputValue)
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to get outputs of Service Catalog provisioned product %q", id)
+	}
+
+	return outputs, nil
+}
+
+func (s *Service) provisionedProductName() string {
+	return s.scope.Name()
+}
+
+func isInProgress(product *servicecatalog.ProvisionedProductDetail) bool {
+	switch aws.StringValue(product.Status) {
+	case servicecatalog.ProvisionedProductStatusUnderChange, servicecatalog.ProvisionedProductStatusPlanInProgress:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicecatalog/mock_servicecatalogiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testProvisionedProductID = "pp-1"
+
+func newServiceCatalogTestScope(t *testing.T, scMock *mock_servicecatalogiface.MockServiceCatalogAPI) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			ServiceCatalogRef: &infrav1.ServiceCatalogRef{
+				ProductID:              "prod-1",
+				ProvisioningArtifactID: "pa-1",
+				Parameters: map[string]string{
+					"VpcCidr":     "10.0.0.0/16",
+					"Environment": "test",
+				},
+				OutputKeyMappings: map[string]string{
+					"VpcId":   infrav1.CFStackOutputVPCID,
+					"Subnets": infrav1.CFStackOutputSubnets,
+				},
+			},
+		},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			ServiceCatalog: scMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func provisionedProduct(status string) *servicecatalog.DescribeProvisionedProductOutput {
+	return &servicecatalog.DescribeProvisionedProductOutput{
+		ProvisionedProductDetail: &servicecatalog.ProvisionedProductDetail{
+			Id:     aws.String(testProvisionedProductID),
+			Name:   aws.String("test-cluster"),
+			Status: aws.String(status),
+		},
+	}
+}
+
+func expectOutputs(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+	m.GetProvisionedProductOutputsPages(gomock.Eq(&servicecatalog.GetProvisionedProductOutputsInput{
+		ProvisionedProductId: aws.String(testProvisionedProductID),
+	}), gomock.Any()).DoAndReturn(func(_ *servicecatalog.GetProvisionedProductOutputsInput, fn func(*servicecatalog.GetProvisionedProductOutputsOutput, bool) bool) error {
+		fn(&servicecatalog.GetProvisionedProductOutputsOutput{
+			Outputs: []*servicecatalog.RecordOutput{
+				{OutputKey: aws.String("VpcId"), OutputValue: aws.String("vpc-1")},
+				{OutputKey: aws.String("Subnets"), OutputValue: aws.String("subnet-1,subnet-2")},
+			},
+		}, true)
+		return nil
+	})
+}
+
+var errProvisionedProductNotFound = awserr.New(servicecatalog.ErrCodeResourceNotFoundException, "not found", nil)
+
+func TestReconcileProvisionedProduct(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expect    func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name: "provisions the product and imports its outputs",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Eq(&servicecatalog.DescribeProvisionedProductInput{
+					Name: aws.String("test-cluster"),
+				})).Return(nil, errProvisionedProductNotFound)
+				m.ProvisionProduct(gomock.Eq(&servicecatalog.ProvisionProductInput{
+					ProductId:              aws.String("prod-1"),
+					ProvisioningArtifactId: aws.String("pa-1"),
+					ProvisionedProductName: aws.String("test-cluster"),
+					ProvisioningParameters: []*servicecatalog.ProvisioningParameter{
+						{Key: aws.String("Environment"), Value: aws.String("test")},
+						{Key: aws.String("VpcCidr"), Value: aws.String("10.0.0.0/16")},
+					},
+					Tags: []*servicecatalog.Tag{},
+				})).Return(&servicecatalog.ProvisionProductOutput{
+					RecordDetail: &servicecatalog.RecordDetail{ProvisionedProductId: aws.String(testProvisionedProductID)},
+				}, nil)
+				m.DescribeProvisionedProduct(gomock.Eq(&servicecatalog.DescribeProvisionedProductInput{
+					Id: aws.String(testProvisionedProductID),
+				})).Return(provisionedProduct(servicecatalog.ProvisionedProductStatusAvailable), nil)
+				expectOutputs(m)
+			},
+		},
+		{
+			name: "imports the outputs of an available product",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Any()).Return(provisionedProduct(servicecatalog.ProvisionedProductStatusAvailable), nil)
+				expectOutputs(m)
+			},
+		},
+		{
+			name: "product failed to provision",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Any()).Return(provisionedProduct(servicecatalog.ProvisionedProductStatusError), nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "provision fails",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Any()).Return(nil, errProvisionedProductNotFound)
+				m.ProvisionProduct(gomock.Any()).Return(nil, awserr.New(servicecatalog.ErrCodeInvalidParametersException, "invalid", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scMock := mock_servicecatalogiface.NewMockServiceCatalogAPI(mockCtrl)
+			tc.expect(scMock.EXPECT())
+
+			s := NewService(newServiceCatalogTestScope(t, scMock))
+			err := s.ReconcileProvisionedProduct()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if s.scope.AWSCluster.Status.ProvisionedProductID != testProvisionedProductID {
+				t.Fatalf("expected provisioned product ID %q in status, got %q", testProvisionedProductID, s.scope.AWSCluster.Status.ProvisionedProductID)
+			}
+			if s.scope.VPC().ID != "vpc-1" {
+				t.Fatalf("expected VPC ID %q, got %q", "vpc-1", s.scope.VPC().ID)
+			}
+			if len(s.scope.Subnets()) != 2 {
+				t.Fatalf("expected 2 subnets, got %d", len(s.scope.Subnets()))
+			}
+		})
+	}
+}
+
+func TestDeleteProvisionedProduct(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expect    func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name: "terminates the product",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Eq(&servicecatalog.DescribeProvisionedProductInput{
+					Name: aws.String("test-cluster"),
+				})).Return(provisionedProduct(servicecatalog.ProvisionedProductStatusAvailable), nil)
+				m.TerminateProvisionedProduct(gomock.Eq(&servicecatalog.TerminateProvisionedProductInput{
+					ProvisionedProductId: aws.String(testProvisionedProductID),
+				})).Return(&servicecatalog.TerminateProvisionedProductOutput{}, nil)
+				m.DescribeProvisionedProduct(gomock.Eq(&servicecatalog.DescribeProvisionedProductInput{
+					Id: aws.String(testProvisionedProductID),
+				})).Return(nil, errProvisionedProductNotFound)
+			},
+		},
+		{
+			name: "product already terminated",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Any()).Return(nil, errProvisionedProductNotFound)
+			},
+		},
+		{
+			name: "termination fails",
+			expect: func(m *mock_servicecatalogiface.MockServiceCatalogAPIMockRecorder) {
+				m.DescribeProvisionedProduct(gomock.Eq(&servicecatalog.DescribeProvisionedProductInput{
+					Name: aws.String("test-cluster"),
+				})).Return(provisionedProduct(servicecatalog.ProvisionedProductStatusAvailable), nil)
+				m.TerminateProvisionedProduct(gomock.Any()).Return(&servicecatalog.TerminateProvisionedProductOutput{}, nil)
+				m.DescribeProvisionedProduct(gomock.Eq(&servicecatalog.DescribeProvisionedProductInput{
+					Id: aws.String(testProvisionedProductID),
+				})).Return(provisionedProduct(servicecatalog.ProvisionedProductStatusError), nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scMock := mock_servicecatalogiface.NewMockServiceCatalogAPI(mockCtrl)
+			tc.expect(scMock.EXPECT())
+
+			clusterScope := newServiceCatalogTestScope(t, scMock)
+			clusterScope.AWSCluster.Status.ProvisionedProductID = testProvisionedProductID
+			s := NewService(clusterScope)
+			err := s.DeleteProvisionedProduct()
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if s.scope.AWSCluster.Status.ProvisionedProductID != "" {
+				t.Fatalf("expected provisioned product ID to be removed from the status, got %q", s.scope.AWSCluster.Status.ProvisionedProductID)
+			}
+		})
+	}
+}
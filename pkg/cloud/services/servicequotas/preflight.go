@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+const (
+	// bastionVCPUs is the number of vCPUs of the default bastion instance type, t2.micro.
+	bastionVCPUs = 1
+	// controlPlaneVCPUs is the minimum number of vCPUs of a control plane instance, as required by kubeadm.
+	controlPlaneVCPUs = 2
+	// securityGroupsPerCluster is the number of security groups created for a cluster, one per role.
+	securityGroupsPerCluster = 5
+	// defaultAvailabilityZones is the number of availability zones default subnets are created in.
+	defaultAvailabilityZones = 3
+)
+
+// QuotaInsufficientError is returned by PreFlightQuotaCheck when creating the
+// resources of the cluster would exceed a service quota.
+type QuotaInsufficientError struct {
+	ServiceCode string
+	QuotaCode   string
+	Description string
+	Used        float64
+	Required    float64
+	Limit       float64
+}
+
+// Error implements the error interface.
+func (e *QuotaInsufficientError) Error() string {
+	return fmt.Sprintf("quota %s/%s (%s) is insufficient: %v used out of %v, %v more required",
+		e.ServiceCode, e.QuotaCode, e.Description, e.Used, e.Limit, e.Required)
+}
+
+// preFlightQuota is a service quota checked before the resources of a new
+// cluster are created, along with the amount the cluster requires.
+type preFlightQuota struct {
+	quota
+	description string
+	required    func(s *Service) float64
+}
+
+var preFlightQuotas = []preFlightQuota{
+	{
+		quota:       quota{serviceCode: "ec2", quotaCode: "L-1216C47A", usage: (*Service).standardInstanceVCPUs},
+		description: "Running On-Demand Standard instances",
+		required:    (*Service).requiredVCPUs,
+	},
+	{
+		quota:       quota{serviceCode: "vpc", quotaCode: "L-FE5A380F", usage: (*Service).natGatewaysPerZone},
+		description: "NAT gateways per Availability Zone",
+		required:    (*Service).requiredNATGatewaysPerZone,
+	},
+	{
+		quota:       quota{serviceCode: "ec2", quotaCode: "L-0263D0A3", usage: (*Service).elasticIPs},
+		description: "EC2-VPC Elastic IPs",
+		required:    (*Service).requiredElasticIPs,
+	},
+	{
+		quota:       quota{serviceCode: "vpc", quotaCode: "L-E79EC296", usage: (*Service).securityGroups},
+		description: "VPC security groups per Region",
+		required:    func(*Service) float64 { return securityGroupsPerCluster },
+	},
+}
+
+// PreFlightQuotaCheck verifies that the account has enough quota left for the
+// instances, NAT gateways, Elastic IPs and security groups the cluster is about
+// to create. It returns a *QuotaInsufficientError for the first quota that
+// would be exceeded.
+func (s *Service) PreFlightQuotaCheck() error {
+	s.scope.V(2).Info("Checking service quotas before creating cluster resources")
+
+	for _, q := range preFlightQuotas {
+		required := q.required(s)
+		if required == 0 {
+			continue
+		}
+
+		value, err := s.quotaValue(q.serviceCode, q.quotaCode)
+		if err != nil {
+			return err
+		}
+		used, err := q.usage(s)
+		if err != nil {
+			return errors.Wrapf(err, "failed to compute usage of quota %s/%s", q.serviceCode, q.quotaCode)
+		}
+
+		if used+required > value {
+			return &QuotaInsufficientError{
+				ServiceCode: q.serviceCode,
+				QuotaCode:   q.quotaCode,
+				Description: q.description,
+				Used:        used,
+				Required:    required,
+				Limit:       value,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) requiredVCPUs() float64 {
+	if s.scope.AWSCluster.Spec.Bastion.Enabled {
+		return bastionVCPUs + controlPlaneVCPUs
+	}
+	return controlPlaneVCPUs
+}
+
+// requiredNATGatewaysPerZone returns the number of NAT gateways created in
+// each availability zone, which is one for managed VPCs.
+func (s *Service) requiredNATGatewaysPerZone() float64 {
+	if s.scope.VPC().ID != "" {
+		return 0
+	}
+	return 1
+}
+
+// requiredElasticIPs returns the number of Elastic IPs allocated to the NAT
+// gateways of a managed VPC, one per availability zone.
+func (s *Service) requiredElasticIPs() float64 {
+	if s.scope.VPC().ID != "" {
+		return 0
+	}
+
+	zones := map[string]bool{}
+	for _, sn := range s.scope.Subnets().FilterPublic() {
+		zones[sn.AvailabilityZone] = true
+	}
+	if len(zones) > 0 {
+		return float64(len(zones))
+	}
+
+	if limit := s.scope.VPC().AvailabilityZoneUsageLimit; limit != nil {
+		return float64(*limit)
+	}
+	return defaultAvailabilityZones
+}
+
+func (s *Service) securityGroups() (float64, error) {
+	var count float64
+	err := s.scope.EC2.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{}, func(out *ec2.DescribeSecurityGroupsOutput, _ bool) bool {
+		count += float64(len(out.SecurityGroups))
+		return true
+	})
+	return count, err
+}
+
+// IsQuotaInsufficient returns the *QuotaInsufficientError wrapped by err, if any.
+func IsQuotaInsufficient(err error) (*QuotaInsufficientError, bool) {
+	qerr, ok := errors.Cause(err).(*QuotaInsufficientError)
+	return qerr, ok
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas/mock_servicequotasiface"
+)
+
+func TestPreFlightQuotaCheck(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	sqMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+
+	expectUsage(ec2Mock.EXPECT(), true)
+	ec2Mock.EXPECT().DescribeSecurityGroupsPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *ec2.DescribeSecurityGroupsInput, fn func(*ec2.DescribeSecurityGroupsOutput, bool) bool) error {
+			fn(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-1")}, {GroupId: aws.String("sg-2")}},
+			}, true)
+			return nil
+		})
+	expectQuota(sqMock.EXPECT(), "ec2", "L-1216C47A", 32)
+	expectQuota(sqMock.EXPECT(), "vpc", "L-FE5A380F", 5)
+	// 2 Elastic IPs are in use, and the 3 NAT gateways of the default subnets need one each.
+	expectQuota(sqMock.EXPECT(), "ec2", "L-0263D0A3", 5)
+	expectQuota(sqMock.EXPECT(), "vpc", "L-E79EC296", 2500)
+
+	s := NewService(newQuotaTestScope(t, ec2Mock, sqMock, nil))
+	if err := s.PreFlightQuotaCheck(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+}
+
+func TestPreFlightQuotaCheckInsufficient(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	sqMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+
+	// The check stops at the first insufficient quota, security groups are never counted.
+	expectUsage(ec2Mock.EXPECT(), true)
+	expectQuota(sqMock.EXPECT(), "ec2", "L-1216C47A", 32)
+	expectQuota(sqMock.EXPECT(), "vpc", "L-FE5A380F", 5)
+	expectQuota(sqMock.EXPECT(), "ec2", "L-0263D0A3", 4)
+
+	s := NewService(newQuotaTestScope(t, ec2Mock, sqMock, nil))
+	err := s.PreFlightQuotaCheck()
+	qerr, ok := IsQuotaInsufficient(err)
+	if !ok {
+		t.Fatalf("expected a QuotaInsufficientError, got %v", err)
+	}
+	if qerr.QuotaCode != "L-0263D0A3" || qerr.Used != 2 || qerr.Required != 3 || qerr.Limit != 4 {
+		t.Fatalf("unexpected bottleneck: %v", qerr)
+	}
+}
+
+func TestPreFlightQuotaCheckUnmanagedVPC(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	sqMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+
+	// NAT gateways and Elastic IPs aren't created in an unmanaged VPC.
+	ec2Mock.EXPECT().DescribeInstancesPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+			fn(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{
+					Instances: []*ec2.Instance{{
+						InstanceType: aws.String("m5.xlarge"),
+						CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(2), ThreadsPerCore: aws.Int64(2)},
+					}},
+				}},
+			}, true)
+			return nil
+		})
+	expectQuota(sqMock.EXPECT(), "ec2", "L-1216C47A", 6)
+
+	clusterScope := newQuotaTestScope(t, ec2Mock, sqMock, nil)
+	clusterScope.AWSCluster.Spec.NetworkSpec.VPC.ID = "vpc-1"
+	clusterScope.AWSCluster.Spec.Bastion.Enabled = true
+
+	err := NewService(clusterScope).PreFlightQuotaCheck()
+	qerr, ok := IsQuotaInsufficient(err)
+	if !ok {
+		t.Fatalf("expected a QuotaInsufficientError, got %v", err)
+	}
+	if qerr.QuotaCode != "L-1216C47A" || qerr.Used != 4 || qerr.Required != 3 {
+		t.Fatalf("unexpected bottleneck: %v", qerr)
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// QuotaCheckInterval is the minimum interval between two quota checks of a cluster.
+	QuotaCheckInterval = 15 * time.Minute
+
+	// quotaWarningThreshold is the fraction of a quota above which a warning event is emitted.
+	quotaWarningThreshold = 0.8
+)
+
+// quota is a service quota tracked by the controller, along with the function
+// that computes its current usage.
+type quota struct {
+	serviceCode string
+	quotaCode   string
+	usage       func(s *Service) (float64, error)
+}
+
+var trackedQuotas = []quota{
+	// Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances, in vCPUs.
+	{serviceCode: "ec2", quotaCode: "L-1216C47A", usage: (*Service).standardInstanceVCPUs},
+	// EC2-VPC Elastic IPs.
+	{serviceCode: "ec2", quotaCode: "L-0263D0A3", usage: (*Service).elasticIPs},
+	// NAT gateways per Availability Zone.
+	{serviceCode: "vpc", quotaCode: "L-FE5A380F", usage: (*Service).natGatewaysPerZone},
+}
+
+// ReconcileQuotas updates the cluster's quota status, at most once per
+// QuotaCheckInterval, and emits a warning event for every quota whose usage
+// exceeds 80% of its value.
+func (s *Service) ReconcileQuotas() error {
+	status := &s.scope.AWSCluster.Status
+	if status.QuotaCheckedAt != nil && time.Since(status.QuotaCheckedAt.Time) < QuotaCheckInterval {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling service quotas")
+
+	var (
+		usages []infrav1.QuotaUsage
+		errs   []error
+	)
+	for _, q := range trackedQuotas {
+		value, err := s.quotaValue(q.serviceCode, q.quotaCode)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		used, err := q.usage(s)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to compute usage of quota %s/%s", q.serviceCode, q.quotaCode))
+			continue
+		}
+
+		if value > 0 && used > quotaWarningThreshold*value {
+			record.Warnf(s.scope.AWSCluster, "QuotaUsageHigh", "Usage of quota %s/%s is %v out of %v", q.serviceCode, q.quotaCode, used, value)
+		}
+
+		usages = append(usages, infrav1.QuotaUsage{
+			ServiceCode: q.serviceCode,
+			QuotaCode:   q.quotaCode,
+			Value:       quantity(value),
+			UsedValue:   quantity(used),
+		})
+	}
+
+	status.QuotaStatus = usages
+	now := metav1.Now()
+	status.QuotaCheckedAt = &now
+
+	return kerrors.NewAggregate(errs)
+}
+
+// quotaValue returns the value of a quota applied to the account, falling
+// back to the AWS default for quotas that were never adjusted.
+func (s *Service) quotaValue(serviceCode, quotaCode string) (float64, error) {
+	out, err := s.scope.ServiceQuotas.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return aws.Float64Value(out.Quota.Value), nil
+	}
+	if code, ok := awserrors.Code(err); !ok || code != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, errors.Wrapf(err, "failed to get service quota %s/%s", serviceCode, quotaCode)
+	}
+
+	def, err := s.scope.ServiceQuotas.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get default service quota %s/%s", serviceCode, quotaCode)
+	}
+	return aws.Float64Value(def.Quota.Value), nil
+}
+
+// standardInstanceFamilies are the instance families counted against the
+// Running On-Demand Standard instances quota. Families sharing a first letter
+// with a standard family, like inf, dl, trn or hpc, have quotas of their own.
+var standardInstanceFamilies = map[string]bool{
+	"a":  true,
+	"c":  true,
+	"d":  true,
+	"h":  true,
+	"i":  true,
+	"im": true,
+	"is": true,
+	"m":  true,
+	"r":  true,
+	"t":  true,
+	"z":  true,
+}
+
+// instanceFamily returns the family of an instance type, the letters preceding
+// its generation, e.g. inf for inf1.xlarge.
+func instanceFamily(instanceType string) string {
+	i := strings.IndexFunc(instanceType, func(r rune) bool { return r < 'a' || r > 'z' })
+	if i < 0 {
+		return instanceType
+	}
+	return instanceType[:i]
+}
+
+func (s *Service) standardInstanceVCPUs() (float64, error) {
+	var vcpus float64
+	err := s.scope.EC2.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.InstanceStates(ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning),
+		},
+	}, func(out *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range out.Reservations {
+			for _, instance := range reservation.Instances {
+				if !standardInstanceFamilies[instanceFamily(aws.StringValue(instance.InstanceType))] ||
+					instance.InstanceLifecycle != nil || instance.CpuOptions == nil {
+					// Spot and scheduled instances have their own quotas.
+					continue
+				}
+				vcpus += float64(aws.Int64Value(instance.CpuOptions.CoreCount) * aws.Int64Value(instance.CpuOptions.ThreadsPerCore))
+			}
+		}
+		return true
+	})
+	return vcpus, err
+}
+
+func (s *Service) elasticIPs() (float64, error) {
+	out, err := s.scope.EC2.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("domain"), Values: aws.StringSlice([]string{ec2.DomainTypeVpc})},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return float64(len(out.Addresses)), nil
+}
+
+func (s *Service) natGatewaysPerZone() (float64, error) {
+	var subnetIDs []*string
+	err := s.scope.EC2.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			filter.EC2.NATGatewayStates(ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable),
+		},
+	}, func(out *ec2.DescribeNatGatewaysOutput, _ bool) bool {
+		for _, ng := range out.NatGateways {
+			subnetIDs = append(subnetIDs, ng.SubnetId)
+		}
+		return true
+	})
+	if err != nil || len(subnetIDs) == 0 {
+		return 0, err
+	}
+
+	out, err := s.scope.EC2.DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: subnetIDs})
+	if err != nil {
+		return 0, err
+	}
+	zones := map[string]string{}
+	for _, sn := range out.Subnets {
+		zones[aws.StringValue(sn.SubnetId)] = aws.StringValue(sn.AvailabilityZone)
+	}
+
+	var max float64
+	perZone := map[string]float64{}
+	for _, id := range subnetIDs {
+		zone := zones[aws.StringValue(id)]
+		perZone[zone]++
+		if perZone[zone] > max {
+			max = perZone[zone]
+		}
+	}
+	return max, nil
+}
+
+func quantity(v float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(v*1000), resource.DecimalSI)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas/mock_servicequotasiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newQuotaTestScope(t *testing.T, ec2Mock *mock_ec2iface.MockEC2API, sqMock *mock_servicequotasiface.MockServiceQuotasAPI, checkedAt *metav1.Time) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+		Status:     infrav1.AWSClusterStatus{QuotaCheckedAt: checkedAt},
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			EC2:           ec2Mock,
+			ServiceQuotas: sqMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func expectUsage(m *mock_ec2iface.MockEC2APIMockRecorder, natGateways bool) {
+	m.DescribeInstancesPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+			fn(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{
+					Instances: []*ec2.Instance{
+						{
+							InstanceType: aws.String("m5.large"),
+							CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(1), ThreadsPerCore: aws.Int64(2)},
+						},
+						{
+							InstanceType: aws.String("t3.xlarge"),
+							CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(2), ThreadsPerCore: aws.Int64(2)},
+						},
+						{
+							InstanceType:      aws.String("m5.large"),
+							InstanceLifecycle: aws.String(ec2.InstanceLifecycleTypeSpot),
+							CpuOptions:        &ec2.CpuOptions{CoreCount: aws.Int64(1), ThreadsPerCore: aws.Int64(2)},
+						},
+						{
+							InstanceType: aws.String("p3.2xlarge"),
+							CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(4), ThreadsPerCore: aws.Int64(2)},
+						},
+						{
+							InstanceType: aws.String("inf1.xlarge"),
+							CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(2), ThreadsPerCore: aws.Int64(2)},
+						},
+						{
+							InstanceType: aws.String("trn1.2xlarge"),
+							CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(4), ThreadsPerCore: aws.Int64(2)},
+						},
+					},
+				}},
+			}, true)
+			return nil
+		})
+	m.DescribeAddresses(gomock.Any()).
+		Return(&ec2.DescribeAddressesOutput{
+			Addresses: []*ec2.Address{{AllocationId: aws.String("eipalloc-1")}, {AllocationId: aws.String("eipalloc-2")}},
+		}, nil)
+	if !natGateways {
+		return
+	}
+	m.DescribeNatGatewaysPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *ec2.DescribeNatGatewaysInput, fn func(*ec2.DescribeNatGatewaysOutput, bool) bool) error {
+			fn(&ec2.DescribeNatGatewaysOutput{
+				NatGateways: []*ec2.NatGateway{
+					{SubnetId: aws.String("subnet-1")},
+					{SubnetId: aws.String("subnet-2")},
+					{SubnetId: aws.String("subnet-3")},
+				},
+			}, true)
+			return nil
+		})
+	m.DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2", "subnet-3"})})).
+		Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-east-1a")},
+				{SubnetId: aws.String("subnet-2"), AvailabilityZone: aws.String("us-east-1a")},
+				{SubnetId: aws.String("subnet-3"), AvailabilityZone: aws.String("us-east-1b")},
+			},
+		}, nil)
+}
+
+func expectQuota(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder, serviceCode, quotaCode string, value float64) {
+	m.GetServiceQuota(gomock.Eq(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})).Return(&servicequotas.GetServiceQuotaOutput{
+		Quota: &servicequotas.ServiceQuota{Value: aws.Float64(value)},
+	}, nil)
+}
+
+func TestReconcileQuotas(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	sqMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+
+	expectUsage(ec2Mock.EXPECT(), true)
+	expectQuota(sqMock.EXPECT(), "ec2", "L-1216C47A", 32)
+	expectQuota(sqMock.EXPECT(), "ec2", "L-0263D0A3", 5)
+	sqMock.EXPECT().GetServiceQuota(gomock.Eq(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String("vpc"),
+		QuotaCode:   aws.String("L-FE5A380F"),
+	})).Return(nil, awserr.New(servicequotas.ErrCodeNoSuchResourceException, "not found", nil))
+	sqMock.EXPECT().GetAWSDefaultServiceQuota(gomock.Eq(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String("vpc"),
+		QuotaCode:   aws.String("L-FE5A380F"),
+	})).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+		Quota: &servicequotas.ServiceQuota{Value: aws.Float64(5)},
+	}, nil)
+
+	s := NewService(newQuotaTestScope(t, ec2Mock, sqMock, nil))
+	if err := s.ReconcileQuotas(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	status := s.scope.AWSCluster.Status
+	if status.QuotaCheckedAt == nil {
+		t.Fatal("expected quotaCheckedAt to be set")
+	}
+	expected := map[string][2]int64{
+		"L-1216C47A": {32, 6},
+		"L-0263D0A3": {5, 2},
+		"L-FE5A380F": {5, 2},
+	}
+	if len(status.QuotaStatus) != len(expected) {
+		t.Fatalf("expected %d quotas, got %d", len(expected), len(status.QuotaStatus))
+	}
+	for _, q := range status.QuotaStatus {
+		want, ok := expected[q.QuotaCode]
+		if !ok {
+			t.Fatalf("unexpected quota %s/%s", q.ServiceCode, q.QuotaCode)
+		}
+		if q.Value.Value() != want[0] || q.UsedValue.Value() != want[1] {
+			t.Errorf("quota %s: expected %d/%d, got %s/%s", q.QuotaCode, want[1], want[0], q.UsedValue.String(), q.Value.String())
+		}
+	}
+}
+
+func TestReconcileQuotasPartialFailure(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	sqMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+
+	expectUsage(ec2Mock.EXPECT(), false)
+	expectQuota(sqMock.EXPECT(), "ec2", "L-1216C47A", 32)
+	expectQuota(sqMock.EXPECT(), "ec2", "L-0263D0A3", 5)
+	// The tracked quotas are independent, an error on one doesn't skip the others.
+	sqMock.EXPECT().GetServiceQuota(gomock.Any()).
+		Return(nil, awserr.New("AccessDeniedException", "denied", nil))
+
+	s := NewService(newQuotaTestScope(t, ec2Mock, sqMock, nil))
+	if err := s.ReconcileQuotas(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(s.scope.AWSCluster.Status.QuotaStatus) != 2 {
+		t.Fatalf("expected 2 quotas, got %d", len(s.scope.AWSCluster.Status.QuotaStatus))
+	}
+}
+
+func TestReconcileQuotasInterval(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	sqMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+
+	checkedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	s := NewService(newQuotaTestScope(t, ec2Mock, sqMock, &checkedAt))
+	if err := s.ReconcileQuotas(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if !s.scope.AWSCluster.Status.QuotaCheckedAt.Equal(&checkedAt) {
+		t.Fatal("expected quotas not to be checked again within the interval")
+	}
+}
+
+func TestStandardInstanceFamilies(t *testing.T) {
+	tests := map[string]bool{
+		"m5.large":       true,
+		"t3a.micro":      true,
+		"i4i.xlarge":     true,
+		"im4gn.large":    true,
+		"d3en.xlarge":    true,
+		"h1.2xlarge":     true,
+		"inf1.xlarge":    false,
+		"dl1.24xlarge":   false,
+		"trn1.2xlarge":   false,
+		"hpc6a.48xlarge": false,
+		"p3.2xlarge":     false,
+		"u-6tb1.metal":   false,
+		"":               false,
+	}
+	for instanceType, standard := range tests {
+		if got := standardInstanceFamilies[instanceFamily(instanceType)]; got != standard {
+			t.Errorf("expected %q to be standard: %v, got %v", instanceType, standard, got)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sns
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// eventMessageAttribute is the message attribute holding the lifecycle event,
+// so that subscriptions can filter on it.
+const eventMessageAttribute = "event"
+
+// lifecycleNotification is the JSON message published for a lifecycle event.
+type lifecycleNotification struct {
+	ClusterName string `json:"clusterName"`
+	Namespace   string `json:"namespace"`
+	Event       string `json:"event"`
+	Timestamp   string `json:"timestamp"`
+	Region      string `json:"region"`
+}
+
+// NotificationPublisher publishes the lifecycle events of a cluster to the
+// SNS topic of its LifecycleNotifications.
+type NotificationPublisher struct {
+	scope *scope.ClusterScope
+	now   func() time.Time
+}
+
+// NewNotificationPublisher returns a new NotificationPublisher for the given scope.
+func NewNotificationPublisher(scope *scope.ClusterScope) *NotificationPublisher {
+	return &NotificationPublisher{
+		scope: scope,
+		now:   time.Now,
+	}
+}
+
+// ReconcileLifecycle publishes the lifecycle event the cluster went through since
+// the last one recorded in its status, if any, and records it. Events the cluster
+// didn't subscribe to are recorded without being published.
+func (p *NotificationPublisher) ReconcileLifecycle() error {
+	spec := p.scope.LifecycleNotifications()
+	if spec == nil {
+		return nil
+	}
+
+	event, ok := lifecycleTransition(p.scope.AWSCluster)
+	if !ok {
+		return nil
+	}
+
+	if subscribed(spec, event) {
+		if err := p.Publish(event); err != nil {
+			return err
+		}
+	}
+
+	p.scope.AWSCluster.Status.LifecycleEvent = event
+	return nil
+}
+
+// Publish publishes the given lifecycle event to the SNS topic of the cluster.
+func (p *NotificationPublisher) Publish(event infrav1.ClusterLifecycleEvent) error {
+	topicARN := p.scope.LifecycleNotifications().TopicARN
+
+	message, err := json.Marshal(lifecycleNotification{
+		ClusterName: p.scope.Name(),
+		Namespace:   p.scope.Namespace(),
+		Event:       string(event),
+		Timestamp:   p.now().UTC().Format(time.RFC3339),
+		Region:      p.scope.Region(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s notification", event)
+	}
+
+	if _, err := p.scope.SNS.Publish(&sns.PublishInput{
+		TopicArn: aws.String(topicARN),
+		Message:  aws.String(string(message)),
+		MessageAttributes: map[string]*sns.MessageAttributeValue{
+			eventMessageAttribute: {
+				DataType:    aws.String("String"),
+				StringValue: aws.String(string(event)),
+			},
+		},
+	}); err != nil {
+		record.Warnf(p.scope.AWSCluster, "FailedPublishNotification", "Failed to publish %s notification to SNS topic %q: %v", event, topicARN, err)
+		return errors.Wrapf(err, "failed to publish %s notification to SNS topic %q", event, topicARN)
+	}
+
+	p.scope.V(2).Info("Published lifecycle notification", "event", event, "topic-arn", topicARN)
+	return nil
+}
+
+// lifecycleTransition returns the lifecycle event the cluster went through since
+// the one recorded in its status, if any.
+func lifecycleTransition(awsCluster *infrav1.AWSCluster) (infrav1.ClusterLifecycleEvent, bool) {
+	last := awsCluster.Status.LifecycleEvent
+
+	switch {
+	case !awsCluster.DeletionTimestamp.IsZero():
+		// The finalizer is removed once the resources of the cluster are deleted.
+		if last != infrav1.ClusterLifecycleEventDeleted && !controllerutil.ContainsFinalizer(awsCluster, infrav1.ClusterFinalizer) {
+			return infrav1.ClusterLifecycleEventDeleted, true
+		}
+	case last == "":
+		return infrav1.ClusterLifecycleEventCreated, true
+	case last != infrav1.ClusterLifecycleEventReady && conditions.IsTrue(awsCluster, clusterv1.ReadyCondition):
+		return infrav1.ClusterLifecycleEventReady, true
+	case last == infrav1.ClusterLifecycleEventReady && conditions.IsFalse(awsCluster, clusterv1.ReadyCondition):
+		return infrav1.ClusterLifecycleEventDegraded, true
+	}
+
+	return "", false
+}
+
+func subscribed(spec *infrav1.SNSNotificationSpec, event infrav1.ClusterLifecycleEvent) bool {
+	if len(spec.Events) == 0 {
+		return true
+	}
+	for _, e := range spec.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sns
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sns/mock_snsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const topicARN = "arn:aws:sns:us-east-1:123456789012:cluster-events"
+
+var now = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newSNSTestScope(t *testing.T, snsMock *mock_snsiface.MockSNSAPI, awsCluster *infrav1.AWSCluster) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		},
+		AWSClients: scope.AWSClients{
+			SNS: snsMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func newAWSCluster(events ...infrav1.ClusterLifecycleEvent) *infrav1.AWSCluster {
+	return &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: infrav1.AWSClusterSpec{
+			Region: "us-east-1",
+			LifecycleNotifications: &infrav1.SNSNotificationSpec{
+				TopicARN: topicARN,
+				Events:   events,
+			},
+		},
+	}
+}
+
+// expectPublish expects the given event to be published and checks its payload.
+func expectPublish(t *testing.T, m *mock_snsiface.MockSNSAPIMockRecorder, event infrav1.ClusterLifecycleEvent) {
+	m.Publish(gomock.Any()).DoAndReturn(func(input *sns.PublishInput) (*sns.PublishOutput, error) {
+		if aws.StringValue(input.TopicArn) != topicARN {
+			t.Errorf("expected the notification to be published to %q, got %q", topicARN, aws.StringValue(input.TopicArn))
+		}
+		if attr := input.MessageAttributes[eventMessageAttribute]; attr == nil || aws.StringValue(attr.StringValue) != string(event) {
+			t.Errorf("expected the event message attribute to be %q, got %v", event, attr)
+		}
+
+		var got map[string]string
+		if err := json.Unmarshal([]byte(aws.StringValue(input.Message)), &got); err != nil {
+			t.Fatalf("failed to unmarshal message: %v", err)
+		}
+		expected := map[string]string{
+			"clusterName": "test-cluster",
+			"namespace":   "default",
+			"event":       string(event),
+			"timestamp":   "2020-06-01T12:00:00Z",
+			"region":      "us-east-1",
+		}
+		for k, v := range expected {
+			if got[k] != v {
+				t.Errorf("expected %q of the message to be %q, got %q", k, v, got[k])
+			}
+		}
+		return &sns.PublishOutput{MessageId: aws.String("1")}, nil
+	})
+}
+
+func TestReconcileLifecycle(t *testing.T) {
+	deletionTimestamp := metav1.NewTime(now)
+
+	testCases := []struct {
+		name   string
+		mutate func(*infrav1.AWSCluster)
+		expect infrav1.ClusterLifecycleEvent
+	}{
+		{
+			name:   "publishes created for a new cluster",
+			mutate: func(*infrav1.AWSCluster) {},
+			expect: infrav1.ClusterLifecycleEventCreated,
+		},
+		{
+			name: "publishes ready once the cluster is ready",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventCreated
+				conditions.MarkTrue(c, clusterv1.ReadyCondition)
+			},
+			expect: infrav1.ClusterLifecycleEventReady,
+		},
+		{
+			name: "publishes degraded once a ready cluster is no longer ready",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventReady
+				conditions.MarkFalse(c, clusterv1.ReadyCondition, "Failed", clusterv1.ConditionSeverityError, "")
+			},
+			expect: infrav1.ClusterLifecycleEventDegraded,
+		},
+		{
+			name: "publishes ready once a degraded cluster recovers",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventDegraded
+				conditions.MarkTrue(c, clusterv1.ReadyCondition)
+			},
+			expect: infrav1.ClusterLifecycleEventReady,
+		},
+		{
+			name: "publishes deleted once the resources of the cluster are deleted",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventReady
+				c.DeletionTimestamp = &deletionTimestamp
+			},
+			expect: infrav1.ClusterLifecycleEventDeleted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			snsMock := mock_snsiface.NewMockSNSAPI(mockCtrl)
+			awsCluster := newAWSCluster()
+			tc.mutate(awsCluster)
+			clusterScope := newSNSTestScope(t, snsMock, awsCluster)
+
+			expectPublish(t, snsMock.EXPECT(), tc.expect)
+
+			p := NewNotificationPublisher(clusterScope)
+			p.now = func() time.Time { return now }
+			if err := p.ReconcileLifecycle(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if awsCluster.Status.LifecycleEvent != tc.expect {
+				t.Errorf("expected the lifecycle event to be %q, got %q", tc.expect, awsCluster.Status.LifecycleEvent)
+			}
+		})
+	}
+}
+
+func TestReconcileLifecycleWithoutTransition(t *testing.T) {
+	deletionTimestamp := metav1.NewTime(now)
+
+	testCases := []struct {
+		name   string
+		mutate func(*infrav1.AWSCluster)
+	}{
+		{
+			name: "cluster still ready",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventReady
+				conditions.MarkTrue(c, clusterv1.ReadyCondition)
+			},
+		},
+		{
+			name: "cluster created but not ready yet",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventCreated
+				conditions.MarkFalse(c, clusterv1.ReadyCondition, "Provisioning", clusterv1.ConditionSeverityInfo, "")
+			},
+		},
+		{
+			name: "cluster still being deleted",
+			mutate: func(c *infrav1.AWSCluster) {
+				c.Status.LifecycleEvent = infrav1.ClusterLifecycleEventReady
+				c.DeletionTimestamp = &deletionTimestamp
+				c.Finalizers = []string{infrav1.ClusterFinalizer}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			snsMock := mock_snsiface.NewMockSNSAPI(mockCtrl)
+			awsCluster := newAWSCluster()
+			tc.mutate(awsCluster)
+			last := awsCluster.Status.LifecycleEvent
+
+			if err := NewNotificationPublisher(newSNSTestScope(t, snsMock, awsCluster)).ReconcileLifecycle(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if awsCluster.Status.LifecycleEvent != last {
+				t.Errorf("expected the lifecycle event to stay %q, got %q", last, awsCluster.Status.LifecycleEvent)
+			}
+		})
+	}
+}
+
+func TestReconcileLifecycleUnsubscribedEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	snsMock := mock_snsiface.NewMockSNSAPI(mockCtrl)
+	awsCluster := newAWSCluster(infrav1.ClusterLifecycleEventDeleted)
+
+	if err := NewNotificationPublisher(newSNSTestScope(t, snsMock, awsCluster)).ReconcileLifecycle(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if awsCluster.Status.LifecycleEvent != infrav1.ClusterLifecycleEventCreated {
+		t.Errorf("expected the unpublished created event to be recorded, got %q", awsCluster.Status.LifecycleEvent)
+	}
+}
+
+func TestReconcileLifecyclePublishFailure(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	snsMock := mock_snsiface.NewMockSNSAPI(mockCtrl)
+	awsCluster := newAWSCluster()
+	snsMock.EXPECT().Publish(gomock.Any()).Return(nil, errors.New("AuthorizationError"))
+
+	if err := NewNotificationPublisher(newSNSTestScope(t, snsMock, awsCluster)).ReconcileLifecycle(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if awsCluster.Status.LifecycleEvent != "" {
+		t.Errorf("expected the lifecycle event not to be recorded, got %q", awsCluster.Status.LifecycleEvent)
+	}
+}
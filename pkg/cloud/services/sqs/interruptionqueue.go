@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"fmt"
+)
+
+// InterruptionQueueName returns the name of the SQS queue receiving the interruption events of the nodes
+// launched by the Karpenter installation of the cluster.
+func InterruptionQueueName(clusterName string) string {
+	return fmt.Sprintf("%s-karpenter-interruption", clusterName)
+}
+
+// ReconcileInterruptionQueue creates the interruption queue of the cluster's Karpenter installation, allows
+// EventBridge to send messages to it, and records its URL and ARN in the status of the cluster.
+func (s *Service) ReconcileInterruptionQueue() error {
+	url, arn, err := s.reconcileEventQueue(InterruptionQueueName(s.scope.Name()))
+	if err != nil {
+		return err
+	}
+
+	status := s.scope.KarpenterStatus()
+	status.InterruptionQueueURL = url
+	status.InterruptionQueueARN = arn
+	return nil
+}
+
+// DeleteInterruptionQueue deletes the interruption queue of the cluster's Karpenter installation, if any.
+func (s *Service) DeleteInterruptionQueue() error {
+	status := s.scope.AWSCluster.Status.Karpenter
+	if status == nil || status.InterruptionQueueURL == "" {
+		return nil
+	}
+
+	if err := s.deleteQueue(InterruptionQueueName(s.scope.Name()), status.InterruptionQueueURL); err != nil {
+		return err
+	}
+	status.InterruptionQueueURL = ""
+	status.InterruptionQueueARN = ""
+	return nil
+}
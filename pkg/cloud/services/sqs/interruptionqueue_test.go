@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sqs/mock_sqsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testQueueName = "test-cluster-karpenter-interruption"
+	testQueueURL  = "https://sqs.us-east-1.amazonaws.com/123456789012/test-cluster-karpenter-interruption"
+	testQueueARN  = "arn:aws:sqs:us-east-1:123456789012:test-cluster-karpenter-interruption"
+)
+
+func newSQSTestScope(t *testing.T, sqsMock *mock_sqsiface.MockSQSAPI, status *infrav1.KarpenterStatus) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Karpenter: &infrav1.KarpenterSpec{Version: "v0.27.0", IAMRoleARN: "arn:aws:iam::123456789012:role/karpenter-node"},
+		},
+		Status: infrav1.AWSClusterStatus{Karpenter: status},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			SQS: sqsMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestReconcileInterruptionQueue(t *testing.T) {
+	policy, err := queuePolicy(testQueueARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getAttributes := func(m *mock_sqsiface.MockSQSAPIMockRecorder, policy string) {
+		attrs := map[string]*string{sqs.QueueAttributeNameQueueArn: aws.String(testQueueARN)}
+		if policy != "" {
+			attrs[sqs.QueueAttributeNamePolicy] = aws.String(policy)
+		}
+		m.GetQueueAttributes(gomock.Eq(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(testQueueURL),
+			AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameQueueArn, sqs.QueueAttributeNamePolicy}),
+		})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
+	}
+	setPolicy := func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+		m.SetQueueAttributes(gomock.Eq(&sqs.SetQueueAttributesInput{
+			QueueUrl:   aws.String(testQueueURL),
+			Attributes: map[string]*string{sqs.QueueAttributeNamePolicy: aws.String(policy)},
+		})).Return(&sqs.SetQueueAttributesOutput{}, nil)
+	}
+	queueTags := func(m *mock_sqsiface.MockSQSAPIMockRecorder, lifecycle string) {
+		m.ListQueueTags(gomock.Eq(&sqs.ListQueueTagsInput{QueueUrl: aws.String(testQueueURL)})).
+			Return(&sqs.ListQueueTagsOutput{Tags: map[string]*string{
+				"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": aws.String(lifecycle),
+			}}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mock_sqsiface.MockSQSAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "creates the queue and sets its policy",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Eq(&sqs.GetQueueUrlInput{QueueName: aws.String(testQueueName)})).
+					Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "not found", nil))
+				m.CreateQueue(gomock.AssignableToTypeOf(&sqs.CreateQueueInput{})).
+					DoAndReturn(func(input *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+						if aws.StringValue(input.QueueName) != testQueueName {
+							t.Errorf("expected queue %q, got %q", testQueueName, aws.StringValue(input.QueueName))
+						}
+						if aws.StringValue(input.Tags["sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"]) != "owned" {
+							t.Errorf("expected the queue to be owned by the cluster, got tags %v", aws.StringValueMap(input.Tags))
+						}
+						return &sqs.CreateQueueOutput{QueueUrl: aws.String(testQueueURL)}, nil
+					})
+				getAttributes(m, "")
+				setPolicy(m)
+			},
+		},
+		{
+			name: "leaves an up to date queue alone",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Any()).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+				queueTags(m, "owned")
+				getAttributes(m, policy)
+			},
+		},
+		{
+			name: "fails on a queue not owned by the cluster",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Any()).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+				queueTags(m, "shared")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+			tc.expect(sqsMock.EXPECT())
+
+			clusterScope := newSQSTestScope(t, sqsMock, nil)
+			err := NewService(clusterScope).ReconcileInterruptionQueue()
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+
+			status := clusterScope.AWSCluster.Status.Karpenter
+			if status.InterruptionQueueURL != testQueueURL || status.InterruptionQueueARN != testQueueARN {
+				t.Fatalf("expected the queue in the status, got %+v", status)
+			}
+		})
+	}
+}
+
+func TestDeleteInterruptionQueue(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+	sqsMock.EXPECT().DeleteQueue(gomock.Eq(&sqs.DeleteQueueInput{QueueUrl: aws.String(testQueueURL)})).
+		Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "not found", nil))
+
+	clusterScope := newSQSTestScope(t, sqsMock, &infrav1.KarpenterStatus{InterruptionQueueURL: testQueueURL, InterruptionQueueARN: testQueueARN})
+	if err := NewService(clusterScope).DeleteInterruptionQueue(); err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	if status := clusterScope.AWSCluster.Status.Karpenter; status.InterruptionQueueURL != "" || status.InterruptionQueueARN != "" {
+		t.Fatalf("expected the queue to be removed from the status, got %+v", status)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+)
+
+// NodeTerminationHandlerQueueName returns the name of the SQS queue created for the AWS Node Termination Handler
+// of the cluster.
+func NodeTerminationHandlerQueueName(clusterName string) string {
+	return fmt.Sprintf("%s-nth", clusterName)
+}
+
+// ReconcileNodeTerminationHandlerQueue creates the queue of the cluster's AWS Node Termination Handler when it is
+// managed by the cluster, or looks up the existing queue of its spec otherwise. The URL of the queue is recorded in
+// the status of the cluster, and its ARN is returned.
+func (s *Service) ReconcileNodeTerminationHandlerQueue() (string, error) {
+	spec := s.scope.NodeTerminationHandler()
+	if spec == nil {
+		return "", nil
+	}
+
+	if spec.CreateQueue {
+		url, queueARN, err := s.reconcileEventQueue(NodeTerminationHandlerQueueName(s.scope.Name()))
+		if err != nil {
+			return "", err
+		}
+		s.scope.AWSCluster.Status.NTHQueueURL = url
+		return queueARN, nil
+	}
+
+	parsed, err := arn.Parse(spec.QueueARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse queue ARN %q", spec.QueueARN)
+	}
+	url, err := s.getQueueURL(parsed.Resource, parsed.AccountID)
+	if err != nil {
+		return "", err
+	}
+	if url == "" {
+		return "", errors.Errorf("SQS queue %q not found", spec.QueueARN)
+	}
+	s.scope.AWSCluster.Status.NTHQueueURL = url
+	return spec.QueueARN, nil
+}
+
+// DeleteNodeTerminationHandlerQueue deletes the queue created for the cluster's AWS Node Termination Handler, if
+// any. Existing queues given in the spec are left alone.
+func (s *Service) DeleteNodeTerminationHandlerQueue() error {
+	if s.scope.NodeTerminationHandler() == nil && s.scope.AWSCluster.Status.NTHQueueURL == "" {
+		return nil
+	}
+
+	name := NodeTerminationHandlerQueueName(s.scope.Name())
+	url, err := s.getQueueURL(name, "")
+	if err != nil || url == "" {
+		return err
+	}
+	if owned, err := s.isOwned(name, url); err != nil || !owned {
+		return err
+	}
+
+	if err := s.deleteQueue(name, url); err != nil {
+		return err
+	}
+	s.scope.AWSCluster.Status.NTHQueueURL = ""
+	return nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/sqs/mock_sqsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNTHQueueName = "test-cluster-nth"
+	testNTHQueueURL  = "https://sqs.us-east-1.amazonaws.com/123456789012/test-cluster-nth"
+	testNTHQueueARN  = "arn:aws:sqs:us-east-1:123456789012:test-cluster-nth"
+
+	testSharedQueueURL = "https://sqs.us-east-1.amazonaws.com/210987654321/shared-nth"
+	testSharedQueueARN = "arn:aws:sqs:us-east-1:210987654321:shared-nth"
+)
+
+func newNTHTestScope(t *testing.T, sqsMock *mock_sqsiface.MockSQSAPI, spec *infrav1.NTHSpec) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       infrav1.AWSClusterSpec{NodeTerminationHandler: spec},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			SQS: sqsMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestReconcileNodeTerminationHandlerQueue(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *infrav1.NTHSpec
+		expect  func(m *mock_sqsiface.MockSQSAPIMockRecorder)
+		wantURL string
+		wantARN string
+		wantErr bool
+	}{
+		{
+			name: "creates the queue of the cluster",
+			spec: &infrav1.NTHSpec{CreateQueue: true},
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Eq(&sqs.GetQueueUrlInput{QueueName: aws.String(testNTHQueueName)})).
+					Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "not found", nil))
+				m.CreateQueue(gomock.AssignableToTypeOf(&sqs.CreateQueueInput{})).
+					Return(&sqs.CreateQueueOutput{QueueUrl: aws.String(testNTHQueueURL)}, nil)
+				m.GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{sqs.QueueAttributeNameQueueArn: aws.String(testNTHQueueARN)},
+				}, nil)
+				m.SetQueueAttributes(gomock.Any()).Return(&sqs.SetQueueAttributesOutput{}, nil)
+			},
+			wantURL: testNTHQueueURL,
+			wantARN: testNTHQueueARN,
+		},
+		{
+			name: "looks up an existing queue in its own account",
+			spec: &infrav1.NTHSpec{QueueARN: testSharedQueueARN},
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Eq(&sqs.GetQueueUrlInput{
+					QueueName:              aws.String("shared-nth"),
+					QueueOwnerAWSAccountId: aws.String("210987654321"),
+				})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testSharedQueueURL)}, nil)
+			},
+			wantURL: testSharedQueueURL,
+			wantARN: testSharedQueueARN,
+		},
+		{
+			name: "fails on a missing existing queue",
+			spec: &infrav1.NTHSpec{QueueARN: testSharedQueueARN},
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Any()).Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "not found", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+			tc.expect(sqsMock.EXPECT())
+
+			clusterScope := newNTHTestScope(t, sqsMock, tc.spec)
+			queueARN, err := NewService(clusterScope).ReconcileNodeTerminationHandlerQueue()
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if queueARN != tc.wantARN {
+				t.Fatalf("expected queue ARN %q, got %q", tc.wantARN, queueARN)
+			}
+			if url := clusterScope.AWSCluster.Status.NTHQueueURL; url != tc.wantURL {
+				t.Fatalf("expected queue URL %q in the status, got %q", tc.wantURL, url)
+			}
+		})
+	}
+}
+
+func TestDeleteNodeTerminationHandlerQueue(t *testing.T) {
+	queueTags := func(m *mock_sqsiface.MockSQSAPIMockRecorder, lifecycle string) {
+		m.ListQueueTags(gomock.Eq(&sqs.ListQueueTagsInput{QueueUrl: aws.String(testNTHQueueURL)})).
+			Return(&sqs.ListQueueTagsOutput{Tags: map[string]*string{
+				"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": aws.String(lifecycle),
+			}}, nil)
+	}
+
+	testCases := []struct {
+		name   string
+		expect func(m *mock_sqsiface.MockSQSAPIMockRecorder)
+	}{
+		{
+			name: "deletes the queue of the cluster",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Eq(&sqs.GetQueueUrlInput{QueueName: aws.String(testNTHQueueName)})).
+					Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testNTHQueueURL)}, nil)
+				queueTags(m, "owned")
+				m.DeleteQueue(gomock.Eq(&sqs.DeleteQueueInput{QueueUrl: aws.String(testNTHQueueURL)})).
+					Return(&sqs.DeleteQueueOutput{}, nil)
+			},
+		},
+		{
+			name: "leaves a queue not owned by the cluster alone",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Any()).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testNTHQueueURL)}, nil)
+				queueTags(m, "shared")
+			},
+		},
+		{
+			name: "skips a queue already deleted",
+			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(gomock.Any()).Return(nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "not found", nil))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+			tc.expect(sqsMock.EXPECT())
+
+			clusterScope := newNTHTestScope(t, sqsMock, &infrav1.NTHSpec{CreateQueue: true})
+			if err := NewService(clusterScope).DeleteNodeTerminationHandlerQueue(); err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+		})
+	}
+}
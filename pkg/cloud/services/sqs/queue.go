@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/api/iam/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// eventMessageRetentionPeriod is how long the events of instances are kept in a queue, in seconds.
+// Interruptions are handled within minutes, older events are not relevant anymore.
+const eventMessageRetentionPeriod = "300"
+
+// reconcileEventQueue creates the queue with the given name receiving the events of the instances of the
+// cluster, allows EventBridge to send messages to it, and returns its URL and ARN.
+func (s *Service) reconcileEventQueue(name string) (string, string, error) {
+	url, err := s.getQueueURL(name, "")
+	if err != nil {
+		return "", "", err
+	}
+	if url == "" {
+		out, err := s.scope.SQS.CreateQueue(&sqs.CreateQueueInput{
+			QueueName: aws.String(name),
+			Attributes: map[string]*string{
+				sqs.QueueAttributeNameMessageRetentionPeriod: aws.String(eventMessageRetentionPeriod),
+				sqs.QueueAttributeNameSqsManagedSseEnabled:   aws.String("true"),
+			},
+			Tags: aws.StringMap(infrav1.Build(infrav1.BuildParams{
+				ClusterName: s.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(name),
+				Additional:  s.scope.AdditionalTags(),
+			})),
+		})
+		if err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedCreateQueue", "Failed to create SQS queue %q: %v", name, err)
+			return "", "", errors.Wrapf(err, "failed to create SQS queue %q", name)
+		}
+		url = aws.StringValue(out.QueueUrl)
+		record.Eventf(s.scope.AWSCluster, "SuccessfulCreateQueue", "Created SQS queue %q", name)
+	} else if err := s.checkOwned(name, url); err != nil {
+		return "", "", err
+	}
+
+	attrs, err := s.scope.SQS.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(url),
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameQueueArn, sqs.QueueAttributeNamePolicy}),
+	})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get attributes of SQS queue %q", name)
+	}
+	arn := aws.StringValue(attrs.Attributes[sqs.QueueAttributeNameQueueArn])
+
+	policy, err := queuePolicy(arn)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to build policy of SQS queue %q", name)
+	}
+	if !samePolicy(aws.StringValue(attrs.Attributes[sqs.QueueAttributeNamePolicy]), policy) {
+		if _, err := s.scope.SQS.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+			QueueUrl:   aws.String(url),
+			Attributes: map[string]*string{sqs.QueueAttributeNamePolicy: aws.String(policy)},
+		}); err != nil {
+			return "", "", errors.Wrapf(err, "failed to set policy of SQS queue %q", name)
+		}
+		s.scope.V(2).Info("Set policy of SQS queue", "queue", name)
+	}
+
+	return url, arn, nil
+}
+
+// deleteQueue deletes the queue with the given name and URL, if it still exists.
+func (s *Service) deleteQueue(name, url string) error {
+	if _, err := s.scope.SQS.DeleteQueue(&sqs.DeleteQueueInput{
+		QueueUrl: aws.String(url),
+	}); err != nil && !isQueueNotFound(err) {
+		record.Warnf(s.scope.AWSCluster, "FailedDeleteQueue", "Failed to delete SQS queue %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete SQS queue %q", name)
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteQueue", "Deleted SQS queue %q", name)
+	return nil
+}
+
+// getQueueURL returns the URL of the queue with the given name, owned by the given account or by the account
+// of the cluster if empty, or an empty string if it doesn't exist.
+func (s *Service) getQueueURL(name, ownerAccountID string) (string, error) {
+	input := &sqs.GetQueueUrlInput{QueueName: aws.String(name)}
+	if ownerAccountID != "" {
+		input.QueueOwnerAWSAccountId = aws.String(ownerAccountID)
+	}
+	out, err := s.scope.SQS.GetQueueUrl(input)
+	if isQueueNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get URL of SQS queue %q", name)
+	}
+	return aws.StringValue(out.QueueUrl), nil
+}
+
+// checkOwned returns an error if the queue is not tagged as owned by the cluster.
+func (s *Service) checkOwned(name, url string) error {
+	owned, err := s.isOwned(name, url)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return errors.Errorf("SQS queue %q already exists and is not owned by the cluster", name)
+	}
+	return nil
+}
+
+// isOwned returns true if the queue is tagged as owned by the cluster.
+func (s *Service) isOwned(name, url string) (bool, error) {
+	out, err := s.scope.SQS.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: aws.String(url)})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list tags of SQS queue %q", name)
+	}
+	return aws.StringValue(out.Tags[infrav1.ClusterTagKey(s.scope.Name())]) == string(infrav1.ResourceLifecycleOwned), nil
+}
+
+// queuePolicy returns the policy allowing EventBridge and SQS to send messages to the queue.
+func queuePolicy(arn string) (string, error) {
+	return converters.IAMPolicyDocumentToJSON(iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: iamv1.Statements{
+			{
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com", "sqs.amazonaws.com"}},
+				Action:    iamv1.Actions{"sqs:SendMessage"},
+				Resource:  iamv1.Resources{arn},
+			},
+		},
+	})
+}
+
+// samePolicy returns true if both JSON policy documents are equal.
+func samePolicy(a, b string) bool {
+	var docA, docB interface{}
+	if err := json.Unmarshal([]byte(a), &docA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &docB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(docA, docB)
+}
+
+func isQueueNotFound(err error) bool {
+	code, ok := awserrors.Code(err)
+	return ok && code == sqs.ErrCodeQueueDoesNotExist
+}
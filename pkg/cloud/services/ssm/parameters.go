@@ -0,0 +1,338 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// maxDeleteParameters is the maximum number of parameters deleted by a single DeleteParameters call.
+const maxDeleteParameters = 10
+
+// parameter is the value of an SSM parameter along with its type.
+type parameter struct {
+	value         string
+	parameterType string
+}
+
+// ReconcileParameters writes the exported fields of the status of the cluster as SSM parameters,
+// updating the parameters whose value changed. Fields without a value are not exported. Existing
+// parameters are only updated when they are owned by the cluster, and the parameters written for
+// fields which are no longer exported, or under a previous path, are deleted.
+func (s *Service) ReconcileParameters() error {
+	export := s.scope.SSMParameterExport()
+	if export == nil {
+		return nil
+	}
+
+	desired, err := s.exportedParameters(export)
+	if err != nil {
+		return err
+	}
+	existing, err := s.getParameters(s.parameterPath(export))
+	if err != nil {
+		return err
+	}
+
+	recorded := map[string]bool{}
+	for _, name := range s.scope.AWSCluster.Status.SSMParameters {
+		recorded[name] = true
+	}
+
+	exported := map[string]bool{}
+	for _, field := range export.Parameters {
+		name := s.parameterName(export, field)
+		exported[name] = true
+		want, ok := desired[field]
+		if !ok {
+			s.scope.V(2).Info("Field of the cluster status has no value, not exporting it", "field", field)
+			continue
+		}
+
+		got, exists := existing[name]
+		if exists && (got != want || !recorded[name]) {
+			owned, err := s.isOwned(name)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				record.Warnf(s.scope.AWSCluster, "FailedPutParameter", "Refusing to overwrite SSM parameter %q, which is not owned by the cluster", name)
+				return errors.Errorf("SSM parameter %q already exists and is not owned by the cluster", name)
+			}
+		}
+		if exists && got == want {
+			s.recordParameter(name)
+			continue
+		}
+
+		input := &ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Value: aws.String(want.value),
+			Type:  aws.String(want.parameterType),
+		}
+		// Parameters can only be tagged when they are created.
+		if exists {
+			input.Overwrite = aws.Bool(true)
+		} else {
+			input.Tags = converters.MapToSSMTags(infrav1.Build(infrav1.BuildParams{
+				ClusterName: s.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(name),
+				Additional:  s.scope.AdditionalTags(),
+			}))
+		}
+		if _, err := s.scope.SSM.PutParameter(input); err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedPutParameter", "Failed to write SSM parameter %q: %v", name, err)
+			return errors.Wrapf(err, "failed to write SSM parameter %q", name)
+		}
+
+		s.recordParameter(name)
+
+		if exists {
+			s.scope.V(2).Info("Updated SSM parameter", "parameter", name)
+		} else {
+			record.Eventf(s.scope.AWSCluster, "SuccessfulCreateParameter", "Created SSM parameter %q", name)
+		}
+	}
+
+	var stale []string
+	for _, name := range s.scope.AWSCluster.Status.SSMParameters {
+		if !exported[name] {
+			stale = append(stale, name)
+		}
+	}
+	return s.deleteParameters(stale)
+}
+
+// DeleteParameters deletes the SSM parameters written for the cluster.
+func (s *Service) DeleteParameters() error {
+	return s.deleteParameters(s.scope.AWSCluster.Status.SSMParameters)
+}
+
+// deleteParameters deletes the given SSM parameters and removes them from the status of the cluster.
+func (s *Service) deleteParameters(names []string) error {
+	for len(names) > 0 {
+		batch := names
+		if len(batch) > maxDeleteParameters {
+			batch = batch[:maxDeleteParameters]
+		}
+		names = names[len(batch):]
+
+		// Parameters which don't exist are reported as invalid, rather than failing the call.
+		out, err := s.scope.SSM.DeleteParameters(&ssm.DeleteParametersInput{Names: aws.StringSlice(batch)})
+		if err != nil {
+			record.Warnf(s.scope.AWSCluster, "FailedDeleteParameters", "Failed to delete SSM parameters: %v", err)
+			return errors.Wrap(err, "failed to delete SSM parameters")
+		}
+		for _, name := range out.DeletedParameters {
+			record.Eventf(s.scope.AWSCluster, "SuccessfulDeleteParameter", "Deleted SSM parameter %q", aws.StringValue(name))
+		}
+		s.forgetParameters(batch)
+	}
+
+	return nil
+}
+
+// isOwned returns true if the SSM parameter has the tag of the resources owned by the cluster.
+func (s *Service) isOwned(name string) (bool, error) {
+	out, err := s.scope.SSM.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+		ResourceId:   aws.String(name),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get the tags of SSM parameter %q", name)
+	}
+	return converters.SSMTagsToMap(out.TagList).HasOwned(s.scope.Name()), nil
+}
+
+// recordParameter adds the SSM parameter to the parameters written for the cluster, if missing.
+func (s *Service) recordParameter(name string) {
+	for _, recorded := range s.scope.AWSCluster.Status.SSMParameters {
+		if recorded == name {
+			return
+		}
+	}
+	s.scope.AWSCluster.Status.SSMParameters = append(s.scope.AWSCluster.Status.SSMParameters, name)
+}
+
+// forgetParameters removes the SSM parameters from the parameters written for the cluster.
+func (s *Service) forgetParameters(names []string) {
+	forgotten := map[string]bool{}
+	for _, name := range names {
+		forgotten[name] = true
+	}
+	var remaining []string
+	for _, name := range s.scope.AWSCluster.Status.SSMParameters {
+		if !forgotten[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	s.scope.AWSCluster.Status.SSMParameters = remaining
+}
+
+// getParameters returns the SSM parameters directly under the given path, by name.
+func (s *Service) getParameters(path string) (map[string]parameter, error) {
+	parameters := map[string]parameter{}
+	if err := s.scope.SSM.GetParametersByPathPages(&ssm.GetParametersByPathInput{
+		Path: aws.String(path),
+	}, func(out *ssm.GetParametersByPathOutput, _ bool) bool {
+		for _, p := range out.Parameters {
+			parameters[aws.StringValue(p.Name)] = parameter{
+				value:         aws.StringValue(p.Value),
+				parameterType: aws.StringValue(p.Type),
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to get SSM parameters under %q", path)
+	}
+	return parameters, nil
+}
+
+// exportedParameters returns the parameters of the exported fields of the status of the cluster which
+// have a value, by field path.
+func (s *Service) exportedParameters(export *infrav1.SSMExportSpec) (map[string]parameter, error) {
+	doc, err := s.exportDocument()
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]parameter{}
+	for _, field := range export.Parameters {
+		values, list := lookup(doc, strings.Split(field, "."), false)
+		if len(values) == 0 {
+			continue
+		}
+
+		formatted := make([]string, 0, len(values))
+		for _, value := range values {
+			v, err := formatValue(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to format field %q", field)
+			}
+			if v != "" {
+				formatted = append(formatted, v)
+			}
+		}
+		if len(formatted) == 0 {
+			continue
+		}
+
+		if list {
+			parameters[field] = parameter{value: strings.Join(formatted, ","), parameterType: ssm.ParameterTypeStringList}
+		} else {
+			parameters[field] = parameter{value: formatted[0], parameterType: ssm.ParameterTypeString}
+		}
+	}
+	return parameters, nil
+}
+
+// exportDocument returns the status of the cluster as a JSON document, along with the VPC and subnets
+// of its network spec, which the status only reports once the network is reconciled.
+func (s *Service) exportDocument() (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	if err := toJSONDocument(s.scope.AWSCluster.Status, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to convert the cluster status")
+	}
+	networkSpec := map[string]interface{}{}
+	if err := toJSONDocument(s.scope.AWSCluster.Spec.NetworkSpec, &networkSpec); err != nil {
+		return nil, errors.Wrap(err, "failed to convert the cluster network spec")
+	}
+
+	network, ok := doc["network"].(map[string]interface{})
+	if !ok {
+		network = map[string]interface{}{}
+		doc["network"] = network
+	}
+	for _, key := range []string{"vpc", "subnets"} {
+		if value, ok := networkSpec[key]; ok {
+			network[key] = value
+		}
+	}
+	return doc, nil
+}
+
+// parameterPath returns the path of the SSM parameters of the cluster.
+func (s *Service) parameterPath(export *infrav1.SSMExportSpec) string {
+	return fmt.Sprintf("%s/%s", export.PathPrefix, s.scope.Name())
+}
+
+// parameterName returns the name of the SSM parameter of the given field of the status of the cluster.
+func (s *Service) parameterName(export *infrav1.SSMExportSpec, field string) string {
+	return fmt.Sprintf("%s/%s", s.parameterPath(export), field)
+}
+
+// lookup returns the values at the given path of a JSON document, descending into each item of the
+// lists along the path, and whether a list was found along the path.
+func lookup(doc interface{}, path []string, list bool) ([]interface{}, bool) {
+	switch v := doc.(type) {
+	case []interface{}:
+		var values []interface{}
+		for _, item := range v {
+			itemValues, _ := lookup(item, path, true)
+			values = append(values, itemValues...)
+		}
+		return values, true
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return []interface{}{v}, list
+		}
+		child, ok := v[path[0]]
+		if !ok {
+			return nil, list
+		}
+		return lookup(child, path[1:], list)
+	case nil:
+		return nil, list
+	default:
+		if len(path) > 0 {
+			return nil, list
+		}
+		return []interface{}{v}, list
+	}
+}
+
+// formatValue returns the value of a field of a JSON document as the value of an SSM parameter:
+// strings as they are, other values in JSON.
+func formatValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// toJSONDocument converts a value to a generic JSON document.
+func toJSONDocument(value interface{}, doc *map[string]interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, doc)
+}
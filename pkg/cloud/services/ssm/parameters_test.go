@@ -0,0 +1,288 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testParameterPath = "/capa/test-cluster"
+
+func newTestScope(t *testing.T, ssmMock *mock_ssmiface.MockSSMAPI, parameters ...string) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-1"},
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+					{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+				},
+			},
+			SSMParameterExport: &infrav1.SSMExportSpec{PathPrefix: "/capa", Parameters: parameters},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Network: infrav1.Network{
+				APIServerELB: infrav1.ClassicELB{DNSName: "test.elb.amazonaws.com"},
+			},
+		},
+	}
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSClients: scope.AWSClients{
+			SSM: ssmMock,
+		},
+		AWSCluster: awsCluster,
+		Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+	return clusterScope
+}
+
+func TestReconcileParameters(t *testing.T) {
+	testCases := []struct {
+		name       string
+		parameters []string
+		existing   []*ssm.Parameter
+		// recorded are the parameters written for the cluster by a previous reconciliation.
+		recorded []string
+		// notOwned are the existing parameters without the tag of the cluster.
+		notOwned []string
+		putErr   error
+		// wantPuts are the written parameters by name, as "<type> <value>", followed by "(overwrite)" when updated.
+		wantPuts     map[string]string
+		wantDeletes  []string
+		wantRecorded []string
+		wantErr      bool
+	}{
+		{
+			name:       "writes new parameters with the tags of the cluster",
+			parameters: []string{"network.vpc.id", "network.apiServerElb.dnsName"},
+			wantPuts: map[string]string{
+				testParameterPath + "/network.vpc.id":               "String vpc-1",
+				testParameterPath + "/network.apiServerElb.dnsName": "String test.elb.amazonaws.com",
+			},
+			wantRecorded: []string{testParameterPath + "/network.vpc.id", testParameterPath + "/network.apiServerElb.dnsName"},
+		},
+		{
+			name:       "writes the values of lists as a string list",
+			parameters: []string{"network.subnets.id"},
+			wantPuts: map[string]string{
+				testParameterPath + "/network.subnets.id": "StringList subnet-1,subnet-2",
+			},
+			wantRecorded: []string{testParameterPath + "/network.subnets.id"},
+		},
+		{
+			name:       "updates parameters whose value changed",
+			parameters: []string{"network.vpc.id", "network.apiServerElb.dnsName"},
+			existing: []*ssm.Parameter{
+				{Name: aws.String(testParameterPath + "/network.vpc.id"), Value: aws.String("vpc-0"), Type: aws.String(ssm.ParameterTypeString)},
+				{Name: aws.String(testParameterPath + "/network.apiServerElb.dnsName"), Value: aws.String("test.elb.amazonaws.com"), Type: aws.String(ssm.ParameterTypeString)},
+			},
+			wantPuts: map[string]string{
+				testParameterPath + "/network.vpc.id": "String vpc-1 (overwrite)",
+			},
+			wantRecorded: []string{testParameterPath + "/network.vpc.id", testParameterPath + "/network.apiServerElb.dnsName"},
+		},
+		{
+			name:       "refuses to overwrite parameters not owned by the cluster",
+			parameters: []string{"network.vpc.id"},
+			existing: []*ssm.Parameter{
+				{Name: aws.String(testParameterPath + "/network.vpc.id"), Value: aws.String("vpc-0"), Type: aws.String(ssm.ParameterTypeString)},
+			},
+			notOwned: []string{testParameterPath + "/network.vpc.id"},
+			wantPuts: map[string]string{},
+			wantErr:  true,
+		},
+		{
+			name:       "skips fields without a value",
+			parameters: []string{"network.vpc.cidrBlock", "bastion.id"},
+			wantPuts:   map[string]string{},
+		},
+		{
+			name:       "deletes the parameters of fields which are no longer exported",
+			parameters: []string{"network.vpc.id"},
+			existing: []*ssm.Parameter{
+				{Name: aws.String(testParameterPath + "/network.vpc.id"), Value: aws.String("vpc-1"), Type: aws.String(ssm.ParameterTypeString)},
+				{Name: aws.String(testParameterPath + "/network.apiServerElb.dnsName"), Value: aws.String("test.elb.amazonaws.com"), Type: aws.String(ssm.ParameterTypeString)},
+			},
+			recorded:     []string{testParameterPath + "/network.vpc.id", testParameterPath + "/network.apiServerElb.dnsName"},
+			wantPuts:     map[string]string{},
+			wantDeletes:  []string{testParameterPath + "/network.apiServerElb.dnsName"},
+			wantRecorded: []string{testParameterPath + "/network.vpc.id"},
+		},
+		{
+			name:       "moves the parameters when the path changes",
+			parameters: []string{"network.vpc.id"},
+			recorded:   []string{"/previous/test-cluster/network.vpc.id"},
+			wantPuts: map[string]string{
+				testParameterPath + "/network.vpc.id": "String vpc-1",
+			},
+			wantDeletes:  []string{"/previous/test-cluster/network.vpc.id"},
+			wantRecorded: []string{testParameterPath + "/network.vpc.id"},
+		},
+		{
+			name:       "fails when a parameter cannot be written",
+			parameters: []string{"network.vpc.id"},
+			putErr:     errors.New("access denied"),
+			wantPuts: map[string]string{
+				testParameterPath + "/network.vpc.id": "String vpc-1",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+			ssmMock.EXPECT().GetParametersByPathPages(gomock.Eq(&ssm.GetParametersByPathInput{Path: aws.String(testParameterPath)}), gomock.Any()).
+				DoAndReturn(func(_ *ssm.GetParametersByPathInput, fn func(*ssm.GetParametersByPathOutput, bool) bool) error {
+					fn(&ssm.GetParametersByPathOutput{Parameters: tc.existing}, true)
+					return nil
+				})
+			puts := map[string]string{}
+			ssmMock.EXPECT().PutParameter(gomock.AssignableToTypeOf(&ssm.PutParameterInput{})).
+				DoAndReturn(func(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					put := fmt.Sprintf("%s %s", aws.StringValue(input.Type), aws.StringValue(input.Value))
+					if aws.BoolValue(input.Overwrite) {
+						put += " (overwrite)"
+						if len(input.Tags) > 0 {
+							t.Fatalf("expected no tags on the update of parameter %q", aws.StringValue(input.Name))
+						}
+					} else if len(input.Tags) == 0 {
+						t.Fatalf("expected tags on the new parameter %q", aws.StringValue(input.Name))
+					}
+					puts[aws.StringValue(input.Name)] = put
+					if tc.putErr != nil {
+						return nil, tc.putErr
+					}
+					return &ssm.PutParameterOutput{}, nil
+				}).AnyTimes()
+			ssmMock.EXPECT().ListTagsForResource(gomock.AssignableToTypeOf(&ssm.ListTagsForResourceInput{})).
+				DoAndReturn(func(input *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+					for _, name := range tc.notOwned {
+						if aws.StringValue(input.ResourceId) == name {
+							return &ssm.ListTagsForResourceOutput{}, nil
+						}
+					}
+					return &ssm.ListTagsForResourceOutput{TagList: []*ssm.Tag{
+						{Key: aws.String(infrav1.ClusterTagKey("test-cluster")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+					}}, nil
+				}).AnyTimes()
+			if len(tc.wantDeletes) > 0 {
+				ssmMock.EXPECT().DeleteParameters(gomock.Eq(&ssm.DeleteParametersInput{Names: aws.StringSlice(tc.wantDeletes)})).
+					Return(&ssm.DeleteParametersOutput{DeletedParameters: aws.StringSlice(tc.wantDeletes)}, nil)
+			}
+
+			clusterScope := newTestScope(t, ssmMock, tc.parameters...)
+			clusterScope.AWSCluster.Status.SSMParameters = tc.recorded
+			err := NewService(clusterScope).ReconcileParameters()
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(puts, tc.wantPuts) {
+				t.Fatalf("expected parameters %v to be written, got %v", tc.wantPuts, puts)
+			}
+			if !reflect.DeepEqual(clusterScope.AWSCluster.Status.SSMParameters, tc.wantRecorded) {
+				t.Fatalf("expected parameters %v to be recorded, got %v", tc.wantRecorded, clusterScope.AWSCluster.Status.SSMParameters)
+			}
+		})
+	}
+}
+
+func TestDeleteParameters(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var recorded, firstBatch []string
+	for i := 0; i < 12; i++ {
+		recorded = append(recorded, fmt.Sprintf("%s/field%d", testParameterPath, i))
+		if i < maxDeleteParameters {
+			firstBatch = append(firstBatch, fmt.Sprintf("%s/field%d", testParameterPath, i))
+		}
+	}
+
+	ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+	gomock.InOrder(
+		ssmMock.EXPECT().DeleteParameters(gomock.Eq(&ssm.DeleteParametersInput{Names: aws.StringSlice(firstBatch)})).
+			Return(&ssm.DeleteParametersOutput{DeletedParameters: aws.StringSlice(firstBatch)}, nil),
+		ssmMock.EXPECT().DeleteParameters(gomock.Eq(&ssm.DeleteParametersInput{Names: aws.StringSlice([]string{
+			testParameterPath + "/field10",
+			testParameterPath + "/field11",
+		})})).Return(&ssm.DeleteParametersOutput{InvalidParameters: aws.StringSlice([]string{testParameterPath + "/field11"})}, nil),
+	)
+
+	// Only the recorded parameters are deleted, whatever the export.
+	clusterScope := newTestScope(t, ssmMock, "network.vpc.id")
+	clusterScope.AWSCluster.Status.SSMParameters = recorded
+	if err := NewService(clusterScope).DeleteParameters(); err != nil {
+		t.Fatalf("failed to delete parameters: %v", err)
+	}
+	if len(clusterScope.AWSCluster.Status.SSMParameters) != 0 {
+		t.Fatalf("expected no parameters to remain recorded, got %v", clusterScope.AWSCluster.Status.SSMParameters)
+	}
+}
+
+func TestDeleteParametersOfRemovedExport(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	recorded := []string{testParameterPath + "/network.vpc.id"}
+	ssmMock := mock_ssmiface.NewMockSSMAPI(mockCtrl)
+	ssmMock.EXPECT().DeleteParameters(gomock.Eq(&ssm.DeleteParametersInput{Names: aws.StringSlice(recorded)})).
+		Return(&ssm.DeleteParametersOutput{DeletedParameters: aws.StringSlice(recorded)}, nil)
+
+	clusterScope := newTestScope(t, ssmMock)
+	clusterScope.AWSCluster.Spec.SSMParameterExport = nil
+	clusterScope.AWSCluster.Status.SSMParameters = recorded
+
+	s := NewService(clusterScope)
+	// Without an export there's nothing to write, the controller deletes the recorded parameters instead.
+	if err := s.ReconcileParameters(); err != nil {
+		t.Fatalf("failed to reconcile parameters: %v", err)
+	}
+	if err := s.DeleteParameters(); err != nil {
+		t.Fatalf("failed to delete parameters: %v", err)
+	}
+	if len(clusterScope.AWSCluster.Status.SSMParameters) != 0 {
+		t.Fatalf("expected no parameters to remain recorded, got %v", clusterScope.AWSCluster.Status.SSMParameters)
+	}
+}
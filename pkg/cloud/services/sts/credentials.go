@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultCredentialCheckInterval is how long a successful credential
+	// check is cached before STS is called again.
+	DefaultCredentialCheckInterval = 60 * time.Second
+
+	// credentialCheckTimeout bounds a single sts:GetCallerIdentity call.
+	credentialCheckTimeout = 5 * time.Second
+)
+
+// CredentialsChecker is a readiness check that reports whether the
+// controller's AWS credentials are still valid by calling
+// sts:GetCallerIdentity. Successful checks are cached for the configured
+// interval to avoid hammering STS from frequent probes.
+type CredentialsChecker struct {
+	sts      stsiface.STSAPI
+	interval time.Duration
+	timeout  time.Duration
+	now      func() time.Time
+
+	mu          sync.Mutex
+	lastSuccess time.Time
+}
+
+// NewCredentialsChecker returns a CredentialsChecker using the given STS client.
+func NewCredentialsChecker(client stsiface.STSAPI, interval time.Duration) *CredentialsChecker {
+	return &CredentialsChecker{
+		sts:      client,
+		interval: interval,
+		timeout:  credentialCheckTimeout,
+		now:      time.Now,
+	}
+}
+
+// Check implements healthz.Checker. It returns an error if the credentials
+// could not be validated.
+func (c *CredentialsChecker) Check(req *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.lastSuccess.IsZero() && c.now().Sub(c.lastSuccess) < c.interval {
+		return nil
+	}
+
+	ctx := context.Background()
+	if req != nil {
+		ctx = req.Context()
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if _, err := c.sts.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		return errors.Wrap(err, "unable to validate AWS credentials")
+	}
+
+	c.lastSuccess = c.now()
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+type fakeSTS struct {
+	stsiface.STSAPI
+	err   error
+	calls int
+}
+
+func (f *fakeSTS) GetCallerIdentityWithContext(aws.Context, *sts.GetCallerIdentityInput, ...request.Option) (*sts.GetCallerIdentityOutput, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil
+}
+
+func probe(c *CredentialsChecker) int {
+	rec := httptest.NewRecorder()
+	healthz.CheckHandler{Checker: c.Check}.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestCredentialsChecker(t *testing.T) {
+	now := time.Now()
+	client := &fakeSTS{}
+	c := NewCredentialsChecker(client, time.Minute)
+	c.now = func() time.Time { return now }
+
+	if code := probe(c); code != http.StatusOK {
+		t.Fatalf("expected %d with valid credentials, got %d", http.StatusOK, code)
+	}
+
+	// A successful check is cached for the interval.
+	client.err = errors.New("ExpiredToken")
+	now = now.Add(30 * time.Second)
+	if code := probe(c); code != http.StatusOK {
+		t.Fatalf("expected cached success, got %d", code)
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected 1 call to STS, got %d", client.calls)
+	}
+
+	// Once the interval has passed, invalid credentials fail the probe.
+	now = now.Add(time.Minute)
+	if code := probe(c); code == http.StatusOK {
+		t.Fatal("expected the probe to fail with invalid credentials")
+	}
+	if client.calls != 2 {
+		t.Fatalf("expected 2 calls to STS, got %d", client.calls)
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// ImportState populates the network spec and status of the cluster from its Terraform state: the VPC, the
+// subnets and security groups of the VPC, and the API server load balancer. Fields already set in the spec
+// are kept, and a VPC or security group that conflicts with the state is an error. The imported resources
+// are tagged as imported from Terraform.
+func (s *Service) ImportState() error {
+	spec := s.scope.TerraformStateImport()
+	if spec == nil {
+		return nil
+	}
+
+	location := stateLocation(spec)
+	s.scope.V(2).Info("Importing network from Terraform state", "location", location)
+
+	data, err := s.getState(spec)
+	if err != nil {
+		record.Warnf(s.scope.AWSCluster, "FailedImportTerraformState", "Failed to read Terraform state %s: %v", location, err)
+		return err
+	}
+	res, err := parseState(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to import Terraform state %s", location)
+	}
+
+	ec2IDs, err := s.importResources(res)
+	if err != nil {
+		return errors.Wrapf(err, "failed to import Terraform state %s", location)
+	}
+	if err := s.tagImported(ec2IDs); err != nil {
+		return err
+	}
+
+	record.Eventf(s.scope.AWSCluster, "SuccessfulImportTerraformState", "Imported network from Terraform state %s", location)
+	return nil
+}
+
+// importResources populates the network spec and status of the cluster from the resources of the state, and
+// returns the IDs of the imported EC2 resources.
+func (s *Service) importResources(res *resources) ([]string, error) {
+	vpc := s.scope.VPC()
+	var imported *infrav1.VPCSpec
+	for i := range res.vpcs {
+		if vpc.ID == "" || res.vpcs[i].ID == vpc.ID {
+			if imported != nil {
+				return nil, errors.Errorf("the state has %d VPCs, the VPC to import must be set in spec.networkSpec.vpc.id", len(res.vpcs))
+			}
+			imported = &res.vpcs[i]
+		}
+	}
+	if imported == nil {
+		if vpc.ID != "" {
+			return nil, errors.Errorf("VPC %q is not in the state", vpc.ID)
+		}
+		return nil, errors.New("the state has no VPC")
+	}
+	vpc.ID = imported.ID
+	if vpc.CidrBlock == "" {
+		vpc.CidrBlock = imported.CidrBlock
+	}
+	ids := []string{vpc.ID}
+
+	for _, sn := range res.subnets[vpc.ID] {
+		ids = append(ids, sn.ID)
+		if s.scope.Subnets().FindByID(sn.ID) != nil {
+			continue
+		}
+		s.scope.AWSCluster.Spec.NetworkSpec.Subnets = append(s.scope.AWSCluster.Spec.NetworkSpec.Subnets, sn)
+	}
+
+	groups := res.securityGroups[vpc.ID]
+	roles := make([]string, 0, len(groups))
+	for role := range groups {
+		roles = append(roles, string(role))
+	}
+	sort.Strings(roles)
+	for _, r := range roles {
+		role := infrav1.SecurityGroupRole(r)
+		sg := groups[role]
+		overrides := s.scope.SecurityGroupOverrides()
+		if id, ok := overrides[role]; ok && id != sg.ID {
+			return nil, errors.Errorf("security group %q of role %q conflicts with the security group override %q", sg.ID, role, id)
+		}
+		if s.scope.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides == nil {
+			s.scope.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides = map[infrav1.SecurityGroupRole]string{}
+		}
+		s.scope.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides[role] = sg.ID
+		if s.scope.Network().SecurityGroups == nil {
+			s.scope.Network().SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{}
+		}
+		s.scope.SecurityGroups()[role] = sg
+		ids = append(ids, sg.ID)
+	}
+
+	if res.apiServerELB != nil && s.scope.Network().APIServerELB.Name == "" {
+		s.scope.Network().APIServerELB = *res.apiServerELB
+	}
+
+	return ids, nil
+}
+
+// tagImported tags the imported EC2 resources and API server load balancer as imported from Terraform.
+func (s *Service) tagImported(ec2IDs []string) error {
+	if _, err := s.scope.EC2.CreateTags(&ec2.CreateTagsInput{
+		Resources: aws.StringSlice(ec2IDs),
+		Tags: []*ec2.Tag{{
+			Key:   aws.String(infrav1.ImportedFromTagKey),
+			Value: aws.String(infrav1.TerraformImportedFromTagValue),
+		}},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to tag resources %v imported from Terraform", ec2IDs)
+	}
+
+	name := s.scope.Network().APIServerELB.Name
+	if name == "" {
+		return nil
+	}
+	if _, err := s.scope.ELB.AddTags(&elb.AddTagsInput{
+		LoadBalancerNames: aws.StringSlice([]string{name}),
+		Tags: []*elb.Tag{{
+			Key:   aws.String(infrav1.ImportedFromTagKey),
+			Value: aws.String(infrav1.TerraformImportedFromTagValue),
+		}},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to tag load balancer %q imported from Terraform", name)
+	}
+	return nil
+}
+
+// getState returns the Terraform state stored by the S3 backend.
+func (s *Service) getState(spec *infrav1.TFStateImportSpec) ([]byte, error) {
+	key := stateKey(spec)
+	out, err := s.scope.S3.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(spec.StateS3Bucket),
+		Key:    aws.String(key),
+	})
+	if code, _ := awserrors.Code(err); code == s3.ErrCodeNoSuchKey {
+		return nil, awserrors.NewNotFound(errors.Errorf("Terraform state %q not found in S3 bucket %q", key, spec.StateS3Bucket))
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Terraform state %q from S3 bucket %q", key, spec.StateS3Bucket)
+	}
+	defer out.Body.Close()
+
+	data, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read Terraform state %q from S3 bucket %q", key, spec.StateS3Bucket)
+	}
+	return data, nil
+}
+
+// stateKey returns the key of the state of the workspace, stored by the S3 backend under the default
+// workspace key prefix for workspaces other than default.
+func stateKey(spec *infrav1.TFStateImportSpec) string {
+	if spec.WorkspaceID == "" || spec.WorkspaceID == "default" {
+		return spec.StateS3Key
+	}
+	return fmt.Sprintf("env:/%s/%s", spec.WorkspaceID, spec.StateS3Key)
+}
+
+func stateLocation(spec *infrav1.TFStateImportSpec) string {
+	return fmt.Sprintf("s3://%s/%s", spec.StateS3Bucket, stateKey(spec))
+}
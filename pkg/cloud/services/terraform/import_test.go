@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb/mock_elbiface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3/mock_s3iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestImportState(t *testing.T) {
+	importedTags := []*ec2.Tag{{
+		Key:   aws.String(infrav1.ImportedFromTagKey),
+		Value: aws.String(infrav1.TerraformImportedFromTagValue),
+	}}
+
+	testCases := []struct {
+		name        string
+		workspaceID string
+		networkSpec infrav1.NetworkSpec
+		expect      func(s3Mock *mock_s3iface.MockS3APIMockRecorder, ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, elbMock *mock_elbiface.MockELBAPIMockRecorder)
+		check       func(t *testing.T, awsCluster *infrav1.AWSCluster)
+		wantErr     bool
+	}{
+		{
+			name: "imports and tags the network of the state",
+			expect: func(s3Mock *mock_s3iface.MockS3APIMockRecorder, ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, elbMock *mock_elbiface.MockELBAPIMockRecorder) {
+				s3Mock.GetObject(gomock.Eq(&s3.GetObjectInput{
+					Bucket: aws.String("tf-state"),
+					Key:    aws.String("network/terraform.tfstate"),
+				})).Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader(testState))}, nil)
+				ec2Mock.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"vpc-1", "subnet-1", "subnet-2", "sg-1", "sg-2"}),
+					Tags:      importedTags,
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+				elbMock.AddTags(gomock.Eq(&elb.AddTagsInput{
+					LoadBalancerNames: aws.StringSlice([]string{"test-apiserver"}),
+					Tags: []*elb.Tag{{
+						Key:   aws.String(infrav1.ImportedFromTagKey),
+						Value: aws.String(infrav1.TerraformImportedFromTagValue),
+					}},
+				})).Return(&elb.AddTagsOutput{}, nil)
+			},
+			check: func(t *testing.T, awsCluster *infrav1.AWSCluster) {
+				network := awsCluster.Spec.NetworkSpec
+				if network.VPC.ID != "vpc-1" || network.VPC.CidrBlock != "10.0.0.0/16" {
+					t.Fatalf("expected VPC vpc-1 10.0.0.0/16 to be imported, got %+v", network.VPC)
+				}
+				if len(network.Subnets) != 2 || !network.Subnets[0].IsPublic || network.Subnets[1].IsPublic {
+					t.Fatalf("expected a public and a private subnet to be imported, got %v", network.Subnets)
+				}
+				if network.SecurityGroupOverrides[infrav1.SecurityGroupNode] != "sg-2" || network.SecurityGroupOverrides[infrav1.SecurityGroupAPIServerLB] != "sg-1" {
+					t.Fatalf("expected the security groups to be imported as overrides, got %v", network.SecurityGroupOverrides)
+				}
+				if sg := awsCluster.Status.Network.SecurityGroups[infrav1.SecurityGroupNode]; sg.ID != "sg-2" {
+					t.Fatalf("expected the node security group to be imported, got %+v", sg)
+				}
+				if lb := awsCluster.Status.Network.APIServerELB; lb.DNSName != "test-apiserver.elb.amazonaws.com" {
+					t.Fatalf("expected the API server load balancer to be imported, got %+v", lb)
+				}
+			},
+		},
+		{
+			name:        "reads the state of a workspace",
+			workspaceID: "staging",
+			networkSpec: infrav1.NetworkSpec{
+				VPC:     infrav1.VPCSpec{ID: "vpc-1", CidrBlock: "10.0.0.0/8"},
+				Subnets: infrav1.Subnets{{ID: "subnet-1", AvailabilityZone: "us-east-1a", IsPublic: true}},
+			},
+			expect: func(s3Mock *mock_s3iface.MockS3APIMockRecorder, ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, elbMock *mock_elbiface.MockELBAPIMockRecorder) {
+				s3Mock.GetObject(gomock.Eq(&s3.GetObjectInput{
+					Bucket: aws.String("tf-state"),
+					Key:    aws.String("env:/staging/network/terraform.tfstate"),
+				})).Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader(testState))}, nil)
+				ec2Mock.CreateTags(gomock.Any()).Return(&ec2.CreateTagsOutput{}, nil)
+				elbMock.AddTags(gomock.Any()).Return(&elb.AddTagsOutput{}, nil)
+			},
+			check: func(t *testing.T, awsCluster *infrav1.AWSCluster) {
+				network := awsCluster.Spec.NetworkSpec
+				if network.VPC.CidrBlock != "10.0.0.0/8" {
+					t.Fatalf("expected the CIDR block of the spec to be kept, got %q", network.VPC.CidrBlock)
+				}
+				if len(network.Subnets) != 2 || network.Subnets[1].ID != "subnet-2" {
+					t.Fatalf("expected only the missing subnet to be imported, got %v", network.Subnets)
+				}
+			},
+		},
+		{
+			name: "fails when the VPC of the spec is not in the state",
+			networkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-2"},
+			},
+			expect: func(s3Mock *mock_s3iface.MockS3APIMockRecorder, ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, elbMock *mock_elbiface.MockELBAPIMockRecorder) {
+				s3Mock.GetObject(gomock.Any()).Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader(testState))}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when a security group conflicts with an override",
+			networkSpec: infrav1.NetworkSpec{
+				SecurityGroupOverrides: map[infrav1.SecurityGroupRole]string{infrav1.SecurityGroupNode: "sg-9"},
+			},
+			expect: func(s3Mock *mock_s3iface.MockS3APIMockRecorder, ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, elbMock *mock_elbiface.MockELBAPIMockRecorder) {
+				s3Mock.GetObject(gomock.Any()).Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(strings.NewReader(testState))}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails when the state doesn't exist",
+			expect: func(s3Mock *mock_s3iface.MockS3APIMockRecorder, ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, elbMock *mock_elbiface.MockELBAPIMockRecorder) {
+				s3Mock.GetObject(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			elbMock := mock_elbiface.NewMockELBAPI(mockCtrl)
+			tc.expect(s3Mock.EXPECT(), ec2Mock.EXPECT(), elbMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: tc.networkSpec,
+					TerraformStateImport: &infrav1.TFStateImportSpec{
+						StateS3Bucket: "tf-state",
+						StateS3Key:    "network/terraform.tfstate",
+						WorkspaceID:   tc.workspaceID,
+					},
+				},
+			}
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSClients: scope.AWSClients{
+					EC2: ec2Mock,
+					ELB: elbMock,
+					S3:  s3Mock,
+				},
+				AWSCluster: awsCluster,
+				Client:     fake.NewFakeClientWithScheme(scheme, awsCluster),
+			})
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			err = NewService(clusterScope).ImportState()
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.check != nil {
+				tc.check(t, awsCluster)
+			}
+		})
+	}
+}
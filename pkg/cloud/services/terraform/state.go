@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// stateVersion is the version of the format of the states written by Terraform 0.12 and later.
+const stateVersion = 4
+
+// apiServerELBResourceName is the name of the aws_elb resource imported as the API server load balancer.
+const apiServerELBResourceName = infrav1.APIServerRoleTagValue
+
+// state is the part of a Terraform state the network resources of a cluster are imported from.
+type state struct {
+	Version   int             `json:"version"`
+	Resources []stateResource `json:"resources"`
+}
+
+type stateResource struct {
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Instances []struct {
+		Attributes attributes `json:"attributes"`
+	} `json:"instances"`
+}
+
+// attributes are the attributes of an instance of a resource, as known to the AWS provider.
+type attributes map[string]interface{}
+
+func (a attributes) string(key string) string {
+	s, _ := a[key].(string)
+	return s
+}
+
+func (a attributes) bool(key string) bool {
+	b, _ := a[key].(bool)
+	return b
+}
+
+func (a attributes) strings(key string) []string {
+	values, _ := a[key].([]interface{})
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// resources are the network resources of a Terraform state known to the importer.
+type resources struct {
+	vpcs []infrav1.VPCSpec
+
+	// subnets are the subnets of the state by the ID of their VPC.
+	subnets map[string][]*infrav1.SubnetSpec
+
+	// securityGroups are the security groups of the state by the ID of their VPC, then by role.
+	securityGroups map[string]map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
+
+	apiServerELB *infrav1.ClassicELB
+}
+
+// parseState extracts the VPCs, subnets, security groups and API server load balancer managed by a
+// Terraform state. Security groups are only extracted for the resources named after a role, and resources
+// of other types are ignored.
+func parseState(data []byte) (*resources, error) {
+	st := &state{}
+	if err := json.Unmarshal(data, st); err != nil {
+		return nil, errors.Wrap(err, "failed to parse Terraform state")
+	}
+	if st.Version != stateVersion {
+		return nil, errors.Errorf("unsupported Terraform state version %d, only version %d is supported", st.Version, stateVersion)
+	}
+
+	res := &resources{
+		subnets:        map[string][]*infrav1.SubnetSpec{},
+		securityGroups: map[string]map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{},
+	}
+	for _, r := range st.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+		for _, instance := range r.Instances {
+			attrs := instance.Attributes
+			switch r.Type {
+			case "aws_vpc":
+				res.vpcs = append(res.vpcs, infrav1.VPCSpec{
+					ID:        attrs.string("id"),
+					CidrBlock: attrs.string("cidr_block"),
+				})
+			case "aws_subnet":
+				vpcID := attrs.string("vpc_id")
+				res.subnets[vpcID] = append(res.subnets[vpcID], &infrav1.SubnetSpec{
+					ID:               attrs.string("id"),
+					CidrBlock:        attrs.string("cidr_block"),
+					AvailabilityZone: attrs.string("availability_zone"),
+					IsPublic:         attrs.bool("map_public_ip_on_launch"),
+				})
+			case "aws_security_group":
+				role, ok := securityGroupRole(r.Name)
+				if !ok {
+					continue
+				}
+				if len(r.Instances) > 1 {
+					return nil, errors.Errorf("Terraform resource aws_security_group.%s has %d instances, the security group of role %q must be unique", r.Name, len(r.Instances), role)
+				}
+				vpcID := attrs.string("vpc_id")
+				if res.securityGroups[vpcID] == nil {
+					res.securityGroups[vpcID] = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{}
+				}
+				res.securityGroups[vpcID][role] = infrav1.SecurityGroup{
+					ID:   attrs.string("id"),
+					Name: attrs.string("name"),
+				}
+			case "aws_elb":
+				if r.Name != apiServerELBResourceName {
+					continue
+				}
+				if len(r.Instances) > 1 {
+					return nil, errors.Errorf("Terraform resource aws_elb.%s has %d instances, the API server load balancer must be unique", r.Name, len(r.Instances))
+				}
+				scheme := infrav1.ClassicELBSchemeInternetFacing
+				if attrs.bool("internal") {
+					scheme = infrav1.ClassicELBSchemeInternal
+				}
+				res.apiServerELB = &infrav1.ClassicELB{
+					Name:              attrs.string("name"),
+					DNSName:           attrs.string("dns_name"),
+					Scheme:            scheme,
+					AvailabilityZones: attrs.strings("availability_zones"),
+					SubnetIDs:         attrs.strings("subnets"),
+					SecurityGroupIDs:  attrs.strings("security_groups"),
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// securityGroupRole returns the role of the security group of a Terraform resource named after it, with
+// underscores standing for dashes.
+func securityGroupRole(resourceName string) (infrav1.SecurityGroupRole, bool) {
+	role := infrav1.SecurityGroupRole(strings.ReplaceAll(resourceName, "_", "-"))
+	switch role {
+	case infrav1.SecurityGroupBastion, infrav1.SecurityGroupNode, infrav1.SecurityGroupControlPlane,
+		infrav1.SecurityGroupAPIServerLB, infrav1.SecurityGroupLB:
+		return role, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"reflect"
+	"testing"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+const testState = `{
+  "version": 4,
+  "terraform_version": "0.13.5",
+  "serial": 12,
+  "resources": [
+    {
+      "mode": "data",
+      "type": "aws_vpc",
+      "name": "default",
+      "instances": [{"attributes": {"id": "vpc-default", "cidr_block": "172.31.0.0/16"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "instances": [{"attributes": {"id": "vpc-1", "cidr_block": "10.0.0.0/16"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_subnet",
+      "name": "public",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "subnet-1", "vpc_id": "vpc-1", "cidr_block": "10.0.0.0/24", "availability_zone": "us-east-1a", "map_public_ip_on_launch": true}},
+        {"index_key": 1, "attributes": {"id": "subnet-2", "vpc_id": "vpc-1", "cidr_block": "10.0.1.0/24", "availability_zone": "us-east-1b", "map_public_ip_on_launch": false}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_security_group",
+      "name": "apiserver_lb",
+      "instances": [{"attributes": {"id": "sg-1", "name": "test-apiserver-lb", "vpc_id": "vpc-1"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_security_group",
+      "name": "node",
+      "instances": [{"attributes": {"id": "sg-2", "name": "test-node", "vpc_id": "vpc-1"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_security_group",
+      "name": "monitoring",
+      "instances": [{"attributes": {"id": "sg-3", "name": "test-monitoring", "vpc_id": "vpc-1"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_elb",
+      "name": "apiserver",
+      "instances": [{"attributes": {
+        "name": "test-apiserver",
+        "dns_name": "test-apiserver.elb.amazonaws.com",
+        "internal": false,
+        "availability_zones": ["us-east-1a"],
+        "subnets": ["subnet-1"],
+        "security_groups": ["sg-1"]
+      }}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "bastion",
+      "instances": [{"attributes": {"id": "i-1", "subnet_id": "subnet-1"}}]
+    }
+  ]
+}`
+
+func TestParseState(t *testing.T) {
+	res, err := parseState([]byte(testState))
+	if err != nil {
+		t.Fatalf("failed to parse state: %v", err)
+	}
+
+	expected := &resources{
+		vpcs: []infrav1.VPCSpec{{ID: "vpc-1", CidrBlock: "10.0.0.0/16"}},
+		subnets: map[string][]*infrav1.SubnetSpec{
+			"vpc-1": {
+				{ID: "subnet-1", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a", IsPublic: true},
+				{ID: "subnet-2", CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1b"},
+			},
+		},
+		securityGroups: map[string]map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+			"vpc-1": {
+				infrav1.SecurityGroupAPIServerLB: {ID: "sg-1", Name: "test-apiserver-lb"},
+				infrav1.SecurityGroupNode:        {ID: "sg-2", Name: "test-node"},
+			},
+		},
+		apiServerELB: &infrav1.ClassicELB{
+			Name:              "test-apiserver",
+			DNSName:           "test-apiserver.elb.amazonaws.com",
+			Scheme:            infrav1.ClassicELBSchemeInternetFacing,
+			AvailabilityZones: []string{"us-east-1a"},
+			SubnetIDs:         []string{"subnet-1"},
+			SecurityGroupIDs:  []string{"sg-1"},
+		},
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Fatalf("expected resources %+v, got %+v", expected, res)
+	}
+}
+
+func TestParseStateErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		state string
+	}{
+		{
+			name:  "invalid JSON",
+			state: `{"version": 4, "resources": [`,
+		},
+		{
+			name:  "unsupported version",
+			state: `{"version": 3, "modules": []}`,
+		},
+		{
+			name: "security group of a role with several instances",
+			state: `{"version": 4, "resources": [{"mode": "managed", "type": "aws_security_group", "name": "node", "instances": [
+				{"index_key": 0, "attributes": {"id": "sg-1", "vpc_id": "vpc-1"}},
+				{"index_key": 1, "attributes": {"id": "sg-2", "vpc_id": "vpc-1"}}
+			]}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := parseState([]byte(tc.state)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
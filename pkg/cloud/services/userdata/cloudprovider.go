@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+const (
+	// CloudProviderConfigPath is the path the cloud.conf file of the cloud-controller-manager is written to.
+	CloudProviderConfigPath = "/etc/kubernetes/cloud.conf"
+
+	cloudProviderConfigINI = `[Global]
+KubernetesClusterID = {{.ClusterName}}
+Region = {{.Region}}
+VPC = {{.VpcID}}
+{{- range .SubnetIDs}}
+SubnetID = {{.}}
+{{- end}}
+{{- if .RoleARN}}
+RoleARN = {{.RoleARN}}
+{{- end}}
+`
+
+	cloudProviderConfigCloudConfig = `#cloud-config
+{{template "files" .WriteFiles}}
+`
+)
+
+// RenderCloudProviderConfig returns the INI cloud.conf file of a cloud-controller-manager with the settings
+// of the spec.
+func RenderCloudProviderConfig(spec infrav1.CloudProviderConfigSpec) (string, error) {
+	switch {
+	case spec.ClusterName == "":
+		return "", errors.New("a cluster name is required to render the cloud provider config")
+	case spec.Region == "":
+		return "", errors.New("a region is required to render the cloud provider config")
+	case spec.VpcID == "":
+		return "", errors.New("a VPC ID is required to render the cloud provider config")
+	}
+
+	// Values spanning several lines would add settings of their own to the file.
+	values := append([]string{spec.ClusterName, spec.Region, spec.VpcID, spec.RoleARN}, spec.SubnetIDs...)
+	for _, value := range values {
+		if strings.ContainsAny(value, "\r\n") {
+			return "", errors.Errorf("cloud provider config value %q spans several lines", value)
+		}
+	}
+
+	return generate("cloud-provider-config", cloudProviderConfigINI, spec)
+}
+
+// AppendCloudProviderConfig returns a multi-part MIME document running the given bootstrap data followed by
+// a cloud-config writing the cloud.conf file rendered from the spec to CloudProviderConfigPath.
+func AppendCloudProviderConfig(bootstrapData []byte, spec infrav1.CloudProviderConfigSpec) ([]byte, error) {
+	config, err := RenderCloudProviderConfig(spec)
+	if err != nil {
+		return nil, err
+	}
+	cloudConfig, err := generate("cloud-provider-config-files", cloudProviderConfigCloudConfig, baseUserData{
+		WriteFiles: []Files{{
+			Path:        CloudProviderConfigPath,
+			Owner:       "root:root",
+			Permissions: "0600",
+			Content:     config,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendParts(bootstrapData, part{contentType: "text/cloud-config", body: []byte(cloudConfig)})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+func TestRenderCloudProviderConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     infrav1.CloudProviderConfigSpec
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "required settings",
+			spec: infrav1.CloudProviderConfigSpec{
+				ClusterName: "test-cluster",
+				Region:      "us-east-1",
+				VpcID:       "vpc-1",
+			},
+			expected: `[Global]
+KubernetesClusterID = test-cluster
+Region = us-east-1
+VPC = vpc-1
+`,
+		},
+		{
+			name: "subnets and role",
+			spec: infrav1.CloudProviderConfigSpec{
+				ClusterName: "test-cluster",
+				Region:      "us-east-1",
+				VpcID:       "vpc-1",
+				SubnetIDs:   []string{"subnet-1", "subnet-2"},
+				RoleARN:     "arn:aws:iam::123456789012:role/ccm",
+			},
+			expected: `[Global]
+KubernetesClusterID = test-cluster
+Region = us-east-1
+VPC = vpc-1
+SubnetID = subnet-1
+SubnetID = subnet-2
+RoleARN = arn:aws:iam::123456789012:role/ccm
+`,
+		},
+		{
+			name: "VPC ID is required",
+			spec: infrav1.CloudProviderConfigSpec{
+				ClusterName: "test-cluster",
+				Region:      "us-east-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "values span a single line",
+			spec: infrav1.CloudProviderConfigSpec{
+				ClusterName: "test-cluster",
+				Region:      "us-east-1",
+				VpcID:       "vpc-1",
+				SubnetIDs:   []string{"subnet-1\nRoleARN = arn:aws:iam::123456789012:role/admin"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := RenderCloudProviderConfig(tc.spec)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RenderCloudProviderConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if config != tc.expected {
+				t.Errorf("expected config:\n%s\ngot:\n%s", tc.expected, config)
+			}
+		})
+	}
+}
+
+func TestAppendCloudProviderConfig(t *testing.T) {
+	spec := infrav1.CloudProviderConfigSpec{ClusterName: "test-cluster", Region: "us-east-1", VpcID: "vpc-1"}
+	bootstrapData := "#cloud-config\nruncmd:\n- kubeadm join\n"
+
+	doc, err := AppendCloudProviderConfig([]byte(bootstrapData), spec)
+	if err != nil {
+		t.Fatalf("AppendCloudProviderConfig() error = %v", err)
+	}
+	parts, err := userDataParts(doc)
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+	}
+	if len(parts) != 2 {
+		t.Fatalf("expected 2 parts, got %d", len(parts))
+	}
+	if parts[0].contentType != "text/cloud-config" || string(parts[0].body) != bootstrapData {
+		t.Errorf("expected bootstrap data part, got %q:\n%s", parts[0].contentType, parts[0].body)
+	}
+
+	config, _ := RenderCloudProviderConfig(spec)
+	files := string(parts[1].body)
+	for _, s := range []string{
+		"#cloud-config\nwrite_files:",
+		"path: " + CloudProviderConfigPath,
+		"permissions: '0600'",
+		base64.StdEncoding.EncodeToString([]byte(config)),
+	} {
+		if !strings.Contains(files, s) {
+			t.Errorf("expected write_files part to contain %q, got:\n%s", s, files)
+		}
+	}
+	if parts[1].contentType != "text/cloud-config" {
+		t.Errorf("expected write_files part of type text/cloud-config, got %q", parts[1].contentType)
+	}
+
+	// The parts of user data that already has parts appended are kept.
+	doc, err = AppendGPUDriverInstaller(doc, &GPUDriverInput{BucketURL: "s3://gpu-drivers", DriverVersion: "450.80.02"})
+	if err != nil {
+		t.Fatalf("AppendGPUDriverInstaller() error = %v", err)
+	}
+	parts, err = userDataParts(doc)
+	if err != nil {
+		t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+	}
+	if len(parts) != 3 || !bytes.Contains(parts[1].body, []byte(CloudProviderConfigPath)) || parts[2].contentType != "text/x-shellscript" {
+		t.Errorf("expected the bootstrap data, cloud provider config and GPU driver parts, got:\n%s", doc)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	gpuDriverBashScript = `{{.Header}}
+
+GPU_DRIVER_BUCKET_URL={{.BucketURL}}
+NVIDIA_DRIVER_VERSION={{.DriverVersion}}
+WORKDIR=$(mktemp -d)
+
+# The NVIDIA installers build kernel modules against the running kernel.
+if command -v apt-get > /dev/null; then
+  apt-get -y update && apt-get -y install gcc make "linux-headers-$(uname -r)"
+else
+  yum -y install gcc make "kernel-devel-$(uname -r)"
+fi
+
+aws s3 cp "${GPU_DRIVER_BUCKET_URL}/NVIDIA-Linux-x86_64-${NVIDIA_DRIVER_VERSION}.run" "${WORKDIR}/nvidia-driver.run"
+sh "${WORKDIR}/nvidia-driver.run" --silent --dkms
+{{- if .CUDAVersion}}
+
+CUDA_VERSION={{.CUDAVersion}}
+aws s3 cp "${GPU_DRIVER_BUCKET_URL}/cuda_${CUDA_VERSION}_linux.run" "${WORKDIR}/cuda.run"
+sh "${WORKDIR}/cuda.run" --silent --toolkit
+{{- end}}
+
+rm -rf "${WORKDIR}"
+nvidia-smi
+`
+)
+
+// GPUDriverInput defines the context to generate the NVIDIA driver installation script of a GPU instance.
+type GPUDriverInput struct {
+	baseUserData
+
+	// BucketURL is the s3:// location the installers are downloaded from.
+	BucketURL     string
+	DriverVersion string
+	CUDAVersion   string
+}
+
+// NewGPUDriverInstaller returns a script installing the NVIDIA driver, and optionally the CUDA toolkit,
+// from the S3 bucket of the input.
+func NewGPUDriverInstaller(input *GPUDriverInput) (string, error) {
+	if input.BucketURL == "" {
+		return "", errors.New("a GPU driver bucket URL is required to install GPU drivers")
+	}
+	input.Header = defaultHeader
+	input.BucketURL = strings.TrimSuffix(input.BucketURL, "/")
+	return generate("gpu-driver", gpuDriverBashScript, input)
+}
+
+// AppendGPUDriverInstaller returns a multi-part MIME document running the given bootstrap data followed by
+// the NVIDIA driver installation script generated from the input.
+func AppendGPUDriverInstaller(bootstrapData []byte, input *GPUDriverInput) ([]byte, error) {
+	script, err := NewGPUDriverInstaller(input)
+	if err != nil {
+		return nil, err
+	}
+	return appendParts(bootstrapData, part{contentType: "text/x-shellscript", body: []byte(script)})
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestNewGPUDriverInstaller(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       *GPUDriverInput
+		contains    []string
+		notContains []string
+		wantErr     bool
+	}{
+		{
+			name: "driver only",
+			input: &GPUDriverInput{
+				BucketURL:     "s3://gpu-drivers/nvidia/",
+				DriverVersion: "450.80.02",
+			},
+			contains: []string{
+				"GPU_DRIVER_BUCKET_URL=s3://gpu-drivers/nvidia\n",
+				"NVIDIA_DRIVER_VERSION=450.80.02",
+				"NVIDIA-Linux-x86_64-${NVIDIA_DRIVER_VERSION}.run",
+				"nvidia-smi",
+			},
+			notContains: []string{"cuda"},
+		},
+		{
+			name: "driver and CUDA toolkit",
+			input: &GPUDriverInput{
+				BucketURL:     "s3://gpu-drivers",
+				DriverVersion: "450.80.02",
+				CUDAVersion:   "11.0.3",
+			},
+			contains: []string{
+				"CUDA_VERSION=11.0.3",
+				"cuda_${CUDA_VERSION}_linux.run",
+			},
+		},
+		{
+			name: "bucket URL is required",
+			input: &GPUDriverInput{
+				DriverVersion: "450.80.02",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			script, err := NewGPUDriverInstaller(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewGPUDriverInstaller() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			for _, s := range tc.contains {
+				if !strings.Contains(script, s) {
+					t.Errorf("expected script to contain %q, got:\n%s", s, script)
+				}
+			}
+			for _, s := range tc.notContains {
+				if strings.Contains(script, s) {
+					t.Errorf("expected script not to contain %q, got:\n%s", s, script)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendGPUDriverInstaller(t *testing.T) {
+	input := func() *GPUDriverInput {
+		return &GPUDriverInput{BucketURL: "s3://gpu-drivers", DriverVersion: "450.80.02"}
+	}
+
+	testCases := []struct {
+		name          string
+		bootstrapData string
+		wantType      string
+		wantErr       bool
+	}{
+		{
+			name:          "cloud-config",
+			bootstrapData: "## template: jinja\n#cloud-config\nruncmd:\n- kubeadm join\n",
+			wantType:      "text/cloud-config",
+		},
+		{
+			name:          "shell script",
+			bootstrapData: "#!/bin/bash\nkubeadm join\n",
+			wantType:      "text/x-shellscript",
+		},
+		{
+			name:          "unsupported format",
+			bootstrapData: "kubeadm join\n",
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := AppendGPUDriverInstaller([]byte(tc.bootstrapData), input())
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("AppendGPUDriverInstaller() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			msg, err := mail.ReadMessage(bytes.NewBuffer(doc))
+			if err != nil {
+				t.Fatalf("Cannot parse MIME doc: %+v\n%s", err, string(doc))
+			}
+			_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			if err != nil {
+				t.Fatalf("Cannot parse content type: %v", err)
+			}
+
+			reader := multipart.NewReader(msg.Body, params["boundary"])
+			var types, bodies []string
+			for {
+				part, err := reader.NextPart()
+				if err != nil {
+					break
+				}
+				body, _ := ioutil.ReadAll(part)
+				types = append(types, part.Header.Get("Content-Type"))
+				bodies = append(bodies, string(body))
+			}
+
+			if len(types) != 2 {
+				t.Fatalf("expected 2 parts, got %d", len(types))
+			}
+			if types[0] != tc.wantType || bodies[0] != tc.bootstrapData {
+				t.Errorf("expected bootstrap data part of type %q, got %q:\n%s", tc.wantType, types[0], bodies[0])
+			}
+			if types[1] != "text/x-shellscript" || !strings.Contains(bodies[1], "nvidia-driver.run") {
+				t.Errorf("expected GPU driver script part, got %q:\n%s", types[1], bodies[1])
+			}
+		})
+	}
+}
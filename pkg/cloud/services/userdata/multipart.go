@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	multipartHeader = strings.Join([]string{
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/mixed; boundary=\"%s\"",
+		"\n",
+	}, "\n")
+)
+
+// part is a part of a multi-part MIME user data document.
+type part struct {
+	contentType string
+	body        []byte
+}
+
+// appendParts returns a multi-part MIME document running the given bootstrap data followed by the given parts.
+// The parts of bootstrap data that already is a multi-part MIME document are kept, so that several parts can
+// be appended one after the other.
+func appendParts(bootstrapData []byte, parts ...part) ([]byte, error) {
+	bootstrapParts, err := userDataParts(bootstrapData)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	mpWriter := multipart.NewWriter(&buf)
+	buf.WriteString(fmt.Sprintf(multipartHeader, mpWriter.Boundary()))
+	for _, p := range append(bootstrapParts, parts...) {
+		w, err := mpWriter.CreatePart(textproto.MIMEHeader{"content-type": {p.contentType}})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create userdata part")
+		}
+		if _, err := w.Write(p.body); err != nil {
+			return nil, errors.Wrap(err, "failed to write userdata part")
+		}
+	}
+	if err := mpWriter.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close userdata")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// userDataParts returns the parts of a multi-part MIME user data document, or the user data itself as a
+// single part otherwise.
+func userDataParts(data []byte) ([]part, error) {
+	if !bytes.HasPrefix(data, []byte("MIME-Version:")) {
+		contentType, err := cloudInitContentType(data)
+		if err != nil {
+			return nil, err
+		}
+		return []part{{contentType: contentType, body: data}}, nil
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse multi-part userdata")
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, errors.Errorf("unsupported userdata content type %q", msg.Header.Get("Content-Type"))
+	}
+
+	var parts []part
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read multi-part userdata")
+		}
+		body, err := ioutil.ReadAll(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read multi-part userdata")
+		}
+		parts = append(parts, part{contentType: p.Header.Get("Content-Type"), body: body})
+	}
+}
+
+// cloudInitContentType returns the MIME type cloud-init expects for the given bootstrap data, looking
+// past a leading jinja template marker.
+func cloudInitContentType(data []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "## template:"):
+			continue
+		case strings.HasPrefix(line, "#cloud-config"):
+			return "text/cloud-config", nil
+		case strings.HasPrefix(line, "#!"):
+			return "text/x-shellscript", nil
+		}
+		break
+	}
+	return "", errors.New("userdata can only be appended to cloud-config or shell script bootstrap data")
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hooks calls the user-defined webhooks of the AWSMachine lifecycle.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+// DefaultTimeoutSeconds is the number of seconds to wait for a webhook to respond
+// when the WebhookSpec does not set a timeout.
+const DefaultTimeoutSeconds = 10
+
+// PreTerminationPayload is the body sent to an AWSMachine pre-termination hook.
+type PreTerminationPayload struct {
+	MachineID  string `json:"machineID"`
+	InstanceID string `json:"instanceID"`
+}
+
+// PostProvisionPayload is the body sent to an AWSMachine post-provision hook.
+type PostProvisionPayload struct {
+	InstanceID string `json:"instanceID"`
+	PrivateIP  string `json:"privateIP"`
+}
+
+// Call POSTs the payload encoded as JSON to the webhook, and returns an error if
+// the webhook does not respond with a 200 status code within its timeout.
+func Call(ctx context.Context, spec *infrav1.WebhookSpec, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode webhook payload")
+	}
+
+	timeout := spec.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = DefaultTimeoutSeconds
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for webhook %q", spec.URL)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "failed to call webhook %q", spec.URL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("webhook %q responded with status code %d", spec.URL, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// IgnoreFailure returns true if errors calling the webhook should not block the operation.
+func IgnoreFailure(spec *infrav1.WebhookSpec) bool {
+	return spec.FailurePolicy == infrav1.WebhookFailurePolicyIgnore
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+)
+
+func TestCall(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		expectError bool
+	}{
+		{
+			name: "webhook responds with 200",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				payload := &PreTerminationPayload{}
+				if err := json.NewDecoder(r.Body).Decode(payload); err != nil || payload.InstanceID != "i-1234" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+			expectError: false,
+		},
+		{
+			name: "webhook responds with 500",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectError: true,
+		},
+		{
+			name: "webhook times out",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(2 * time.Second)
+				w.WriteHeader(http.StatusOK)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+
+			err := Call(context.Background(), &infrav1.WebhookSpec{
+				URL:            server.URL,
+				TimeoutSeconds: 1,
+			}, &PreTerminationPayload{MachineID: "machine", InstanceID: "i-1234"})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
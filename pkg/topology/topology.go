@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topology describes the network topology of a cluster as a graph of its AWS resources.
+package topology
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NodeKind is the kind of AWS resource of a node.
+type NodeKind string
+
+const (
+	// NodeKindVPC is a VPC.
+	NodeKindVPC = NodeKind("vpc")
+
+	// NodeKindInternetGateway is an internet gateway.
+	NodeKindInternetGateway = NodeKind("igw")
+
+	// NodeKindNatGateway is a NAT gateway.
+	NodeKindNatGateway = NodeKind("nat")
+
+	// NodeKindSubnet is a subnet.
+	NodeKindSubnet = NodeKind("subnet")
+
+	// NodeKindRouteTable is a route table.
+	NodeKindRouteTable = NodeKind("rtb")
+)
+
+// EdgeKind is the relationship between the resources of an edge.
+type EdgeKind string
+
+const (
+	// EdgeKindAttached links an internet gateway to its VPC.
+	EdgeKindAttached = EdgeKind("attached")
+
+	// EdgeKindContains links a VPC to its subnets, and a subnet to its NAT gateway.
+	EdgeKindContains = EdgeKind("contains")
+
+	// EdgeKindAssociated links a subnet to its route table.
+	EdgeKindAssociated = EdgeKind("associated")
+
+	// EdgeKindRoutes links a route table to the gateway of its default route.
+	EdgeKindRoutes = EdgeKind("routes")
+)
+
+// Graph is the network topology of a cluster.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// Node is an AWS resource of the network.
+type Node struct {
+	ID               string   `json:"id"`
+	Kind             NodeKind `json:"kind"`
+	CIDR             string   `json:"cidr,omitempty"`
+	AvailabilityZone string   `json:"az,omitempty"`
+	Public           bool     `json:"public,omitempty"`
+}
+
+// Edge is the relationship between two resources, identified by their IDs.
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+}
+
+// AddNode adds a node to the graph, unless a node with the same ID exists already.
+func (g *Graph) AddNode(n Node) {
+	if n.ID == "" || g.node(n.ID) != nil {
+		return
+	}
+	g.Nodes = append(g.Nodes, n)
+}
+
+// AddEdge adds an edge to the graph, unless it exists already.
+func (g *Graph) AddEdge(e Edge) {
+	if e.From == "" || e.To == "" {
+		return
+	}
+	for _, existing := range g.Edges {
+		if existing == e {
+			return
+		}
+	}
+	g.Edges = append(g.Edges, e)
+}
+
+func (g *Graph) node(id string) *Node {
+	for i := range g.Nodes {
+		if g.Nodes[i].ID == id {
+			return &g.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// targets returns the nodes the given node is linked to by edges of the given kind.
+func (g *Graph) targets(from string, kind EdgeKind) []*Node {
+	var nodes []*Node
+	for _, e := range g.Edges {
+		if e.From != from || e.Kind != kind {
+			continue
+		}
+		if n := g.node(e.To); n != nil {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// Render writes the graph to w as an ASCII tree: the VPC, its internet gateway and its subnets
+// grouped by availability zone, along with their route tables and NAT gateways.
+func Render(w io.Writer, g *Graph) error {
+	var vpcs []*Node
+	for i := range g.Nodes {
+		if g.Nodes[i].Kind == NodeKindVPC {
+			vpcs = append(vpcs, &g.Nodes[i])
+		}
+	}
+	if len(vpcs) == 0 {
+		return errors.New("topology has no VPC")
+	}
+
+	var b strings.Builder
+	for _, vpc := range vpcs {
+		fmt.Fprintf(&b, "%s\n", label(vpc))
+
+		var lines []string
+		for i := range g.Nodes {
+			if g.Nodes[i].Kind != NodeKindInternetGateway {
+				continue
+			}
+			for _, attached := range g.targets(g.Nodes[i].ID, EdgeKindAttached) {
+				if attached.ID == vpc.ID {
+					lines = append(lines, label(&g.Nodes[i]))
+				}
+			}
+		}
+
+		zones := map[string][]*Node{}
+		for _, subnet := range g.targets(vpc.ID, EdgeKindContains) {
+			zones[subnet.AvailabilityZone] = append(zones[subnet.AvailabilityZone], subnet)
+		}
+		names := make([]string, 0, len(zones))
+		for zone := range zones {
+			names = append(names, zone)
+		}
+		sort.Strings(names)
+
+		children := make([][]string, len(lines), len(lines)+len(names))
+		for i := range lines {
+			children[i] = []string{lines[i]}
+		}
+		for _, zone := range names {
+			tree := []string{zone}
+			if zone == "" {
+				tree[0] = "unknown availability zone"
+			}
+			subnets := zones[zone]
+			for i, subnet := range subnets {
+				tree = append(tree, indent(g.subnetTree(subnet), i == len(subnets)-1)...)
+			}
+			children = append(children, tree)
+		}
+		for i, child := range children {
+			for _, line := range indent(child, i == len(children)-1) {
+				fmt.Fprintf(&b, "%s\n", line)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// subnetTree returns the lines of a subnet with its route table and NAT gateways.
+func (g *Graph) subnetTree(subnet *Node) []string {
+	line := label(subnet)
+	for _, rtb := range g.targets(subnet.ID, EdgeKindAssociated) {
+		line += " -> " + label(rtb)
+		for _, gateway := range g.targets(rtb.ID, EdgeKindRoutes) {
+			line += " -> " + label(gateway)
+		}
+	}
+
+	var children [][]string
+	for _, nat := range g.targets(subnet.ID, EdgeKindContains) {
+		children = append(children, []string{label(nat)})
+	}
+
+	lines := []string{line}
+	for i, child := range children {
+		lines = append(lines, indent(child, i == len(children)-1)...)
+	}
+	return lines
+}
+
+// indent prefixes the lines of a child of a tree with its branch.
+func indent(lines []string, last bool) []string {
+	res := make([]string, 0, len(lines))
+	for i, line := range lines {
+		switch {
+		case i == 0 && last:
+			res = append(res, "`-- "+line)
+		case i == 0:
+			res = append(res, "|-- "+line)
+		case last:
+			res = append(res, "    "+line)
+		default:
+			res = append(res, "|   "+line)
+		}
+	}
+	return res
+}
+
+func label(n *Node) string {
+	var details []string
+	if n.CIDR != "" {
+		details = append(details, n.CIDR)
+	}
+	if n.Kind == NodeKindSubnet {
+		if n.Public {
+			details = append(details, "public")
+		} else {
+			details = append(details, "private")
+		}
+	}
+	if len(details) == 0 {
+		return n.ID
+	}
+	return fmt.Sprintf("%s (%s)", n.ID, strings.Join(details, ", "))
+}
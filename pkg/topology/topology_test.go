@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	graph := &Graph{}
+	graph.AddNode(Node{ID: "vpc-1", Kind: NodeKindVPC, CIDR: "10.0.0.0/16"})
+	graph.AddNode(Node{ID: "igw-1", Kind: NodeKindInternetGateway})
+	graph.AddEdge(Edge{From: "igw-1", To: "vpc-1", Kind: EdgeKindAttached})
+	for _, subnet := range []Node{
+		{ID: "subnet-private-a", Kind: NodeKindSubnet, CIDR: "10.0.1.0/24", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-public-a", Kind: NodeKindSubnet, CIDR: "10.0.0.0/24", AvailabilityZone: "us-east-1a", Public: true},
+		{ID: "subnet-private-b", Kind: NodeKindSubnet, CIDR: "10.0.2.0/24", AvailabilityZone: "us-east-1b"},
+	} {
+		graph.AddNode(subnet)
+		graph.AddEdge(Edge{From: "vpc-1", To: subnet.ID, Kind: EdgeKindContains})
+	}
+	graph.AddNode(Node{ID: "nat-a", Kind: NodeKindNatGateway, AvailabilityZone: "us-east-1a"})
+	graph.AddEdge(Edge{From: "subnet-public-a", To: "nat-a", Kind: EdgeKindContains})
+	graph.AddNode(Node{ID: "rtb-private-a", Kind: NodeKindRouteTable})
+	graph.AddEdge(Edge{From: "subnet-private-a", To: "rtb-private-a", Kind: EdgeKindAssociated})
+	graph.AddEdge(Edge{From: "rtb-private-a", To: "nat-a", Kind: EdgeKindRoutes})
+	graph.AddNode(Node{ID: "rtb-public", Kind: NodeKindRouteTable})
+	graph.AddEdge(Edge{From: "subnet-public-a", To: "rtb-public", Kind: EdgeKindAssociated})
+	graph.AddEdge(Edge{From: "rtb-public", To: "igw-1", Kind: EdgeKindRoutes})
+
+	// Nodes and edges are only added once.
+	graph.AddNode(Node{ID: "vpc-1", Kind: NodeKindVPC})
+	graph.AddEdge(Edge{From: "igw-1", To: "vpc-1", Kind: EdgeKindAttached})
+
+	expected := `vpc-1 (10.0.0.0/16)
+|-- igw-1
+|-- us-east-1a
+|   |-- subnet-private-a (10.0.1.0/24, private) -> rtb-private-a -> nat-a
+|   ` + "`" + `-- subnet-public-a (10.0.0.0/24, public) -> rtb-public -> igw-1
+|       ` + "`" + `-- nat-a
+` + "`" + `-- us-east-1b
+    ` + "`" + `-- subnet-private-b (10.0.2.0/24, private)
+`
+
+	var out bytes.Buffer
+	if err := Render(&out, graph); err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+	if out.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestRenderWithoutVPC(t *testing.T) {
+	if err := Render(&bytes.Buffer{}, &Graph{}); err == nil {
+		t.Fatalf("expected an error for a topology without VPC")
+	}
+}